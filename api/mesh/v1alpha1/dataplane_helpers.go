@@ -20,6 +20,8 @@ const (
 	ProtocolTag = "kuma.io/protocol"
 	// InstanceTag is set only for Dataplanes that implements headless services
 	InstanceTag = "kuma.io/instance"
+	// KubeNamespaceTag is set only for Dataplanes on Kubernetes. It is a namespace of the Pod.
+	KubeNamespaceTag = "k8s.kuma.io/namespace"
 )
 
 type InboundInterface struct {
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	math "math"
 )
@@ -27,10 +28,15 @@ type DataplaneInsight struct {
 	// List of ADS subscriptions created by a given Dataplane.
 	Subscriptions []*DiscoverySubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
 	// Insights about mTLS for Dataplane.
-	MTLS                 *DataplaneInsight_MTLS `protobuf:"bytes,2,opt,name=mTLS,proto3" json:"mTLS,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	MTLS *DataplaneInsight_MTLS `protobuf:"bytes,2,opt,name=mTLS,proto3" json:"mTLS,omitempty"`
+	// Time when the Dataplane started draining before shutdown.
+	// A draining Dataplane is excluded from endpoints of other Dataplanes.
+	DrainTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=drain_time,json=drainTime,proto3" json:"drain_time,omitempty"`
+	// Traffic of the Dataplane to its outbound clusters reported by Kuma DP.
+	Traffic              *DataplaneInsight_Traffic `protobuf:"bytes,4,opt,name=traffic,proto3" json:"traffic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *DataplaneInsight) Reset()         { *m = DataplaneInsight{} }
@@ -72,6 +78,20 @@ func (m *DataplaneInsight) GetMTLS() *DataplaneInsight_MTLS {
 	return nil
 }
 
+func (m *DataplaneInsight) GetDrainTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.DrainTime
+	}
+	return nil
+}
+
+func (m *DataplaneInsight) GetTraffic() *DataplaneInsight_Traffic {
+	if m != nil {
+		return m.Traffic
+	}
+	return nil
+}
+
 // MTLS defines insights for mTLS
 type DataplaneInsight_MTLS struct {
 	// Expiration time of the last certificate that was generated for a
@@ -132,6 +152,143 @@ func (m *DataplaneInsight_MTLS) GetCertificateRegenerations() uint32 {
 	return 0
 }
 
+// Traffic defines traffic to outbound clusters observed by a Dataplane
+// during a single report period.
+type DataplaneInsight_Traffic struct {
+	// Time when the traffic was reported.
+	ReportTime *timestamp.Timestamp `protobuf:"bytes,1,opt,name=report_time,json=reportTime,proto3" json:"report_time,omitempty"`
+	// Period over which the traffic was observed.
+	Period *duration.Duration `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	// Traffic indexed by the name of an upstream cluster.
+	Clusters             map[string]*DataplaneInsight_ClusterTraffic `protobuf:"bytes,3,rep,name=clusters,proto3" json:"clusters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *DataplaneInsight_Traffic) Reset()         { *m = DataplaneInsight_Traffic{} }
+func (m *DataplaneInsight_Traffic) String() string { return proto.CompactTextString(m) }
+func (*DataplaneInsight_Traffic) ProtoMessage()    {}
+func (*DataplaneInsight_Traffic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35794f05b529b342, []int{0, 1}
+}
+
+func (m *DataplaneInsight_Traffic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataplaneInsight_Traffic.Unmarshal(m, b)
+}
+func (m *DataplaneInsight_Traffic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataplaneInsight_Traffic.Marshal(b, m, deterministic)
+}
+func (m *DataplaneInsight_Traffic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataplaneInsight_Traffic.Merge(m, src)
+}
+func (m *DataplaneInsight_Traffic) XXX_Size() int {
+	return xxx_messageInfo_DataplaneInsight_Traffic.Size(m)
+}
+func (m *DataplaneInsight_Traffic) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataplaneInsight_Traffic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataplaneInsight_Traffic proto.InternalMessageInfo
+
+func (m *DataplaneInsight_Traffic) GetReportTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReportTime
+	}
+	return nil
+}
+
+func (m *DataplaneInsight_Traffic) GetPeriod() *duration.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (m *DataplaneInsight_Traffic) GetClusters() map[string]*DataplaneInsight_ClusterTraffic {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+// ClusterTraffic defines traffic to a single upstream cluster.
+type DataplaneInsight_ClusterTraffic struct {
+	// Number of requests sent to the cluster.
+	Requests uint64 `protobuf:"varint,1,opt,name=requests,proto3" json:"requests,omitempty"`
+	// Number of requests that resulted in 5xx.
+	Errors uint64 `protobuf:"varint,2,opt,name=errors,proto3" json:"errors,omitempty"`
+	// Number of connections opened to the cluster.
+	Connections uint64 `protobuf:"varint,3,opt,name=connections,proto3" json:"connections,omitempty"`
+	// Sum of latencies of the requests in milliseconds.
+	LatencySum float64 `protobuf:"fixed64,4,opt,name=latency_sum,json=latencySum,proto3" json:"latency_sum,omitempty"`
+	// Number of requests that the latency was measured for.
+	LatencyCount         uint64   `protobuf:"varint,5,opt,name=latency_count,json=latencyCount,proto3" json:"latency_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DataplaneInsight_ClusterTraffic) Reset()         { *m = DataplaneInsight_ClusterTraffic{} }
+func (m *DataplaneInsight_ClusterTraffic) String() string { return proto.CompactTextString(m) }
+func (*DataplaneInsight_ClusterTraffic) ProtoMessage()    {}
+func (*DataplaneInsight_ClusterTraffic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35794f05b529b342, []int{0, 2}
+}
+
+func (m *DataplaneInsight_ClusterTraffic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataplaneInsight_ClusterTraffic.Unmarshal(m, b)
+}
+func (m *DataplaneInsight_ClusterTraffic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataplaneInsight_ClusterTraffic.Marshal(b, m, deterministic)
+}
+func (m *DataplaneInsight_ClusterTraffic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataplaneInsight_ClusterTraffic.Merge(m, src)
+}
+func (m *DataplaneInsight_ClusterTraffic) XXX_Size() int {
+	return xxx_messageInfo_DataplaneInsight_ClusterTraffic.Size(m)
+}
+func (m *DataplaneInsight_ClusterTraffic) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataplaneInsight_ClusterTraffic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataplaneInsight_ClusterTraffic proto.InternalMessageInfo
+
+func (m *DataplaneInsight_ClusterTraffic) GetRequests() uint64 {
+	if m != nil {
+		return m.Requests
+	}
+	return 0
+}
+
+func (m *DataplaneInsight_ClusterTraffic) GetErrors() uint64 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
+
+func (m *DataplaneInsight_ClusterTraffic) GetConnections() uint64 {
+	if m != nil {
+		return m.Connections
+	}
+	return 0
+}
+
+func (m *DataplaneInsight_ClusterTraffic) GetLatencySum() float64 {
+	if m != nil {
+		return m.LatencySum
+	}
+	return 0
+}
+
+func (m *DataplaneInsight_ClusterTraffic) GetLatencyCount() uint64 {
+	if m != nil {
+		return m.LatencyCount
+	}
+	return 0
+}
+
 // DiscoverySubscription describes a single ADS subscription
 // created by a Dataplane to the Control Plane.
 // Ideally, there should be only one such subscription per Dataplane lifecycle.
@@ -151,10 +308,12 @@ type DiscoverySubscription struct {
 	// Time when a given Dataplane disconnected from the Control Plane.
 	DisconnectTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=disconnect_time,json=disconnectTime,proto3" json:"disconnect_time,omitempty"`
 	// Status of the ADS subscription.
-	Status               *DiscoverySubscriptionStatus `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+	Status *DiscoverySubscriptionStatus `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// Version of Envoy and Kuma DP.
+	Version              *Version `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DiscoverySubscription) Reset()         { *m = DiscoverySubscription{} }
@@ -217,6 +376,182 @@ func (m *DiscoverySubscription) GetStatus() *DiscoverySubscriptionStatus {
 	return nil
 }
 
+func (m *DiscoverySubscription) GetVersion() *Version {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+// Version defines version of Kuma DP and Envoy that created a given
+// subscription.
+type Version struct {
+	// Version of Kuma DP.
+	KumaDp *KumaDpVersion `protobuf:"bytes,1,opt,name=kuma_dp,json=kumaDp,proto3" json:"kuma_dp,omitempty"`
+	// Version of Envoy.
+	Envoy                *EnvoyVersion `protobuf:"bytes,2,opt,name=envoy,proto3" json:"envoy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Version) Reset()         { *m = Version{} }
+func (m *Version) String() string { return proto.CompactTextString(m) }
+func (*Version) ProtoMessage()    {}
+func (*Version) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35794f05b529b342, []int{2}
+}
+
+func (m *Version) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Version.Unmarshal(m, b)
+}
+func (m *Version) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Version.Marshal(b, m, deterministic)
+}
+func (m *Version) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Version.Merge(m, src)
+}
+func (m *Version) XXX_Size() int {
+	return xxx_messageInfo_Version.Size(m)
+}
+func (m *Version) XXX_DiscardUnknown() {
+	xxx_messageInfo_Version.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Version proto.InternalMessageInfo
+
+func (m *Version) GetKumaDp() *KumaDpVersion {
+	if m != nil {
+		return m.KumaDp
+	}
+	return nil
+}
+
+func (m *Version) GetEnvoy() *EnvoyVersion {
+	if m != nil {
+		return m.Envoy
+	}
+	return nil
+}
+
+// KumaDpVersion describes details of Kuma DP version.
+type KumaDpVersion struct {
+	// Version number of Kuma DP.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Git tag of Kuma DP version.
+	GitTag string `protobuf:"bytes,2,opt,name=git_tag,json=gitTag,proto3" json:"git_tag,omitempty"`
+	// Git commit of Kuma DP version.
+	GitCommit string `protobuf:"bytes,3,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	// Build date of Kuma DP version.
+	BuildDate            string   `protobuf:"bytes,4,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KumaDpVersion) Reset()         { *m = KumaDpVersion{} }
+func (m *KumaDpVersion) String() string { return proto.CompactTextString(m) }
+func (*KumaDpVersion) ProtoMessage()    {}
+func (*KumaDpVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35794f05b529b342, []int{3}
+}
+
+func (m *KumaDpVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KumaDpVersion.Unmarshal(m, b)
+}
+func (m *KumaDpVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KumaDpVersion.Marshal(b, m, deterministic)
+}
+func (m *KumaDpVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KumaDpVersion.Merge(m, src)
+}
+func (m *KumaDpVersion) XXX_Size() int {
+	return xxx_messageInfo_KumaDpVersion.Size(m)
+}
+func (m *KumaDpVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_KumaDpVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KumaDpVersion proto.InternalMessageInfo
+
+func (m *KumaDpVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *KumaDpVersion) GetGitTag() string {
+	if m != nil {
+		return m.GitTag
+	}
+	return ""
+}
+
+func (m *KumaDpVersion) GetGitCommit() string {
+	if m != nil {
+		return m.GitCommit
+	}
+	return ""
+}
+
+func (m *KumaDpVersion) GetBuildDate() string {
+	if m != nil {
+		return m.BuildDate
+	}
+	return ""
+}
+
+// EnvoyVersion describes details of Envoy version.
+type EnvoyVersion struct {
+	// Version number of Envoy.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Git revision of Envoy build.
+	Build                string   `protobuf:"bytes,2,opt,name=build,proto3" json:"build,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnvoyVersion) Reset()         { *m = EnvoyVersion{} }
+func (m *EnvoyVersion) String() string { return proto.CompactTextString(m) }
+func (*EnvoyVersion) ProtoMessage()    {}
+func (*EnvoyVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35794f05b529b342, []int{4}
+}
+
+func (m *EnvoyVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnvoyVersion.Unmarshal(m, b)
+}
+func (m *EnvoyVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnvoyVersion.Marshal(b, m, deterministic)
+}
+func (m *EnvoyVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnvoyVersion.Merge(m, src)
+}
+func (m *EnvoyVersion) XXX_Size() int {
+	return xxx_messageInfo_EnvoyVersion.Size(m)
+}
+func (m *EnvoyVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnvoyVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnvoyVersion proto.InternalMessageInfo
+
+func (m *EnvoyVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *EnvoyVersion) GetBuild() string {
+	if m != nil {
+		return m.Build
+	}
+	return ""
+}
+
 // DiscoverySubscriptionStatus defines status of an ADS subscription.
 type DiscoverySubscriptionStatus struct {
 	// Time when status of a given ADS subscription was most recently updated.
@@ -240,7 +575,7 @@ func (m *DiscoverySubscriptionStatus) Reset()         { *m = DiscoverySubscripti
 func (m *DiscoverySubscriptionStatus) String() string { return proto.CompactTextString(m) }
 func (*DiscoverySubscriptionStatus) ProtoMessage()    {}
 func (*DiscoverySubscriptionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35794f05b529b342, []int{2}
+	return fileDescriptor_35794f05b529b342, []int{5}
 }
 
 func (m *DiscoverySubscriptionStatus) XXX_Unmarshal(b []byte) error {
@@ -320,7 +655,7 @@ func (m *DiscoveryServiceStats) Reset()         { *m = DiscoveryServiceStats{} }
 func (m *DiscoveryServiceStats) String() string { return proto.CompactTextString(m) }
 func (*DiscoveryServiceStats) ProtoMessage()    {}
 func (*DiscoveryServiceStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35794f05b529b342, []int{3}
+	return fileDescriptor_35794f05b529b342, []int{6}
 }
 
 func (m *DiscoveryServiceStats) XXX_Unmarshal(b []byte) error {
@@ -365,7 +700,13 @@ func (m *DiscoveryServiceStats) GetResponsesRejected() uint64 {
 func init() {
 	proto.RegisterType((*DataplaneInsight)(nil), "kuma.mesh.v1alpha1.DataplaneInsight")
 	proto.RegisterType((*DataplaneInsight_MTLS)(nil), "kuma.mesh.v1alpha1.DataplaneInsight.MTLS")
+	proto.RegisterType((*DataplaneInsight_Traffic)(nil), "kuma.mesh.v1alpha1.DataplaneInsight.Traffic")
+	proto.RegisterMapType((map[string]*DataplaneInsight_ClusterTraffic)(nil), "kuma.mesh.v1alpha1.DataplaneInsight.Traffic.ClustersEntry")
+	proto.RegisterType((*DataplaneInsight_ClusterTraffic)(nil), "kuma.mesh.v1alpha1.DataplaneInsight.ClusterTraffic")
 	proto.RegisterType((*DiscoverySubscription)(nil), "kuma.mesh.v1alpha1.DiscoverySubscription")
+	proto.RegisterType((*Version)(nil), "kuma.mesh.v1alpha1.Version")
+	proto.RegisterType((*KumaDpVersion)(nil), "kuma.mesh.v1alpha1.KumaDpVersion")
+	proto.RegisterType((*EnvoyVersion)(nil), "kuma.mesh.v1alpha1.EnvoyVersion")
 	proto.RegisterType((*DiscoverySubscriptionStatus)(nil), "kuma.mesh.v1alpha1.DiscoverySubscriptionStatus")
 	proto.RegisterType((*DiscoveryServiceStats)(nil), "kuma.mesh.v1alpha1.DiscoveryServiceStats")
 }
@@ -375,43 +716,66 @@ func init() {
 }
 
 var fileDescriptor_35794f05b529b342 = []byte{
-	// 600 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xdf, 0x4e, 0x13, 0x41,
-	0x14, 0xc6, 0xb3, 0xed, 0x82, 0x38, 0x08, 0xe2, 0x24, 0xc0, 0x52, 0x62, 0x24, 0x4d, 0x48, 0xf0,
-	0xc2, 0x6d, 0xd0, 0x78, 0x45, 0x8c, 0x71, 0xc1, 0x18, 0x12, 0x8c, 0x3a, 0xc5, 0x1b, 0x2e, 0xdc,
-	0x0c, 0x33, 0x87, 0x32, 0xb2, 0x9d, 0xd9, 0xcc, 0x4c, 0xab, 0xbe, 0x82, 0x4f, 0xe0, 0x03, 0xf0,
-	0x04, 0xbe, 0x8b, 0x2f, 0xc3, 0x8d, 0x66, 0x66, 0xff, 0x74, 0x55, 0xfe, 0xf5, 0xae, 0xed, 0x39,
-	0xbf, 0xef, 0x9c, 0xf3, 0xcd, 0x57, 0xb4, 0x39, 0x04, 0x73, 0xda, 0x1b, 0x6f, 0xd3, 0x2c, 0x3f,
-	0xa5, 0xdb, 0x3d, 0x4e, 0x2d, 0xcd, 0x33, 0x2a, 0x21, 0x15, 0xd2, 0x88, 0xc1, 0xa9, 0x8d, 0x73,
-	0xad, 0xac, 0xc2, 0xf8, 0x6c, 0x34, 0xa4, 0xb1, 0xeb, 0x8d, 0xab, 0xde, 0xce, 0xa3, 0x81, 0x52,
-	0x83, 0x0c, 0x7a, 0xbe, 0xe3, 0x78, 0x74, 0xd2, 0xb3, 0x62, 0x08, 0xc6, 0xd2, 0x61, 0x5e, 0x40,
-	0x9d, 0xd5, 0x31, 0xcd, 0x04, 0xa7, 0x16, 0x7a, 0xd5, 0x87, 0xa2, 0xd0, 0x3d, 0x6f, 0xa3, 0xa5,
-	0xbd, 0x6a, 0xd2, 0x7e, 0x31, 0x08, 0xbf, 0x43, 0x0b, 0x66, 0x74, 0x6c, 0x98, 0x16, 0xb9, 0x15,
-	0x4a, 0x9a, 0x28, 0xd8, 0x68, 0x6f, 0xcd, 0x3f, 0x7d, 0x1c, 0xff, 0x3f, 0x3a, 0xde, 0x13, 0x86,
-	0xa9, 0x31, 0xe8, 0x6f, 0xfd, 0x06, 0x41, 0xfe, 0xe6, 0xf1, 0x0b, 0x14, 0x0e, 0x0f, 0x0f, 0xfa,
-	0x51, 0x6b, 0x23, 0xb8, 0x52, 0xe7, 0x9f, 0x25, 0xe2, 0xb7, 0x87, 0x07, 0x7d, 0xe2, 0xb1, 0xce,
-	0xef, 0x00, 0x85, 0xee, 0x2b, 0x3e, 0x42, 0xeb, 0x0c, 0xb4, 0x15, 0x27, 0x82, 0x51, 0x0b, 0x29,
-	0x7c, 0xcd, 0x85, 0xa6, 0x6e, 0x44, 0xea, 0x0e, 0x8e, 0x02, 0x2f, 0xdf, 0x89, 0x0b, 0x37, 0xe2,
-	0xca, 0x8d, 0xf8, 0xb0, 0x72, 0x83, 0xac, 0x35, 0xf0, 0xd7, 0x35, 0xed, 0xea, 0xf8, 0x13, 0x7a,
-	0x98, 0x51, 0x63, 0xd3, 0xe6, 0x00, 0x0d, 0x03, 0x90, 0x50, 0x34, 0x95, 0xcb, 0x5f, 0xa7, 0xbe,
-	0xee, 0x04, 0x76, 0x27, 0x3c, 0x69, 0xe0, 0x78, 0x07, 0xad, 0x5d, 0x25, 0x6d, 0xa2, 0xf6, 0x46,
-	0xb0, 0xb5, 0x40, 0x22, 0x76, 0x39, 0x6b, 0xba, 0xbf, 0x5a, 0x68, 0xf9, 0x52, 0xa7, 0xf1, 0x2a,
-	0x6a, 0x09, 0xee, 0x2f, 0xbf, 0x9b, 0xdc, 0xb9, 0x48, 0x42, 0xdd, 0x5a, 0x0a, 0x48, 0x4b, 0x70,
-	0x9c, 0xa0, 0x35, 0xa6, 0xa4, 0xd5, 0x2a, 0x4b, 0xeb, 0x18, 0x59, 0x2a, 0x19, 0xa4, 0x82, 0xfb,
-	0x5b, 0x1a, 0xfd, 0x2b, 0x65, 0xe7, 0xfb, 0xf2, 0x01, 0x7c, 0xdf, 0x3e, 0xc7, 0x6f, 0xd0, 0x3d,
-	0xa6, 0xa4, 0x04, 0x66, 0x0b, 0x83, 0xdb, 0x37, 0x59, 0x90, 0xcc, 0x5d, 0x24, 0x33, 0x3f, 0x83,
-	0xd6, 0x5c, 0x40, 0xe6, 0x4b, 0xd2, 0x9b, 0xbb, 0x8b, 0xee, 0x73, 0xb7, 0x7e, 0x43, 0x2b, 0xbc,
-	0xd1, 0xce, 0xc5, 0x09, 0xe2, 0x45, 0x3e, 0xa0, 0x59, 0x63, 0xa9, 0x1d, 0x99, 0x68, 0xc6, 0xb3,
-	0xbd, 0x5b, 0xe7, 0xb1, 0xef, 0x31, 0xbf, 0xdc, 0xf7, 0xc0, 0x1d, 0x5c, 0x0a, 0x75, 0x7f, 0xb4,
-	0xd1, 0xfa, 0x35, 0x04, 0xde, 0x43, 0x4b, 0x3e, 0x14, 0xa3, 0xdc, 0xfd, 0x67, 0x6e, 0x9b, 0xb2,
-	0x45, 0xc7, 0x7c, 0xf4, 0x88, 0x5f, 0xfc, 0x25, 0x9a, 0xb1, 0xca, 0xd2, 0xec, 0xda, 0xfc, 0xd7,
-	0x5b, 0x80, 0x1e, 0x0b, 0x06, 0x6e, 0x01, 0x43, 0x0a, 0x0e, 0xef, 0xa0, 0x36, 0xe3, 0xa6, 0xb4,
-	0x7f, 0x0a, 0xdc, 0x51, 0x0e, 0x06, 0x6e, 0x4a, 0xbf, 0xa7, 0x81, 0xa1, 0x80, 0x33, 0x5e, 0x19,
-	0x3e, 0x0d, 0x9c, 0x15, 0xb0, 0xe6, 0x26, 0x9a, 0x9d, 0x1a, 0xd6, 0xdc, 0x74, 0xcf, 0x83, 0x66,
-	0xe4, 0x1b, 0x65, 0xbc, 0x89, 0x16, 0x35, 0x98, 0x5c, 0x49, 0x03, 0x26, 0x35, 0x20, 0xad, 0x7f,
-	0x92, 0x90, 0x2c, 0xd4, 0xbf, 0xf6, 0x41, 0x5a, 0xfc, 0x1c, 0xad, 0x4c, 0xda, 0x28, 0x3b, 0x93,
-	0xea, 0x4b, 0x06, 0x7c, 0x00, 0x45, 0xfa, 0x43, 0xb2, 0x5c, 0x57, 0x5f, 0x35, 0x8a, 0xf8, 0x09,
-	0xc2, 0x13, 0x4c, 0xc3, 0x67, 0x60, 0x16, 0xb8, 0xb7, 0x3e, 0x24, 0x0f, 0xea, 0x0a, 0x29, 0x0b,
-	0x09, 0x3a, 0x9a, 0xab, 0xae, 0x39, 0x9e, 0xf5, 0x59, 0x78, 0xf6, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0xe1, 0x68, 0x8d, 0x9f, 0xca, 0x05, 0x00, 0x00,
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xc7, 0x35, 0xfe, 0x76, 0x25, 0x0e, 0xa1, 0x45, 0x92, 0x89, 0xa3, 0x65, 0x83, 0xd1, 0x4a,
+	0x20, 0xc1, 0x58, 0xd9, 0xd5, 0x22, 0x48, 0x04, 0x08, 0xc7, 0x01, 0x45, 0x2c, 0x02, 0xda, 0x66,
+	0x0f, 0x7b, 0x60, 0xd4, 0x99, 0xe9, 0x4c, 0x9a, 0xcc, 0x17, 0xdd, 0x3d, 0x06, 0x1f, 0x90, 0x38,
+	0x23, 0x1e, 0x80, 0x03, 0x47, 0x8e, 0x9c, 0x78, 0x05, 0xde, 0x6a, 0x2f, 0xa0, 0xfe, 0x18, 0xdb,
+	0xd9, 0xf5, 0x26, 0xf6, 0xcd, 0x5d, 0xf5, 0xff, 0xfd, 0xbb, 0xaa, 0xbb, 0xa6, 0x0d, 0x0f, 0x12,
+	0x2a, 0xae, 0xfa, 0x93, 0x23, 0x12, 0xe7, 0x57, 0xe4, 0xa8, 0x1f, 0x12, 0x49, 0xf2, 0x98, 0xa4,
+	0xd4, 0x67, 0xa9, 0x60, 0xd1, 0x95, 0xf4, 0x72, 0x9e, 0xc9, 0x0c, 0xa1, 0xeb, 0x22, 0x21, 0x9e,
+	0xd2, 0x7a, 0xa5, 0xb6, 0xfb, 0x66, 0x94, 0x65, 0x51, 0x4c, 0xfb, 0x5a, 0x71, 0x51, 0x5c, 0xf6,
+	0xc3, 0x82, 0x13, 0xc9, 0xb2, 0xd4, 0x30, 0xdd, 0xfb, 0x2f, 0xe6, 0x25, 0x4b, 0xa8, 0x90, 0x24,
+	0xc9, 0xad, 0x60, 0x6f, 0x42, 0x62, 0x16, 0x12, 0x49, 0xfb, 0xe5, 0x0f, 0x93, 0xe8, 0xfd, 0xd9,
+	0x82, 0xed, 0x61, 0x59, 0xc9, 0xb9, 0x29, 0x04, 0x7d, 0x0d, 0x1d, 0x51, 0x5c, 0x88, 0x80, 0xb3,
+	0x5c, 0x6d, 0x22, 0x5c, 0xe7, 0xb0, 0xfa, 0xce, 0xc6, 0xc3, 0x77, 0xbd, 0x97, 0x4b, 0xf3, 0x86,
+	0x4c, 0x04, 0xd9, 0x84, 0xf2, 0xe9, 0x68, 0x81, 0xc0, 0x37, 0x79, 0xf4, 0x31, 0xd4, 0x92, 0xf1,
+	0x93, 0x91, 0x5b, 0x39, 0x74, 0x5e, 0xe9, 0xf3, 0x42, 0x11, 0xde, 0x57, 0xe3, 0x27, 0x23, 0xac,
+	0x31, 0xf4, 0x11, 0x40, 0xc8, 0x09, 0x4b, 0x7d, 0xd5, 0x96, 0x5b, 0xd5, 0x26, 0x5d, 0xcf, 0xf4,
+	0xec, 0x95, 0x3d, 0x7b, 0xe3, 0xb2, 0x67, 0xdc, 0xd6, 0x6a, 0xb5, 0x46, 0x9f, 0x43, 0x53, 0x72,
+	0x72, 0x79, 0xc9, 0x02, 0xb7, 0xa6, 0xb9, 0xf7, 0x56, 0xda, 0x7c, 0x6c, 0x18, 0x5c, 0xc2, 0xdd,
+	0xff, 0x1c, 0xa8, 0xa9, 0x8a, 0xd0, 0x33, 0x38, 0x08, 0x28, 0x97, 0xec, 0x92, 0x05, 0x44, 0x52,
+	0x9f, 0xfe, 0x9c, 0x33, 0x73, 0x15, 0xa6, 0x38, 0xe7, 0xce, 0xe2, 0xf6, 0x17, 0xf0, 0xb3, 0x19,
+	0xad, 0x8b, 0xfd, 0x1e, 0xee, 0xc5, 0x44, 0x48, 0x7f, 0x71, 0x03, 0x4e, 0x23, 0x9a, 0x52, 0x23,
+	0x72, 0x2b, 0x77, 0xba, 0x1f, 0x28, 0x83, 0xd3, 0x39, 0x8f, 0x17, 0x70, 0x74, 0x02, 0xfb, 0xaf,
+	0xb2, 0x16, 0xfa, 0x58, 0x3b, 0xd8, 0x0d, 0x96, 0xb3, 0xa2, 0xfb, 0x6f, 0x05, 0x9a, 0xf6, 0x58,
+	0xd0, 0x09, 0x6c, 0x70, 0x9a, 0x67, 0x5c, 0xae, 0xda, 0x34, 0x18, 0xb9, 0xee, 0xf2, 0x08, 0x1a,
+	0x39, 0xe5, 0x2c, 0x0b, 0x6d, 0x3b, 0xfb, 0x2f, 0x71, 0x43, 0x3b, 0xdd, 0xd8, 0x0a, 0xd1, 0x53,
+	0x68, 0x05, 0x71, 0x21, 0x24, 0xe5, 0xaa, 0x4e, 0x35, 0x8b, 0xc7, 0xeb, 0x5c, 0xa3, 0x77, 0x6a,
+	0xe1, 0xb3, 0x54, 0xf2, 0x29, 0x9e, 0x79, 0x75, 0x73, 0xe8, 0xdc, 0x48, 0xa1, 0x6d, 0xa8, 0x5e,
+	0xd3, 0xa9, 0x6e, 0xa8, 0x8d, 0xd5, 0x4f, 0x74, 0x0e, 0xf5, 0x09, 0x89, 0x0b, 0x6a, 0x8b, 0x7d,
+	0xb4, 0xd2, 0xbe, 0xd6, 0xb4, 0x9c, 0x22, 0xe3, 0x70, 0x5c, 0xf9, 0xd0, 0xe9, 0xfe, 0xed, 0xc0,
+	0xd6, 0xcd, 0x2c, 0xea, 0x42, 0x8b, 0xd3, 0x1f, 0x0b, 0x2a, 0xa4, 0xd0, 0x1b, 0xd7, 0xf0, 0x6c,
+	0x8d, 0x76, 0xa1, 0x41, 0x39, 0xcf, 0xb8, 0xd0, 0xdb, 0xd7, 0xb0, 0x5d, 0xa1, 0x43, 0xd8, 0x08,
+	0xb2, 0x34, 0xa5, 0xc1, 0xfc, 0xee, 0x6a, 0x78, 0x31, 0x84, 0xee, 0xc3, 0x46, 0x4c, 0x24, 0x4d,
+	0x83, 0xa9, 0x2f, 0x8a, 0x44, 0x0f, 0xbf, 0x83, 0xc1, 0x86, 0x46, 0x45, 0x82, 0xde, 0x86, 0x4e,
+	0x29, 0x08, 0xb2, 0x22, 0x95, 0x6e, 0x5d, 0x9b, 0x6c, 0xda, 0xe0, 0xa9, 0x8a, 0xf5, 0x7e, 0xaf,
+	0xc2, 0xce, 0xd2, 0x2f, 0x1c, 0xed, 0x41, 0x85, 0x85, 0xe6, 0xa0, 0x06, 0xcd, 0xe7, 0x83, 0x1a,
+	0xaf, 0x6c, 0x3b, 0xb8, 0xc2, 0x42, 0x34, 0x80, 0xfd, 0x20, 0x4b, 0x25, 0xcf, 0x62, 0x7f, 0xf6,
+	0xbc, 0x49, 0x92, 0x06, 0xd4, 0x67, 0xe6, 0xc6, 0x17, 0xf4, 0xbb, 0x56, 0xf9, 0x8d, 0x3d, 0x3c,
+	0xad, 0x3b, 0x0f, 0xd1, 0x17, 0xb0, 0x69, 0x7b, 0x59, 0xf1, 0x93, 0x1f, 0xb4, 0x9e, 0x0f, 0xea,
+	0xff, 0x38, 0x95, 0x96, 0x33, 0x3b, 0x05, 0x3d, 0x6b, 0xa7, 0xf0, 0x5a, 0xc8, 0x84, 0x8d, 0x18,
+	0xaf, 0xda, 0x9d, 0xc3, 0xba, 0x35, 0x47, 0xb4, 0xc9, 0xb7, 0xd0, 0x10, 0x92, 0xc8, 0x42, 0xe8,
+	0x23, 0xda, 0x78, 0xd8, 0x5f, 0xf9, 0x1d, 0x1c, 0x69, 0x4c, 0x17, 0xf7, 0x9b, 0xa3, 0x1a, 0xb6,
+	0x46, 0xe8, 0x31, 0x34, 0x27, 0x94, 0x0b, 0xf5, 0x4d, 0x37, 0xb4, 0xe7, 0xc1, 0x32, 0xcf, 0xa7,
+	0x46, 0x82, 0x4b, 0x6d, 0xef, 0x17, 0x68, 0xda, 0x18, 0x3a, 0x86, 0xa6, 0x22, 0xfc, 0x30, 0xb7,
+	0x9f, 0xdf, 0x5b, 0xcb, 0x1c, 0xbe, 0x2c, 0x12, 0x32, 0xcc, 0x4b, 0x9f, 0xc6, 0xb5, 0x5e, 0xa2,
+	0x0f, 0xa0, 0x4e, 0xd3, 0x49, 0x36, 0xb5, 0x33, 0x7d, 0xb8, 0x8c, 0x3c, 0x53, 0x82, 0x12, 0x34,
+	0xf2, 0xde, 0xaf, 0x0e, 0x74, 0x6e, 0x38, 0x22, 0x77, 0xde, 0x87, 0xf9, 0x66, 0xca, 0x25, 0xda,
+	0x83, 0x66, 0xc4, 0xa4, 0x2f, 0x49, 0x64, 0x2e, 0x1d, 0x37, 0x22, 0x26, 0xc7, 0x24, 0x42, 0xf7,
+	0x00, 0x54, 0x22, 0xc8, 0x92, 0x84, 0x49, 0x7d, 0xb3, 0x6d, 0xdc, 0x8e, 0x98, 0x3c, 0xd5, 0x01,
+	0x95, 0xbe, 0x28, 0x58, 0x1c, 0xfa, 0xea, 0x4f, 0x4a, 0x5f, 0x56, 0x1b, 0xb7, 0x75, 0x64, 0x48,
+	0x24, 0xed, 0x7d, 0x02, 0x9b, 0x8b, 0x95, 0xdd, 0x52, 0xc0, 0x1b, 0x50, 0xd7, 0x98, 0xdd, 0xde,
+	0x2c, 0x7a, 0x7f, 0x54, 0xe1, 0xe0, 0x96, 0xab, 0x42, 0x43, 0xd8, 0xd6, 0x4f, 0x70, 0x91, 0xab,
+	0xfd, 0x57, 0x7d, 0xde, 0xb6, 0x14, 0xf3, 0x9d, 0x46, 0xf4, 0xc4, 0x7c, 0x0a, 0x75, 0x99, 0x49,
+	0x12, 0xdf, 0xfa, 0x87, 0x37, 0xab, 0x82, 0xf2, 0x09, 0x0b, 0xa8, 0x2a, 0x40, 0x60, 0xc3, 0xa1,
+	0x13, 0xa8, 0x06, 0xa1, 0x70, 0xab, 0xeb, 0xe2, 0x8a, 0x52, 0x30, 0x0d, 0x85, 0x5b, 0x5b, 0x1b,
+	0xa6, 0x06, 0x8e, 0xc3, 0x72, 0xd2, 0xd7, 0x81, 0x63, 0x03, 0xf3, 0x50, 0xb8, 0x8d, 0xb5, 0x61,
+	0x1e, 0x8a, 0xde, 0x5f, 0x0e, 0xec, 0x2c, 0x4d, 0xa3, 0x07, 0xb0, 0xc5, 0xa9, 0xc8, 0xb3, 0x54,
+	0x50, 0xe1, 0x0b, 0x9a, 0x4a, 0xfb, 0x4e, 0x76, 0x66, 0xd1, 0x11, 0x4d, 0x25, 0x7a, 0x0c, 0xbb,
+	0x73, 0x19, 0x09, 0xae, 0xd3, 0xec, 0xa7, 0x98, 0x86, 0x11, 0x0d, 0xed, 0xe3, 0xb9, 0x33, 0xcb,
+	0x7e, 0xb6, 0x90, 0x44, 0xef, 0x03, 0x9a, 0x63, 0x9c, 0xfe, 0x40, 0x03, 0x49, 0x43, 0xfb, 0xa4,
+	0xbe, 0x3e, 0xcb, 0x60, 0x9b, 0x18, 0xc0, 0xb3, 0x56, 0xd9, 0xcd, 0x45, 0x43, 0xcf, 0xc2, 0xa3,
+	0xff, 0x07, 0x00, 0x4e, 0xf2, 0x8b, 0xa5, 0xdb, 0x09, 0x00, 0x00,
 }
@@ -62,9 +62,38 @@ func (ds *DataplaneInsight) UpdateSubscription(s *DiscoverySubscription) {
 		ds.Subscriptions[i] = s
 	} else {
 		ds.Subscriptions = append(ds.Subscriptions, s)
+		// a new subscription means that the Dataplane was restarted, so it is no longer draining
+		ds.DrainTime = nil
 	}
 }
 
+func (ds *DataplaneInsight) Drain(drainTime time.Time) error {
+	ts, err := ptypes.TimestampProto(drainTime)
+	if err != nil {
+		return err
+	}
+	ds.DrainTime = ts
+	return nil
+}
+
+func (ds *DataplaneInsight) IsDraining() bool {
+	return ds.GetDrainTime() != nil
+}
+
+// ReportTraffic replaces the traffic of the Dataplane with the traffic observed during the latest report period.
+func (ds *DataplaneInsight) ReportTraffic(reportTime time.Time, period time.Duration, clusters map[string]*DataplaneInsight_ClusterTraffic) error {
+	ts, err := ptypes.TimestampProto(reportTime)
+	if err != nil {
+		return err
+	}
+	ds.Traffic = &DataplaneInsight_Traffic{
+		ReportTime: ts,
+		Period:     ptypes.DurationProto(period),
+		Clusters:   clusters,
+	}
+	return nil
+}
+
 func (ds *DataplaneInsight) GetLatestSubscription() (*DiscoverySubscription, *time.Time) {
 	if len(ds.GetSubscriptions()) == 0 {
 		return nil, nil
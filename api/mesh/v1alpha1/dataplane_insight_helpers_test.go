@@ -101,6 +101,82 @@ var _ = Describe("DataplaneHelpers", func() {
 			})
 		})
 
+		Describe("Drain()", func() {
+
+			It("should mark the Dataplane as draining", func() {
+				// when
+				Expect(status.Drain(t1)).To(Succeed())
+
+				// then
+				Expect(status.IsDraining()).To(BeTrue())
+				Expect(util_proto.ToYAML(status)).To(MatchYAML(`
+                drainTime: "2017-07-17T17:07:47Z"
+`))
+			})
+
+			It("should keep draining when an existing subscription is updated", func() {
+				// setup
+				status.Subscriptions = []*DiscoverySubscription{
+					{
+						Id:                     "1",
+						ControlPlaneInstanceId: "node-001",
+						Status:                 NewSubscriptionStatus(),
+					},
+				}
+				Expect(status.Drain(t1)).To(Succeed())
+
+				// when
+				status.UpdateSubscription(&DiscoverySubscription{
+					Id:                     "1",
+					ControlPlaneInstanceId: "node-001",
+					Status:                 NewSubscriptionStatus(),
+				})
+
+				// then
+				Expect(status.IsDraining()).To(BeTrue())
+			})
+
+			It("should stop draining when the Dataplane creates a new subscription", func() {
+				// setup
+				Expect(status.Drain(t1)).To(Succeed())
+
+				// when
+				status.UpdateSubscription(&DiscoverySubscription{
+					Id:                     "2",
+					ControlPlaneInstanceId: "node-001",
+					Status:                 NewSubscriptionStatus(),
+				})
+
+				// then
+				Expect(status.IsDraining()).To(BeFalse())
+			})
+		})
+
+		Describe("ReportTraffic()", func() {
+
+			It("should replace the previously reported traffic", func() {
+				// setup
+				Expect(status.ReportTraffic(t1, 30*time.Second, map[string]*DataplaneInsight_ClusterTraffic{
+					"backend": {Requests: 10},
+				})).To(Succeed())
+
+				// when
+				Expect(status.ReportTraffic(t2, 10*time.Second, map[string]*DataplaneInsight_ClusterTraffic{
+					"redis": {Connections: 2},
+				})).To(Succeed())
+
+				// then
+				Expect(util_proto.ToYAML(status)).To(MatchYAML(`
+                traffic:
+                  reportTime: "2018-08-18T18:08:48Z"
+                  period: 10s
+                  clusters:
+                    redis:
+                      connections: "2"
+`))
+			})
+		})
+
 		Describe("GetLatestSubscription()", func() {
 
 			It("should return `nil` when there are no subscriptions", func() {
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/pkg/config"
+	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	"github.com/kumahq/kuma/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/embedded"
+)
+
+var compactLog = controlPlaneLog.WithName("compact")
+
+func newCompactCmd() *cobra.Command {
+	args := struct {
+		configPath string
+	}{}
+	cmd := &cobra.Command{
+		Use:   "compact",
+		Short: "Compact the file of Embedded store",
+		Long:  `Compact the file of Embedded store, so the space left by deleted resources is given back to the file system. The Control Plane that uses the file has to be stopped.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg := kuma_cp.DefaultConfig()
+			err := config.Load(args.configPath, &cfg)
+			if err != nil {
+				compactLog.Error(err, "could not load the configuration")
+				return err
+			}
+			if cfg.Store.Type != store.EmbeddedStore {
+				return errors.Errorf("compaction is supported only for %s store, the store type is %s", store.EmbeddedStore, cfg.Store.Type)
+			}
+
+			before, after, err := embedded.Compact(*cfg.Store.Embedded)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Compacted %s from %d to %d bytes\n", cfg.Store.Embedded.Path, before, after)
+			return nil
+		},
+	}
+	cmd.PersistentFlags().StringVarP(&args.configPath, "config-file", "c", "", "configuration file")
+	return cmd
+}
@@ -44,6 +44,7 @@ func newRootCmd() *cobra.Command {
 	// sub-commands
 	cmd.AddCommand(newRunCmd())
 	cmd.AddCommand(newMigrateCmd())
+	cmd.AddCommand(newCompactCmd())
 	cmd.AddCommand(version.NewVersionCmd())
 	return cmd
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/kumahq/kuma/pkg/diagnostics"
 	dns "github.com/kumahq/kuma/pkg/dns/components"
 	"github.com/kumahq/kuma/pkg/gc"
+	"github.com/kumahq/kuma/pkg/insights"
 	kds_global "github.com/kumahq/kuma/pkg/kds/global"
 	kds_remote "github.com/kumahq/kuma/pkg/kds/remote"
 	mads_server "github.com/kumahq/kuma/pkg/mads/server"
@@ -141,6 +142,11 @@ func newRunCmdWithOpts(opts runCmdOpts) *cobra.Command {
 				return err
 			}
 
+			if err := insights.Setup(rt); err != nil {
+				runLog.Error(err, "unable to set up Insights")
+				return err
+			}
+
 			if err := metrics.Setup(rt); err != nil {
 				runLog.Error(err, "unable to set up Metrics")
 				return err
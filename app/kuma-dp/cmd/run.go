@@ -15,7 +15,10 @@ import (
 
 	kumadp_config "github.com/kumahq/kuma/app/kuma-dp/pkg/config"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/accesslogs"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/dependencies"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/lifecycle"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/stats"
 	"github.com/kumahq/kuma/pkg/catalog"
 	"github.com/kumahq/kuma/pkg/catalog/client"
 	"github.com/kumahq/kuma/pkg/config"
@@ -132,14 +135,43 @@ func newRunCmd() *cobra.Command {
 				return err
 			}
 			server := accesslogs.NewAccessLogServer()
+			drainer := lifecycle.NewDrainer(cfg, catalog.Apis.Bootstrap.Url)
 
 			componentMgr := component.NewManager(leader_memory.NewNeverLeaderElector())
 			if err := componentMgr.Add(server, dataplane); err != nil {
 				return err
 			}
+			if cfg.Dataplane.LifecyclePort != 0 {
+				health := lifecycle.NewHealthChecker(cfg, dataplane, drainer)
+				if err := componentMgr.Add(lifecycle.NewServer(cfg.Dataplane.LifecyclePort, drainer, health)); err != nil {
+					return err
+				}
+			}
+			if cfg.Dataplane.DependenciesReportInterval > 0 {
+				if cfg.Dataplane.AdminPort.Empty() {
+					runLog.Info("Envoy Admin API is not exposed, destinations of the Dataplane won't be reported")
+				} else if err := componentMgr.Add(dependencies.NewReporter(cfg, catalog.Apis.Bootstrap.Url)); err != nil {
+					return err
+				}
+			}
+			if cfg.Dataplane.AdminPort.Empty() {
+				runLog.Info("Envoy Admin API is not exposed, traffic of the Dataplane won't be reported")
+			} else if err := componentMgr.Add(stats.NewReporter(cfg, catalog.Apis.Bootstrap.Url)); err != nil {
+				return err
+			}
+
+			// components are stopped only after the Dataplane is drained.
+			// On Kubernetes, the drain is usually triggered earlier by the pre-stop hook, in which case it is not repeated.
+			signal := core.SetupSignalHandler()
+			stop := make(chan struct{})
+			go func() {
+				<-signal
+				drainer.Drain()
+				close(stop)
+			}()
 
 			runLog.Info("starting Kuma DP", "version", kuma_version.Build.Version)
-			if err := componentMgr.Start(core.SetupSignalHandler()); err != nil {
+			if err := componentMgr.Start(stop); err != nil {
 				runLog.Error(err, "error while running Kuma DP")
 				return err
 			}
@@ -0,0 +1,144 @@
+package dependencies
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
+)
+
+var log = core.Log.WithName("kuma-dp").WithName("dependencies")
+
+var _ component.Component = &Reporter{}
+
+// Reporter periodically reports destinations that the Dataplane reached through the passthrough cluster to the Control Plane.
+// The Control Plane records them in Dependencies that select the Dataplane and have learning enabled,
+// so the Dataplane gets outbounds for them.
+// Hosts of the passthrough cluster are sampled, therefore a destination is learned eventually, not on the first connection.
+// A destination that still goes through the passthrough cluster is reported again after the refresh interval
+// returned by the Control Plane, so it is learned again if the Control Plane forgot it in the meantime.
+type Reporter struct {
+	cfg          kuma_dp.Config
+	bootstrapUrl string
+	client       *http.Client
+	envoyAdmin   *envoy.AdminClient
+	// reported are destinations accepted by the Control Plane together with the time they were accepted
+	reported        map[string]time.Time
+	refreshInterval time.Duration
+}
+
+func NewReporter(cfg kuma_dp.Config, bootstrapUrl string) *Reporter {
+	return &Reporter{
+		cfg:          cfg,
+		bootstrapUrl: bootstrapUrl,
+		client: &http.Client{
+			Timeout:   5 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+		},
+		envoyAdmin: envoy.NewAdminClient(cfg.Dataplane.AdminPort.Lowest()),
+		reported:   map[string]time.Time{},
+	}
+}
+
+func (r *Reporter) NeedLeaderElection() bool {
+	return false
+}
+
+func (r *Reporter) Start(stop <-chan struct{}) error {
+	log.Info("starting", "interval", r.cfg.Dataplane.DependenciesReportInterval)
+	ticker := time.NewTicker(r.cfg.Dataplane.DependenciesReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.Report(); err != nil {
+				// destinations that were not accepted are reported again with the next tick
+				log.Error(err, "could not report destinations")
+			}
+		case <-stop:
+			log.Info("stopping")
+			return nil
+		}
+	}
+}
+
+// Report sends destinations reached through the passthrough cluster that were not reported yet
+// or were reported longer than the refresh interval ago.
+func (r *Reporter) Report() error {
+	addresses, err := r.envoyAdmin.PassThroughAddresses()
+	if err != nil {
+		return errors.Wrap(err, "could not get destinations of the passthrough cluster")
+	}
+	now := core.Now()
+	for destination, reportedAt := range r.reported {
+		if r.refreshInterval > 0 && now.Sub(reportedAt) >= r.refreshInterval {
+			delete(r.reported, destination)
+		}
+	}
+	var destinations []string
+	for _, address := range addresses {
+		if _, ok := r.reported[address]; !ok {
+			destinations = append(destinations, address)
+		}
+	}
+	if len(destinations) == 0 {
+		return nil
+	}
+	response, err := r.send(destinations)
+	if err != nil {
+		return err
+	}
+	r.refreshInterval = response.RefreshInterval
+	for _, destination := range destinations {
+		r.reported[destination] = now
+	}
+	log.Info("reported destinations", "destinations", destinations)
+	return nil
+}
+
+func (r *Reporter) send(destinations []string) (*types.DependenciesResponse, error) {
+	request := types.DependenciesRequest{
+		Mesh:         r.cfg.Dataplane.Mesh,
+		Name:         r.cfg.Dataplane.Name,
+		Destinations: destinations,
+	}
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal request to json")
+	}
+	req, err := http.NewRequest(http.MethodPost, r.bootstrapUrl+"/dependencies", bytes.NewReader(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.cfg.DataplaneRuntime.TokenPath != "" {
+		token, err := ioutil.ReadFile(r.cfg.DataplaneRuntime.TokenPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read the dataplane token")
+		}
+		req.Header.Set("Authorization", string(token))
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "request to bootstrap server failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	response := &types.DependenciesResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, errors.Wrap(err, "could not parse the response")
+	}
+	return response, nil
+}
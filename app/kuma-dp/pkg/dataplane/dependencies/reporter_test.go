@@ -0,0 +1,165 @@
+package dependencies_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/dependencies"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	config_types "github.com/kumahq/kuma/pkg/config/types"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
+)
+
+var _ = Describe("Reporter", func() {
+
+	var tmpDir string
+	var cpServer *httptest.Server
+	var envoyServer *httptest.Server
+	var reporter *dependencies.Reporter
+
+	var requests []types.DependenciesRequest
+	var authorization string
+	var status int
+	var passThroughHosts []string
+	var now time.Time
+
+	BeforeEach(func() {
+		requests = nil
+		authorization = ""
+		status = http.StatusOK
+		passThroughHosts = nil
+		now = time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+		core.Now = func() time.Time {
+			return now
+		}
+
+		cpMux := http.NewServeMux()
+		cpMux.HandleFunc("/dependencies", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			request := types.DependenciesRequest{}
+			Expect(json.NewDecoder(req.Body).Decode(&request)).To(Succeed())
+			requests = append(requests, request)
+			authorization = req.Header.Get("Authorization")
+			writer.WriteHeader(status)
+			if status == http.StatusOK {
+				Expect(json.NewEncoder(writer).Encode(types.DependenciesResponse{RefreshInterval: time.Hour})).To(Succeed())
+			}
+		})
+		cpServer = httptest.NewServer(cpMux)
+
+		envoyMux := http.NewServeMux()
+		envoyMux.HandleFunc("/clusters", func(writer http.ResponseWriter, req *http.Request) {
+			var hosts []string
+			for _, host := range passThroughHosts {
+				parts := strings.Split(host, ":")
+				hosts = append(hosts, `{"address": {"socket_address": {"address": "`+parts[0]+`", "port_value": `+parts[1]+`}}}`)
+			}
+			_, _ = writer.Write([]byte(`{"cluster_statuses": [{"name": "outbound:passthrough", "host_statuses": [` + strings.Join(hosts, ",") + `]}]}`))
+		})
+		envoyServer = httptest.NewServer(envoyMux)
+		port, err := strconv.Atoi(strings.Split(envoyServer.Listener.Addr().String(), ":")[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		tmpDir, err = ioutil.TempDir("", "")
+		Expect(err).ToNot(HaveOccurred())
+		tokenPath := filepath.Join(tmpDir, "token")
+		Expect(ioutil.WriteFile(tokenPath, []byte("sample-token"), 0600)).To(Succeed())
+
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.Name = "backend-01"
+		cfg.Dataplane.AdminPort = config_types.MustExactPort(uint32(port))
+		cfg.DataplaneRuntime.TokenPath = tokenPath
+		reporter = dependencies.NewReporter(cfg, cpServer.URL)
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+		cpServer.Close()
+		envoyServer.Close()
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	It("should report only destinations that were not reported yet", func() {
+		// given
+		passThroughHosts = []string{"10.0.0.2:80", "10.0.0.1:443"}
+
+		// when
+		Expect(reporter.Report()).To(Succeed())
+
+		// then
+		Expect(requests).To(Equal([]types.DependenciesRequest{{
+			Mesh:         "default",
+			Name:         "backend-01",
+			Destinations: []string{"10.0.0.1:443", "10.0.0.2:80"},
+		}}))
+		Expect(authorization).To(Equal("sample-token"))
+
+		// when
+		passThroughHosts = []string{"10.0.0.2:80", "10.0.0.3:8080"}
+		Expect(reporter.Report()).To(Succeed())
+
+		// then
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[1].Destinations).To(Equal([]string{"10.0.0.3:8080"}))
+	})
+
+	It("should not send a request when there are no new destinations", func() {
+		// when
+		Expect(reporter.Report()).To(Succeed())
+
+		// then
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should report destinations again when the Control Plane did not accept them", func() {
+		// given
+		passThroughHosts = []string{"10.0.0.1:443"}
+		status = http.StatusInternalServerError
+
+		// when
+		err := reporter.Report()
+
+		// then
+		Expect(err).To(MatchError("unexpected status code: 500"))
+
+		// when
+		status = http.StatusOK
+		Expect(reporter.Report()).To(Succeed())
+
+		// then
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[1].Destinations).To(Equal([]string{"10.0.0.1:443"}))
+	})
+
+	It("should report destinations again after the refresh interval", func() {
+		// given
+		passThroughHosts = []string{"10.0.0.1:443"}
+		Expect(reporter.Report()).To(Succeed())
+
+		// when
+		now = now.Add(59 * time.Minute)
+		Expect(reporter.Report()).To(Succeed())
+
+		// then
+		Expect(requests).To(HaveLen(1))
+
+		// when the Control Plane forgot the destination and it still goes through the passthrough cluster
+		now = now.Add(time.Minute)
+		Expect(reporter.Report()).To(Succeed())
+
+		// then
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[1].Destinations).To(Equal([]string{"10.0.0.1:443"}))
+	})
+})
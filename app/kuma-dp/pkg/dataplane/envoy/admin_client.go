@@ -0,0 +1,230 @@
+package envoy
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	envoy_admin "github.com/envoyproxy/go-control-plane/envoy/admin/v2alpha"
+	"github.com/pkg/errors"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// AdminClient is a client of the Envoy Admin API that listens on 127.0.0.1.
+type AdminClient struct {
+	address string
+	client  *http.Client
+}
+
+func NewAdminClient(port uint32) *AdminClient {
+	return &AdminClient{
+		address: fmt.Sprintf("http://127.0.0.1:%d", port),
+		client: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+	}
+}
+
+// DrainListeners makes inbound listeners stop accepting new connections and gracefully close the existing ones.
+// Outbound listeners are not drained, so the application can still reach other services while it shuts down.
+func (a *AdminClient) DrainListeners() error {
+	resp, err := a.client.Post(a.address+"/drain_listeners?inboundonly&graceful", "text/plain", nil)
+	if err != nil {
+		return errors.Wrap(err, "request to Envoy Admin API failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// ActiveConnections returns the number of active downstream connections of inbound listeners, which are drained by DrainListeners.
+func (a *AdminClient) ActiveConnections() (uint64, error) {
+	prefixes, err := a.inboundListenerStatPrefixes()
+	if err != nil {
+		return 0, err
+	}
+	stats, err := a.stats(`^listener\..*\.downstream_cx_active$`)
+	if err != nil {
+		return 0, err
+	}
+	var total uint64
+	for name, value := range stats {
+		if strings.Contains(name, ".worker_") {
+			continue
+		}
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(name, prefix) {
+				total += value
+			}
+		}
+	}
+	return total, nil
+}
+
+// inboundListenerStatPrefixes returns prefixes of the stats of inbound listeners.
+// Kuma listeners don't set a stat prefix, so Envoy names their stats after the address, e.g. "listener.192.168.0.1_8080.".
+func (a *AdminClient) inboundListenerStatPrefixes() ([]string, error) {
+	resp, err := a.client.Get(a.address + "/listeners?format=json")
+	if err != nil {
+		return nil, errors.Wrap(err, "request to Envoy Admin API failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the response")
+	}
+	listeners := &envoy_admin.Listeners{}
+	if err := util_proto.FromJSON(body, listeners); err != nil {
+		return nil, errors.Wrap(err, "could not parse the response")
+	}
+	var prefixes []string
+	for _, listener := range listeners.GetListenerStatuses() {
+		// see names.GetInboundListenerName()
+		if !strings.HasPrefix(listener.GetName(), "inbound:") {
+			continue
+		}
+		socketAddress := listener.GetLocalAddress().GetSocketAddress()
+		if socketAddress == nil {
+			continue
+		}
+		address := net.JoinHostPort(socketAddress.GetAddress(), strconv.Itoa(int(socketAddress.GetPortValue())))
+		prefixes = append(prefixes, "listener."+strings.ReplaceAll(address, ":", "_")+".")
+	}
+	return prefixes, nil
+}
+
+// ConfigReceived returns true when Envoy has received and ACKed both LDS and CDS from the Control Plane.
+func (a *AdminClient) ConfigReceived() (bool, error) {
+	stats, err := a.stats(`^(listener_manager\.lds|cluster_manager\.cds)\.update_success$`)
+	if err != nil {
+		return false, err
+	}
+	return stats["listener_manager.lds.update_success"] > 0 && stats["cluster_manager.cds.update_success"] > 0, nil
+}
+
+// Ping checks that Envoy responds to requests.
+func (a *AdminClient) Ping() error {
+	resp, err := a.client.Get(a.address + "/server_info")
+	if err != nil {
+		return errors.Wrap(err, "request to Envoy Admin API failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// ClusterStats returns cumulative upstream stats of all clusters indexed by the name of a cluster
+// as it is exposed by the Prometheus endpoint.
+func (a *AdminClient) ClusterStats() (map[string]ClusterStats, error) {
+	resp, err := a.client.Get(a.address + "/stats/prometheus")
+	if err != nil {
+		return nil, errors.Wrap(err, "request to Envoy Admin API failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	stats, err := parseClusterStats(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse the response")
+	}
+	return stats, nil
+}
+
+// InboundAddresses returns addresses of the application that inbound clusters point to.
+func (a *AdminClient) InboundAddresses() ([]string, error) {
+	return a.clusterAddresses(func(name string) bool {
+		// inbound clusters are named after the port of the application, see names.GetLocalClusterName()
+		return strings.HasPrefix(name, "localhost:")
+	})
+}
+
+// PassThroughAddresses returns destinations that the passthrough cluster has connections to.
+// Those are destinations the Dataplane has no outbound for. Hosts of the passthrough cluster are removed
+// some time after their connections are closed, so only recently reached destinations are returned.
+func (a *AdminClient) PassThroughAddresses() ([]string, error) {
+	return a.clusterAddresses(func(name string) bool {
+		// see generator.TransparentProxyGenerator
+		return name == "outbound:passthrough"
+	})
+}
+
+// clusterAddresses returns sorted addresses of the hosts of clusters that match a given predicate.
+func (a *AdminClient) clusterAddresses(predicate func(name string) bool) ([]string, error) {
+	resp, err := a.client.Get(a.address + "/clusters?format=json")
+	if err != nil {
+		return nil, errors.Wrap(err, "request to Envoy Admin API failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the response")
+	}
+	clusters := &envoy_admin.Clusters{}
+	if err := util_proto.FromJSON(body, clusters); err != nil {
+		return nil, errors.Wrap(err, "could not parse the response")
+	}
+	var addresses []string
+	for _, cluster := range clusters.GetClusterStatuses() {
+		if !predicate(cluster.GetName()) {
+			continue
+		}
+		for _, host := range cluster.GetHostStatuses() {
+			socketAddress := host.GetAddress().GetSocketAddress()
+			if socketAddress == nil {
+				continue
+			}
+			addresses = append(addresses, net.JoinHostPort(socketAddress.GetAddress(), strconv.Itoa(int(socketAddress.GetPortValue()))))
+		}
+	}
+	sort.Strings(addresses)
+	return addresses, nil
+}
+
+// stats returns values of the stats that match a given regex.
+func (a *AdminClient) stats(filter string) (map[string]uint64, error) {
+	resp, err := a.client.Get(a.address + "/stats?filter=" + url.QueryEscape(filter))
+	if err != nil {
+		return nil, errors.Wrap(err, "request to Envoy Admin API failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	stats := map[string]uint64{}
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		// every line has format "<name>: <value>"
+		parts := strings.SplitN(scanner.Text(), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse the value of %q stat", parts[0])
+		}
+		stats[parts[0]] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "could not read the response")
+	}
+	return stats, nil
+}
@@ -0,0 +1,223 @@
+package envoy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Admin Client", func() {
+
+	var mux *http.ServeMux
+	var server *httptest.Server
+	var client *AdminClient
+
+	BeforeEach(func() {
+		mux = http.NewServeMux()
+		server = httptest.NewServer(mux)
+		port, err := strconv.Atoi(strings.Split(server.Listener.Addr().String(), ":")[1])
+		Expect(err).ToNot(HaveOccurred())
+		client = NewAdminClient(uint32(port))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should drain listeners", func() {
+		// given
+		var method, query string
+		mux.HandleFunc("/drain_listeners", func(writer http.ResponseWriter, req *http.Request) {
+			method = req.Method
+			query = req.URL.RawQuery
+			_, _ = writer.Write([]byte("OK\n"))
+		})
+
+		// when
+		err := client.DrainListeners()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(method).To(Equal(http.MethodPost))
+		Expect(query).To(Equal("inboundonly&graceful"))
+	})
+
+	It("should return an error when listeners could not be drained", func() {
+		// given
+		mux.HandleFunc("/drain_listeners", func(writer http.ResponseWriter, req *http.Request) {
+			writer.WriteHeader(http.StatusInternalServerError)
+		})
+
+		// when
+		err := client.DrainListeners()
+
+		// then
+		Expect(err).To(MatchError("unexpected status code: 500"))
+	})
+
+	It("should count active connections of inbound listeners", func() {
+		// given
+		mux.HandleFunc("/listeners", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Query().Get("format")).To(Equal("json"))
+			_, _ = writer.Write([]byte(`{
+  "listener_statuses": [
+    {"name": "inbound:0.0.0.0:15006", "local_address": {"socket_address": {"address": "0.0.0.0", "port_value": 15006}}},
+    {"name": "inbound:192.168.0.1:8080", "local_address": {"socket_address": {"address": "192.168.0.1", "port_value": 8080}}},
+    {"name": "outbound:0.0.0.0:15001", "local_address": {"socket_address": {"address": "0.0.0.0", "port_value": 15001}}}
+  ]
+}`))
+		})
+		mux.HandleFunc("/stats", func(writer http.ResponseWriter, req *http.Request) {
+			_, _ = writer.Write([]byte(`listener.0.0.0.0_15001.downstream_cx_active: 4
+listener.0.0.0.0_15006.downstream_cx_active: 3
+listener.0.0.0.0_15006.worker_0.downstream_cx_active: 3
+listener.192.168.0.1_8080.downstream_cx_active: 2
+listener.admin.downstream_cx_active: 1
+`))
+		})
+
+		// when
+		connections, err := client.ActiveConnections()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(connections).To(Equal(uint64(5)))
+	})
+
+	It("should report that the config is received when both LDS and CDS are ACKed", func() {
+		// given
+		mux.HandleFunc("/stats", func(writer http.ResponseWriter, req *http.Request) {
+			_, _ = writer.Write([]byte(`cluster_manager.cds.update_success: 1
+listener_manager.lds.update_success: 2
+`))
+		})
+
+		// when
+		received, err := client.ConfigReceived()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(received).To(BeTrue())
+	})
+
+	It("should report that the config is not received when LDS is not ACKed", func() {
+		// given
+		mux.HandleFunc("/stats", func(writer http.ResponseWriter, req *http.Request) {
+			_, _ = writer.Write([]byte(`cluster_manager.cds.update_success: 1
+listener_manager.lds.update_success: 0
+`))
+		})
+
+		// when
+		received, err := client.ConfigReceived()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(received).To(BeFalse())
+	})
+
+	It("should return addresses of inbound clusters", func() {
+		// given
+		mux.HandleFunc("/clusters", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Query().Get("format")).To(Equal("json"))
+			_, _ = writer.Write([]byte(`{
+  "cluster_statuses": [
+    {
+      "name": "localhost:8080",
+      "host_statuses": [{"address": {"socket_address": {"address": "127.0.0.1", "port_value": 8080}}}]
+    },
+    {
+      "name": "backend",
+      "host_statuses": [{"address": {"socket_address": {"address": "192.168.0.2", "port_value": 10001}}}]
+    },
+    {
+      "name": "localhost:7070",
+      "host_statuses": [{"address": {"socket_address": {"address": "127.0.0.1", "port_value": 7070}}}]
+    }
+  ]
+}`))
+		})
+
+		// when
+		addresses, err := client.InboundAddresses()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(addresses).To(Equal([]string{"127.0.0.1:7070", "127.0.0.1:8080"}))
+	})
+
+	It("should return addresses reached through the passthrough cluster", func() {
+		// given
+		mux.HandleFunc("/clusters", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Query().Get("format")).To(Equal("json"))
+			_, _ = writer.Write([]byte(`{
+  "cluster_statuses": [
+    {
+      "name": "outbound:passthrough",
+      "host_statuses": [
+        {"address": {"socket_address": {"address": "10.108.144.24", "port_value": 443}}},
+        {"address": {"socket_address": {"address": "10.108.144.20", "port_value": 80}}}
+      ]
+    },
+    {
+      "name": "backend",
+      "host_statuses": [{"address": {"socket_address": {"address": "192.168.0.2", "port_value": 10001}}}]
+    }
+  ]
+}`))
+		})
+
+		// when
+		addresses, err := client.PassThroughAddresses()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(addresses).To(Equal([]string{"10.108.144.20:80", "10.108.144.24:443"}))
+	})
+
+	It("should return upstream stats of clusters", func() {
+		// given
+		mux.HandleFunc("/stats/prometheus", func(writer http.ResponseWriter, req *http.Request) {
+			_, _ = writer.Write([]byte(`# TYPE envoy_cluster_upstream_rq_total counter
+envoy_cluster_upstream_rq_total{envoy_cluster_name="backend"} 100
+# TYPE envoy_cluster_upstream_rq_xx counter
+envoy_cluster_upstream_rq_xx{envoy_response_code_class="2",envoy_cluster_name="backend"} 75
+envoy_cluster_upstream_rq_xx{envoy_response_code_class="5",envoy_cluster_name="backend"} 25
+# TYPE envoy_cluster_upstream_cx_total counter
+envoy_cluster_upstream_cx_total{envoy_cluster_name="backend"} 2
+envoy_cluster_upstream_cx_total{envoy_cluster_name="redis"} 3
+# TYPE envoy_cluster_upstream_rq_time histogram
+envoy_cluster_upstream_rq_time_bucket{envoy_cluster_name="backend",le="+Inf"} 100
+envoy_cluster_upstream_rq_time_sum{envoy_cluster_name="backend"} 1000
+envoy_cluster_upstream_rq_time_count{envoy_cluster_name="backend"} 100
+# TYPE envoy_server_live gauge
+envoy_server_live{} 1
+`))
+		})
+
+		// when
+		stats, err := client.ClusterStats()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stats).To(Equal(map[string]ClusterStats{
+			"backend": {
+				Requests:     100,
+				Errors:       25,
+				Connections:  2,
+				LatencySum:   1000,
+				LatencyCount: 100,
+			},
+			"redis": {
+				Connections: 3,
+			},
+		}))
+	})
+})
@@ -0,0 +1,107 @@
+package envoy
+
+import (
+	"io"
+
+	io_prometheus_client "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	clusterNameLabel       = "envoy_cluster_name"
+	responseCodeClassLabel = "envoy_response_code_class"
+
+	upstreamRqTotal = "envoy_cluster_upstream_rq_total"
+	upstreamRqXX    = "envoy_cluster_upstream_rq_xx"
+	upstreamRqTime  = "envoy_cluster_upstream_rq_time"
+	upstreamCxTotal = "envoy_cluster_upstream_cx_total"
+)
+
+// ClusterStats holds cumulative Envoy counters of a single upstream cluster.
+type ClusterStats struct {
+	Requests     float64
+	Errors       float64
+	Connections  float64
+	LatencySum   float64
+	LatencyCount float64
+}
+
+// Sub returns the difference between two observations of the same counters.
+// It returns false if any of the counters has been reset in the meantime.
+func (s ClusterStats) Sub(prev ClusterStats) (ClusterStats, bool) {
+	diff := ClusterStats{
+		Requests:     s.Requests - prev.Requests,
+		Errors:       s.Errors - prev.Errors,
+		Connections:  s.Connections - prev.Connections,
+		LatencySum:   s.LatencySum - prev.LatencySum,
+		LatencyCount: s.LatencyCount - prev.LatencyCount,
+	}
+	if diff.Requests < 0 || diff.Errors < 0 || diff.Connections < 0 || diff.LatencySum < 0 || diff.LatencyCount < 0 {
+		return ClusterStats{}, false
+	}
+	return diff, true
+}
+
+// parseClusterStats extracts per-cluster upstream stats from the output of Envoy's `/stats/prometheus` endpoint.
+func parseClusterStats(r io.Reader) (map[string]ClusterStats, error) {
+	parser := expfmt.TextParser{}
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, err
+	}
+	stats := map[string]ClusterStats{}
+	update := func(metric *io_prometheus_client.Metric, fn func(*ClusterStats)) {
+		cluster := labelValue(metric, clusterNameLabel)
+		if cluster == "" {
+			return
+		}
+		s := stats[cluster]
+		fn(&s)
+		stats[cluster] = s
+	}
+	for _, metric := range families[upstreamRqTotal].GetMetric() {
+		update(metric, func(s *ClusterStats) {
+			s.Requests = metricValue(metric)
+		})
+	}
+	for _, metric := range families[upstreamRqXX].GetMetric() {
+		if labelValue(metric, responseCodeClassLabel) != "5" {
+			continue
+		}
+		update(metric, func(s *ClusterStats) {
+			s.Errors = metricValue(metric)
+		})
+	}
+	for _, metric := range families[upstreamCxTotal].GetMetric() {
+		update(metric, func(s *ClusterStats) {
+			s.Connections = metricValue(metric)
+		})
+	}
+	for _, metric := range families[upstreamRqTime].GetMetric() {
+		update(metric, func(s *ClusterStats) {
+			s.LatencySum = metric.GetHistogram().GetSampleSum()
+			s.LatencyCount = float64(metric.GetHistogram().GetSampleCount())
+		})
+	}
+	return stats, nil
+}
+
+func labelValue(metric *io_prometheus_client.Metric, name string) string {
+	for _, label := range metric.GetLabel() {
+		if label.GetName() == name {
+			return label.GetValue()
+		}
+	}
+	return ""
+}
+
+func metricValue(metric *io_prometheus_client.Metric) float64 {
+	switch {
+	case metric.GetCounter() != nil:
+		return metric.GetCounter().GetValue()
+	case metric.GetGauge() != nil:
+		return metric.GetGauge().GetValue()
+	default:
+		return metric.GetUntyped().GetValue()
+	}
+}
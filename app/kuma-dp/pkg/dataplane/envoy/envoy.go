@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sync/atomic"
 	"time"
 
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
@@ -52,6 +53,13 @@ var _ component.Component = &Envoy{}
 
 type Envoy struct {
 	opts Opts
+	// running is 1 when Envoy process is running, accessed atomically
+	running int32
+}
+
+// Running returns true when Envoy process is started and has not terminated yet.
+func (e *Envoy) Running() bool {
+	return atomic.LoadInt32(&e.running) == 1
 }
 
 func (e *Envoy) NeedLeaderElection() bool {
@@ -136,6 +144,9 @@ func (e *Envoy) Start(stop <-chan struct{}) error {
 		// so, let's turn it off to simplify getting started experience.
 		"--disable-hot-restart",
 	}
+	if concurrency := e.opts.Config.Dataplane.Concurrency; concurrency > 0 {
+		args = append(args, "--concurrency", fmt.Sprintf("%d", concurrency))
+	}
 	command := exec.CommandContext(ctx, resolvedPath, args...)
 	command.Stdout = e.opts.Stdout
 	command.Stderr = e.opts.Stderr
@@ -144,9 +155,12 @@ func (e *Envoy) Start(stop <-chan struct{}) error {
 		runLog.Error(err, "the envoy executable was found at "+resolvedPath+" but an error occurred when executing it")
 		return err
 	}
+	atomic.StoreInt32(&e.running, 1)
+	defer atomic.StoreInt32(&e.running, 0)
 	done := make(chan error, 1)
 	go func() {
 		done <- command.Wait()
+		atomic.StoreInt32(&e.running, 0)
 	}()
 
 	select {
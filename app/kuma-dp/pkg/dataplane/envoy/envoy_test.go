@@ -66,7 +66,8 @@ var _ = Describe("Envoy", func() {
 			// given
 			cfg := kuma_dp.Config{
 				Dataplane: kuma_dp.Dataplane{
-					DrainTime: 15 * time.Second,
+					DrainTime:   15 * time.Second,
+					Concurrency: 2,
 				},
 				DataplaneRuntime: kuma_dp.DataplaneRuntime{
 					BinaryPath: filepath.Join("testdata", "envoy-mock.exit-0.sh"),
@@ -120,7 +121,7 @@ var _ = Describe("Envoy", func() {
 			// then
 			Expect(err).ToNot(HaveOccurred())
 			// and
-			Expect(strings.TrimSpace(buf.String())).To(Equal(fmt.Sprintf("-c %s --drain-time-s 15 --disable-hot-restart", expectedConfigFile)))
+			Expect(strings.TrimSpace(buf.String())).To(Equal(fmt.Sprintf("-c %s --drain-time-s 15 --disable-hot-restart --concurrency 2", expectedConfigFile)))
 
 			By("verifying the contents Envoy config file")
 			// when
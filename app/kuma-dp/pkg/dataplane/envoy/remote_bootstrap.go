@@ -18,6 +18,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core"
 	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	kuma_version "github.com/kumahq/kuma/pkg/version"
 	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
 )
 
@@ -102,6 +103,14 @@ func (b *remoteBootstrap) requestForBootstrap(url *net_url.URL, cfg kuma_dp.Conf
 		AdminPort:          cfg.Dataplane.AdminPort.Lowest(),
 		DataplaneTokenPath: cfg.DataplaneRuntime.TokenPath,
 		DataplaneResource:  dataplaneResource,
+		Version: types.Version{
+			KumaDp: types.KumaDpVersion{
+				Version:   kuma_version.Build.Version,
+				GitTag:    kuma_version.Build.GitTag,
+				GitCommit: kuma_version.Build.GitCommit,
+				BuildDate: kuma_version.Build.BuildDate,
+			},
+		},
 	}
 	jsonBytes, err := json.Marshal(request)
 	if err != nil {
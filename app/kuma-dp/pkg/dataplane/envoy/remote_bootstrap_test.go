@@ -80,7 +80,15 @@ var _ = Describe("Remote Bootstrap", func() {
                       "name": "sample",
                       "adminPort": 4321,
                       "dataplaneTokenPath": "/tmp/token",
-                      "dataplaneResource": "{\"type\":\"Dataplane\",\"mesh\":\"demo\",\"name\":\"sample\",\"creationTime\":\"0001-01-01T00:00:00Z\",\"modificationTime\":\"0001-01-01T00:00:00Z\"}"
+                      "dataplaneResource": "{\"type\":\"Dataplane\",\"mesh\":\"demo\",\"name\":\"sample\",\"creationTime\":\"0001-01-01T00:00:00Z\",\"modificationTime\":\"0001-01-01T00:00:00Z\"}",
+                      "version": {
+                        "kumaDp": {
+                          "version": "unknown",
+                          "gitTag": "unknown",
+                          "gitCommit": "unknown",
+                          "buildDate": "unknown"
+                        }
+                      }
                     }
 `,
 				}
@@ -109,7 +117,15 @@ var _ = Describe("Remote Bootstrap", func() {
                       "name": "sample",
                       "adminPort": 4321,
                       "dataplaneTokenPath": "/tmp/token",
-                      "dataplaneResource": "{\"type\":\"Dataplane\",\"mesh\":\"demo\",\"name\":\"sample\",\"creationTime\":\"0001-01-01T00:00:00Z\",\"modificationTime\":\"0001-01-01T00:00:00Z\"}"
+                      "dataplaneResource": "{\"type\":\"Dataplane\",\"mesh\":\"demo\",\"name\":\"sample\",\"creationTime\":\"0001-01-01T00:00:00Z\",\"modificationTime\":\"0001-01-01T00:00:00Z\"}",
+                      "version": {
+                        "kumaDp": {
+                          "version": "unknown",
+                          "gitTag": "unknown",
+                          "gitCommit": "unknown",
+                          "buildDate": "unknown"
+                        }
+                      }
                     }
 `,
 				}
@@ -136,7 +152,15 @@ var _ = Describe("Remote Bootstrap", func() {
                       "mesh": "demo",
                       "name": "sample",
                       "dataplaneTokenPath": "/tmp/token",
-                      "dataplaneResource": "{\"type\":\"Dataplane\",\"mesh\":\"demo\",\"name\":\"sample\",\"creationTime\":\"0001-01-01T00:00:00Z\",\"modificationTime\":\"0001-01-01T00:00:00Z\"}"
+                      "dataplaneResource": "{\"type\":\"Dataplane\",\"mesh\":\"demo\",\"name\":\"sample\",\"creationTime\":\"0001-01-01T00:00:00Z\",\"modificationTime\":\"0001-01-01T00:00:00Z\"}",
+                      "version": {
+                        "kumaDp": {
+                          "version": "unknown",
+                          "gitTag": "unknown",
+                          "gitCommit": "unknown",
+                          "buildDate": "unknown"
+                        }
+                      }
                     }
 `,
 				}
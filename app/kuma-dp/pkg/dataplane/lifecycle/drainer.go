@@ -0,0 +1,137 @@
+package lifecycle
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
+)
+
+var log = core.Log.WithName("kuma-dp").WithName("lifecycle")
+
+// Drainer drains the Dataplane before shutdown.
+// First, the Dataplane is marked as draining in the Control Plane, so it is excluded from endpoints of other Dataplanes.
+// Then Envoy listeners are drained and Drainer waits until there are no active connections or until the drain time passes.
+type Drainer struct {
+	cfg          kuma_dp.Config
+	bootstrapUrl string
+	client       *http.Client
+	envoyAdmin   *envoy.AdminClient
+	once         sync.Once
+	// draining is 1 once the drain is started, accessed atomically
+	draining int32
+}
+
+func NewDrainer(cfg kuma_dp.Config, bootstrapUrl string) *Drainer {
+	return &Drainer{
+		cfg:          cfg,
+		bootstrapUrl: bootstrapUrl,
+		client: &http.Client{
+			Timeout:   5 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+		},
+		envoyAdmin: newEnvoyAdmin(cfg),
+	}
+}
+
+// newEnvoyAdmin returns nil when Envoy Admin API is not exposed over TCP.
+func newEnvoyAdmin(cfg kuma_dp.Config) *envoy.AdminClient {
+	if cfg.Dataplane.AdminPort.Empty() {
+		return nil
+	}
+	return envoy.NewAdminClient(cfg.Dataplane.AdminPort.Lowest())
+}
+
+// Drain blocks until the Dataplane is drained. The sequence is executed only once,
+// subsequent calls wait for the first one to finish.
+func (d *Drainer) Drain() {
+	d.once.Do(d.drain)
+}
+
+// Draining returns true once the drain is started.
+func (d *Drainer) Draining() bool {
+	return atomic.LoadInt32(&d.draining) == 1
+}
+
+func (d *Drainer) drain() {
+	atomic.StoreInt32(&d.draining, 1)
+	log.Info("draining the Dataplane", "drainTime", d.cfg.Dataplane.DrainTime)
+	if err := d.markDraining(); err != nil {
+		// the Control Plane will eventually notice that the Dataplane is disconnected, so we can continue
+		log.Error(err, "could not mark the Dataplane as draining in the Control Plane")
+	}
+	if d.envoyAdmin == nil {
+		log.Info("Envoy Admin API is not exposed, listeners won't be drained")
+		return
+	}
+	if err := d.envoyAdmin.DrainListeners(); err != nil {
+		log.Error(err, "could not drain Envoy listeners")
+		return
+	}
+	d.waitForConnections()
+}
+
+func (d *Drainer) markDraining() error {
+	request := types.DrainRequest{
+		Mesh: d.cfg.Dataplane.Mesh,
+		Name: d.cfg.Dataplane.Name,
+	}
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal request to json")
+	}
+	req, err := http.NewRequest(http.MethodPost, d.bootstrapUrl+"/drain", bytes.NewReader(jsonBytes))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if d.cfg.DataplaneRuntime.TokenPath != "" {
+		token, err := ioutil.ReadFile(d.cfg.DataplaneRuntime.TokenPath)
+		if err != nil {
+			return errors.Wrap(err, "could not read the dataplane token")
+		}
+		req.Header.Set("Authorization", string(token))
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "request to bootstrap server failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		return errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (d *Drainer) waitForConnections() {
+	deadline := core.Now().Add(d.cfg.Dataplane.DrainTime)
+	for {
+		connections, err := d.envoyAdmin.ActiveConnections()
+		if err != nil {
+			log.Error(err, "could not get the number of active connections")
+		} else if connections == 0 {
+			log.Info("the Dataplane is drained")
+			return
+		}
+		remaining := deadline.Sub(core.Now())
+		if remaining <= 0 {
+			log.Info("drain time passed, some connections are still active", "connections", connections)
+			return
+		}
+		if remaining > time.Second {
+			remaining = time.Second
+		}
+		time.Sleep(remaining)
+	}
+}
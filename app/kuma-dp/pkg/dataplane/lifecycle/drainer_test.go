@@ -0,0 +1,152 @@
+package lifecycle_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/lifecycle"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	config_types "github.com/kumahq/kuma/pkg/config/types"
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
+)
+
+var _ = Describe("Drainer", func() {
+
+	var tmpDir string
+	var cpServer *httptest.Server
+	var envoyServer *httptest.Server
+	var cfg kuma_dp.Config
+
+	var drainRequests []types.DrainRequest
+	var authorization string
+	var listenersDrained bool
+	var activeConnections int64
+
+	BeforeEach(func() {
+		drainRequests = nil
+		authorization = ""
+		listenersDrained = false
+		atomic.StoreInt64(&activeConnections, 0)
+
+		cpMux := http.NewServeMux()
+		cpMux.HandleFunc("/drain", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			request := types.DrainRequest{}
+			Expect(json.NewDecoder(req.Body).Decode(&request)).To(Succeed())
+			drainRequests = append(drainRequests, request)
+			authorization = req.Header.Get("Authorization")
+			writer.WriteHeader(http.StatusNoContent)
+		})
+		cpServer = httptest.NewServer(cpMux)
+
+		envoyMux := http.NewServeMux()
+		envoyMux.HandleFunc("/drain_listeners", func(writer http.ResponseWriter, req *http.Request) {
+			listenersDrained = true
+		})
+		envoyMux.HandleFunc("/listeners", func(writer http.ResponseWriter, req *http.Request) {
+			_, _ = writer.Write([]byte(`{"listener_statuses": [{"name": "inbound:0.0.0.0:15006", "local_address": {"socket_address": {"address": "0.0.0.0", "port_value": 15006}}}]}`))
+		})
+		envoyMux.HandleFunc("/stats", func(writer http.ResponseWriter, req *http.Request) {
+			// every poll closes one connection
+			connections := atomic.AddInt64(&activeConnections, -1) + 1
+			if connections < 0 {
+				connections = 0
+			}
+			_, _ = writer.Write([]byte("listener.0.0.0.0_15006.downstream_cx_active: " + strconv.FormatInt(connections, 10) + "\n"))
+		})
+		envoyServer = httptest.NewServer(envoyMux)
+		port, err := strconv.Atoi(strings.Split(envoyServer.Listener.Addr().String(), ":")[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		tmpDir, err = ioutil.TempDir("", "")
+		Expect(err).ToNot(HaveOccurred())
+		tokenPath := filepath.Join(tmpDir, "token")
+		Expect(ioutil.WriteFile(tokenPath, []byte("sample-token"), 0600)).To(Succeed())
+
+		cfg = kuma_dp.DefaultConfig()
+		cfg.Dataplane.Name = "backend-01"
+		cfg.Dataplane.AdminPort = config_types.MustExactPort(uint32(port))
+		cfg.Dataplane.DrainTime = 5 * time.Second
+		cfg.DataplaneRuntime.TokenPath = tokenPath
+	})
+
+	AfterEach(func() {
+		cpServer.Close()
+		envoyServer.Close()
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	It("should mark the Dataplane as draining and drain Envoy listeners", func() {
+		// given
+		drainer := lifecycle.NewDrainer(cfg, cpServer.URL)
+
+		// when
+		drainer.Drain()
+
+		// then
+		Expect(drainRequests).To(Equal([]types.DrainRequest{{Mesh: "default", Name: "backend-01"}}))
+		Expect(authorization).To(Equal("sample-token"))
+		Expect(listenersDrained).To(BeTrue())
+	})
+
+	It("should wait until there are no active connections", func() {
+		// given
+		atomic.StoreInt64(&activeConnections, 2)
+		drainer := lifecycle.NewDrainer(cfg, cpServer.URL)
+
+		// when
+		drainer.Drain()
+
+		// then
+		Expect(atomic.LoadInt64(&activeConnections)).To(BeNumerically("<=", 0))
+	})
+
+	It("should not wait longer than the drain time", func() {
+		// given
+		atomic.StoreInt64(&activeConnections, 1000)
+		cfg.Dataplane.DrainTime = 100 * time.Millisecond
+		drainer := lifecycle.NewDrainer(cfg, cpServer.URL)
+
+		// when
+		start := time.Now()
+		drainer.Drain()
+
+		// then
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+	})
+
+	It("should drain only once", func() {
+		// given
+		drainer := lifecycle.NewDrainer(cfg, cpServer.URL)
+
+		// when
+		drainer.Drain()
+		drainer.Drain()
+
+		// then
+		Expect(drainRequests).To(HaveLen(1))
+	})
+
+	It("should drain Envoy listeners even if the Control Plane is not available", func() {
+		// given
+		cpServer.Close()
+		drainer := lifecycle.NewDrainer(cfg, cpServer.URL)
+
+		// when
+		drainer.Drain()
+
+		// then
+		Expect(listenersDrained).To(BeTrue())
+	})
+})
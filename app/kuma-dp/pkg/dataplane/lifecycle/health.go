@@ -0,0 +1,90 @@
+package lifecycle
+
+import (
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+)
+
+// EnvoyProcess reports the state of Envoy process.
+type EnvoyProcess interface {
+	Running() bool
+}
+
+// HealthChecker aggregates the state of Envoy process, Envoy configuration received from the Control Plane
+// and optionally the state of the application into liveness and readiness of the Dataplane.
+type HealthChecker struct {
+	process      EnvoyProcess
+	envoyAdmin   *envoy.AdminClient
+	drainer      *Drainer
+	checkInbound bool
+}
+
+func NewHealthChecker(cfg kuma_dp.Config, process EnvoyProcess, drainer *Drainer) *HealthChecker {
+	return &HealthChecker{
+		process:      process,
+		envoyAdmin:   newEnvoyAdmin(cfg),
+		drainer:      drainer,
+		checkInbound: cfg.Dataplane.ReadinessCheckInbound,
+	}
+}
+
+// Live returns an error when Envoy process is not running or does not respond.
+func (h *HealthChecker) Live() error {
+	if !h.process.Running() {
+		return errors.New("Envoy is not running")
+	}
+	if h.envoyAdmin == nil {
+		return nil
+	}
+	if err := h.envoyAdmin.Ping(); err != nil {
+		return errors.Wrap(err, "Envoy does not respond")
+	}
+	return nil
+}
+
+// Ready returns an error when the Dataplane should not receive traffic.
+func (h *HealthChecker) Ready() error {
+	if err := h.Live(); err != nil {
+		return err
+	}
+	if h.drainer.Draining() {
+		return errors.New("Dataplane is draining")
+	}
+	if h.envoyAdmin == nil {
+		// without Envoy Admin API there is no way to check the configuration of Envoy
+		return nil
+	}
+	received, err := h.envoyAdmin.ConfigReceived()
+	if err != nil {
+		return errors.Wrap(err, "could not check whether Envoy received configuration")
+	}
+	if !received {
+		return errors.New("Envoy has not received LDS and CDS from the Control Plane yet")
+	}
+	if h.checkInbound {
+		if err := h.inboundReady(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *HealthChecker) inboundReady() error {
+	addresses, err := h.envoyAdmin.InboundAddresses()
+	if err != nil {
+		return errors.Wrap(err, "could not get inbound addresses")
+	}
+	for _, address := range addresses {
+		conn, err := net.DialTimeout("tcp", address, time.Second)
+		if err != nil {
+			return errors.Wrapf(err, "application does not accept connections on %s", address)
+		}
+		_ = conn.Close()
+	}
+	return nil
+}
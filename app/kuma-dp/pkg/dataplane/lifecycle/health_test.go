@@ -0,0 +1,142 @@
+package lifecycle_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/lifecycle"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	config_types "github.com/kumahq/kuma/pkg/config/types"
+)
+
+type fakeEnvoyProcess struct {
+	running bool
+}
+
+func (f *fakeEnvoyProcess) Running() bool {
+	return f.running
+}
+
+var _ = Describe("HealthChecker", func() {
+
+	var envoyServer *httptest.Server
+	var process *fakeEnvoyProcess
+	var cfg kuma_dp.Config
+
+	var updateSuccess int
+	var inboundAddress string
+
+	BeforeEach(func() {
+		updateSuccess = 1
+		inboundAddress = ""
+		process = &fakeEnvoyProcess{running: true}
+
+		envoyMux := http.NewServeMux()
+		envoyMux.HandleFunc("/server_info", func(writer http.ResponseWriter, req *http.Request) {
+			_, _ = writer.Write([]byte("{}"))
+		})
+		envoyMux.HandleFunc("/drain_listeners", func(writer http.ResponseWriter, req *http.Request) {
+		})
+		envoyMux.HandleFunc("/stats", func(writer http.ResponseWriter, req *http.Request) {
+			_, _ = writer.Write([]byte(fmt.Sprintf("cluster_manager.cds.update_success: 1\nlistener_manager.lds.update_success: %d\nlistener.0.0.0.0_15001.downstream_cx_active: 0\n", updateSuccess)))
+		})
+		envoyMux.HandleFunc("/clusters", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			host, port, err := net.SplitHostPort(inboundAddress)
+			Expect(err).ToNot(HaveOccurred())
+			_, _ = writer.Write([]byte(fmt.Sprintf(`{"cluster_statuses": [{"name": "localhost:%s", "host_statuses": [{"address": {"socket_address": {"address": "%s", "port_value": %s}}}]}]}`, port, host, port)))
+		})
+		envoyServer = httptest.NewServer(envoyMux)
+		port, err := strconv.Atoi(strings.Split(envoyServer.Listener.Addr().String(), ":")[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		cfg = kuma_dp.DefaultConfig()
+		cfg.Dataplane.AdminPort = config_types.MustExactPort(uint32(port))
+	})
+
+	AfterEach(func() {
+		envoyServer.Close()
+	})
+
+	It("should be live and ready when Envoy is running and has received configuration", func() {
+		// given
+		checker := lifecycle.NewHealthChecker(cfg, process, lifecycle.NewDrainer(cfg, "http://127.0.0.1:0"))
+
+		// expect
+		Expect(checker.Live()).To(Succeed())
+		Expect(checker.Ready()).To(Succeed())
+	})
+
+	It("should not be live when Envoy is not running", func() {
+		// given
+		process.running = false
+		checker := lifecycle.NewHealthChecker(cfg, process, lifecycle.NewDrainer(cfg, "http://127.0.0.1:0"))
+
+		// expect
+		Expect(checker.Live()).To(MatchError("Envoy is not running"))
+		Expect(checker.Ready()).To(MatchError("Envoy is not running"))
+	})
+
+	It("should not be ready until Envoy has received LDS", func() {
+		// given
+		updateSuccess = 0
+		checker := lifecycle.NewHealthChecker(cfg, process, lifecycle.NewDrainer(cfg, "http://127.0.0.1:0"))
+
+		// expect
+		Expect(checker.Live()).To(Succeed())
+		Expect(checker.Ready()).To(MatchError("Envoy has not received LDS and CDS from the Control Plane yet"))
+	})
+
+	It("should not be ready when the Dataplane is draining", func() {
+		// given
+		drainer := lifecycle.NewDrainer(cfg, "http://127.0.0.1:0")
+		checker := lifecycle.NewHealthChecker(cfg, process, drainer)
+
+		// when
+		drainer.Drain()
+
+		// then
+		Expect(checker.Live()).To(Succeed())
+		Expect(checker.Ready()).To(MatchError("Dataplane is draining"))
+	})
+
+	Context("with inbound check", func() {
+
+		BeforeEach(func() {
+			cfg.Dataplane.ReadinessCheckInbound = true
+		})
+
+		It("should be ready when the application accepts connections", func() {
+			// given
+			application := httptest.NewServer(http.NotFoundHandler())
+			defer application.Close()
+			inboundAddress = application.Listener.Addr().String()
+			checker := lifecycle.NewHealthChecker(cfg, process, lifecycle.NewDrainer(cfg, "http://127.0.0.1:0"))
+
+			// expect
+			Expect(checker.Ready()).To(Succeed())
+		})
+
+		It("should not be ready when the application does not accept connections", func() {
+			// given
+			application := httptest.NewServer(http.NotFoundHandler())
+			inboundAddress = application.Listener.Addr().String()
+			application.Close()
+			checker := lifecycle.NewHealthChecker(cfg, process, lifecycle.NewDrainer(cfg, "http://127.0.0.1:0"))
+
+			// when
+			err := checker.Ready()
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("application does not accept connections on " + inboundAddress))
+		})
+	})
+})
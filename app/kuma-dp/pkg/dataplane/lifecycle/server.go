@@ -0,0 +1,82 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+var _ component.Component = &Server{}
+
+// Server exposes lifecycle of Kuma DP to a process manager, e.g. probes and the pre-stop hook on Kubernetes.
+// It listens only on 127.0.0.1.
+type Server struct {
+	port    uint32
+	drainer *Drainer
+	health  *HealthChecker
+}
+
+func NewServer(port uint32, drainer *Drainer, health *HealthChecker) *Server {
+	return &Server{
+		port:    port,
+		drainer: drainer,
+		health:  health,
+	}
+}
+
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *Server) Start(stop <-chan struct{}) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/drain", s.handleDrain)
+	mux.HandleFunc("/ready", s.handleCheck(s.health.Ready))
+	mux.HandleFunc("/live", s.handleCheck(s.health.Live))
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf("127.0.0.1:%d", s.port),
+		Handler: mux,
+	}
+
+	errChan := make(chan error)
+	go func() {
+		defer close(errChan)
+		if err := server.ListenAndServe(); err != nil {
+			if err != http.ErrServerClosed {
+				log.Error(err, "terminated with an error")
+				errChan <- err
+				return
+			}
+		}
+		log.Info("terminated normally")
+	}()
+	log.Info("starting", "interface", "127.0.0.1", "port", s.port)
+
+	select {
+	case <-stop:
+		log.Info("stopping")
+		return server.Shutdown(context.Background())
+	case err := <-errChan:
+		return err
+	}
+}
+
+// handleDrain blocks until the Dataplane is drained.
+func (s *Server) handleDrain(resp http.ResponseWriter, _ *http.Request) {
+	s.drainer.Drain()
+	resp.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) handleCheck(check func() error) http.HandlerFunc {
+	return func(resp http.ResponseWriter, _ *http.Request) {
+		if err := check(); err != nil {
+			resp.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = resp.Write([]byte(err.Error()))
+			return
+		}
+		resp.WriteHeader(http.StatusOK)
+	}
+}
@@ -0,0 +1,164 @@
+package stats
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
+)
+
+var log = core.Log.WithName("kuma-dp").WithName("stats")
+
+// defaultReportInterval is used until the Control Plane tells how often it expects the stats.
+const defaultReportInterval = 30 * time.Second
+
+var _ component.Component = &Reporter{}
+
+// Reporter periodically reports traffic of the Dataplane to its upstream clusters to the Control Plane,
+// which builds the service graph of a Mesh out of it.
+// Envoy counters are cumulative, so every report contains the difference since the previous accepted report.
+// Stats are taken from the Envoy Admin API, so they are available even when the Prometheus endpoint is protected by mTLS.
+type Reporter struct {
+	cfg          kuma_dp.Config
+	bootstrapUrl string
+	client       *http.Client
+	envoyAdmin   *envoy.AdminClient
+	interval     time.Duration
+	// previous is the sample of stats that the next report is computed against
+	previous *sample
+}
+
+type sample struct {
+	time  time.Time
+	stats map[string]envoy.ClusterStats
+}
+
+func NewReporter(cfg kuma_dp.Config, bootstrapUrl string) *Reporter {
+	return &Reporter{
+		cfg:          cfg,
+		bootstrapUrl: bootstrapUrl,
+		client: &http.Client{
+			Timeout:   5 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+		},
+		envoyAdmin: envoy.NewAdminClient(cfg.Dataplane.AdminPort.Lowest()),
+		interval:   defaultReportInterval,
+	}
+}
+
+func (r *Reporter) NeedLeaderElection() bool {
+	return false
+}
+
+func (r *Reporter) Start(stop <-chan struct{}) error {
+	log.Info("starting")
+	timer := time.NewTimer(r.interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			if err := r.Report(); err != nil {
+				// traffic that was not accepted is included in the next report
+				log.Error(err, "could not report stats")
+			}
+			timer.Reset(r.interval)
+		case <-stop:
+			log.Info("stopping")
+			return nil
+		}
+	}
+}
+
+// Interval returns how often the stats are reported.
+func (r *Reporter) Interval() time.Duration {
+	return r.interval
+}
+
+// Report sends traffic to upstream clusters observed since the previous report.
+// The first call only takes a sample that the next report is computed against.
+func (r *Reporter) Report() error {
+	stats, err := r.envoyAdmin.ClusterStats()
+	if err != nil {
+		return errors.Wrap(err, "could not get stats of clusters")
+	}
+	current := &sample{time: core.Now(), stats: stats}
+	if r.previous == nil {
+		r.previous = current
+		return nil
+	}
+	clusters := map[string]types.ClusterStats{}
+	for name, s := range current.stats {
+		diff, ok := s.Sub(r.previous.stats[name])
+		if !ok {
+			continue // Envoy was restarted in the meantime
+		}
+		if diff.Requests == 0 && diff.Connections == 0 {
+			continue
+		}
+		clusters[name] = types.ClusterStats{
+			Requests:     uint64(diff.Requests),
+			Errors:       uint64(diff.Errors),
+			Connections:  uint64(diff.Connections),
+			LatencySum:   diff.LatencySum,
+			LatencyCount: uint64(diff.LatencyCount),
+		}
+	}
+	response, err := r.send(current.time.Sub(r.previous.time), clusters)
+	if err != nil {
+		return err
+	}
+	r.previous = current
+	if response.ReportInterval > 0 && response.ReportInterval != r.interval {
+		log.Info("changing the report interval", "interval", response.ReportInterval)
+		r.interval = response.ReportInterval
+	}
+	return nil
+}
+
+func (r *Reporter) send(period time.Duration, clusters map[string]types.ClusterStats) (*types.StatsResponse, error) {
+	request := types.StatsRequest{
+		Mesh:     r.cfg.Dataplane.Mesh,
+		Name:     r.cfg.Dataplane.Name,
+		Period:   period,
+		Clusters: clusters,
+	}
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal request to json")
+	}
+	req, err := http.NewRequest(http.MethodPost, r.bootstrapUrl+"/stats", bytes.NewReader(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.cfg.DataplaneRuntime.TokenPath != "" {
+		token, err := ioutil.ReadFile(r.cfg.DataplaneRuntime.TokenPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read the dataplane token")
+		}
+		req.Header.Set("Authorization", string(token))
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "request to bootstrap server failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	response := &types.StatsResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, errors.Wrap(err, "could not parse the response")
+	}
+	return response, nil
+}
@@ -0,0 +1,191 @@
+package stats_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/stats"
+	kuma_dp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	config_types "github.com/kumahq/kuma/pkg/config/types"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
+)
+
+var _ = Describe("Reporter", func() {
+
+	var tmpDir string
+	var cpServer *httptest.Server
+	var envoyServer *httptest.Server
+	var reporter *stats.Reporter
+
+	var requests []types.StatsRequest
+	var authorization string
+	var status int
+	var requestsTotal, connectionsTotal int
+	var now time.Time
+
+	BeforeEach(func() {
+		requests = nil
+		authorization = ""
+		status = http.StatusOK
+		requestsTotal = 0
+		connectionsTotal = 0
+		now = time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+		core.Now = func() time.Time {
+			return now
+		}
+
+		cpMux := http.NewServeMux()
+		cpMux.HandleFunc("/stats", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			request := types.StatsRequest{}
+			Expect(json.NewDecoder(req.Body).Decode(&request)).To(Succeed())
+			requests = append(requests, request)
+			authorization = req.Header.Get("Authorization")
+			writer.WriteHeader(status)
+			_, _ = writer.Write([]byte(`{"reportInterval": 10000000000}`))
+		})
+		cpServer = httptest.NewServer(cpMux)
+
+		envoyMux := http.NewServeMux()
+		envoyMux.HandleFunc("/stats/prometheus", func(writer http.ResponseWriter, req *http.Request) {
+			_, _ = fmt.Fprintf(writer, `# TYPE envoy_cluster_upstream_rq_total counter
+envoy_cluster_upstream_rq_total{envoy_cluster_name="backend"} %d
+envoy_cluster_upstream_rq_total{envoy_cluster_name="web"} 7
+# TYPE envoy_cluster_upstream_cx_total counter
+envoy_cluster_upstream_cx_total{envoy_cluster_name="backend"} 1
+envoy_cluster_upstream_cx_total{envoy_cluster_name="redis"} %d
+envoy_cluster_upstream_cx_total{envoy_cluster_name="web"} 1
+`, requestsTotal, connectionsTotal)
+		})
+		envoyServer = httptest.NewServer(envoyMux)
+		port, err := strconv.Atoi(strings.Split(envoyServer.Listener.Addr().String(), ":")[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		tmpDir, err = ioutil.TempDir("", "")
+		Expect(err).ToNot(HaveOccurred())
+		tokenPath := filepath.Join(tmpDir, "token")
+		Expect(ioutil.WriteFile(tokenPath, []byte("sample-token"), 0600)).To(Succeed())
+
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.Name = "backend-01"
+		cfg.Dataplane.AdminPort = config_types.MustExactPort(uint32(port))
+		cfg.DataplaneRuntime.TokenPath = tokenPath
+		reporter = stats.NewReporter(cfg, cpServer.URL)
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+		cpServer.Close()
+		envoyServer.Close()
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	It("should report traffic observed since the previous report", func() {
+		// given
+		requestsTotal = 100
+		connectionsTotal = 2
+
+		// when
+		Expect(reporter.Report()).To(Succeed())
+
+		// then the first report only takes a sample
+		Expect(requests).To(BeEmpty())
+
+		// when
+		requestsTotal = 250
+		connectionsTotal = 5
+		now = now.Add(30 * time.Second)
+		Expect(reporter.Report()).To(Succeed())
+
+		// then
+		Expect(requests).To(Equal([]types.StatsRequest{{
+			Mesh:   "default",
+			Name:   "backend-01",
+			Period: 30 * time.Second,
+			Clusters: map[string]types.ClusterStats{
+				"backend": {
+					Requests: 150,
+				},
+				"redis": {
+					Connections: 3,
+				},
+			},
+		}}))
+		Expect(authorization).To(Equal("sample-token"))
+	})
+
+	It("should report at the interval requested by the Control Plane", func() {
+		// given
+		Expect(reporter.Interval()).To(Equal(30 * time.Second))
+		Expect(reporter.Report()).To(Succeed())
+
+		// when
+		now = now.Add(30 * time.Second)
+		Expect(reporter.Report()).To(Succeed())
+
+		// then
+		Expect(reporter.Interval()).To(Equal(10 * time.Second))
+	})
+
+	It("should include traffic that was not accepted in the next report", func() {
+		// given
+		Expect(reporter.Report()).To(Succeed())
+		requestsTotal = 10
+		now = now.Add(30 * time.Second)
+		status = http.StatusInternalServerError
+
+		// when
+		err := reporter.Report()
+
+		// then
+		Expect(err).To(MatchError("unexpected status code: 500"))
+
+		// when
+		requestsTotal = 30
+		now = now.Add(30 * time.Second)
+		status = http.StatusOK
+		Expect(reporter.Report()).To(Succeed())
+
+		// then
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[1].Period).To(Equal(60 * time.Second))
+		Expect(requests[1].Clusters).To(Equal(map[string]types.ClusterStats{
+			"backend": {
+				Requests: 30,
+			},
+		}))
+	})
+
+	It("should skip clusters whose counters were reset", func() {
+		// given
+		requestsTotal = 100
+		connectionsTotal = 10
+		Expect(reporter.Report()).To(Succeed())
+
+		// when
+		requestsTotal = 5
+		connectionsTotal = 12
+		now = now.Add(30 * time.Second)
+		Expect(reporter.Report()).To(Succeed())
+
+		// then
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Clusters).To(Equal(map[string]types.ClusterStats{
+			"redis": {
+				Connections: 2,
+			},
+		}))
+	})
+})
@@ -2,15 +2,8 @@ package apply
 
 import (
 	"context"
-	"crypto/tls"
-	"io/ioutil"
-	"net/http"
-	"strings"
-	"time"
-
-	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
-	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
-	"github.com/kumahq/kuma/pkg/util/template"
+	"fmt"
+	"io"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -18,24 +11,35 @@ import (
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/pkg/api-server/definitions"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
-	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 )
 
 const (
-	timeout = 10 * time.Second
+	dryRunNone   = "none"
+	dryRunClient = "client"
+	dryRunServer = "server"
+
+	// maxConflictRetries is the number of times a resource is fetched and applied again with --force
+	// when it was modified concurrently.
+	maxConflictRetries = 5
 )
 
 type applyContext struct {
 	*kumactl_cmd.RootContext
 
 	args struct {
-		file   string
-		vars   map[string]string
-		dryRun bool
+		file     string
+		vars     map[string]string
+		dryRun   string
+		prune    bool
+		selector map[string]string
+		force    bool
 	}
 }
 
@@ -44,89 +48,190 @@ func NewApplyCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "apply",
 		Short: "Create or modify Kuma resources",
-		Long:  `Create or modify Kuma resources.`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			var b []byte
-			var err error
+		Long: `Create or modify Kuma resources.
 
-			if ctx.args.file == "" || ctx.args.file == "-" {
-				b, err = ioutil.ReadAll(cmd.InOrStdin())
-				if err != nil {
-					return err
-				}
-			} else {
-				if strings.HasPrefix(ctx.args.file, "http://") || strings.HasPrefix(ctx.args.file, "https://") {
-					client := &http.Client{
-						Timeout:   timeout,
-						Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
-					}
-					req, err := http.NewRequest("GET", ctx.args.file, nil)
-					if err != nil {
-						return errors.Wrap(err, "error creating new http request")
-					}
-					resp, err := client.Do(req)
-					if err != nil {
-						return errors.Wrap(err, "error with GET http request")
-					}
-					if resp.StatusCode != http.StatusOK {
-						return errors.Wrap(err, "error while retrieving URL")
-					}
-					defer resp.Body.Close()
-					b, err = ioutil.ReadAll(resp.Body)
-					if err != nil {
-						return errors.Wrap(err, "error while reading provided file")
-					}
-				} else {
-					b, err = ioutil.ReadFile(ctx.args.file)
-					if err != nil {
-						return errors.Wrap(err, "error while reading provided file")
-					}
-				}
+Input can be a single file, a directory with .yaml, .yml and .json files or a URL.
+Every input can contain multiple resources separated by "---".`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch ctx.args.dryRun {
+			case dryRunNone, dryRunClient, dryRunServer:
+			default:
+				return errors.Errorf("invalid --dry-run %q, expected one of: %s, %s, %s", ctx.args.dryRun, dryRunNone, dryRunClient, dryRunServer)
+			}
+			if ctx.args.prune && len(ctx.args.selector) == 0 {
+				return errors.New("--prune requires --selector to limit resources that can be deleted")
 			}
 
-			bytes := template.Render(string(b), ctx.args.vars)
-			res, err := rest.UnmarshallToCore(bytes)
+			resources, err := readResources(cmd.InOrStdin(), ctx.args.file, ctx.args.vars)
 			if err != nil {
-				return errors.Wrap(err, "YAML contains invalid resource")
+				return err
 			}
-			if err := mesh.ValidateMeta(res.GetMeta().GetName(), res.GetMeta().GetMesh(), res.Scope()); err.HasViolations() {
-				return err.OrNil()
+
+			switch ctx.args.dryRun {
+			case dryRunClient:
+				return printResources(resources, cmd.OutOrStdout())
+			case dryRunServer:
+				defaulted, err := ctx.dryRunOnServer(resources)
+				if err != nil {
+					return err
+				}
+				return printResources(defaulted, cmd.OutOrStdout())
 			}
 
-			if ctx.args.dryRun {
-				p, err := printers.NewGenericPrinter(output.YAMLFormat)
+			for _, res := range resources {
+				rs, err := ctx.storeFor(res.GetType())
 				if err != nil {
 					return err
 				}
-				if err := p.Print(rest_types.From.Resource(res), cmd.OutOrStdout()); err != nil {
+				if err := upsert(rs, res, ctx.args.force); err != nil {
 					return err
 				}
-				return nil
 			}
 
-			var rs store.ResourceStore
-			if res.GetType() == system.SecretType { // Secret is exposed via Admin Server. It will be merged into API Server eventually.
-				rs, err = pctx.CurrentAdminResourceStore()
+			if ctx.args.prune {
+				return ctx.prune(resources, cmd.OutOrStdout())
+			}
+			return nil
+		},
+	}
+	cmd.PersistentFlags().StringVarP(&ctx.args.file, "file", "f", "", "Path to file, directory or URL to apply")
+	cmd.PersistentFlags().StringToStringVarP(&ctx.args.vars, "var", "v", map[string]string{}, "Variable to replace in configuration")
+	cmd.PersistentFlags().StringVar(&ctx.args.dryRun, "dry-run", dryRunNone, `One of: none, client, server. If client, resolve variables and print the result without applying. If server, defaults and validates resources on the server and prints the result without persisting`)
+	cmd.PersistentFlags().Lookup("dry-run").NoOptDefVal = dryRunClient
+	cmd.PersistentFlags().BoolVar(&ctx.args.prune, "prune", false, "delete resources matching --selector that are not present in the input")
+	cmd.PersistentFlags().StringToStringVarP(&ctx.args.selector, "selector", "l", map[string]string{}, "labels of resources to prune, e.g. --selector team=web")
+	cmd.PersistentFlags().BoolVar(&ctx.args.force, "force", false, "overwrite resources that were modified concurrently")
+	return cmd
+}
+
+func (c *applyContext) storeFor(resType model.ResourceType) (store.ResourceStore, error) {
+	if resType == system.SecretType { // Secret is exposed via Admin Server. It will be merged into API Server eventually.
+		return c.CurrentAdminResourceStore()
+	}
+	return c.CurrentResourceStore()
+}
+
+func (c *applyContext) dryRunOnServer(resources []model.Resource) ([]model.Resource, error) {
+	client, err := c.CurrentResourceDryRunClient()
+	if err != nil {
+		return nil, err
+	}
+	var defaulted []model.Resource
+	for _, res := range resources {
+		if res.GetType() == system.SecretType {
+			return nil, errors.New("server-side dry run is not supported for Secrets")
+		}
+		result, err := client.DryRun(context.Background(), rest_types.From.Resource(res))
+		if err != nil {
+			return nil, err
+		}
+		defaulted = append(defaulted, result)
+	}
+	return defaulted, nil
+}
+
+// prune deletes resources with labels matching the selector that were not applied.
+// Only resources exposed by the API Server for modification are taken into account, Meshes are deleted last.
+func (c *applyContext) prune(applied []model.Resource, out io.Writer) error {
+	rs, err := c.CurrentResourceStore()
+	if err != nil {
+		return err
+	}
+	keep := map[model.ResourceType]map[model.ResourceKey]bool{}
+	for _, res := range applied {
+		if keep[res.GetType()] == nil {
+			keep[res.GetType()] = map[model.ResourceKey]bool{}
+		}
+		keep[res.GetType()][pruneKey(res)] = true
+	}
+
+	var types []model.ResourceType
+	for _, def := range definitions.All {
+		if def.ReadOnly {
+			continue
+		}
+		resType := def.ResourceFactory().GetType()
+		if resType != mesh.MeshType {
+			types = append(types, resType)
+		}
+	}
+	types = append(types, mesh.MeshType)
+
+	for _, resType := range types {
+		list, err := registry.Global().NewList(resType)
+		if err != nil {
+			return err
+		}
+		if err := rs.List(context.Background(), list, store.ListByLabels(c.args.selector)); err != nil {
+			return errors.Wrapf(err, "could not list %s resources to prune", resType)
+		}
+		for _, item := range list.GetItems() {
+			key := pruneKey(item)
+			if keep[resType][key] {
+				continue
+			}
+			if err := rs.Delete(context.Background(), item, store.DeleteBy(model.MetaToResourceKey(item.GetMeta()))); err != nil && !store.IsResourceNotFound(err) {
+				return errors.Wrapf(err, "could not prune %s %q", resType, key.Name)
+			}
+			if item.Scope() == model.ScopeMesh {
+				_, err = fmt.Fprintf(out, "%s %q in mesh %q pruned\n", resType, key.Name, key.Mesh)
 			} else {
-				rs, err = pctx.CurrentResourceStore()
+				_, err = fmt.Fprintf(out, "%s %q pruned\n", resType, key.Name)
 			}
 			if err != nil {
 				return err
 			}
+		}
+	}
+	return nil
+}
 
-			if err := upsert(rs, res); err != nil {
+// pruneKey identifies a resource regardless of how a store fills mesh of resources that are not scoped to a mesh
+func pruneKey(res model.Resource) model.ResourceKey {
+	key := model.MetaToResourceKey(res.GetMeta())
+	if res.Scope() == model.ScopeGlobal {
+		key.Mesh = ""
+	}
+	return key
+}
+
+func printResources(resources []model.Resource, out io.Writer) error {
+	p, err := printers.NewGenericPrinter(output.YAMLFormat)
+	if err != nil {
+		return err
+	}
+	for i, res := range resources {
+		if i > 0 {
+			if _, err := fmt.Fprintln(out, "---"); err != nil {
 				return err
 			}
-			return nil
-		},
+		}
+		if err := p.Print(rest_types.From.Resource(res), out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upsert creates or updates the resource. The update fails when the resource was modified after it was fetched,
+// unless force is set, in which case the resource is fetched and applied again.
+func upsert(rs store.ResourceStore, res model.Resource, force bool) error {
+	for i := 0; ; i++ {
+		err := tryUpsert(rs, res)
+		if !store.IsResourceConflict(err) && !store.IsResourceAlreadyExists(err) {
+			return err
+		}
+		meta := res.GetMeta()
+		if !force {
+			return errors.Errorf("%s %q was modified concurrently, run the command again or use --force to overwrite it", res.GetType(), meta.GetName())
+		}
+		if i == maxConflictRetries {
+			return errors.Wrapf(err, "could not apply %s %q after %d attempts", res.GetType(), meta.GetName(), i+1)
+		}
 	}
-	cmd.PersistentFlags().StringVarP(&ctx.args.file, "file", "f", "", "Path to file to apply")
-	cmd.PersistentFlags().StringToStringVarP(&ctx.args.vars, "var", "v", map[string]string{}, "Variable to replace in configuration")
-	cmd.PersistentFlags().BoolVar(&ctx.args.dryRun, "dry-run", false, "Resolve variable and prints result out without actual applying")
-	return cmd
 }
 
-func upsert(rs store.ResourceStore, res model.Resource) error {
+func tryUpsert(rs store.ResourceStore, res model.Resource) error {
 	newRes, err := registry.Global().NewObject(res.GetType())
 	if err != nil {
 		return err
@@ -134,7 +239,7 @@ func upsert(rs store.ResourceStore, res model.Resource) error {
 	meta := res.GetMeta()
 	if err := rs.Get(context.Background(), newRes, store.GetByKey(meta.GetName(), meta.GetMesh())); err != nil {
 		if store.IsResourceNotFound(err) {
-			return rs.Create(context.Background(), res, store.CreateByKey(meta.GetName(), meta.GetMesh()))
+			return rs.Create(context.Background(), res, store.CreateByKey(meta.GetName(), meta.GetMesh()), store.CreateWithLabels(meta.GetLabels()))
 		} else {
 			return err
 		}
@@ -142,5 +247,5 @@ func upsert(rs store.ResourceStore, res model.Resource) error {
 	if err := newRes.SetSpec(res.GetSpec()); err != nil {
 		return err
 	}
-	return rs.Update(context.Background(), newRes)
+	return rs.Update(context.Background(), newRes, store.UpdateWithLabels(meta.GetLabels()))
 }
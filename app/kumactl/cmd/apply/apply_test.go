@@ -3,6 +3,7 @@ package apply_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -24,8 +25,11 @@ import (
 	"github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/app/kumactl/cmd"
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
 	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
 	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
@@ -33,6 +37,33 @@ import (
 	test_store "github.com/kumahq/kuma/pkg/test/store"
 )
 
+type fakeDryRunClient struct {
+	dryRun *[]string
+}
+
+func (f *fakeDryRunClient) DryRun(_ context.Context, resource *rest.Resource) (core_model.Resource, error) {
+	*f.dryRun = append(*f.dryRun, resource.Meta.Type+"/"+resource.Meta.Name)
+	bytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	return rest.UnmarshallToCore(bytes)
+}
+
+// conflictingStore simulates resources that are modified concurrently between Get and Update.
+type conflictingStore struct {
+	core_store.ResourceStore
+	conflicts int
+}
+
+func (c *conflictingStore) Update(ctx context.Context, res core_model.Resource, fs ...core_store.UpdateOptionsFunc) error {
+	if c.conflicts > 0 {
+		c.conflicts--
+		return core_store.ErrorResourceConflict(res.GetType(), res.GetMeta().GetName(), res.GetMeta().GetMesh())
+	}
+	return c.ResourceStore.Update(ctx, res, fs...)
+}
+
 var _ = Describe("kumactl apply", func() {
 
 	var rootCtx *kumactl_cmd.RootContext
@@ -184,6 +215,50 @@ var _ = Describe("kumactl apply", func() {
 		ValidatePersistedResource()
 	})
 
+	Describe("resources modified concurrently", func() {
+		BeforeEach(func() {
+			err := store.Create(context.Background(), &mesh.DataplaneResource{
+				Spec: v1alpha1.Dataplane{
+					Networking: &v1alpha1.Dataplane_Networking{
+						Address: "8.8.8.8",
+					},
+				},
+			}, core_store.CreateByKey("sample", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			store = &conflictingStore{ResourceStore: store, conflicts: 2}
+		})
+
+		It("should fail without --force", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"apply", "-f", filepath.Join("testdata", "apply-dataplane.yaml")},
+			)
+			rootCmd.SetOut(&bytes.Buffer{})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError(`Dataplane "sample" was modified concurrently, run the command again or use --force to overwrite it`))
+		})
+
+		It("should fetch and apply the resource again with --force", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"apply", "-f", filepath.Join("testdata", "apply-dataplane.yaml"), "--force"},
+			)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			ValidatePersistedResource()
+		})
+	})
+
 	It("should apply a Mesh resource", func() {
 		// given
 		rootCmd.SetArgs([]string{
@@ -406,6 +481,168 @@ type: Dataplane
 		Expect(resource.Spec.Networking.Address).To(Equal("2.2.2.2"))
 	})
 
+	It("should apply multiple resources from a single file", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "-f", filepath.Join("testdata", "apply-multiple.yaml")},
+		)
+
+		// when
+		err := rootCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		meshRes := mesh.MeshResource{}
+		Expect(store.Get(context.Background(), &meshRes, core_store.GetByKey("demo", "demo"))).To(Succeed())
+		Expect(meshRes.Meta.GetLabels()).To(Equal(map[string]string{"team": "web"}))
+		// and
+		dataplane := mesh.DataplaneResource{}
+		Expect(store.Get(context.Background(), &dataplane, core_store.GetByKey("web-01", "demo"))).To(Succeed())
+		Expect(dataplane.Meta.GetLabels()).To(Equal(map[string]string{"team": "web"}))
+		Expect(dataplane.Spec.Networking.Address).To(Equal("2.2.2.2"))
+	})
+
+	It("should apply resources from a directory", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "-f", filepath.Join("testdata", "apply-directory")},
+		)
+
+		// when
+		err := rootCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		Expect(store.Get(context.Background(), &mesh.MeshResource{}, core_store.GetByKey("demo", "demo"))).To(Succeed())
+		Expect(store.Get(context.Background(), &mesh.DataplaneResource{}, core_store.GetByKey("web-01", "demo"))).To(Succeed())
+	})
+
+	It("should prune resources matching the selector that are not in the input", func() {
+		// setup
+		for _, dp := range []struct {
+			name   string
+			labels map[string]string
+		}{
+			{name: "web-02", labels: map[string]string{"team": "web"}},
+			{name: "backend-01", labels: map[string]string{"team": "backend"}},
+			{name: "unlabeled"},
+		} {
+			err := store.Create(context.Background(), &mesh.DataplaneResource{
+				Spec: v1alpha1.Dataplane{
+					Networking: &v1alpha1.Dataplane_Networking{
+						Address: "1.1.1.1",
+					},
+				},
+			}, core_store.CreateByKey(dp.name, "demo"), core_store.CreateWithLabels(dp.labels))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "-f", filepath.Join("testdata", "apply-multiple.yaml"),
+			"--prune", "--selector", "team=web"},
+		)
+		buf := &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+
+		// when
+		err := rootCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`Dataplane "web-02" in mesh "demo" pruned
+`))
+
+		// and
+		list := mesh.DataplaneResourceList{}
+		Expect(store.List(context.Background(), &list, core_store.ListByMesh("demo"))).To(Succeed())
+		var names []string
+		for _, item := range list.Items {
+			names = append(names, item.Meta.GetName())
+		}
+		Expect(names).To(ConsistOf("web-01", "backend-01", "unlabeled"))
+	})
+
+	It("should require a selector to prune", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "-f", filepath.Join("testdata", "apply-multiple.yaml"), "--prune"},
+		)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("--prune requires --selector to limit resources that can be deleted"))
+	})
+
+	It("should print resources defaulted by the server without applying", func() {
+		// setup
+		var dryRun []string
+		rootCtx.Runtime.NewResourceDryRunClient = func(*config_proto.ControlPlaneCoordinates_ApiServer) (resources.ResourceDryRunClient, error) {
+			return &fakeDryRunClient{dryRun: &dryRun}, nil
+		}
+
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "-f", filepath.Join("testdata", "apply-multiple.yaml"), "--dry-run=server"},
+		)
+		buf := &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+
+		// when
+		err := rootCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dryRun).To(Equal([]string{"Mesh/demo", "Dataplane/web-01"}))
+		Expect(buf.String()).To(Equal(`creationTime: "0001-01-01T00:00:00Z"
+labels:
+  team: web
+modificationTime: "0001-01-01T00:00:00Z"
+name: demo
+type: Mesh
+---
+creationTime: "0001-01-01T00:00:00Z"
+labels:
+  team: web
+mesh: demo
+modificationTime: "0001-01-01T00:00:00Z"
+name: web-01
+networking:
+  address: 2.2.2.2
+  inbound:
+  - port: 80
+    servicePort: 8080
+    tags:
+      service: web
+type: Dataplane
+`))
+
+		// and
+		err = store.Get(context.Background(), &mesh.MeshResource{}, core_store.GetByKey("demo", "demo"))
+		Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+	})
+
+	It("should reject an unknown dry run mode", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "-f", filepath.Join("testdata", "apply-multiple.yaml"), "--dry-run=all"},
+		)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`invalid --dry-run "all", expected one of: none, client, server`))
+	})
+
 	type testCase struct {
 		resource string
 		err      string
@@ -0,0 +1,114 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ghodss/yaml"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+func NewDiffCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &applyContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show changes that apply would make to Kuma resources",
+		Long: `Show changes that apply would make to Kuma resources.
+
+Every resource from the input is compared with its current state on the Control Plane and differences are printed in the unified diff format.
+Input can be a single file, a directory with .yaml, .yml and .json files or a URL.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := readResources(cmd.InOrStdin(), ctx.args.file, ctx.args.vars)
+			if err != nil {
+				return err
+			}
+			for _, res := range resources {
+				rs, err := ctx.storeFor(res.GetType())
+				if err != nil {
+					return err
+				}
+				if err := printDiff(rs, res, cmd.OutOrStdout()); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	cmd.PersistentFlags().StringVarP(&ctx.args.file, "file", "f", "", "Path to file, directory or URL to compare")
+	cmd.PersistentFlags().StringToStringVarP(&ctx.args.vars, "var", "v", map[string]string{}, "Variable to replace in configuration")
+	return cmd
+}
+
+func printDiff(rs store.ResourceStore, res model.Resource, out io.Writer) error {
+	meta := res.GetMeta()
+	current, err := registry.Global().NewObject(res.GetType())
+	if err != nil {
+		return err
+	}
+	var currentYAML string
+	if err := rs.Get(context.Background(), current, store.GetByKey(meta.GetName(), meta.GetMesh())); err != nil {
+		if !store.IsResourceNotFound(err) {
+			return err
+		}
+	} else {
+		if currentYAML, err = comparableYAML(current); err != nil {
+			return err
+		}
+	}
+	appliedYAML, err := comparableYAML(res)
+	if err != nil {
+		return err
+	}
+
+	id := string(res.GetType()) + "/" + meta.GetName()
+	if res.Scope() == model.ScopeMesh {
+		id = string(res.GetType()) + "/" + meta.GetMesh() + "/" + meta.GetName()
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(currentYAML),
+		B:        splitLines(appliedYAML),
+		FromFile: "current " + id,
+		ToFile:   "applied " + id,
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(out, diff)
+	return err
+}
+
+// comparableYAML converts a resource to YAML without fields set by the Control Plane, like creation time.
+func comparableYAML(res model.Resource) (string, error) {
+	bytes, err := json.Marshal(rest_types.From.Resource(res))
+	if err != nil {
+		return "", err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(bytes, &fields); err != nil {
+		return "", err
+	}
+	delete(fields, "creationTime")
+	delete(fields, "modificationTime")
+	bytes, err = yaml.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return difflib.SplitLines(s)
+}
@@ -0,0 +1,117 @@
+package apply_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("kumactl diff", func() {
+
+	var rootCmd *cobra.Command
+	var store core_store.ResourceStore
+	BeforeEach(func() {
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				NewResourceStore: func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+					return store, nil
+				},
+			},
+		}
+		store = memory_resources.NewStore()
+		rootCmd = cmd.NewRootCmd(rootCtx)
+	})
+
+	It("should print differences between the input and the current state", func() {
+		// setup
+		err := store.Create(context.Background(), &mesh.DataplaneResource{
+			Spec: v1alpha1.Dataplane{
+				Networking: &v1alpha1.Dataplane_Networking{
+					Address: "1.1.1.1",
+					Inbound: []*v1alpha1.Dataplane_Networking_Inbound{
+						{
+							Port:        80,
+							ServicePort: 8080,
+							Tags: map[string]string{
+								"service": "web",
+							},
+						},
+					},
+				},
+			},
+		}, core_store.CreateByKey("web-01", "demo"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"diff", "-f", filepath.Join("testdata", "apply-directory")},
+		)
+		buf := &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+
+		// when
+		err = rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "diff.golden.txt"))
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(buf.String()).To(Equal(string(expected)))
+	})
+
+	It("should print nothing when resources are up to date", func() {
+		// setup
+		err := store.Create(context.Background(), &mesh.MeshResource{}, core_store.CreateByKey("demo", "demo"))
+		Expect(err).ToNot(HaveOccurred())
+		err = store.Create(context.Background(), &mesh.DataplaneResource{
+			Spec: v1alpha1.Dataplane{
+				Networking: &v1alpha1.Dataplane_Networking{
+					Address: "2.2.2.2",
+					Inbound: []*v1alpha1.Dataplane_Networking_Inbound{
+						{
+							Port:        80,
+							ServicePort: 8080,
+							Tags: map[string]string{
+								"service": "web",
+							},
+						},
+					},
+				},
+			},
+		}, core_store.CreateByKey("web-01", "demo"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"diff", "-f", filepath.Join("testdata", "apply-directory")},
+		)
+		buf := &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+
+		// when
+		err = rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(BeEmpty())
+	})
+})
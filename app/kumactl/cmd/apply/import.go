@@ -0,0 +1,174 @@
+package apply
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd/export"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	provided_config "github.com/kumahq/kuma/pkg/plugins/ca/provided/config"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+type importContext struct {
+	*applyContext
+
+	args struct {
+		targetMesh string
+		rename     map[string]string
+	}
+}
+
+func NewImportCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &importContext{applyContext: &applyContext{RootContext: pctx}}
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import configuration of a mesh",
+		Long: `Import configuration of a mesh exported with "kumactl export".
+
+Resources are created or updated in dependency order: Secrets, the Mesh and then policies.
+Secrets of a builtin CA are skipped, the control plane generates them for the imported Mesh.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			resources, err := parseResources(cmd.InOrStdin(), ctx.applyContext.args.file, nil)
+			if err != nil {
+				return err
+			}
+			resources = skipBuiltinCaSecrets(cmd, resources)
+			if err := validateMeta(resources); err != nil {
+				return err
+			}
+			if resources, err = ctx.remap(resources); err != nil {
+				return err
+			}
+			sortByDependencies(resources)
+			for _, res := range resources {
+				rs, err := ctx.storeFor(res.GetType())
+				if err != nil {
+					return err
+				}
+				if err := upsert(rs, res, ctx.applyContext.args.force); err != nil {
+					return errors.Wrapf(err, "could not import %s %q", res.GetType(), res.GetMeta().GetName())
+				}
+				cmd.Printf("imported %s %q\n", res.GetType(), res.GetMeta().GetName())
+			}
+			return nil
+		},
+	}
+	cmd.PersistentFlags().StringVarP(&ctx.applyContext.args.file, "file", "f", "", "Path to file, directory or URL with exported configuration")
+	cmd.PersistentFlags().StringVar(&ctx.args.targetMesh, "target-mesh", "", "name of the mesh to import configuration into, defaults to the name of the exported mesh")
+	cmd.PersistentFlags().StringToStringVar(&ctx.args.rename, "rename", map[string]string{}, "rename resources, e.g. --rename web-to-backend=web-to-backend-v2")
+	cmd.PersistentFlags().BoolVar(&ctx.applyContext.args.force, "force", false, "overwrite resources that were modified concurrently")
+	return cmd
+}
+
+// remap changes names and mesh of imported resources according to the flags.
+func (c *importContext) remap(resources []model.Resource) ([]model.Resource, error) {
+	if c.args.targetMesh == "" && len(c.args.rename) == 0 {
+		return resources, nil
+	}
+	meshes := map[string]bool{}
+	for _, res := range resources {
+		if res.GetType() == mesh.MeshType {
+			meshes[res.GetMeta().GetName()] = true
+		} else {
+			meshes[res.GetMeta().GetMesh()] = true
+		}
+	}
+	if c.args.targetMesh != "" && len(meshes) > 1 {
+		return nil, errors.New("--target-mesh can be used only when the input contains resources of a single mesh")
+	}
+	for _, res := range resources {
+		meta := res.GetMeta()
+		if meshRes, ok := res.(*mesh.MeshResource); ok {
+			if err := c.remapCaSecrets(meshRes); err != nil {
+				return nil, errors.Wrapf(err, "could not remap CA secrets of Mesh %q", meta.GetName())
+			}
+		}
+		remapped := &rest.ResourceMeta{
+			Type:   string(res.GetType()),
+			Mesh:   meta.GetMesh(),
+			Name:   meta.GetName(),
+			Labels: meta.GetLabels(),
+		}
+		if newName, ok := c.args.rename[meta.GetName()]; ok && res.GetType() != mesh.MeshType {
+			remapped.Name = newName
+		}
+		if c.args.targetMesh != "" {
+			remapped.Mesh = c.args.targetMesh
+			if res.GetType() == mesh.MeshType {
+				remapped.Name = c.args.targetMesh
+			}
+		}
+		res.SetMeta(remapped)
+	}
+	return resources, nil
+}
+
+// skipBuiltinCaSecrets drops secrets of builtin CAs. They are named after the mesh (see pkg/plugins/ca/builtin),
+// so they cannot be created through the API and they would not match a mesh imported with --target-mesh anyway.
+func skipBuiltinCaSecrets(cmd *cobra.Command, resources []model.Resource) []model.Resource {
+	var kept []model.Resource
+	for _, res := range resources {
+		meta := res.GetMeta()
+		if res.GetType() == system.SecretType && strings.HasPrefix(meta.GetName(), meta.GetMesh()+".ca-builtin-") {
+			cmd.Printf("skipped %s %q: secrets of a builtin CA are generated by the control plane\n", res.GetType(), meta.GetName())
+			continue
+		}
+		kept = append(kept, res)
+	}
+	return kept
+}
+
+// remapCaSecrets points provided CA backends of the Mesh to the renamed secrets.
+func (c *importContext) remapCaSecrets(meshRes *mesh.MeshResource) error {
+	for _, backend := range meshRes.Spec.GetMtls().GetBackends() {
+		if backend.GetType() != string(core_plugins.CaProvided) {
+			continue
+		}
+		cfg := &provided_config.ProvidedCertificateAuthorityConfig{}
+		if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+			return errors.Wrapf(err, "could not convert config of backend %q", backend.GetName())
+		}
+		for _, source := range []*system_proto.DataSource{cfg.GetCert(), cfg.GetKey()} {
+			secret, ok := source.GetType().(*system_proto.DataSource_Secret)
+			if !ok {
+				continue
+			}
+			if newName, ok := c.args.rename[secret.Secret]; ok {
+				secret.Secret = newName
+			}
+		}
+		conf, err := util_proto.ToStruct(cfg)
+		if err != nil {
+			return err
+		}
+		backend.Conf = &conf
+	}
+	return nil
+}
+
+// sortByDependencies orders resources as they are exported, so every resource is created after resources it depends on.
+func sortByDependencies(resources []model.Resource) {
+	order := map[model.ResourceType]int{}
+	for i, resType := range export.ExportedTypes() {
+		order[resType] = i + 1
+	}
+	rank := func(res model.Resource) int {
+		if i, ok := order[res.GetType()]; ok {
+			return i
+		}
+		return len(order) + 1
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		return rank(resources[i]) < rank(resources[j])
+	})
+}
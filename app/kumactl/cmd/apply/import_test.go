@@ -0,0 +1,182 @@
+package apply_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/catalog"
+	catalog_client "github.com/kumahq/kuma/pkg/catalog/client"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	provided_config "github.com/kumahq/kuma/pkg/plugins/ca/provided/config"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_catalog "github.com/kumahq/kuma/pkg/test/catalog"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("kumactl import", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var store core_store.ResourceStore
+
+	BeforeEach(func() {
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				NewResourceStore: func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+					return store, nil
+				},
+				NewAdminResourceStore: func(string, *config_proto.Context_AdminApiCredentials) (core_store.ResourceStore, error) {
+					return store, nil
+				},
+				NewCatalogClient: func(string) (catalog_client.CatalogClient, error) {
+					return &test_catalog.StaticCatalogClient{
+						Resp: catalog.Catalog{
+							Apis: catalog.Apis{
+								Admin: catalog.AdminApi{
+									LocalUrl: "http://localhost:1234",
+								},
+							},
+						},
+					}, nil
+				},
+			},
+		}
+		store = memory_resources.NewStore()
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	It("should import resources in dependency order", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"import", "-f", filepath.Join("testdata", "import-bundle.yaml")})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`imported Secret "sec-1"
+imported Mesh "demo"
+imported TrafficPermission "allow-all"
+imported TrafficRoute "web-to-backend"
+`))
+
+		// and
+		secret := system.SecretResource{}
+		Expect(store.Get(context.Background(), &secret, core_store.GetByKey("sec-1", "demo"))).To(Succeed())
+		Expect(secret.Spec.Data.Value).To(Equal([]byte("secret")))
+		Expect(store.Get(context.Background(), &mesh.MeshResource{}, core_store.GetByKey("demo", "demo"))).To(Succeed())
+		Expect(store.Get(context.Background(), &mesh.TrafficPermissionResource{}, core_store.GetByKey("allow-all", "demo"))).To(Succeed())
+		Expect(store.Get(context.Background(), &mesh.TrafficRouteResource{}, core_store.GetByKey("web-to-backend", "demo"))).To(Succeed())
+	})
+
+	It("should import resources into another mesh with new names", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"import", "-f", filepath.Join("testdata", "import-bundle.yaml"),
+			"--target-mesh", "prod", "--rename", "allow-all=allow-everything"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(store.Get(context.Background(), &mesh.MeshResource{}, core_store.GetByKey("prod", "prod"))).To(Succeed())
+		Expect(store.Get(context.Background(), &mesh.TrafficPermissionResource{}, core_store.GetByKey("allow-everything", "prod"))).To(Succeed())
+		Expect(store.Get(context.Background(), &mesh.TrafficRouteResource{}, core_store.GetByKey("web-to-backend", "prod"))).To(Succeed())
+		// and
+		err = store.Get(context.Background(), &mesh.MeshResource{}, core_store.GetByKey("demo", "demo"))
+		Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+	})
+
+	It("should rewrite references to provided CA secrets and skip builtin CA secrets", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"import", "--target-mesh", "prod", "--rename", "ca-cert=prod-ca-cert"})
+		rootCmd.SetIn(strings.NewReader(`
+type: Mesh
+name: demo
+mtls:
+  enabledBackend: ca-1
+  backends:
+  - name: ca-1
+    type: builtin
+  - name: ca-2
+    type: provided
+    conf:
+      cert:
+        secret: ca-cert
+      key:
+        secret: ca-key
+---
+type: Secret
+mesh: demo
+name: demo.ca-builtin-cert-ca-1
+data: Y2VydA==
+---
+type: Secret
+mesh: demo
+name: ca-cert
+data: Y2VydA==
+---
+type: Secret
+mesh: demo
+name: ca-key
+data: a2V5
+`))
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix(`skipped Secret "demo.ca-builtin-cert-ca-1": secrets of a builtin CA are generated by the control plane
+`))
+		Expect(store.Get(context.Background(), &system.SecretResource{}, core_store.GetByKey("prod-ca-cert", "prod"))).To(Succeed())
+		Expect(store.Get(context.Background(), &system.SecretResource{}, core_store.GetByKey("ca-key", "prod"))).To(Succeed())
+
+		// and
+		meshRes := mesh.MeshResource{}
+		Expect(store.Get(context.Background(), &meshRes, core_store.GetByKey("prod", "prod"))).To(Succeed())
+		cfg := provided_config.ProvidedCertificateAuthorityConfig{}
+		Expect(util_proto.ToTyped(meshRes.Spec.Mtls.Backends[1].Conf, &cfg)).To(Succeed())
+		Expect(cfg.GetCert().GetSecret()).To(Equal("prod-ca-cert"))
+		Expect(cfg.GetKey().GetSecret()).To(Equal("ca-key"))
+	})
+
+	It("should not change the mesh of resources from multiple meshes", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"import", "--target-mesh", "prod"})
+		rootCmd.SetIn(strings.NewReader(`
+type: Mesh
+name: demo
+---
+type: Mesh
+name: staging
+`))
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("--target-mesh can be used only when the input contains resources of a single mesh"))
+	})
+})
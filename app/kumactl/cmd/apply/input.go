@@ -0,0 +1,146 @@
+package apply
+
+import (
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/util/template"
+	util_yaml "github.com/kumahq/kuma/pkg/util/yaml"
+)
+
+const (
+	timeout = 10 * time.Second
+)
+
+// readResources reads resources from stdin (empty file or "-"), a URL, a file or every .yaml, .yml and .json file of a directory.
+// Every input can contain multiple resources separated by "---". Meshes are returned first, so resources can be applied in order.
+func readResources(stdin io.Reader, file string, vars map[string]string) ([]model.Resource, error) {
+	resources, err := parseResources(stdin, file, vars)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMeta(resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// parseResources is like readResources, but it does not validate names and meshes of the resources.
+func parseResources(stdin io.Reader, file string, vars map[string]string) ([]model.Resource, error) {
+	inputs, err := readInputs(stdin, file)
+	if err != nil {
+		return nil, err
+	}
+	var resources []model.Resource
+	for _, input := range inputs {
+		rendered := template.Render(string(input), vars)
+		for _, doc := range util_yaml.SplitYAML(string(rendered)) {
+			res, err := rest.UnmarshallToCore([]byte(doc))
+			if err != nil {
+				return nil, errors.Wrap(err, "YAML contains invalid resource")
+			}
+			resources = append(resources, res)
+		}
+	}
+	if len(resources) == 0 {
+		return nil, errors.New("no resources found in the input")
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].GetType() == mesh.MeshType && resources[j].GetType() != mesh.MeshType
+	})
+	return resources, nil
+}
+
+func validateMeta(resources []model.Resource) error {
+	for _, res := range resources {
+		if err := mesh.ValidateMeta(res.GetMeta().GetName(), res.GetMeta().GetMesh(), res.Scope()); err.HasViolations() {
+			return err.OrNil()
+		}
+	}
+	return nil
+}
+
+func readInputs(stdin io.Reader, file string) ([][]byte, error) {
+	if file == "" || file == "-" {
+		b, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{b}, nil
+	}
+	if strings.HasPrefix(file, "http://") || strings.HasPrefix(file, "https://") {
+		b, err := readURL(file)
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{b}, nil
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while reading provided file")
+	}
+	if !info.IsDir() {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrap(err, "error while reading provided file")
+		}
+		return [][]byte{b}, nil
+	}
+	entries, err := ioutil.ReadDir(file) // entries are sorted by name
+	if err != nil {
+		return nil, errors.Wrap(err, "error while reading provided directory")
+	}
+	var inputs [][]byte
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch filepath.Ext(entry.Name()) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+		b, err := ioutil.ReadFile(filepath.Join(file, entry.Name()))
+		if err != nil {
+			return nil, errors.Wrap(err, "error while reading provided file")
+		}
+		inputs = append(inputs, b)
+	}
+	return inputs, nil
+}
+
+func readURL(url string) ([]byte, error) {
+	client := &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating new http request")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "error with GET http request")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("error while retrieving URL, status code %d", resp.StatusCode)
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while reading provided file")
+	}
+	return b, nil
+}
@@ -0,0 +1,114 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+)
+
+type simulateContext struct {
+	*kumactl_cmd.RootContext
+
+	args struct {
+		file         string
+		vars         map[string]string
+		dataplane    string
+		outputFormat string
+	}
+}
+
+func NewSimulateCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &simulateContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Preview Envoy configuration of a Dataplane with candidate policies",
+		Long: `Preview Envoy configuration of a Dataplane with candidate policies.
+
+Envoy listeners, clusters and routes of the Dataplane are generated as if resources from the input were applied,
+without persisting them. Output in the table format includes the difference with the configuration the Dataplane currently has.
+The Dataplane is either an existing one given with --dataplane or a hypothetical one given in the input.
+Input can be a single file, a directory with .yaml, .yml and .json files or a URL.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			resources, err := readResources(cmd.InOrStdin(), ctx.args.file, ctx.args.vars)
+			if err != nil {
+				return err
+			}
+			simulationReq := api_server_types.SimulationRequest{
+				Dataplane: ctx.args.dataplane,
+			}
+			for _, res := range resources {
+				bytes, err := json.Marshal(rest_types.From.Resource(res))
+				if err != nil {
+					return err
+				}
+				simulationReq.Resources = append(simulationReq.Resources, bytes)
+			}
+
+			client, err := ctx.CurrentInspectClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create an inspect client")
+			}
+			simulation, err := client.Simulate(context.Background(), ctx.CurrentMesh(), simulationReq)
+			if err != nil {
+				return err
+			}
+
+			switch format := output.Format(ctx.args.outputFormat); format {
+			case output.TableFormat:
+				return printSimulation(simulation, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(simulation, cmd.OutOrStdout())
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVarP(&ctx.args.file, "file", "f", "", "Path to file, directory or URL with candidate resources")
+	cmd.PersistentFlags().StringToStringVarP(&ctx.args.vars, "var", "v", map[string]string{}, "Variable to replace in configuration")
+	cmd.PersistentFlags().StringVar(&ctx.args.dataplane, "dataplane", "", "name of an existing Dataplane to simulate, omit when a Dataplane is given in the input")
+	cmd.PersistentFlags().StringVarP(&ctx.args.outputFormat, "output", "o", string(output.TableFormat), kuma_cmd.UsageOptions("output format", output.TableFormat, output.YAMLFormat, output.JSONFormat))
+	return cmd
+}
+
+func printSimulation(simulation *api_server_types.Simulation, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"TYPE", "RESOURCES"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(simulation.Resources) <= i {
+					return nil
+				}
+				resources := simulation.Resources[i]
+				return []string{
+					resources.TypeUrl,                      // TYPE
+					table.Number(len(resources.Resources)), // RESOURCES
+				}
+			}
+		}(),
+	}
+	if err := printers.NewTablePrinter().Print(data, out); err != nil {
+		return err
+	}
+	if simulation.Diff == "" {
+		_, err := fmt.Fprintln(out, "\nCandidate resources do not change Envoy configuration of the Dataplane")
+		return err
+	}
+	_, err := fmt.Fprintf(out, "\n%s", simulation.Diff)
+	return err
+}
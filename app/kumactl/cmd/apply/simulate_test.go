@@ -0,0 +1,130 @@
+package apply_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+)
+
+type testSimulateClient struct {
+	resources.InspectClient
+	receivedMesh string
+	receivedReq  api_server_types.SimulationRequest
+	simulation   *api_server_types.Simulation
+}
+
+func (c *testSimulateClient) Simulate(_ context.Context, meshName string, simulationReq api_server_types.SimulationRequest) (*api_server_types.Simulation, error) {
+	c.receivedMesh = meshName
+	c.receivedReq = simulationReq
+	return c.simulation, nil
+}
+
+var _ = Describe("kumactl simulate", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var testClient *testSimulateClient
+
+	BeforeEach(func() {
+		testClient = &testSimulateClient{
+			simulation: &api_server_types.Simulation{
+				Mesh:      "default",
+				Dataplane: "web-01",
+				Resources: []api_server_types.XdsResources{
+					{
+						TypeUrl:   "type.googleapis.com/envoy.api.v2.Cluster",
+						Resources: []json.RawMessage{json.RawMessage(`{"name":"backend-v2"}`)},
+					},
+					{
+						TypeUrl:   "type.googleapis.com/envoy.api.v2.Listener",
+						Resources: []json.RawMessage{json.RawMessage(`{"name":"inbound:192.168.0.1:8080"}`)},
+					},
+				},
+				Diff: `--- live type.googleapis.com/envoy.api.v2.Cluster
++++ simulated type.googleapis.com/envoy.api.v2.Cluster
+@@ -1 +1 @@
+-- name: backend
++- name: backend-v2
+`,
+			},
+		}
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				NewInspectClient: func(*config_proto.ControlPlaneCoordinates_ApiServer) (resources.InspectClient, error) {
+					return testClient, nil
+				},
+			},
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	It("should simulate configuration of a dataplane with candidate resources", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"simulate", "--dataplane", "web-01", "-f", filepath.Join("testdata", "simulate-traffic-route.yaml")})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testClient.receivedMesh).To(Equal("default"))
+		Expect(testClient.receivedReq.Dataplane).To(Equal("web-01"))
+		Expect(testClient.receivedReq.Resources).To(HaveLen(1))
+		res := map[string]interface{}{}
+		Expect(json.Unmarshal(testClient.receivedReq.Resources[0], &res)).To(Succeed())
+		Expect(res["type"]).To(Equal("TrafficRoute"))
+		Expect(res["name"]).To(Equal("route-v2"))
+
+		// and
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "simulate.golden.txt"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(string(expected)))
+	})
+
+	It("should print simulated configuration in the YAML format", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"simulate", "--dataplane", "web-01", "-f", filepath.Join("testdata", "simulate-traffic-route.yaml"), "-o", "yaml"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "simulate.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(MatchYAML(string(expected)))
+	})
+
+	It("should report when candidate resources do not change configuration", func() {
+		// given
+		testClient.simulation.Diff = ""
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"simulate", "--dataplane", "web-01", "-f", filepath.Join("testdata", "simulate-traffic-route.yaml")})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("Candidate resources do not change Envoy configuration of the Dataplane"))
+	})
+})
@@ -16,6 +16,11 @@ func newConfigControlPlanesAddCmd(pctx *kumactl_cmd.RootContext) *cobra.Command
 		overwrite       bool
 		adminClientCert string
 		adminClientKey  string
+		clientCertFile  string
+		clientKeyFile   string
+		authToken       string
+		username        string
+		password        string
 	}{}
 	cmd := &cobra.Command{
 		Use:   "add",
@@ -26,11 +31,23 @@ func newConfigControlPlanesAddCmd(pctx *kumactl_cmd.RootContext) *cobra.Command
 				Name: args.name,
 				Coordinates: &config_proto.ControlPlaneCoordinates{
 					ApiServer: &config_proto.ControlPlaneCoordinates_ApiServer{
-						Url: args.apiServerURL,
+						Url:            args.apiServerURL,
+						ClientCertFile: args.clientCertFile,
+						ClientKeyFile:  args.clientKeyFile,
+						AuthToken:      args.authToken,
+						Username:       args.username,
+						Password:       args.password,
 					},
 				},
 			}
 
+			if (args.clientCertFile == "") != (args.clientKeyFile == "") {
+				return errors.New("both --client-cert-file and --client-key-file have to be provided")
+			}
+			if args.authToken != "" && args.username != "" {
+				return errors.New("--auth-token cannot be used together with --username")
+			}
+
 			cfg := pctx.Config()
 			if err := cp.Validate(); err != nil {
 				return errors.Wrapf(err, "Control Plane configuration is not valid")
@@ -74,5 +91,10 @@ func newConfigControlPlanesAddCmd(pctx *kumactl_cmd.RootContext) *cobra.Command
 	cmd.Flags().BoolVar(&args.overwrite, "overwrite", false, "overwrite existing Control Plane with the same reference name")
 	cmd.Flags().StringVar(&args.adminClientCert, "admin-client-cert", "", "Path to certificate of a client that is authorized to use Admin Server")
 	cmd.Flags().StringVar(&args.adminClientKey, "admin-client-key", "", "Path to certificate key of a client that is authorized to use Admin Server")
+	cmd.Flags().StringVar(&args.clientCertFile, "client-cert-file", "", "Path to certificate of a client used to authenticate to the API Server")
+	cmd.Flags().StringVar(&args.clientKeyFile, "client-key-file", "", "Path to certificate key of a client used to authenticate to the API Server")
+	cmd.Flags().StringVar(&args.authToken, "auth-token", "", "User Token used to authenticate to the API Server")
+	cmd.Flags().StringVar(&args.username, "username", "", "Name of a user used to authenticate to the API Server with Basic authentication")
+	cmd.Flags().StringVar(&args.password, "password", "", "Password of a user used to authenticate to the API Server with Basic authentication")
 	return cmd
 }
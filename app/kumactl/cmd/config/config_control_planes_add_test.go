@@ -168,6 +168,7 @@ var _ = Describe("kumactl config control-planes add", func() {
 			goldenFile  string
 			expectedOut string
 			overwrite   bool
+			extraArgs   []string
 		}
 
 		DescribeTable("should add a new Control Plane by name and address",
@@ -191,6 +192,7 @@ var _ = Describe("kumactl config control-planes add", func() {
 				if given.overwrite {
 					args = append(args, "--overwrite")
 				}
+				args = append(args, given.extraArgs...)
 
 				// given
 				rootCmd.SetArgs(args)
@@ -244,6 +246,21 @@ switched active Control Plane to "example"
 `,
 				overwrite: true,
 			}),
+			Entry("should add a Control Plane with API Server credentials", testCase{
+				configFile: "config-control-planes-add.01.initial.yaml",
+				goldenFile: "config-control-planes-add.04.golden.yaml",
+				expectedOut: `
+added Control Plane "example"
+switched active Control Plane to "example"
+`,
+				overwrite: false,
+				extraArgs: []string{
+					"--client-cert-file", "/tmp/user.pem",
+					"--client-key-file", "/tmp/user.key.pem",
+					"--username", "john",
+					"--password", "secret",
+				},
+			}),
 		)
 	})
 })
@@ -48,19 +48,25 @@ func NewDeleteCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 				resourceType = mesh.FaultInjectionType
 			case "circuit-breaker":
 				resourceType = mesh.CircuitBreakerType
+			case "gateway-listener":
+				resourceType = mesh.GatewayListenerType
+			case "gateway-route":
+				resourceType = mesh.GatewayRouteType
 			case "secret":
 				resourceType = system.SecretType
 			case "zone":
 				resourceType = system.ZoneType
+			case "role":
+				resourceType = system.RoleType
 			default:
-				return errors.Errorf("unknown TYPE: %s. Allowed values: mesh, dataplane, healthcheck, proxytemplate, traffic-log, traffic-permission, traffic-route, traffic-trace, fault-injection, circuit-breaker, secret, zone", resourceTypeArg)
+				return errors.Errorf("unknown TYPE: %s. Allowed values: mesh, dataplane, healthcheck, proxytemplate, traffic-log, traffic-permission, traffic-route, traffic-trace, fault-injection, circuit-breaker, gateway-listener, gateway-route, secret, zone, role", resourceTypeArg)
 			}
 
 			currentMesh := pctx.CurrentMesh()
 			switch resourceType {
 			case mesh.MeshType:
 				currentMesh = name
-			case system.ZoneType:
+			case system.ZoneType, system.RoleType:
 				currentMesh = "default"
 			}
 
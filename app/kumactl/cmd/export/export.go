@@ -0,0 +1,156 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/pkg/api-server/definitions"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+const pageSize = 100
+
+type exportContext struct {
+	*kumactl_cmd.RootContext
+
+	args struct {
+		includeSecrets bool
+	}
+}
+
+func NewExportCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &exportContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export configuration of a mesh",
+		Long: `Export configuration of a mesh.
+
+The Mesh and all its policies are printed as YAML documents separated by "---", which can be recreated on another Control Plane with "kumactl import".
+Dataplanes are not exported, they are registered by data plane proxies.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			resources, err := ctx.meshResources(ctx.CurrentMesh())
+			if err != nil {
+				return err
+			}
+			return printResources(resources, cmd.OutOrStdout())
+		},
+	}
+	cmd.PersistentFlags().BoolVar(&ctx.args.includeSecrets, "include-secrets", false, "export Secrets of the mesh")
+	return cmd
+}
+
+// ExportedTypes returns types of resources that belong to a mesh configuration, in order in which they can be created.
+// Types are taken from definitions of the API Server, so new policies are exported without changes in kumactl.
+func ExportedTypes() []model.ResourceType {
+	types := []model.ResourceType{system.SecretType, mesh.MeshType}
+	for _, def := range definitions.All {
+		res := def.ResourceFactory()
+		if def.ReadOnly || res.Scope() != model.ScopeMesh {
+			continue
+		}
+		switch res.GetType() {
+		case mesh.MeshType, mesh.DataplaneType:
+			continue
+		}
+		types = append(types, res.GetType())
+	}
+	return types
+}
+
+func (c *exportContext) meshResources(meshName string) ([]model.Resource, error) {
+	rs, err := c.CurrentResourceStore()
+	if err != nil {
+		return nil, err
+	}
+	meshRes := &mesh.MeshResource{}
+	if err := rs.Get(context.Background(), meshRes, store.GetByKey(meshName, meshName)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return nil, errors.Errorf("there is no Mesh with name %q", meshName)
+		}
+		return nil, err
+	}
+
+	var resources []model.Resource
+	for _, resType := range ExportedTypes() {
+		switch resType {
+		case mesh.MeshType:
+			resources = append(resources, meshRes)
+			continue
+		case system.SecretType:
+			if !c.args.includeSecrets {
+				continue
+			}
+			adminStore, err := c.CurrentAdminResourceStore()
+			if err != nil {
+				return nil, err
+			}
+			secrets, err := listAll(adminStore, resType, meshName)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, secrets...)
+			continue
+		}
+		items, err := listAll(rs, resType, meshName)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, items...)
+	}
+	return resources, nil
+}
+
+// listAll lists resources of a given type in a mesh page by page, sorted by name.
+func listAll(rs store.ResourceStore, resType model.ResourceType, meshName string) ([]model.Resource, error) {
+	var resources []model.Resource
+	offset := ""
+	for {
+		list, err := registry.Global().NewList(resType)
+		if err != nil {
+			return nil, err
+		}
+		if err := rs.List(context.Background(), list, store.ListByMesh(meshName), store.ListByPage(pageSize, offset)); err != nil {
+			return nil, errors.Wrapf(err, "failed to list %s", resType)
+		}
+		resources = append(resources, list.GetItems()...)
+		offset = list.GetPagination().GetNextOffset()
+		if offset == "" {
+			break
+		}
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].GetMeta().GetName() < resources[j].GetMeta().GetName()
+	})
+	return resources, nil
+}
+
+func printResources(resources []model.Resource, out io.Writer) error {
+	p, err := printers.NewGenericPrinter(output.YAMLFormat)
+	if err != nil {
+		return err
+	}
+	for i, res := range resources {
+		if i > 0 {
+			if _, err := fmt.Fprintln(out, "---"); err != nil {
+				return err
+			}
+		}
+		if err := p.Print(rest_types.From.Resource(res), out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
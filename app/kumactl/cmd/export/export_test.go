@@ -0,0 +1,185 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/catalog"
+	catalog_client "github.com/kumahq/kuma/pkg/catalog/client"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_catalog "github.com/kumahq/kuma/pkg/test/catalog"
+)
+
+var _ = Describe("kumactl export", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var store core_store.ResourceStore
+
+	BeforeEach(func() {
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				NewResourceStore: func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+					return store, nil
+				},
+				NewAdminResourceStore: func(string, *config_proto.Context_AdminApiCredentials) (core_store.ResourceStore, error) {
+					return store, nil
+				},
+				NewCatalogClient: func(string) (catalog_client.CatalogClient, error) {
+					return &test_catalog.StaticCatalogClient{
+						Resp: catalog.Catalog{
+							Apis: catalog.Apis{
+								Admin: catalog.AdminApi{
+									LocalUrl: "http://localhost:1234",
+								},
+							},
+						},
+					}, nil
+				},
+			},
+		}
+		store = memory_resources.NewStore()
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+
+		resources := []struct {
+			res  core_model.Resource
+			name string
+			mesh string
+		}{
+			{
+				res:  &mesh.MeshResource{},
+				name: "demo",
+				mesh: "demo",
+			},
+			{
+				res:  &mesh.MeshResource{},
+				name: "other",
+				mesh: "other",
+			},
+			{
+				res: &mesh.TrafficPermissionResource{
+					Spec: mesh_proto.TrafficPermission{
+						Sources:      []*mesh_proto.Selector{{Match: map[string]string{"service": "*"}}},
+						Destinations: []*mesh_proto.Selector{{Match: map[string]string{"service": "*"}}},
+					},
+				},
+				name: "allow-all",
+				mesh: "demo",
+			},
+			{
+				res: &mesh.TrafficPermissionResource{
+					Spec: mesh_proto.TrafficPermission{
+						Sources:      []*mesh_proto.Selector{{Match: map[string]string{"service": "*"}}},
+						Destinations: []*mesh_proto.Selector{{Match: map[string]string{"service": "*"}}},
+					},
+				},
+				name: "allow-all",
+				mesh: "other",
+			},
+			{
+				res: &mesh.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Sources:      []*mesh_proto.Selector{{Match: map[string]string{"service": "web"}}},
+						Destinations: []*mesh_proto.Selector{{Match: map[string]string{"service": "backend"}}},
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{
+							{Weight: 100, Destination: map[string]string{"service": "backend"}},
+						},
+					},
+				},
+				name: "web-to-backend",
+				mesh: "demo",
+			},
+			{
+				res: &mesh.DataplaneResource{
+					Spec: mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "1.1.1.1",
+						},
+					},
+				},
+				name: "web-01",
+				mesh: "demo",
+			},
+			{
+				res: &system.SecretResource{
+					Spec: system_proto.Secret{
+						Data: &wrappers.BytesValue{
+							Value: []byte("secret"),
+						},
+					},
+				},
+				name: "sec-1",
+				mesh: "demo",
+			},
+		}
+		for _, r := range resources {
+			err := store.Create(context.Background(), r.res, core_store.CreateByKey(r.name, r.mesh))
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	type testCase struct {
+		args       []string
+		goldenFile string
+	}
+
+	DescribeTable("should export configuration of a mesh",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"export", "--mesh", "demo"}, given.args...))
+
+			// when
+			err := rootCmd.Execute()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", given.goldenFile))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(buf.String()).To(Equal(string(expected)))
+		},
+		Entry("without secrets", testCase{
+			goldenFile: "export.golden.yaml",
+		}),
+		Entry("with secrets", testCase{
+			args:       []string{"--include-secrets"},
+			goldenFile: "export-with-secrets.golden.yaml",
+		}),
+	)
+
+	It("should fail when mesh does not exist", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"export", "--mesh", "non-existing"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`there is no Mesh with name "non-existing"`))
+	})
+})
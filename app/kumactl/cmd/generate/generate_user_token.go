@@ -0,0 +1,57 @@
+package generate
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+type generateUserTokenContext struct {
+	*kumactl_cmd.RootContext
+
+	args struct {
+		name     string
+		groups   []string
+		validFor time.Duration
+	}
+}
+
+func NewGenerateUserTokenCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &generateUserTokenContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "user-token",
+		Short: "Generate User Token",
+		Long:  `Generate User Token that is used to authenticate to the API Server.`,
+		Example: `
+Generate token for a user
+$ kumactl generate user-token --name john
+
+Generate token for a user that belongs to groups
+$ kumactl generate user-token --name john --group operators,developers
+
+Generate token for a user that expires after 7 days
+$ kumactl generate user-token --name john --valid-for 168h
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := pctx.CurrentUserTokenClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create user token client")
+			}
+
+			token, err := client.Generate(ctx.args.name, ctx.args.groups, ctx.args.validFor)
+			if err != nil {
+				return errors.Wrap(err, "failed to generate a user token")
+			}
+			_, err = cmd.OutOrStdout().Write([]byte(token))
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&ctx.args.name, "name", "", "name of the user (required)")
+	_ = cmd.MarkFlagRequired("name")
+	cmd.Flags().StringSliceVar(&ctx.args.groups, "group", nil, "groups of the user")
+	cmd.Flags().DurationVar(&ctx.args.validFor, "valid-for", 24*time.Hour, "how long the token is valid, e.g. 24h")
+	return cmd
+}
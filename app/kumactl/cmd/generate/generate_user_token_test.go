@@ -0,0 +1,106 @@
+package generate_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	"github.com/kumahq/kuma/pkg/catalog"
+	catalog_client "github.com/kumahq/kuma/pkg/catalog/client"
+	config_kumactl "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	test_catalog "github.com/kumahq/kuma/pkg/test/catalog"
+)
+
+type staticUserTokenGenerator struct {
+	err error
+}
+
+var _ tokens.UserTokenClient = &staticUserTokenGenerator{}
+
+func (s *staticUserTokenGenerator) Generate(name string, groups []string, validFor time.Duration) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	return fmt.Sprintf("token-for-%s-%s-%s", name, strings.Join(groups, ","), validFor), nil
+}
+
+var _ = Describe("kumactl generate user-token", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var generator *staticUserTokenGenerator
+
+	BeforeEach(func() {
+		generator = &staticUserTokenGenerator{}
+		ctx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				NewUserTokenClient: func(string, *config_kumactl.Context_AdminApiCredentials) (tokens.UserTokenClient, error) {
+					return generator, nil
+				},
+				NewCatalogClient: func(s string) (catalog_client.CatalogClient, error) {
+					return &test_catalog.StaticCatalogClient{
+						Resp: catalog.Catalog{
+							Apis: catalog.Apis{
+								Admin: catalog.AdminApi{
+									LocalUrl: "http://localhost:1234",
+								},
+							},
+						},
+					}, nil
+				},
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(ctx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	It("should generate a token", func() {
+		// when
+		rootCmd.SetArgs([]string{"generate", "user-token", "--name=john", "--group=operators,developers"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		Expect(buf.String()).To(Equal("token-for-john-operators,developers-24h0m0s"))
+	})
+
+	It("should generate a token with given validity", func() {
+		// when
+		rootCmd.SetArgs([]string{"generate", "user-token", "--name=john", "--valid-for=1h"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		Expect(buf.String()).To(Equal("token-for-john--1h0m0s"))
+	})
+
+	It("should write error when generating token fails", func() {
+		// setup
+		generator.err = errors.New("could not connect to API")
+
+		// when
+		rootCmd.SetArgs([]string{"generate", "user-token", "--name=john"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(HaveOccurred())
+
+		// and
+		Expect(buf.String()).To(Equal("Error: failed to generate a user token: could not connect to API\n"))
+	})
+})
@@ -1,11 +1,18 @@
 package get
 
 import (
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output"
 	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 )
 
 type getContext struct {
@@ -13,15 +20,42 @@ type getContext struct {
 
 	args struct {
 		outputFormat string
+		watch        bool
 	}
 }
 
 type listContext struct {
 	*getContext
 	args struct {
-		size   int
-		offset string
+		size          int
+		offset        string
+		namePrefix    string
+		tags          []string
+		modifiedSince string
+	}
+}
+
+// listOptions returns given options extended with pagination and filters from the flags
+func (c *listContext) listOptions(fs ...core_store.ListOptionsFunc) ([]core_store.ListOptionsFunc, error) {
+	fs = append(fs, core_store.ListByPage(c.args.size, c.args.offset))
+	if c.args.namePrefix != "" {
+		fs = append(fs, core_store.ListByNamePrefix(c.args.namePrefix))
+	}
+	for _, value := range c.args.tags {
+		tag, err := core_store.ParseTagFilter(value)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, core_store.ListByTags(tag))
 	}
+	if c.args.modifiedSince != "" {
+		since, err := time.Parse(time.RFC3339, c.args.modifiedSince)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid --modified-since, expected time in RFC 3339 format")
+		}
+		fs = append(fs, core_store.ListModifiedSince(since))
+	}
+	return fs, nil
 }
 
 func NewGetCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
@@ -35,36 +69,60 @@ func NewGetCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.PersistentFlags().StringVarP(&ctx.args.outputFormat, "output", "o", string(output.TableFormat), kuma_cmd.UsageOptions("output format", output.TableFormat, output.YAMLFormat, output.JSONFormat))
 	// sub-commands
 	listCtx := &listContext{getContext: ctx}
-	cmd.AddCommand(withPaginationArgs(newGetMeshesCmd(listCtx), listCtx))
-	cmd.AddCommand(withPaginationArgs(newGetDataplanesCmd(listCtx), listCtx))
-	cmd.AddCommand(withPaginationArgs(newGetHealthChecksCmd(listCtx), listCtx))
-	cmd.AddCommand(withPaginationArgs(newGetProxyTemplatesCmd(listCtx), listCtx))
-	cmd.AddCommand(withPaginationArgs(newGetTrafficPermissionsCmd(listCtx), listCtx))
-	cmd.AddCommand(withPaginationArgs(newGetTrafficRoutesCmd(listCtx), listCtx))
-	cmd.AddCommand(withPaginationArgs(newGetTrafficLogsCmd(listCtx), listCtx))
-	cmd.AddCommand(withPaginationArgs(newGetTrafficTracesCmd(listCtx), listCtx))
-	cmd.AddCommand(withPaginationArgs(newGetFaultInjectionsCmd(listCtx), listCtx))
-	cmd.AddCommand(withPaginationArgs(newGetCircuitBreakersCmd(listCtx), listCtx))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetMeshesCmd(listCtx), listCtx), ctx, mesh.MeshType))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetDataplanesCmd(listCtx), listCtx), ctx, mesh.DataplaneType))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetHealthChecksCmd(listCtx), listCtx), ctx, mesh.HealthCheckType))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetProxyTemplatesCmd(listCtx), listCtx), ctx, mesh.ProxyTemplateType))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetTrafficPermissionsCmd(listCtx), listCtx), ctx, mesh.TrafficPermissionType))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetTrafficRoutesCmd(listCtx), listCtx), ctx, mesh.TrafficRouteType))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetTrafficLogsCmd(listCtx), listCtx), ctx, mesh.TrafficLogType))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetTrafficTracesCmd(listCtx), listCtx), ctx, mesh.TrafficTraceType))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetFaultInjectionsCmd(listCtx), listCtx), ctx, mesh.FaultInjectionType))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetCircuitBreakersCmd(listCtx), listCtx), ctx, mesh.CircuitBreakerType))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetGatewayListenersCmd(listCtx), listCtx), ctx, mesh.GatewayListenerType))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetGatewayRoutesCmd(listCtx), listCtx), ctx, mesh.GatewayRouteType))
 	cmd.AddCommand(newGetSecretsCmd(ctx))
-	cmd.AddCommand(withPaginationArgs(newGetZonesCmd(listCtx), listCtx))
+	cmd.AddCommand(withWatchArgs(withListArgs(newGetZonesCmd(listCtx), listCtx), ctx, system.ZoneType))
 
-	cmd.AddCommand(newGetMeshCmd(ctx))
-	cmd.AddCommand(newGetDataplaneCmd(ctx))
-	cmd.AddCommand(newGetHealthCheckCmd(ctx))
-	cmd.AddCommand(newGetProxyTemplateCmd(ctx))
-	cmd.AddCommand(newGetTrafficLogCmd(ctx))
-	cmd.AddCommand(newGetTrafficPermissionCmd(ctx))
-	cmd.AddCommand(newGetTrafficRouteCmd(ctx))
-	cmd.AddCommand(newGetTrafficTraceCmd(ctx))
-	cmd.AddCommand(newGetFaultInjectionCmd(ctx))
-	cmd.AddCommand(newGetCircuitBreakerCmd(ctx))
+	cmd.AddCommand(withWatchArgs(newGetMeshCmd(ctx), ctx, mesh.MeshType))
+	cmd.AddCommand(withWatchArgs(newGetDataplaneCmd(ctx), ctx, mesh.DataplaneType))
+	cmd.AddCommand(withWatchArgs(newGetHealthCheckCmd(ctx), ctx, mesh.HealthCheckType))
+	cmd.AddCommand(withWatchArgs(newGetProxyTemplateCmd(ctx), ctx, mesh.ProxyTemplateType))
+	cmd.AddCommand(withWatchArgs(newGetTrafficLogCmd(ctx), ctx, mesh.TrafficLogType))
+	cmd.AddCommand(withWatchArgs(newGetTrafficPermissionCmd(ctx), ctx, mesh.TrafficPermissionType))
+	cmd.AddCommand(withWatchArgs(newGetTrafficRouteCmd(ctx), ctx, mesh.TrafficRouteType))
+	cmd.AddCommand(withWatchArgs(newGetTrafficTraceCmd(ctx), ctx, mesh.TrafficTraceType))
+	cmd.AddCommand(withWatchArgs(newGetFaultInjectionCmd(ctx), ctx, mesh.FaultInjectionType))
+	cmd.AddCommand(withWatchArgs(newGetCircuitBreakerCmd(ctx), ctx, mesh.CircuitBreakerType))
+	cmd.AddCommand(withWatchArgs(newGetGatewayListenerCmd(ctx), ctx, mesh.GatewayListenerType))
+	cmd.AddCommand(withWatchArgs(newGetGatewayRouteCmd(ctx), ctx, mesh.GatewayRouteType))
 	cmd.AddCommand(newGetSecretCmd(ctx))
-	cmd.AddCommand(newGetZoneCmd(ctx))
+	cmd.AddCommand(withWatchArgs(newGetZoneCmd(ctx), ctx, system.ZoneType))
 	return cmd
 }
 
-func withPaginationArgs(cmd *cobra.Command, ctx *listContext) *cobra.Command {
+func withListArgs(cmd *cobra.Command, ctx *listContext) *cobra.Command {
 	cmd.PersistentFlags().IntVarP(&ctx.args.size, "size", "", 0, "maximum number of elements to return")
 	cmd.PersistentFlags().StringVarP(&ctx.args.offset, "offset", "", "", "the offset that indicates starting element of the resources list to retrieve")
+	cmd.PersistentFlags().StringVarP(&ctx.args.namePrefix, "name-prefix", "", "", "show only resources which name starts with the prefix")
+	cmd.PersistentFlags().StringArrayVarP(&ctx.args.tags, "tag", "", nil, "show only resources with the tag in key:value, key:value1,value2, key:!value1,value2 or key:* format. The flag can be repeated")
+	cmd.PersistentFlags().StringVarP(&ctx.args.modifiedSince, "modified-since", "", "", "show only resources modified after the time in RFC 3339 format, e.g. 2020-01-02T15:04:05Z")
+	return cmd
+}
+
+// withWatchArgs adds --watch flag. When it is set, the command streams changes of resources instead of printing them once.
+func withWatchArgs(cmd *cobra.Command, ctx *getContext, resourceType core_model.ResourceType) *cobra.Command {
+	cmd.PersistentFlags().BoolVarP(&ctx.args.watch, "watch", "w", false, "stream changes of the resources until interrupted")
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if !ctx.args.watch {
+			return runE(cmd, args)
+		}
+		name := ""
+		if len(args) > 0 {
+			name = args[0]
+		}
+		return watchResources(ctx, resourceType, name, cmd.OutOrStdout())
+	}
 	return cmd
 }
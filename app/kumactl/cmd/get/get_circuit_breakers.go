@@ -29,7 +29,11 @@ func newGetCircuitBreakersCmd(pctx *listContext) *cobra.Command {
 			}
 
 			circuitBreakers := mesh.CircuitBreakerResourceList{}
-			if err := rs.List(context.Background(), &circuitBreakers, core_store.ListByMesh(pctx.CurrentMesh()), core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+			listOpts, err := pctx.listOptions(core_store.ListByMesh(pctx.CurrentMesh()))
+			if err != nil {
+				return err
+			}
+			if err := rs.List(context.Background(), &circuitBreakers, listOpts...); err != nil {
 				return errors.Wrapf(err, "failed to list CircuitBreaker")
 			}
 
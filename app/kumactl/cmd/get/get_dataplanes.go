@@ -28,7 +28,11 @@ func newGetDataplanesCmd(pctx *listContext) *cobra.Command {
 			}
 
 			dataplanes := mesh.DataplaneResourceList{}
-			if err := rs.List(context.Background(), &dataplanes, core_store.ListByMesh(pctx.CurrentMesh()), core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+			listOpts, err := pctx.listOptions(core_store.ListByMesh(pctx.CurrentMesh()))
+			if err != nil {
+				return err
+			}
+			if err := rs.List(context.Background(), &dataplanes, listOpts...); err != nil {
 				return errors.Wrapf(err, "failed to list Dataplanes")
 			}
 
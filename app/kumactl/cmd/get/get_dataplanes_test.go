@@ -125,6 +125,7 @@ var _ = Describe("kumactl get dataplanes", func() {
 		type testCase struct {
 			outputFormat string
 			pagination   string
+			filters      []string
 			goldenFile   string
 			matcher      func(interface{}) gomega_types.GomegaMatcher
 		}
@@ -134,7 +135,7 @@ var _ = Describe("kumactl get dataplanes", func() {
 				// given
 				rootCmd.SetArgs(append([]string{
 					"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
-					"get", "dataplanes"}, given.outputFormat, given.pagination))
+					"get", "dataplanes", given.outputFormat, given.pagination}, given.filters...))
 
 				// when
 				err := rootCmd.Execute()
@@ -170,6 +171,22 @@ var _ = Describe("kumactl get dataplanes", func() {
 					return WithTransform(strings.TrimSpace, Equal(strings.TrimSpace(string(expected.([]byte)))))
 				},
 			}),
+			Entry("should support filtering by tag", testCase{
+				outputFormat: "-otable",
+				goldenFile:   "get-dataplanes.filtered.golden.txt",
+				filters:      []string{"--tag=service:web,backend", "--tag=version:!v1"},
+				matcher: func(expected interface{}) gomega_types.GomegaMatcher {
+					return WithTransform(strings.TrimSpace, Equal(strings.TrimSpace(string(expected.([]byte)))))
+				},
+			}),
+			Entry("should support filtering by name prefix", testCase{
+				outputFormat: "-otable",
+				goldenFile:   "get-dataplanes.filtered.golden.txt",
+				filters:      []string{"--name-prefix=exa"},
+				matcher: func(expected interface{}) gomega_types.GomegaMatcher {
+					return WithTransform(strings.TrimSpace, Equal(strings.TrimSpace(string(expected.([]byte)))))
+				},
+			}),
 			Entry("should support JSON output", testCase{
 				outputFormat: "-ojson",
 				goldenFile:   "get-dataplanes.golden.json",
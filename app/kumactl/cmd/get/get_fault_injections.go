@@ -29,7 +29,11 @@ func newGetFaultInjectionsCmd(pctx *listContext) *cobra.Command {
 			}
 
 			faultInjections := mesh.FaultInjectionResourceList{}
-			if err := rs.List(context.Background(), &faultInjections, core_store.ListByMesh(pctx.CurrentMesh()), core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+			listOpts, err := pctx.listOptions(core_store.ListByMesh(pctx.CurrentMesh()))
+			if err != nil {
+				return err
+			}
+			if err := rs.List(context.Background(), &faultInjections, listOpts...); err != nil {
 				return errors.Wrapf(err, "failed to list FaultInjection")
 			}
 
@@ -0,0 +1,77 @@
+package get
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+func newGetGatewayListenersCmd(pctx *listContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway-listeners",
+		Short: "Show GatewayListeners",
+		Long:  `Show GatewayListener entities.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+
+			gatewayListeners := mesh.GatewayListenerResourceList{}
+			listOpts, err := pctx.listOptions(core_store.ListByMesh(pctx.CurrentMesh()))
+			if err != nil {
+				return err
+			}
+			if err := rs.List(context.Background(), &gatewayListeners, listOpts...); err != nil {
+				return errors.Wrapf(err, "failed to list GatewayListener")
+			}
+
+			switch format := output.Format(pctx.getContext.args.outputFormat); format {
+			case output.TableFormat:
+				return printGatewayListeners(pctx.Now(), &gatewayListeners, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(rest_types.From.ResourceList(&gatewayListeners), cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func printGatewayListeners(rootTime time.Time, gatewayListeners *mesh.GatewayListenerResourceList, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"MESH", "NAME", "AGE"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(gatewayListeners.Items) <= i {
+					return nil
+				}
+				gatewayListener := gatewayListeners.Items[i]
+
+				return []string{
+					gatewayListener.GetMeta().GetMesh(),                                        // MESH
+					gatewayListener.GetMeta().GetName(),                                        // NAME
+					table.TimeSince(gatewayListener.GetMeta().GetModificationTime(), rootTime), // AGE
+				}
+			}
+		}(),
+		Footer: table.PaginationFooter(gatewayListeners),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
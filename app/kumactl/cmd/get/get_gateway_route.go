@@ -0,0 +1,52 @@
+package get
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+func newGetGatewayRouteCmd(pctx *getContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway-route NAME",
+		Short: "Show a single GatewayRoute resource",
+		Long:  `Show a single GatewayRoute resource.`,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			name := args[0]
+			currentMesh := pctx.CurrentMesh()
+			gatewayRoute := &mesh.GatewayRouteResource{}
+			if err := rs.Get(context.Background(), gatewayRoute, store.GetByKey(name, currentMesh)); err != nil {
+				if store.IsResourceNotFound(err) {
+					return errors.Errorf("No resources found in %s mesh", currentMesh)
+				}
+				return errors.Wrapf(err, "failed to get mesh %s", currentMesh)
+			}
+			gatewayRoutes := &mesh.GatewayRouteResourceList{
+				Items: []*mesh.GatewayRouteResource{gatewayRoute},
+			}
+			switch format := output.Format(pctx.args.outputFormat); format {
+			case output.TableFormat:
+				return printGatewayRoutes(pctx.Now(), gatewayRoutes, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(rest_types.From.Resource(gatewayRoute), cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
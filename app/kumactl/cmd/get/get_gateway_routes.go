@@ -0,0 +1,77 @@
+package get
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+func newGetGatewayRoutesCmd(pctx *listContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway-routes",
+		Short: "Show GatewayRoutes",
+		Long:  `Show GatewayRoute entities.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+
+			gatewayRoutes := mesh.GatewayRouteResourceList{}
+			listOpts, err := pctx.listOptions(core_store.ListByMesh(pctx.CurrentMesh()))
+			if err != nil {
+				return err
+			}
+			if err := rs.List(context.Background(), &gatewayRoutes, listOpts...); err != nil {
+				return errors.Wrapf(err, "failed to list GatewayRoute")
+			}
+
+			switch format := output.Format(pctx.getContext.args.outputFormat); format {
+			case output.TableFormat:
+				return printGatewayRoutes(pctx.Now(), &gatewayRoutes, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(rest_types.From.ResourceList(&gatewayRoutes), cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func printGatewayRoutes(rootTime time.Time, gatewayRoutes *mesh.GatewayRouteResourceList, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"MESH", "NAME", "AGE"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(gatewayRoutes.Items) <= i {
+					return nil
+				}
+				gatewayRoute := gatewayRoutes.Items[i]
+
+				return []string{
+					gatewayRoute.GetMeta().GetMesh(),                                        // MESH
+					gatewayRoute.GetMeta().GetName(),                                        // NAME
+					table.TimeSince(gatewayRoute.GetMeta().GetModificationTime(), rootTime), // AGE
+				}
+			}
+		}(),
+		Footer: table.PaginationFooter(gatewayRoutes),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
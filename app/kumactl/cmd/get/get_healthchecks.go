@@ -29,7 +29,11 @@ func newGetHealthChecksCmd(pctx *listContext) *cobra.Command {
 			}
 
 			healthChecks := &mesh_core.HealthCheckResourceList{}
-			if err := rs.List(context.Background(), healthChecks, core_store.ListByMesh(pctx.CurrentMesh()), core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+			listOpts, err := pctx.listOptions(core_store.ListByMesh(pctx.CurrentMesh()))
+			if err != nil {
+				return err
+			}
+			if err := rs.List(context.Background(), healthChecks, listOpts...); err != nil {
 				return errors.Wrapf(err, "failed to list HealthChecks")
 			}
 
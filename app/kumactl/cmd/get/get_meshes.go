@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
-	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -30,7 +29,11 @@ func newGetMeshesCmd(pctx *listContext) *cobra.Command {
 			}
 
 			meshes := mesh.MeshResourceList{}
-			if err := rs.List(context.Background(), &meshes, core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+			listOpts, err := pctx.listOptions()
+			if err != nil {
+				return err
+			}
+			if err := rs.List(context.Background(), &meshes, listOpts...); err != nil {
 				return errors.Wrapf(err, "failed to list Meshes")
 			}
 
@@ -29,7 +29,11 @@ func newGetProxyTemplatesCmd(pctx *listContext) *cobra.Command {
 			}
 
 			proxyTemplates := &mesh_core.ProxyTemplateResourceList{}
-			if err := rs.List(context.Background(), proxyTemplates, core_store.ListByMesh(pctx.CurrentMesh()), core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+			listOpts, err := pctx.listOptions(core_store.ListByMesh(pctx.CurrentMesh()))
+			if err != nil {
+				return err
+			}
+			if err := rs.List(context.Background(), proxyTemplates, listOpts...); err != nil {
 				return errors.Wrapf(err, "failed to list ProxyTemplates")
 			}
 
@@ -89,6 +89,8 @@ var _ = Describe("kumactl get [resource] NAME", func() {
 		Entry("traffic-permission", "traffic-permission"),
 		Entry("traffic-route", "traffic-route"),
 		Entry("traffic-trace", "traffic-trace"),
+		Entry("gateway-listener", "gateway-listener"),
+		Entry("gateway-route", "gateway-route"),
 		Entry("secret", "secret"),
 	}
 
@@ -29,7 +29,11 @@ func newGetTrafficLogsCmd(pctx *listContext) *cobra.Command {
 			}
 
 			trafficLogging := mesh.TrafficLogResourceList{}
-			if err := rs.List(context.Background(), &trafficLogging, core_store.ListByMesh(pctx.CurrentMesh()), core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+			listOpts, err := pctx.listOptions(core_store.ListByMesh(pctx.CurrentMesh()))
+			if err != nil {
+				return err
+			}
+			if err := rs.List(context.Background(), &trafficLogging, listOpts...); err != nil {
 				return errors.Wrapf(err, "failed to list TrafficLog")
 			}
 
@@ -29,7 +29,11 @@ func newGetTrafficRoutesCmd(pctx *listContext) *cobra.Command {
 			}
 
 			trafficRoutes := &mesh_core.TrafficRouteResourceList{}
-			if err := rs.List(context.Background(), trafficRoutes, core_store.ListByMesh(pctx.CurrentMesh()), core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+			listOpts, err := pctx.listOptions(core_store.ListByMesh(pctx.CurrentMesh()))
+			if err != nil {
+				return err
+			}
+			if err := rs.List(context.Background(), trafficRoutes, listOpts...); err != nil {
 				return errors.Wrapf(err, "failed to list TrafficRoutes")
 			}
 
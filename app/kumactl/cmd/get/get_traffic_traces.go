@@ -29,7 +29,11 @@ func newGetTrafficTracesCmd(pctx *listContext) *cobra.Command {
 			}
 
 			trafficTraces := mesh.TrafficTraceResourceList{}
-			if err := rs.List(context.Background(), &trafficTraces, core_store.ListByMesh(pctx.CurrentMesh()), core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+			listOpts, err := pctx.listOptions(core_store.ListByMesh(pctx.CurrentMesh()))
+			if err != nil {
+				return err
+			}
+			if err := rs.List(context.Background(), &trafficTraces, listOpts...); err != nil {
 				return errors.Wrapf(err, "failed to list TrafficTrace")
 			}
 
@@ -29,7 +29,11 @@ func newGetTrafficPermissionsCmd(pctx *listContext) *cobra.Command {
 			}
 
 			trafficPermissions := mesh.TrafficPermissionResourceList{}
-			if err := rs.List(context.Background(), &trafficPermissions, core_store.ListByMesh(pctx.CurrentMesh()), core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+			listOpts, err := pctx.listOptions(core_store.ListByMesh(pctx.CurrentMesh()))
+			if err != nil {
+				return err
+			}
+			if err := rs.List(context.Background(), &trafficPermissions, listOpts...); err != nil {
 				return errors.Wrapf(err, "failed to list TrafficPermissions")
 			}
 
@@ -0,0 +1,130 @@
+package get_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+type staticWatchClient struct {
+	resType core_model.ResourceType
+	mesh    string
+	name    string
+	events  []rest.WatchEventType
+	items   []core_model.Resource
+}
+
+var _ kumactl_resources.ResourceWatchClient = &staticWatchClient{}
+
+func (s *staticWatchClient) Watch(_ context.Context, resType core_model.ResourceType, mesh string, name string, handler kumactl_resources.WatchHandler) error {
+	s.resType = resType
+	s.mesh = mesh
+	s.name = name
+	for i, event := range s.events {
+		if err := handler(event, s.items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ = Describe("kumactl get --watch", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var client *staticWatchClient
+
+	BeforeEach(func() {
+		rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
+		trafficRoute := func(name string, modificationTime time.Time) core_model.Resource {
+			return &mesh_core.TrafficRouteResource{
+				Meta: &test_model.ResourceMeta{
+					Mesh:             "default",
+					Name:             name,
+					ModificationTime: modificationTime,
+				},
+				Spec: mesh_proto.TrafficRoute{},
+			}
+		}
+		client = &staticWatchClient{
+			events: []rest.WatchEventType{rest.WatchEventAdded, rest.WatchEventModified, rest.WatchEventDeleted},
+			items: []core_model.Resource{
+				trafficRoute("web-to-backend", rootTime.Add(-time.Hour)),
+				trafficRoute("web-to-backend", rootTime),
+				trafficRoute("backend-to-db", rootTime.Add(-time.Minute)),
+			},
+		}
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				Now: func() time.Time { return rootTime },
+				NewResourceWatchClient: func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ResourceWatchClient, error) {
+					return client, nil
+				},
+			},
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	type testCase struct {
+		args       []string
+		name       string
+		goldenFile string
+	}
+
+	DescribeTable("should print events",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"get"}, given.args...))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(client.resType).To(Equal(mesh_core.TrafficRouteType))
+			Expect(client.mesh).To(Equal("default"))
+			Expect(client.name).To(Equal(given.name))
+
+			// and
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", given.goldenFile))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(strings.TrimSpace(buf.String())).To(Equal(strings.TrimSpace(string(expected))))
+		},
+		Entry("list in a table", testCase{
+			args:       []string{"traffic-routes", "--watch"},
+			goldenFile: "get-traffic-routes.watch.golden.txt",
+		}),
+		Entry("list as YAML", testCase{
+			args:       []string{"traffic-routes", "-w", "-oyaml"},
+			goldenFile: "get-traffic-routes.watch.golden.yaml",
+		}),
+		Entry("single resource", testCase{
+			args:       []string{"traffic-route", "web-to-backend", "--watch"},
+			name:       "web-to-backend",
+			goldenFile: "get-traffic-routes.watch.golden.txt",
+		}),
+	)
+})
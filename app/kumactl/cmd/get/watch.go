@@ -0,0 +1,77 @@
+package get
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+)
+
+func watchResources(pctx *getContext, resourceType core_model.ResourceType, name string, out io.Writer) error {
+	client, err := pctx.CurrentResourceWatchClient()
+	if err != nil {
+		return err
+	}
+	handler, err := watchHandler(pctx, resourceType, out)
+	if err != nil {
+		return err
+	}
+	return client.Watch(context.Background(), resourceType, pctx.CurrentMesh(), name, handler)
+}
+
+func watchHandler(pctx *getContext, resourceType core_model.ResourceType, out io.Writer) (kumactl_resources.WatchHandler, error) {
+	switch format := output.Format(pctx.args.outputFormat); format {
+	case output.TableFormat:
+		res, err := registry.Global().NewObject(resourceType)
+		if err != nil {
+			return nil, err
+		}
+		meshScoped := res.Scope() == core_model.ScopeMesh
+		writer := table.NewWriter(out)
+		headers := []string{"EVENT", "NAME", "AGE"}
+		if meshScoped {
+			headers = []string{"EVENT", "MESH", "NAME", "AGE"}
+		}
+		if err := writer.Headers(headers...); err != nil {
+			return nil, err
+		}
+		return func(eventType rest_types.WatchEventType, resource core_model.Resource) error {
+			row := []string{string(eventType)}
+			if meshScoped {
+				row = append(row, resource.GetMeta().GetMesh())
+			}
+			row = append(row,
+				resource.GetMeta().GetName(),
+				table.TimeSince(resource.GetMeta().GetModificationTime(), pctx.Now()),
+			)
+			if err := writer.Row(row...); err != nil {
+				return err
+			}
+			return writer.Flush()
+		}, nil
+	default:
+		printer, err := printers.NewGenericPrinter(format)
+		if err != nil {
+			return nil, err
+		}
+		return func(eventType rest_types.WatchEventType, resource core_model.Resource) error {
+			if format == output.YAMLFormat {
+				// separate events as YAML documents
+				if _, err := fmt.Fprintln(out, "---"); err != nil {
+					return err
+				}
+			}
+			return printer.Print(rest_types.WatchEvent{
+				Type:   eventType,
+				Object: rest_types.From.Resource(resource),
+			}, out)
+		}, nil
+	}
+}
@@ -26,7 +26,13 @@ func NewInspectCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	// flags
 	cmd.PersistentFlags().StringVarP(&ctx.args.outputFormat, "output", "o", string(output.TableFormat), kuma_cmd.UsageOptions("output format", output.TableFormat, output.YAMLFormat, output.JSONFormat))
 	// sub-commands
+	cmd.AddCommand(newInspectMeshesCmd(ctx))
 	cmd.AddCommand(newInspectDataplanesCmd(ctx))
 	cmd.AddCommand(newInspectZonesCmd(ctx))
+	cmd.AddCommand(newInspectServicesCmd(ctx))
+	cmd.AddCommand(newInspectDataplaneCmd(ctx))
+	for _, policy := range inspectedPolicies {
+		cmd.AddCommand(newInspectPolicyCmd(ctx, policy.use, policy.short, policy.policyType))
+	}
 	return cmd
 }
@@ -0,0 +1,161 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+)
+
+type inspectDataplaneContext struct {
+	*inspectContext
+
+	args struct {
+		policies   bool
+		configDump bool
+	}
+}
+
+func newInspectDataplaneCmd(pctx *inspectContext) *cobra.Command {
+	ctx := inspectDataplaneContext{
+		inspectContext: pctx,
+	}
+	cmd := &cobra.Command{
+		Use:   "dataplane NAME",
+		Short: "Inspect a Dataplane",
+		Long: `Inspect a Dataplane.
+
+With --policies, lists policies applied to every inbound, outbound and destination service of the Dataplane,
+selected the same way as when Envoy configuration of the Dataplane is generated.
+
+With --config-dump, shows Envoy configuration generated for the Dataplane by the Control Plane
+and the configuration ACKed by Envoy. Output in the table format includes the difference between both.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch {
+			case ctx.args.policies:
+				return ctx.printPolicies(args[0], cmd.OutOrStdout())
+			case ctx.args.configDump:
+				return ctx.printConfigDump(args[0], cmd.OutOrStdout())
+			default:
+				return errors.New(`one of --policies or --config-dump is required, use "kumactl inspect dataplanes" to inspect the state of Dataplanes`)
+			}
+		},
+	}
+	cmd.PersistentFlags().BoolVar(&ctx.args.policies, "policies", false, "list policies applied to the Dataplane")
+	cmd.PersistentFlags().BoolVar(&ctx.args.configDump, "config-dump", false, "show Envoy configuration generated for the Dataplane and differences with the configuration ACKed by Envoy")
+	return cmd
+}
+
+func (c *inspectDataplaneContext) printPolicies(name string, out io.Writer) error {
+	client, err := c.CurrentInspectClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to create an inspect client")
+	}
+	policies, err := client.DataplanePolicies(context.Background(), c.CurrentMesh(), name)
+	if err != nil {
+		return err
+	}
+
+	switch format := output.Format(c.inspectContext.args.outputFormat); format {
+	case output.TableFormat:
+		return printDataplanePolicies(policies, out)
+	default:
+		printer, err := printers.NewGenericPrinter(format)
+		if err != nil {
+			return err
+		}
+		return printer.Print(policies, out)
+	}
+}
+
+func (c *inspectDataplaneContext) printConfigDump(name string, out io.Writer) error {
+	client, err := c.CurrentInspectClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to create an inspect client")
+	}
+	xds, err := client.DataplaneXds(context.Background(), c.CurrentMesh(), name)
+	if err != nil {
+		return err
+	}
+
+	switch format := output.Format(c.inspectContext.args.outputFormat); format {
+	case output.TableFormat:
+		return printDataplaneXds(xds, out)
+	default:
+		printer, err := printers.NewGenericPrinter(format)
+		if err != nil {
+			return err
+		}
+		return printer.Print(xds, out)
+	}
+}
+
+func printDataplanePolicies(policies *api_server_types.DataplanePolicies, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"MESH", "DATAPLANE", "ATTACHMENT TYPE", "ATTACHMENT", "SERVICE", "POLICY TYPE", "POLICY"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(policies.Items) <= i {
+					return nil
+				}
+				item := policies.Items[i]
+				return []string{
+					policies.Mesh,                   // MESH
+					policies.Dataplane,              // DATAPLANE
+					item.Attachment.Type,            // ATTACHMENT TYPE
+					item.Attachment.Name,            // ATTACHMENT
+					orDash(item.Attachment.Service), // SERVICE
+					item.Type,                       // POLICY TYPE
+					item.Name,                       // POLICY
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+func printDataplaneXds(xds *api_server_types.DataplaneXds, out io.Writer) error {
+	acked := map[string]api_server_types.XdsResources{}
+	for _, resources := range xds.Acked {
+		acked[resources.TypeUrl] = resources
+	}
+	data := printers.Table{
+		Headers: []string{"TYPE", "GENERATED VERSION", "ACKED VERSION", "RESOURCES", "ERROR"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(xds.Generated) <= i {
+					return nil
+				}
+				generated := xds.Generated[i]
+				return []string{
+					generated.TypeUrl,                        // TYPE
+					orDash(generated.Version),                // GENERATED VERSION
+					orDash(acked[generated.TypeUrl].Version), // ACKED VERSION
+					table.Number(len(generated.Resources)),   // RESOURCES
+					orDash(acked[generated.TypeUrl].Error),   // ERROR
+				}
+			}
+		}(),
+	}
+	if err := printers.NewTablePrinter().Print(data, out); err != nil {
+		return err
+	}
+	if xds.Diff == "" {
+		_, err := fmt.Fprintln(out, "\nEnvoy configuration is in sync with the Control Plane")
+		return err
+	}
+	_, err := fmt.Fprintf(out, "\n%s", xds.Diff)
+	return err
+}
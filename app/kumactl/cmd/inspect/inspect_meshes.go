@@ -0,0 +1,100 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+)
+
+func newInspectMeshesCmd(ctx *inspectContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "meshes",
+		Short: "Inspect Meshes",
+		Long:  `Inspect Meshes.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, err := ctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			insights := &mesh_core.MeshInsightResourceList{}
+			if err := rs.List(context.Background(), insights); err != nil {
+				return errors.Wrap(err, "failed to list mesh insights")
+			}
+			sort.Slice(insights.Items, func(i, j int) bool {
+				return insights.Items[i].GetMeta().GetName() < insights.Items[j].GetMeta().GetName()
+			})
+
+			switch format := output.Format(ctx.args.outputFormat); format {
+			case output.TableFormat:
+				return printMeshInsights(insights, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(rest_types.From.ResourceList(insights), cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func printMeshInsights(insights *mesh_core.MeshInsightResourceList, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"MESH", "ONLINE", "OFFLINE", "TOTAL", "POLICIES", "VALID CERTS", "CERT EXPIRATIONS", "KUMA-DP VERSIONS", "ENVOY VERSIONS"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(insights.Items) <= i {
+					return nil
+				}
+				insight := insights.Items[i]
+				return []string{
+					insight.GetMeta().GetName(),                                // MESH
+					table.Number(insight.Spec.GetDataplanes().GetOnline()),     // ONLINE
+					table.Number(insight.Spec.GetDataplanes().GetOffline()),    // OFFLINE
+					table.Number(insight.Spec.GetDataplanes().GetTotal()),      // TOTAL
+					counts(policyCounts(insight.Spec.GetPolicies())),           // POLICIES
+					validCerts(&insight.Spec),                                  // VALID CERTS
+					counts(insight.Spec.GetMTLS().GetCertificateExpirations()), // CERT EXPIRATIONS
+					counts(insight.Spec.GetDpVersions().GetKumaDp()),           // KUMA-DP VERSIONS
+					counts(insight.Spec.GetDpVersions().GetEnvoy()),            // ENVOY VERSIONS
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+// policyCounts returns the number of policies of every type that is in use.
+func policyCounts(policies map[string]*mesh_proto.MeshInsight_PolicyStat) map[string]uint32 {
+	result := map[string]uint32{}
+	for policyType, stat := range policies {
+		if stat.GetTotal() > 0 {
+			result[policyType] = stat.GetTotal()
+		}
+	}
+	return result
+}
+
+// validCerts formats the share of Dataplanes with a valid certificate, e.g. "2/4 (50%)".
+func validCerts(insight *mesh_proto.MeshInsight) string {
+	total := insight.GetDataplanes().GetTotal()
+	if total == 0 {
+		return "-"
+	}
+	valid := insight.GetMTLS().GetValidCertificates()
+	return fmt.Sprintf("%d/%d (%d%%)", valid, total, valid*100/total)
+}
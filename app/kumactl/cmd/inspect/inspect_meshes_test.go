@@ -0,0 +1,127 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	gomega_types "github.com/onsi/gomega/types"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("kumactl inspect meshes", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+
+	BeforeEach(func() {
+		lastSync, _ := time.Parse(time.RFC3339, "2019-07-17T18:08:41+00:00")
+		t1, _ := time.Parse(time.RFC3339, "2018-07-17T16:05:36.995+00:00")
+		store := memory_resources.NewStore()
+		insights := map[string]mesh_proto.MeshInsight{
+			"default": {
+				LastSync: util_proto.MustTimestampProto(lastSync),
+				Dataplanes: &mesh_proto.MeshInsight_DataplaneStat{
+					Total:   4,
+					Online:  3,
+					Offline: 1,
+				},
+				Policies: map[string]*mesh_proto.MeshInsight_PolicyStat{
+					"TrafficPermission": {Total: 2},
+					"TrafficRoute":      {Total: 1},
+					"TrafficTrace":      {},
+				},
+				MTLS: &mesh_proto.MeshInsight_MTLS{
+					ValidCertificates:   3,
+					ExpiredCertificates: 1,
+					CertificateExpirations: map[string]uint32{
+						"24h":  1,
+						"720h": 2,
+					},
+				},
+				DpVersions: &mesh_proto.MeshInsight_DpVersions{
+					KumaDp: map[string]uint32{"0.8.0": 3, "0.7.1": 1},
+					Envoy:  map[string]uint32{"1.15.0": 3, "1.14.1": 1},
+				},
+			},
+			"empty": {
+				LastSync: util_proto.MustTimestampProto(lastSync),
+			},
+		}
+		for name, spec := range insights {
+			err := store.Create(context.Background(), &mesh_core.MeshInsightResource{Spec: spec}, core_store.CreateByKey(name, name), core_store.CreatedAt(t1))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				Now: time.Now,
+				NewResourceStore: func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+					return store, nil
+				},
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	type testCase struct {
+		outputFormat string
+		goldenFile   string
+		matcher      func(interface{}) gomega_types.GomegaMatcher
+	}
+
+	DescribeTable("kumactl inspect meshes -o table|json|yaml",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "meshes"}, given.outputFormat))
+
+			// when
+			err := rootCmd.Execute()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", given.goldenFile))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(buf.String()).To(given.matcher(expected))
+		},
+		Entry("should support Table output by default", testCase{
+			outputFormat: "",
+			goldenFile:   "inspect-meshes.golden.txt",
+			matcher: func(expected interface{}) gomega_types.GomegaMatcher {
+				return WithTransform(strings.TrimSpace, Equal(strings.TrimSpace(string(expected.([]byte)))))
+			},
+		}),
+		Entry("should support JSON output", testCase{
+			outputFormat: "-ojson",
+			goldenFile:   "inspect-meshes.golden.json",
+			matcher:      MatchJSON,
+		}),
+		Entry("should support YAML output", testCase{
+			outputFormat: "-oyaml",
+			goldenFile:   "inspect-meshes.golden.yaml",
+			matcher:      MatchYAML,
+		}),
+	)
+})
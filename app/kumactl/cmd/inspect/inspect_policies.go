@@ -0,0 +1,101 @@
+package inspect
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+// inspectedPolicies are policies for which "kumactl inspect <policy> NAME" lists Dataplanes they are applied to.
+var inspectedPolicies = []struct {
+	use        string
+	short      string
+	policyType model.ResourceType
+}{
+	{use: "traffic-permission", short: "TrafficPermission", policyType: mesh_core.TrafficPermissionType},
+	{use: "fault-injection", short: "FaultInjection", policyType: mesh_core.FaultInjectionType},
+	{use: "traffic-route", short: "TrafficRoute", policyType: mesh_core.TrafficRouteType},
+	{use: "traffic-log", short: "TrafficLog", policyType: mesh_core.TrafficLogType},
+	{use: "health-check", short: "HealthCheck", policyType: mesh_core.HealthCheckType},
+	{use: "circuit-breaker", short: "CircuitBreaker", policyType: mesh_core.CircuitBreakerType},
+	{use: "traffic-trace", short: "TrafficTrace", policyType: mesh_core.TrafficTraceType},
+	{use: "proxytemplate", short: "ProxyTemplate", policyType: mesh_core.ProxyTemplateType},
+}
+
+func newInspectPolicyCmd(pctx *inspectContext, use string, short string, policyType model.ResourceType) *cobra.Command {
+	return &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Inspect Dataplanes affected by a " + short,
+		Long:  "Inspect Dataplanes affected by a " + short + " and parts of them that the policy is applied to.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := pctx.CurrentInspectClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create an inspect client")
+			}
+			dataplanes, err := client.PolicyDataplanes(context.Background(), pctx.CurrentMesh(), policyType, args[0])
+			if err != nil {
+				return err
+			}
+
+			switch format := output.Format(pctx.args.outputFormat); format {
+			case output.TableFormat:
+				return printPolicyDataplanes(dataplanes, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(dataplanes, cmd.OutOrStdout())
+			}
+		},
+	}
+}
+
+func printPolicyDataplanes(dataplanes *api_server_types.PolicyDataplanes, out io.Writer) error {
+	type row struct {
+		dataplane  string
+		attachment api_server_types.PolicyAttachment
+	}
+	var rows []row
+	for _, item := range dataplanes.Items {
+		for _, attachment := range item.Attachments {
+			rows = append(rows, row{dataplane: item.Dataplane, attachment: attachment})
+		}
+	}
+	data := printers.Table{
+		Headers: []string{"MESH", "DATAPLANE", "ATTACHMENT TYPE", "ATTACHMENT", "SERVICE"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(rows) <= i {
+					return nil
+				}
+				return []string{
+					dataplanes.Mesh,                    // MESH
+					rows[i].dataplane,                  // DATAPLANE
+					rows[i].attachment.Type,            // ATTACHMENT TYPE
+					rows[i].attachment.Name,            // ATTACHMENT
+					orDash(rows[i].attachment.Service), // SERVICE
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,259 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	gomega_types "github.com/onsi/gomega/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+type testInspectClient struct {
+	receivedMesh       string
+	receivedPolicyType model.ResourceType
+	receivedName       string
+	policies           *api_server_types.DataplanePolicies
+	dataplanes         *api_server_types.PolicyDataplanes
+	xds                *api_server_types.DataplaneXds
+}
+
+func (c *testInspectClient) DataplanePolicies(_ context.Context, meshName string, name string) (*api_server_types.DataplanePolicies, error) {
+	c.receivedMesh = meshName
+	c.receivedName = name
+	return c.policies, nil
+}
+
+func (c *testInspectClient) PolicyDataplanes(_ context.Context, meshName string, policyType model.ResourceType, name string) (*api_server_types.PolicyDataplanes, error) {
+	c.receivedMesh = meshName
+	c.receivedPolicyType = policyType
+	c.receivedName = name
+	return c.dataplanes, nil
+}
+
+func (c *testInspectClient) DataplaneXds(_ context.Context, meshName string, name string) (*api_server_types.DataplaneXds, error) {
+	c.receivedMesh = meshName
+	c.receivedName = name
+	return c.xds, nil
+}
+
+func (c *testInspectClient) Simulate(context.Context, string, api_server_types.SimulationRequest) (*api_server_types.Simulation, error) {
+	return nil, errors.New("not implemented")
+}
+
+var _ resources.InspectClient = &testInspectClient{}
+
+var _ = Describe("kumactl inspect policies", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var testClient *testInspectClient
+
+	BeforeEach(func() {
+		testClient = &testInspectClient{
+			policies: &api_server_types.DataplanePolicies{
+				Mesh:      "default",
+				Dataplane: "web-01",
+				Items: []api_server_types.MatchedPolicy{
+					{
+						Type:       "TrafficPermission",
+						Name:       "allow-all",
+						Attachment: api_server_types.PolicyAttachment{Type: "inbound", Name: "192.168.0.1:8080:18080", Service: "web"},
+					},
+					{
+						Type:       "TrafficRoute",
+						Name:       "web-to-backend",
+						Attachment: api_server_types.PolicyAttachment{Type: "outbound", Name: "127.0.0.1:10001", Service: "backend"},
+					},
+					{
+						Type:       "HealthCheck",
+						Name:       "backend-hc",
+						Attachment: api_server_types.PolicyAttachment{Type: "service", Name: "backend", Service: "backend"},
+					},
+					{
+						Type:       "TrafficTrace",
+						Name:       "traces",
+						Attachment: api_server_types.PolicyAttachment{Type: "dataplane", Name: "web-01"},
+					},
+				},
+			},
+			dataplanes: &api_server_types.PolicyDataplanes{
+				Mesh: "default",
+				Type: "TrafficRoute",
+				Name: "web-to-backend",
+				Items: []api_server_types.MatchedDataplane{
+					{
+						Dataplane: "web-01",
+						Attachments: []api_server_types.PolicyAttachment{
+							{Type: "outbound", Name: "127.0.0.1:10001", Service: "backend"},
+						},
+					},
+					{
+						Dataplane: "web-02",
+						Attachments: []api_server_types.PolicyAttachment{
+							{Type: "outbound", Name: "127.0.0.1:10001", Service: "backend"},
+							{Type: "outbound", Name: "127.0.0.1:10002", Service: "backend"},
+						},
+					},
+				},
+			},
+		}
+
+		testClient.xds = &api_server_types.DataplaneXds{
+			Mesh:      "default",
+			Dataplane: "web-01",
+			Generated: []api_server_types.XdsResources{
+				{
+					TypeUrl:   "type.googleapis.com/envoy.api.v2.Cluster",
+					Version:   "v2",
+					Resources: []json.RawMessage{json.RawMessage(`{"name":"backend","connectTimeout":"5s"}`)},
+				},
+				{
+					TypeUrl:   "type.googleapis.com/envoy.api.v2.Listener",
+					Version:   "v1",
+					Resources: []json.RawMessage{},
+				},
+			},
+			Acked: []api_server_types.XdsResources{
+				{
+					TypeUrl:   "type.googleapis.com/envoy.api.v2.Cluster",
+					Version:   "v1",
+					Error:     "invalid cluster",
+					Resources: []json.RawMessage{json.RawMessage(`{"name":"backend","connectTimeout":"1s"}`)},
+				},
+				{
+					TypeUrl:   "type.googleapis.com/envoy.api.v2.Listener",
+					Version:   "v1",
+					Resources: []json.RawMessage{},
+				},
+			},
+			Diff: `--- acked type.googleapis.com/envoy.api.v2.Cluster
++++ generated type.googleapis.com/envoy.api.v2.Cluster
+@@ -1,2 +1,2 @@
+-- connectTimeout: 1s
++- connectTimeout: 5s
+   name: backend
+`,
+		}
+
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				Now: time.Now,
+				NewInspectClient: func(*config_proto.ControlPlaneCoordinates_ApiServer) (resources.InspectClient, error) {
+					return testClient, nil
+				},
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	type testCase struct {
+		args       []string
+		goldenFile string
+		matcher    func(interface{}) gomega_types.GomegaMatcher
+	}
+
+	DescribeTable("kumactl inspect dataplane|traffic-route NAME [--policies|--config-dump] -o table|json|yaml",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect"}, given.args...))
+
+			// when
+			err := rootCmd.Execute()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", given.goldenFile))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(buf.String()).To(given.matcher(expected))
+		},
+		Entry("should list policies of a dataplane", testCase{
+			args:       []string{"dataplane", "web-01", "--policies"},
+			goldenFile: "inspect-dataplane-policies.golden.txt",
+			matcher: func(expected interface{}) gomega_types.GomegaMatcher {
+				return WithTransform(strings.TrimSpace, Equal(strings.TrimSpace(string(expected.([]byte)))))
+			},
+		}),
+		Entry("should support YAML output of policies of a dataplane", testCase{
+			args:       []string{"dataplane", "web-01", "--policies", "-oyaml"},
+			goldenFile: "inspect-dataplane-policies.golden.yaml",
+			matcher:    MatchYAML,
+		}),
+		Entry("should show config dump of a dataplane", testCase{
+			args:       []string{"dataplane", "web-01", "--config-dump"},
+			goldenFile: "inspect-dataplane-config-dump.golden.txt",
+			matcher: func(expected interface{}) gomega_types.GomegaMatcher {
+				return WithTransform(strings.TrimSpace, Equal(strings.TrimSpace(string(expected.([]byte)))))
+			},
+		}),
+		Entry("should support YAML output of config dump of a dataplane", testCase{
+			args:       []string{"dataplane", "web-01", "--config-dump", "-oyaml"},
+			goldenFile: "inspect-dataplane-config-dump.golden.yaml",
+			matcher:    MatchYAML,
+		}),
+		Entry("should list dataplanes of a policy", testCase{
+			args:       []string{"traffic-route", "web-to-backend"},
+			goldenFile: "inspect-traffic-route.golden.txt",
+			matcher: func(expected interface{}) gomega_types.GomegaMatcher {
+				return WithTransform(strings.TrimSpace, Equal(strings.TrimSpace(string(expected.([]byte)))))
+			},
+		}),
+		Entry("should support JSON output of dataplanes of a policy", testCase{
+			args:       []string{"traffic-route", "web-to-backend", "-ojson"},
+			goldenFile: "inspect-traffic-route.golden.json",
+			matcher:    MatchJSON,
+		}),
+	)
+
+	It("should query the policy of the current mesh", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "health-check", "backend-hc"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testClient.receivedMesh).To(Equal("default"))
+		Expect(testClient.receivedPolicyType).To(Equal(model.ResourceType("HealthCheck")))
+		Expect(testClient.receivedName).To(Equal("backend-hc"))
+	})
+
+	It("should require --policies or --config-dump flag", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "dataplane", "web-01"})
+		rootCmd.SetErr(&bytes.Buffer{})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`one of --policies or --config-dump is required, use "kumactl inspect dataplanes" to inspect the state of Dataplanes`))
+	})
+})
@@ -0,0 +1,164 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+type inspectServicesContext struct {
+	graph bool
+}
+
+func newInspectServicesCmd(pctx *inspectContext) *cobra.Command {
+	ctx := &inspectServicesContext{}
+	cmd := &cobra.Command{
+		Use:   "services",
+		Short: "Inspect Services",
+		Long:  `Inspect Services.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if ctx.graph {
+				return printServiceGraph(pctx, cmd.OutOrStdout())
+			}
+			return printServiceInsight(pctx, cmd.OutOrStdout())
+		},
+	}
+	// flags
+	cmd.PersistentFlags().BoolVar(&ctx.graph, "graph", false, "show service-to-service traffic instead of the list of services")
+	return cmd
+}
+
+func printServiceInsight(pctx *inspectContext, out io.Writer) error {
+	rs, err := pctx.CurrentResourceStore()
+	if err != nil {
+		return err
+	}
+	insight := &mesh_core.ServiceInsightResource{}
+	if err := rs.Get(context.Background(), insight, store.GetByKey(mesh_core.ServiceInsightName(pctx.CurrentMesh()), pctx.CurrentMesh())); err != nil {
+		if store.IsResourceNotFound(err) {
+			return errors.Errorf("insights about services of mesh %q have not been computed yet", pctx.CurrentMesh())
+		}
+		return errors.Wrap(err, "failed to get a service insight")
+	}
+
+	switch format := output.Format(pctx.args.outputFormat); format {
+	case output.TableFormat:
+		return printServiceInsightTable(insight, out)
+	default:
+		printer, err := printers.NewGenericPrinter(format)
+		if err != nil {
+			return err
+		}
+		return printer.Print(rest_types.From.Resource(insight), out)
+	}
+}
+
+func printServiceGraph(pctx *inspectContext, out io.Writer) error {
+	client, err := pctx.CurrentServiceGraphClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to create a service graph client")
+	}
+	graph, err := client.Get(context.Background(), pctx.CurrentMesh())
+	if err != nil {
+		return err
+	}
+
+	switch format := output.Format(pctx.args.outputFormat); format {
+	case output.TableFormat:
+		return printServiceGraphEdges(graph, out)
+	default:
+		printer, err := printers.NewGenericPrinter(format)
+		if err != nil {
+			return err
+		}
+		return printer.Print(graph, out)
+	}
+}
+
+func printServiceInsightTable(insight *mesh_core.ServiceInsightResource, out io.Writer) error {
+	var services []string
+	for service := range insight.Spec.GetServices() {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	data := printers.Table{
+		Headers: []string{"MESH", "SERVICE", "ONLINE", "OFFLINE", "TOTAL", "ZONES", "PROTOCOLS", "VERSIONS"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(services) <= i {
+					return nil
+				}
+				service := insight.Spec.Services[services[i]]
+				return []string{
+					insight.GetMeta().GetMesh(), // MESH
+					services[i],                 // SERVICE
+					table.Number(service.GetDataplanes().GetOnline()),  // ONLINE
+					table.Number(service.GetDataplanes().GetOffline()), // OFFLINE
+					table.Number(service.GetDataplanes().GetTotal()),   // TOTAL
+					counts(service.GetZones()),                         // ZONES
+					counts(service.GetProtocols()),                     // PROTOCOLS
+					counts(service.GetVersions()),                      // VERSIONS
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+// counts formats a number of dataplanes per key, e.g. "v1=2 v2=1".
+func counts(values map[string]uint32) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	var keys []string
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", key, values[key]))
+	}
+	return strings.Join(pairs, " ")
+}
+
+func printServiceGraphEdges(graph *api_server_types.ServiceGraph, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"MESH", "SOURCE", "DESTINATION", "REQUESTS/S", "ERRORS", "AVG LATENCY", "CONNECTIONS/S"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(graph.Edges) <= i {
+					return nil
+				}
+				edge := graph.Edges[i]
+				return []string{
+					graph.Mesh,                            // MESH
+					edge.Source,                           // SOURCE
+					edge.Destination,                      // DESTINATION
+					fmt.Sprintf("%.2f", edge.RequestRate), // REQUESTS/S
+					fmt.Sprintf("%.2f%%", edge.ErrorRate*100), // ERRORS
+					fmt.Sprintf("%.2fms", edge.LatencyMs),     // AVG LATENCY
+					fmt.Sprintf("%.2f", edge.ConnectionRate),  // CONNECTIONS/S
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
@@ -0,0 +1,186 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	gomega_types "github.com/onsi/gomega/types"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+type testServiceGraphClient struct {
+	receivedMesh string
+	graph        *api_server_types.ServiceGraph
+}
+
+func (c *testServiceGraphClient) Get(_ context.Context, meshName string) (*api_server_types.ServiceGraph, error) {
+	c.receivedMesh = meshName
+	return c.graph, nil
+}
+
+var _ resources.ServiceGraphClient = &testServiceGraphClient{}
+
+var _ = Describe("kumactl inspect services", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var testClient *testServiceGraphClient
+	var store core_store.ResourceStore
+
+	BeforeEach(func() {
+		generatedAt, _ := time.Parse(time.RFC3339, "2019-07-17T18:08:41+00:00")
+		store = memory_resources.NewStore()
+		insight := &mesh_core.ServiceInsightResource{
+			Spec: mesh_proto.ServiceInsight{
+				LastSync: util_proto.MustTimestampProto(generatedAt),
+				Services: map[string]*mesh_proto.ServiceInsight_Service{
+					"web": {
+						Dataplanes: &mesh_proto.ServiceInsight_DataplaneStat{
+							Total:  1,
+							Online: 1,
+						},
+						Zones:     map[string]uint32{"zone-1": 1},
+						Protocols: map[string]uint32{"http": 1},
+					},
+					"backend": {
+						Dataplanes: &mesh_proto.ServiceInsight_DataplaneStat{
+							Total:   3,
+							Online:  2,
+							Offline: 1,
+						},
+						Zones:     map[string]uint32{"zone-1": 2, "zone-2": 1},
+						Protocols: map[string]uint32{"http": 3},
+						Versions:  map[string]uint32{"v1": 2, "v2": 1},
+					},
+					"redis": {
+						Dataplanes: &mesh_proto.ServiceInsight_DataplaneStat{
+							Total:   1,
+							Offline: 1,
+						},
+						Protocols: map[string]uint32{"tcp": 1},
+					},
+				},
+			},
+		}
+		err := store.Create(context.Background(), insight, core_store.CreateByKey(mesh_core.ServiceInsightName("default"), "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		testClient = &testServiceGraphClient{
+			graph: &api_server_types.ServiceGraph{
+				Mesh:        "default",
+				GeneratedAt: generatedAt,
+				Nodes: []api_server_types.ServiceGraphNode{
+					{Service: "backend", Instances: 2},
+					{Service: "redis", Instances: 0},
+					{Service: "web", Instances: 1},
+				},
+				Edges: []api_server_types.ServiceGraphEdge{
+					{Source: "backend", Destination: "redis", ConnectionRate: 0.5},
+					{Source: "web", Destination: "backend", RequestRate: 12.5, ErrorRate: 0.025, LatencyMs: 3.25, ConnectionRate: 0.1},
+				},
+			},
+		}
+
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				Now: time.Now,
+				NewResourceStore: func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+					return store, nil
+				},
+				NewServiceGraphClient: func(*config_proto.ControlPlaneCoordinates_ApiServer) (resources.ServiceGraphClient, error) {
+					return testClient, nil
+				},
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	type testCase struct {
+		args       []string
+		goldenFile string
+		matcher    func(interface{}) gomega_types.GomegaMatcher
+	}
+
+	DescribeTable("kumactl inspect services [--graph] -o table|json|yaml",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "services"}, given.args...))
+
+			// when
+			err := rootCmd.Execute()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", given.goldenFile))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(buf.String()).To(given.matcher(expected))
+		},
+		Entry("should list services by default", testCase{
+			goldenFile: "inspect-services.golden.txt",
+			matcher: func(expected interface{}) gomega_types.GomegaMatcher {
+				return WithTransform(strings.TrimSpace, Equal(strings.TrimSpace(string(expected.([]byte)))))
+			},
+		}),
+		Entry("should support JSON output", testCase{
+			args:       []string{"-ojson"},
+			goldenFile: "inspect-services.golden.json",
+			matcher:    MatchJSON,
+		}),
+		Entry("should list traffic between services with --graph", testCase{
+			args:       []string{"--graph"},
+			goldenFile: "inspect-services-graph.golden.txt",
+			matcher: func(expected interface{}) gomega_types.GomegaMatcher {
+				return WithTransform(strings.TrimSpace, Equal(strings.TrimSpace(string(expected.([]byte)))))
+			},
+		}),
+		Entry("should support JSON output of the graph", testCase{
+			args:       []string{"--graph", "-ojson"},
+			goldenFile: "inspect-services-graph.golden.json",
+			matcher:    MatchJSON,
+		}),
+		Entry("should support YAML output", testCase{
+			args:       []string{"-oyaml"},
+			goldenFile: "inspect-services.golden.yaml",
+			matcher:    MatchYAML,
+		}),
+	)
+
+	It("should query the service graph of the current mesh", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "services", "--graph"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testClient.receivedMesh).To(Equal("default"))
+	})
+})
@@ -50,6 +50,8 @@ type InstallControlPlaneArgs struct {
 	ControlPlane_mode                         string           `helm:"controlPlane.mode"`
 	ControlPlane_zone                         string           `helm:"controlPlane.zone"`
 	ControlPlane_globalRemoteSyncService_type string           `helm:"controlPlane.globalRemoteSyncService.type"`
+	ControlPlane_ingressController_enabled    bool             `helm:"controlPlane.ingressController.enabled"`
+	ControlPlane_ingressController_class      string           `helm:"controlPlane.ingressController.ingressClass"`
 }
 
 type ImageEnvSecret struct {
@@ -80,6 +82,7 @@ var DefaultInstallControlPlaneArgs = InstallControlPlaneArgs{
 	ControlPlane_mode:                         core.Standalone,
 	ControlPlane_zone:                         "",
 	ControlPlane_globalRemoteSyncService_type: "LoadBalancer",
+	ControlPlane_ingressController_class:      "kuma",
 }
 
 var InstallCpTemplateFilesFn = InstallCpTemplateFiles
@@ -149,6 +152,8 @@ func newInstallControlPlaneCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.Flags().StringVar(&args.ControlPlane_mode, "mode", args.ControlPlane_mode, kuma_cmd.UsageOptions("kuma cp modes", "standalone", "remote", "global"))
 	cmd.Flags().StringVar(&args.ControlPlane_zone, "zone", args.ControlPlane_zone, "set the Kuma zone name")
 	cmd.Flags().BoolVar(&useNodePort, "use-node-port", false, "use NodePort instead of LoadBalancer")
+	cmd.Flags().BoolVar(&args.ControlPlane_ingressController_enabled, "ingress-controller-enabled", args.ControlPlane_ingressController_enabled, "turn Ingresses of the ingress class into Kuma gateway configuration")
+	cmd.Flags().StringVar(&args.ControlPlane_ingressController_class, "ingress-class", args.ControlPlane_ingressController_class, "ingress class handled by the Kuma Control Plane")
 	return cmd
 }
 
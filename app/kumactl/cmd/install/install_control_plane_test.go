@@ -136,6 +136,13 @@ var _ = Describe("kumactl install control-plane", func() {
 			},
 			goldenFile: "install-control-plane.cni-enabled.golden.yaml",
 		}),
+		Entry("should generate Kubernetes resources with Ingress controller", testCase{
+			extraArgs: []string{
+				"--ingress-controller-enabled",
+				"--ingress-class", "kuma-gateway",
+			},
+			goldenFile: "install-control-plane.ingress-controller.golden.yaml",
+		}),
 		Entry("should generate Kubernetes resources for Global", testCase{
 			extraArgs: []string{
 				"--mode", "global",
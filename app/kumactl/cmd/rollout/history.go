@@ -0,0 +1,61 @@
+package rollout
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+)
+
+func newHistoryCmd(ctx *rolloutContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history TYPE NAME",
+		Short: "Show revisions of a resource",
+		Long:  `Show revisions of a resource from the oldest to the newest.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resourceType, key, err := ctx.resourceKey(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			client, err := ctx.CurrentResourceHistoryClient()
+			if err != nil {
+				return err
+			}
+			revisions, err := client.History(context.Background(), resourceType, key.Name, key.Mesh)
+			if err != nil {
+				return err
+			}
+			return printRevisions(ctx.Now(), revisions, cmd.OutOrStdout())
+		},
+	}
+	return cmd
+}
+
+func printRevisions(now time.Time, revisions []kumactl_resources.ResourceRevision, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"REVISION", "OPERATION", "USER", "AGE"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(revisions) <= i {
+					return nil
+				}
+				revision := revisions[i]
+				return []string{
+					table.Number(revision.Revision),     // REVISION
+					revision.Operation,                  // OPERATION
+					revision.User,                       // USER
+					table.TimeSince(revision.Time, now), // AGE
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
@@ -0,0 +1,74 @@
+package rollout
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+type rolloutContext struct {
+	*kumactl_cmd.RootContext
+}
+
+func NewRolloutCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &rolloutContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "rollout",
+		Short: "Manage revisions of Kuma resources",
+		Long: `Manage revisions of Kuma resources.
+
+The Control Plane keeps a limited number of revisions of Meshes and policies changed through its API.`,
+	}
+	// sub-commands
+	cmd.AddCommand(newHistoryCmd(ctx))
+	cmd.AddCommand(newUndoCmd(ctx))
+	return cmd
+}
+
+// resourceKey returns the type and the key of the resource identified by TYPE and NAME arguments.
+func (c *rolloutContext) resourceKey(resourceTypeArg string, name string) (model.ResourceType, model.ResourceKey, error) {
+	var resourceType model.ResourceType
+	switch resourceTypeArg {
+	case "mesh":
+		resourceType = mesh.MeshType
+	case "healthcheck":
+		resourceType = mesh.HealthCheckType
+	case "proxytemplate":
+		resourceType = mesh.ProxyTemplateType
+	case "traffic-log":
+		resourceType = mesh.TrafficLogType
+	case "traffic-permission":
+		resourceType = mesh.TrafficPermissionType
+	case "traffic-route":
+		resourceType = mesh.TrafficRouteType
+	case "traffic-trace":
+		resourceType = mesh.TrafficTraceType
+	case "fault-injection":
+		resourceType = mesh.FaultInjectionType
+	case "circuit-breaker":
+		resourceType = mesh.CircuitBreakerType
+	case "gateway-listener":
+		resourceType = mesh.GatewayListenerType
+	case "gateway-route":
+		resourceType = mesh.GatewayRouteType
+	case "zone":
+		resourceType = system.ZoneType
+	case "role":
+		resourceType = system.RoleType
+	default:
+		return "", model.ResourceKey{}, errors.Errorf("unknown TYPE: %s. Allowed values: mesh, healthcheck, proxytemplate, traffic-log, traffic-permission, traffic-route, traffic-trace, fault-injection, circuit-breaker, gateway-listener, gateway-route, zone, role", resourceTypeArg)
+	}
+
+	currentMesh := c.CurrentMesh()
+	switch resourceType {
+	case mesh.MeshType:
+		currentMesh = name
+	case system.ZoneType, system.RoleType:
+		currentMesh = "default"
+	}
+	return resourceType, model.ResourceKey{Name: name, Mesh: currentMesh}, nil
+}
@@ -0,0 +1,13 @@
+package rollout_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRolloutCmd(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rollout Cmd Suite")
+}
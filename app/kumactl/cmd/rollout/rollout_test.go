@@ -0,0 +1,165 @@
+package rollout_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+type staticHistoryClient struct {
+	revisions map[model.ResourceKey][]kumactl_resources.ResourceRevision
+}
+
+func (s *staticHistoryClient) History(_ context.Context, _ model.ResourceType, name string, mesh string) ([]kumactl_resources.ResourceRevision, error) {
+	return s.revisions[model.ResourceKey{Name: name, Mesh: mesh}], nil
+}
+
+var _ = Describe("kumactl rollout", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var store core_store.ResourceStore
+	var historyClient *staticHistoryClient
+	now, _ := time.Parse(time.RFC3339, "2020-10-19T12:00:00Z")
+	key := model.ResourceKey{Name: "route-1", Mesh: "default"}
+
+	routeTo := func(service string) *core_mesh.TrafficRouteResource {
+		return &core_mesh.TrafficRouteResource{
+			Meta: &test_model.ResourceMeta{Name: key.Name, Mesh: key.Mesh, Labels: map[string]string{"team": "web"}},
+			Spec: mesh_proto.TrafficRoute{
+				Sources:      []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "*"}}},
+				Destinations: []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "backend"}}},
+				Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+					Weight:      100,
+					Destination: map[string]string{"kuma.io/service": service},
+				}},
+			},
+		}
+	}
+
+	destination := func() string {
+		route := &core_mesh.TrafficRouteResource{}
+		Expect(store.Get(context.Background(), route, core_store.GetBy(key))).To(Succeed())
+		return route.Spec.GetConf()[0].GetDestination()["kuma.io/service"]
+	}
+
+	BeforeEach(func() {
+		store = memory_resources.NewStore()
+		historyClient = &staticHistoryClient{
+			revisions: map[model.ResourceKey][]kumactl_resources.ResourceRevision{
+				key: {
+					{Revision: 1, Operation: "Create", User: "john", Time: now.Add(-2 * time.Hour), Resource: routeTo("backend-v1")},
+					{Revision: 2, Operation: "Update", User: "jane", Time: now.Add(-5 * time.Minute), Resource: routeTo("backend-v2")},
+					{Revision: 3, Operation: "Update", User: "admin", Time: now.Add(-30 * time.Second), Resource: routeTo("backend-v3")},
+				},
+			},
+		}
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				Now: func() time.Time { return now },
+				NewResourceStore: func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+					return store, nil
+				},
+				NewResourceHistoryClient: func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ResourceHistoryClient, error) {
+					return historyClient, nil
+				},
+			},
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	execute := func(args ...string) error {
+		rootCmd.SetArgs(append([]string{"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"), "rollout"}, args...))
+		return rootCmd.Execute()
+	}
+
+	It("should print revisions of a resource", func() {
+		// when
+		err := execute("history", "traffic-route", "route-1")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`REVISION   OPERATION   USER    AGE
+1          Create      john    2h
+2          Update      jane    5m
+3          Update      admin   30s
+`))
+	})
+
+	It("should restore the revision before the last change", func() {
+		// given
+		Expect(store.Create(context.Background(), routeTo("backend-v3"), core_store.CreateBy(key))).To(Succeed())
+
+		// when
+		err := execute("undo", "traffic-route", "route-1")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("TrafficRoute \"route-1\" rolled back to revision 2\n"))
+		Expect(destination()).To(Equal("backend-v2"))
+	})
+
+	It("should restore the given revision", func() {
+		// given
+		Expect(store.Create(context.Background(), routeTo("backend-v3"), core_store.CreateBy(key))).To(Succeed())
+
+		// when
+		err := execute("undo", "traffic-route", "route-1", "--to-revision", "1")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(destination()).To(Equal("backend-v1"))
+	})
+
+	It("should create a deleted resource again", func() {
+		// given
+		revisions := historyClient.revisions[key]
+		historyClient.revisions[key] = append(revisions, kumactl_resources.ResourceRevision{
+			Revision: 4, Operation: "Delete", User: "admin", Time: now, Resource: routeTo("backend-v3"),
+		})
+
+		// when
+		err := execute("undo", "traffic-route", "route-1")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(destination()).To(Equal("backend-v3"))
+		route := &core_mesh.TrafficRouteResource{}
+		Expect(store.Get(context.Background(), route, core_store.GetBy(key))).To(Succeed())
+		Expect(route.GetMeta().GetLabels()).To(Equal(map[string]string{"team": "web"}))
+	})
+
+	It("should fail when there is no history", func() {
+		// when
+		err := execute("undo", "traffic-route", "route-2")
+
+		// then
+		Expect(err).To(MatchError(`could not undo TrafficRoute "route-2": there is no history of the resource`))
+	})
+
+	It("should fail when the revision does not exist", func() {
+		// when
+		err := execute("undo", "traffic-route", "route-1", "--to-revision", "7")
+
+		// then
+		Expect(err).To(MatchError(`could not undo TrafficRoute "route-1": there is no revision 7`))
+	})
+})
@@ -0,0 +1,99 @@
+package rollout
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+func newUndoCmd(ctx *rolloutContext) *cobra.Command {
+	args := struct {
+		toRevision uint32
+	}{}
+	cmd := &cobra.Command{
+		Use:   "undo TYPE NAME",
+		Short: "Restore a previous revision of a resource",
+		Long: `Restore a previous revision of a resource.
+
+By default, the revision before the last change is restored. A deleted resource is created again.
+The revision is applied through the API Server, so it is validated like any other change.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, cmdArgs []string) error {
+			resourceType, key, err := ctx.resourceKey(cmdArgs[0], cmdArgs[1])
+			if err != nil {
+				return err
+			}
+			client, err := ctx.CurrentResourceHistoryClient()
+			if err != nil {
+				return err
+			}
+			revisions, err := client.History(context.Background(), resourceType, key.Name, key.Mesh)
+			if err != nil {
+				return err
+			}
+			revision, err := targetRevision(revisions, args.toRevision)
+			if err != nil {
+				return errors.Wrapf(err, "could not undo %s %q", resourceType, key.Name)
+			}
+			rs, err := ctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			if err := restore(rs, key, revision.Resource); err != nil {
+				return errors.Wrapf(err, "could not restore revision %d of %s %q", revision.Revision, resourceType, key.Name)
+			}
+			cmd.Printf("%s %q rolled back to revision %d\n", resourceType, key.Name, revision.Revision)
+			return nil
+		},
+	}
+	cmd.Flags().Uint32Var(&args.toRevision, "to-revision", 0, "revision to restore, defaults to the revision before the last change")
+	return cmd
+}
+
+// targetRevision picks the revision to restore. Without an explicit revision, it is the revision before the last change
+// or the last revision when the last change was the deletion of the resource.
+func targetRevision(revisions []kumactl_resources.ResourceRevision, toRevision uint32) (kumactl_resources.ResourceRevision, error) {
+	if len(revisions) == 0 {
+		return kumactl_resources.ResourceRevision{}, errors.New("there is no history of the resource")
+	}
+	if toRevision != 0 {
+		for _, revision := range revisions {
+			if revision.Revision == toRevision {
+				return revision, nil
+			}
+		}
+		return kumactl_resources.ResourceRevision{}, errors.Errorf("there is no revision %d", toRevision)
+	}
+	last := revisions[len(revisions)-1]
+	if last.Operation == string(core_store.DeleteOperation) {
+		return last, nil
+	}
+	if len(revisions) < 2 {
+		return kumactl_resources.ResourceRevision{}, errors.New("there is no previous revision")
+	}
+	return revisions[len(revisions)-2], nil
+}
+
+func restore(rs core_store.ResourceStore, key model.ResourceKey, res model.Resource) error {
+	current, err := registry.Global().NewObject(res.GetType())
+	if err != nil {
+		return err
+	}
+	labels := res.GetMeta().GetLabels()
+	if err := rs.Get(context.Background(), current, core_store.GetBy(key)); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			return rs.Create(context.Background(), res, core_store.CreateBy(key), core_store.CreateWithLabels(labels))
+		}
+		return err
+	}
+	if err := current.SetSpec(res.GetSpec()); err != nil {
+		return err
+	}
+	return rs.Update(context.Background(), current, core_store.UpdateWithLabels(labels))
+}
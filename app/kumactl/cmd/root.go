@@ -9,10 +9,12 @@ import (
 	"github.com/kumahq/kuma/app/kumactl/cmd/completion"
 	"github.com/kumahq/kuma/app/kumactl/cmd/config"
 	"github.com/kumahq/kuma/app/kumactl/cmd/delete"
+	"github.com/kumahq/kuma/app/kumactl/cmd/export"
 	"github.com/kumahq/kuma/app/kumactl/cmd/generate"
 	"github.com/kumahq/kuma/app/kumactl/cmd/get"
 	"github.com/kumahq/kuma/app/kumactl/cmd/inspect"
 	"github.com/kumahq/kuma/app/kumactl/cmd/install"
+	"github.com/kumahq/kuma/app/kumactl/cmd/rollout"
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	kumactl_config "github.com/kumahq/kuma/app/kumactl/pkg/config"
 	kumactl_errors "github.com/kumahq/kuma/app/kumactl/pkg/errors"
@@ -76,10 +78,15 @@ func NewRootCmd(root *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.AddCommand(completion.NewCompletionCommand(root))
 	cmd.AddCommand(config.NewConfigCmd(root))
 	cmd.AddCommand(delete.NewDeleteCmd(root))
+	cmd.AddCommand(apply.NewDiffCmd(root))
+	cmd.AddCommand(export.NewExportCmd(root))
 	cmd.AddCommand(generate.NewGenerateCmd(root))
 	cmd.AddCommand(get.NewGetCmd(root))
+	cmd.AddCommand(apply.NewImportCmd(root))
 	cmd.AddCommand(inspect.NewInspectCmd(root))
 	cmd.AddCommand(install.NewInstallCmd(root))
+	cmd.AddCommand(rollout.NewRolloutCmd(root))
+	cmd.AddCommand(apply.NewSimulateCmd(root))
 	cmd.AddCommand(version.NewVersionCmd())
 	kumactl_cmd.WrapRunnables(cmd, kumactl_errors.FormatErrorWrapper)
 	return cmd
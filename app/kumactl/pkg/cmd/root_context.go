@@ -32,7 +32,13 @@ type RootRuntime struct {
 	NewAdminResourceStore      func(string, *kumactl_config.Context_AdminApiCredentials) (core_store.ResourceStore, error)
 	NewDataplaneOverviewClient func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.DataplaneOverviewClient, error)
 	NewZoneOverviewClient      func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ZoneOverviewClient, error)
+	NewServiceGraphClient      func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ServiceGraphClient, error)
+	NewInspectClient           func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.InspectClient, error)
+	NewResourceWatchClient     func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ResourceWatchClient, error)
+	NewResourceDryRunClient    func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ResourceDryRunClient, error)
+	NewResourceHistoryClient   func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ResourceHistoryClient, error)
 	NewDataplaneTokenClient    func(string, *kumactl_config.Context_AdminApiCredentials) (tokens.DataplaneTokenClient, error)
+	NewUserTokenClient         func(string, *kumactl_config.Context_AdminApiCredentials) (tokens.UserTokenClient, error)
 	NewCatalogClient           func(string) (catalog_client.CatalogClient, error)
 	NewAPIServerClient         func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ApiServerClient, error)
 }
@@ -50,7 +56,13 @@ func DefaultRootContext() *RootContext {
 			NewAdminResourceStore:      kumactl_resources.NewAdminResourceStore,
 			NewDataplaneOverviewClient: kumactl_resources.NewDataplaneOverviewClient,
 			NewZoneOverviewClient:      kumactl_resources.NewZoneOverviewClient,
+			NewServiceGraphClient:      kumactl_resources.NewServiceGraphClient,
+			NewInspectClient:           kumactl_resources.NewInspectClient,
+			NewResourceWatchClient:     kumactl_resources.NewResourceWatchClient,
+			NewResourceDryRunClient:    kumactl_resources.NewResourceDryRunClient,
+			NewResourceHistoryClient:   kumactl_resources.NewResourceHistoryClient,
 			NewDataplaneTokenClient:    tokens.NewDataplaneTokenClient,
+			NewUserTokenClient:         tokens.NewUserTokenClient,
 			NewCatalogClient:           catalog_client.NewCatalogClient,
 			NewAPIServerClient:         kumactl_resources.NewAPIServerClient,
 		},
@@ -148,6 +160,46 @@ func (rc *RootContext) CurrentZoneOverviewClient() (kumactl_resources.ZoneOvervi
 	return rc.Runtime.NewZoneOverviewClient(controlPlane.Coordinates.ApiServer)
 }
 
+func (rc *RootContext) CurrentServiceGraphClient() (kumactl_resources.ServiceGraphClient, error) {
+	controlPlane, err := rc.CurrentControlPlane()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewServiceGraphClient(controlPlane.Coordinates.ApiServer)
+}
+
+func (rc *RootContext) CurrentInspectClient() (kumactl_resources.InspectClient, error) {
+	controlPlane, err := rc.CurrentControlPlane()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewInspectClient(controlPlane.Coordinates.ApiServer)
+}
+
+func (rc *RootContext) CurrentResourceWatchClient() (kumactl_resources.ResourceWatchClient, error) {
+	controlPlane, err := rc.CurrentControlPlane()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewResourceWatchClient(controlPlane.Coordinates.ApiServer)
+}
+
+func (rc *RootContext) CurrentResourceDryRunClient() (kumactl_resources.ResourceDryRunClient, error) {
+	controlPlane, err := rc.CurrentControlPlane()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewResourceDryRunClient(controlPlane.Coordinates.ApiServer)
+}
+
+func (rc *RootContext) CurrentResourceHistoryClient() (kumactl_resources.ResourceHistoryClient, error) {
+	controlPlane, err := rc.CurrentControlPlane()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewResourceHistoryClient(controlPlane.Coordinates.ApiServer)
+}
+
 func (rc *RootContext) catalog() (catalog.Catalog, error) {
 	controlPlane, err := rc.CurrentControlPlane()
 	if err != nil {
@@ -182,6 +234,19 @@ func (rc *RootContext) CurrentDataplaneTokenClient() (tokens.DataplaneTokenClien
 	return rc.Runtime.NewDataplaneTokenClient(adminServerUrl, ctx.GetCredentials().GetAdminApi())
 }
 
+func (rc *RootContext) CurrentUserTokenClient() (tokens.UserTokenClient, error) {
+	ctx, err := rc.CurrentContext()
+	if err != nil {
+		return nil, err
+	}
+
+	adminServerUrl, err := rc.adminServerUrl()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewUserTokenClient(adminServerUrl, ctx.GetCredentials().GetAdminApi())
+}
+
 func (rc *RootContext) adminServerUrl() (string, error) {
 	components, err := rc.catalog()
 	if err != nil {
@@ -39,14 +39,14 @@ var HelmTemplates = func() http.FileSystem {
 		},
 		"/README.md": &vfsgen۰CompressedFileInfo{
 			name:             "README.md",
-			modTime:          time.Date(2020, 9, 18, 8, 21, 4, 141572929, time.UTC),
-			uncompressedSize: 9833,
+			modTime:          time.Date(2026, 10, 19, 10, 5, 56, 922834082, time.UTC),
+			uncompressedSize: 10229,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x9a\xdf\x73\xdb\xb8\x11\xc7\xdf\xf9\x57\x6c\xc7\x2f\xfd\x61\x51\x4e\xd3\xbb\xc9\xf8\x2d\xb5\xe7\x5a\xcd\xa5\x1e\x4f\x7c\xbd\x3e\x38\x9e\x10\x02\x56\x14\x2a\x10\xcb\xc1\x82\x76\x74\xa7\xfc\xef\x1d\x80\xa2\x64\xcb\xfa\x41\x89\x4c\xa3\x87\x44\x16\x81\x05\x3e\xd8\xc5\x8f\xfd\x12\xf7\x7f\xb8\x7f\xb8\x9f\x55\x85\x18\x18\xca\xe9\x61\xf9\xbd\x72\xe6\x21\x49\xce\x20\xfc\x91\x24\xbf\x4c\x31\x7e\x03\x39\x15\xce\x03\x57\x65\x49\xce\x33\x08\x63\xc0\x4f\x11\x26\x28\x7c\xe5\x90\x41\x58\x05\x54\x7a\x4d\x96\xa1\x74\xf4\xa8\x15\x2a\x18\xcf\x21\x0b\xb5\xa5\x37\xa0\x2d\xfb\x50\x4b\x92\xf5\x8e\xcc\xa0\x34\xc2\x62\x96\xc6\x16\x36\x8c\xff\x13\x4d\x01\x8f\x6f\xff\x92\x26\xc9\xd9\x19\xfc\x2a\x4c\x85\x9c\x24\x0b\xb8\x15\x4e\x14\xe8\xd1\x41\xeb\xcf\x02\xae\x91\xa5\xd3\xb1\x67\xd0\xcf\x27\xd8\x9c\x88\xca\xf8\x13\xaa\x26\x8b\xc1\x31\x9f\xa6\xda\xa0\xff\x4f\x07\x9b\x8b\x64\x01\x59\x6e\x68\x2c\x4c\xaa\x0b\x91\x63\xea\x30\xd7\xec\xdd\x3c\x6b\x37\x6e\x4d\x71\x98\x90\x8b\xa1\xf4\x73\x08\xb1\x68\x8a\x8f\xf5\x45\x36\x23\x9b\x0f\x14\xc9\x19\xba\x41\x8c\xe0\xfa\x7b\x3a\xd6\xd6\x3b\x31\x4f\x35\x65\xbb\x7c\xb1\x41\xe1\x45\x9e\x1d\xe1\x7d\x2f\xf2\xee\x00\x4b\x9b\x56\x9b\x73\x50\xb5\x61\x06\x4f\x70\x15\xa6\x44\xfa\xbe\x2c\x7f\x45\xc7\x7b\x62\x37\x52\x94\xc2\xcb\xe9\xdd\x9c\x3d\x16\x37\xa2\x40\x2e\x85\xc4\xec\x40\x8b\xb7\xa1\x4e\x9c\xc3\x0e\x0d\x0a\x46\xb0\x4d\x55\x78\xd2\xbe\x7e\x14\xb1\x38\x1a\x06\x23\xc6\x68\x0e\xf8\xc2\xbb\x0a\x33\x38\x69\x5e\x40\xb6\x5c\x19\x6e\xc3\xc2\x90\x1a\xca\x3f\xe0\x23\x9a\x03\x11\x15\x3b\x78\x75\x0b\x86\x72\x30\xa1\xfc\x25\x90\x45\xa0\x09\xd0\x64\xf2\x69\xa1\xed\x84\x3e\x2d\x14\x8e\xab\xfc\x88\x88\x0a\xb5\xfa\xa1\x28\x48\x1d\x1e\x8f\x35\x45\x28\xce\x2b\x02\xf6\xc2\x2a\x61\xc8\xe2\xa7\x85\xc3\x82\x3c\x7e\x5a\xd4\xf1\xda\x8a\x62\x5d\x3d\xeb\x48\xf1\x5b\x1b\x1b\x4b\x8a\x50\x36\x46\x52\x3f\x2b\xad\xd5\xe6\xc4\xaa\xaf\x28\x66\x8a\xff\x11\x47\xef\xbd\x52\x0e\x99\xb3\xed\x2d\xfe\xfb\xe3\x87\x30\xf8\x75\xd1\x95\x67\xba\x51\x9c\x5e\xf5\x15\x85\x25\x85\x77\x68\x50\x7a\x72\xd9\xee\x16\x6f\x48\x21\x34\xe5\xe2\x2a\xb5\x9a\xce\x57\xb5\x39\x88\xf6\xa0\x24\xc5\x6d\xe7\xc5\xef\x30\xab\xc6\xe8\x2c\x7a\xe4\x54\xd3\x90\xf8\x12\x8c\xb6\xd5\x97\xf3\x8d\x07\xc2\xc9\xe9\x25\x88\x42\xfd\xf8\x37\xf8\x9a\x6d\xa1\xd0\xf6\xbf\xb1\x67\x3f\x09\x6d\x2a\x87\xb7\x64\xb4\x7c\xb5\x79\x2c\x60\xf9\x18\xca\xf8\x3c\xb8\x25\x50\x14\x95\x17\x5e\xdb\x1c\x9e\x70\x3c\x25\x9a\x81\x2e\x4a\x83\x05\x5a\x5f\x1f\x39\x56\xa4\xa3\x65\x2b\x20\xa9\x28\xc9\xa2\xf5\x81\x62\x94\x5b\x72\x27\xac\x52\xaf\x29\x18\xdd\xa3\x96\x98\x86\x70\xdf\xe3\x8b\xbb\xba\x58\x3d\x2b\x96\x0c\x5b\x3c\xf1\xbd\xe6\x45\x43\xe1\xe7\x65\x1b\x8a\x50\xac\x2f\x8a\x2b\x53\xb1\x47\x37\xba\xed\x8f\x42\x58\x4b\x21\x3c\xc8\x6e\x99\xe0\x0b\x78\xaf\x94\x0e\x0f\x85\x81\x67\x25\xc3\x86\x5b\x56\x1e\xc8\xee\xc2\x5a\x9a\xdf\x4a\xf1\xfb\xd7\xde\x66\x77\xbd\xbc\x7f\x8c\x8b\xfd\xdd\xdc\xca\xbb\x6d\x11\xb6\x3d\xa2\xea\x05\x6b\x50\xd7\x85\x50\xf9\xbb\x45\xd4\x2e\x8a\x17\x11\xb6\x3d\xa2\xba\x52\x7c\x20\xa1\xfe\x2e\x8c\xb0\xf2\x98\x2c\xe1\x28\x8a\x90\xa3\x3c\xa3\xb8\x25\x17\x03\xe7\x69\xaa\xe5\x74\x5b\xff\x35\x03\x7e\x29\x89\x51\xb5\xa6\xf8\xe1\xc7\x77\x3f\x7c\x63\x5f\xbc\x98\x27\x2d\xe7\xc5\x16\xb8\xff\xd3\xbc\x68\x4e\xc6\x29\xcf\x74\xf9\x2f\xe4\xe9\x95\xc3\xd8\xc5\xec\x45\x8b\xff\x99\xa2\x9f\xa2\x03\x72\x60\xc9\x87\xde\x87\xf2\x20\x63\x61\x9b\x47\x88\xa5\x29\x08\x56\x5a\xed\x7a\xfd\x9d\x69\x1d\x32\x55\x4e\x22\x67\x7b\x5b\x0c\x19\xf1\xcf\xef\x18\x56\xc5\x81\x4b\x94\x71\x13\x3f\xf1\x38\xd2\xe4\x17\x7a\x32\x41\xc7\x30\x16\x21\x18\xc9\xc6\xf3\xe6\xd1\x14\xde\x70\x2a\xf1\xd9\x1c\xd8\x41\xf1\xe1\x0e\x42\x31\x3d\xd1\x52\x78\x5c\x9d\x41\x56\xd9\x52\x08\x9d\xd0\x9b\x63\x29\x72\xb4\xe8\x44\xd8\xeb\x85\x55\xc0\x68\x26\x03\xd6\xb9\xdd\x3b\xbd\xb6\x53\xcc\x70\x7e\x28\x4b\x0a\x14\x33\x9c\xf7\xd0\xfb\x6f\x42\x51\xe7\xad\x65\x65\xcc\xf6\x23\xd4\x46\x7e\x31\x0a\xc5\x6f\x57\xa5\x3b\x9d\x69\xb3\xd1\xe4\x86\xfc\xad\x43\x46\xeb\xb3\x4e\xf3\xa2\x95\x86\xb0\xa6\x88\xc5\xd7\x1a\x42\xd7\xfc\xe2\x1c\x2a\x46\x86\xb6\x99\xd5\x41\x8a\x92\x58\x7b\x72\x87\x7c\xd1\x50\x34\xc5\x3b\xf9\x22\xea\x1e\xb2\xcc\xba\xaf\x51\xad\x94\x90\x4d\x0a\x2f\xf2\x7e\x72\xbd\x2e\xbe\xb0\x3a\x45\x2b\xc6\x06\x55\xd6\xb6\xc5\xd1\x52\x93\x8c\x34\x51\xf4\xb8\xba\x19\x45\xa5\x12\x85\x0a\x47\x92\xd2\xd1\x97\x39\x68\xab\x7d\xd4\x2d\x85\xb6\x07\x8e\x15\x0b\xc8\x26\xc2\xf0\x29\x1b\xc6\x8a\xa2\x95\xf4\xf1\xfc\x14\x7d\x33\xea\x51\xff\xe8\x45\x03\xb1\xba\x5d\xa2\x7a\x30\x63\x0d\x6c\xad\x53\xd4\xbe\x33\x56\xab\x8f\x91\x37\x57\xbe\xe8\x6f\x7d\x5a\x52\x2c\x05\x4d\x7d\xac\x43\x5e\x51\xec\x5d\x9a\x76\x50\x74\x5f\x9f\x96\x14\x86\xc6\x33\x7a\xd4\x66\x2e\x86\xcb\x77\x01\x03\x69\x75\x76\x2c\x45\x2b\x91\x76\x75\x8e\x5a\x93\xf4\xb3\x46\x65\x17\xe9\x45\xfa\xe6\xd4\xd9\xad\x84\x17\x47\x6c\x79\xeb\xd3\x60\x58\x9f\xae\xfb\xda\xf9\xba\xae\xb4\xaf\x29\x0e\xc5\xd5\x76\x8a\x6e\x91\xd5\x75\xd7\xdb\xa4\x38\x1c\x57\xdb\x28\xba\x46\x55\x8f\xbe\xb0\xda\x8f\x5a\x44\xd5\x06\x45\xd8\xda\x3a\x87\xd5\xb7\xa1\xd8\x17\x55\x7b\x28\x4e\x0e\xab\x26\xa2\x82\xb5\xac\x5b\x44\xad\x28\xf6\x47\xd5\x4e\x8a\x0e\x61\xd5\xd5\x17\xda\xe6\x0e\x99\x8f\x38\x4b\x2d\x60\x34\x81\x90\x25\x9f\x83\xf6\xa0\xb0\x34\x34\x67\x18\xd5\x76\xe2\x4e\x2e\x1d\x31\x83\xac\xd5\x3e\x90\x54\x14\x95\x0d\x59\xe1\xee\xf7\x5a\x0b\x88\xc7\xa8\x93\xd5\x83\x86\x42\x39\xa1\xed\x2f\xba\x68\xf3\xfe\x22\x14\x8b\xdd\xad\xa5\x1c\x32\x0a\x8c\x66\x1f\x12\x35\x78\xd2\xc6\x00\xfb\xf0\xef\x18\x41\x48\xaf\x1f\x11\x04\x43\xb4\xaf\x6d\xbe\xcb\xe6\xdb\x0b\xee\xa0\x81\x34\x14\xad\x84\xe6\x3d\xda\x60\xe3\x8b\xef\xf3\x16\x66\x93\x62\xaf\xd0\xbc\x47\x1b\xec\x4a\xd1\x4d\x1b\xdc\xa4\x78\x21\x03\xee\x7a\xc7\xfa\x42\x1b\x6c\xfa\x7f\xb4\x20\xf8\xc2\xe6\x9b\x8b\x8b\x8b\x37\xbd\xf9\x62\xaf\x5c\x7e\x8c\x66\xde\xc0\xed\x16\x04\x7b\xd4\x06\x1b\x8a\x02\x79\xda\x36\x4b\x8a\x7a\x9f\xa7\xa5\x2f\xae\x85\x17\xf1\x06\xca\xaa\xe3\x63\x34\x64\xf3\x88\xd5\xda\x17\xea\xf4\x7b\x20\xc9\xd9\x19\x5c\x55\xec\xa9\x80\x8f\x4b\x9d\x0f\xae\x71\x12\xf6\x80\x30\xba\x49\xf2\xbe\xc9\x4b\xaf\x3e\x5e\x33\x08\x87\x60\x48\x28\x54\xf0\xa8\x45\x1c\xf1\xfb\x4c\x3a\xc5\xd9\xc3\x1f\xc3\x7f\x7f\x02\xa5\x5d\x4c\x9d\xe6\x29\xfc\x44\x0e\x0a\x72\x08\x0a\xbd\xd0\x06\x15\x84\x6c\xce\x15\xf5\x82\x4b\x76\x69\xd2\xaa\x78\xd3\xe6\x3c\x29\xeb\xf7\xff\x0e\x27\xe8\x02\xff\x7d\x30\x1f\x2f\xe1\x28\x92\x55\x81\xb6\x76\xf9\xc3\xfd\x14\x4d\x31\x90\x4e\x3d\xd4\x37\x73\x6e\xc8\xe3\xea\xae\x02\xa8\x90\x7e\x52\x89\x8e\x93\xf5\xa5\x9e\xba\xe7\x15\xc7\x3e\x78\x74\x56\x18\x13\x12\xea\x57\xf7\x82\xb2\xf3\x00\xe5\x70\x12\xfa\x1d\x6f\x16\x91\x31\xf4\x14\x96\x56\x57\x19\x64\x10\x65\x69\xe6\xf0\x34\x45\xdb\xb4\x14\x9e\x59\x7c\x0a\x0d\xf9\xd5\x45\xa4\xcb\x04\xfe\x1c\xda\x83\xac\x10\x33\x5c\xc9\x6c\xc3\x65\x73\x4d\xea\x31\x9c\xbd\xe3\xe1\xcb\xab\x48\x51\x2e\x9e\x5b\x09\x2b\xfa\x9a\x2b\x8c\xd3\xab\xde\x82\xc7\xa2\x34\xc2\x23\x87\xf6\xe2\x6d\xa8\xe6\x07\xf0\xa1\x3f\xec\x43\xdd\xa8\x29\x64\xa5\xc3\x41\x56\xdb\x29\x89\x7d\xf8\xee\x10\xa8\xd0\xde\xa3\xaa\x91\x4a\x47\x12\x99\x03\xd2\xb6\xc1\x01\x48\x92\xf5\xf5\xac\x4b\x98\x7a\x5f\xf2\xe5\x30\x52\x85\xf4\x35\x79\x76\x8f\xeb\xe5\xd3\x41\x59\x8d\x8d\x96\x03\xc1\x8c\x9e\x53\x7e\x9b\x8a\x42\xfc\x46\x56\x3c\x71\x2a\xa9\x18\xae\xea\x0d\x1e\xff\x9a\x96\x36\x4f\xd6\x4e\x5e\x1b\x0a\x3f\xa5\x3c\x1d\x2a\x92\x3c\x8c\x7e\xfd\x3c\x46\xf6\x9f\x4b\x17\x76\x42\x89\x3c\x94\x31\x94\x3f\x37\x92\xf5\x67\xb5\x0e\xe5\x61\xf2\xbf\x00\x00\x00\xff\xff\xc6\xab\x84\x50\x69\x26\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x9a\x4b\x93\xdb\xb8\x11\xc7\xef\xfc\x14\x9d\xf2\x25\x0f\x8b\x1a\xc7\xd9\x2d\xd7\xdc\x9c\x71\x6d\xa2\x5a\x67\x6a\xca\xb3\xd9\x1c\xc6\x53\x26\x04\x34\x29\x44\x20\x9a\x05\x80\x23\x6b\x97\xfb\xdd\x53\x00\x1f\x1a\x69\xf4\xa0\x44\x3a\xcb\xc3\xae\x3c\x44\x37\xf1\x63\x37\x1a\xc0\x9f\x78\xf8\xc3\xc3\xe3\xc3\xb2\xcc\xd9\x44\x51\x46\x8f\xcd\xef\xd2\xa8\xc7\x28\x7a\x05\xfe\x1f\x51\xf4\xd3\x02\xc3\x2f\xe0\x0b\x66\x1c\xd8\xb2\x28\xc8\x38\x0b\x4c\x29\x70\x0b\x84\x14\x99\x2b\x0d\x5a\x60\x5a\x00\x15\x4e\x92\xb6\x50\x18\x7a\x92\x02\x05\xcc\xd7\x90\x78\x6b\xee\x14\x48\x6d\x9d\xb7\xe2\xa4\x9d\x21\x35\x29\x14\xd3\x98\xc4\xe1\x09\x3b\xce\xff\x89\x2a\x87\xa7\xb7\x7f\x89\xa3\xe8\xd5\x2b\xf8\x99\xa9\x12\x6d\x14\x55\x70\xc7\x0c\xcb\xd1\xa1\x81\xde\x57\x05\x1f\xd0\x72\x23\x43\xcf\x60\x9c\xcb\xfb\x4c\x59\xa9\xdc\x05\xa6\x51\x35\x39\xe7\x6a\xcd\x26\xe3\x5f\x03\x7c\x56\x51\x05\x49\xa6\x68\xce\x54\x2c\x73\x96\x61\x6c\x30\x93\xd6\x99\x75\xd2\xef\xbd\xb5\xcd\x21\x25\x13\x52\xe9\x47\x9f\x62\xc1\x95\x3d\x37\x16\xc9\x92\x74\x36\x11\xc4\x97\x68\x26\x21\x83\xeb\xdf\xf1\x5c\x6a\x67\xd8\x3a\x96\x94\x1c\x8a\xc5\x0e\x85\x63\x59\x72\x46\xf4\x1d\xcb\x86\x03\x00\x00\x40\x05\x5a\xaa\xd7\x20\x6a\xc7\x16\x1c\xc1\x8d\x1f\x12\xf1\xfb\xa2\xf8\x19\x8d\x3d\x92\xbb\x81\xa2\x60\x8e\x2f\xee\xd7\xd6\x61\x7e\xcb\x72\xb4\x05\xe3\x98\x9c\x78\xe2\x9d\xb7\x09\x63\xd8\xa0\x42\x66\x11\x74\x6b\x0a\x2b\xe9\xea\x5b\x01\xcb\x06\xc7\xa0\xd8\x1c\xd5\x89\x58\x38\x53\x62\x02\x17\x8d\x0b\x48\x9a\xca\x70\xe7\x0b\x43\xac\x28\xfb\x88\x4f\xa8\x4e\x64\x54\xe8\xe0\xcd\x1d\x28\xca\x40\xf9\xf6\xd7\x40\x1a\x81\x52\xa0\x34\xfd\x5c\x49\x9d\xd2\xe7\x4a\xe0\xbc\xcc\xce\xc8\x28\x6f\x35\x0e\x45\x4e\xe2\xf4\xfb\xd8\x50\xf8\xe6\xb6\x23\xb0\x8e\x69\xc1\x14\x69\xfc\x5c\x19\xcc\xc9\xe1\xe7\xaa\xce\xd7\x5e\x14\x1b\xf3\x64\x20\xc5\x2f\xa4\x7b\x53\xf8\xb6\x21\x93\xc6\xa9\xb4\x5a\xaa\x0b\x4d\x5f\x50\x2c\x85\xfd\x47\x78\x7b\xef\x85\x30\x68\xed\x5e\xa2\x0a\xfe\xfd\xe9\x23\x50\x0a\x75\xd3\x2e\x32\xc3\x28\x2e\x37\x7d\x41\xa1\x49\xe0\x3d\x2a\xe4\x8e\xcc\xc1\x98\x54\x70\x4b\x02\xa1\x6d\x17\xaa\x54\x37\x9c\x6f\x6a\x77\x10\xfc\x41\x41\xc2\xf6\x1d\x17\xbf\xc2\xb2\x9c\xa3\xd1\xe8\xd0\xc6\x92\xa6\x64\xaf\x41\x49\x5d\x7e\x7d\xbd\x73\x83\x19\xbe\xb8\x06\x96\x8b\xef\xff\x06\xbf\x25\x7b\x28\xa4\xfe\x6f\xe8\xd9\x0f\x4c\xaa\xd2\xe0\x1d\x29\xc9\x5f\x4c\x1e\x15\x34\xb7\xa1\x08\xf7\x81\xd2\x40\x91\x97\x8e\x39\xa9\x33\x58\xe1\x7c\x41\xb4\x04\x99\x17\x0a\x73\xd4\xae\x5e\x72\x74\xa4\xb3\xe6\x29\xc0\x29\x2f\x48\xa3\x76\x9e\x62\x96\x69\x32\x17\x54\xa9\x97\x14\x16\xcd\x93\xe4\x18\xfb\x74\x3f\x12\x8b\xfb\xba\x59\x3d\x2a\x28\xdd\xf4\x6f\x3b\x12\xbf\xd7\xb8\x68\x29\xdc\xba\xe8\x43\xe1\x9b\x8d\x45\x71\xa3\x4a\xeb\xd0\xcc\xee\xc6\xa3\x60\x5a\x93\x4f\x0f\xd2\x7b\x06\x78\x05\xef\x85\x90\xfe\x26\x53\xf0\xac\x25\x38\x82\xa2\x74\x40\xfa\x10\x56\xe3\x7e\x2f\xc5\xaf\xbf\x8d\x36\xba\xeb\xf2\xfe\x29\x14\xfb\xfb\xb5\xe6\xf7\xfb\x32\x6c\x7f\x46\xd5\x05\x6b\x52\xdb\x82\x37\xfe\xdd\x32\xea\x10\xc5\x56\x86\xed\xcf\xa8\xa1\x14\x1f\x89\x89\xbf\x33\xc5\x34\x3f\x67\x97\x70\x16\x85\xdf\xa3\x3c\xa3\xb8\x23\x13\x12\x67\xb5\x90\x7c\xb1\xaf\xff\xd2\x02\x7e\x2d\xc8\xa2\xe8\x4d\xf1\xdd\xf7\xef\xbe\xfb\xc6\xb1\xd8\x1a\x27\x3d\xc7\xc5\x1e\xb8\xff\xd3\xb8\x68\x57\xc6\xb1\x5d\xca\xe2\x5f\x68\x17\x37\x06\x43\x17\x9f\x8f\xf1\x0a\xfe\xb3\x40\xb7\x40\x03\x64\x40\x93\x03\x47\xe0\xdb\x03\x0f\x8d\x75\x16\x20\x1a\x57\xe0\xbd\xf4\x9a\xf5\xc6\x5b\xd3\x4a\x9d\xf9\x85\x47\x53\x59\x14\x9a\x18\x35\x9b\x2b\x14\xa7\x28\x5c\x69\x34\xcc\x6a\x6b\xb4\xed\x58\x69\xdc\x01\x57\xcc\x5a\x90\xda\x51\x5d\xb9\x32\xe6\x70\xc5\xd6\xc0\x49\xa7\x32\x6b\x28\x52\xa6\x2c\x26\xdf\x86\xa2\xfd\x8b\xef\x47\xd2\x98\xc1\x6c\xab\x77\x0b\xa6\x85\xda\x99\x9e\xcf\x9d\x38\xaa\x5a\x4c\x18\x27\x16\x06\x2d\x95\x86\xa3\x3d\xe6\xae\x02\xaf\x4e\xfc\xf8\xce\x42\xd7\x1c\x6c\x81\x3c\x2c\xa8\x2e\x5c\x1a\xb6\x7b\x3d\x99\xa6\x68\x2c\xcc\x99\x2f\x0c\xa4\xc3\xda\xff\x6c\x0a\xa7\x6c\xcc\xf1\x59\x3d\x3a\x40\xf1\xf1\x1e\x7c\x33\x99\x4a\xce\x1c\x76\xeb\xc1\x6e\xe7\xea\x87\xb1\xef\xcd\xb9\x14\x19\x6a\x34\xcc\xa1\x08\xfa\x8f\x45\x95\x4e\xac\xcc\x34\x8a\xb3\x29\x96\xb8\x4e\xe0\x34\xc5\x12\xd7\x23\xf4\xfe\x9b\x50\xd4\x1a\x42\x51\x2a\xb5\x7f\x39\x0b\xb0\xb5\xd7\x9b\xf9\xe6\x77\x5d\x6b\xb8\xfc\xf2\x6b\xda\xf4\x96\xdc\x9d\x41\x8b\xda\x25\xc3\x46\x77\x1f\x3d\x67\x43\x11\x9a\x6f\xf4\x9c\x41\x14\x21\x16\xa5\x2f\x70\x7d\x77\xb9\x27\x29\x0a\xb2\xd2\x91\x39\x15\x8b\x96\xa2\x6d\x3e\x28\x16\x41\x83\xe2\x45\x32\x42\xa5\xed\xa3\x4a\xed\x52\x38\x96\xc1\xd0\x6b\x70\x2c\xb4\xdc\x37\xb5\x1d\x33\x83\x59\xa3\x0f\x07\x9a\x20\x40\xdd\xdc\xce\x82\x6a\x8c\x4c\x00\xa5\x50\x18\xfa\xba\x06\xa9\xa5\x0b\x1a\x32\x93\xfa\xc4\x12\x6f\xf0\xac\xa7\x65\x3f\x19\x6a\x63\x16\xfa\x3c\x9e\x16\x05\x63\xe8\x51\x5a\xf6\x13\x0d\x4e\xaa\x07\x9e\xad\xb7\x5c\x30\xb6\x7a\xa0\xe5\x39\x52\x73\x17\x8b\xf1\xea\x53\x43\xd1\x88\xcb\xf2\xdc\x80\xbc\xa0\x38\x5a\x9a\x0e\x50\x0c\xaf\x4f\x0d\x85\xa2\xf9\x92\x9e\xa4\x5a\xb3\x69\xf3\x5d\x66\xc2\xb5\x4c\xce\xa5\xe8\x25\x98\x77\xeb\xa8\x0d\xc9\x38\x35\x2a\xb9\x8a\xaf\xe2\x37\x97\x8e\x6e\xc1\x1c\x3b\x63\xca\xdb\xac\x06\x7d\x7d\xfa\x30\xd6\xcc\x37\xb4\xd2\xbe\xa4\x38\x95\x57\xfb\x29\x86\x65\xd6\xd0\x59\x6f\x97\xe2\x74\x5e\xed\xa3\x18\x9a\x55\x23\xc6\x42\x4b\x37\xeb\x91\x55\x3b\x14\x5a\xba\xe1\x69\xf5\x6d\x28\x8e\x65\xd5\x11\x8a\x8b\xd3\xaa\xcd\x28\xef\x2d\x19\x96\x51\x1d\xc5\xf1\xac\x3a\x48\x31\x20\xad\x86\xc6\xa2\xd9\x58\x9f\xb1\x96\xaa\x60\x96\x82\x57\x2c\x5e\x83\x74\x20\xb0\x50\xb4\xb6\xdd\x5e\xdc\xcf\xe4\xdc\x50\xd8\x95\x07\xe5\x15\x38\xe5\x79\xa9\xfd\xae\xf0\xf0\x37\xc6\x0a\xc2\x32\xea\xa2\x17\xf0\x9c\x42\x18\x26\xf5\x4f\x32\xef\xf3\x2d\xc9\x37\x0b\xdd\xad\x65\x35\x52\x02\x94\xb4\xce\x6f\xd4\x60\x25\x95\x02\xeb\xfc\x7f\xe7\x08\x8c\x3b\xf9\x84\xc0\x2c\x04\xff\x52\x67\x87\x7c\xbe\xbd\xb2\x00\x83\x29\x7a\x89\xfe\x00\x07\x75\xda\x36\x16\x03\x32\x6a\x44\x8a\xa3\xa2\xff\x0e\xc5\x73\x9d\x76\x28\xc5\x30\x9d\x76\x97\x62\x4b\x92\xdd\x6f\xb6\xa3\xd3\xb6\xfd\x3f\x5b\x9c\xdd\xf2\xf9\xe6\xea\xea\xea\xcd\x68\xb1\x38\xfa\xe9\x02\xa0\xff\xf7\x8b\x16\xee\xb0\x38\x3b\xa2\x4e\xdb\x52\xe4\x68\x17\x7d\x77\x49\x41\x7b\x75\xd4\xc4\xe2\x03\x73\x2c\x9c\x06\xea\x3a\x3e\x47\x45\x3a\x0b\x58\x3d\xaf\xaa\xd3\x75\x2f\xa0\xf0\xa7\x8c\x6e\x4a\xeb\x28\x87\x4f\x8d\xce\xe7\x0f\x79\xf8\x39\xc0\xbf\xdd\x28\x7a\xdf\xee\x4b\x6f\x3e\x7d\xb0\xc0\x0c\x82\x22\x26\x50\xc0\x93\x64\xe1\x8d\x3f\x24\xdc\x08\x9b\x3c\xfe\xd1\xff\xef\x4f\x20\xa4\x09\x5b\xa7\x75\x0c\x3f\x90\x81\x9c\x0c\x82\x40\xc7\xa4\x42\x01\x7e\x37\x67\xf2\x10\x38\x20\xdd\xb8\xd4\x22\x9c\x7a\x7a\x1d\x15\xf5\x59\x0c\x83\x29\x1a\x70\x04\x0f\xde\xbd\xbf\x05\x82\x78\xe9\x3f\x72\x06\xcb\xc7\x87\x05\xaa\x7c\xc2\x8d\x78\xac\x4f\x49\xdd\x92\xc3\xee\xdc\x08\x08\xbf\xfd\xa4\x02\x8d\x8d\x36\x07\xac\xea\x9e\x97\x36\xf4\xc1\xa1\xd1\x4c\x29\xbf\xa1\x7e\x71\x46\x2b\x79\xed\xa1\x0c\xa6\xbe\xdf\xe1\x94\x17\x29\x45\x2b\x5f\x5a\x4d\xa9\xd0\x02\x2b\x0a\xb5\x86\xd5\x02\x75\xfb\x24\x7f\x4f\xe3\xca\x3f\xc8\x75\x87\xc2\xae\x23\xf8\xb3\x7f\x1e\x24\x39\x5b\x62\x27\xb3\x4d\x9b\xc7\xb5\x5b\x8f\xe9\xf2\x9d\x9d\x6e\x1f\x0b\x03\x47\x60\xfd\xf7\x85\x8e\xbe\xe6\xf2\xef\xe9\x45\x6f\xc1\x61\x5e\x28\xe6\xd0\xfa\xe7\x85\x93\x69\xed\x1f\xc0\xf9\xfe\x58\xe7\x6d\x83\xa6\x90\x14\x06\x27\x49\xed\xa7\x20\xeb\xfc\x6f\x83\x40\xb9\x74\x0e\x45\x8d\x54\x18\xe2\x68\xad\x47\xda\xf7\x72\x00\xa2\x68\x73\x54\xee\x1a\x16\xce\x15\xf6\x7a\x1a\xa8\xfc\xf6\x35\x7a\x76\xa6\x6e\xfb\xee\xa4\x28\xe7\x4a\xf2\x09\xb3\x16\xfd\xb7\x8c\xb7\x31\xcb\xd9\x2f\xa4\xd9\xca\xc6\x9c\xf2\x69\x67\x37\x79\xfa\x6b\x5c\xe8\x2c\xda\x04\x79\xe3\xc8\xff\x29\xb6\x8b\xa9\x20\x6e\xa7\x21\xae\x5f\xe6\x68\xdd\x97\xc2\xf8\x99\x90\xa3\x9d\xf2\x90\xca\x5f\x5a\xc9\xfa\x8b\xd8\xa4\xf2\x34\xfa\xdf\x00\x3e\x44\xf0\xd2\xf5\x27\x00\x00"),
 		},
 		"/crds": &vfsgen۰DirInfo{
 			name:    "crds",
-			modTime: time.Date(2020, 8, 7, 14, 28, 17, 766503188, time.UTC),
+			modTime: time.Date(2026, 10, 19, 10, 51, 32, 269605944, time.UTC),
 		},
 		"/crds/circuit-breaker.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "circuit-breaker.yaml",
@@ -69,6 +69,20 @@ var HelmTemplates = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x3c\xdb\x72\xdb\xc6\x92\xef\xfc\x8a\x2e\x9d\x07\xd9\x55\x24\x65\xc7\x39\x5b\x7b\xf4\xa6\x95\xed\xac\x36\xbe\x95\x25\x67\x6b\x2b\x4e\x6d\x0d\x81\x26\x39\x47\xe0\x0c\x32\x33\x90\xc4\x7c\xfd\x56\xf7\x5c\x00\x10\x17\x42\xb6\x92\x3d\x7a\x13\x49\x34\x7a\xfa\x7e\x1d\x51\xca\x5f\xd0\x58\xa9\xd5\x39\x88\x52\xe2\x83\x43\x45\xff\xd9\xe5\xed\xbf\xdb\xa5\xd4\x67\x77\x2f\x57\xe8\xc4\xcb\xd9\xad\x54\xf9\x39\x5c\x56\xd6\xe9\xdd\x67\xb4\xba\x32\x19\xbe\xc6\xb5\x54\xd2\x49\xad\x66\x3b\x74\x22\x17\x4e\x9c\xcf\x00\x32\x83\x82\x3e\xbc\x91\x3b\xb4\x4e\xec\xca\x73\x50\x55\x51\xcc\x00\x94\xd8\xe1\x39\xd0\xef\xca\x42\x28\x94\xca\xca\xcd\xd6\xd9\xe5\x6d\xb5\x13\x4b\xa9\x67\xb6\xc4\x8c\x20\x6c\x8c\xae\xca\x73\x88\x1f\xfb\x07\x2d\x7d\x03\xe0\x11\x79\x1d\x61\x5c\x79\x18\xfc\x55\x59\x54\x46\x14\x3d\x2f\x98\x01\xd8\x4c\x97\x78\x0e\x27\x27\x33\x80\x3b\x51\xc8\x9c\x51\xf4\x20\x75\x89\xea\xe2\xd3\xd5\x2f\xaf\xae\xb3\x2d\xee\x84\xff\x10\x20\x47\x9b\x19\x59\xf2\xef\x3a\x2f\x04\x69\xc1\x6d\x11\xfc\x23\xb0\xd6\x86\xff\x4d\xaf\x86\xf8\x6e\xb8\xf8\x74\x15\x00\x96\x46\x97\x68\x9c\x8c\x47\xa1\xbf\x06\x03\xd2\x67\x07\xaf\x3e\x25\xdc\xfc\x6f\x20\x27\x92\xa3\x7f\xf5\x9d\xff\x0c\x73\xb0\x1e\x09\xbd\x06\xb7\x95\x16\x0c\x96\x06\x2d\x2a\xc7\x67\x6c\x80\x05\xfa\x89\x50\xa0\x57\xff\xc4\xcc\x2d\xe1\x1a\x0d\x01\x01\xbb\xd5\x55\x91\x43\xa6\xd5\x1d\x1a\x07\x06\x33\xbd\x51\xf2\x8f\x04\xd9\x82\xd3\xfc\xca\x42\x38\xb4\xae\x05\x51\x2a\x87\x46\x89\x82\xa8\x5a\xe1\x1c\x84\xca\x61\x27\xf6\x60\x90\xde\x01\x95\x6a\x40\xe3\x9f\xd8\x25\xbc\xd7\x86\x08\xb4\xd6\xe7\xb0\x75\xae\xb4\xe7\x67\x67\x1b\xe9\xa2\xc8\x65\x7a\xb7\xab\x94\x74\xfb\xb3\x4c\x2b\x67\xe4\xaa\x72\xda\xd8\xb3\x1c\xef\xb0\x38\x13\xa5\x5c\x30\x9e\xca\xb1\x98\xee\xf2\xbf\x99\x20\x8e\xf6\xb4\x81\x98\xdb\x13\xbb\xad\x33\x52\x6d\xd2\xc7\x2c\x3b\x83\x64\xfe\x59\xaa\x9c\xd8\x2a\xc2\x63\x1e\xdd\x9a\x9a\xf4\x11\x11\xe1\xf3\x9b\xeb\x1b\x88\x2f\x65\x8a\xb7\x49\xcc\xc4\xad\x1f\xb3\x35\x9d\x89\x2e\x52\xad\xd1\x78\x3e\xad\x8d\xde\x31\x44\x54\x79\xa9\xa5\x72\xfc\x4f\x56\x48\x54\x6d\x1a\xdb\x6a\xb5\x93\x8e\x18\xfb\x7b\x85\xd6\x11\x3b\x96\x70\x29\x94\xd2\x0e\x56\x08\x55\x99\x0b\x87\xf9\x12\xae\x14\x5c\x8a\x1d\x16\x97\xc2\xe2\x53\x53\x99\x08\x6a\x17\x44\xc1\xe3\x74\x6e\x5a\x83\xf8\xd7\x27\xfc\xac\x00\x74\x0a\x16\xd4\x83\x2f\x00\x44\x9e\xb3\x75\x11\xc5\xa7\x81\x87\x07\x31\xe8\x55\xa3\xfa\x4d\xcc\x66\x05\x95\xb2\xce\x54\x99\xab\x0c\xe6\x70\x8b\xfb\xc0\xf1\x9d\x28\xc1\x3a\x4d\x1f\xde\x4b\xb7\xed\xbc\x51\x34\xb9\x2f\x1c\xb3\x75\x85\x60\xd1\xc1\x6a\x0f\x64\x43\x59\x21\x9c\xd6\x05\x6b\x0e\xc3\x62\xc5\x30\xe8\x8c\xc4\x3b\xec\x82\x34\x2b\xe9\x8c\x30\xfb\x44\xbb\x25\xdc\x6c\x71\x0f\xc2\x20\x10\x9b\x7f\xaf\xd0\xec\xc5\xaa\xf0\x70\x82\xc2\xae\x10\x58\xc8\xcc\x1d\xe6\x1d\x90\xf7\x5b\x54\xb0\xd3\xb9\x5c\xef\x49\x72\xbd\x58\x76\x95\xef\xfc\xec\xec\xb6\x5a\xa1\x51\xe8\x90\x05\x23\xd7\x99\x3d\xab\x2c\x9a\xc5\xa6\x92\x39\x9e\x35\x18\x74\x3a\xeb\x23\xbd\x87\xdc\xfa\x2a\x2b\x2a\xeb\xd0\x7c\x20\x7b\x3f\xc6\x93\x9b\x2d\xb2\x6d\xf7\xa6\x0b\xe3\x73\x70\xbf\x95\xd9\x96\x3f\x09\xda\xb4\xc2\x42\xab\x8d\x17\xfc\x9b\x43\x8d\x63\x33\x64\xa1\xb2\x98\x13\xb9\x73\x69\x49\x57\x2b\x69\xb7\x89\x51\x96\x39\x09\x96\xde\xc5\x2f\x24\x2a\xb2\x57\x29\x45\x46\xe4\x80\x5c\xae\xd7\x68\x0e\x35\xaf\x71\x18\xeb\xdf\x0c\x6b\x89\x05\xdb\x09\x62\x0b\xf1\x5c\xa8\xfd\xfd\x16\x0d\x82\x61\xc7\xa0\xf4\x3d\x43\x17\xa5\x64\xce\x18\xe8\x41\x77\xa3\xd9\x9a\x68\x90\x1b\xc5\xfc\x70\x20\xd7\x0c\x4d\x2a\xef\x40\x11\xb4\x09\x9a\x1d\xf5\x7e\x39\x9b\x28\xf9\x5d\x0f\x3c\xc6\x84\x93\xcb\xc3\x9f\x7b\x2b\xe8\xd2\xbf\x1d\x13\xe8\x0f\xd6\x55\x45\xb9\x43\x2f\x77\x6c\xdf\x02\xef\xee\x85\x0d\x47\x22\x13\xe5\x22\xe9\x36\x95\x30\x42\x39\xf4\x4c\xf3\xfa\xd3\x65\xab\x82\xad\x28\x4b\x54\x76\xb1\xc2\x35\x51\x4a\x9b\x1c\x0d\x88\xcc\x68\x6b\xc1\x62\x29\x0c\xd3\xaa\x44\xe3\x65\x74\x09\x97\x6c\x40\xbd\xb5\x55\xba\x0b\x93\xa8\xcc\xf8\xb1\xb6\x47\x94\xd2\x19\x31\xa7\xb7\x7e\x7e\x7b\xf9\xea\xd5\xab\x7f\x90\x63\xdf\x31\x3b\xa5\xa5\x8f\xbf\xdc\x5c\x2e\xe1\xab\xea\xc0\xfc\xa4\xcb\x8a\x9c\x63\x4e\x16\x80\x29\xb4\xb7\x0e\x77\x4b\xf8\x8c\x22\x5f\x68\x55\xec\x97\xf0\xa1\x2a\x0a\x0e\x14\x0a\x69\xdd\x93\x7b\xc1\x68\x37\x4e\x0e\x70\xa3\x03\x08\xc7\x41\x11\x2e\x88\x41\x53\x85\x28\xc7\x02\x09\xfa\x4f\x46\x64\xf8\x09\x8d\xd4\xf9\x35\x66\x5a\xe5\x76\x54\x9a\x3e\x54\xbb\x15\x1a\x52\x68\xeb\x7f\x0d\xa2\x28\xf4\x3d\xe6\x21\x46\xaa\xe5\xc2\x69\xd8\x10\xec\x75\x55\x14\xfb\xae\x2c\xa1\xd9\x49\x45\xbc\x0d\x8c\x97\x0e\xee\x65\x51\x90\xa4\x18\xdc\xe9\x3b\x82\x18\x1d\x68\xa4\xf6\x47\x55\xec\x99\xbf\x24\x84\x1d\x90\xf1\x44\x6d\x39\x2f\xac\xa6\x47\x96\xf0\x5e\xec\x81\x38\xc5\xb2\xb8\xd5\xc6\xa1\x22\x89\xad\x39\x38\x40\x59\xa9\xdc\xbf\xfd\xd8\x4b\x55\x8a\x8d\x36\x07\x7a\xd2\x41\x62\x5c\x37\x5f\xf7\xe1\xfc\xf9\xed\x25\xb0\x74\xb2\x75\x20\xe9\x64\xcd\x13\x2e\x19\xce\x1e\x93\x93\x7c\x56\xa4\x22\x63\x42\x27\x6c\x9b\xb5\xe0\xc6\x6a\x35\xf7\x1a\x2d\x12\xb3\x06\xe9\xea\xd5\x88\x4d\x55\xad\x08\xe4\x49\xe6\x51\x83\x48\xef\x73\x69\x30\x73\x9e\x4f\x8e\x3d\xda\x6a\xdf\xe3\x62\x7d\x18\xc4\x5e\xb0\x46\x5d\x5a\xc0\x87\x12\x33\x97\x8c\x46\x38\x04\x3c\x53\x1a\xc8\x45\xa0\x81\x3b\x69\xe5\xaa\xe8\xfa\x58\x96\x96\x04\x8a\x95\xd0\x23\x46\x58\x19\x14\xd9\x36\x60\xc3\x8e\xe1\x39\x88\xb5\x43\x1f\xd5\x33\x75\x65\x57\xa0\x5c\x22\xdc\x1c\xb4\xe2\x70\x00\x61\x2d\x95\x28\xe4\x1f\x14\xef\xd1\x3b\x18\xe7\x5d\xe9\xf6\x4b\xb8\xb0\x8c\x22\x08\x7b\xf0\xc3\x0e\x60\x7e\x90\xf4\x5e\x48\x0a\x56\x1c\xee\xec\xbc\x45\xe6\x55\xa1\xb3\x5b\xe2\xdd\xc7\xf8\xda\x8e\x5c\xf5\xb9\x48\x8b\x6e\xde\xb0\x7d\xd1\x44\x72\x10\xa9\x88\xf1\xda\xc4\x48\x66\x5d\x19\xb7\x25\xe7\xa5\x42\xec\xbf\xae\x28\x4e\x9a\x77\x59\x55\xb8\xad\xae\x36\x5b\x52\xd0\x18\x09\x45\xed\x81\x90\x16\x25\xaa\x87\x1f\x44\xae\x95\x46\xea\x1e\x37\xa2\x3d\x8e\x44\xf6\x25\xbc\xd5\x06\xf0\x41\xec\xca\x82\xb2\x0b\x96\xa7\x90\x60\xb0\xa4\xf9\x10\x4c\x40\xa9\x59\xc2\x02\xe4\x3e\x47\xf2\xea\x45\x34\x49\x5e\xaa\x7e\xae\x56\xf4\x63\xaf\x0f\xc4\x7f\x96\x7b\x8b\x2a\x27\x37\x57\xcb\x7b\x32\x45\x87\xc9\x14\x3b\x13\xb9\xf1\xb1\x9e\x8f\x5f\x3c\xcb\x88\xf7\x52\xf1\x27\xa5\xce\x97\x70\x11\x24\x49\xb8\x06\x12\x73\xfe\x3e\x20\xd1\x8d\xde\x08\x29\xc2\x05\x04\x6c\x85\xc9\x9b\x48\xc4\x97\x3e\xbb\xbe\xfa\xe9\xe7\xab\x77\xef\x9e\x77\x5e\x4f\x62\xdd\x65\x14\x63\x91\x15\x28\x54\x55\xce\x83\x11\x8d\x48\xd6\xb6\xf4\xe2\xd3\x15\x67\x12\xfc\x05\xbb\xc4\x8c\xe3\x33\x85\xee\x5e\x9b\xdb\x0e\xd8\x52\x18\xc7\x61\xba\x9d\xb7\xcc\x3b\xf1\xc8\x3a\x3a\x06\x3e\x90\x38\x47\x75\x0a\x8c\x65\x19\x9d\x43\xa5\x9c\xec\x5a\x14\xa1\x40\xe4\x3b\xa9\xa4\x75\x46\x38\x6d\x48\x8e\x44\xe5\xf4\x4e\x78\xa9\xd1\x19\x5a\x0b\x99\xa0\x84\xd8\x13\x06\xdb\x72\xd6\x63\xff\xd8\xcd\xd4\x6e\x85\x62\x91\x75\x8c\xe1\xe6\x35\xb3\x93\x96\x85\x90\x34\x9c\x66\x2b\xba\x10\xbd\xe6\xa0\xaa\x8d\x1e\xc5\x06\x43\xb1\xc0\xa1\x19\x4d\x6f\xea\x53\xd4\x06\xc4\x46\x04\xf1\x2f\x1e\x31\xd4\x06\x6d\xd4\xa7\xbd\xaf\x2c\x5b\x1c\xb6\x8a\xd1\xbb\x37\x48\x5d\x6b\x71\x2d\x94\x06\x37\x24\x0b\x1d\x1f\x0c\xf0\x46\x64\x5b\x40\xe5\xcc\x3e\x24\x75\x32\xa7\x33\xae\x25\x9a\x54\x95\x31\x68\x4b\xad\xd8\x2b\x40\xa6\x77\xa5\x56\xa8\x82\xe1\x20\x3d\xeb\x71\x95\x49\x35\x3c\xe4\x84\x07\x19\x66\x16\x9c\x5e\x93\xdb\x96\x99\x3e\xbe\x2a\xad\x16\x4a\x16\x73\x86\x2b\x31\x98\x09\x19\x5c\x05\x09\x74\x8c\x40\x42\x8c\x73\x78\x60\xf6\x05\x8f\x4a\x82\xfd\x57\xc2\x18\xd1\x76\xb3\x1b\x54\x14\x33\xe3\xd1\x24\xed\xe4\xa7\xc6\x2f\x03\x91\x75\xe9\x13\x73\xb2\x10\x6b\xf9\x30\xf7\xc9\x57\x2b\x6c\x98\xf7\xd9\xf5\xf8\x52\x32\xe4\x4a\xfe\x5e\x85\x6c\xec\xe3\x87\x77\xff\x03\x57\x6f\xf9\x69\x7e\x8b\x8f\x46\xb6\xc2\xd6\x4a\x56\x1a\x7d\x27\xf3\x2e\x45\xc0\xb3\xa3\x19\xc2\x10\x32\xde\xbc\x32\x74\x83\xae\x32\xca\x87\x0c\x75\x85\xa5\x8e\x83\x06\x33\x3f\xb7\x15\xaa\x06\x53\x0a\x6b\x53\xb8\xe4\xfd\x27\x83\xe0\x08\x72\xc5\x92\xb5\x92\x2a\x14\x0d\xd2\x01\xbb\x1e\xa3\x5a\xaf\xe5\x83\x77\x41\xf1\x4c\x01\xdc\x36\x44\x06\x9c\xa6\xd6\x55\x4a\x30\x55\x81\x36\x86\x0d\x44\x9f\xae\x71\xf3\x41\x48\x2c\xbe\xad\x10\x9c\xa9\x54\xd6\xb4\x42\x05\xaa\x8d\xdb\x46\x11\xf5\x58\xb0\x9d\x91\x86\x49\xd3\x81\xb9\x13\xb7\x5e\x07\x3c\x72\x81\x5f\x5a\x35\x78\xcc\xf6\xae\x43\x7e\x5b\x62\x46\x0a\xd8\xe3\x82\x28\x54\xdd\x62\x12\x03\x9f\x83\x7b\x07\x11\x1c\x62\x8c\x39\x89\xb2\x1f\x3e\xde\x04\xe6\x81\x80\x1f\x5f\xfc\x03\x16\x3d\x7e\xdd\x3a\x14\xf9\x3c\xa5\x07\x28\x39\x6c\x09\x8f\xfd\xf0\xe2\x25\x5c\xfa\xdc\x93\x7c\xc8\xdf\x5f\xbc\xf0\xdc\xf9\x8c\xc2\x6a\x15\x0a\x73\xa4\xbf\xba\xea\x4b\x3e\x73\x99\x09\xe7\xa3\x81\xa6\xb8\x66\x5c\x7d\x09\x81\xd3\x5a\x57\x2a\x8f\xee\xde\xc7\xe1\x45\xa1\x9d\xc3\x7c\x3e\x78\xfe\x20\x81\xa1\x8c\x63\x90\x6c\xcc\xb3\xa8\x53\xc5\xbe\x1b\x7a\x32\x22\x9c\x99\xf6\x08\x29\xc2\x67\x82\xb0\xf0\x61\xc6\x16\x45\x8e\xe6\x39\xb3\xe6\xa2\x2c\x0b\x49\x47\x27\xa3\x22\xd7\x10\x35\x98\xdd\x5e\xe4\x52\x57\xa1\x9e\xd6\xcf\xc8\x1c\x77\xa5\x76\xa8\xb2\xfd\xc9\x54\x57\x12\x04\xe4\xa0\x2c\xde\x31\x4d\x17\x60\xc9\x51\x52\x84\xa2\x7c\xde\xd9\x2a\x55\x88\x78\xc8\xac\x01\x10\xf4\xba\x97\x86\x39\x5a\xd6\x04\xeb\x84\xc3\xe5\x94\x8c\xfe\x49\xf2\x41\xee\xa0\x4c\x71\x9b\x27\x17\xaa\xf9\x63\x5f\xa3\x61\x0e\xe8\xa2\x48\x35\x33\x54\x6b\xcd\xf5\x2e\xab\x77\x11\xe7\x1e\xc1\xbe\x13\x46\x0a\xe5\x28\x65\x0c\x5e\x37\xd6\x8c\x42\xd4\xdd\xce\x09\x85\xf7\x4f\x7a\xdd\x42\xb7\xcf\x5e\x52\xa4\x74\xe7\x4b\x96\x7b\x74\x20\x38\x55\xd3\xad\x82\x90\x0f\xbc\x64\x41\x0a\xc9\x31\x40\x2b\x6e\xec\x00\x25\xa3\xc8\x0e\x80\x3c\x37\x85\x05\x24\xca\x09\x0b\x4a\x81\x48\xe1\xef\xa5\xc5\xf9\x41\x14\x91\x91\xcf\xcf\xd1\xf4\x18\xa2\x4a\x35\x40\xc4\xec\x74\x2b\xf3\x1c\x15\x3c\x93\x8a\x8f\x7b\x76\x2f\x5c\xb6\xe5\x2f\x37\x48\xce\xb9\x28\xec\x73\x1f\x0a\x78\xfd\x1d\x21\x80\x3a\x75\x94\xa9\x16\x32\x93\x94\xea\x0a\x7b\xeb\xdd\x8f\x5e\xb1\x7d\x3b\x78\x7f\xaa\xcd\xf6\x54\x96\xfe\x9b\xa3\x46\xd5\x3c\x96\xb7\x67\xf3\x56\x6c\x49\xa6\xaf\x0c\x22\xdb\x88\x28\x7a\xeb\xd7\x6c\x81\x2a\x63\xd8\x04\x61\x87\xad\xa1\x8c\x52\x1a\x79\x27\x0b\xdc\x60\xce\x39\x97\xaf\xa7\xf9\x1c\xb1\xeb\x2a\xb8\xcc\x5c\xbf\x37\xe4\xa5\xb2\xce\x7e\xe7\x31\x3d\x0c\x56\x93\x9f\x20\xd3\x14\xf2\xcc\x0e\xc8\xd5\x1e\x84\xda\xf3\xab\xd9\x94\xbd\x7e\xf3\xe9\xf3\x9b\xcb\x8b\x9b\x37\xaf\x61\xd1\x42\x97\x4b\xe4\x94\x30\x14\xe5\x56\x04\x91\x25\x9e\xf5\x46\x76\x8d\xe2\x91\x54\x70\xf7\x72\xf9\xf2\xef\xcb\x43\xa3\x54\x8e\x34\x1b\x4a\x9f\x1d\x76\xbf\x38\x50\xd6\x4f\x21\x8b\x1c\xd4\x9d\xd0\x39\xa0\x50\x18\x1f\x30\xab\x1c\xf6\x80\xe4\xb4\xd5\x17\x3c\x53\x98\x5c\x27\x58\x14\x85\xf8\x52\xc7\xd2\x4b\x89\xef\xd0\x59\x17\xb1\x1c\x80\xd8\x32\x21\x81\x1a\xb1\x10\x02\x6b\x21\x0b\x42\xdc\xa0\xad\x0a\xd7\xa8\x19\xe0\xb8\xea\x73\x64\xc3\xcd\x94\x14\x57\x71\x9d\x55\xb3\xa6\x47\xbf\xd7\xa7\x9b\x14\xd7\x34\x94\xa1\x17\x32\x3d\x1f\xce\x4a\x20\x45\x51\x44\x15\x5c\xf6\xfc\x7e\x20\x46\x3e\xc6\xdb\x90\xd4\xf5\x84\xc3\x03\x4c\x6e\x76\x2e\x62\x4e\xca\x6c\x65\xba\xd6\x29\x07\xa5\x21\xe9\x84\x43\x7c\x69\x54\x94\x82\x99\x1c\xfc\xd9\x48\xb0\x1f\xf3\x17\x1f\xd5\x0d\x9d\x63\xc1\xa8\xcf\x86\x61\x0f\x98\xe2\xe1\x64\x22\x14\x18\xab\xc2\x1d\x55\x8d\xab\x75\x5b\xb8\x7c\x40\x46\x34\x7c\x2b\x64\x51\x19\x8c\xc1\xec\x48\x26\x95\x2a\x24\x2b\x84\x12\x8d\x95\x36\x54\x04\xad\xd3\x46\x6c\x30\x0a\x9c\x8a\x99\x24\x25\x5c\xb6\x32\xbe\x7f\x41\x4e\xaf\xd7\xe6\xf8\x6e\x8f\xef\x1e\x70\x2e\x16\xac\x75\x33\xd9\xeb\x63\xcb\x31\xa9\xea\x6f\xf2\x8f\x74\x2a\x1f\xd7\xf0\x1f\x14\x94\xf6\x20\xc0\x63\x9b\xff\x83\x60\x7b\x87\x02\x1e\x33\x08\x30\x08\xf9\x2f\x1c\x10\x78\x94\x42\x65\x3a\xc7\x49\xac\xbb\xae\x36\x1b\x5f\xfe\xfe\xcf\x9b\x9b\x4f\x31\x0b\xa1\xc7\xeb\xf6\x07\x05\x98\x95\x9d\xc3\x0b\x90\xeb\x41\x4a\x84\xc2\xd4\x90\x11\x68\xc4\x9a\xaf\x7e\x18\x3d\x55\x5f\xcc\x59\xa3\xee\x84\x2c\xec\xa4\x93\xbd\x79\x70\xa8\x28\x55\xcd\x85\x13\x20\xac\xd5\x99\xe4\xf0\x38\xa9\xaf\xe1\x9c\x6a\xe9\x4b\x32\x23\x32\xc9\x99\x17\x49\x86\x97\x6d\x90\xce\x82\xbe\x57\xdc\x38\xf7\x6f\xf0\x68\x1d\x04\xa1\x83\x10\x53\x2d\x22\x7a\x19\xc6\x30\x25\xfd\xbd\xed\xc6\x4c\x53\x9c\xbc\x1b\x36\xb1\x9a\xa3\x8f\xa0\x67\xf8\x90\x61\x19\x0a\x46\x1e\xe9\x94\x15\x84\xe3\x10\xad\x87\x78\x75\xdc\xe7\x00\x64\xa2\xb2\x63\xdf\xf7\xf4\xcd\x2f\xf9\x11\x6f\x8b\x41\xaa\xac\xa8\x72\xb4\xb0\x23\xcd\x09\x04\x6c\x70\x69\x04\x30\xd4\x1c\xbc\x66\xc9\x0c\xb9\xf1\xda\x5b\xe3\x25\x7c\xd0\x8e\x3d\x6e\xf3\x5b\x8e\x06\x47\x81\x86\xd2\x46\xc0\x05\xf3\x70\xc4\xe5\xc8\x43\x23\x7e\xfb\x31\xb4\x6c\x54\x44\x8e\xfd\xe8\x30\xc5\xba\xd9\xc6\xd2\x53\x70\xeb\xed\x41\x0f\x4a\x45\xf8\x18\xf9\x51\xb8\xc1\x95\xa3\x31\xda\xcc\x29\xc4\x21\x8f\xcb\x52\x43\xe2\xfe\x5f\xd7\x1f\x3f\x80\x45\xc3\x11\x81\x18\x72\x2b\x9d\xb4\xbc\x66\x34\xe4\xc4\x14\x95\x43\xa9\xad\x5b\xcb\x07\x88\x33\x1a\x6c\x66\x14\x9b\xa0\x09\x10\x85\xf3\xe6\x93\x6c\xee\x05\x09\x92\x8f\xa6\xff\x40\xa3\x17\x52\xe5\xf8\x40\xf9\x15\xbc\x25\x8a\x1c\xe7\x78\xf4\x75\x25\x0a\xe3\xe5\x90\xeb\x67\xdc\x18\x93\x9c\xc3\x78\x59\xd5\xeb\x20\x0b\x90\x57\x38\x85\x90\xda\xf3\xc4\x52\x66\x45\x1e\x7c\x57\x15\x4e\x96\x05\x7a\xea\x52\xbe\x12\x2c\x00\x27\x0a\x6f\x7c\xaf\xc8\x9e\x4f\x00\xfd\x15\xe0\xeb\x09\x71\xe6\xeb\x09\x2c\x42\x53\x8e\xb8\x9f\x3e\x0c\xd5\xae\x90\x2d\x4d\x80\x98\x04\x86\x20\xb3\x40\xff\xfa\xe2\xb7\xe5\xc8\x2b\x26\xc0\x0c\x48\xac\xa5\xb1\x2e\xd0\x30\x14\xbc\x55\x7c\xc9\xd7\x93\xe3\x80\x8e\x7a\xb9\x46\x3e\x87\xd6\x8a\x0d\x3e\x52\x7d\x2e\x60\x5b\xed\x84\x5a\x18\x14\x39\xb7\x52\x1b\xdf\xa6\x09\x1f\xe2\xfc\x94\x33\xfb\x9f\x33\x87\x97\xd0\xf4\x04\xa1\xbe\x59\x4f\x6b\x08\xbb\x18\xf1\x0e\x6d\x9b\x4e\xf6\x3a\x47\xb3\x7c\x4a\x62\x79\x17\xf0\x68\x5a\xed\x44\xb6\x95\x0a\xc7\xa8\x35\xe1\x50\x4c\xcf\x03\x6a\xc5\x82\xac\xaf\xdb\xc6\x0c\x9c\x7e\x61\xa6\x80\x64\x87\xc9\xd1\x17\xc5\x18\x84\x8d\xb8\x13\xb2\x20\x1c\x9f\x90\x6e\x47\x12\x8d\x29\x09\x47\x2a\x16\xf2\xb8\xf0\x63\x7c\x27\x3f\x51\x5b\xbf\x8e\xb5\x7f\xac\xe3\xf4\x21\x5d\xcb\x43\x2e\x67\xdf\x49\xa4\xc3\x61\xd5\xf1\x94\x81\x4e\x45\x4f\xfc\xc9\x87\x82\x8f\xca\x57\x16\xeb\x81\x2b\x1f\xca\x71\x0f\x65\x14\x6e\xa3\x97\x17\x47\x44\x12\x6a\x3f\x4b\x95\xff\x45\x03\xab\xdf\xc4\x8b\xf1\xa2\xc0\xd0\x50\xe3\x9f\xca\x0a\x78\x16\x06\xed\xd0\x60\x98\x5a\x96\x6a\x53\xe0\x70\x6a\x5f\x0f\x86\xca\x6c\xcb\xf9\xed\x2a\x1a\x9d\x15\xe6\xcf\xbf\x5b\x60\xb9\x8d\xc1\x3d\x88\x81\x39\xb1\xb1\x92\x40\xea\x46\xcc\x9b\x6d\x8f\x34\x43\x56\x77\x89\x47\x8f\x96\xa4\xb2\x31\x21\xeb\x67\x6e\xf3\x25\x5c\x93\xdc\xfa\x90\x21\x4c\x62\xfb\xae\xca\xb8\x99\xaa\xbb\x35\x5c\xac\x73\xe2\x36\x54\x1b\x39\xdb\x75\x08\x22\xe3\x17\x2e\x42\x82\xa7\x6d\x7c\xc9\xec\x68\x74\x58\x3b\xb4\x88\x0b\x6c\xf5\xbd\x1f\x12\x72\x1a\xee\x85\x74\xe9\xe4\xe2\xf6\xa8\x45\xdd\x62\x07\xad\x31\xa6\x4e\xc9\x21\xa7\xe5\x91\x5c\x51\x97\x8f\xb0\x56\x5f\xae\x5e\x1f\xea\xc4\x72\x48\xa0\x67\x93\xc2\xad\x21\xa1\x7e\xf4\xb8\x73\x3d\x3e\x60\xff\x56\xc9\xef\xb6\x1d\x47\xdd\xdc\x98\x99\x7f\x82\xfd\x84\xd9\x84\x4a\xe3\x37\xed\x2a\xcc\x26\x68\xcc\x37\xed\x2d\x0c\x02\xfe\xcb\xdd\xc3\x51\xf6\x1e\x09\x93\x1f\x1d\x1c\x07\x33\x7f\xac\xac\x97\xac\xdc\xf2\xdb\x11\xef\x2e\x68\x0c\x0b\xde\xb5\x13\x2a\x17\x26\xf7\x8d\x8c\x7a\x41\xe1\x2f\x67\xc8\xa4\x4a\x8a\x26\x4d\xa8\xa6\xbb\xeb\xf8\x40\x73\x8d\x43\xae\xd3\xec\xaa\x1f\xf1\x87\x42\xee\xe4\x78\xfe\x17\xb2\x34\x95\xe6\x9f\x39\x31\x4b\x75\xa8\x30\x03\x1b\xec\x7c\x68\x14\x1c\xf3\x67\x61\x18\x62\x2b\x62\x61\x87\x6b\x6f\x29\x1a\xe7\x50\x23\x45\xf9\xba\x14\xbf\x57\xd8\x3b\xfa\xd7\x1e\xeb\xe0\x63\xc6\x6d\x09\x69\x2d\x3f\xa4\xc3\xd8\x44\x18\xaa\xd4\x87\x8b\x49\xc2\x1d\xc7\x34\x6f\x74\x1e\x9d\x4e\xdb\x2e\x9e\x2e\xf8\x90\xba\x8d\xe9\x04\xe3\x04\x8d\x5d\xd1\x4b\xcf\x21\xdf\xd1\xe7\xc6\x91\x75\x64\x5e\xbc\x38\xd6\x3d\xc5\x52\xdb\xfe\xc9\xdf\x56\x76\xb3\x6e\x8e\x99\x64\x5a\xad\xe5\xa6\x0a\x41\x03\xd7\x77\xb6\x42\x6d\xfc\xb4\x48\x5d\xc3\x10\xe3\x91\x2d\xde\xc3\x4e\xaa\x8a\xd8\xca\xdd\xef\x7a\x52\xa8\xf6\x6f\xb1\xa0\xef\x7d\x7e\x94\x8a\x23\x81\x1a\x2a\xa8\xac\xb7\xeb\xbe\x67\xe6\x25\xb5\x31\x7c\xb4\xc2\x30\xf0\x96\xa5\x29\xd4\x51\x98\x41\x5a\x9a\x15\x85\xd0\xaa\xc2\x39\x54\xaa\x40\x6b\x61\xaf\x2b\x7f\x0e\x83\x19\xca\xbb\x23\x58\xfa\x89\x4e\x7d\x8b\xca\x3b\x09\xa1\x7c\xfc\x13\xad\xe3\x13\xc4\x95\x2d\x0a\x4e\x8f\x32\xae\x5d\xdd\xf0\x49\x6e\xdd\x36\xd8\x7f\x7a\x6a\x53\xdb\x62\x9c\x6a\x81\x79\x71\xc3\x32\x6e\x30\x10\xe4\x10\x73\xc4\x01\xb8\xd8\x3f\xea\x19\xa8\x3a\x28\xbd\x87\xb9\x55\xe6\x72\x90\x75\x4f\xf6\x20\x82\x4b\xf8\xc5\x0f\x69\x87\x79\x49\xe7\xfb\xfe\xa3\x60\x45\x32\x03\x0d\x54\xb8\x4e\xc8\x22\x09\x95\x4a\x8d\xf7\x95\xc8\x6e\xa7\x48\x4c\x9c\xf4\x9a\xb2\xe2\x52\x7b\x84\x51\x90\x4f\xe0\x2d\x32\xad\x7c\x51\x2e\xdb\x2f\xc2\x10\xcc\x42\xa8\x7c\x91\xcc\x43\xb6\xff\xee\xac\xcf\x62\xb1\x7e\x27\xd5\xed\x64\x89\x8b\x0f\xf8\x28\xed\xcb\xe7\x77\x87\xc1\xd9\x84\xe6\xee\xc4\x6d\xa2\x3f\x39\x2a\x1d\xaf\x69\x3d\xb2\x92\x75\xbf\x0d\xa3\x21\x29\x70\x99\x8d\xd4\x9e\x82\x6d\x3a\x09\xdd\xe0\x93\x10\x15\x8d\x97\xb5\xc6\xfa\x43\x83\xc5\x2c\xb8\x88\x73\x80\x59\x21\x8c\x37\x0e\x42\xf9\xce\x9d\x7f\xe9\x48\x94\x91\x23\xac\x2a\x07\xb9\x46\xdf\x5f\xd2\x77\x68\x8c\xcc\x11\xa4\xfb\xe6\xb0\xcc\xbf\x74\x72\x50\x96\x62\xc5\x46\x39\x66\x09\x1f\x15\x82\x5e\x9f\xc3\xc9\x75\x95\x65\x68\xed\x49\xdf\xc0\x4e\xfc\x4b\x54\x7e\xea\x68\x8e\xf2\x79\x56\x48\x7f\xa6\x6f\x0c\xb1\x47\xe4\x74\x78\xc6\x61\x31\x30\xff\x32\x08\xac\x10\x2b\x2c\xfe\xec\xed\xe3\xf7\x82\xc7\xc3\x43\xea\x76\x8b\x7b\x6f\x97\x7d\xc7\xbb\xeb\x49\x9c\x06\x6d\x36\x42\xc9\x3f\xb0\x77\x8a\x94\x82\xc8\x8d\x36\xf2\x0f\x84\x67\x7c\xb7\x81\xdf\x0b\xc6\x02\x33\xf7\xbc\xb1\xec\x2b\xf6\xb0\xe3\x31\x36\xff\x95\x36\xb6\x6f\xfe\xd1\x60\x59\xf0\xa8\x2b\xe9\x42\x1a\x29\xb4\x01\xa6\xb9\x93\x19\x7e\xc3\xe6\xb0\xa7\xeb\xe4\xa5\xe1\x9d\x50\x62\x83\xb9\xef\x36\x8d\x8f\x42\xbe\x6f\xfe\x14\x76\xa2\xb4\x70\xaf\xcd\xed\xba\xd0\xf7\x0b\xe9\xc7\xbf\xa2\xcb\x0e\x91\x6c\xdf\x72\xa9\x5e\xc7\xc6\x92\xdf\x21\x32\x18\x71\xf0\x76\x57\xb8\x04\x35\xf4\xa2\x25\xc5\xe1\xd6\x15\xfb\x30\xd3\x33\x10\x3a\x6c\x75\x65\xf1\x16\xb1\x94\x6a\xe3\xe3\x7e\x3f\x41\xe7\xf6\x25\xc5\x69\xc5\x3e\x94\xa7\xd4\xa9\x03\x15\x3a\xd2\x61\xfb\xaa\x52\x39\x1a\xeb\xfa\x82\xf8\xba\x64\x44\x96\x2b\x62\x16\xa5\x26\xe6\x2b\xa7\xbe\xd5\x38\x6f\x0d\x87\xc6\x0f\xbb\x24\x30\xf5\x7c\x3b\x05\xe6\xf5\xc0\xac\x28\xcb\x62\x0f\xa5\x70\x5b\x28\xe4\x2d\xc2\xd7\x93\x4c\x2e\xb2\xfc\xeb\x89\x0f\x6b\x43\x24\xef\xe9\xd7\xb7\xe9\x20\x8a\x7b\xb1\x4f\xd6\x3c\x71\x23\x64\x3d\x35\xfa\x2c\xed\x07\xbb\xea\x7d\x21\x49\x1c\x5b\xf9\xaa\x0e\x67\x53\x79\xee\xcf\xeb\x04\x53\xa2\x11\xc1\xc7\x59\xbf\x7b\xe9\xb6\x7d\x13\xde\x4a\x3b\x99\x61\x67\x02\x70\xa0\x11\x3d\x9e\x7e\x1e\x1b\xf2\x69\x3b\xcd\xd1\x09\x9f\xc6\x4d\x1e\x8d\xf6\xf3\xec\x68\x25\x91\x53\x55\x1e\xf9\x8e\x9b\xf2\x18\xaa\x7c\x44\xa8\x13\xee\x7a\x9c\x85\x77\x9c\xc0\x3f\x2b\x3b\x04\x93\x39\xce\x75\x58\x5d\x2e\x0a\xb2\xf1\x4d\x8c\x83\x0c\x86\x55\x6e\x24\x27\x23\xcc\x9e\x35\xcd\x88\xec\x76\x36\x5c\x17\x6a\x9c\x4f\x34\x70\x5e\xa1\x6f\x63\x49\xb6\x81\x21\x9b\x0b\xfb\x5e\x5e\x61\x66\x83\xc9\xfe\x1d\x1a\xd7\x37\xc3\x3e\xc1\xbb\xac\x7b\x2d\xcd\x88\xf5\x07\x67\x06\x3a\xd6\x2d\xe6\x06\xb3\xd4\x48\x39\x44\x5b\x5f\x96\xdf\x32\x7a\xe7\x4d\x93\x99\x20\x5c\xde\x3a\x9a\xee\x3e\x54\x08\x16\x92\xee\x31\xc8\x91\x28\x71\x8b\x16\x27\xa0\x3c\x48\xe0\x14\x95\x4c\x40\xfa\x63\x2a\xdd\x87\x9b\x75\x08\x36\x61\x5c\xd7\xf4\x7d\x8d\xb7\x40\x91\x0f\x67\x57\xac\x0d\x2d\xf7\xf0\x86\x5b\xe5\x2b\x24\xc3\x92\xae\x21\x20\xcd\xe0\xa5\x08\xde\xb2\x09\x5e\x78\x78\xd6\xaa\xa9\x64\xe4\x26\x4e\x2f\xc8\x3a\x9e\xb2\xd5\x39\xfd\xc2\x65\xcc\xd3\x6f\xa2\x90\x93\x43\x8d\xa5\x76\x4b\x49\xfa\xbd\x0d\xd7\xdc\x34\x8b\xe5\xf2\xc4\x23\xb8\xa7\x48\x78\x64\x6a\xec\x2a\xad\x9c\x04\xeb\x9c\xb6\xf0\xe4\xba\xcd\x80\x70\xc0\xd9\x58\xdf\x60\x68\x3f\x70\xc2\xc1\x47\x44\x7d\xa8\xe1\xab\x8e\xad\xa9\x9d\xf2\x72\x4b\x4c\x96\xc3\xba\x0e\x19\x7e\xa9\x40\xd4\x77\x7d\x2c\xe1\xca\xd6\x6b\x4f\xbd\xf7\x04\xf8\x55\x08\x3f\x04\xed\x07\x07\xe7\xf5\x96\x33\x77\x3f\xeb\x6b\x45\x76\x62\xef\x2f\x38\x48\x2b\xeb\x7d\xb2\x59\xef\x2a\x63\x7b\x13\x85\x5b\x49\x25\x39\x16\x23\x85\x8b\x7d\xc3\xa6\xe5\x5b\xf6\x2f\x7c\x49\x0b\xa5\x91\x3b\x61\x24\xaf\x43\x84\xc9\x39\x12\xd5\xb4\xc8\x51\xef\xdd\xf8\xe0\xb0\x5d\xeb\xca\xd3\xe5\x5d\x5d\x69\xe9\x29\xd1\x7f\x4f\x1b\x85\x69\x7f\x3a\x75\xf5\x27\x71\x6a\x3c\x04\xfc\x90\x2e\x6f\x69\x3a\x50\xff\x49\xe0\x3a\x8a\x6c\xeb\x29\xda\x96\x8a\xee\x81\x2f\x54\xd0\x83\xc6\x95\x30\x16\x48\x48\xee\x44\xe1\x79\xca\xe0\xbf\x9e\xe4\xb8\x16\x55\xe1\xbe\x9e\xd4\x3f\x9d\x53\x22\xd8\x01\xd9\xfc\x69\xb0\x68\x99\x50\x5a\x71\xa1\xae\x3d\x98\x5b\x8f\xd8\xc5\x32\x10\xd9\x98\x28\xa3\x7d\x6b\x94\x2b\xf4\x17\x9a\xe5\x7e\xa8\xa5\xc6\x7a\xd1\x58\xd8\xd3\xad\xbd\xbc\xba\x3b\x19\x5e\x32\x1b\x1a\xa8\x0e\xb7\x15\x7c\x55\x69\x53\x57\xc0\xeb\x0f\xd7\xff\xfb\xee\xe2\x3f\xde\xbc\x5b\x8e\x0b\xc7\xac\xaf\xd4\x73\x54\x58\x12\xfe\x76\xf2\x82\x98\xbe\x57\x68\x3e\x23\x2f\x6e\x66\x38\x9e\x2e\xbc\x0b\xfb\x17\x91\xba\x39\x96\x5e\x5d\x56\xfb\xce\x5e\xd2\xc5\xbb\x77\x83\x04\x0a\xb1\x2c\x97\x9d\xb9\x50\xc7\x6b\x49\x69\xc2\xbc\x75\xe7\x4d\xa0\xe5\x46\x98\x95\xd8\x20\x64\x14\x86\x67\x6e\x6c\x7b\xb5\xde\x8d\x68\x24\x21\xcd\x20\x7e\xee\x27\xda\x85\xaa\xa7\xbf\x52\xb9\xbd\x9f\x99\xa1\x76\xaf\xeb\xf2\x71\x84\x94\x26\x0b\x1a\x0b\x64\x75\x3c\xc6\x91\x5c\x9f\x9e\xdc\x70\xad\xa5\x8e\xd1\x9a\x53\x7e\x98\xc2\x89\x06\xd0\xe5\xff\x47\x64\xdd\x0e\xa3\x49\x93\xfc\x7e\xef\x37\x79\x68\xbe\x69\xe3\x23\x49\x5b\xbc\x8a\x65\x02\x12\xc4\x53\x53\xe1\x1c\x2e\x3e\xbc\x8e\x1d\x07\x96\xd8\xb4\xe2\x7b\xb2\xd6\x06\x29\x20\x57\x79\x84\x3b\x34\xc1\x97\xd6\xea\x83\x00\xd4\xc0\x6a\x46\x74\x16\xe6\x6f\x71\xbf\x60\x33\x30\x00\xd4\xdf\x49\xc6\xb7\x2f\xc4\x54\x23\xe8\x52\x63\x2b\x68\x09\xaf\xbd\x0d\xe3\x59\xff\xb5\x28\x2c\x2e\xe1\x66\x28\xf4\x4a\xf7\x2a\xc5\x65\x64\xdf\x3f\xa3\x04\xd7\xc2\x89\xc7\xf0\x04\x4a\x34\x3b\x69\x9b\xec\xe1\xb3\xcc\x07\x80\xea\xb8\xdc\x07\x3f\xfe\xf0\x03\x3c\xfb\xa2\xc2\xa2\x0d\xd7\x19\xdf\x28\x27\xdd\xfe\x79\xe3\x5e\x20\xdf\x55\x19\x63\xf4\x4a\xeb\x02\x45\x5f\x05\xb2\x96\xda\xc7\x70\xf8\x80\x78\xac\x72\x69\x35\x62\x82\x46\x4c\xc3\x6d\x78\x4a\xa0\x67\x46\xe0\x50\xec\xff\xea\x46\xed\x11\x8d\x1a\x1e\xa6\xea\x89\xe7\x8e\x9d\xe5\xfb\x03\x91\x49\x38\x0f\x4e\xb7\x8c\xcc\xb5\x3c\x05\xc6\xc3\x13\x28\xa3\x08\x8f\x2d\x80\x2d\x1a\xf6\xb4\xf7\x6b\xe2\x6c\xef\x17\x03\x93\x65\x0b\xa2\xce\x53\x84\xf8\x47\x1a\x7d\x9d\x6d\xe8\xd0\xe9\xf2\xd1\x0e\x57\x96\xea\x41\x96\xb0\xb1\x18\x57\x92\x92\x43\x98\x8d\xcd\xc1\x8c\xf7\xf3\x06\x7a\x76\x3d\x0b\xcb\xcd\x1e\xde\xfb\x46\xbb\x9d\x62\x30\x5d\x3a\xb9\x93\xd6\xc9\x0c\x1a\x3d\xac\x79\x78\x80\xdf\xc1\x93\x5b\xc3\x97\x07\xf8\xb5\xe4\x3a\x2d\xd6\xaa\x79\x23\xa5\x36\xb1\xd6\x90\x92\x94\x74\x25\x5e\x77\x6f\x97\x47\xda\x28\x61\x08\x89\x64\x28\x47\x8b\xe6\x34\xc1\x23\x7b\x87\xb1\x5f\xc8\xd7\x57\xee\x1a\x77\xaa\xf9\x54\x9b\x68\x20\xfc\xa5\x41\x59\x55\x08\xd3\x83\xf9\xe0\xd5\x65\x76\xec\x7e\x9d\x56\x23\x72\x5a\xe7\x74\xb0\x5b\xfa\xd4\x26\x73\x42\xb7\x72\x72\xe4\x3b\xd4\x95\x6c\xef\xa1\x4d\xef\x44\xb6\xe8\xd9\xbb\x2b\x7e\xb4\xfb\x38\x88\x6b\x8f\xd9\x6c\x6b\x31\x19\xcc\x90\x1d\x85\x8c\x5d\xaa\x70\x89\x86\xca\x43\x36\xe7\xf5\xfb\xe0\xf6\xc0\x9e\x38\xda\xdf\xc9\x9c\x4a\xec\xf5\x1d\x23\xed\xdb\xec\xb4\x02\xeb\x3b\x63\xeb\xaa\xa8\xb3\xe5\x1e\xb1\x6b\x68\x55\xe3\xfe\xba\x78\x9d\xa1\xd3\x51\x67\xb5\x82\x4f\x5f\x6e\x5a\x77\x50\x36\xc5\xb4\x6f\xb5\xfd\x68\xff\xfc\xdb\x5c\xc5\x44\x21\xea\xb5\xcd\xdd\xe6\x63\xcf\xcf\x0e\x3e\x0a\x76\x35\x3c\xb5\x08\x17\x7c\xdf\xbd\xe4\x9a\xfc\xcb\x59\x73\x34\xa8\x55\x3c\x0d\x6b\xba\xe1\xb3\xfa\xcd\x22\xcb\xb0\x74\x98\x7f\x38\xbc\xef\x3b\xac\xb7\xc4\x1b\xbe\xf9\xdf\x4c\x2b\x5f\xa3\xb5\xe7\xf0\xeb\x6f\xb3\x10\xd3\xe6\xbf\x44\x9c\xe0\xd7\xdf\xfe\x2f\x00\x00\xff\xff\x6d\xba\x1d\x21\xdf\x5c\x00\x00"),
 		},
+		"/crds/dependencies-insight.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "dependencies-insight.yaml",
+			modTime:          time.Date(2026, 10, 19, 13, 28, 28, 366636656, time.UTC),
+			uncompressedSize: 23790,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x3c\xd9\x72\xdb\x48\x92\xef\xfc\x8a\x0c\xce\x83\xec\x08\x92\xb2\xdb\x33\x1b\x3b\x7a\xd3\xca\xf6\xac\x76\x7c\x85\x25\xf7\xc6\xc6\xb8\x63\xa3\x08\x24\xc9\x1a\x81\x55\xe8\xaa\x82\x64\xf6\xd7\x6f\x64\xd6\x01\x80\x38\x08\xd9\xea\x9e\xf5\x93\x05\x02\x89\xac\xbc\x4f\x88\x52\xfe\x8c\xc6\x4a\xad\x2e\x40\x94\x12\xbf\x39\x54\xf4\x97\x5d\xdd\xfd\xbb\x5d\x49\x7d\x7e\xff\x72\x8d\x4e\xbc\x9c\xdd\x49\x95\x5f\xc0\x55\x65\x9d\xde\x7f\x46\xab\x2b\x93\xe1\x6b\xdc\x48\x25\x9d\xd4\x6a\xb6\x47\x27\x72\xe1\xc4\xc5\x0c\x20\x33\x28\xe8\xe2\xad\xdc\xa3\x75\x62\x5f\x5e\x80\xaa\x8a\x62\x06\xa0\xc4\x1e\x2f\x20\xc7\x12\x55\x8e\x2a\x93\x68\xa5\xb2\x72\xbb\x73\x76\x75\x57\xed\xc5\x4a\xea\x99\x2d\x31\x23\x20\x5b\xa3\xab\xf2\x02\xe2\x65\xff\xac\xa5\x5f\x00\x3c\x2e\xaf\x1b\x60\xae\x3d\x98\x19\x00\x40\x59\x54\x46\x14\xfd\xaf\x99\x01\xd8\x4c\x97\x78\x01\xf3\xf9\x0c\xe0\x5e\x14\x32\x67\x5c\x3d\x60\x5d\xa2\xba\xfc\x74\xfd\xf3\xab\x9b\x6c\x87\x7b\xe1\x2f\x02\xe4\x68\x33\x23\x4b\xbe\xaf\xef\xb5\x20\x2d\xb8\x1d\x82\x7f\x0a\x36\xda\xf0\x9f\x4d\x04\x20\x62\x00\x97\x9f\xae\x67\x00\x00\x00\xa5\xd1\x25\x1a\x27\xe3\xb1\x00\x00\x1a\xfc\x48\xd7\x8e\x10\x38\x23\x0c\xfd\x3d\x90\x13\x07\xd0\xbf\xfd\xde\x5f\xc3\x1c\xac\xc7\x43\x6f\xc0\xed\xa4\x05\x83\xa5\x41\x8b\xca\xf1\x49\x1b\x60\x81\x6e\x11\x0a\xf4\xfa\x9f\x98\xb9\x15\xdc\xa0\x21\x20\x60\x77\xba\x2a\x72\xc8\xb4\xba\x47\xe3\xc0\x60\xa6\xb7\x4a\xfe\x96\x20\x5b\x70\x9a\x5f\x59\x08\x87\xd6\xb5\x20\x4a\xe5\xd0\x28\x51\x10\x6d\x2b\x5c\x80\x50\x39\xec\xc5\x01\x0c\xd2\x3b\xa0\x52\x0d\x68\x7c\x8b\x5d\xc1\x7b\x6d\x10\xa4\xda\xe8\x0b\xd8\x39\x57\xda\x8b\xf3\xf3\xad\x74\x51\x02\x33\xbd\xdf\x57\x4a\xba\xc3\x79\xa6\x95\x33\x72\x5d\x39\x6d\xec\x79\x8e\xf7\x58\x9c\x8b\x52\x2e\x19\x4f\xe5\x58\x6a\xf7\xf9\x9f\x4c\x90\x4e\x7b\xd6\x40\xcc\x1d\x88\xe9\xd6\x19\xa9\xb6\xe9\x32\xcb\xd1\x20\x99\xff\x2e\x55\x0e\xd2\x82\x08\x8f\x79\x74\x6b\x6a\xd2\x25\x22\xc2\xe7\x37\x37\xb7\x10\x5f\xca\x14\x6f\x93\x98\x89\x5b\x3f\x66\x6b\x3a\x13\x5d\xa4\xda\xa0\xe1\xa7\x60\x63\xf4\x9e\x21\xa2\xca\x4b\x2d\x95\xe3\x3f\xb2\x42\xa2\x6a\xd3\xd8\x56\xeb\xbd\x74\xc4\xd8\x5f\x2b\xb4\x8e\xd8\xb1\x82\x2b\xa1\x94\x76\xb0\x46\xa8\xca\x5c\x38\xcc\x57\x70\xad\xe0\x4a\xec\xb1\xb8\x12\x16\x9f\x9a\xca\x44\x50\xbb\x24\x0a\x9e\xa6\x73\xd3\x38\x00\x0c\x0b\x3f\x00\x00\x9f\x82\x05\xf5\xe8\x07\x00\x91\xe7\x6c\x6c\x44\xf1\x69\xe0\xe1\x41\x0c\x7a\xd5\xa8\x7e\x13\xb3\x59\x41\xa5\xac\x33\x55\xe6\x2a\x83\x39\xdc\xe1\x21\x70\x7c\x2f\x4a\xb0\x4e\xd3\xc5\x07\xe9\x76\x9d\x37\x8a\x26\xf7\x85\x63\xb6\xae\x11\x2c\x3a\x58\x1f\x00\xbf\x05\x85\x70\x5a\x17\xc4\x2a\x0f\x8b\x15\xc3\xa0\x33\x12\xef\xb1\x0b\xd2\xac\xa5\x33\xc2\x1c\x12\xed\x56\x70\xbb\xc3\x03\x08\x83\x40\x6c\xfe\xb5\x42\x73\x10\xeb\xc2\xc3\x09\x0a\xbb\x46\x60\x21\x33\xf7\x98\x77\x40\x3e\xec\x50\xc1\x5e\xe7\x72\x73\x20\xc9\xf5\x62\xd9\x55\xbe\x8b\xf3\xf3\xbb\x6a\x8d\x46\xa1\x43\x16\x8c\x5c\x67\xf6\xbc\xb2\x68\x96\xdb\x4a\xe6\x78\xde\x60\xd0\xd9\xac\x8f\xf4\x1e\x72\xeb\xa7\xac\xa8\xac\x43\xf3\x81\xcc\xff\x18\x4f\x6e\x77\xc8\x76\xde\x9b\x2e\x8c\xcf\xc1\xc3\x4e\x66\x3b\xbe\xe2\x81\xc3\x1a\x0b\xad\xb6\x5e\xf0\x6f\x8f\x35\x0e\x00\x40\x5a\xa8\x2c\xe6\xe0\x34\xe4\xd2\x92\xae\x56\xd2\xee\x12\xa3\x2c\x73\x12\xac\xd8\x87\x17\x12\x15\xe9\x3f\xb6\x14\x19\x91\x03\x72\xb9\xd9\xa0\x39\xd6\xbc\xc6\x61\xac\x7f\x33\x6c\x24\x16\x6c\x27\x88\x2d\x16\x1d\x08\x75\x78\xd8\xa1\x41\x30\xec\x1b\x94\x7e\x60\xe8\xa2\x94\xcc\x19\x03\x3d\xe8\x6e\x35\x5b\x13\x0d\x72\xab\x98\x1f\x0e\xe4\x86\xa1\x49\xe5\xfd\x29\x82\x36\x41\xb3\xa3\xde\xaf\x66\x13\x25\xbf\xeb\x90\xc7\x98\x30\xbf\x3a\xbe\x9d\xd5\x03\x5c\xfa\xb3\x63\x02\xfd\xc1\xba\xaa\x28\xf7\xe8\xe5\x8e\xed\x5b\xe0\xdd\x83\xb0\xe1\x48\x64\xa2\x5c\x24\xdd\xb6\x12\x46\x28\x87\x9e\x69\x5e\x7f\xba\x6c\x55\xb0\x13\x65\x89\xca\x2e\xd7\xb8\x21\x4a\x69\x93\xa3\x01\x91\x19\x6d\x2d\x58\x2c\x85\x61\x5a\x95\x68\xbc\x8c\xae\xe0\x8a\x0d\xa8\xb7\xb6\x4a\x77\x61\x5a\x74\x1e\x3f\xd6\xf6\x88\x52\x3a\x23\xe6\x20\x15\x7c\x7e\x7b\xf5\xea\xd5\xab\xbf\x92\x6f\xdf\x33\x3b\xa5\xa5\xcb\x5f\x6e\xaf\x56\xf0\x55\x75\x60\x7e\xd2\x65\x45\xce\x31\x87\xf5\xc1\x53\xe8\x60\x1d\xee\x57\xf0\x19\x45\xbe\xd4\xaa\x38\xac\xe0\x43\x55\x14\x04\x0f\x0a\x69\xdd\x93\x7b\xc1\x68\x37\xe6\x47\xb8\xd1\x01\x84\xbb\x00\x12\xa4\x25\x31\x68\xaa\x10\xe5\x58\x20\x41\xff\x9b\x11\x19\x7e\x42\x23\x75\x7e\x83\x99\x56\xb9\x1d\x95\xa6\x0f\xd5\x7e\x8d\x06\x34\x49\x33\xdf\x0d\xa2\x28\xf4\x03\xe6\x21\x4c\xaa\xe5\xc2\x69\xd8\x12\xec\x4d\x55\x14\x87\xae\x2c\xa1\xd9\x4b\x25\x1c\x42\x60\xbc\x74\xf0\x20\x8b\x02\xd6\x08\x06\xf7\xfa\x1e\xf3\xda\x81\x46\x6a\x7f\x54\xc5\x81\xf9\x4b\x42\xd8\x01\x19\x4f\xd4\x96\xf3\xc2\x6a\x7a\x64\x05\xef\xc5\x01\x88\x53\x2c\x8b\x3b\x6d\x1c\x2a\xcc\x9b\x1c\x1c\xa0\xac\x54\xee\xdf\xfe\xdc\x4b\x55\x8a\x8d\xb6\x47\x7a\xd2\x41\x62\x5c\x37\x5f\xf7\xe1\xfc\xf9\xed\x15\xb0\x74\x12\x53\x59\x3a\x89\xb1\x20\x5c\x32\x9c\x3d\x26\x27\xf9\xac\x48\x45\xc6\x04\xf3\x63\xb3\x16\xdc\x58\xad\xe6\x4c\x4c\x10\x89\x59\x83\x74\xf5\x6a\xc4\xa6\xaa\x56\x04\xf2\x24\x8b\xa8\x41\x4a\x3b\xc8\xa5\xc1\xcc\x79\x3e\x39\xf6\x68\xeb\x2e\xf7\x45\x08\x83\xd8\x0b\xd6\xa8\x4b\x0b\xf8\xad\xc4\xcc\x25\xa3\x11\x0e\x01\xcf\x94\x06\x72\x11\x68\xe0\x5e\x5a\xb9\x2e\xba\x3e\x96\xa5\x25\x81\x62\x25\xf4\x88\x11\x56\x06\x45\xb6\x0b\xd8\xb0\x63\x78\x0e\x62\xe3\xd0\x07\xf6\x4c\x5d\xd9\x15\x28\x97\x08\xb7\x00\xad\x38\x1c\x40\xd8\x48\x25\x0a\xf9\x1b\x1a\xcb\xef\x60\x9c\xf7\xa5\x3b\xac\xe0\xd2\x32\x8a\x20\xec\xd1\x8d\x1d\xc0\xfc\x20\xe9\xbd\x90\xca\x82\x74\xb8\xb7\x8b\x16\x99\xd7\x85\xce\xee\x88\x77\x1f\xe3\x6b\x3b\x72\xd5\xe7\x22\x2d\xba\x45\xc3\xf6\x45\x13\xc9\x41\xa4\xb2\xe8\x40\x9b\x60\x89\x61\x53\x19\xb7\x43\x03\x52\x85\xd8\x7f\x53\x51\x9c\xb4\xe8\xb2\xaa\x70\x3b\x5d\x6d\x77\x20\xeb\x48\x28\x6a\x0f\x84\xcc\x28\x51\x3d\xdc\x10\xb9\x56\x1a\xa9\x7b\xdc\x88\xf6\x38\x12\xd9\x57\xf0\x56\x1b\xc0\x6f\x62\x5f\x16\x94\x5d\xb0\x3c\x85\x04\x83\x25\xcd\x87\x60\x02\x4a\xcd\x12\x16\x20\xf7\x39\x92\x57\x2f\xa2\x49\xf2\x52\xf5\xf7\x6a\x4d\x37\x7b\x7d\x20\xfe\xb3\xdc\x5b\x54\x39\xb9\xb9\x5a\xde\x93\x29\x3a\x4e\xa6\x00\x00\xac\xdc\xfa\x58\xcf\xc7\x2f\x9e\x65\xc4\x7b\xa9\xf8\x4a\xa9\xf3\x15\x5c\x06\x49\x12\xae\x81\xc4\x02\x5c\x8d\x44\x37\x7a\x23\xa4\x08\x17\x10\xb0\x13\x26\x6f\x22\x11\x5f\xfa\xec\xe6\xfa\x6f\x7f\xbf\x7e\xf7\xee\x79\xe7\xf5\x24\xd6\x5d\x46\x31\x16\x59\x81\x42\x55\xe5\x22\x18\xd1\x88\x64\x6d\x4b\x2f\x3f\x5d\x73\x26\xc1\x3f\xb0\x4b\xcc\x38\x3e\x53\xe8\x1e\xb4\xb9\xeb\x80\x2d\x85\x71\x1c\xa6\xdb\x45\xcb\xbc\x13\x8f\xac\xa3\x63\xe0\x37\x69\x5d\x52\xa7\xc0\x58\x96\xd1\x05\x54\xca\xc9\xae\x45\x11\x0a\x44\xbe\x97\x4a\x5a\x67\x84\xd3\x06\xb4\x01\x51\x39\xbd\x17\x5e\x6a\x74\x86\xd6\x42\x26\x14\xe4\xe8\x09\x83\x6d\x39\xeb\xb1\x7f\xec\x66\x6a\xb7\x42\xb1\xc8\x26\xc6\x70\x8b\x9a\xd9\x49\xcb\x42\x48\x1a\x4e\xb3\x13\x5d\x88\x5e\x73\x50\xd5\x46\x8f\x62\x83\xa1\x58\xe0\xd8\x8c\xa6\x37\xf5\x29\x6a\x03\x62\xed\x7f\xfe\xbf\x47\x0c\xb5\x41\x1b\xf5\x69\xef\x2b\x4b\x74\xf3\x56\x31\x7a\xf7\x06\xa9\x6b\x2d\xae\x85\xd2\xe0\x96\x64\xa1\xe3\x83\x01\xde\x88\x6c\x07\xa8\x9c\x39\x84\xa4\x4e\xe6\x74\xc6\x8d\x44\x93\x0a\x33\x06\x6d\xa9\x15\x7b\x05\xc8\xf4\xbe\xd4\x0a\x55\x30\x1c\xa4\x67\x3d\xae\x32\xa9\x86\x87\x9c\xf0\x20\xc3\xcc\x82\xd3\x6b\x72\xdb\x32\xd3\xc7\x57\xa5\xd5\x52\xc9\x62\xc1\x70\x7d\x99\xc8\xab\x84\xb7\xf8\x42\xa5\x08\x24\xc4\x38\xc7\x07\x66\x5f\xf0\xa8\x24\xd8\xff\x24\x8c\x11\x6d\x37\xbb\x45\x45\x31\x33\x9e\x4c\xd2\xe6\x7f\x6b\xdc\x19\x88\xac\x4b\x9f\x98\x43\x69\x70\x23\xbf\x2d\x7c\xf2\xd5\x0a\x1b\x16\x7d\x76\x3d\xbe\x14\x04\x54\x4a\xfe\x5a\x85\x6c\xec\xe3\x87\x77\xff\x03\xd7\x6f\xf9\x69\x7e\x0b\x3b\x55\x52\xba\x5a\xc9\x4a\xa3\xef\x65\xde\xa5\x08\x78\x76\x34\x43\x18\x42\xc6\x9b\x57\x86\x6e\xd0\x55\x46\xf9\x90\xa1\xae\xb0\xd4\x71\xd0\x60\xe6\xe7\x76\x42\xd5\x60\x4a\x61\x6d\x0a\x97\xbc\xff\x64\x10\x1c\x41\xae\x59\xb2\xd6\x52\x85\xa2\x41\x3a\x60\xd7\x63\x54\x9b\x8d\xfc\xe6\x5d\x50\x3c\x53\x00\xb7\x0b\x91\x01\xa7\xa9\x75\xad\x12\x4c\x55\xa0\x8d\x61\x03\xd1\xa7\x6b\xdc\x7c\x10\x12\x8b\x6f\x6b\x04\x67\x2a\x95\x35\xad\x50\x81\x6a\xeb\x76\x51\x44\x3d\x16\x6c\x67\xa4\x61\xd2\x74\x60\xee\xc5\x9d\xd7\x01\x8f\x9c\x3f\x0e\x68\xd5\xe0\x31\xdb\xbb\x0e\xf9\xa9\xa4\x4b\x0a\xd8\xe3\x82\x54\xce\x4f\x47\x31\xf0\x39\xb8\x77\x10\x76\xd1\x00\xec\x29\xfb\xe1\xe3\x6d\x60\x1e\x08\xf8\xf3\x8b\xbf\xc2\xb2\xc7\xaf\x5b\x87\x22\x5f\xa4\xf4\x00\x25\x87\x2d\xe1\xb1\x9f\x5e\xbc\x84\x2b\x9f\x7b\x82\x36\xf0\x97\x17\x2f\x3c\x77\x3e\xa3\xb0\x5a\x85\xc2\x1c\xe9\xaf\xae\xfa\x92\xcf\x5c\x66\xc2\xf9\x68\xa0\x29\xae\x19\x57\x5f\xbc\x64\xc2\x46\x57\x2a\x8f\xee\xde\xc7\xe1\x45\xa1\x9d\xc3\x7c\x31\x78\xfe\x20\x81\xa1\x8c\x63\x90\x6c\xcc\xb3\xa8\x53\xc5\xa1\x1b\x7a\x32\x22\x9c\x99\xf6\x08\x29\xc2\x67\x82\xb0\xf4\x61\xc6\x0e\x45\x8e\xe6\x39\xb3\xe6\xb2\x2c\x0b\x89\xb9\x37\x2a\x72\x03\x51\x83\xd9\xed\x45\x2e\x75\x15\xea\x69\xfd\x8c\xcc\x71\x5f\x6a\x87\x2a\x3b\xcc\xa7\xba\x92\x20\x20\x47\x65\xf1\x8e\x69\xba\x04\x4b\x8e\x52\x65\x08\xca\xe7\x9d\xad\x52\x85\x88\x87\xcc\x1a\x00\x41\x6f\x7a\x69\x98\xa3\x65\x4d\xb0\x4e\x38\x5c\x4d\xc9\xe8\x9f\x24\x1f\xe4\x86\xca\x14\xb7\x39\xbf\x54\xcd\x9b\xd9\x10\x73\xc4\x67\x74\x51\xa4\x9a\x19\xaa\x8d\xe6\x7a\x97\xd5\xfb\x88\x73\x8f\x60\xdf\x0b\x23\x85\x72\x20\x5c\xf4\xba\xb1\x66\x14\xa2\xee\x76\x4e\x28\xbc\x7f\xd2\x9b\x16\xba\x7d\xf6\xd2\xc1\x4e\xdc\xfb\x92\xe5\x01\x1d\x08\x4e\xd5\x74\xab\x20\xe4\x03\x2f\x59\x80\x36\x3e\x06\x68\xc5\x8d\x1d\xa0\x64\x14\xd9\x01\x90\xe7\xa6\xb0\xa0\x38\x34\xb0\xa0\x14\x88\x14\xfe\x41\x5a\x5c\x1c\x45\x11\x19\xf9\xfc\x1c\x4d\x8f\x21\xaa\x54\x03\x44\xcc\x4e\x77\x32\xcf\x51\xc1\x33\xa9\xf8\xb8\xe7\x0f\xc2\x65\x3b\xfe\x71\x8b\x0e\x32\x51\x14\xf6\xb9\x0f\x05\xbc\xfe\x8e\x10\x40\x9d\x39\xca\x54\x0b\x99\x49\x4a\x75\x85\xbd\xf3\xee\x47\xaf\xd9\xbe\x1d\xbd\x3f\xd5\x66\x7b\x2a\x4b\xff\xcd\x51\xa3\x6a\x1e\xcb\xdb\xb3\x45\x2b\xb6\x24\xd3\x57\x06\x91\x6d\x44\x14\xbd\xf5\x6b\xb6\x40\x95\x31\x6c\x82\xb0\xc3\xd6\x50\x46\x29\x8d\xbc\x97\x05\x6e\x31\xe7\x9c\xcb\xd7\xd3\xf8\xf6\x6e\xc6\xe6\xcb\xcc\xf5\x7b\x43\x5e\x2a\xeb\xec\x77\x11\xd3\xc3\x60\x35\xf9\x09\x89\x79\xcc\x33\x3b\x20\xd7\x07\x10\xea\xc0\xaf\x26\xba\xc0\xeb\x37\x9f\x3e\xbf\xb9\xba\xbc\x7d\xf3\x1a\x96\x2d\x74\x41\x70\x71\x1d\x44\x51\xee\x44\x10\x59\xe2\x59\x6f\x64\x57\x07\x56\x20\x15\xdc\xbf\x5c\xbd\xfc\xcb\xea\xd8\x28\x95\x23\xcd\x86\xd2\x67\x87\xdd\x1f\x8e\x94\xf5\x93\xbf\x6f\x58\x77\x42\xe7\xa0\xb2\x24\x27\x98\x55\x0e\x7b\x40\x02\x48\x15\x0a\x9e\x29\x4c\x4e\x8a\x02\xd2\xc6\x52\xc7\xca\x4b\x89\xef\xd0\x59\x17\xb1\x1c\x80\xd8\x32\x21\x81\x1a\xb1\x10\x02\x1b\x21\x0b\x42\xdc\xa0\xad\x0a\xd7\xa8\x19\xe0\xb8\xea\x03\x00\xf8\x66\x4a\x8a\xab\x2c\x3a\x70\x9a\x35\x3d\xfa\xbd\x3e\xdd\x04\x61\x9b\xfa\xdc\x0b\x99\x9e\x0f\x67\x05\xa7\xc9\xc1\x46\x15\x5c\xf5\xdc\x3f\x10\x23\x9f\xe2\x2d\x00\x40\x68\x59\x0f\xfc\x76\xc4\xe4\x66\xe7\x22\xe6\xa4\xcc\x56\x69\x5b\x29\x07\xa5\x21\xe9\x84\x43\x7c\x69\x54\x94\x82\x99\x1c\xbc\x6d\x24\xd8\x07\x00\x80\x14\xd5\x0d\x9d\x63\xc9\xa8\xcf\x86\x61\x0f\x98\xe2\xe1\x64\xc2\xbf\x95\x44\xe6\xa4\x6a\x5c\x6f\xda\xc2\xc5\x36\x8a\x69\xf8\x56\xc8\xa2\x32\x18\x83\xd9\x91\x4c\x2a\x55\x48\xd6\x08\x25\xb5\xc1\x6d\xa8\x08\x52\xab\x4d\x6c\x31\x0a\x9c\x8a\x99\x24\x25\x5c\xb6\x32\xbe\x7f\x21\x1c\xe8\x5e\x9b\x03\x00\x51\xae\x7c\x2e\x16\xac\x75\x33\xd9\x5b\xcd\x1e\x2f\x55\xfd\x4d\x7e\x80\x27\x6a\xf8\x0f\xc0\x84\xa3\x41\x80\xc7\x36\xff\x07\xc1\xf6\x0e\x05\x3c\x66\x10\x60\x10\xf2\x1f\x38\x20\xf0\x28\x85\xca\x74\x8e\x93\x58\x77\x53\x6d\xb7\xbe\xfc\xfd\x9f\xb7\xb7\x9f\x62\x16\x42\x8f\xd7\xed\x0f\x0a\x30\x2b\xbb\x80\x17\x20\x37\x03\x30\x21\x16\xa6\x86\x8c\x40\x23\xd6\x7c\xf5\xd3\xe8\xa9\xfa\x62\xce\x1a\x75\x27\x64\x61\x27\x9d\xec\x0d\x4d\x0a\xe5\x98\x03\x95\x8c\x40\x58\xab\x33\xc9\xe1\x71\x52\x5f\xc3\x39\xd5\xca\x97\x64\x46\x64\x92\xee\x62\xc9\xf0\xb2\x0d\xd2\x59\xd0\x0f\x0a\x30\xbd\xc1\xa3\x75\x14\x84\x0e\x42\x8c\x79\x53\x54\x7a\x8f\x61\x4a\xfa\x7b\xdb\x8d\x99\xa6\x38\x79\x3f\x08\xd3\x69\x8e\x3e\x82\x9e\xe1\xb7\x0c\xcb\x50\x30\xf2\x48\xa7\xac\x20\x1c\x87\x68\x3d\xc4\xab\xd3\x3e\x07\x20\x13\x95\x1d\xfb\xbd\xa7\x6f\x7e\xc5\x8f\x78\x5b\x0c\x52\x65\x45\x95\xa3\x85\xbd\x36\x18\x09\xd8\xe0\xd2\x08\x60\xa8\x39\x78\xc3\x92\x19\x72\xe3\x8d\xb7\xc6\x2b\xf8\xa0\x1d\x7b\xdc\xe6\xaf\x1c\x0d\x8e\x02\x0d\xa5\x8d\x80\x0b\xe6\xe1\x88\xab\x91\x87\x46\xfc\xf6\x63\x68\x09\x00\xb1\x22\x72\xea\xa6\xe3\x14\xeb\x76\x17\xbc\x4f\x74\xeb\xed\x41\x8f\x9d\xb0\xfe\x18\xf9\x49\xb8\xc1\x95\xa3\x31\x9a\xda\x5f\x96\x3d\x2e\x4b\x8d\x74\x16\xfe\xeb\xe6\xe3\x07\xb0\x68\x38\x22\x10\x43\x6e\xe5\xf8\xdf\xfb\x9a\xd1\x90\x13\x53\x54\x0e\xa5\xb6\x8e\x0a\x39\x71\x46\x83\xcd\x8c\x62\x13\x34\x01\xa2\x70\xde\x7c\x92\xcd\xbd\x24\x41\xf2\xd1\xf4\x6f\x68\xf4\x52\xaa\x1c\xbf\x51\x7e\x05\x6f\x89\x22\xa7\x39\x1e\x7d\x5d\x89\xc2\x78\x39\xe4\xfa\x19\x37\xc6\xa4\x02\xa1\x82\xac\xea\x4d\x90\x05\xc8\x2b\x9c\x42\x48\xed\x79\x62\x29\xb3\x22\x0f\xbe\xaf\x0a\x27\xcb\x02\x3d\x75\x29\x5f\x09\x16\x80\x13\x85\x37\xbe\x57\x64\x2f\x26\x80\xfe\x0a\xf0\x75\x4e\x9c\xf9\x3a\x87\x25\xb8\xc4\xfd\x74\x51\xab\x66\xb6\x34\x01\x62\x12\x18\x82\xcc\x02\xfd\x8f\x17\xbf\xac\x46\x5e\x31\x01\x66\x40\x62\x23\x8d\x75\x81\x86\xa1\xe0\xad\xe2\x4b\xbe\xce\x4f\x03\x3a\xe9\xe5\xea\x7f\x7b\xb4\x56\x6c\xf1\x91\xea\x73\x09\xbb\x6a\x2f\xd4\xd2\xa0\xc8\xb9\x95\xda\xf8\x35\x4d\xf8\x10\xe7\xa7\x9c\xd9\xdf\xce\x1c\x5e\x41\xd3\x13\x84\xfa\x66\x3d\xad\x21\xec\x72\xc4\x3b\xb4\x6d\x3a\x18\xae\x8e\xad\x9e\x92\x58\xde\x05\x3c\x9a\x56\x7b\x91\xed\xa4\xc2\x31\x6a\xcd\x4e\x1f\x8a\xe9\x79\x44\xad\x58\x90\xe5\x68\x2a\x65\xe0\x74\x87\x99\x02\x92\x1d\x26\x47\x5f\x14\x63\x10\x36\xe2\x5e\xc8\x82\x70\x7c\x42\xba\x9d\x48\x34\xda\xb7\xf5\x27\x1c\xf1\x9f\x1f\x1d\x7e\x8c\xef\xe4\x27\x6a\xeb\xd7\xb1\xf6\x8f\x75\x9c\x3e\xa4\x6b\x79\xc8\xd5\xec\x07\x89\x74\x3c\xac\x3a\x7a\xa8\x33\x3a\x15\x3d\xf1\x3b\x1f\x0a\x3e\x2a\x5f\x59\xac\x07\xae\x7c\x28\xc7\x3d\x94\x51\xb8\x8d\x5e\x5e\xe8\x6d\xd6\xa8\xd1\xe8\xed\x1f\x34\xb0\xfa\x5d\xbc\x18\x2f\x0a\x0c\x0d\x35\xfe\xae\xac\x80\x67\x61\xd0\x0e\x0d\x86\xa9\x65\xa9\xb6\x05\x0e\xa7\xf6\x09\x2a\x17\x8a\x33\xa1\xfc\x24\x06\x61\xbe\xc6\xfc\xf9\x0f\x0b\x2c\xb7\x31\xb8\x07\x31\x30\x27\x36\x48\xb1\xeb\x4d\xdd\x8d\x58\x34\xdb\x1e\x69\x86\xac\xee\x12\x8f\x1e\x2d\x49\x65\x63\x42\xd6\xcf\xdc\xe6\x2b\xb8\xd1\xfb\x60\x22\xe3\x24\xb6\xef\xaa\xcc\xc6\xa3\xb8\xd4\xad\xe1\x62\x9d\xa3\xa6\x18\x57\x1b\x39\xdb\x75\x08\x22\xe3\x17\x2e\x43\x82\xa7\x6d\x7c\xc9\x09\xb8\x2d\x87\x16\x71\x81\x9d\x7e\xf0\x43\x42\x4e\xc3\x83\x90\x2e\x9d\x5c\xdc\x9d\xb4\xa8\x3b\xec\xa0\x35\xc6\xd4\x29\x39\x24\x4c\xca\x23\x01\x00\x2a\xf9\x08\x6b\xf5\xe5\xfa\xf5\xb1\x4e\xac\x86\x04\x7a\x36\x29\xdc\x1a\x12\xea\x47\x8f\x3b\xd7\xe3\x03\xf6\x4f\x95\xfc\x61\xdb\x71\xd2\xcd\x8d\x99\xf9\x27\xd8\x4f\x98\x8d\x0a\xe0\x0f\xec\x2a\xcc\x26\x68\xcc\x77\xed\x2d\x0c\x02\xfe\xc3\xdd\xc3\x49\xf6\x9e\x08\x93\x1f\x1d\x1c\x07\x33\x7f\xaa\xac\x97\xac\xdc\xea\xfb\x11\xef\x2e\x68\x0c\x0b\xde\x8d\x13\x2a\x17\x26\xf7\x8d\x8c\xf8\xec\xbf\xc0\x5f\x4f\xaa\xa4\x68\xd2\x84\x6a\xba\xbb\x8e\x0f\x34\xd7\x38\xe4\x26\xcd\xae\xf2\xdf\x02\x0a\xb9\x97\x6e\x36\x21\x4b\x53\x69\xfe\x99\x13\xb3\x54\x87\x0a\x33\xb0\xc1\xce\x87\x46\xc1\x29\x7f\x16\x86\x21\x76\x22\x16\x76\xb8\xf6\x96\xa2\x71\x0e\x35\x52\x94\xaf\x4b\x41\x13\x0a\x7d\xa3\x7f\xcd\x7f\xe1\x98\x71\x5b\x42\x5a\xcb\x0f\xe9\x30\x36\x11\x86\x2a\xf5\xf1\x62\x92\x70\xa7\x31\xcd\xeb\x0e\x20\x38\x9d\xb6\x5d\x3c\x5d\xf0\x5b\xea\x36\xa6\x13\x8c\x13\x34\x76\x45\xaf\x3c\x87\x7c\x47\x9f\x1b\x47\xd6\xa1\x72\x41\x1c\xeb\x9e\x62\xa9\x6d\xff\xe4\x6f\xf3\x5f\x60\x6d\xa0\x2c\xd5\x01\xe5\xb6\xf2\xea\xe4\xeb\x3b\x3b\xa1\xb6\x7e\x5a\xa4\xae\x61\x88\xf1\xc8\x16\x1f\x60\x2f\x15\x95\x51\x7c\xf7\xbb\x9e\x14\xaa\xfd\x5b\x2c\xe8\x7b\x9f\x1f\xa5\xe2\x44\xa0\x86\x0a\x2a\xeb\xed\xba\xef\x99\x79\x49\x6d\x0c\x1f\xad\x31\x0c\xbc\x65\x69\x0a\x75\x14\x66\x90\x96\x66\x45\x21\xb4\xaa\x90\x86\x31\x0b\xb4\x16\x0e\xba\xf2\xe7\x30\x98\xa1\xbc\x3f\x81\x25\xa3\xe6\xf4\x1d\x2a\xef\x24\x84\xf2\xf1\x4f\xb4\x8e\x4f\x10\x57\xb6\x28\x38\x3d\xca\xb8\x71\x75\xc3\x27\xb9\x75\xdb\x60\xff\xd9\x99\x4d\x6d\x8b\x71\xaa\xf9\x57\x47\xcb\x9c\x36\x18\x08\x72\x88\x39\xe2\x00\x5c\xec\x1f\xf5\x0c\x54\xb5\x31\x8d\x73\xab\xcc\xe5\x20\xeb\x9e\xec\x41\x04\x57\xf0\xb3\x1f\xd2\x0e\xf3\x92\xce\xf7\xfd\x47\xc1\x8a\x64\x06\x1a\xa8\x70\x9d\x90\x45\x12\x2a\x95\x1a\xef\x6b\x91\xdd\x4d\x91\x98\x38\xe9\x35\x65\xc5\xa5\xf6\x08\xa3\x20\x9f\xc0\x5b\x64\x5a\xf9\xa2\x5c\x76\x58\x86\x21\x98\xa5\x50\xf9\x32\x99\x87\xec\xf0\xc3\x59\x9f\xc5\x62\xf3\x4e\xaa\xbb\xc9\x12\x17\x1f\xf0\x51\xda\x97\xcf\xef\x8e\x83\xb3\x09\xcd\x5d\x98\xb6\x4d\xf4\x3b\x47\xa5\xe3\x35\xad\x47\x56\xb2\x1e\x76\x61\x34\x24\x05\x2e\x83\xd8\xcb\x34\x38\x3f\x0f\xdd\xe0\x79\x88\x8a\xc6\xcb\x5a\x63\xfd\xa1\xc1\x62\x16\x5c\xc6\x39\xc0\xac\x10\xc6\x1b\x07\xa1\x7c\xe7\xce\xbf\x74\x24\xca\xc8\x11\xd6\x95\x83\x5c\xa3\xef\x2f\xe9\x7b\x34\x46\xe6\x08\xd2\x7d\x77\x58\xe6\x5f\x3a\x39\x28\x4b\xb1\x62\xa3\x1c\x43\x15\x1a\x04\xbd\xb9\x80\xf9\x4d\x95\xd1\x48\xc2\xbc\x6f\x60\x27\xfe\x4b\x54\x7e\xea\x68\x8e\xf2\x79\x56\x48\x7f\xa6\xef\x0c\xb1\x47\xe4\x74\x78\xc6\x61\x39\x30\xff\x32\x08\xac\x10\x6b\x2c\x7e\xef\xed\xe3\xf7\x82\xc7\xc3\xfd\x9d\xb4\x6c\xec\xed\xb2\xef\x78\x77\x3d\x89\xd3\xa0\xcd\x56\x50\xbb\xbc\x77\x8a\x94\x82\xc8\xad\x36\xf2\x37\x84\x67\xfc\x85\x03\xbe\x6a\xb1\xc0\xcc\x3d\x6f\x2c\xfb\x8a\x03\xec\x79\x8c\xcd\xff\xa4\x8d\xed\x9b\x7f\x34\x48\xa3\x6a\x5e\x3f\xea\x91\x42\x1b\x60\x9a\x7b\x99\xe1\x77\x6c\x0e\x7b\xba\x4e\x5e\x1a\xde\x0b\x25\xb6\x98\xfb\x6e\xd3\xf8\x28\xe4\xfb\xe6\xad\xb0\x17\xa5\x05\xda\x4d\xd9\x14\xfa\x61\x29\xfd\xf8\x57\x74\xd9\xde\xc3\xf5\x2e\x97\xea\x4d\x6c\x2c\x31\xf9\x85\xc1\x88\x83\xb7\xbb\xc2\x25\xa8\xa1\x17\x2d\x29\x0e\xb7\xae\x38\x84\x99\x9e\x81\xd0\x61\xa7\x2b\x8b\x77\x88\xa5\x54\x5b\x1f\xf7\xfb\x09\x3a\x77\x28\x29\x4e\x2b\x0e\xa1\x3c\x45\x53\x82\x2a\x74\xa4\xc3\xf6\x55\xa5\x72\x34\xd6\xf5\x05\xf1\x75\xc9\x88\x2c\x57\xc4\x2c\x4a\x4d\xcc\x57\xce\x7c\xab\x71\xd1\x1a\x0e\x8d\x17\xbb\x24\x30\xf5\x7c\x3b\x05\xe6\xf5\xc0\xac\x28\x4b\x1a\x02\x14\x6e\x07\x85\xbc\x43\xf8\x3a\xcf\xe4\x32\xcb\xbf\xce\x7d\x58\x1b\x22\x79\x4f\xbf\xbe\x4d\x07\x51\x3c\x88\x43\xb2\xe6\x89\x1b\x21\xeb\xa9\xd1\x67\x69\x3f\xda\x55\xef\x0b\x49\x82\xdf\x84\xaf\xea\x78\x36\x95\xe7\xfe\xbc\x4e\x30\x25\x1a\x11\x7c\x9c\xf5\xa3\x42\x6a\xdf\x84\xb7\xd2\x4e\x66\xd8\x99\x00\x1c\x68\x44\x8f\xa7\x9f\xa7\x86\x7c\xda\x4e\x73\x74\xc2\xa7\xf1\x25\x8f\x46\xfb\x79\x36\x12\x7f\x7b\x6a\x70\xaa\xca\x23\xdf\x71\x53\x1e\x43\x95\x0f\xa4\x85\x39\x77\x3d\xce\xc3\x3b\xe6\xf0\xcf\xca\x0e\xc1\x64\x8e\x13\x42\x4e\x97\xcb\x82\x6c\x7c\x13\xe3\x20\x83\x61\x95\x1b\xc9\xc9\x08\x73\x00\xa7\xc1\x19\x91\xdd\x0d\xe2\xd9\x3a\x9f\x68\xe0\xbc\x46\xdf\xc6\x92\x6c\x03\x43\x36\x17\xf6\xbd\xbc\xc2\xcc\x86\xdc\x30\x0f\x2d\xf5\xcd\xb0\x4f\xf0\x2e\x9b\x5e\x4b\x33\x62\xfd\xc1\x99\x0a\x4f\x33\x37\x98\xa5\x46\xca\x21\xda\xfa\xb2\xfa\x9e\xd1\x3b\x6f\x9a\xcc\x04\xe1\xf2\xd6\xd1\x74\xf7\xa1\xf4\xa6\xad\x7b\x0c\x72\x24\x4a\xdc\xa1\xc5\x09\x28\x0f\x12\x38\x45\x25\x13\x90\xfe\x18\xef\x8d\x1f\xd7\x21\xd8\x84\x71\x02\x12\x6a\xbc\x05\x8a\x7c\x38\xbb\x62\x6d\x68\xb9\x87\x37\xdc\x2a\x5f\x23\x19\x96\xf4\x19\x02\xd2\x0c\x8a\xa3\xfd\x96\x4d\xf0\xc2\xc3\xb3\x56\x4d\x25\x13\x06\xe1\x8c\x16\x2b\x0e\x67\x6c\x75\xce\xbe\x70\x19\xf3\xec\xbb\x28\x44\x7d\x8e\x09\xc4\xb9\x95\x7e\x6f\xc3\x35\x37\xcd\x62\xb9\x3c\xf1\x08\x1e\xd0\xe0\xd8\xd4\xd8\x75\x5a\x39\x09\xd6\x39\x6d\xe1\xc9\x4d\x9b\x01\xe1\x80\xb3\xb1\xbe\xc1\xd0\x7e\xe0\x84\x83\x8f\x88\xfa\x50\xc3\x57\x9d\x5a\x53\x3b\xe3\xe5\x96\x98\x2c\x87\x75\x1d\x32\xfc\x52\x81\xa8\xbf\xf5\xb1\x82\x6b\x9b\x82\xc7\xfe\xef\x04\xf8\x55\x08\xb5\x4d\xe6\xd7\x2e\xea\x2d\x67\xee\x7e\xa6\x1f\xb8\xfc\xc4\x1f\x38\x48\x2b\xeb\x7d\xb2\x59\xef\x2a\x63\x7b\x13\x05\x84\x22\x8b\x6d\x74\x69\xa4\x70\xb1\x6f\xd8\xb4\x7c\xab\xfe\x85\x2f\x69\xa1\x34\x72\x2f\x8c\xe4\x75\x88\x30\x39\x47\xa2\x9a\x16\x39\xea\xbd\x1b\x1f\x1c\xb6\x6b\x5d\x79\xfa\x96\x57\x57\x5a\x7a\x4a\xf4\x3f\xd2\x46\x61\xda\x9f\x4d\x5d\xfd\x49\x9c\x1a\x0f\x01\x3f\xc4\xdb\x5a\x0e\xd4\x5f\x09\x5c\xa7\x95\x7e\x50\x5d\xa9\xe8\x1e\xf8\x52\x05\x3d\x48\x2f\x07\x69\x81\x84\xe4\x5e\x14\x9e\xa7\x0c\xfe\xeb\x3c\xc7\x8d\xa8\x0a\xf7\x75\x5e\xdf\xba\xa0\x44\xb0\x03\xb2\x79\x6b\xb0\x68\x99\x50\x5a\x11\x57\x8f\x06\x73\xeb\x11\xbb\x10\xb7\x83\x30\x98\x64\xb4\x6f\x8d\x72\x8d\xfe\xb3\x66\x39\xfd\xd1\x10\xee\x30\x61\xc4\xe6\x2c\x05\x11\xde\x6c\xd5\xdd\xc9\xf0\x92\xfe\x95\xf3\x68\x11\x38\xd0\x8a\x9b\xba\x02\x5e\x7f\xb8\xf9\xdf\x77\x97\xff\xf1\xe6\xdd\x6a\x5c\x38\xba\xa1\xf0\x14\x61\x49\xf8\xdb\xc9\x0b\x62\xfa\x41\xa1\xf9\x8c\xbc\xb8\x99\xe1\x78\xba\xf0\x2e\xec\x5f\x84\x83\xc7\xef\xb4\x85\x28\xbf\xbd\x97\x74\xf9\xee\xdd\x20\x81\x42\x2c\xcb\x65\x67\x2e\xd4\xf1\x5a\x52\x9a\x30\x6f\x7d\xf3\x26\xd0\x72\x2b\xcc\x5a\x6c\x11\x32\x0a\xc3\x33\x37\xb6\xbd\x5a\xef\x46\x34\x92\x90\x66\x10\x4f\x6f\xf0\xbb\x40\x69\xfa\x2b\x95\xdb\xfb\x99\x19\x6a\xf7\xba\x2e\x1f\x47\x48\x69\xb2\xa0\xbe\xd8\x88\xc7\xe8\x09\xd3\xa7\x27\xb7\x5c\x6b\xa9\x63\xb4\xe6\x94\x1f\xa6\x70\xa2\x01\x74\xf5\xaf\x88\xac\xdb\x61\x34\x82\xf1\x62\xe2\xbe\xcb\x43\xf3\x97\x36\x3e\x92\xb4\xc5\x4f\xb1\x4c\x40\x82\x78\x6a\x68\x08\xfe\xf2\xc3\xeb\xd8\x71\x60\x89\x4d\x2b\xbe\x73\xea\xea\x53\x40\xae\xf2\x08\x77\x68\x82\x2f\xad\xd5\x07\x01\xa8\x81\xd5\x8c\xe8\x2c\xcc\xdf\xe1\x61\xc9\x66\x60\x00\xa8\xff\x26\x19\x7f\x7d\x21\xa6\x1a\x41\x97\x1a\x5b\x41\x2b\x78\xed\x6d\x98\x05\xa7\x61\x23\x0a\x4b\x3d\xa7\xa1\xd0\x2b\x7d\x57\x29\x2e\x23\x73\x3e\xca\x09\xae\x85\xb9\xc7\x70\x0e\x25\x95\xbd\x6d\x93\x3d\x7c\x96\xc5\x00\x50\x1d\x97\xfb\xe0\xcf\x3f\xfd\x04\xcf\xbe\xa8\xb0\x68\xc3\x75\xc6\x37\xca\x49\x77\x78\xde\xf8\x2e\x90\xef\xaa\x8c\x31\x7a\xad\x75\x81\x42\xcd\x7a\x93\x89\x20\xb5\x8f\xe1\xf0\x11\xf1\x58\xe5\xd2\x6a\xc4\x04\x8d\x98\x86\xdb\xf0\x94\x40\xcf\x8c\xc0\xb1\xd8\xff\xd1\x8d\xda\x13\x1a\x35\x3c\x4c\xd5\x13\xcf\x9d\x3a\xcb\x8f\x07\x22\x93\x70\x1e\x9c\x6e\x19\x99\x6b\x79\x0a\x8c\x87\x27\x50\x46\x11\x1e\x5b\x00\x5b\x36\xec\x69\xef\xcf\xc4\xd9\xde\x1f\x06\x26\xcb\x96\x44\x9d\xa7\x08\xf1\x4f\x34\xfa\x3a\xdb\xd0\xa1\xd3\xc5\x66\xce\x57\x96\xea\x41\x96\xb0\xb1\x18\x57\x92\x92\x43\xe8\xaf\xaa\x4d\xea\xe7\x0d\xf4\xec\x7a\x16\x96\x9b\x3d\xbc\xf7\x8d\x76\x3b\xc5\x60\xb4\xad\xb2\x97\xd6\xc9\x0c\x1a\x3d\xac\x45\x78\x80\xdf\xc1\x93\x5b\xc3\x1f\x0f\xf0\x6b\xc9\x75\x5a\xac\x55\xf3\x8b\x94\xda\xc4\x5a\x43\xbc\x54\x7f\x12\xaf\x03\xd2\x8f\xb4\x51\xc2\x10\x12\x49\x9f\x08\x37\xda\x88\x8f\xef\x1d\xc6\x7e\x21\x7f\xbe\x72\xdf\xf8\xa6\x9a\x4f\xb5\x89\x06\xc2\x7f\x34\x28\xab\x0a\x61\x7a\x30\x1f\xfc\x74\x99\x1d\xfb\xbe\x4e\xab\x11\x39\xad\x73\x3a\xd8\x2d\x7d\x6a\x93\x39\xa1\x5b\x39\x39\xf2\x1d\xea\x4a\xb6\xf7\xd0\xa6\x77\x22\x5b\xf4\xec\xdd\x15\x3f\xd9\x7d\x1c\xc4\xb5\xc7\x6c\xb6\xb5\x98\x0c\x66\xc8\x8e\x42\xc6\x2e\x55\xf8\x88\x86\xca\x43\x36\xe7\xf5\xfb\xe8\xeb\x81\x3d\x71\xb4\x03\xd9\x2c\xb1\xd7\xdf\x18\x69\x7f\xcd\x4e\x2b\xb0\xbe\x33\x46\x1f\x61\x4a\xd9\x72\x8f\xd8\x35\xb4\xaa\xf1\xfd\xba\xf8\x39\x43\xa7\xa3\xce\x6a\x05\x9f\xbe\xdc\xb6\xbe\x41\xd9\x14\xd3\xbe\xd5\xf6\x93\xfd\xf3\xef\x73\x15\x13\x85\xa8\xd7\x36\x77\x9b\x8f\x3d\xb7\x1d\x5d\x0a\x76\x35\x3c\xe5\x3d\xc4\x05\xdc\xbf\xe4\x9a\xfc\xcb\x59\xc3\x20\xe4\xad\xe2\x69\x58\xd3\x0d\xd7\xea\x37\x8b\x8c\x16\xed\x30\xff\x70\xfc\xed\xef\xf9\xbc\xf5\xa9\x6f\xfe\x93\x26\x83\xfd\x87\xc7\x2e\xe0\x1f\xbf\xcc\x20\x7c\xb3\xf7\xe7\x88\x13\xfc\xe3\x97\xff\x1b\x00\x3e\xfb\xb0\xd8\xee\x5c\x00\x00"),
+		},
+		"/crds/dependencies.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "dependencies.yaml",
+			modTime:          time.Date(2026, 10, 19, 10, 51, 32, 272741027, time.UTC),
+			uncompressedSize: 23695,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x3c\x6b\x73\xdb\x48\x72\xdf\xf9\x2b\xba\x74\x1f\x64\x57\x91\x94\xbd\x7b\x97\xca\xe9\x9b\x22\x7b\x2f\xca\xf9\x55\x96\x7c\xa9\x54\x9c\x4a\x0d\x81\x26\x39\x27\x60\x06\x37\x33\x90\xcc\xfd\xf5\xa9\xee\x79\x00\x20\x1e\x84\x6c\xed\x5e\xfc\xc9\x02\x81\x46\x4f\xbf\x9f\x10\x95\xfc\x1b\x1a\x2b\xb5\xba\x04\x51\x49\xfc\xe6\x50\xd1\x5f\x76\x7d\xff\xaf\x76\x2d\xf5\xc5\xc3\xeb\x0d\x3a\xf1\x7a\x71\x2f\x55\x7e\x09\xd7\xb5\x75\xba\xfc\x8c\x56\xd7\x26\xc3\x37\xb8\x95\x4a\x3a\xa9\xd5\xa2\x44\x27\x72\xe1\xc4\xe5\x02\x20\x33\x28\xe8\xe2\x9d\x2c\xd1\x3a\x51\x56\x97\xa0\xea\xa2\x58\x00\x28\x51\xe2\x25\xe4\x58\xa1\xca\x51\x65\x12\xed\xfa\xbe\x2e\xc5\x5a\xea\x85\xad\x30\xa3\x87\x77\x46\xd7\xd5\x25\xc4\xcb\xfe\x19\x4b\xbf\x00\x78\x1c\xde\xb4\x1e\x5f\x00\x00\x54\x45\x6d\x44\xd1\x85\xbb\x00\xb0\x99\xae\xf0\x12\xce\xce\x16\x00\x0f\xa2\x90\x39\x23\xe5\x21\xe9\x0a\xd5\xd5\xa7\x9b\xbf\xfd\x7c\x9b\xed\xb1\x14\xfe\x22\x40\x8e\x36\x33\xb2\xe2\xfb\x3a\xef\x01\x69\xc1\xed\x11\xfc\xed\xb0\xd5\x86\xff\x6c\xbf\x11\xae\x3e\xdd\x2c\x00\x00\x00\x2a\xa3\x2b\x34\x4e\x46\xbc\x01\x00\x5a\x84\x4e\xd7\x8e\x5e\x78\x4e\x18\xf9\x7b\x20\x27\xd2\xa2\x7f\xe9\x83\xbf\x86\x39\x58\xff\x7a\xbd\x05\xb7\x97\x16\x0c\x56\x06\x2d\x2a\xc7\x27\x6b\x81\x05\xba\x45\x28\xd0\x9b\xbf\x63\xe6\xd6\x70\x8b\x86\x80\x80\xdd\xeb\xba\xc8\x21\xd3\xea\x01\x8d\x03\x83\x99\xde\x29\xf9\x6b\x82\x6c\xc1\x69\x7e\x65\x21\x1c\x5a\xd7\x81\x28\x95\x43\xa3\x44\x41\xb4\xac\x71\x09\x42\xe5\x50\x8a\x03\x18\xa4\x77\x40\xad\x5a\xd0\xf8\x16\xbb\x86\xf7\xda\x20\x48\xb5\xd5\x97\xb0\x77\xae\xb2\x97\x17\x17\x3b\xe9\xa2\x68\x65\xba\x2c\x6b\x25\xdd\xe1\x22\xd3\xca\x19\xb9\xa9\x9d\x36\xf6\x22\xc7\x07\x2c\x2e\x44\x25\x57\x8c\xa7\x72\x2c\x8e\x65\xfe\x07\x13\xc4\xce\x9e\xb7\x10\x73\x07\x62\xb2\x75\x46\xaa\x5d\xba\xcc\x82\x32\x4a\xe6\xbf\x4a\x95\x83\xb4\x20\xc2\x63\x1e\xdd\x86\x9a\x74\x89\x88\xf0\xf9\xed\xed\x1d\xc4\x97\x32\xc5\xbb\x24\x66\xe2\x36\x8f\xd9\x86\xce\x44\x17\xa9\xb6\x68\xf8\x29\xd8\x1a\x5d\x32\x44\x54\x79\xa5\xa5\x72\xfc\x47\x56\x48\x54\x5d\x1a\xdb\x7a\x53\x4a\x47\x8c\xfd\x47\x8d\xd6\x11\x3b\xd6\x70\x2d\x94\xd2\x0e\x36\x08\x75\x95\x0b\x87\xf9\x1a\x6e\x14\x5c\x8b\x12\x8b\x6b\x61\xf1\xb9\xa9\x4c\x04\xb5\x2b\xa2\xe0\x69\x3a\xb7\xb5\x1e\x60\x5c\xf8\x01\x00\xf8\x14\x2c\xa8\x47\x3f\x00\x88\x3c\x67\x2b\x22\x8a\x4f\x23\x0f\x8f\x62\x30\xa8\x46\xcd\x9b\x98\xcd\x0a\x6a\x65\x9d\xa9\x33\x57\x1b\xcc\xe1\x1e\x0f\x81\xe3\xa5\xa8\xc0\x3a\x4d\x17\x1f\xa5\xdb\xf7\xde\x28\xda\xdc\x17\x8e\xd9\xba\x41\xb0\xe8\x60\x73\x00\xfc\x16\x14\xc2\x69\x5d\x10\xab\x3c\x2c\x56\x0c\x83\xce\x48\x7c\xc0\x3e\x48\xb3\x91\xce\x08\x73\x48\xb4\x5b\xc3\xdd\x1e\x0f\x20\x0c\x02\xb1\xf9\x1f\x35\x9a\x83\xd8\x14\x1e\x4e\x50\xd8\x0d\x02\x0b\x99\x79\xc0\xbc\x07\xf2\x71\x8f\x0a\x4a\x9d\xcb\xed\x81\x24\xd7\x8b\x65\x5f\xf9\x2e\x2f\x2e\xee\xeb\x0d\x1a\x85\x0e\x59\x30\x72\x9d\xd9\x8b\xda\xa2\x59\xed\x6a\x99\xe3\x45\x8b\x41\xe7\x8b\x21\xd2\x7b\xc8\x9d\x9f\xb2\xa2\xb6\x0e\xcd\x07\xb2\xeb\x53\x3c\xb9\xdb\x23\x1b\x72\x6f\xba\x30\x3e\x07\x8f\x7b\x99\xed\xf9\x8a\x07\x0e\x1b\x2c\xb4\xda\x79\xc1\xbf\x3b\xd6\x38\x00\x00\x69\xa1\xb6\x98\x83\xd3\x90\x4b\x4b\xba\x5a\x4b\xbb\x4f\x8c\xb2\xcc\x49\xb0\xa2\x0c\x2f\x24\x2a\xd2\x7f\x6c\x25\x32\x22\x07\xe4\x72\xbb\x45\x73\xac\x79\xad\xc3\x58\xff\x66\xd8\x4a\x2c\xd8\x4e\x10\x5b\x2c\x3a\x10\xea\xf0\xb8\x47\x83\x60\xe4\x6e\xef\x40\xe9\x47\x86\x2e\x2a\xc9\x9c\x31\x30\x80\xee\x4e\xb3\x35\xd1\x20\x77\x8a\xf9\xe1\x40\x6e\x19\x9a\x54\xde\x51\x22\x68\x13\x34\x3b\xea\xfd\x7a\x31\x53\xf2\xfb\x9e\x76\x8a\x09\x67\xd7\xc7\xb7\xb3\x7a\x80\x4b\x7f\xf6\x4c\xa0\x3f\x58\x5f\x15\x65\x89\x5e\xee\xd8\xbe\x05\xde\x3d\x0a\x1b\x8e\x44\x26\xca\x45\xd2\xed\x6a\x61\x84\x72\xe8\x99\xe6\xf5\xa7\xcf\x56\x05\x7b\x51\x55\xa8\xec\x6a\x83\x5b\xa2\x94\x36\x39\x1a\x10\x99\xd1\xd6\x82\xc5\x4a\x18\xa6\x55\x85\xc6\xcb\xe8\x1a\xae\xd9\x80\x7a\x6b\xab\x74\x1f\xa6\x45\xe7\xf1\x63\x6d\x8f\x28\xa5\x33\x62\x0e\x52\xc1\xe7\x5f\xae\x7f\xfe\xf9\xe7\x3f\x93\x4b\x2f\x99\x9d\xd2\xd2\xe5\x2f\x77\xd7\x6b\xf8\xaa\x7a\x30\x3f\xe9\xaa\x26\xe7\x98\xc3\xe6\xe0\x29\x74\xb0\x0e\xcb\x35\x7c\x46\x91\xaf\xb4\x2a\x0e\x6b\xf8\x50\x17\x05\xc1\x83\x42\x5a\xf7\xec\x5e\x30\xda\x8d\xb3\x23\xdc\xe8\x00\xc2\x5d\x02\x09\xd2\x8a\x18\x34\x57\x88\x72\x2c\x90\xa0\xff\xc5\x88\x0c\x3f\xa1\x91\x3a\xbf\xc5\x4c\xab\xdc\x4e\x4a\xd3\x87\xba\xdc\xa0\x01\x4d\xd2\xcc\x77\x83\x28\x0a\xfd\x88\x79\x88\x8e\x1a\xb9\x70\x1a\x76\x04\x7b\x5b\x17\xc5\xa1\x2f\x4b\x68\x4a\xa9\x84\x43\x08\x8c\x97\x0e\x1e\x65\x51\xc0\x06\xc1\x60\xa9\x1f\x30\x6f\x1c\x68\xa4\xf6\x47\x55\x1c\x98\xbf\x24\x84\x3d\x90\xf1\x44\x5d\x39\x2f\xac\xa6\x47\xd6\xf0\x5e\x1c\x80\x38\xc5\xb2\xb8\xd7\xc6\xa1\xc2\xbc\xcd\xc1\x11\xca\x4a\xe5\xfe\xe5\x8f\x83\x54\xa5\xd8\x68\x77\xa4\x27\x3d\x24\xa6\x75\xf3\xcd\x10\xce\x9f\x7f\xb9\x06\x96\x4e\x62\x2a\x4b\x27\x31\x16\x84\x4b\x86\x73\xc0\xe4\x24\x9f\x15\xa9\xc8\x98\x60\x7e\x6c\xd6\x82\x1b\x6b\xd4\x9c\x89\x09\x22\x31\x6b\x94\xae\x5e\x8d\xd8\x54\x35\x8a\x40\x9e\x64\x19\x35\x48\x69\x07\xb9\x34\x98\x39\xcf\x27\xc7\x1e\x6d\xd3\xe7\xbe\x08\x61\x10\x7b\xc1\x06\x75\x69\x01\xbf\x55\x98\xb9\x64\x34\xc2\x21\xe0\x85\xd2\x40\x2e\x02\x0d\x3c\x48\x2b\x37\x45\xdf\xc7\xb2\xb4\x24\x50\xac\x84\x1e\x31\xc2\xca\xa0\xc8\xf6\x01\x1b\x76\x0c\x2f\x41\x6c\x1d\xfa\x78\x9e\xa9\x2b\xfb\x02\xe5\x12\xe1\x96\xa0\x15\x87\x03\x08\x5b\xa9\x44\x21\x7f\x45\x63\xf9\x1d\x8c\x73\x59\xb9\xc3\x1a\xae\x2c\xa3\x08\xc2\x1e\xdd\xd8\x03\xcc\x0f\x92\xde\x0b\xa9\x2c\x48\x87\xa5\x5d\x76\xc8\xbc\x29\x74\x76\x4f\xbc\xfb\x18\x5f\xdb\x93\xab\x21\x17\x69\xd1\x2d\x5b\xb6\x2f\x9a\x48\x0e\x22\x95\x45\x07\xda\x04\x4b\x0c\xdb\xda\xb8\x3d\x1a\x90\x2a\xc4\xfe\xdb\x9a\xe2\xa4\x65\x9f\x55\x85\xdb\xeb\x7a\xb7\x07\xd9\x44\x42\x51\x7b\x20\x24\x44\x89\xea\xe1\x86\xc8\xb5\xca\x48\x3d\xe0\x46\xb4\xc7\x91\xc8\xbe\x86\x5f\xb4\x01\xfc\x26\xca\xaa\xa0\xec\x82\xe5\x29\x24\x18\x2c\x69\x3e\x04\x13\x50\x69\x96\xb0\x00\x79\xc8\x91\xfc\xfc\x2a\x9a\x24\x2f\x55\x7f\xad\x37\x74\xb3\xd7\x07\xe2\x3f\xcb\xbd\x45\x95\x93\x9b\x6b\xe4\x3d\x99\xa2\xe3\x64\x0a\x00\xc0\xca\x9d\x8f\xf5\x7c\xfc\xe2\x59\x46\xbc\x97\x8a\xaf\x54\x3a\x5f\xc3\x55\x90\x24\xe1\x5a\x48\x2c\xc1\x35\x48\xf4\xa3\x37\x42\x8a\x70\x01\x01\x7b\x61\xf2\x36\x12\xf1\xa5\x2f\x6e\x6f\xfe\xf2\xd7\x9b\x77\xef\x5e\xf6\x5e\x4f\x62\xdd\x67\x14\x63\x91\x15\x28\x54\x5d\x2d\x83\x11\x8d\x48\x36\xb6\xf4\xea\xd3\x0d\x67\x12\xfc\x03\xbb\xc4\x8c\xe3\x33\x85\xee\x51\x9b\xfb\x1e\xd8\x4a\x18\xc7\x61\xba\x5d\x76\xcc\x3b\xf1\xc8\x3a\x3a\x06\x7e\x93\xd6\x25\x75\x0a\x8c\x65\x19\x5d\x42\xad\x9c\xec\x5b\x14\xa1\x40\xe4\xa5\x54\xd2\x3a\x23\x9c\x36\xa0\x0d\x88\xda\xe9\x52\x78\xa9\xd1\x19\x5a\x0b\x99\x50\x90\xa3\x27\x0c\x76\xe5\x6c\xc0\xfe\xb1\x9b\x69\xdc\x0a\xc5\x22\xdb\x18\xc3\x2d\x1b\x66\x27\x2d\x0b\x21\x69\x38\xcd\x5e\xf4\x21\x7a\xcd\x41\xd5\x18\x3d\x8a\x0d\xc6\x62\x81\x63\x33\x9a\xde\x34\xa4\xa8\x2d\x88\x8d\xff\xf9\xff\x1e\x31\x34\x06\x6d\xd2\xa7\xbd\xaf\x2d\xd1\xcd\x5b\xc5\xe8\xdd\x5b\xa4\x6e\xb4\xb8\x11\x4a\x83\x3b\x92\x85\x9e\x0f\x06\x78\x2b\xb2\x3d\xa0\x72\xe6\x10\x92\x3a\x99\xd3\x19\xb7\x12\x4d\xaa\xc7\x18\xb4\x95\x56\xec\x15\x20\xd3\x65\xa5\x15\xaa\x60\x38\x48\xcf\x06\x5c\x65\x52\x0d\x0f\x39\xe1\x41\x86\x99\x05\x67\xd0\xe4\x76\x65\x66\x88\xaf\x4a\xab\x95\x92\xc5\x92\xe1\x72\x01\x29\xc4\xca\xde\xe2\x0b\x95\x22\x90\x10\xe3\x1c\x1f\x98\x7d\xc1\x93\x92\x60\xff\x93\x30\x46\x74\xdd\xec\x0e\x15\xc5\xcc\x78\x32\x49\x3b\xfb\x4b\xeb\xce\x40\x64\x5d\xf9\xc4\x1c\x2a\x83\x5b\xf9\x6d\xe9\x93\xaf\x4e\xd8\xb0\x1c\xb2\xeb\xf1\xa5\x20\xa0\x56\xf2\x1f\x75\xc8\xc6\x3e\x7e\x78\xf7\x5f\x70\xf3\x0b\x3f\xcd\x6f\x61\xa7\x4a\x4a\xd7\x28\x59\x65\xf4\x83\xcc\xfb\x14\x01\xcf\x8e\x76\x08\x43\xc8\x78\xf3\xca\xd0\x0d\xba\xda\x28\x1f\x32\x34\x15\x96\x26\x0e\x1a\xcd\xfc\xdc\x5e\xa8\x06\x4c\x25\xac\x4d\xe1\x92\xf7\x9f\x0c\x82\x23\xc8\x0d\x4b\xd6\x46\xaa\x50\x34\x48\x07\xec\x7b\x8c\x7a\xbb\x95\xdf\xbc\x0b\x8a\x67\x0a\xe0\xf6\x21\x32\xe0\x34\xb5\xa9\x4d\x82\xa9\x0b\xb4\x31\x6c\x20\xfa\xf4\x8d\x9b\x0f\x42\x62\xf1\x6d\x83\xe0\x4c\xad\xb2\xb6\x15\x2a\x50\xed\xdc\x3e\x8a\xa8\xc7\x82\xed\x8c\x34\x4c\x9a\x1e\xcc\x52\xdc\x7b\x1d\xf0\xc8\xf9\xe3\x80\x56\x2d\x1e\xb3\xbd\xeb\x91\x9f\x6a\xb6\xa4\x80\x03\x2e\x48\xe5\xfc\x74\x14\x03\x9f\x83\x7b\x07\x61\x97\x2d\xc0\x9e\xb2\x1f\x3e\xde\x05\xe6\x81\x80\x3f\xbe\xfa\x33\xac\x06\xfc\xba\x75\x28\xf2\x65\x4a\x0f\x50\x72\xd8\x12\x1e\xfb\xe9\xd5\x6b\xb8\xf6\xb9\x27\x68\x03\x7f\x7a\xf5\xca\x73\xe7\x33\x0a\xab\x55\x28\xcc\x91\xfe\xea\x7a\x28\xf9\xcc\x65\x26\x9c\x8f\x06\xda\xe2\x9a\x71\xf5\xc5\x4b\x26\x6c\x75\xad\xf2\xe8\xee\x7d\x1c\x5e\x14\xda\x39\xcc\x97\xa3\xe7\x0f\x12\x18\xca\x38\x06\xc9\xc6\xbc\x88\x3a\x55\x1c\xfa\xa1\x27\x23\xc2\x99\xe9\x80\x90\x22\x7c\x26\x08\x2b\x1f\x66\xec\x51\xe4\x68\x5e\x32\x6b\xae\xaa\xaa\x90\x98\x7b\xa3\x22\xb7\x10\x35\x98\xdd\x5e\xe4\x52\x5f\xa1\x9e\xd7\xcf\xc8\x1c\xcb\x4a\x3b\x54\xd9\xe1\x6c\xae\x2b\x09\x02\x72\x54\x16\xef\x99\xa6\x2b\xb0\xe4\x28\x55\x86\xa0\x7c\xde\xd9\x29\x55\x88\x78\xc8\xac\x05\x10\xf4\x76\x90\x86\x39\x5a\xd6\x04\xeb\x84\xc3\xf5\x9c\x8c\xfe\x59\xf2\x41\xee\x94\xcc\x71\x9b\x67\x57\xaa\x7d\x33\x1b\x62\x8e\xf8\x8c\x2e\x8a\x54\x33\x43\xb5\xd5\x5c\xef\xb2\xba\x8c\x38\x0f\x08\xf6\x83\x30\x52\x28\x07\xc2\x45\xaf\x1b\x6b\x46\x21\xea\xee\xe6\x84\xc2\xfb\x27\xbd\xed\xa0\x3b\x64\x2f\x1d\xec\xc5\x83\x2f\x59\x1e\xd0\x81\xe0\x54\x4d\x77\x0a\x42\x3e\xf0\x92\x05\x68\xe3\x63\x80\x4e\xdc\xd8\x03\x4a\x46\x91\x1d\x00\x79\x6e\x0a\x0b\x8a\x43\x0b\x0b\x4a\x81\x48\xe1\x1f\xa5\xc5\xe5\x51\x14\x91\x91\xcf\xcf\xd1\x0c\x18\xa2\x5a\xb5\x40\xc4\xec\x74\x2f\xf3\x1c\x15\xbc\x90\x8a\x8f\x7b\xf1\x28\x5c\xb6\xe7\x1f\x77\xe8\x20\x13\x45\x61\x5f\xfa\x50\xc0\xeb\xef\x04\x01\xd4\xb9\xa3\x4c\xb5\x90\x99\xa4\x54\x57\xd8\x7b\xef\x7e\xf4\x86\xed\xdb\xd1\xfb\x53\x6d\x76\xa0\xb2\xf4\x9f\x1c\x35\xaa\xf6\xb1\xbc\x3d\x5b\x76\x62\x4b\x32\x7d\x55\x10\xd9\x56\x44\x31\x58\xbf\x66\x0b\x54\x1b\xc3\x26\x08\x7b\x6c\x0d\x65\x94\xca\xc8\x07\x59\xe0\x0e\x73\xce\xb9\x7c\x3d\x8d\x6f\xef\x67\x6c\xbe\xcc\xdc\xbc\x37\xe4\xa5\xb2\xc9\x7e\x97\x31\x3d\x0c\x56\x93\x9f\x90\x98\xc7\x3c\xb3\x07\x72\x73\x00\xa1\x0e\xfc\x6a\xa2\x0b\xbc\x79\xfb\xe9\xf3\xdb\xeb\xab\xbb\xb7\x6f\x60\xd5\x41\x17\x04\x17\xd7\x41\x14\xd5\x5e\x04\x91\x25\x9e\x0d\x46\x76\x4d\x60\x05\x52\xc1\xc3\xeb\xf5\xeb\x3f\xad\x8f\x8d\x52\x35\xd1\x6c\xa8\x7c\x76\xd8\xff\xe1\x48\x59\x3f\xf9\xfb\xc6\x75\x27\x74\x0e\x6a\x4b\x72\x82\x59\xed\x70\x00\x24\x80\x54\xa1\xe0\x99\xc2\xe4\xa4\x28\x20\x6d\x2c\x75\xac\xbd\x94\xf8\x0e\x9d\x75\x11\xcb\x11\x88\x1d\x13\x12\xa8\x11\x0b\x21\xb0\x15\xb2\x20\xc4\x0d\xda\xba\x70\xad\x9a\x01\x4e\xab\x3e\x00\x80\x6f\xa6\xa4\xb8\xca\xa2\x03\xa7\x59\xd3\xa3\xdf\x1b\xd2\x4d\x10\xb6\xad\xcf\x83\x90\xe9\xf9\x70\x56\x70\x9a\x1c\x6c\x54\xc1\xf5\xc0\xfd\x23\x31\xf2\x29\xde\x02\x00\x84\x5e\xf4\xc8\x6f\x47\x4c\x6e\x77\x2e\x62\x4e\xca\x6c\x95\xb6\x93\x72\x50\x1a\x92\x4e\x38\xc6\x97\x56\x45\x29\x98\xc9\xd1\xdb\x26\x82\x7d\x00\x00\x48\x51\xdd\xd8\x39\x56\x8c\xfa\x62\x1c\xf6\x88\x29\x1e\x4f\x26\xfc\x5b\x49\x64\x4e\xaa\xc6\xcd\xb6\x2b\x5c\x6c\xa3\x98\x86\xbf\x08\x59\xd4\x06\x63\x30\x3b\x91\x49\xa5\x0a\xc9\x06\xa1\xa2\x36\xb8\x0d\x15\x41\x6a\xb5\x89\x1d\x46\x81\x53\x31\x93\xa4\x84\xcb\xd6\xc6\xf7\x2f\x84\x03\x3d\x68\x73\x00\x20\xca\x95\xcf\xc5\x82\xb5\x6e\x27\x7b\xeb\xc5\xd3\xa5\x6a\xb8\xc9\x0f\xf0\x4c\x0d\xff\x11\x98\x70\x34\x08\xf0\xd4\xe6\xff\x28\xd8\xc1\xa1\x80\xa7\x0c\x02\x8c\x42\xfe\x1d\x07\x04\x9e\xa4\x50\x99\xce\x71\x16\xeb\x6e\xeb\xdd\xce\x97\xbf\xff\xfd\xee\xee\x53\xcc\x42\xe8\xf1\xa6\xfd\x41\x01\x66\x6d\x97\xf0\x0a\xe4\x76\x04\x26\xc4\xc2\xd4\x98\x11\x68\xc5\x9a\x3f\xff\x34\x79\xaa\xa1\x98\xb3\x41\xdd\x09\x59\xd8\x59\x27\x7b\x4b\x23\x40\x39\xe6\x40\x25\x23\x10\xd6\xea\x4c\x72\x78\x9c\xd4\xd7\x70\x4e\xb5\xf6\x25\x99\x09\x99\xa4\xbb\x58\x32\xbc\x6c\x83\x74\x16\xf4\xa3\x02\x4c\x6f\xf0\x68\x1d\x05\xa1\xa3\x10\x63\xde\x14\x95\xde\x63\x98\x92\xfe\xc1\x76\x63\xa6\x29\x4e\x2e\x47\x61\x3a\xcd\xd1\x47\xd0\x33\xfc\x96\x61\x15\x0a\x46\x1e\xe9\x94\x15\x84\xe3\x10\xad\xc7\x78\x75\xda\xe7\x00\x64\xa2\xb6\x53\xbf\x0f\xf4\xcd\xaf\xf9\x11\x6f\x8b\x41\xaa\xac\xa8\x73\xb4\x50\x6a\x83\x91\x80\x2d\x2e\x4d\x00\x86\x86\x83\xb7\x2c\x99\x21\x37\xde\x7a\x6b\xbc\x86\x0f\xda\xb1\xc7\x6d\xff\xca\xd1\xe0\x24\xd0\x50\xda\x08\xb8\x60\x1e\x8e\xb8\x9e\x78\x68\xc2\x6f\x3f\x85\x96\x00\x10\x2b\x22\xa7\x6e\x3a\x4e\xb1\xee\xf6\xc1\xfb\x44\xb7\xde\x1d\xf4\xd8\x0b\xeb\x8f\x91\x9f\x84\x1b\x5c\x39\x1a\xa3\xa9\xfd\x65\xd9\xe3\xb2\xd4\x48\x67\xe1\x3f\x6e\x3f\x7e\x00\x8b\x86\x23\x02\x31\xe6\x56\x8e\xff\xbd\x6f\x18\x0d\x39\x31\x45\xe5\x50\x69\xeb\xa8\x90\x13\x67\x34\xd8\xcc\x28\x36\x41\x33\x20\x0a\xe7\xcd\x27\xd9\xdc\x2b\x12\x24\x1f\x4d\xff\x8a\x46\xaf\xa4\xca\xf1\x1b\xe5\x57\xf0\x0b\x51\xe4\x34\xc7\xa3\xaf\xab\x50\x18\x2f\x87\x5c\x3f\xe3\xc6\x98\x54\x20\x54\x90\x55\xbd\x0d\xb2\x00\x79\x8d\x73\x08\xa9\x3d\x4f\x2c\x65\x56\xe4\xc1\xcb\xba\x70\xb2\x2a\xd0\x53\x97\xf2\x95\x60\x01\x38\x51\x78\xeb\x7b\x45\xf6\x72\x06\xe8\xaf\x00\x5f\xcf\x88\x33\x5f\xcf\x60\x05\x2e\x71\x3f\x5d\xd4\xaa\x9d\x2d\xcd\x80\x98\x04\x86\x20\xb3\x40\xff\xf7\xab\xff\x59\x4f\xbc\x62\x06\xcc\x80\xc4\x56\x1a\xeb\x02\x0d\x43\xc1\x5b\xc5\x97\x7c\x3d\x3b\x0d\xe8\xa4\x97\x6b\xfe\x95\x68\xad\xd8\xe1\x13\xd5\xe7\x0a\xf6\x75\x29\xd4\xca\xa0\xc8\xb9\x95\xda\xfa\x35\x4d\xf8\x10\xe7\xe7\x9c\xd9\xdf\xce\x1c\x5e\x43\xdb\x13\x84\xfa\x66\x33\xad\x21\xec\x6a\xc2\x3b\x74\x6d\x3a\x18\xae\x8e\xad\x9f\x93\x58\xde\x05\x3c\x99\x56\xa5\xc8\xf6\x52\xe1\x14\xb5\x16\xa7\x0f\xc5\xf4\x3c\xa2\x56\x2c\xc8\x72\x34\x95\x32\x70\xba\xc3\xcc\x01\xc9\x0e\x93\xa3\x2f\x8a\x31\x08\x1b\xf1\x20\x64\x41\x38\x3e\x23\xdd\x4e\x24\x1a\xdd\xdb\x86\x13\x8e\xf8\xcf\xcf\x06\x3f\xc5\x77\xf2\x13\x8d\xf5\xeb\x59\xfb\xa7\x3a\x4e\x1f\xd2\x75\x3c\xe4\x7a\xf1\x83\x44\x3a\x1e\x56\x9d\x3c\xd4\x39\x9d\x8a\x9e\xf8\x8d\x0f\x05\x1f\x95\xaf\x2c\x36\x03\x57\x3e\x94\xe3\x1e\xca\x24\xdc\x56\x2f\x2f\xf4\x36\x1b\xd4\x68\xf4\xf6\x77\x1a\x58\xfd\x2e\x5e\x4c\x17\x05\xc6\x86\x1a\x7f\x53\x56\xc0\x8b\x30\x68\x87\x06\xc3\xd4\xb2\x54\xbb\x02\xc7\x53\xfb\x04\x95\x0b\xc5\x99\x50\x7e\x12\x83\x30\xdf\x60\xfe\xf2\x87\x05\x96\xdb\x18\xdc\x83\x18\x99\x13\x1b\xa5\xd8\xcd\xb6\xe9\x46\x2c\xdb\x6d\x8f\x34\x43\xd6\x74\x89\x27\x8f\x96\xa4\xb2\x35\x21\xeb\x67\x6e\xf3\x35\xdc\xea\x32\x98\xc8\x38\x89\xed\xbb\x2a\x8b\xe9\x28\x2e\x75\x6b\xb8\x58\xe7\xa8\x29\xc6\xd5\x46\xce\x76\x1d\x82\xc8\xf8\x85\xab\x90\xe0\x69\x1b\x5f\x72\x02\x6e\xc7\xa1\x45\x5c\x60\xaf\x1f\xfd\x90\x90\xd3\xf0\x28\xa4\x4b\x27\x17\xf7\x27\x2d\xea\x1e\x7b\x68\x4d\x31\x75\x4e\x0e\x09\xb3\xf2\x48\x00\x80\x5a\x3e\xc1\x5a\x7d\xb9\x79\x73\xac\x13\xeb\x31\x81\x5e\xcc\x0a\xb7\xc6\x84\xfa\xc9\xe3\xce\xcd\xf8\x80\xfd\x43\x2d\x7f\xd8\x76\x9c\x74\x73\x53\x66\xfe\x19\xf6\x13\x16\x93\x02\xf8\x03\xbb\x0a\x8b\x19\x1a\xf3\x5d\x7b\x0b\xa3\x80\x7f\x77\xf7\x70\x92\xbd\x27\xc2\xe4\x27\x07\xc7\xc1\xcc\x9f\x2a\xeb\x25\x2b\xb7\xfe\x7e\xc4\xfb\x0b\x1a\xe3\x82\x77\xeb\x84\xca\x85\xc9\x7d\x23\x23\x3e\xfb\x4f\xf0\xd7\xb3\x2a\x29\x9a\x34\xa1\x9e\xef\xae\xe3\x03\xed\x35\x0e\xb9\x4d\xb3\xab\xfc\xb7\x80\x42\x96\xd2\x2d\x66\x64\x69\x2a\xcd\x3f\x73\x62\x96\xea\x50\x61\x06\x36\xd8\xf9\xd0\x28\x38\xe5\xcf\xc2\x30\xc4\x5e\xc4\xc2\x0e\xd7\xde\x52\x34\xce\xa1\x46\x8a\xf2\x75\x25\x68\x42\x61\x68\xf4\xaf\xfd\x2f\x1c\x33\x6e\x4b\x48\x6b\xf9\x21\x1d\xc6\x26\xc2\x50\xa5\x3e\x5e\x4c\x12\xee\x34\xa6\x79\xd3\x01\x04\xa7\xd3\xb6\x8b\xa7\x0b\x7e\x4b\xdd\xc6\x74\x82\x69\x82\xc6\xae\xe8\xb5\xe7\x90\xef\xe8\x73\xe3\xc8\x3a\x54\x2e\x88\x63\xd3\x53\xac\xb4\x1d\x9e\xfc\x6d\xff\x0b\xac\x0d\x94\xa5\x3a\xa0\xdc\xd5\x5e\x9d\x7c\x7d\x67\x2f\xd4\xce\x4f\x8b\x34\x35\x0c\x31\x1d\xd9\xe2\x23\x94\x52\x51\x19\xc5\x77\xbf\x9b\x49\xa1\xc6\xbf\xc5\x82\xbe\xf7\xf9\x51\x2a\x4e\x04\x6a\xa8\xa0\xb6\xde\xae\xfb\x9e\x99\x97\xd4\xd6\xf0\xd1\x06\xc3\xc0\x5b\x96\xa6\x50\x27\x61\x06\x69\x69\x57\x14\x42\xab\x0a\x69\x18\xb3\x40\x6b\xe1\xa0\x6b\x7f\x0e\x83\x19\xca\x87\x13\x58\x32\x6a\x4e\xdf\xa3\xf2\x4e\x42\x28\x1f\xff\x44\xeb\xf8\x0c\x71\x65\x87\x82\xf3\xa3\x8c\x5b\xd7\x34\x7c\x92\x5b\xb7\x2d\xf6\x9f\x9f\xdb\xd4\xb6\x98\xa6\x9a\x7f\x75\xb4\xcc\x69\x83\x81\x20\x87\x98\x23\x0e\xc0\xc5\xfe\xd1\xc0\x40\x55\x17\xd3\x38\xb7\xca\x5c\x0e\xb2\xee\xc9\x1e\x44\x70\x0d\x7f\xf3\x43\xda\x61\x5e\xd2\xf9\xbe\xff\x24\x58\x91\xcc\x40\x0b\x15\xae\x13\xb2\x48\x42\xad\x52\xe3\x7d\x23\xb2\xfb\x39\x12\x13\x27\xbd\xe6\xac\xb8\x34\x1e\x61\x12\xe4\x33\x78\x8b\x4c\x2b\x5f\x94\xcb\x0e\xab\x30\x04\xb3\x12\x2a\x5f\x25\xf3\x90\x1d\x7e\x38\xeb\xb3\x58\x6c\xdf\x49\x75\x3f\x5b\xe2\xe2\x03\x3e\x4a\xfb\xf2\xf9\xdd\x71\x70\x36\xa3\xb9\x0b\xf3\xb6\x89\x7e\xe3\xa8\x74\xba\xa6\xf5\xc4\x4a\xd6\xe3\x3e\x8c\x86\xa4\xc0\x65\x14\x7b\x99\x06\xe7\xcf\x42\x37\xf8\x2c\x44\x45\xd3\x65\xad\xa9\xfe\xd0\x68\x31\x0b\xae\xe2\x1c\x60\x56\x08\xe3\x8d\x83\x50\xbe\x73\xe7\x5f\x3a\x11\x65\xe4\x08\x9b\xda\x41\xae\xd1\xf7\x97\xf4\x03\x1a\x23\x73\x04\xe9\xbe\x3b\x2c\xf3\x2f\x9d\x1d\x94\xa5\x58\xb1\x55\x8e\xa1\x0a\x0d\x82\xde\x5e\xc2\xd9\x6d\x9d\xd1\x48\xc2\xd9\xd0\xc0\x4e\xfc\x97\xa8\xfc\xdc\xd1\x1c\xe5\xf3\xac\x90\xfe\x4c\xdf\x19\x62\x4f\xc8\xe9\xf8\x8c\xc3\x6a\x64\xfe\x65\x14\x58\x21\x36\x58\xfc\xd6\xdb\xc7\xef\x05\x8f\x87\xfb\x3b\x69\xd9\xd8\xdb\x65\xdf\xf1\xee\x7b\x12\xa7\x41\x9b\x9d\xa0\x76\xf9\xe0\x14\x29\x05\x91\x3b\x6d\xe4\xaf\x08\x2f\xf8\x8b\x06\x7c\xd5\x62\x81\x99\x7b\xd9\x5a\xf6\x15\x07\x28\x79\x8c\xcd\xff\xa4\x8d\x1d\x9a\x7f\x34\x48\xa3\x6a\x5e\x3f\x9a\x91\x42\x1b\x60\x9a\x07\x99\xe1\x77\x6c\x0e\x7b\xba\xce\x5e\x1a\x2e\x85\x12\x3b\xcc\x7d\xb7\x69\x7a\x14\xf2\x7d\xfb\x56\x28\x45\x65\x81\x76\x53\xb6\x85\x7e\x5c\x49\x3f\xfe\x15\x5d\xb6\xf7\x70\x83\xcb\xa5\x7a\x1b\x1b\x4b\x4c\x7e\x61\x30\xe2\xe0\xed\xae\x70\x09\x6a\xe8\x45\x4b\x8a\xc3\xad\x2b\x0e\x61\xa6\x67\x24\x74\xd8\xeb\xda\xe2\x3d\x62\x25\xd5\xce\xc7\xfd\x7e\x82\xce\x1d\x2a\x8a\xd3\x8a\x43\x28\x4f\xd1\x94\xa0\x0a\x1d\xe9\xb0\x7d\x55\xab\x1c\x8d\x75\x43\x41\x7c\x53\x32\x22\xcb\x15\x31\x8b\x52\x13\xf3\x95\x73\xdf\x6a\x5c\x76\x86\x43\xe3\xc5\x3e\x09\x4c\x33\xdf\x4e\x81\x79\x33\x30\x2b\xaa\x8a\x86\x00\x85\xdb\x43\x21\xef\x11\xbe\x9e\x65\x72\x95\xe5\x5f\xcf\x7c\x58\x1b\x22\x79\x4f\xbf\xa1\x4d\x07\x51\x3c\x8a\x43\xb2\xe6\x89\x1b\x21\xeb\x69\xd0\x67\x69\x3f\xda\x55\x1f\x0a\x49\x82\xdf\x84\xaf\xea\x78\x36\x95\xe7\xfe\xbc\x4e\x30\x25\x5a\x11\x7c\x9c\xf5\xa3\x42\xea\xd0\x84\xb7\xd2\x4e\x66\xd8\x9b\x00\x1c\x69\x44\x4f\xa7\x9f\xa7\x86\x7c\xba\x4e\x73\x72\xc2\xa7\xf5\x25\x8f\x56\xfb\x79\x31\x11\x7f\x7b\x6a\x70\xaa\xca\x23\xdf\x71\x53\x1e\x43\x95\x0f\xa4\x85\x33\xee\x7a\x5c\x84\x77\x9c\xc1\xdf\x6b\x3b\x06\x93\x39\x4e\x08\x39\x5d\xad\x0a\xb2\xf1\x6d\x8c\x83\x0c\x86\x55\x6e\x24\x27\x23\xcc\x01\x9c\x06\x67\x44\x76\x3f\x8a\x67\xe7\x7c\xa2\x85\xf3\x06\x7d\x1b\x4b\xb2\x0d\x0c\xd9\x5c\xd8\xf7\xf2\x0a\xb3\x18\x73\xc3\x3c\xb4\x34\x34\xc3\x3e\xc3\xbb\x6c\x07\x2d\xcd\x84\xf5\x07\x67\x6a\x3c\xcd\xdc\x60\x96\x5a\x29\x87\xe8\xea\xcb\xfa\x7b\x46\xef\xbc\x69\x32\x33\x84\xcb\x5b\x47\xd3\xdf\x87\xd2\xdb\xae\xee\x31\xc8\x89\x28\x71\x8f\x16\x67\xa0\x3c\x4a\xe0\x14\x95\xcc\x40\xfa\x63\xbc\x37\x7e\x53\x87\x60\x13\xc6\x09\x48\xa8\xf1\x16\x28\xf2\xf1\xec\x8a\xb5\xa1\xe3\x1e\xde\x72\xab\x7c\x83\x64\x58\xd2\x67\x08\x48\x33\x28\x8e\xf6\x5b\x36\xc1\x0b\x8f\xcf\x5a\xb5\x95\x4c\x18\x84\x73\x5a\xac\x38\x9c\xb3\xd5\x39\xff\xc2\x65\xcc\xf3\xef\xa2\x10\xf5\x39\x66\x10\xe7\x4e\xfa\xbd\x0d\xd7\xde\x34\x8b\xe5\xf2\xc4\x23\x78\x44\x83\x53\x53\x63\x37\x69\xe5\x24\x58\xe7\xb4\x85\x27\xb7\x5d\x06\x84\x03\x2e\xa6\xfa\x06\x63\xfb\x81\x33\x0e\x3e\x21\xea\x63\x0d\x5f\x75\x6a\x4d\xed\x9c\x97\x5b\x62\xb2\x1c\xd6\x75\xc8\xf0\x4b\x05\xa2\xf9\xd6\xc7\x1a\x6e\x6c\x0a\x1e\x87\xbf\x13\xe0\x57\x21\xd4\x2e\x99\x5f\xbb\x6c\xb6\x9c\xb9\xfb\x99\x7e\xe0\xf2\x13\x7f\xe0\x20\xad\xac\x0f\xc9\x66\xb3\xab\x8c\xdd\x4d\x14\x10\x8a\x2c\xb6\xd1\x95\x91\xc2\xc5\xbe\x61\xdb\xf2\xad\x87\x17\xbe\xa4\x85\xca\xc8\x52\x18\xc9\xeb\x10\x61\x72\x8e\x44\x35\x2d\x72\x34\x7b\x37\x3e\x38\xec\xd6\xba\xf2\xf4\x91\xae\xbe\xb4\x0c\x94\xe8\x7f\xa4\x8d\xc2\xb4\x3f\x9f\xbb\xfa\x93\x38\x35\x1d\x02\x7e\x88\xb7\x75\x1c\xa8\xbf\x12\xb8\x4e\x2b\xfd\xa0\xfa\x52\xd1\x3f\xf0\x95\x0a\x7a\x90\x5e\x0e\xd2\x02\x09\xc9\x83\x28\x3c\x4f\x19\xfc\xd7\xb3\x1c\xb7\xa2\x2e\xdc\xd7\xb3\xe6\xd6\x25\x25\x82\x3d\x90\xed\x5b\x83\x45\xcb\x84\xd2\x8a\xb8\x7a\x34\x98\xdb\x8c\xd8\x85\xb8\x1d\x84\xc1\x24\xa3\x43\x6b\x94\x1b\xf4\x9f\x31\xcb\xe9\x8f\x96\x70\x87\x09\x23\x36\x67\x29\x88\xf0\x66\xab\xe9\x4e\x86\x97\x0c\xaf\x9c\x47\x8b\xc0\x81\x56\xdc\xd4\x15\xf0\xe6\xc3\xed\xff\xbe\xbb\xfa\xb7\xb7\xef\xd6\xd3\xc2\xd1\x0f\x85\xe7\x08\x4b\xc2\xdf\xce\x5e\x10\xd3\x8f\x0a\xcd\x67\xe4\xc5\xcd\x0c\xa7\xd3\x85\x77\x61\xff\x22\x1c\x3c\x7e\x9e\x2d\x44\xf9\xdd\xbd\xa4\xab\x77\xef\x46\x09\x14\x62\x59\x2e\x3b\x73\xa1\x8e\xd7\x92\xd2\x84\x79\xe7\x9b\x37\x81\x96\x3b\x61\x36\x62\x87\x90\x51\x18\x9e\xb9\xa9\xed\xd5\x66\x37\xa2\x95\x84\xb4\x83\x78\x7a\x83\xdf\x05\x4a\xd3\x5f\xa9\xdc\x3e\xcc\xcc\x50\xbb\xd7\x4d\xf9\x38\x42\x4a\x93\x05\xcd\xc5\x56\x3c\x46\x4f\x98\x21\x3d\xb9\xe3\x5a\x4b\x13\xa3\xb5\xa7\xfc\x30\x85\x13\x2d\xa0\xeb\x7f\x46\x64\xdd\x0d\xa3\x11\x8c\x17\x13\xf7\x5d\x1e\x9a\xbf\xb4\xf1\x91\xa4\x2d\x7e\x8a\x65\x06\x12\xc4\x53\x43\x43\xf0\x57\x1f\xde\xc4\x8e\x03\x4b\x6c\x5a\xf1\x3d\xa3\xae\x3e\x05\xe4\x2a\x8f\x70\xc7\x26\xf8\xd2\x5a\x7d\x10\x80\x06\x58\xc3\x88\xde\xc2\xfc\x3d\x1e\x56\x6c\x06\x46\x80\xfa\x6f\x92\xf1\xd7\x17\x62\xaa\x11\x74\xa9\xb5\x15\xb4\x86\x37\xde\x86\x59\x70\x1a\xb6\xa2\xb0\xd4\x73\x1a\x0b\xbd\xd2\x77\x95\xe2\x32\x32\xe7\xa3\x9c\xe0\x5a\x38\xf3\x18\x9e\x41\x45\x65\x6f\xdb\x66\x0f\x9f\x65\x39\x02\x54\xc7\xe5\x3e\xf8\xe3\x4f\x3f\xc1\x8b\x2f\x2a\x2c\xda\x70\x9d\xf1\xad\x72\xd2\x1d\x5e\xb6\xbe\x0b\xe4\xbb\x2a\x53\x8c\xde\x68\x5d\xa0\x50\x8b\xc1\x64\x22\x48\xed\x53\x38\x7c\x44\x3c\x56\xb9\xb4\x1a\x31\x43\x23\xe6\xe1\x36\x3e\x25\x30\x30\x23\x70\x2c\xf6\xbf\x77\xa3\xf6\x84\x46\x8d\x0f\x53\x0d\xc4\x73\xa7\xce\xf2\xe3\x81\xc8\x2c\x9c\x47\xa7\x5b\x26\xe6\x5a\x9e\x03\xe3\xf1\x09\x94\x49\x84\xa7\x16\xc0\x56\x2d\x7b\x3a\xf8\x33\x71\x76\xf0\x87\x91\xc9\xb2\x15\x51\xe7\x39\x42\xfc\x13\x8d\xbe\xde\x36\x74\xe8\x74\xb1\x99\xf3\x95\xa5\x66\x90\x25\x6c\x2c\xc6\x95\xa4\xe4\x10\x86\xab\x6a\xb3\xfa\x79\x23\x3d\xbb\x81\x85\xe5\x76\x0f\xef\x7d\xab\xdd\x4e\x31\x18\x6d\xab\x94\xd2\x3a\x99\x41\xab\x87\xb5\x0c\x0f\xf0\x3b\x78\x72\x6b\xfc\xe3\x01\x7e\x2d\xb9\x49\x8b\xb5\x6a\x7f\x91\x52\x9b\x58\x6b\x88\x97\x9a\x4f\xe2\xf5\x40\xfa\x91\x36\x4a\x18\x42\x22\xe9\x13\xe1\x56\x1b\xf1\xe9\xbd\xc3\xd8\x2f\xe4\xcf\x57\x96\xad\x6f\xaa\xf9\x54\x9b\x68\x20\xfc\x47\x83\xb2\xba\x10\x66\x00\xf3\xd1\x4f\x97\xd9\xa9\xef\xeb\x74\x1a\x91\xf3\x3a\xa7\xa3\xdd\xd2\xe7\x36\x99\x33\xba\x95\xb3\x23\xdf\xb1\xae\x64\x77\x0f\x6d\x7e\x27\xb2\x43\xcf\xc1\x5d\xf1\x93\xdd\xc7\x51\x5c\x07\xcc\x66\x57\x8b\xc9\x60\x86\xec\x28\x64\xec\x52\x85\x8f\x68\xa8\x3c\x64\x73\x5e\xbf\x8f\xbe\x1e\x38\x10\x47\x3b\x90\xed\x12\x7b\xf3\x8d\x91\xee\xd7\xec\xb4\x02\xeb\x3b\x63\xf4\x11\xa6\x94\x2d\x0f\x88\x5d\x4b\xab\x5a\xdf\xaf\x8b\x9f\x33\x74\x3a\xea\xac\x56\xf0\xe9\xcb\x5d\xe7\x1b\x94\x6d\x31\x1d\x5a\x6d\x3f\xd9\x3f\xff\x3e\x57\x31\x53\x88\x06\x6d\x73\x89\x76\x7f\x79\xea\xcb\xbe\xf1\x73\xdc\x13\x90\x8e\x2e\x05\xd3\x1b\x02\x7b\xef\x44\x2e\xe1\xe1\x35\x97\xed\x5f\x2f\x5a\x36\x23\xef\xd4\x57\xc3\x26\xaf\xbf\xf6\x7f\x03\x00\x45\xa0\x99\xbe\x8f\x5c\x00\x00"),
+		},
 		"/crds/fault-injections.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "fault-injections.yaml",
 			modTime:          time.Date(2020, 7, 31, 11, 8, 25, 954473843, time.UTC),
@@ -76,6 +90,20 @@ var HelmTemplates = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x7c\x5b\x73\xdb\xb8\x92\xf0\xbb\x7e\x45\x97\xcf\x83\x93\x2a\x49\x49\x66\xce\xf9\xea\x3b\x7e\xf3\x3a\xc9\x59\xef\x64\x92\x54\xec\x99\xad\xad\xcd\xd6\x16\x44\x36\x45\x1c\x93\x00\x07\x00\x6d\x6b\x7e\xfd\x56\x37\x00\x5e\xc4\x8b\xe8\xc4\x33\xbb\x7e\xb3\x24\x36\x1b\x7d\xbf\x42\x54\xf2\x57\x34\x56\x6a\x75\x01\xa2\x92\xf8\xe8\x50\xd1\x7f\x76\x7b\xf7\xff\xed\x56\xea\x57\xf7\x6f\x76\xe8\xc4\x9b\xd5\x9d\x54\xe9\x05\x5c\xd5\xd6\xe9\xf2\x0b\x5a\x5d\x9b\x04\xdf\x62\x26\x95\x74\x52\xab\x55\x89\x4e\xa4\xc2\x89\x8b\x15\x40\x62\x50\xd0\x87\xb7\xb2\x44\xeb\x44\x59\x5d\x80\xaa\x8b\x62\x05\xa0\x44\x89\x17\x90\x89\xba\x70\x52\xfd\x13\x13\xe7\xdf\x54\x97\x62\x2b\xf5\xca\x56\x98\xd0\xf3\x7b\xa3\xeb\xea\x02\xe2\xc7\xfe\x31\x4b\xdf\x00\x78\x34\xde\x13\x84\xeb\x08\x81\xbf\xa8\x8a\xda\x88\x62\x00\x7c\x05\x60\x13\x5d\xe1\x05\x9c\x9d\xad\x00\xee\x45\x21\x53\x46\xce\x83\xd3\x15\xaa\xcb\xcf\xd7\xbf\xfe\x78\x93\xe4\x58\x0a\xff\x21\x40\x8a\x36\x31\xb2\xe2\xdf\x1d\xbd\x0c\xa4\x05\x97\x23\xf8\x07\x20\xd3\x86\xff\x3d\x7a\x2d\x5c\x7e\xbe\x0e\xb0\x2a\xa3\x2b\x34\x4e\xc6\x13\xd0\x5f\x87\xea\xcd\x67\x47\x6f\x3d\x27\xb4\xfc\x6f\x20\x25\x3a\xa3\x7f\xef\xbd\xff\x0c\x53\xb0\x1e\x03\x9d\x81\xcb\xa5\x05\x83\x95\x41\x8b\xca\x89\x86\x26\xf1\x4f\x67\x20\x14\xe8\x1d\x61\xb7\x85\x1b\x34\x04\x04\x6c\xae\xeb\x22\x85\x44\xab\x7b\x34\x0e\x0c\x26\x7a\xaf\xe4\xef\x0d\x64\x0b\x4e\xf3\x2b\x0b\xe1\xd0\xba\x1e\x44\xa9\x1c\x1a\x25\x0a\x22\x68\x8d\x6b\x10\x2a\x85\x52\x1c\xc0\x20\xbd\x03\x6a\xd5\x81\xc6\x3f\xb1\x5b\xf8\x59\x1b\x04\xa9\x32\x7d\x01\xb9\x73\x95\xbd\x78\xf5\x6a\x2f\x5d\x94\xb3\x44\x97\x65\xad\xa4\x3b\xbc\x4a\xb4\x72\x46\xee\x6a\xa7\x8d\x7d\x95\xe2\x3d\x16\xaf\x44\x25\x37\x8c\xa7\xf2\x12\x53\xa6\x7f\x31\x41\x06\xed\x79\x07\x31\x77\x20\x4e\x5b\x67\xa4\xda\x37\x1f\xb3\xc8\x4c\x92\xf9\x27\xa9\x52\xe2\xa9\x08\x8f\x79\x74\x5b\x6a\xd2\x47\x44\x84\x2f\xef\x6e\x6e\x21\xbe\x94\x29\xde\x27\x31\x13\xb7\x7d\xcc\xb6\x74\x26\xba\x48\x95\xa1\xf1\x7c\xca\x8c\x2e\x19\x22\xaa\xb4\xd2\x52\x39\xfe\x27\x29\x24\xaa\x3e\x8d\x6d\xbd\x2b\xa5\x23\xc6\xfe\x56\xa3\x75\xc4\x8e\x2d\x5c\x09\xa5\xb4\x83\x1d\x42\x5d\xa5\xc2\x61\xba\x85\x6b\x05\x57\xa2\xc4\xe2\x4a\x58\x7c\x6e\x2a\x13\x41\xed\x86\x28\x78\x9a\xce\x5d\x13\x10\xff\xc6\x84\x9f\x15\x80\x4e\xc1\x82\x7a\xf4\x05\x80\x48\x53\x36\x29\xa2\xf8\x3c\xf1\xf0\x24\x06\xa3\x6a\xd4\xbe\x89\xd9\xac\xa0\x56\xd6\x99\x3a\x71\xb5\xc1\x14\xee\xf0\x10\x38\x5e\x8a\x0a\xac\xd3\xf4\xe1\x83\x74\xf9\xe0\x8d\xa2\xcb\x7d\xe1\x98\xad\x3b\x04\x8b\x0e\x76\x07\x20\xc3\xc9\x0a\xe1\xb4\x2e\x58\x73\x18\x16\x2b\x86\x41\x67\x24\xde\xe3\x10\xa4\xd9\x49\x67\x84\x39\x34\xb4\xdb\xc2\x6d\x8e\x07\x10\x06\x81\xd8\xfc\x5b\x8d\xe6\x20\x76\x85\x87\x13\x14\x76\x87\xc0\x42\x66\xee\x31\x1d\x80\x7c\xc8\x51\x41\xa9\x53\x99\x1d\x48\x72\xbd\x58\x0e\x95\xef\xe2\xd5\xab\xbb\x7a\x87\x46\xa1\x43\x16\x8c\x54\x27\xf6\x55\x6d\xd1\x6c\xf6\xb5\x4c\xf1\x55\x87\x41\xe7\xab\x31\xd2\x7b\xc8\xbd\xaf\x92\xa2\xb6\x0e\xcd\x47\x32\xf2\x73\x3c\xb9\xcd\x91\x4d\xba\x37\x5d\x18\x9f\x83\x87\x5c\x26\x39\x7f\x12\xb4\x69\x87\x85\x56\x7b\x2f\xf8\xb7\xc7\x1a\xc7\x66\xc8\x42\x6d\x31\x25\x72\xa7\xd2\x92\xae\xd6\xd2\xe6\x0d\xa3\x2c\x73\x12\x2c\xbd\x8b\x5f\x48\x54\x64\x67\x52\x89\x84\xc8\x01\xa9\xcc\x32\x34\xc7\x9a\xd7\x39\x8c\xf5\x6f\x86\x4c\x62\xc1\x76\x82\xd8\x42\x3c\x17\xea\xf0\x90\xa3\x41\x30\x72\x9f\x3b\x50\xfa\x81\xa1\x8b\x4a\x32\x67\x0c\x8c\xa0\xbb\xd7\x6c\x4d\x34\xc8\xbd\x62\x7e\x38\x90\x19\x43\x93\xca\x7b\x4d\x04\x6d\x82\x66\x47\xbd\xdf\xae\x16\x4a\xfe\xd0\xed\xce\x31\xe1\xec\xea\xf8\xe7\xde\x0a\xba\xe6\xdf\x81\x09\xf4\x07\x1b\xaa\xa2\x2c\xd1\xcb\x1d\xdb\xb7\xc0\xbb\x07\x61\xc3\x91\xc8\x44\xb9\x48\xba\x7d\x2d\x8c\x50\x0e\x3d\xd3\xbc\xfe\x0c\xd9\xaa\x20\x17\x55\x85\xca\x6e\x76\x98\x11\xa5\xb4\x49\xd1\x80\x48\x8c\xb6\x16\x2c\x56\xc2\x30\xad\x2a\x34\x5e\x46\xb7\x70\xc5\x06\xd4\x5b\x5b\xa5\x87\x30\x89\xca\x8c\x1f\x6b\x7b\x44\xa9\x39\x23\xa6\xf4\xd6\x2f\xef\xaf\x7e\xfc\xf1\xc7\xbf\x93\x57\x2f\x99\x9d\xd2\xd2\xc7\xbf\xdc\x5e\x6d\xe1\xab\x1a\xc0\xfc\xac\xab\x9a\x9c\x63\x4a\x16\x80\x29\x74\xb0\x0e\xcb\x2d\x7c\x41\x91\x6e\xb4\x2a\x0e\x5b\xf8\x58\x17\x05\x47\x09\x85\xb4\xee\xd9\xbd\x60\xb4\x1b\x67\x47\xb8\xd1\x01\x84\xbb\x00\x12\xa4\x0d\x31\x68\xa9\x10\xa5\x58\x20\x41\xff\x87\x11\x09\x7e\x46\x23\x75\x7a\x83\x89\x56\xa9\x9d\x95\xa6\x8f\x75\xb9\x43\x43\x0a\x6d\xfd\xaf\x41\x14\x85\x7e\xc0\x34\x04\x48\xad\x5c\x38\x0d\x7b\x82\x9d\xd5\x45\x71\x18\xca\x12\x9a\x52\x2a\xe2\x6d\x60\xbc\x74\xf0\x20\x8b\x82\x24\xc5\x60\xa9\xef\x09\x62\x74\xa0\x91\xda\x9f\x54\x71\x60\xfe\x92\x10\x0e\x40\xc6\x13\xf5\xe5\xbc\xb0\x9a\x1e\xd9\xc2\xcf\xe2\x00\xc4\x29\x96\xc5\x5c\x1b\x87\x8a\x24\xb6\xe5\xe0\x04\x65\xa5\x72\xff\xef\xaf\xa3\x54\xa5\xd8\x68\x7f\xa4\x27\x03\x24\xe6\x75\xf3\xed\x18\xce\x5f\xde\x5f\x01\x4b\x27\x5b\x07\x92\x4e\xd6\x3c\xe1\x1a\xc3\x39\x62\x72\x1a\x9f\x15\xa9\xc8\x98\xd0\x09\xfb\x66\x2d\xb8\xb1\x56\xcd\xbd\x46\x8b\x86\x59\x93\x74\xf5\x6a\xc4\xa6\xaa\x55\x04\xf2\x24\xeb\xa8\x41\xa4\xf7\xa9\x34\x98\x38\xcf\x27\xc7\x1e\x6d\x77\x18\x71\xb1\x3e\x0c\x62\x2f\xd8\xa2\x2e\x2d\xe0\x63\x85\x89\x6b\x8c\x46\x38\x04\xbc\x50\x1a\xc8\x45\xa0\x81\x7b\x69\xe5\xae\x18\xfa\x58\x96\x96\x06\x14\x2b\xa1\x47\x8c\xb0\x32\x28\x92\x3c\x60\xc3\x8e\xe1\x25\x88\xcc\xa1\x0f\xe9\x99\xba\x72\x28\x50\xae\x21\xdc\x1a\xb4\xe2\x70\x00\x21\x93\x4a\x14\xf2\x77\x8a\xf7\xe8\x1d\x8c\x73\x59\xb9\xc3\x16\x2e\x2d\xa3\x08\xc2\x1e\xfd\x70\x00\x98\x1f\x24\xbd\x17\x92\x82\x15\x87\xa5\x5d\xf7\xc8\xbc\x2b\x74\x72\x47\xbc\xfb\x14\x5f\x3b\x90\xab\x31\x17\x69\xd1\xad\x3b\xb6\x2f\x9a\x48\x0e\x22\x15\x31\x5e\x9b\x18\xc9\x64\xb5\x71\x39\x39\x2f\x15\x62\xff\xac\xa6\x38\x69\x3d\x64\x55\xe1\x72\x5d\xef\x73\x52\xd0\x18\x09\x45\xed\x81\x90\x13\x35\x54\x0f\x3f\x88\x5c\xab\x8c\xd4\x23\x6e\x44\x7b\x1c\x89\xec\x5b\x78\xaf\x0d\xe0\xa3\x28\xab\x82\xb2\x0b\x96\xa7\x90\x60\xb0\xa4\xf9\x10\x4c\x40\xa5\x59\xc2\x02\xe4\x31\x47\xf2\xe3\xeb\x68\x92\xbc\x54\xfd\x54\xef\xe8\xc7\x5e\x1f\x88\xff\x2c\xf7\x16\x55\x4a\x6e\xae\x95\xf7\xc6\x14\x1d\x27\x53\xec\x4c\xe4\xde\xc7\x7a\x3e\x7e\xf1\x2c\x23\xde\x4b\xc5\x9f\x54\x3a\xdd\xc2\x65\x90\x24\xe1\x3a\x48\xac\xf9\xfb\x80\xc4\x30\x7a\x23\xa4\x08\x17\x10\x90\x0b\x93\x76\x91\x88\x2f\x7d\x71\x73\xfd\x8f\x9f\xae\x3f\x7c\x78\x39\x78\x3d\x89\xf5\x90\x51\x8c\x45\x52\xa0\x50\x75\xb5\x0e\x46\x34\x22\xd9\xda\xd2\xcb\xcf\xd7\x9c\x49\xf0\x17\xec\x12\x13\x8e\xcf\x14\xba\x07\x6d\xee\x06\x60\x2b\x61\x1c\x87\xe9\x76\xdd\x33\xef\xc4\x23\xeb\xe8\x18\xf8\x48\xe2\x1c\xd5\x29\x30\x96\x65\x74\x0d\xb5\x72\x72\x68\x51\x84\x02\x91\x96\x52\x49\xeb\x8c\x70\xda\x90\x1c\x89\xda\xe9\x52\x78\xa9\xd1\x09\x5a\x0b\x89\xa0\x84\xd8\x13\x06\xfb\x72\x36\x62\xff\xd8\xcd\xb4\x6e\x85\x62\x91\x2c\xc6\x70\xeb\x96\xd9\x8d\x96\x85\x90\x34\x9c\x26\x17\x43\x88\x5e\x73\x50\xb5\x46\x8f\x62\x83\xa9\x58\xe0\xd8\x8c\x36\x6f\x1a\x53\xd4\x0e\xc4\x4e\x04\xf1\x7f\x3c\x62\x68\x0d\xda\xac\x4f\xfb\xb9\xb6\x6c\x71\xd8\x2a\x46\xef\xde\x21\x75\xab\xc5\xad\x50\x1a\xdc\x93\x2c\x0c\x7c\x30\xc0\x3b\x91\xe4\x80\xca\x99\x43\x48\xea\x64\x4a\x67\xcc\x24\x9a\xa6\x24\x63\xd0\x56\x5a\xb1\x57\x80\x44\x97\x95\x56\xa8\x82\xe1\x20\x3d\x1b\x71\x95\x8d\x6a\x78\xc8\x0d\x1e\x64\x98\x59\x70\x46\x4d\x6e\x5f\x66\xc6\xf8\xaa\xb4\xda\x28\x59\xac\x19\xae\xc4\x60\x26\x64\x70\x15\x24\xd0\x31\x02\x09\x31\xce\xf1\x81\xd9\x17\x3c\x29\x09\xf6\x5f\x09\x63\x44\xdf\xcd\xee\x51\x51\xcc\x8c\x27\x93\xb4\xb3\x7f\x74\x7e\x19\x88\xac\x2b\x9f\x98\x93\x85\xc8\xe4\xe3\xda\x27\x5f\xbd\xb0\x61\x3d\x66\xd7\xe3\x4b\xc9\x90\x2b\xf9\x5b\x1d\xb2\xb1\x4f\x1f\x3f\xfc\x07\x5c\xbf\xe7\xa7\xf9\x2d\x3e\x1a\xc9\x85\x6d\x95\xac\x32\xfa\x5e\xa6\x43\x8a\x80\x67\x47\x37\x84\x21\x64\xbc\x79\x65\xe8\x06\x5d\x6d\x94\x0f\x19\xda\x0a\x4b\x1b\x07\x4d\x66\x7e\x2e\x17\xaa\x05\x53\x09\x6b\x9b\x70\xc9\xfb\x4f\x06\xc1\x11\xe4\x8e\x25\x6b\x27\x55\x28\x1a\x34\x07\x1c\x7a\x8c\x3a\xcb\xe4\xa3\x77\x41\xf1\x4c\x01\x5c\x1e\x22\x03\x4e\x53\xdb\x02\x25\x98\xba\x40\x1b\xc3\x06\xa2\xcf\xd0\xb8\xf9\x20\x24\x16\xdf\x76\x08\xce\xd4\x2a\xe9\x5a\xa1\x02\xd5\xde\xe5\x51\x44\x3d\x16\x6c\x67\xa4\x61\xd2\x0c\x60\x96\xe2\xce\xeb\x80\x47\x2e\xf0\x4b\xab\x0e\x8f\xd9\xde\x0d\xc8\x6f\x2b\x4c\x48\x01\x47\x5c\x10\x85\xaa\x39\x36\x62\xe0\x73\x70\xef\x20\x82\x43\x8c\x31\x27\x51\xf6\xe3\xa7\xdb\xc0\x3c\x10\xf0\xd7\xd7\x7f\x87\xcd\x88\x5f\xb7\x0e\x45\xba\x6e\xd2\x03\x94\x1c\xb6\x84\xc7\x7e\x78\xfd\x06\xae\x7c\xee\x49\x3e\xe4\x6f\xaf\x5f\x7b\xee\x7c\x41\x61\xb5\x0a\x85\x39\xd2\x5f\x5d\x8f\x25\x9f\xa9\x4c\x84\xf3\xd1\x40\x57\x5c\x13\xae\xbe\x84\xc0\x29\xd3\xb5\x4a\xa3\xbb\xf7\x71\x78\x51\x68\xe7\x30\x5d\x4f\x9e\x3f\x48\x60\x28\xe3\x18\x24\x1b\xf3\x22\xea\x54\x71\x18\x86\x9e\x8c\x08\x67\xa6\x23\x42\x8a\xf0\x85\x20\x6c\x7c\x98\x91\xa3\x48\xd1\xbc\x64\xd6\x5c\x56\x55\x21\xe9\xe8\x64\x54\x64\x06\x51\x83\xd9\xed\x45\x2e\x0d\x15\xea\x79\xfd\x8c\x4c\xb1\xac\xb4\x43\x95\x1c\xce\x96\xba\x92\x20\x20\x47\x65\xf1\x81\x69\xba\x04\x4b\x8e\x92\x22\x14\xe5\xf3\xce\x5e\xa9\x42\xc4\x43\x26\x1d\x80\xa0\xb3\x51\x1a\xa6\x68\x59\x13\xac\x13\x0e\xb7\x4b\x32\xfa\x67\xc9\x07\xb9\x6d\xb2\xc4\x6d\x9e\x5d\xaa\xee\x8f\x7d\x8d\x86\x39\xa0\x8b\xa2\xa9\x99\xa1\xca\x34\xd7\xbb\xac\x2e\x23\xce\x23\x82\x7d\x2f\x8c\x14\xca\x51\xca\x18\xbc\x6e\xac\x19\x85\xa8\xbb\x9f\x13\x0a\xef\x9f\x74\xd6\x43\x77\xcc\x5e\x52\xa4\x74\xef\x4b\x96\x07\x74\x20\x38\x55\xd3\xbd\x82\x90\x0f\xbc\x64\x41\x0a\xc9\x31\x40\x2f\x6e\x1c\x00\x25\xa3\xc8\x0e\x80\x3c\x37\x85\x05\x24\xca\x0d\x16\x94\x02\x91\xc2\x3f\x48\x8b\xeb\xa3\x28\x22\x21\x9f\x9f\xa2\x19\x31\x44\xb5\xea\x80\x88\xd9\x69\x2e\xd3\x14\x15\xbc\x90\x8a\x8f\xfb\xea\x41\xb8\x24\xe7\x2f\xf7\x48\xce\xb9\x28\xec\x4b\x1f\x0a\x78\xfd\x9d\x21\x80\x3a\x77\x94\xa9\x16\x32\x91\x94\xea\x0a\x7b\xe7\xdd\x8f\xde\xb1\x7d\x3b\x7a\x7f\x53\x9b\x1d\xa9\x2c\xfd\x3b\x47\x8d\xaa\x7b\x2c\x6f\xcf\xd6\xbd\xd8\x92\x4c\x5f\x15\x44\xb6\x13\x51\x8c\xd6\xaf\xd9\x02\xd5\xc6\xb0\x09\xc2\x01\x5b\x43\x19\xa5\x32\xf2\x5e\x16\xb8\xc7\x94\x73\x2e\x5f\x4f\xf3\x39\xe2\xd0\x55\x70\x99\xb9\x7d\x6f\xc8\x4b\x65\x9b\xfd\xae\x63\x7a\x18\xac\x26\x3f\x41\xa6\x29\xe4\x99\x03\x90\xbb\x03\x08\x75\xe0\x57\xb3\x29\x7b\xfb\xee\xf3\x97\x77\x57\x97\xb7\xef\xde\xc2\xa6\x87\x2e\x97\xc8\x29\x61\x28\xaa\x5c\x04\x91\x25\x9e\x8d\x46\x76\x9d\xe2\x91\x54\x70\xff\x66\xfb\xe6\x6f\xdb\x63\xa3\x54\xcd\x34\x1b\x2a\x9f\x1d\x0e\xbf\x38\x52\xd6\xcf\x21\x8b\x9c\xd4\x9d\xd0\x39\xa0\x50\x18\x1f\x31\xa9\x1d\x8e\x80\xe4\xb4\xd5\x17\x3c\x9b\x30\xb9\x4d\xb0\x28\x0a\xf1\xa5\x8e\xad\x97\x12\xdf\xa1\xb3\x2e\x62\x39\x01\xb1\x67\x42\x02\x35\x62\x21\x04\x32\x21\x0b\x42\xdc\xa0\xad\x0b\xd7\xa9\x19\xe0\xbc\xea\x73\x64\xc3\xcd\x94\x26\xae\xe2\x3a\xab\x66\x4d\x8f\x7e\x6f\x4c\x37\x29\xae\xe9\x28\xc3\x28\x64\x7a\x3e\x9c\x95\x40\x8a\xa2\x88\x2a\xb8\x1d\xf9\xfd\x44\x8c\x7c\x8a\xb7\x21\xa9\x1b\x09\x87\x27\x98\xdc\xed\x5c\xc4\x9c\x94\xd9\xca\x74\x6d\x53\x0e\x4a\x43\x9a\x13\x4e\xf1\xa5\x53\x51\x0a\x66\x72\xf2\x67\x33\xc1\x7e\xcc\x5f\x7c\x54\x37\x75\x8e\x0d\xa3\xbe\x9a\x86\x3d\x61\x8a\xa7\x93\x89\x50\x60\xac\x0b\x77\x52\x35\xae\xb3\xbe\x70\xf9\x80\x8c\x68\xf8\x5e\xc8\xa2\x36\x18\x83\xd9\x99\x4c\xaa\xa9\x90\xec\x10\x2a\x34\x56\xda\x50\x11\xb4\x4e\x1b\xb1\xc7\x28\x70\x2a\x66\x92\x94\x70\xd9\xda\xf8\xfe\x05\x39\xbd\x51\x9b\xe3\xbb\x3d\xbe\x7b\xc0\xb9\x58\xb0\xd6\xdd\x64\x6f\x8c\x2d\xa7\xa4\x6a\xbc\xc9\x3f\xd3\xa9\x7c\x5a\xc3\x7f\x52\x50\xfa\x83\x00\x4f\x6d\xfe\x4f\x82\x1d\x1d\x0a\x78\xca\x20\xc0\x24\xe4\x3f\x71\x40\xe0\x49\x0a\x95\xe8\x14\x17\xb1\xee\xa6\xde\xef\x7d\xf9\xfb\x5f\x6f\x6f\x3f\xc7\x2c\x84\x1e\x6f\xdb\x1f\x14\x60\xd6\x76\x0d\xaf\x41\x66\x93\x94\x08\x85\xa9\x29\x23\xd0\x89\x35\x7f\xfc\x61\xf6\x54\x63\x31\x67\x8b\xba\x13\xb2\xb0\x8b\x4e\xf6\xee\xd1\xa1\xa2\x54\x35\x15\x4e\x80\xb0\x56\x27\x92\xc3\xe3\x46\x7d\x0d\xe7\x54\x5b\x5f\x92\x99\x91\x49\xce\xbc\x48\x32\xbc\x6c\x83\x74\x16\xf4\x83\xe2\xc6\xb9\x7f\x83\x47\xeb\x28\x08\x9d\x84\xd8\xd4\x22\xa2\x97\x61\x0c\x9b\xa4\x7f\xb4\xdd\x98\x68\x8a\x93\xcb\x69\x13\xab\x39\xfa\x08\x7a\x86\x8f\x09\x56\xa1\x60\xe4\x91\x6e\xb2\x82\x70\x1c\xa2\xf5\x14\xaf\x4e\xfb\x1c\x80\x44\xd4\x76\xee\xfb\x91\xbe\xf9\x15\x3f\xe2\x6d\x31\x48\x95\x14\x75\x8a\x16\x4a\xd2\x9c\x40\xc0\x0e\x97\x66\x00\x43\xcb\xc1\x1b\x96\xcc\x90\x1b\x67\xde\x1a\x6f\xe1\xa3\x76\xec\x71\xbb\xdf\x72\x34\x38\x0b\x34\x94\x36\x02\x2e\x98\x86\x23\x6e\x67\x1e\x9a\xf1\xdb\x4f\xa1\x65\xa7\x22\x72\xea\x47\xc7\x29\xd6\x6d\x1e\x4b\x4f\xc1\xad\xf7\x07\x3d\x28\x15\xe1\x63\xa4\x27\xe1\x06\x57\x8e\xc6\x68\xb3\xa6\x10\x87\x3c\x2e\x4b\x0d\x89\xfb\xbf\xdd\x7c\xfa\x08\x16\x0d\x47\x04\x62\xca\xad\x0c\xd2\xf2\x96\xd1\x90\x12\x53\x54\x0a\x95\xb6\x2e\x93\x8f\x10\x67\x34\xd8\xcc\x28\x36\x41\x0b\x20\x0a\xe7\xcd\x27\xd9\xdc\x4b\x12\x24\x1f\x4d\xff\x8e\x46\x6f\xa4\x4a\xf1\x91\xf2\x2b\x78\x4f\x14\x39\xcd\xf1\xe8\xeb\x2a\x14\xc6\xcb\x21\xd7\xcf\xb8\x31\x26\x39\x87\xf1\xb2\xaa\xb3\x20\x0b\x90\xd6\xb8\x84\x90\xda\xf3\xc4\x52\x66\x45\x1e\xbc\xac\x0b\x27\xab\x02\x3d\x75\x29\x5f\x09\x16\x80\x13\x85\x77\xbe\x57\x64\x2f\x16\x80\xfe\x0a\xf0\xf5\x8c\x38\xf3\xf5\x0c\x36\xa1\x29\x47\xdc\x6f\x3e\x0c\xd5\xae\x90\x2d\x2d\x80\xd8\x08\x0c\x41\x66\x81\xfe\xcf\xd7\xff\xb5\x9d\x79\xc5\x02\x98\x01\x89\x4c\x1a\xeb\x02\x0d\x43\xc1\x5b\xc5\x97\x7c\x3d\x3b\x0d\xe8\xa4\x97\xeb\xe4\x73\x68\xad\xd8\xe3\x13\xd5\xe7\x12\xf2\xba\x14\x6a\x63\x50\xa4\xdc\x4a\xed\x7c\xdb\x4c\xf8\x10\xe7\x97\x9c\xd9\xff\x9c\x39\xbc\x85\xae\x27\x08\xf5\xcd\x76\x5a\x43\xd8\xcd\x8c\x77\xe8\xdb\x74\xb2\xd7\x29\x9a\xed\x73\x12\xcb\xbb\x80\x27\xd3\xaa\x14\x49\x2e\x15\xce\x51\x6b\xc1\xa1\x98\x9e\x47\xd4\x8a\x05\x59\x5f\xb7\x8d\x19\x38\xfd\xc2\x2c\x01\xc9\x0e\x93\xa3\x2f\x8a\x31\x08\x1b\x71\x2f\x64\x41\x38\x3e\x23\xdd\x4e\x24\x1a\x4b\x12\x8e\xa6\x58\xc8\x53\xc2\x4f\xf1\x9d\xfc\x44\x6b\xfd\x06\xd6\xfe\xa9\x8e\xd3\x87\x74\x3d\x0f\xb9\x5d\x7d\x27\x91\x8e\x87\x55\xe7\x53\x06\x3a\x15\x3d\xf1\x07\x1f\x0a\x3e\x29\x5f\x59\x6c\x07\xae\x7c\x28\xc7\x3d\x94\x59\xb8\x9d\x5e\x5e\x1c\x11\x69\x50\xfb\x49\xaa\xf4\x4f\x1a\x58\xfd\x26\x5e\xcc\x17\x05\xa6\x86\x1a\xff\x50\x56\xc0\x8b\x30\x68\x87\x06\xc3\xd4\xb2\x54\xfb\x02\xa7\x53\xfb\x76\x30\x54\x26\x39\xe7\xb7\xbb\x68\x74\x76\x98\xbe\xfc\x6e\x81\xe5\x36\x06\xf7\x20\x26\xe6\xc4\xe6\x4a\x02\x4d\x37\x62\xdd\x6d\x7b\x34\x33\x64\x6d\x97\x78\xf6\x68\x8d\x54\x76\x26\x64\xfd\xcc\x6d\xba\x85\x1b\x92\x5b\x1f\x32\x84\x49\x6c\xdf\x55\x99\x37\x53\x6d\xb7\x86\x8b\x75\x4e\xdc\x85\x6a\x23\x67\xbb\x0e\x41\xf8\x65\x80\x4d\x48\xf0\xb4\x8d\x2f\x59\x9d\x8c\x0e\x5b\x87\x16\x71\x81\x5c\x3f\xf8\x21\x21\xa7\xe1\x41\x48\xd7\x9c\x5c\xdc\x9d\xb4\xa8\x39\x0e\xd0\x9a\x63\xea\x92\x1c\x72\x59\x1e\xc9\x15\x75\xf9\x04\x6b\xf5\xcb\xf5\xdb\x63\x9d\xd8\x4e\x09\xf4\x6a\x51\xb8\x35\x25\xd4\x4f\x1e\x77\x6e\xc7\x07\xec\x5f\x6a\xf9\xdd\xb6\xe3\xa4\x9b\x9b\x33\xf3\xcf\xb0\x9f\xb0\x5a\x50\x69\xfc\xa6\x5d\x85\xd5\x02\x8d\xf9\xa6\xbd\x85\x49\xc0\x7f\xba\x7b\x38\xc9\xde\x13\x61\xf2\x93\x83\xe3\x60\xe6\x4f\x95\xf5\x1a\x2b\xb7\xfd\x76\xc4\x87\x0b\x1a\xd3\x82\x77\xe3\x84\x4a\x85\x49\x7d\x23\xa3\x5d\x50\xf8\xd3\x19\xb2\xa8\x92\xa2\x49\x13\xea\xe5\xee\x3a\x3e\xd0\x5d\xe3\x90\x59\x33\xbb\xea\x47\xfc\xa1\x90\xa5\x9c\xcf\xff\x42\x96\xa6\x9a\xf9\x67\x4e\xcc\x9a\x3a\x54\x98\x81\x0d\x76\x3e\x34\x0a\x4e\xf9\xb3\x30\x0c\x91\x8b\x58\xd8\xe1\xda\x5b\x13\x8d\x73\xa8\xd1\x44\xf9\xba\x12\xbf\xd5\x38\x3a\xfa\xd7\x1f\xeb\xe0\x63\xc6\x6d\x09\x69\x2d\x3f\xa4\xc3\xd8\x44\x18\xaa\xd4\xc7\x8b\x49\xc2\x9d\xc6\x34\xed\x74\x1e\x9d\x6e\xb6\x5d\x3c\x5d\xf0\xb1\xe9\x36\x36\x27\x98\x27\x68\xec\x8a\x5e\x79\x0e\xf9\x8e\x3e\x37\x8e\xac\x23\xf3\xe2\xc5\xb1\xed\x29\x56\xda\x8e\x4f\xfe\xf6\xb2\x9b\xac\x3b\x66\x92\x68\x95\xc9\x7d\x1d\x82\x06\xae\xef\xe4\x42\xed\xfd\xb4\x48\x5b\xc3\x10\xf3\x91\x2d\x3e\x40\x29\x55\x4d\x6c\xe5\xee\x77\x3b\x29\xd4\xfa\xb7\x58\xd0\xf7\x3e\x3f\x4a\xc5\x89\x40\x0d\x15\xd4\xd6\xdb\x75\xdf\x33\xf3\x92\xda\x19\x3e\xda\x61\x18\x78\x4b\x9a\x29\xd4\x59\x98\x41\x5a\xba\x15\x85\xd0\xaa\xc2\x35\xd4\xaa\x40\x6b\xe1\xa0\x6b\x7f\x0e\x83\x09\xca\xfb\x13\x58\xfa\x89\x4e\x7d\x87\xca\x3b\x09\xa1\x7c\xfc\x13\xad\xe3\x33\xc4\x95\x3d\x0a\x2e\x8f\x32\x6e\x5c\xdb\xf0\x69\xdc\xba\xed\xb0\xff\xfc\xdc\x36\x6d\x8b\x79\xaa\x05\xe6\xc5\x0d\xcb\xb8\xc1\x40\x90\x43\xcc\x11\x07\xe0\x62\xff\x68\x64\xa0\xea\xa8\xf4\x1e\xe6\x56\x99\xcb\x41\xd6\x3d\xd9\x83\x08\x6e\xe1\x57\x3f\xa4\x1d\xe6\x25\x9d\xef\xfb\xcf\x82\x15\x8d\x19\xe8\xa0\xc2\x75\x42\x16\x49\xa8\x55\xd3\x78\xdf\x89\xe4\x6e\x89\xc4\xc4\x49\xaf\x25\x2b\x2e\xad\x47\x98\x05\xf9\x0c\xde\x22\xd1\xca\x17\xe5\x92\xc3\x26\x0c\xc1\x6c\x84\x4a\x37\x8d\x79\x48\x0e\xdf\x9d\xf5\x59\x2c\xb2\x0f\x52\xdd\x2d\x96\xb8\xf8\x80\x8f\xd2\x7e\xf9\xf2\xe1\x38\x38\x5b\xd0\xdc\x5d\xb8\x4d\xf4\x07\x47\xa5\xf3\x35\xad\x27\x56\xb2\x1e\xf2\x30\x1a\xd2\x04\x2e\xab\x99\xda\x53\xb0\x4d\x67\xa1\x1b\x7c\x16\xa2\xa2\xf9\xb2\xd6\x5c\x7f\x68\xb2\x98\x05\x97\x71\x0e\x30\x29\x84\xf1\xc6\x41\x28\xdf\xb9\xf3\x2f\x9d\x89\x32\x52\x84\x5d\xed\x20\xd5\xe8\xfb\x4b\xfa\x1e\x8d\x91\x29\x82\x74\xdf\x1c\x96\xf9\x97\x2e\x0e\xca\x9a\x58\xb1\x53\x8e\xd9\xc2\x27\x85\xa0\xb3\x0b\x38\xbb\xa9\x93\x04\xad\x3d\x1b\x1b\xd8\x89\x7f\x0d\x95\x9f\x3b\x9a\xa3\x7c\x9e\x15\xd2\x9f\xe9\x1b\x43\xec\x19\x39\x9d\x9e\x71\xd8\x4c\xcc\xbf\x4c\x02\x2b\xc4\x0e\x8b\x3f\x7a\xfb\xf8\x67\xc1\xe3\xe1\x21\x75\xbb\xc3\x83\xb7\xcb\xbe\xe3\x3d\xf4\x24\x4e\x83\x36\x7b\xa1\xe4\xef\x38\x3a\x45\x4a\x41\xe4\x5e\x1b\xf9\x3b\xc2\x0b\xbe\xd6\xc0\xef\x05\x63\x81\x89\x7b\xd9\x59\xf6\x15\x07\x28\x79\x8c\xcd\x7f\xa5\x8d\x1d\x9b\x7f\x34\x58\x15\x3c\xea\x4a\xba\xd0\x8c\x14\xda\x00\xd3\xdc\xcb\x04\xbf\x61\x73\xd8\xd3\x75\xf1\xd2\x70\x29\x94\xd8\x63\xea\xbb\x4d\xf3\xa3\x90\x3f\x77\x7f\x0a\xa5\xa8\x2c\x3c\x68\x73\x97\x15\xfa\x61\x23\xfd\xf8\x57\x74\xd9\x21\x92\x1d\x5b\x2e\xd5\x59\x6c\x2c\xf9\x1d\x22\x83\x11\x07\x6f\x77\x85\x6b\xa0\x86\x5e\xb4\xa4\x38\xdc\xba\xe2\x10\x66\x7a\x26\x42\x87\x5c\xd7\x16\xef\x10\x2b\xa9\xf6\x3e\xee\xf7\x13\x74\xee\x50\x51\x9c\x56\x1c\x42\x79\x4a\x9d\x3b\x50\xa1\x23\x1d\xb6\xaf\x6a\x95\xa2\xb1\x6e\x2c\x88\x6f\x4b\x46\x64\xb9\x22\x66\x51\x6a\x62\xbe\x72\xee\x5b\x8d\xeb\xde\x70\x68\xfc\x70\x48\x02\xd3\xce\xb7\x53\x60\xde\x0e\xcc\x8a\xaa\x2a\x0e\x50\x09\x97\x43\x21\xef\x10\xbe\x9e\x25\x72\x93\xa4\x5f\xcf\x7c\x58\x1b\x22\x79\x4f\xbf\xb1\x4d\x07\x51\x3c\x88\x43\x63\xcd\x1b\x6e\x84\xac\xa7\x45\x9f\xa5\xfd\x68\x57\x7d\x2c\x24\x89\x63\x2b\x5f\xd5\xf1\x6c\x2a\xcf\xfd\x79\x9d\x60\x4a\x74\x22\xf8\x38\xeb\xf7\x20\x5d\x3e\x36\xe1\xad\xb4\x93\x09\x0e\x26\x00\x27\x1a\xd1\xf3\xe9\xe7\xa9\x21\x9f\xbe\xd3\x9c\x9d\xf0\xe9\xdc\xe4\xd1\x69\x3f\xaf\x4e\x56\x12\x39\x55\xe5\x91\xef\xb8\x29\x8f\xa1\xca\x47\x84\x3a\xe3\xae\xc7\xab\xf0\x8e\x33\xf8\x67\x6d\xa7\x60\x32\xc7\xb9\x0e\xab\xab\x4d\x41\x36\xbe\x8b\x71\x90\xc1\xb0\xca\x8d\xe4\x64\x84\x39\xb0\xa6\x19\x91\xdc\xad\xa6\xeb\x42\x9d\xf3\x89\x0e\xce\x3b\xf4\x6d\x2c\xc9\x36\x30\x64\x73\x61\xdf\xcb\x2b\xcc\x6a\x32\xd9\xbf\x47\xe3\xc6\x66\xd8\x17\x78\x97\x6c\xd4\xd2\xcc\x58\x7f\x70\x66\xa2\x63\xdd\xbf\x26\xc6\x1b\x95\x4e\xca\x21\xfa\xfa\xb2\xfd\x96\xd1\x3b\x6f\x9a\xcc\x02\xe1\xf2\xd6\xd1\x0c\xf7\xa1\x42\xb0\xd0\xe8\x1e\x83\x9c\x89\x12\x73\xb4\xb8\x00\xe5\x49\x02\x37\x51\xc9\x02\xa4\x3f\x35\xa5\xfb\x70\xad\x0e\xc1\x26\x8c\xdb\x9a\xbe\xaf\xf1\x16\x28\xd2\xe9\xec\x8a\xb5\xa1\xe7\x1e\xde\x71\xab\x7c\x87\x64\x58\x9a\x6b\x08\x48\x33\x78\x29\x82\xb7\x6c\x82\x17\x9e\x9e\xb5\xea\x2a\x19\xb9\x89\xf3\x4b\xb2\x8e\xe7\x6c\x75\xce\x7f\xe1\x32\xe6\xf9\x37\x51\xc8\xc9\xa9\xc6\x52\xbf\xa5\x24\xfd\xde\x86\xeb\x6e\x9a\xc5\x72\x79\xc3\x23\x78\xa0\x48\x78\x66\x6a\xec\xba\x59\x39\x09\xd6\xb9\xd9\xc2\x93\x59\x9f\x01\xe1\x80\xab\xb9\xbe\xc1\xd4\x7e\xe0\x82\x83\xcf\x88\xfa\x54\xc3\x57\x9d\x5a\x53\x3b\xe7\xe5\x96\x98\x2c\x87\x75\x1d\x32\xfc\x52\x81\x68\xef\xfa\xd8\xc2\xb5\x6d\xd7\x9e\x46\xef\x09\xf0\xab\x10\x7e\x08\xda\x0f\x0e\xae\xdb\x2d\x67\xee\x7e\xb6\xd7\x8a\x94\xe2\xe0\x2f\x38\x68\x56\xd6\xc7\x64\xb3\xdd\x55\xc6\xfe\x26\x0a\xb7\x92\x2a\x72\x2c\x46\x0a\x17\xfb\x86\x5d\xcb\xb7\x1d\x5f\xf8\x92\x16\x2a\x23\x4b\x61\x24\xaf\x43\x84\xc9\x39\x12\xd5\x66\x91\xa3\xdd\xbb\xf1\xc1\x61\xbf\xd6\x95\x36\x37\x76\x0d\xa5\x65\xa4\x44\xff\x3d\x6d\x14\xa6\xfd\xf9\xd2\xd5\x9f\x86\x53\xf3\x21\xe0\xc7\xe6\xf2\x96\xae\x03\xf5\x9f\x04\xae\xa3\x48\x72\x4f\xd1\xbe\x54\x0c\x0f\x7c\xa9\x82\x1e\x74\xae\x84\xb1\x40\x42\x72\x2f\x0a\xcf\x53\x06\xff\xf5\x2c\x45\xbe\xdf\xeb\xeb\x59\xfb\xd3\x35\x25\x82\x03\x90\xdd\x9f\x06\x8b\x96\x08\xa5\x15\x17\xea\xfa\x83\xb9\xed\x88\x5d\x2c\x03\x91\x8d\x89\x32\x3a\xb6\x46\xb9\x43\x7f\x97\x59\xea\x87\x5a\x5a\xac\x37\x9d\x85\x3d\xdd\xdb\xcb\x6b\xbb\x93\xe1\x25\xab\xa9\x81\xea\x70\x5b\xc1\x57\xd5\x6c\xea\x0a\x78\xfb\xf1\xe6\xbf\x3f\x5c\xfe\xcb\xbb\x0f\xdb\x79\xe1\x58\x8d\x95\x7a\x4e\x0a\x4b\x83\xbf\x5d\xbc\x20\xa6\x1f\x14\x9a\x2f\xc8\x8b\x9b\x09\xce\xa7\x0b\x1f\xc2\xfe\x45\xa4\x6e\x8a\x95\x57\x97\xdd\x61\xb0\x97\x74\xf9\xe1\xc3\x24\x81\x42\x2c\xcb\x65\x67\x2e\xd4\xf1\x5a\x52\x33\x61\xde\xbb\xf3\x26\xd0\x72\x2f\xcc\x4e\xec\x11\x12\x0a\xc3\x13\x37\xb7\xbd\xda\xee\x46\x74\x92\x90\x6e\x10\xbf\xf6\x13\xed\x42\xb5\xd3\x5f\x4d\xb9\x7d\x9c\x99\xa1\x76\xaf\xdb\xf2\x71\x84\xd4\x4c\x16\x74\x16\xc8\xda\x78\x8c\x23\xb9\x31\x3d\xb9\xe5\x5a\x4b\x1b\xa3\x75\xa7\xfc\xb0\x09\x27\x3a\x40\xb7\xff\x1b\x91\x75\x3f\x8c\x26\x4d\xf2\xfb\xbd\xdf\xe4\xa1\xf9\xa6\x8d\x4f\x24\x6d\xf1\x2a\x96\x05\x48\x10\x4f\x4d\x8d\x6b\xb8\xfc\xf8\x36\x76\x1c\x58\x62\x9b\x15\xdf\xb3\x4c\x1b\xa4\x80\x5c\xa5\x11\xee\xd4\x04\x5f\xb3\x56\x1f\x04\xa0\x05\xd6\x32\x62\xb0\x30\x7f\x87\x87\x0d\x9b\x81\x09\xa0\xfe\x4e\x32\xbe\x7d\x21\xa6\x1a\x41\x97\x3a\x5b\x41\x5b\x78\xeb\x6d\x18\xcf\xfa\x67\xa2\xb0\xb8\x85\xdb\xa9\xd0\xab\xb9\x57\x29\x2e\x23\xfb\xfe\x19\x25\xb8\x16\xce\x3c\x86\x67\x50\xa1\x29\xa5\xed\xb2\x87\xcf\xb2\x9e\x00\xaa\xe3\x72\x1f\xfc\xf5\x87\x1f\xe0\xc5\x2f\x2a\x2c\xda\x70\x9d\xf1\x9d\x72\xd2\x1d\x5e\x76\xee\x05\xf2\x5d\x95\x39\x46\xef\xb4\x2e\x50\x8c\x55\x20\x5b\xa9\x7d\x0a\x87\x8f\x88\xc7\x2a\xd7\xac\x46\x2c\xd0\x88\x65\xb8\x4d\x4f\x09\x8c\xcc\x08\x1c\x8b\xfd\x9f\xdd\xa8\x3d\xa1\x51\xd3\xc3\x54\x23\xf1\xdc\xa9\xb3\x7c\x7f\x20\xb2\x08\xe7\xc9\xe9\x96\x99\xb9\x96\xe7\xc0\x78\x7a\x02\x65\x16\xe1\xb9\x05\xb0\x4d\xc7\x9e\x8e\x7e\x4d\x9c\x1d\xfd\x62\x62\xb2\x6c\x43\xd4\x79\x8e\x10\xff\x44\xa3\x6f\xb0\x0d\x1d\x3a\x5d\x3e\xda\xe1\xca\x52\x3b\xc8\x12\x36\x16\xe3\x4a\x52\xe3\x10\x56\x73\x73\x30\xf3\xfd\xbc\x89\x9e\xdd\xc8\xc2\x72\xb7\x87\xf7\x73\xa7\xdd\x4e\x31\x98\xae\x9c\x2c\xa5\x75\x32\x81\x4e\x0f\x6b\x1d\x1e\xe0\x77\xf0\xe4\xd6\xf4\xe5\x01\x7e\x2d\xb9\x4d\x8b\xb5\xea\xde\x48\xa9\x4d\xac\x35\x34\x49\x4a\x73\x25\xde\x70\x6f\x97\x47\xda\x28\x61\x08\x89\x64\x28\x47\x8b\xee\x34\xc1\x13\x7b\x87\xb1\x5f\xc8\xd7\x57\x96\x9d\x3b\xd5\x7c\xaa\x4d\x34\x10\xfe\xd2\xa0\xa4\x2e\x84\x19\xc1\x7c\xf2\xea\x32\x3b\x77\xbf\x4e\xaf\x11\xb9\xac\x73\x3a\xd9\x2d\x7d\x6e\x93\xb9\xa0\x5b\xb9\x38\xf2\x9d\xea\x4a\xf6\xf7\xd0\x96\x77\x22\x7b\xf4\x1c\xdd\x15\x3f\xd9\x7d\x9c\xc4\x75\xc4\x6c\xf6\xb5\x98\x0c\x66\xc8\x8e\x42\xc6\x2e\x55\xb8\x44\x43\xa5\x21\x9b\xf3\xfa\x7d\x74\x7b\xe0\x48\x1c\xcd\xb1\x73\x5b\x62\x6f\xef\x18\xe9\xdf\x66\xa7\x15\x58\xdf\x19\xcb\xea\xa2\xcd\x96\x47\xc4\xae\xa3\x55\x9d\xfb\xeb\xe2\x75\x86\x4e\x47\x9d\xd5\x0a\x3e\xff\x72\xdb\xbb\x83\xb2\x2b\xa6\x63\xab\xed\x27\xfb\xe7\xdf\xe6\x2a\x16\x0a\xd1\xa8\x6d\x2e\xd1\xe6\x17\xa7\x6e\xf6\x8d\x17\x73\xcf\x40\x3a\xfa\x28\x98\xde\x10\xd8\x6f\xc2\xc5\xdf\xf7\x6f\xb8\x6c\xff\x66\xd5\x9d\x1e\xea\xd5\x57\xc3\x26\xaf\xff\xec\x7f\x02\x00\x00\xff\xff\xc7\x7c\xb1\xba\x9c\x5c\x00\x00"),
 		},
+		"/crds/gateway-listeners.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "gateway-listeners.yaml",
+			modTime:          time.Date(2026, 10, 19, 9, 49, 52, 391669379, time.UTC),
+			uncompressedSize: 23713,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x3c\x6b\x73\xdb\x48\x72\xdf\xf9\x2b\xba\x74\x1f\x64\x57\x91\x94\xbd\x7b\x97\xca\xe9\x9b\x22\x7b\x37\xca\xf9\x55\x96\xf6\x52\xa9\x38\x95\x1a\x02\x4d\x72\x4e\xc0\x0c\x76\x66\x20\x99\xfb\xeb\x53\xdd\xf3\x00\x40\x3c\x08\xd9\xda\xbd\xf8\x93\x05\x02\x8d\x9e\x7e\x3f\x21\x2a\xf9\x77\x34\x56\x6a\x75\x09\xa2\x92\xf8\xd5\xa1\xa2\xbf\xec\xfa\xfe\x5f\xed\x5a\xea\x8b\x87\xd7\x1b\x74\xe2\xf5\xe2\x5e\xaa\xfc\x12\xae\x6b\xeb\x74\xf9\x19\xad\xae\x4d\x86\x6f\x70\x2b\x95\x74\x52\xab\x45\x89\x4e\xe4\xc2\x89\xcb\x05\x40\x66\x50\xd0\xc5\x3b\x59\xa2\x75\xa2\xac\x2e\x41\xd5\x45\xb1\x00\x50\xa2\xc4\x4b\xd8\x09\x87\x8f\xe2\x50\x48\xeb\x50\xa1\xb1\xeb\xfb\xba\x14\x6b\xa9\x17\xb6\xc2\x8c\x00\xec\x8c\xae\xab\x4b\x88\x97\xfd\x73\x96\x7e\x01\xf0\x78\xfc\xec\x41\xbc\x0b\x20\x16\x00\x00\x55\x51\x1b\x51\xf4\xc1\x2f\x00\x6c\xa6\x2b\xbc\x84\xb3\xb3\x05\xc0\x83\x28\x64\xce\xf8\x79\x80\xba\x42\x75\xf5\xe9\xe6\xef\x3f\xde\x66\x7b\x2c\x85\xbf\x08\x90\xa3\xcd\x8c\xac\xf8\xbe\xe3\xd7\x81\xb4\xe0\xf6\x08\xfe\x09\xd8\x6a\xc3\x7f\x1e\xbf\x18\xae\x3e\xdd\x2c\x00\x00\x00\x2a\xa3\x2b\x34\x4e\xc6\x53\x00\x00\xb4\x48\x9f\xae\x1d\xbd\xf7\x9c\x10\xf3\xf7\x40\x4e\xc4\x46\xff\xe2\x07\x7f\x0d\x73\xb0\x1e\x05\xbd\x05\xb7\x97\x16\x0c\x56\x06\x2d\x2a\xc7\x07\x6c\x81\x05\xba\x45\x28\xd0\x9b\x7f\x60\xe6\xd6\x70\x8b\x86\x80\x80\xdd\xeb\xba\xc8\x21\xd3\xea\x01\x8d\x03\x83\x99\xde\x29\xf9\x5b\x82\x6c\xc1\x69\x7e\x65\x21\x1c\x5a\xd7\x81\x28\x95\x43\xa3\x44\x41\x24\xad\x71\x09\x42\xe5\x50\x8a\x03\x18\xa4\x77\x40\xad\x5a\xd0\xf8\x16\xbb\x86\xf7\xda\x20\x48\xb5\xd5\x97\xb0\x77\xae\xb2\x97\x17\x17\x3b\xe9\xa2\xb0\x65\xba\x2c\x6b\x25\xdd\xe1\x22\xd3\xca\x19\xb9\xa9\x9d\x36\xf6\x22\xc7\x07\x2c\x2e\x44\x25\x57\x8c\xa7\x72\x2c\xa0\x65\xfe\x27\x13\x04\xd1\x9e\xb7\x10\x73\x07\xe2\xb5\x75\x46\xaa\x5d\xba\xcc\x62\x33\x4a\xe6\xbf\x49\x95\x83\xb4\x20\xc2\x63\x1e\xdd\x86\x9a\x74\x89\x88\xf0\xf9\xed\xed\x1d\xc4\x97\x32\xc5\xbb\x24\x66\xe2\x36\x8f\xd9\x86\xce\x44\x17\xa9\xb6\x68\xf8\x29\xd8\x1a\x5d\x32\x44\x54\x79\xa5\xa5\x72\xfc\x47\x56\x48\x54\x5d\x1a\xdb\x7a\x53\x4a\x47\x8c\xfd\xb5\x46\xeb\x88\x1d\x6b\xb8\x16\x4a\x69\x07\x1b\x84\xba\xca\x85\xc3\x7c\x0d\x37\x0a\xae\x45\x89\xc5\xb5\xb0\xf8\xdc\x54\x26\x82\xda\x15\x51\xf0\x34\x9d\xdb\x76\x00\x60\x5c\xf8\x01\x00\xf8\x14\x2c\xa8\x47\x3f\x00\x88\x3c\x67\xbb\x22\x8a\x4f\x23\x0f\x8f\x62\x30\xa8\x46\xcd\x9b\x98\xcd\x0a\x6a\x65\x9d\xa9\x33\x57\x1b\xcc\xe1\x1e\x0f\x81\xe3\xa5\xa8\xc0\x3a\x4d\x17\x1f\xa5\xdb\xf7\xde\x28\xda\xdc\x17\x8e\xd9\xba\x41\xb0\xe8\x60\x73\x00\xfc\x1a\x14\xc2\x69\x5d\x10\xab\x3c\x2c\x56\x0c\x83\xce\x48\x7c\xc0\x3e\x48\xb3\x91\xce\x08\x73\x48\xb4\x5b\xc3\xdd\x1e\x0f\x20\x0c\x02\xb1\xf9\xd7\x1a\xcd\x41\x6c\x0a\x0f\x27\x28\xec\x06\x81\x85\xcc\x3c\x60\xde\x03\xf9\xb8\x47\x05\xa5\xce\xe5\xf6\x40\x92\xeb\xc5\xb2\xaf\x7c\x97\x17\x17\xf7\xf5\x06\x8d\x42\x87\x2c\x18\xb9\xce\xec\x45\x6d\xd1\xac\x76\xb5\xcc\xf1\xa2\xc5\xa0\xf3\xc5\x10\xe9\x3d\xe4\xce\x4f\x59\x51\x5b\x87\xe6\x03\x59\xfa\x29\x9e\xdc\xed\x91\xcd\xba\x37\x5d\x18\x9f\x83\xc7\xbd\xcc\xf6\x7c\xc5\x03\x87\x0d\x16\x5a\xed\xbc\xe0\xdf\x1d\x6b\x1c\x00\x80\xb4\x50\x5b\xcc\xc1\x69\xc8\xa5\x25\x5d\xad\xa5\xdd\x27\x46\x59\xe6\x24\x58\x51\x86\x17\x12\x15\xe9\x3f\xb6\x12\x19\x91\x03\x72\xb9\xdd\xa2\x39\xd6\xbc\xd6\x61\xac\x7f\x33\x6c\x25\x16\x6c\x27\x88\x2d\x16\x1d\x08\x75\x78\xdc\xa3\x41\x30\x72\xb7\x77\xa0\xf4\x23\x43\x17\x95\x64\xce\x18\x18\x40\x77\xa7\xd9\x9a\x68\x90\x3b\xc5\xfc\x70\x20\xb7\x0c\x4d\x2a\xef\x3a\x11\xb4\x09\x9a\x1d\xf5\x7e\xbd\x98\x29\xf9\x7d\xdf\x3b\xc5\x84\xb3\xeb\xe3\xdb\x59\x3d\xc0\xa5\x3f\x7b\x26\xd0\x1f\xac\xaf\x8a\xb2\x44\x2f\x77\x6c\xdf\x02\xef\x1e\x85\x0d\x47\x22\x13\xe5\x22\xe9\x76\xb5\x30\x42\x39\xf4\x4c\xf3\xfa\xd3\x67\xab\x82\xbd\xa8\x2a\x54\x76\xb5\xc1\x2d\x51\x4a\x9b\x1c\x0d\x88\xcc\x68\x6b\xc1\x62\x25\x0c\xd3\xaa\x42\xe3\x65\x74\x0d\xd7\x6c\x40\xbd\xb5\x55\xba\x0f\xd3\xa2\xf3\xf8\xb1\xb6\x47\x94\xd2\x19\x31\x07\xa9\xe0\xf3\x4f\xd7\x3f\xfe\xf8\xe3\x5f\xc9\xad\x97\xcc\x4e\x69\xe9\xf2\x2f\x77\xd7\x6b\xf8\xa2\x7a\x30\x3f\xe9\xaa\x26\xe7\x98\xc3\xe6\xe0\x29\x74\xb0\x0e\xcb\x35\x7c\x46\x91\xaf\xb4\x2a\x0e\x6b\xf8\x50\x17\x05\xc1\x03\x8a\x0d\x9e\xdd\x0b\x46\xbb\x71\x76\x84\x1b\x1d\x40\xb8\x4b\x20\x41\x5a\x11\x83\xe6\x0a\x51\x8e\x05\x12\xf4\x9f\x8d\xc8\xf0\x13\x1a\xa9\xf3\x5b\xcc\xb4\xca\xed\xa4\x34\x7d\xa8\xcb\x0d\x1a\xd0\x24\xcd\x7c\x37\x88\xa2\xd0\x8f\x98\x87\x08\xa9\x91\x0b\xa7\x61\x47\xb0\xb7\x75\x51\x1c\xfa\xb2\x84\xa6\x94\x4a\x38\x84\xc0\x78\xe9\xe0\x51\x16\x05\x6c\x10\x0c\x96\xfa\x01\xf3\xc6\x81\x46\x6a\x7f\x54\xc5\x81\xf9\x4b\x42\xd8\x03\x19\x4f\xd4\x95\xf3\xc2\x6a\x7a\x64\x0d\xef\xc5\x01\x88\x53\x2c\x8b\x7b\x6d\x1c\x2a\xcc\xdb\x1c\x1c\xa1\xac\x54\xee\x5f\xfe\x3c\x48\x55\x8a\x8d\x76\x47\x7a\xd2\x43\x62\x5a\x37\xdf\x0c\xe1\xfc\xf9\xa7\x6b\x60\xe9\x24\xa6\xb2\x74\x12\x63\x41\xb8\x64\x38\x07\x4c\x4e\xf2\x59\x91\x8a\x8c\x09\xe6\xc7\x66\x2d\xb8\xb1\x46\xcd\x99\x98\x20\x12\xb3\x46\xe9\xea\xd5\x88\x4d\x55\xa3\x08\xe4\x49\x96\x51\x83\x94\x76\x90\x4b\x83\x99\xf3\x7c\x72\xec\xd1\x36\x7d\xee\x8b\x10\x06\xb1\x17\x6c\x50\x97\x16\xf0\x6b\x85\x99\x4b\x46\x23\x1c\x02\x5e\x28\x0d\xe4\x22\xd0\xc0\x83\xb4\x72\x53\xf4\x7d\x2c\x4b\x4b\x02\xc5\x4a\xe8\x11\x23\xac\x0c\x8a\x6c\x1f\xb0\x61\xc7\xf0\x12\xc4\xd6\xa1\x8f\xe9\x99\xba\xb2\x2f\x50\x2e\x11\x6e\x09\x5a\x71\x38\x80\xb0\x95\x4a\x14\xf2\x37\x34\x96\xdf\xc1\x38\x97\x95\x3b\xac\xe1\xca\x32\x8a\x20\xec\xd1\x8d\x3d\xc0\xfc\x20\xe9\xbd\x90\xca\x82\x74\x58\xda\x65\x87\xcc\x9b\x42\x67\xf7\xc4\xbb\x8f\xf1\xb5\x3d\xb9\x1a\x72\x91\x16\xdd\xb2\x65\xfb\xa2\x89\xe4\x20\x52\x59\x74\xa0\x4d\xb0\xc4\xb0\xad\x8d\xdb\xa3\x01\xa9\x42\xec\xbf\xad\x29\x4e\x5a\xf6\x59\x55\xb8\xbd\xae\x77\x7b\x90\x4d\x24\x14\xb5\x07\x42\x52\x94\xa8\x1e\x6e\x88\x5c\xab\x8c\xd4\x03\x6e\x44\x7b\x1c\x89\xec\x6b\xf8\x49\x1b\xc0\xaf\xa2\xac\x0a\xca\x2e\x58\x9e\x42\x82\xc1\x92\xe6\x43\x30\x01\x95\x66\x09\x0b\x90\x87\x1c\xc9\x8f\xaf\xa2\x49\xf2\x52\xf5\xb7\x7a\x43\x37\x7b\x7d\x20\xfe\xb3\xdc\x5b\x54\x39\xb9\xb9\x46\xde\x93\x29\x3a\x4e\xa6\x00\x00\xac\xdc\xf9\x58\xcf\xc7\x2f\x9e\x65\xc4\x7b\xa9\xf8\x4a\xa5\xf3\x35\x5c\x05\x49\x12\xae\x85\xc4\x12\x5c\x83\x44\x3f\x7a\x23\xa4\x08\x17\x10\xb0\x17\x26\x6f\x23\x11\x5f\xfa\xe2\xf6\xe6\xe7\xbf\xdd\xbc\x7b\xf7\xb2\xf7\x7a\x12\xeb\x3e\xa3\x18\x8b\xac\x40\xa1\xea\x6a\x19\x8c\x68\x44\xb2\xb1\xa5\x57\x9f\x6e\x38\x93\xe0\x1f\xd8\x25\x66\x1c\x9f\x29\x74\x8f\xda\xdc\xf7\xc0\x56\xc2\x38\x0e\xd3\xed\xb2\x63\xde\x89\x47\xd6\xd1\x31\xf0\xab\xb4\x2e\xa9\x53\x60\x2c\xcb\xe8\x12\x6a\xe5\x64\xdf\xa2\x08\x05\x22\x2f\xa5\x92\xd6\x19\xe1\xb4\x01\x6d\x40\xd4\x4e\x97\xc2\x4b\x8d\xce\xd0\x5a\xc8\x84\x82\x1c\x3d\x61\xb0\x2b\x67\x03\xf6\x8f\xdd\x4c\xe3\x56\x28\x16\xd9\xc6\x18\x6e\xd9\x30\x3b\x69\x59\x08\x49\xc3\x69\xf6\xa2\x0f\xd1\x6b\x0e\xaa\xc6\xe8\x51\x6c\x30\x16\x0b\x1c\x9b\xd1\xf4\xa6\x21\x45\x6d\x41\x6c\xfc\xcf\xff\xf7\x88\xa1\x31\x68\x93\x3e\xed\x7d\x6d\x89\x6e\xde\x2a\x46\xef\xde\x22\x75\xa3\xc5\x8d\x50\x1a\xdc\x91\x2c\xf4\x7c\x30\xc0\x5b\x91\xed\x01\x95\x33\x87\x90\xd4\xc9\x9c\xce\xb8\x95\x68\x52\x4d\xc6\xa0\xad\xb4\x62\xaf\x00\x99\x2e\x2b\xad\x50\x05\xc3\x41\x7a\x36\xe0\x2a\x93\x6a\x78\xc8\x09\x0f\x32\xcc\x2c\x38\x83\x26\xb7\x2b\x33\x43\x7c\x55\x5a\xad\x94\x2c\x96\x0c\x57\x62\x30\x13\x32\xb8\x0a\x12\xe8\x18\x81\x84\x18\xe7\xf8\xc0\xec\x0b\x9e\x94\x04\xfb\x9f\x84\x31\xa2\xeb\x66\x77\xa8\x28\x66\xc6\x93\x49\xda\xd9\xcf\xad\x3b\x03\x91\x75\xe5\x13\x73\xa8\x0c\x6e\xe5\xd7\xa5\x4f\xbe\x3a\x61\xc3\x72\xc8\xae\xc7\x97\x82\x80\x5a\xc9\x5f\xeb\x90\x8d\x7d\xfc\xf0\xee\xbf\xe0\xe6\x27\x7e\x9a\xdf\xc2\x4e\x95\x94\xae\x51\xb2\xca\xe8\x07\x99\xf7\x29\x02\x9e\x1d\xed\x10\x86\x90\xf1\xe6\x95\xa1\x1b\x74\xb5\x51\x3e\x64\x68\x2a\x2c\x4d\x1c\x34\x9a\xf9\xb9\xbd\x50\x0d\x98\x4a\x58\x9b\xc2\x25\xef\x3f\x19\x04\x47\x90\x1b\x96\xac\x8d\x54\xa1\x68\x90\x0e\xd8\xf7\x18\xf5\x76\x2b\xbf\x7a\x17\x14\xcf\x14\xc0\xed\x43\x64\xc0\x69\x6a\x53\xa2\x04\x53\x17\x68\x63\xd8\x40\xf4\xe9\x1b\x37\x1f\x84\xc4\xe2\xdb\x06\xc1\x99\x5a\x65\x6d\x2b\x54\xa0\xda\xb9\x7d\x14\x51\x8f\x05\xdb\x19\x69\x98\x34\x3d\x98\xa5\xb8\xf7\x3a\xe0\x91\xf3\xc7\x01\xad\x5a\x3c\x66\x7b\xd7\x23\x3f\x55\x70\x49\x01\x07\x5c\x90\xca\x7d\x91\x34\x88\x81\xcf\xc1\xbd\x83\xb0\xcb\x16\x60\x4f\xd9\x0f\x1f\xef\x02\xf3\x40\xc0\x9f\x5f\xfd\x15\x56\x03\x7e\xdd\x3a\x14\xf9\x32\xa5\x07\x28\x39\x6c\x09\x8f\xfd\xf0\xea\x35\x5c\xfb\xdc\x13\xb4\x81\xbf\xbc\x7a\xe5\xb9\xf3\x19\x85\xd5\x2a\x14\xe6\x48\x7f\x75\x3d\x94\x7c\xe6\x32\x13\xce\x47\x03\x6d\x71\xcd\xb8\xfa\xe2\x25\x13\xb6\xba\x56\x79\x74\xf7\x3e\x0e\x2f\x0a\xed\x1c\xe6\xcb\xd1\xf3\x07\x09\x0c\x65\x1c\x83\x64\x63\x5e\x44\x9d\x2a\x0e\xfd\xd0\x93\x11\xe1\xcc\x74\x40\x48\x11\x3e\x13\x84\x95\x0f\x33\xf6\x28\x72\x34\x2f\x99\x35\x57\x55\x55\x48\xcc\xbd\x51\x91\x5b\x88\x1a\xcc\x6e\x2f\x72\xa9\xaf\x50\xcf\xeb\x67\x64\x8e\x65\xa5\x1d\xaa\xec\x70\x36\xd7\x95\x04\x01\x39\x2a\x8b\xf7\x4c\xd3\x15\x58\x72\x94\x2a\x43\x50\x3e\xef\xec\x94\x2a\x44\x3c\x64\xd6\x02\x08\x7a\x3b\x48\xc3\x1c\x2d\x6b\x82\x75\xc2\xe1\x7a\x4e\x46\xff\x2c\xf9\x20\xf7\x4e\xe6\xb8\xcd\xb3\x2b\xd5\xbe\x99\x0d\x31\x47\x7c\x46\x17\x45\xaa\x99\xa1\xda\x6a\xae\x77\x59\x5d\x46\x9c\x07\x04\xfb\x41\x18\x29\x94\x03\xe1\xa2\xd7\x8d\x35\xa3\x10\x75\x77\x73\x42\xe1\xfd\x93\xde\x76\xd0\x1d\xb2\x97\x0e\xf6\xe2\xc1\x97\x2c\x0f\xe8\x40\x70\xaa\xa6\x3b\x05\x21\x1f\x78\xc9\x02\xb4\xf1\x31\x40\x27\x6e\xec\x01\x25\xa3\xc8\x0e\x80\x3c\x37\x85\x05\xc5\xa1\x85\x05\xa5\x40\xa4\xf0\x8f\xd2\xe2\xf2\x28\x8a\xc8\xc8\xe7\xe7\x68\x06\x0c\x51\xad\x5a\x20\x62\x76\xba\x97\x79\x8e\x0a\x5e\x48\xc5\xc7\xbd\x78\x14\x2e\xdb\xf3\x8f\x3b\x74\x90\x89\xa2\xb0\x2f\x7d\x28\xe0\xf5\x77\x82\x00\xea\xdc\x51\xa6\x5a\xc8\x4c\x52\xaa\x2b\xec\xbd\x77\x3f\x7a\xc3\xf6\xed\xe8\xfd\xa9\x36\x3b\x50\x59\xfa\x4f\x8e\x1a\x55\xfb\x58\xde\x9e\x2d\x3b\xb1\x25\x99\xbe\x2a\x88\x6c\x2b\xa2\x18\xac\x5f\xb3\x05\xaa\x8d\x61\x13\x84\x3d\xb6\x86\x32\x4a\x65\xe4\x83\x2c\x70\x87\x39\xe7\x5c\xbe\x9e\xc6\xb7\xf7\x33\x36\x5f\x66\x6e\xde\x1b\xf2\x52\xd9\x64\xbf\xcb\x98\x1e\x06\xab\xc9\x4f\x48\xcc\x63\x9e\xd9\x03\xb9\x39\x80\x50\x07\x7e\x35\xd1\x05\xde\xbc\xfd\xf4\xf9\xed\xf5\xd5\xdd\xdb\x37\xb0\xea\xa0\x0b\x82\x8b\xeb\x20\x8a\x6a\x2f\x82\xc8\x12\xcf\x06\x23\xbb\x26\xb0\x02\xa9\xe0\xe1\xf5\xfa\xf5\x5f\xd6\xc7\x46\xa9\x9a\x68\x36\x54\x3e\x3b\xec\xff\x70\xa4\xac\x9f\xfc\x7d\xe3\xba\x13\x3a\x07\xb5\x25\x39\xc1\xac\x76\x38\x00\x12\x40\xaa\x50\xf0\x4c\x61\x72\x52\x14\x90\x36\x96\x3a\xd6\x5e\x4a\x7c\x87\xce\xba\x88\xe5\x08\xc4\x8e\x09\x09\xd4\x88\x85\x10\xd8\x0a\x59\x10\xe2\x06\x6d\x5d\xb8\x56\xcd\x00\xa7\x55\x1f\x00\xc0\x37\x53\x52\x5c\x65\xd1\x81\xd3\xac\xe9\xd1\xef\x0d\xe9\x26\x08\xdb\xd6\xe7\x41\xc8\xf4\x7c\x38\x2b\x38\x4d\x0e\x36\xaa\xe0\x7a\xe0\xfe\x91\x18\xf9\x14\x6f\x01\x00\x42\x77\x7a\xe4\xb7\x23\x26\xb7\x3b\x17\x31\x27\x65\xb6\x4a\xdb\x49\x39\x28\x0d\x49\x27\x1c\xe3\x4b\xab\xa2\x14\xcc\xe4\xe8\x6d\x13\xc1\x3e\x00\x00\xa4\xa8\x6e\xec\x1c\x2b\x46\x7d\x31\x0e\x7b\xc4\x14\x8f\x27\x13\xfe\xad\x24\x32\x27\x55\xe3\x66\xdb\x15\x2e\xb6\x51\x4c\xc3\x9f\x84\x2c\x6a\x83\x31\x98\x9d\xc8\xa4\x52\x85\x64\x83\x50\x51\x1b\xdc\x86\x8a\x20\xb5\xda\xc4\x0e\xa3\xc0\xa9\x98\x49\x52\xc2\x65\x6b\xe3\xfb\x17\xc2\x81\x1e\xb4\x39\x00\x10\xe5\xca\xe7\x62\xc1\x5a\xb7\x93\xbd\xf5\xe2\xe9\x52\x35\xdc\xe4\x07\x78\xa6\x86\xff\x08\x4c\x38\x1a\x04\x78\x6a\xf3\x7f\x14\xec\xe0\x50\xc0\x53\x06\x01\x46\x21\xff\x81\x03\x02\x4f\x52\xa8\x4c\xe7\x38\x8b\x75\xb7\xf5\x6e\xe7\xcb\xdf\xff\x7e\x77\xf7\x29\x66\x21\xf4\x78\xd3\xfe\xa0\x00\xb3\xb6\x4b\x78\x05\x72\x3b\x02\x13\x62\x61\x6a\xcc\x08\xb4\x62\xcd\x1f\x7f\x98\x3c\xd5\x50\xcc\xd9\xa0\xee\x84\x2c\xec\xac\x93\xbd\xa5\xa1\xa0\x1c\x73\xa0\x92\x11\x08\x6b\x75\x26\x39\x3c\x4e\xea\x6b\x38\xa7\x5a\xfb\x92\xcc\x84\x4c\xd2\x5d\x2c\x19\x5e\xb6\x41\x3a\x0b\xfa\x51\x01\xa6\x37\x78\xb4\x8e\x82\xd0\x51\x88\x31\x6f\x8a\x4a\xef\x31\x4c\x49\xff\x60\xbb\x31\xd3\x14\x27\x97\xa3\x30\x9d\xe6\xe8\x23\xe8\x19\x7e\xcd\xb0\x0a\x05\x23\x8f\x74\xca\x0a\xc2\x71\x88\xd6\x63\xbc\x3a\xed\x73\x00\x32\x51\xdb\xa9\xdf\x07\xfa\xe6\xd7\xfc\x88\xb7\xc5\x20\x55\x56\xd4\x39\x5a\x28\xb5\xc1\x48\xc0\x16\x97\x26\x00\x43\xc3\xc1\x5b\x96\xcc\x90\x1b\x6f\xbd\x35\x5e\xc3\x07\xed\xd8\xe3\xb6\x7f\xe5\x68\x70\x12\x68\x28\x6d\x04\x5c\x30\x0f\x47\x5c\x4f\x3c\x34\xe1\xb7\x9f\x42\x4b\x00\x88\x15\x91\x53\x37\x1d\xa7\x58\x77\xfb\xe0\x7d\xa2\x5b\xef\x0e\x7a\xec\x85\xf5\xc7\xc8\x4f\xc2\x0d\xae\x1c\x8d\xd1\xd4\xfe\xb2\xec\x71\x59\x6a\xa4\xb3\xf0\x1f\xb7\x1f\x3f\x80\x45\xc3\x11\x81\x18\x73\x2b\xc7\xff\xde\x37\x8c\x86\x9c\x98\xa2\x72\xa8\xb4\x75\x54\xc8\x89\x33\x1a\x6c\x66\x14\x9b\xa0\x19\x10\x85\xf3\xe6\x93\x6c\xee\x15\x09\x92\x8f\xa6\x7f\x43\xa3\x57\x52\xe5\xf8\x95\xf2\x2b\xf8\x89\x28\x72\x9a\xe3\xd1\xd7\x55\x28\x8c\x97\x43\xae\x9f\x71\x63\x4c\x2a\x10\x2a\xc8\xaa\xde\x06\x59\x80\xbc\xc6\x39\x84\xd4\x9e\x27\x96\x32\x2b\xf2\xe0\x65\x5d\x38\x59\x15\xe8\xa9\x4b\xf9\x4a\xb0\x00\x9c\x28\xbc\xf5\xbd\x22\x7b\x39\x03\xf4\x17\x80\x2f\x67\xc4\x99\x2f\x67\xb0\x02\x97\xb8\x9f\x2e\x6a\xd5\xce\x96\x66\x40\x4c\x02\x43\x90\x59\xa0\xff\xfb\xd5\xff\xac\x27\x5e\x31\x03\x66\x40\x62\x2b\x8d\x75\x81\x86\xa1\xe0\xad\xe2\x4b\xbe\x9c\x9d\x06\x74\xd2\xcb\x35\xff\x4a\xb4\x56\xec\xf0\x89\xea\x73\x05\xfb\xba\x14\x6a\x65\x50\xe4\xdc\x4a\x6d\xfd\x9a\x26\x7c\x88\xf3\x73\xce\xec\x6f\x67\x0e\xaf\xa1\xed\x09\x42\x7d\xb3\x99\xd6\x10\x76\x35\xe1\x1d\xba\x36\x1d\x0c\x57\xc7\xd6\xcf\x49\x2c\xef\x02\x9e\x4c\xab\x52\x64\x7b\xa9\x70\x8a\x5a\x8b\xd3\x87\x62\x7a\x1e\x51\x2b\x16\x64\x39\x9a\x4a\x19\x38\xdd\x61\xe6\x80\x64\x87\xc9\xd1\x17\xc5\x18\x84\x8d\x78\x10\xb2\x20\x1c\x9f\x91\x6e\x27\x12\x8d\xee\x6d\xc3\x09\x47\xfc\xe7\x27\x85\x9f\xe2\x3b\xf9\x89\xc6\xfa\xf5\xac\xfd\x53\x1d\xa7\x0f\xe9\x3a\x1e\x72\xbd\xf8\x4e\x22\x1d\x0f\xab\x4e\x1e\xea\x9c\x4e\x45\x4f\xfc\xce\x87\x82\x8f\xca\x57\x16\x9b\x81\x2b\x1f\xca\x71\x0f\x65\x12\x6e\xab\x97\x17\x7a\x9b\x0d\x6a\x34\x7a\xfb\x07\x0d\xac\x7e\x13\x2f\xa6\x8b\x02\x63\x43\x8d\xbf\x2b\x2b\xe0\x45\x18\xb4\x43\x83\x61\x6a\x59\xaa\x5d\x81\xe3\xa9\x7d\x82\xca\x85\xe2\x4c\x28\x3f\x89\x41\x98\x6f\x30\x7f\xf9\xdd\x02\xcb\x6d\x0c\xee\x41\x8c\xcc\x89\x8d\x52\xec\x66\xdb\x74\x23\x96\xed\xb6\x47\x9a\x21\x6b\xba\xc4\x93\x47\x4b\x52\xd9\x9a\x90\xf5\x33\xb7\xf9\x1a\x6e\x75\x19\x4c\x64\x9c\xc4\xf6\x5d\x95\xc5\x74\x14\x97\xba\x35\x5c\xac\x73\xd4\x14\xe3\x6a\x23\x67\xbb\x0e\x41\x64\xfc\xc2\x55\x48\xf0\xb4\x8d\x2f\x39\x01\xb7\xe3\xd0\x22\x2e\xb0\xd7\x8f\x7e\x48\xc8\x69\x78\x14\xd2\xa5\x93\x8b\xfb\x93\x16\x75\x8f\x3d\xb4\xa6\x98\x3a\x27\x87\x84\x59\x79\x24\x00\x40\x2d\x9f\x60\xad\x7e\xb9\x79\x73\xac\x13\xeb\x31\x81\x5e\xcc\x0a\xb7\xc6\x84\xfa\xc9\xe3\xce\xcd\xf8\x80\xfd\x53\x2d\xbf\xdb\x76\x9c\x74\x73\x53\x66\xfe\x19\xf6\x13\x16\x93\x02\xf8\x1d\xbb\x0a\x8b\x19\x1a\xf3\x4d\x7b\x0b\xa3\x80\xff\x70\xf7\x70\x92\xbd\x27\xc2\xe4\x27\x07\xc7\xc1\xcc\x9f\x2a\xeb\x25\x2b\xb7\xfe\x76\xc4\xfb\x0b\x1a\xe3\x82\x77\xeb\x84\xca\x85\xc9\x7d\x23\x23\x3e\xfb\x4f\xf0\xd7\xb3\x2a\x29\x9a\x34\xa1\x9e\xef\xae\xe3\x03\xed\x35\x0e\xb9\x4d\xb3\xab\xfc\xb7\x80\x42\x96\xd2\x2d\x66\x64\x69\x2a\xcd\x3f\x73\x62\x96\xea\x50\x61\x06\x36\xd8\xf9\xd0\x28\x38\xe5\xcf\xc2\x30\xc4\x5e\xc4\xc2\x0e\xd7\xde\x52\x34\xce\xa1\x46\x8a\xf2\x75\x25\x68\x42\x61\x68\xf4\xaf\xfd\x2f\x1c\x33\x6e\x4b\x48\x6b\xf9\x21\x1d\xc6\x26\xc2\x50\xa5\x3e\x5e\x4c\x12\xee\x34\xa6\x79\xd3\x01\x04\xa7\xd3\xb6\x8b\xa7\x0b\x7e\x4d\xdd\xc6\x74\x82\x69\x82\xc6\xae\xe8\xb5\xe7\x90\xef\xe8\x73\xe3\xc8\x3a\x54\x2e\x88\x63\xd3\x53\xac\xb4\x1d\x9e\xfc\x6d\xff\x0b\xac\x0d\x94\xa5\x3a\xa0\xdc\xd5\x5e\x9d\x7c\x7d\x67\x2f\xd4\xce\x4f\x8b\x34\x35\x0c\x31\x1d\xd9\xe2\x23\x94\x52\x51\x19\xc5\x77\xbf\x9b\x49\xa1\xc6\xbf\xc5\x82\xbe\xf7\xf9\x51\x2a\x4e\x04\x6a\xa8\xa0\xb6\xde\xae\xfb\x9e\x99\x97\xd4\xd6\xf0\xd1\x06\xc3\xc0\x5b\x96\xa6\x50\x27\x61\x06\x69\x69\x57\x14\x42\xab\x0a\x69\x18\xb3\x40\x6b\xe1\xa0\x6b\x7f\x0e\x83\x19\xca\x87\x13\x58\x32\x6a\x4e\xdf\xa3\xf2\x4e\x42\x28\x1f\xff\x44\xeb\xf8\x0c\x71\x65\x87\x82\xf3\xa3\x8c\x5b\xd7\x34\x7c\x92\x5b\xb7\x2d\xf6\x9f\x9f\xdb\xd4\xb6\x98\xa6\x9a\x7f\x75\xb4\xcc\x69\x83\x81\x20\x87\x98\x23\x0e\xc0\xc5\xfe\xd1\xc0\x40\x55\x17\xd3\x38\xb7\xca\x5c\x0e\xb2\xee\xc9\x1e\x44\x70\x0d\x7f\xf7\x43\xda\x61\x5e\xd2\xf9\xbe\xff\x24\x58\x91\xcc\x40\x0b\x15\xae\x13\xb2\x48\x42\xad\x52\xe3\x7d\x23\xb2\xfb\x39\x12\x13\x27\xbd\xe6\xac\xb8\x34\x1e\x61\x12\xe4\x33\x78\x8b\x4c\x2b\x5f\x94\xcb\x0e\xab\x30\x04\xb3\x12\x2a\x5f\x25\xf3\x90\x1d\xbe\x3b\xeb\xb3\x58\x6c\xdf\x49\x75\x3f\x5b\xe2\xe2\x03\x3e\x4a\xfb\xe5\xf3\xbb\xe3\xe0\x6c\x46\x73\x17\xe6\x6d\x13\xfd\xce\x51\xe9\x74\x4d\xeb\x89\x95\xac\xc7\x7d\x18\x0d\x49\x81\xcb\x28\xf6\x32\x0d\xce\x9f\x85\x6e\xf0\x59\x88\x8a\xa6\xcb\x5a\x53\xfd\xa1\xd1\x62\x16\x5c\xc5\x39\xc0\xac\x10\xc6\x1b\x07\xa1\x7c\xe7\xce\xbf\x74\x22\xca\xc8\x11\x36\xb5\x83\x5c\xa3\xef\x2f\xe9\x07\x34\x46\xe6\x08\xd2\x7d\x73\x58\xe6\x5f\x3a\x3b\x28\x4b\xb1\x62\xab\x1c\x43\x15\x1a\x04\xbd\xbd\x84\xb3\xdb\x3a\xa3\x91\x84\xb3\xa1\x81\x9d\xf8\x2f\x51\xf9\xb9\xa3\x39\xca\xe7\x59\x21\xfd\x99\xbe\x31\xc4\x9e\x90\xd3\xf1\x19\x87\xd5\xc8\xfc\xcb\x28\xb0\x42\x6c\xb0\xf8\xbd\xb7\x8f\xdf\x0b\x1e\x0f\xf7\x77\xd2\xb2\xb1\xb7\xcb\xbe\xe3\xdd\xf7\x24\x4e\x83\x36\x3b\x41\xed\xf2\xc1\x29\x52\x0a\x22\x77\xda\xc8\xdf\x10\x5e\xf0\x87\x0d\xf8\xaa\xc5\x02\x33\xf7\xb2\xb5\xec\x2b\x0e\x50\xf2\x18\x9b\xff\x49\x1b\x3b\x34\xff\x68\x90\x46\xd5\xbc\x7e\x34\x23\x85\x36\xc0\x34\x0f\x32\xc3\x6f\xd8\x1c\xf6\x74\x9d\xbd\x34\x5c\x0a\x25\x76\x98\xfb\x6e\xd3\xf4\x28\xe4\xfb\xf6\xad\x50\x8a\xca\x02\xed\xa6\x6c\x0b\xfd\xb8\x92\x7e\xfc\x2b\xba\x6c\xef\xe1\x06\x97\x4b\xf5\x36\x36\x96\x98\xfc\xc2\x60\xc4\xc1\xdb\x5d\xe1\x12\xd4\xd0\x8b\x96\x14\x87\x5b\x57\x1c\xc2\x4c\xcf\x48\xe8\xb0\xd7\xb5\xc5\x7b\xc4\x4a\xaa\x9d\x8f\xfb\xfd\x04\x9d\x3b\x54\x14\xa7\x15\x87\x50\x9e\xa2\x29\x41\x15\x3a\xd2\x61\xfb\xaa\x56\x39\x1a\xeb\x86\x82\xf8\xa6\x64\x44\x96\x2b\x62\x16\xa5\x26\xe6\x2b\xe7\xbe\xd5\xb8\xec\x0c\x87\xc6\x8b\x7d\x12\x98\x66\xbe\x9d\x02\xf3\x66\x60\x56\x54\x15\x0d\x01\x0a\xb7\x87\x42\xde\x23\x7c\x39\xcb\xe4\x2a\xcb\xbf\x9c\xf9\xb0\x36\x44\xf2\x9e\x7e\x43\x9b\x0e\xa2\x78\x14\x87\x64\xcd\x13\x37\x42\xd6\xd3\xa0\xcf\xd2\x7e\xb4\xab\x3e\x14\x92\x04\xbf\x09\x5f\xd4\xf1\x6c\x2a\xcf\xfd\x79\x9d\x60\x4a\xb4\x22\xf8\x38\xeb\x47\x85\xd4\xa1\x09\x6f\xa5\x9d\xcc\xb0\x37\x01\x38\xd2\x88\x9e\x4e\x3f\x4f\x0d\xf9\x74\x9d\xe6\xe4\x84\x4f\xeb\x4b\x1e\xad\xf6\xf3\x62\x22\xfe\xf6\xd4\xe0\x54\x95\x47\xbe\xe3\xa6\x3c\x86\x2a\x1f\x48\x0b\x67\xdc\xf5\xb8\x08\xef\x38\x83\x7f\xd4\x76\x0c\x26\x73\x9c\x10\x72\xba\x5a\x15\x64\xe3\xdb\x18\x07\x19\x0c\xab\xdc\x48\x4e\x46\x98\x03\x38\x0d\xce\x88\xec\x7e\x14\xcf\xce\xf9\x44\x0b\xe7\x0d\xfa\x36\x96\x64\x1b\x18\xb2\xb9\xb0\xef\xe5\x15\x66\x31\xe6\x86\x79\x68\x69\x68\x86\x7d\x86\x77\xd9\x0e\x5a\x9a\x09\xeb\x0f\xce\xd4\x78\x9a\xb9\xc1\x2c\xb5\x52\x0e\xd1\xd5\x97\xf5\xb7\x8c\xde\x79\xd3\x64\x66\x08\x97\xb7\x8e\xa6\xbf\x0f\xa5\xb7\x5d\xdd\x63\x90\x13\x51\xe2\x1e\x2d\xce\x40\x79\x94\xc0\x29\x2a\x99\x81\xf4\xc7\x78\x6f\xfc\xae\x0e\xc1\x26\x8c\x13\x90\x50\xe3\x2d\x50\xe4\xe3\xd9\x15\x6b\x43\xc7\x3d\xbc\xe5\x56\xf9\x06\xc9\xb0\xa4\xcf\x10\x90\x66\x50\x1c\xed\xb7\x6c\x82\x17\x1e\x9f\xb5\x6a\x2b\x99\x30\x08\xe7\xb4\x58\x71\x38\x67\xab\x73\xfe\x0b\x97\x31\xcf\xbf\x89\x42\xd4\xe7\x98\x41\x9c\x3b\xe9\xf7\x36\x5c\x7b\xd3\x2c\x96\xcb\x13\x8f\xe0\x11\x0d\x4e\x4d\x8d\xdd\xa4\x95\x93\x60\x9d\xd3\x16\x9e\xdc\x76\x19\x10\x0e\xb8\x98\xea\x1b\x8c\xed\x07\xce\x38\xf8\x84\xa8\x8f\x35\x7c\xd5\xa9\x35\xb5\x73\x5e\x6e\x89\xc9\x72\x58\xd7\x21\xc3\x2f\x15\x88\xe6\x5b\x1f\x6b\xb8\xb1\x29\x78\x1c\xfe\x4e\x80\x5f\x85\x50\xbb\x64\x7e\xed\xb2\xd9\x72\xe6\xee\x67\xfa\x81\xcb\x4f\xfc\x81\x83\xb4\xb2\x3e\x24\x9b\xcd\xae\x32\x76\x37\x51\x40\x28\xb2\xd8\x46\x57\x46\x0a\x17\xfb\x86\x6d\xcb\xb7\x1e\x5e\xf8\x92\x16\x2a\x23\x4b\x61\x24\xaf\x43\x84\xc9\x39\x12\xd5\xb4\xc8\xd1\xec\xdd\xf8\xe0\xb0\x5b\xeb\xca\xd3\x67\xbb\xfa\xd2\x32\x50\xa2\xff\x9e\x36\x0a\xd3\xfe\x7c\xee\xea\x4f\xe2\xd4\x74\x08\xf8\x21\xde\xd6\x71\xa0\xfe\x4a\xe0\x3a\xad\xf4\x83\xea\x4b\x45\xff\xc0\x57\x2a\xe8\x41\x7a\x39\x48\x0b\x24\x24\x0f\xa2\xf0\x3c\x65\xf0\x5f\xce\x72\xdc\x8a\xba\x70\x5f\xce\x9a\x5b\x97\x94\x08\xf6\x40\xb6\x6f\x0d\x16\x2d\x13\x4a\x2b\xe2\xea\xd1\x60\x6e\x33\x62\x17\xe2\x76\x10\x06\x93\x8c\x0e\xad\x51\x6e\xd0\x7f\xcd\x2c\xa7\x3f\x5a\xc2\x1d\x26\x8c\xd8\x9c\xa5\x20\xc2\x9b\xad\xa6\x3b\x19\x5e\x32\xbc\x72\x1e\x2d\x02\x07\x5a\x71\x53\x57\xc0\x9b\x0f\xb7\xff\xfb\xee\xea\xdf\xde\xbe\x5b\x4f\x0b\x47\x3f\x14\x9e\x23\x2c\x09\x7f\x3b\x7b\x41\x4c\x3f\x2a\x34\x9f\x91\x17\x37\x33\x9c\x4e\x17\xde\x85\xfd\x8b\x70\x70\xc8\xb1\xf2\xea\xb2\x39\xf4\xf6\x92\xae\xde\xbd\x1b\x25\x50\x88\x65\xb9\xec\xcc\x85\x3a\x5e\x4b\x4a\x13\xe6\x9d\x6f\xde\x04\x5a\xee\x84\xd9\x88\x1d\x42\x46\x61\x78\xe6\xa6\xb6\x57\x9b\xdd\x88\x56\x12\xd2\x0e\xe2\xe9\x0d\x7e\x17\x28\x4d\x7f\xa5\x72\xfb\x30\x33\x43\xed\x5e\x37\xe5\xe3\x08\x29\x4d\x16\x34\x17\x5b\xf1\x18\x3d\x61\x86\xf4\xe4\x8e\x6b\x2d\x4d\x8c\xd6\x9e\xf2\xc3\x14\x4e\xb4\x80\xae\xff\x19\x91\x75\x37\x8c\x46\x30\x5e\x4c\xdc\x37\x79\x68\xfe\xd2\xc6\x47\x92\xb6\xf8\x29\x96\x19\x48\x10\x4f\x0d\x0d\xc1\x5f\x7d\x78\x13\x3b\x0e\x2c\xb1\x69\xc5\xf7\x8c\xba\xfa\x14\x90\xab\x3c\xc2\x1d\x9b\xe0\x4b\x6b\xf5\x41\x00\x1a\x60\x0d\x23\x7a\x0b\xf3\xf7\x78\x58\xb1\x19\x18\x01\xea\xbf\x49\xc6\x5f\x5f\x88\xa9\x46\xd0\xa5\xd6\x56\xd0\x1a\xde\x78\x1b\x66\xc1\x69\xd8\x8a\xc2\x52\xcf\x69\x2c\xf4\x4a\xdf\x55\x8a\xcb\xc8\x9c\x8f\x72\x82\x6b\xe1\xcc\x63\x78\x06\x15\x95\xbd\x6d\x9b\x3d\x7c\x96\xe5\x08\x50\x1d\x97\xfb\xe0\xcf\x3f\xfc\x00\x2f\x7e\x51\x61\xd1\x86\xeb\x8c\x6f\x95\x93\xee\xf0\xb2\xf5\x5d\x20\xdf\x55\x99\x62\xf4\x46\xeb\x02\x85\x5a\x0c\x26\x13\x41\x6a\x9f\xc2\xe1\x23\xe2\xb1\xca\xa5\xd5\x88\x19\x1a\x31\x0f\xb7\xf1\x29\x81\x81\x19\x81\x63\xb1\xff\xa3\x1b\xb5\x27\x34\x6a\x7c\x98\x6a\x20\x9e\x3b\x75\x96\xef\x0f\x44\x66\xe1\x3c\x3a\xdd\x32\x31\xd7\xf2\x1c\x18\x8f\x4f\xa0\x4c\x22\x3c\xb5\x00\xb6\x6a\xd9\xd3\xc1\x9f\x89\xb3\x83\x3f\x8c\x4c\x96\xad\x88\x3a\xcf\x11\xe2\x9f\x68\xf4\xf5\xb6\xa1\x43\xa7\x8b\xcd\x9c\xaf\x2c\x35\x83\x2c\x61\x63\x31\xae\x24\x25\x87\x30\x5c\x55\x9b\xd5\xcf\x1b\xe9\xd9\x0d\x2c\x2c\xb7\x7b\x78\xef\x5b\xed\x76\x8a\xc1\x68\x5b\xa5\x94\xd6\xc9\x0c\x5a\x3d\xac\x65\x78\x80\xdf\xc1\x93\x5b\xe3\x1f\x0f\xf0\x6b\xc9\x4d\x5a\xac\x55\xfb\x8b\x94\xda\xc4\x5a\x43\xbc\xd4\x7c\x12\xaf\x07\xd2\x8f\xb4\x51\xc2\x10\x12\x49\x9f\x08\xb7\xda\x88\x4f\xef\x1d\xc6\x7e\x21\x7f\xbe\xb2\x6c\x7d\x53\xcd\xa7\xda\x44\x03\xe1\x3f\x1a\x94\xd5\x85\x30\x03\x98\x8f\x7e\xba\xcc\x4e\x7d\x5f\xa7\xd3\x88\x9c\xd7\x39\x1d\xed\x96\x3e\xb7\xc9\x9c\xd1\xad\x9c\x1d\xf9\x8e\x75\x25\xbb\x7b\x68\xf3\x3b\x91\x1d\x7a\x0e\xee\x8a\x9f\xec\x3e\x8e\xe2\x3a\x60\x36\xbb\x5a\x4c\x06\x33\x64\x47\x21\x63\x97\x2a\x7c\x44\x43\xe5\x21\x9b\xf3\xfa\x7d\xf4\xf5\xc0\x81\x38\xda\x81\x6c\x97\xd8\x9b\x6f\x8c\x74\xbf\x66\xa7\x15\x58\xdf\x19\xa3\x8f\x30\xa5\x6c\x79\x40\xec\x5a\x5a\xd5\xfa\x7e\x5d\xfc\x9c\xa1\xd3\x51\x67\xb5\x82\x4f\xbf\xdc\x75\xbe\x41\xd9\x16\xd3\xa1\xd5\xf6\x93\xfd\xf3\x6f\x73\x15\x33\x85\x68\xd0\x36\x97\x68\xf7\x97\xa7\xbe\xec\x1b\x3f\xce\x3d\x01\xe9\xe8\x52\x30\xbd\x21\xb0\xf7\x4e\xe4\x12\x1e\x5e\x73\xd9\xfe\xf5\xa2\x65\x33\xf2\x4e\x7d\x35\x6c\xf2\xfa\x6b\xff\x37\x00\x6c\xfb\xdc\x8e\xa1\x5c\x00\x00"),
+		},
+		"/crds/gateway-routes.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "gateway-routes.yaml",
+			modTime:          time.Date(2026, 10, 19, 9, 49, 52, 395111953, time.UTC),
+			uncompressedSize: 23698,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x3c\x6b\x73\xdb\x48\x72\xdf\xf9\x2b\xba\x74\x1f\x64\x57\x91\x94\xbd\x7b\x97\xca\xe9\x9b\x22\x7b\x37\xca\xf9\x55\x96\xf6\x52\xa9\x38\x95\x1a\x02\x4d\x72\x4e\xc0\x0c\x76\x66\x20\x99\xfb\xeb\x53\xdd\xf3\x00\x40\x3c\x08\xd9\xda\xbd\xf8\x93\x05\x02\x8d\x9e\x7e\x3f\x21\x2a\xf9\x77\x34\x56\x6a\x75\x09\xa2\x92\xf8\xd5\xa1\xa2\xbf\xec\xfa\xfe\x5f\xed\x5a\xea\x8b\x87\xd7\x1b\x74\xe2\xf5\xe2\x5e\xaa\xfc\x12\xae\x6b\xeb\x74\xf9\x19\xad\xae\x4d\x86\x6f\x70\x2b\x95\x74\x52\xab\x45\x89\x4e\xe4\xc2\x89\xcb\x05\x40\x66\x50\xd0\xc5\x3b\x59\xa2\x75\xa2\xac\x2e\x41\xd5\x45\xb1\x00\x50\xa2\xc4\x4b\xd8\x09\x87\x8f\xe2\x60\x74\xed\xd0\xae\xef\xeb\x52\xac\xa5\x5e\xd8\x0a\x33\x7a\x7a\x67\x74\x5d\x5d\x42\xbc\xec\x1f\xb2\xf4\x0b\x80\x47\xe2\x67\xff\xfc\x67\x7a\x7e\x01\x00\x50\x15\xb5\x11\xc5\x11\xe0\x05\x80\xcd\x74\x85\x97\x70\x76\xb6\x00\x78\x10\x85\xcc\x19\x2d\x0f\x4a\x57\xa8\xae\x3e\xdd\xfc\xfd\xc7\xdb\x6c\x8f\xa5\xf0\x17\x01\x72\xb4\x99\x91\x15\xdf\xd7\x79\x11\x48\x0b\x6e\x8f\xe0\x6f\x87\xad\x36\xfc\x67\xe7\x95\x70\xf5\xe9\x66\x01\x00\x00\x50\x19\x5d\xa1\x71\x32\x62\x0e\x00\xd0\xa2\x75\xba\x76\xf4\xc6\x73\x42\xc9\xdf\x03\x39\x51\x17\xfd\x5b\x1f\xfc\x35\xcc\xc1\xfa\xf7\xeb\x2d\xb8\xbd\xb4\x60\xb0\x32\x68\x51\x39\x3e\x5a\x0b\x2c\xd0\x2d\x42\x81\xde\xfc\x03\x33\xb7\x86\x5b\x34\x04\x04\xec\x5e\xd7\x45\x0e\x99\x56\x0f\x68\x1c\x18\xcc\xf4\x4e\xc9\xdf\x12\x64\x0b\x4e\xf3\x2b\x0b\xe1\xd0\xba\x0e\x44\xa9\x1c\x1a\x25\x0a\x22\x66\x8d\x4b\x10\x2a\x87\x52\x1c\xc0\x20\xbd\x03\x6a\xd5\x82\xc6\xb7\xd8\x35\xbc\xd7\x06\x41\xaa\xad\xbe\x84\xbd\x73\x95\xbd\xbc\xb8\xd8\x49\x17\xa5\x2b\xd3\x65\x59\x2b\xe9\x0e\x17\x99\x56\xce\xc8\x4d\xed\xb4\xb1\x17\x39\x3e\x60\x71\x21\x2a\xb9\x62\x3c\x95\x63\x89\x2c\xf3\x3f\x99\x20\x79\xf6\xbc\x85\x98\x3b\x10\x97\xad\x33\x52\xed\xd2\x65\x16\x95\x51\x32\xff\x4d\xaa\x1c\xa4\x05\x11\x1e\xf3\xe8\x36\xd4\xa4\x4b\x44\x84\xcf\x6f\x6f\xef\x20\xbe\x94\x29\xde\x25\x31\x13\xb7\x79\xcc\x36\x74\x26\xba\x48\xb5\x45\xc3\x4f\xc1\xd6\xe8\x92\x21\xa2\xca\x2b\x2d\x95\xe3\x3f\xb2\x42\xa2\xea\xd2\xd8\xd6\x9b\x52\x3a\x62\xec\xaf\x35\x5a\x47\xec\x58\xc3\xb5\x50\x4a\x3b\xd8\x20\xd4\x55\x2e\x1c\xe6\x6b\xb8\x51\x70\x2d\x4a\x2c\xae\x85\xc5\xe7\xa6\x32\x11\xd4\xae\x88\x82\xa7\xe9\xdc\x56\x7c\x80\x71\xe1\x07\x00\xe0\x53\xb0\xa0\x1e\xfd\x00\x20\xf2\x9c\x0d\x89\x28\x3e\x8d\x3c\x3c\x8a\xc1\xa0\x1a\x35\x6f\x62\x36\x2b\xa8\x95\x75\xa6\xce\x5c\x6d\x30\x87\x7b\x3c\x04\x8e\x97\xa2\x02\xeb\x34\x5d\x7c\x94\x6e\xdf\x7b\xa3\x68\x73\x5f\x38\x66\xeb\x06\xc1\xa2\x83\xcd\x01\xf0\x6b\x50\x08\xa7\x75\x41\xac\xf2\xb0\x58\x31\x0c\x3a\x23\xf1\x01\xfb\x20\xcd\x46\x3a\x23\xcc\x21\xd1\x6e\x0d\x77\x7b\x3c\x80\x30\x08\xc4\xe6\x5f\x6b\x34\x07\xb1\x29\x3c\x9c\xa0\xb0\x1b\x04\x16\x32\xf3\x80\x79\x0f\xe4\xe3\x1e\x15\x94\x3a\x97\xdb\x03\x49\xae\x17\xcb\xbe\xf2\x5d\x5e\x5c\xdc\xd7\x1b\x34\x0a\xc9\xf0\x4a\x7d\x91\xeb\xcc\x5e\xd4\x16\xcd\x6a\x57\xcb\x1c\x2f\x5a\x0c\x3a\x5f\x0c\x91\xde\x43\xee\xfc\x94\x15\xb5\x75\x68\x3e\x90\x69\x9f\xe2\xc9\xdd\x1e\xd9\x94\x7b\xd3\x85\xf1\x39\x78\xdc\xcb\x6c\xcf\x57\x3c\x70\xd8\x60\xa1\xd5\xce\x0b\xfe\xdd\xb1\xc6\x01\x00\x48\x0b\xb5\xc5\x1c\x9c\x86\x5c\x5a\xd2\xd5\x5a\xda\x7d\x62\x94\x65\x4e\x82\x15\x65\x78\x21\x51\x91\xfe\x63\x2b\x91\x11\x39\x20\x97\xdb\x2d\x9a\x63\xcd\x6b\x1d\xc6\xfa\x37\xc3\x56\x62\xc1\x76\x82\xd8\x62\xd1\x81\x50\x87\xc7\x3d\x1a\x04\x23\x77\x7b\x07\x4a\x3f\x32\x74\x51\x49\xe6\x8c\x81\x01\x74\x77\x9a\xad\x89\x06\xb9\x53\xcc\x0f\x07\x72\xcb\xd0\xa4\xf2\xbe\x12\x41\x9b\xa0\xd9\x51\xef\xd7\x8b\x99\x92\xdf\x77\xb6\x53\x4c\x38\xbb\x3e\xbe\x9d\xd5\x03\x5c\xfa\xb3\x67\x02\xfd\xc1\xfa\xaa\x28\x4b\xf4\x72\xc7\xf6\x2d\xf0\xee\x51\xd8\x70\x24\x32\x51\x2e\x92\x6e\x57\x0b\x23\x94\x43\xcf\x34\xaf\x3f\x7d\xb6\x2a\xd8\x8b\xaa\x42\x65\x57\x1b\xdc\x12\xa5\xb4\xc9\xd1\x80\xc8\x8c\xb6\x16\x2c\x56\xc2\x30\xad\x2a\x34\x5e\x46\xd7\x70\xcd\x06\xd4\x5b\x5b\xa5\xfb\x30\x2d\x3a\x8f\x1f\x6b\x7b\x44\x29\x9d\x11\x73\x90\x0a\x3e\xff\x74\xfd\xe3\x8f\x3f\xfe\x95\x7c\x7a\xc9\xec\x94\x96\x2e\xff\x72\x77\xbd\x86\x2f\xaa\x07\xf3\x93\xae\x6a\x72\x8e\x39\x6c\x0e\x9e\x42\x07\xeb\xb0\x5c\xc3\x67\x14\xf9\x4a\xab\xe2\xb0\x86\x0f\x75\x51\x10\x3c\x28\xa4\x75\xcf\xee\x05\xa3\xdd\x38\x3b\xc2\x8d\x0e\x20\xdc\x25\x90\x20\xad\x88\x41\x73\x85\x28\xc7\x02\x09\xfa\xcf\x46\x64\xf8\x09\x8d\xd4\xf9\x2d\x66\x5a\xe5\x76\x52\x9a\x3e\xd4\xe5\x06\x0d\x68\x92\x66\xbe\x1b\x44\x51\xe8\x47\xcc\x43\x78\xd4\xc8\x85\xd3\xb0\x23\xd8\xdb\xba\x28\x0e\x7d\x59\x42\x53\x4a\x25\x1c\x42\x60\xbc\x74\xf0\x28\x8b\x02\x36\x08\x06\x4b\xfd\x80\x79\xe3\x40\x23\xb5\x3f\xaa\xe2\xc0\xfc\x25\x21\xec\x81\x8c\x27\xea\xca\x79\x61\x35\x3d\xb2\x86\xf7\xe2\x00\xc4\x29\x96\xc5\xbd\x36\x0e\x15\xe6\x6d\x0e\x8e\x50\x56\x2a\xf7\x2f\x7f\x1e\xa4\x2a\xc5\x46\xbb\x23\x3d\xe9\x21\x31\xad\x9b\x6f\x86\x70\xfe\xfc\xd3\x35\xb0\x74\x12\x53\x59\x3a\x89\xb1\x20\x5c\x32\x9c\x03\x26\x27\xf9\xac\x48\x45\xc6\x04\xf3\x63\xb3\x16\xdc\x58\xa3\xe6\x4c\x4c\x10\x89\x59\xa3\x74\xf5\x6a\xc4\xa6\xaa\x51\x04\xf2\x24\xcb\xa8\x41\x4a\x3b\xc8\xa5\xc1\xcc\x79\x3e\x39\xf6\x68\x9b\x3e\xf7\x45\x08\x83\xd8\x0b\x36\xa8\x4b\x0b\xf8\xb5\xc2\xcc\x25\xa3\x11\x0e\x01\x2f\x94\x06\x72\x11\x68\xe0\x41\x5a\xb9\x29\xfa\x3e\x96\xa5\x25\x81\x62\x25\xf4\x88\x11\x56\x06\x45\xb6\x0f\xd8\xb0\x63\x78\x09\x62\xeb\xd0\x07\xf4\x4c\x5d\xd9\x17\x28\x97\x08\xb7\x04\xad\x38\x1c\x40\xd8\x4a\x25\x0a\xf9\x1b\x1a\xcb\xef\x60\x9c\xcb\xca\x1d\xd6\x70\x65\x19\x45\x10\xf6\xe8\xc6\x1e\x60\x7e\x90\xf4\x5e\x48\x65\x41\x3a\x2c\xed\xb2\x43\xe6\x4d\xa1\xb3\x7b\xe2\xdd\xc7\xf8\xda\x9e\x5c\x0d\xb9\x48\x8b\x6e\xd9\xb2\x7d\xd1\x44\x72\x10\xa9\x2c\x3a\xd0\x26\x58\x62\xd8\xd6\xc6\xed\xd1\x80\x54\x21\xf6\xdf\xd6\x14\x27\x2d\xfb\xac\x2a\xdc\x5e\xd7\xbb\x3d\xc8\x26\x12\x8a\xda\x03\x21\x23\x4a\x54\x0f\x37\x44\xae\x55\x46\xea\x01\x37\xa2\x3d\x8e\x44\xf6\x35\xfc\xa4\x0d\xe0\x57\x51\x56\x05\x65\x17\x2c\x4f\x21\xc1\x60\x49\xf3\x21\x98\x80\x4a\xb3\x84\x05\xc8\x43\x8e\xe4\xc7\x57\xd1\x24\x79\xa9\xfa\x5b\xbd\xa1\x9b\xbd\x3e\x10\xff\x59\xee\x2d\xaa\x9c\xdc\x5c\x23\xef\xc9\x14\x1d\x27\x53\x00\x00\x56\xee\x7c\xac\xe7\xe3\x17\xcf\x32\xe2\xbd\x54\x7c\xa5\xd2\xf9\x1a\xae\x82\x24\x09\xd7\x42\x62\x09\xae\x41\xa2\x1f\xbd\x11\x52\x84\x0b\x08\xd8\x0b\x93\xb7\x91\x88\x2f\x7d\x71\x7b\xf3\xf3\xdf\x6e\xde\xbd\x7b\xd9\x7b\x3d\x89\x75\x9f\x51\x8c\x45\x56\xa0\x50\x75\xb5\x0c\x46\x34\x22\xd9\xd8\xd2\xab\x4f\x37\x9c\x49\xf0\x0f\xec\x12\x33\x8e\xcf\x14\xba\x47\x6d\xee\x7b\x60\x2b\x61\x1c\x87\xe9\x76\xd9\x31\xef\xc4\x23\xeb\xe8\x18\xf8\x55\x5a\x97\xd4\x29\x30\x96\x65\x74\x09\xb5\x72\xb2\x6f\x51\x84\x02\x91\x97\x52\x49\xeb\x8c\x70\xda\x80\x36\x20\x6a\xa7\x4b\xe1\xa5\x46\x67\x68\x2d\x64\x42\x41\x8e\x9e\x30\xd8\x95\xb3\x01\xfb\xc7\x6e\xa6\x71\x2b\x14\x8b\x6c\x63\x0c\xb7\x6c\x98\x9d\xb4\x2c\x84\xa4\xe1\x34\x7b\xd1\x87\xe8\x35\x07\x55\x63\xf4\x28\x36\x18\x8b\x05\x8e\xcd\x68\x7a\xd3\x90\xa2\xb6\x20\x36\xfe\xe7\xff\x7b\xc4\xd0\x18\xb4\x49\x9f\xf6\xbe\xb6\x44\x37\x6f\x15\xa3\x77\x6f\x91\xba\xd1\xe2\x46\x28\x0d\xee\x48\x16\x7a\x3e\x18\xe0\xad\xc8\xf6\x80\xca\x99\x43\x48\xea\x64\x4e\x67\xdc\x4a\x34\xa9\x20\x63\xd0\x56\x5a\xb1\x57\x80\x4c\x97\x95\x56\xa8\x82\xe1\x20\x3d\x1b\x70\x95\x49\x35\x3c\xe4\x84\x07\x19\x66\x16\x9c\x41\x93\xdb\x95\x99\x21\xbe\x2a\xad\x56\x4a\x16\x4b\x86\x2b\x31\x98\x09\x19\x5c\x05\x09\x74\x8c\x40\x42\x8c\x73\x7c\x60\xf6\x05\x4f\x4a\x82\xfd\x4f\xc2\x18\xd1\x75\xb3\x3b\x54\x14\x33\xe3\xc9\x24\xed\xec\xe7\xd6\x9d\x81\xc8\xba\xf2\x89\x39\x54\x06\xb7\xf2\xeb\xd2\x27\x5f\x9d\xb0\x61\x39\x64\xd7\xe3\x4b\x41\x40\xad\xe4\xaf\x75\xc8\xc6\x3e\x7e\x78\xf7\x5f\x70\xf3\x13\x3f\xcd\x6f\x61\xa7\x4a\x4a\xd7\x28\x59\x65\xf4\x83\xcc\xfb\x14\x01\xcf\x8e\x76\x08\x43\xc8\x78\xf3\xca\xd0\x0d\xba\xda\x28\x1f\x32\x34\x15\x96\x26\x0e\x1a\xcd\xfc\xdc\x5e\xa8\x06\x4c\x25\xac\x4d\xe1\x92\xf7\x9f\x0c\x82\x23\xc8\x0d\x4b\xd6\x46\xaa\x50\x34\x48\x07\xec\x7b\x8c\x7a\xbb\x95\x5f\xbd\x0b\x8a\x67\x0a\xe0\xf6\x21\x32\xe0\x34\xb5\x29\x4e\x82\xa9\x0b\xb4\x31\x6c\x20\xfa\xf4\x8d\x9b\x0f\x42\x62\xf1\x6d\x83\xe0\x4c\xad\xb2\xb6\x15\x2a\x50\xed\xdc\x3e\x8a\xa8\xc7\x82\xed\x8c\x34\x4c\x9a\x1e\xcc\x52\xdc\x7b\x1d\xf0\xc8\xf9\xe3\x80\x56\x2d\x1e\xb3\xbd\xeb\x91\x9f\xaa\xb6\xa4\x80\x03\x2e\x48\xe5\xbe\x42\x1a\xc4\xc0\xe7\xe0\xde\x41\xd8\x65\x0b\xb0\xa7\xec\x87\x8f\x77\x81\x79\x20\xe0\xcf\xaf\xfe\x0a\xab\x01\xbf\x6e\x1d\x8a\x7c\x99\xd2\x03\x94\x1c\xb6\x84\xc7\x7e\x78\xf5\x1a\xae\x7d\xee\x09\xda\xc0\x5f\x5e\xbd\xf2\xdc\xf9\x8c\xc2\x6a\x15\x0a\x73\xa4\xbf\xba\x1e\x4a\x3e\x73\x99\x09\xe7\xa3\x81\xb6\xb8\x66\x5c\x7d\xf1\x92\x09\x5b\x5d\xab\x3c\xba\x7b\x1f\x87\x17\x85\x76\x0e\xf3\xe5\xe8\xf9\x83\x04\x86\x32\x8e\x41\xb2\x31\x2f\xa2\x4e\x15\x87\x7e\xe8\xc9\x88\x70\x66\x3a\x20\xa4\x08\x9f\x09\xc2\xca\x87\x19\x7b\x14\x39\x9a\x97\xcc\x9a\xab\xaa\x2a\x24\xe6\xde\xa8\xc8\x2d\x44\x0d\x66\xb7\x17\xb9\xd4\x57\xa8\xe7\xf5\x33\x32\xc7\xb2\xd2\x0e\x55\x76\x38\x9b\xeb\x4a\x82\x80\x1c\x95\xc5\x7b\xa6\xe9\x0a\x2c\x39\x4a\x95\x21\x28\x9f\x77\x76\x4a\x15\x22\x1e\x32\x6b\x01\x04\xbd\x1d\xa4\x61\x8e\x96\x35\xc1\x3a\xe1\x70\x3d\x27\xa3\x7f\x96\x7c\x90\x9b\x25\x73\xdc\xe6\xd9\x95\x6a\xdf\xcc\x86\x98\x23\x3e\xa3\x8b\x22\xd5\xcc\x50\x6d\x35\xd7\xbb\xac\x2e\x23\xce\x03\x82\xfd\x20\x8c\x14\xca\x81\x70\xd1\xeb\xc6\x9a\x51\x88\xba\xbb\x39\xa1\xf0\xfe\x49\x6f\x3b\xe8\x0e\xd9\x4b\x07\x7b\xf1\xe0\x4b\x96\x07\x74\x20\x38\x55\xd3\x9d\x82\x90\x0f\xbc\x64\x01\xda\xf8\x18\xa0\x13\x37\xf6\x80\x92\x51\x64\x07\x40\x9e\x9b\xc2\x82\xe2\xd0\xc2\x82\x52\x20\x52\xf8\x47\x69\x71\x79\x14\x45\x64\xe4\xf3\x73\x34\x03\x86\xa8\x56\x2d\x10\x31\x3b\xdd\xcb\x3c\x47\x05\x2f\xa4\xe2\xe3\x5e\x3c\x0a\x97\xed\xf9\xc7\x1d\x3a\xc8\x44\x51\xd8\x97\x3e\x14\xf0\xfa\x3b\x41\x00\x75\xee\x28\x53\x2d\x64\x26\x29\xd5\x15\xf6\xde\xbb\x1f\xbd\x61\xfb\x76\xf4\xfe\x54\x9b\x1d\xa8\x2c\xfd\x27\x47\x8d\xaa\x7d\x2c\x6f\xcf\x96\x9d\xd8\x92\x4c\x5f\x15\x44\xb6\x15\x51\x0c\xd6\xaf\xd9\x02\xd5\xc6\xb0\x09\xc2\x1e\x5b\x43\x19\xa5\x32\xf2\x41\x16\xb8\xc3\x9c\x73\x2e\x5f\x4f\xe3\xdb\xfb\x19\x9b\x2f\x33\x37\xef\x0d\x79\xa9\x6c\xb2\xdf\x65\x4c\x0f\x83\xd5\xe4\x27\x24\xe6\x31\xcf\xec\x81\xdc\x1c\x40\xa8\x03\xbf\x9a\xe8\x02\x6f\xde\x7e\xfa\xfc\xf6\xfa\xea\xee\xed\x1b\x58\x75\xd0\x05\xc1\xc5\x75\x10\x45\xb5\x17\x41\x64\x89\x67\x83\x91\x5d\x13\x58\x81\x54\xf0\xf0\x7a\xfd\xfa\x2f\xeb\x63\xa3\x54\x4d\x34\x1b\x2a\x9f\x1d\xf6\x7f\x38\x52\xd6\x4f\xfe\xbe\x71\xdd\x09\x9d\x83\xda\x92\x9c\x60\x16\xbb\x96\x7d\x4d\x0d\x05\xcf\x14\x26\x27\x45\x01\x69\x63\xa9\x63\xed\xa5\xc4\x77\xe8\xac\x8b\x58\x8e\x40\xec\x98\x90\x40\x8d\x58\x08\x81\xad\x90\x05\x21\x6e\xd0\xd6\x85\x6b\xd5\x0c\x70\x5a\xf5\x01\x00\x7c\x33\x25\xc5\x55\x16\x1d\x38\xcd\x9a\x1e\xfd\xde\x90\x6e\x82\xb0\x6d\x7d\x1e\x84\x4c\xcf\x87\xb3\x82\xd3\xe4\x60\xa3\x0a\xae\x07\xee\x1f\x89\x91\x4f\xf1\x16\x00\x20\xb4\xa3\x47\x7e\x3b\x62\x72\xbb\x73\x11\x73\x52\x66\xab\xb4\x9d\x94\x83\xd2\x90\x74\xc2\x31\xbe\xb4\x2a\x4a\xc1\x4c\x8e\xde\x36\x11\xec\x03\x00\x40\x8a\xea\xc6\xce\xb1\x62\xd4\x17\xe3\xb0\x47\x4c\xf1\x78\x32\xe1\xdf\x4a\x22\x73\x52\x35\x6e\xb6\x5d\xe1\x62\x1b\xc5\x34\xfc\x49\xc8\xa2\x36\x18\x83\xd9\x89\x4c\x2a\x55\x48\x36\x08\x15\xb5\xc1\x6d\xa8\x08\x52\xab\x4d\xec\x30\x0a\x9c\x8a\x99\x24\x25\x5c\xb6\x36\xbe\x7f\x21\x1c\xe8\x41\x9b\x03\x00\x51\xae\x7c\x2e\x16\xac\x75\x3b\xd9\x5b\x2f\x9e\x2e\x55\xc3\x4d\x7e\x80\x67\x6a\xf8\x8f\xc0\x84\xa3\x41\x80\xa7\x36\xff\x47\xc1\x0e\x0e\x05\x3c\x65\x10\x60\x14\xf2\x1f\x38\x20\xf0\x24\x85\xca\x74\x8e\xb3\x58\x77\x5b\xef\x76\xbe\xfc\xfd\xef\x77\x77\x9f\x62\x16\x42\x8f\x37\xed\x0f\x0a\x30\x6b\xbb\x84\x57\x20\xb7\x23\x30\x21\x16\xa6\xc6\x8c\x40\x2b\xd6\xfc\xf1\x87\xc9\x53\x0d\xc5\x9c\x0d\xea\x4e\xc8\xc2\xce\x3a\xd9\x5b\x9a\x02\xca\x31\x07\x2a\x19\x81\xb0\x56\x67\x92\xc3\xe3\xa4\xbe\x86\x73\xaa\xb5\x2f\xc9\x4c\xc8\x24\xdd\xc5\x92\xe1\x65\x1b\xa4\xb3\xa0\x1f\x15\x60\x7a\x83\x47\xeb\x28\x08\x1d\x85\x18\xf3\xa6\xa8\xf4\x1e\xc3\x94\xf4\x0f\xb6\x1b\x33\x4d\x71\x72\x39\x0a\xd3\x69\x8e\x3e\x82\x9e\xe1\xd7\x0c\xab\x50\x30\xf2\x48\xa7\xac\x20\x1c\x87\x68\x3d\xc6\xab\xd3\x3e\x07\x20\x13\xb5\x9d\xfa\x7d\xa0\x6f\x7e\xcd\x8f\x78\x5b\x0c\x52\x65\x45\x9d\xa3\x85\x52\x1b\x8c\x04\x6c\x71\x69\x02\x30\x34\x1c\xbc\x65\xc9\x0c\xb9\xf1\xd6\x5b\xe3\x35\x7c\xd0\x8e\x3d\x6e\xfb\x57\x8e\x06\x27\x81\x86\xd2\x46\xc0\x05\xf3\x70\xc4\xf5\xc4\x43\x13\x7e\xfb\x29\xb4\x04\x80\x58\x11\x39\x75\xd3\x71\x8a\x75\xb7\x0f\xde\x27\xba\xf5\xee\xa0\xc7\x5e\x58\x7f\x8c\xfc\x24\xdc\xe0\xca\xd1\x18\x4d\xed\x2f\xcb\x1e\x97\xa5\x46\x3a\x0b\xff\x71\xfb\xf1\x03\x58\x34\x1c\x11\x88\x31\xb7\x72\xfc\xef\x7d\xc3\x68\xc8\x89\x29\x2a\x87\x4a\x5b\x47\x85\x9c\x38\xa3\xc1\x66\x46\xb1\x09\x9a\x01\x51\x38\x6f\x3e\xc9\xe6\x5e\x91\x20\xf9\x68\xfa\x37\x34\x7a\x25\x55\x8e\x5f\x29\xbf\x82\x9f\x88\x22\xa7\x39\x1e\x7d\x5d\x85\xc2\x78\x39\xe4\xfa\x19\x37\xc6\xa4\x02\xa1\x82\xac\xea\x6d\x90\x05\xc8\x6b\x9c\x43\x48\xed\x79\x62\x29\xb3\x22\x0f\x5e\xd6\x85\x93\x55\x81\x9e\xba\x94\xaf\x04\x0b\xc0\x89\xc2\x5b\xdf\x2b\xb2\x97\x33\x40\x7f\x01\xf8\x72\x46\x9c\xf9\x72\x06\x2b\x70\x89\xfb\xe9\xa2\x56\xed\x6c\x69\x06\xc4\x24\x30\x04\x99\x05\xfa\xbf\x5f\xfd\xcf\x7a\xe2\x15\x33\x60\x06\x24\xb6\xd2\x58\x17\x68\x18\x0a\xde\x2a\xbe\xe4\xcb\xd9\x69\x40\x27\xbd\x5c\xf3\xaf\x44\x6b\xc5\x0e\x9f\xa8\x3e\x57\xb0\xaf\x4b\xa1\x56\x06\x45\xce\xad\xd4\xd6\xaf\x69\xc2\x87\x38\x3f\xe7\xcc\xfe\x76\xe6\xf0\x1a\xda\x9e\x20\xd4\x37\x9b\x69\x0d\x61\x57\x13\xde\xa1\x6b\xd3\xc1\x70\x75\x6c\xfd\x9c\xc4\xf2\x2e\xe0\xc9\xb4\x2a\x45\xb6\x97\x0a\xa7\xa8\xb5\x38\x7d\x28\xa6\xe7\x11\xb5\x62\x41\x96\xa3\xa9\x94\x81\xd3\x1d\x66\x0e\x48\x76\x98\x1c\x7d\x51\x8c\x41\xd8\x88\x07\x21\x0b\xc2\xf1\x19\xe9\x76\x22\xd1\xe8\xde\x36\x9c\x70\xc4\x7f\x7e\x3a\xf8\x29\xbe\x93\x9f\x68\xac\x5f\xcf\xda\x3f\xd5\x71\xfa\x90\xae\xe3\x21\xd7\x8b\xef\x24\xd2\xf1\xb0\xea\xe4\xa1\xce\xe9\x54\xf4\xc4\xef\x7c\x28\xf8\xa8\x7c\x65\xb1\x19\xb8\xf2\xa1\x1c\xf7\x50\x26\xe1\xb6\x7a\x79\xa1\xb7\xd9\xa0\x46\xa3\xb7\x7f\xd0\xc0\xea\x37\xf1\x62\xba\x28\x30\x36\xd4\xf8\xbb\xb2\x02\x5e\x84\x41\x3b\x34\x18\xa6\x96\xa5\xda\x15\x38\x9e\xda\x27\xa8\x5c\x28\xce\x84\xf2\x93\x18\x84\xf9\x06\xf3\x97\xdf\x2d\xb0\xdc\xc6\xe0\x1e\xc4\xc8\x9c\xd8\x28\xc5\x6e\xb6\x4d\x37\x62\xd9\x6e\x7b\xa4\x19\xb2\xa6\x4b\x3c\x79\xb4\x24\x95\xad\x09\x59\x3f\x73\x9b\xaf\xe1\x56\x97\xc1\x44\xc6\x49\x6c\xdf\x55\x59\x4c\x47\x71\xa9\x5b\xc3\xc5\x3a\x47\x4d\x31\xae\x36\x72\xb6\xeb\x10\x44\xc6\x2f\x5c\x85\x04\x4f\xdb\xf8\x92\x13\x70\x3b\x0e\x2d\xe2\x02\x7b\xfd\xe8\x87\x84\x9c\x86\x47\x21\x5d\x3a\xb9\xb8\x3f\x69\x51\xf7\xd8\x43\x6b\x8a\xa9\x73\x72\x48\x98\x95\x47\x02\x00\xd4\xf2\x09\xd6\xea\x97\x9b\x37\xc7\x3a\xb1\x1e\x13\xe8\xc5\xac\x70\x6b\x4c\xa8\x9f\x3c\xee\xdc\x8c\x0f\xd8\x3f\xd5\xf2\xbb\x6d\xc7\x49\x37\x37\x65\xe6\x9f\x61\x3f\x61\x31\x29\x80\xdf\xb1\xab\xb0\x98\xa1\x31\xdf\xb4\xb7\x30\x0a\xf8\x0f\x77\x0f\x27\xd9\x7b\x22\x4c\x7e\x72\x70\x1c\xcc\xfc\xa9\xb2\x5e\xb2\x72\xeb\x6f\x47\xbc\xbf\xa0\x31\x2e\x78\xb7\x4e\xa8\x5c\x98\xdc\x37\x32\xe2\xb3\xff\x04\x7f\x3d\xab\x92\xa2\x49\x13\xea\xf9\xee\x3a\x3e\xd0\x5e\xe3\x90\xdb\x34\xbb\xca\x7f\x0b\x28\x64\x29\xdd\x62\x46\x96\xa6\xd2\xfc\x33\x27\x66\xa9\x0e\x15\x66\x60\x83\x9d\x0f\x8d\x82\x53\xfe\x2c\x0c\x43\xec\x45\x2c\xec\x70\xed\x2d\x45\xe3\x1c\x6a\xa4\x28\x5f\x57\x82\x26\x14\x86\x46\xff\xda\xff\xc2\x31\xe3\xb6\x84\xb4\x96\x1f\xd2\x61\x6c\x22\x0c\x55\xea\xe3\xc5\x24\xe1\x4e\x63\x9a\x37\x1d\x40\x70\x3a\x6d\xbb\x78\xba\xe0\xd7\xd4\x6d\x4c\x27\x98\x26\x68\xec\x8a\x5e\x7b\x0e\xf9\x8e\x3e\x37\x8e\xac\x43\xe5\x82\x38\x36\x3d\xc5\x4a\xdb\xe1\xc9\xdf\xf6\xbf\xc0\xda\x40\x59\xaa\x03\xca\x5d\xed\xd5\xc9\xd7\x77\xf6\x42\xed\xfc\xb4\x48\x53\xc3\x10\xd3\x91\x2d\x3e\x42\x29\x15\x95\x51\x7c\xf7\xbb\x99\x14\x6a\xfc\x5b\x2c\xe8\x7b\x9f\x1f\xa5\xe2\x44\xa0\x86\x0a\x6a\xeb\xed\xba\xef\x99\x79\x49\x6d\x0d\x1f\x6d\x30\x0c\xbc\x65\x69\x0a\x75\x12\x66\x90\x96\x76\x45\x21\xb4\xaa\x90\x86\x31\x0b\xb4\x16\x0e\xba\xf6\xe7\x30\x98\xa1\x7c\x38\x81\x25\xa3\xe6\xf4\x3d\x2a\xef\x24\x84\xf2\xf1\x4f\xb4\x8e\xcf\x10\x57\x76\x28\x38\x3f\xca\xb8\x75\x4d\xc3\x27\xb9\x75\xdb\x62\xff\xf9\xb9\x4d\x6d\x8b\x69\xaa\xf9\x57\x47\xcb\x9c\x36\x18\x08\x72\x88\x39\xe2\x00\x5c\xec\x1f\x0d\x0c\x54\x75\x31\x8d\x73\xab\xcc\xe5\x20\xeb\x9e\xec\x41\x04\xd7\xf0\x77\x3f\xa4\x1d\xe6\x25\x9d\xef\xfb\x4f\x82\x15\xc9\x0c\xb4\x50\xe1\x3a\x21\x8b\x24\xd4\x2a\x35\xde\x37\x22\xbb\x9f\x23\x31\x71\xd2\x6b\xce\x8a\x4b\xe3\x11\x26\x41\x3e\x83\xb7\xc8\xb4\xf2\x45\xb9\xec\xb0\x0a\x43\x30\x2b\xa1\xf2\x55\x32\x0f\xd9\xe1\xbb\xb3\x3e\x8b\xc5\xf6\x9d\x54\xf7\xb3\x25\x2e\x3e\xe0\xa3\xb4\x5f\x3e\xbf\x3b\x0e\xce\x66\x34\x77\x61\xde\x36\xd1\xef\x1c\x95\x4e\xd7\xb4\x9e\x58\xc9\x7a\xdc\x87\xd1\x90\x14\xb8\x8c\x62\x2f\xd3\xe0\xfc\x59\xe8\x06\x9f\x85\xa8\x68\xba\xac\x35\xd5\x1f\x1a\x2d\x66\xc1\x55\x9c\x03\xcc\x0a\x61\xbc\x71\x10\xca\x77\xee\xfc\x4b\x27\xa2\x8c\x1c\x61\x53\x3b\xc8\x35\xfa\xfe\x92\x7e\x40\x63\x64\x8e\x20\xdd\x37\x87\x65\xfe\xa5\xb3\x83\xb2\x14\x2b\xb6\xca\x31\x54\xa1\x41\xd0\xdb\x4b\x38\xbb\xad\x33\x1a\x49\x38\x1b\x1a\xd8\x89\xff\x12\x95\x9f\x3b\x9a\xa3\x7c\x9e\x15\xd2\x9f\xe9\x1b\x43\xec\x09\x39\x1d\x9f\x71\x58\x8d\xcc\xbf\x8c\x02\x2b\xc4\x06\x8b\xdf\x7b\xfb\xf8\xbd\xe0\xf1\x70\x7f\x27\x2d\x1b\x7b\xbb\xec\x3b\xde\x7d\x4f\xe2\x34\x68\xb3\x13\xd4\x2e\x1f\x9c\x22\xa5\x20\x72\xa7\x8d\xfc\x0d\xe1\x05\x7f\xd2\x80\xaf\x5a\x2c\x30\x73\x2f\x5b\xcb\xbe\xe2\x00\x25\x8f\xb1\xf9\x9f\xb4\xb1\x43\xf3\x8f\x06\x69\x54\xcd\xeb\x47\x33\x52\x68\x03\x4c\xf3\x20\x33\xfc\x86\xcd\x61\x4f\xd7\xd9\x4b\xc3\xa5\x50\x62\x87\xb9\xef\x36\x4d\x8f\x42\xbe\x6f\xdf\x0a\xa5\xa8\x2c\xd0\x6e\xca\xb6\xd0\x8f\x2b\xe9\xc7\xbf\xa2\xcb\xf6\x1e\x6e\x70\xb9\x54\x6f\x63\x63\x89\xc9\x2f\x0c\x46\x1c\xbc\xdd\x15\x2e\x41\x0d\xbd\x68\x49\x71\xb8\x75\xc5\x21\xcc\xf4\x8c\x84\x0e\x7b\x5d\x5b\xbc\x47\xac\xa4\xda\xf9\xb8\xdf\x4f\xd0\xb9\x43\x45\x71\x5a\x71\x08\xe5\x29\x9a\x12\x54\xa1\x23\x1d\xb6\xaf\x6a\x95\xa3\xb1\x6e\x28\x88\x6f\x4a\x46\x64\xb9\x22\x66\x51\x6a\x62\xbe\x72\xee\x5b\x8d\xcb\xce\x70\x68\xbc\xd8\x27\x81\x69\xe6\xdb\x29\x30\x6f\x06\x66\x45\x55\xd1\x10\xa0\x70\x7b\x28\xe4\x3d\xc2\x97\xb3\x4c\xae\xb2\xfc\xcb\x99\x0f\x6b\x43\x24\xef\xe9\x37\xb4\xe9\x20\x8a\x47\x71\x48\xd6\x3c\x71\x23\x64\x3d\x0d\xfa\x2c\xed\x47\xbb\xea\x43\x21\x49\xf0\x9b\xf0\x45\x1d\xcf\xa6\xf2\xdc\x9f\xd7\x09\xa6\x44\x2b\x82\x8f\xb3\x7e\x54\x48\x1d\x9a\xf0\x56\xda\xc9\x0c\x7b\x13\x80\x23\x8d\xe8\xe9\xf4\xf3\xd4\x90\x4f\xd7\x69\x4e\x4e\xf8\xb4\xbe\xe4\xd1\x6a\x3f\x2f\x26\xe2\x6f\x4f\x0d\x4e\x55\x79\xe4\x3b\x6e\xca\x63\xa8\xf2\x81\xb4\x70\xc6\x5d\x8f\x8b\xf0\x8e\x33\xf8\x47\x6d\xc7\x60\x32\xc7\x09\x21\xa7\xab\x55\x41\x36\xbe\x8d\x71\x90\xc1\xb0\xca\x8d\xe4\x64\x84\x39\x80\xd3\xe0\x8c\xc8\xee\x47\xf1\xec\x9c\x4f\xb4\x70\xde\xa0\x6f\x63\x49\xb6\x81\x21\x9b\x0b\xfb\x5e\x5e\x61\x16\x63\x6e\x98\x87\x96\x86\x66\xd8\x67\x78\x97\xed\xa0\xa5\x99\xb0\xfe\xe0\x4c\x8d\xa7\x99\x1b\xcc\x52\x2b\xe5\x10\x5d\x7d\x59\x7f\xcb\xe8\x9d\x37\x4d\x66\x86\x70\x79\xeb\x68\xfa\xfb\x50\x7a\xdb\xd5\x3d\x06\x39\x11\x25\xee\xd1\xe2\x0c\x94\x47\x09\x9c\xa2\x92\x19\x48\x7f\x8c\xf7\xc6\x8f\xea\x10\x6c\xc2\x38\x01\x09\x35\xde\x02\x45\x3e\x9e\x5d\xb1\x36\x74\xdc\xc3\x5b\x6e\x95\x6f\x90\x0c\x4b\xfa\x0c\x01\x69\x06\xc5\xd1\x7e\xcb\x26\x78\xe1\xf1\x59\xab\xb6\x92\x09\x83\x70\x4e\x8b\x15\x87\x73\xb6\x3a\xe7\xbf\x70\x19\xf3\xfc\x9b\x28\x44\x7d\x8e\x19\xc4\xb9\x93\x7e\x6f\xc3\xb5\x37\xcd\x62\xb9\x3c\xf1\x08\x1e\xd1\xe0\xd4\xd4\xd8\x4d\x5a\x39\x09\xd6\x39\x6d\xe1\xc9\x6d\x97\x01\xe1\x80\x8b\xa9\xbe\xc1\xd8\x7e\xe0\x8c\x83\x4f\x88\xfa\x58\xc3\x57\x9d\x5a\x53\x3b\xe7\xe5\x96\x98\x2c\x87\x75\x1d\x32\xfc\x52\x81\x68\xbe\xf5\xb1\x86\x1b\x9b\x82\xc7\xe1\xef\x04\xf8\x55\x08\xb5\x4b\xe6\xd7\x2e\x9b\x2d\x67\xee\x7e\xa6\x1f\xb8\xfc\xc4\x1f\x38\x48\x2b\xeb\x43\xb2\xd9\xec\x2a\x63\x77\x13\x05\x84\x22\x8b\x6d\x74\x65\xa4\x70\xb1\x6f\xd8\xb6\x7c\xeb\xe1\x85\x2f\x69\xa1\x32\xb2\x14\x46\xf2\x3a\x44\x98\x9c\x23\x51\x4d\x8b\x1c\xcd\xde\x8d\x0f\x0e\xbb\xb5\xae\x3c\x7d\xa7\xab\x2f\x2d\x03\x25\xfa\xef\x69\xa3\x30\xed\xcf\xe7\xae\xfe\x24\x4e\x4d\x87\x80\x1f\xe2\x6d\x1d\x07\xea\xaf\x04\xae\xd3\x4a\x3f\xa8\xbe\x54\xf4\x0f\x7c\xa5\x82\x1e\xa4\x97\x83\xb4\x40\x42\xf2\x20\x0a\xcf\x53\x06\xff\xe5\x2c\xc7\xad\xa8\x0b\xf7\xe5\xac\xb9\x75\x49\x89\x60\x0f\x64\xfb\xd6\x60\xd1\x32\xa1\xb4\x22\xae\x1e\x0d\xe6\x36\x23\x76\x21\x6e\x07\x61\x30\xc9\xe8\xd0\x1a\xe5\x06\xfd\x77\xcc\x72\xfa\xa3\x25\xdc\x61\xc2\x88\xcd\x59\x0a\x22\xbc\xd9\x6a\xba\x93\xe1\x25\xc3\x2b\xe7\xd1\x22\x70\xa0\x15\x37\x75\x05\xbc\xf9\x70\xfb\xbf\xef\xae\xfe\xed\xed\xbb\xf5\xb4\x70\xf4\x43\xe1\x39\xc2\x92\xf0\xb7\xb3\x17\xc4\xf4\xa3\x42\xf3\x19\x79\x71\x33\xc3\xe9\x74\xe1\x5d\xd8\xbf\x08\x07\x87\x1c\x2b\xaf\x2e\x9b\x43\x6f\x2f\xe9\xea\xdd\xbb\x51\x02\x85\x58\x96\xcb\xce\x5c\xa8\xe3\xb5\xa4\x34\x61\xde\xf9\xe6\x4d\xa0\xe5\x4e\x98\x8d\xd8\x21\x64\x14\x86\x67\x6e\x6a\x7b\xb5\xd9\x8d\x68\x25\x21\xed\x20\x9e\xde\xe0\x77\x81\xd2\xf4\x57\x2a\xb7\x0f\x33\x33\xd4\xee\x75\x53\x3e\x8e\x90\xd2\x64\x41\x73\xb1\x15\x8f\xd1\x13\x66\x48\x4f\xee\xb8\xd6\xd2\xc4\x68\xed\x29\x3f\x4c\xe1\x44\x0b\xe8\xfa\x9f\x11\x59\x77\xc3\x68\x04\xe3\xc5\xc4\x7d\x93\x87\xe6\x2f\x6d\x7c\x24\x69\x8b\x9f\x62\x99\x81\x04\xf1\xd4\xd0\x10\xfc\xd5\x87\x37\xb1\xe3\xc0\x12\x9b\x56\x7c\xcf\xa8\xab\x4f\x01\xb9\xca\x23\xdc\xb1\x09\xbe\xb4\x56\x1f\x04\xa0\x01\xd6\x30\xa2\xb7\x30\x7f\x8f\x87\x15\x9b\x81\x11\xa0\xfe\x9b\x64\xfc\xf5\x85\x98\x6a\x04\x5d\x6a\x6d\x05\xad\xe1\x8d\xb7\x61\x16\x9c\x86\xad\x28\x2c\xf5\x9c\xc6\x42\xaf\xf4\x5d\xa5\xb8\x8c\xcc\xf9\x28\x27\xb8\x16\xce\x3c\x86\x67\x50\x51\xd9\xdb\xb6\xd9\xc3\x67\x59\x8e\x00\xd5\x71\xb9\x0f\xfe\xfc\xc3\x0f\xf0\xe2\x17\x15\x16\x6d\xb8\xce\xf8\x56\x39\xe9\x0e\x2f\x5b\xdf\x05\xf2\x5d\x95\x29\x46\x6f\xb4\x2e\x50\xa8\xc5\x60\x32\x11\xa4\xf6\x29\x1c\x3e\x22\x1e\xab\x5c\x5a\x8d\x98\xa1\x11\xf3\x70\x1b\x9f\x12\x18\x98\x11\x38\x16\xfb\x3f\xba\x51\x7b\x42\xa3\xc6\x87\xa9\x06\xe2\xb9\x53\x67\xf9\xfe\x40\x64\x16\xce\xa3\xd3\x2d\x13\x73\x2d\xcf\x81\xf1\xf8\x04\xca\x24\xc2\x53\x0b\x60\xab\x96\x3d\x1d\xfc\x99\x38\x3b\xf8\xc3\xc8\x64\xd9\x8a\xa8\xf3\x1c\x21\xfe\x89\x46\x5f\x6f\x1b\x3a\x74\xba\xd8\xcc\xf9\xca\x52\x33\xc8\x12\x36\x16\xe3\x4a\x52\x72\x08\xc3\x55\xb5\x59\xfd\xbc\x91\x9e\xdd\xc0\xc2\x72\xbb\x87\xf7\xbe\xd5\x6e\xa7\x18\x8c\xb6\x55\x4a\x69\x9d\xcc\xa0\xd5\xc3\x5a\x86\x07\xf8\x1d\x3c\xb9\x35\xfe\xf1\x00\xbf\x96\xdc\xa4\xc5\x5a\xb5\xbf\x48\xa9\x4d\xac\x35\xc4\x4b\xcd\x27\xf1\x7a\x20\xfd\x48\x1b\x25\x0c\x21\x91\xf4\x89\x70\xab\x8d\xf8\xf4\xde\x61\xec\x17\xf2\xe7\x2b\xcb\xd6\x37\xd5\x7c\xaa\x4d\x34\x10\xfe\xa3\x41\x59\x5d\x08\x33\x80\xf9\xe8\xa7\xcb\xec\xd4\xf7\x75\x3a\x8d\xc8\x79\x9d\xd3\xd1\x6e\xe9\x73\x9b\xcc\x19\xdd\xca\xd9\x91\xef\x58\x57\xb2\xbb\x87\x36\xbf\x13\xd9\xa1\xe7\xe0\xae\xf8\xc9\xee\xe3\x28\xae\x03\x66\xb3\xab\xc5\x64\x30\x43\x76\x14\x32\x76\xa9\xc2\x47\x34\x54\x1e\xb2\x39\xaf\xdf\x47\x5f\x0f\x1c\x88\xa3\x1d\xc8\x76\x89\xbd\xf9\xc6\x48\xf7\x6b\x76\x5a\x81\xf5\x9d\x31\xfa\x08\x53\xca\x96\x07\xc4\xae\xa5\x55\xad\xef\xd7\xc5\xcf\x19\x3a\x1d\x75\x56\x2b\xf8\xf4\xcb\x5d\xe7\x1b\x94\x6d\x31\x1d\x5a\x6d\x3f\xd9\x3f\xff\x36\x57\x31\x53\x88\x06\x6d\x73\x89\x76\x7f\x79\xea\xcb\xbe\xf1\x83\xdc\x13\x90\x8e\x2e\x05\xd3\x1b\x02\x7b\xef\x44\x2e\xe1\xe1\x35\x97\xed\x5f\x2f\x5a\x36\x23\xef\xd4\x57\xc3\x26\xaf\xbf\xf6\x7f\x03\x00\xc8\x33\x72\xa8\x92\x5c\x00\x00"),
+		},
 		"/crds/healthchecks.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "healthchecks.yaml",
 			modTime:          time.Date(2020, 7, 31, 11, 8, 25, 954744060, time.UTC),
@@ -83,6 +111,13 @@ var HelmTemplates = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x3c\x5d\x73\xdb\x48\x72\xef\xfc\x15\x5d\xba\x07\xd9\x55\x24\x65\xef\xde\xa5\x72\x7a\x53\x64\xef\x9d\xb3\x5e\xdb\x65\xc9\x97\x4a\xc5\xa9\xd4\x10\x68\x10\x73\x02\x66\xb0\x33\x03\x49\xdc\x5f\x9f\xea\x9e\x19\x7c\x10\x1f\x84\x6c\xed\x26\x7a\x13\x49\x34\x7a\xfa\xfb\x73\x44\x25\xff\x81\xc6\x4a\xad\x2e\x41\x54\x12\x1f\x1d\x2a\xfa\xcf\x6e\xef\xfe\xd5\x6e\xa5\xbe\xb8\x7f\xbd\x43\x27\x5e\xaf\xee\xa4\x4a\x2f\xe1\xba\xb6\x4e\x97\x9f\xd1\xea\xda\x24\xf8\x06\x33\xa9\xa4\x93\x5a\xad\x4a\x74\x22\x15\x4e\x5c\xae\x00\x12\x83\x82\x3e\xbc\x95\x25\x5a\x27\xca\xea\x12\x54\x5d\x14\x2b\x00\x25\x4a\xbc\x84\x1c\x45\xe1\xf2\x24\xc7\xe4\xce\x6e\xef\xea\x52\x6c\xa5\x5e\xd9\x0a\x13\x7a\x78\x6f\x74\x5d\x5d\x42\xfc\xd8\x3f\x63\xe9\x1b\x00\x8f\xc3\xdf\xf9\xf1\x6b\x7a\x9c\x3f\xad\x8a\xda\x88\xa2\x0f\x76\x05\x60\x13\x5d\xe1\x25\x9c\x9d\xad\x00\xee\x45\x21\x53\xc6\xc9\x03\xd2\x15\xaa\xab\x4f\xef\xfe\xf1\xe3\x4d\x92\x63\x29\xfc\x87\x00\x29\xda\xc4\xc8\x8a\x7f\xd7\x7d\x0d\x48\x0b\x2e\x47\xf0\xbf\x86\x4c\x1b\xfe\xb7\xfb\x42\xb8\xfa\xf4\x2e\x40\xa9\x8c\xae\xd0\x38\x19\xb1\xa6\xbf\x0e\x99\x9b\xcf\x8e\xde\x77\x4e\x08\xf9\xdf\x40\x4a\x84\x45\xff\xd2\x7b\xff\x19\xa6\x60\xfd\xeb\x75\x06\x2e\x97\x16\x0c\x56\x06\x2d\x2a\xc7\x07\xeb\x80\x05\xfa\x89\x50\xa0\x77\xff\xc4\xc4\x6d\xe1\x06\x0d\x01\x01\x9b\xeb\xba\x48\x21\xd1\xea\x1e\x8d\x03\x83\x89\xde\x2b\xf9\x5b\x03\xd9\x82\xd3\xfc\xca\x42\x38\xb4\xae\x07\x51\x2a\x87\x46\x89\x82\x48\x59\xe3\x1a\x84\x4a\xa1\x14\x07\x30\x48\xef\x80\x5a\x75\xa0\xf1\x4f\xec\x16\x7e\xd1\x06\x41\xaa\x4c\x5f\x42\xee\x5c\x65\x2f\x2f\x2e\xf6\xd2\x45\xc1\x4a\x74\x59\xd6\x4a\xba\xc3\x45\xa2\x95\x33\x72\x57\x3b\x6d\xec\x45\x8a\xf7\x58\x5c\x88\x4a\x6e\x18\x4f\xe5\x58\x18\xcb\xf4\x4f\x26\x08\x9d\x3d\xef\x20\xe6\x0e\xc4\x63\xeb\x8c\x54\xfb\xe6\x63\x16\x93\x49\x32\xff\x2c\x55\x4a\x0c\x15\xe1\x31\x8f\x6e\x4b\x4d\xfa\x88\x88\xf0\xf9\xed\xcd\x2d\xc4\x97\x32\xc5\xfb\x24\x66\xe2\xb6\x8f\xd9\x96\xce\x44\x17\xa9\x32\x34\x9e\x4f\x99\xd1\x25\x43\x44\x95\x56\x5a\x2a\xc7\xff\x24\x85\x44\xd5\xa7\xb1\xad\x77\xa5\x74\xc4\xd8\x5f\x6b\xb4\x8e\xd8\xb1\x85\x6b\xa1\x94\x76\xb0\x43\xa8\xab\x54\x38\x4c\xb7\xf0\x4e\xc1\xb5\x28\xb1\xb8\x16\x16\x9f\x9b\xca\x44\x50\xbb\x21\x0a\x9e\xa6\x73\x57\xe7\xe3\xdf\x98\xf0\xb3\x02\xd0\x29\x58\x50\x8f\xbe\x00\x10\x69\xca\x36\x44\x14\x9f\x26\x1e\x9e\xc4\x60\x54\x8d\xda\x37\x31\x9b\x15\xd4\xca\x3a\x53\x27\xae\x36\x98\xc2\x1d\x1e\x02\xc7\x4b\x51\x81\x75\x9a\x3e\x7c\x90\x2e\x1f\xbc\x51\x74\xb9\x2f\x1c\xb3\x75\x87\x60\xd1\xc1\xee\x00\x64\x29\x59\x21\x9c\xd6\x05\x6b\x0e\xc3\x62\xc5\x30\xe8\x8c\xc4\x7b\x1c\x82\x34\x3b\xe9\x8c\x30\x87\x86\x76\x5b\xb8\xcd\xf1\x00\xc2\x20\x10\x9b\x7f\xad\xd1\x1c\xc4\xae\xf0\x70\x82\xc2\xee\x10\x58\xc8\xcc\x3d\xa6\x03\x90\x0f\x39\x2a\x28\x75\x2a\xb3\x03\x49\xae\x17\xcb\xa1\xf2\x5d\x5e\x5c\xdc\xd5\x3b\x34\x0a\x1d\xb2\x60\xa4\x3a\xb1\x17\xb5\x45\xb3\xd9\xd7\x32\xc5\x8b\x0e\x83\xce\x57\x63\xa4\xf7\x90\x7b\x5f\x25\x45\x6d\x1d\x9a\x0f\x64\xd5\xe7\x78\x72\x9b\x23\x9b\x71\x6f\xba\x30\x3e\x07\x0f\xb9\x4c\x72\xfe\x24\x68\xd3\x0e\x0b\xad\xf6\x5e\xf0\x6f\x8f\x35\x8e\xcd\x90\x85\xda\x62\x4a\xe4\x4e\xa5\x25\x5d\xad\xa5\xcd\x1b\x46\x59\xe6\x24\x58\x7a\x17\xbf\x90\xa8\xc8\x0e\xa4\x12\x09\x91\x03\x52\x99\x65\x68\x8e\x35\xaf\x73\x18\xeb\xdf\x0c\x99\xc4\x82\xed\x04\xb1\x85\x78\x2e\xd4\xe1\x21\x47\x83\x60\xe4\x3e\x77\xa0\xf4\x03\x43\x17\x95\x64\xce\x18\x18\x41\x77\xaf\xd9\x9a\x68\x90\x7b\xc5\xfc\x70\x20\x33\x86\x26\x95\x77\x93\x08\xda\x04\xcd\x8e\x7a\xbf\x5d\x2d\x94\xfc\xa1\x9f\x9d\x63\xc2\xd9\xf5\xf1\xcf\xbd\x15\x74\xcd\xbf\x03\x13\xe8\x0f\x36\x54\x45\x59\xa2\x97\x3b\xb6\x6f\x81\x77\x0f\xc2\x86\x23\x91\x89\x72\x91\x74\xfb\x5a\x18\xa1\x1c\x7a\xa6\x79\xfd\x19\xb2\x55\x41\x2e\xaa\x0a\x95\xdd\xec\x30\x23\x4a\x69\x93\xa2\x01\x91\x18\x6d\x2d\x58\xac\x84\x61\x5a\x55\x68\xbc\x8c\x6e\xe1\x9a\x0d\xa8\xb7\xb6\x4a\x0f\x61\x12\x95\x19\x3f\xd6\xf6\x88\x52\x73\x46\x4c\xe9\xad\x9f\x7f\xba\xfe\xf1\xc7\x1f\xff\x4a\x2e\xbd\x64\x76\x4a\x4b\x1f\x7f\xb9\xbd\xde\xc2\x57\x35\x80\xf9\x49\x57\x35\x39\xc7\x94\x2c\x00\x53\xe8\x60\x1d\x96\x5b\xf8\x8c\x22\xdd\x68\x55\x1c\xb6\xf0\xa1\x2e\x0a\x0e\x11\x0a\x69\xdd\xb3\x7b\xc1\x68\x37\xce\x8e\x70\xa3\x03\x08\x77\x09\x24\x48\x1b\x62\xd0\x52\x21\x4a\xb1\x40\x82\xfe\x37\x23\x12\xfc\x84\x46\xea\xf4\x06\x13\xad\x52\x3b\x2b\x4d\x1f\xea\x72\x87\x86\x14\xda\xfa\x5f\x83\x28\x0a\xfd\x80\x69\x88\x8e\x5a\xb9\x70\x1a\xf6\x04\x3b\xab\x8b\xe2\x30\x94\x25\x34\xa5\x54\xc4\xdb\xc0\x78\xe9\xe0\x41\x16\x05\x49\x8a\xc1\x52\xdf\x13\xc4\xe8\x40\x23\xb5\x3f\xaa\xe2\xc0\xfc\x25\x21\x1c\x80\x8c\x27\xea\xcb\x79\x61\x35\x3d\xb2\x85\x5f\xc4\x01\x88\x53\x2c\x8b\xb9\x36\x0e\x15\x49\x6c\xcb\xc1\x09\xca\x4a\xe5\xfe\xe5\xcf\xa3\x54\xa5\xd8\x68\x7f\xa4\x27\x03\x24\xe6\x75\xf3\xcd\x18\xce\x9f\x7f\xba\x06\x96\x4e\xb6\x0e\x24\x9d\xac\x79\xc2\x35\x86\x73\xc4\xe4\x34\x3e\x2b\x52\x91\x31\xa1\x13\xf6\xcd\x5a\x70\x63\xad\x9a\x7b\x8d\x16\x0d\xb3\x26\xe9\xea\xd5\x88\x4d\x55\xab\x08\xe4\x49\xd6\x51\x83\x48\xef\x53\x69\x30\x71\x9e\x4f\x8e\x3d\xda\xee\x30\xe2\x62\x7d\x18\xc4\x5e\xb0\x45\x5d\x5a\xc0\xc7\x0a\x13\xd7\x18\x8d\x70\x08\x78\xa1\x34\x90\x8b\x40\x03\xf7\xd2\xca\x5d\x31\xf4\xb1\x2c\x2d\x0d\x28\x56\x42\x8f\x18\x61\x65\x50\x24\x79\xc0\x86\x1d\xc3\x4b\x10\x99\x43\x1f\xcf\x33\x75\xe5\x50\xa0\x5c\x43\xb8\x35\x68\xc5\xe1\x00\x42\x26\x95\x28\xe4\x6f\x14\xef\xd1\x3b\x18\xe7\xb2\x72\x87\x2d\x5c\x59\x46\x11\x84\x3d\xfa\xe1\x00\x30\x3f\x48\x7a\x2f\x24\x05\x2b\x0e\x4b\xbb\xee\x91\x79\x57\xe8\xe4\x8e\x78\xf7\x31\xbe\x76\x20\x57\x63\x2e\xd2\xa2\x5b\x77\x6c\x5f\x34\x91\x1c\x44\x2a\x62\xbc\x36\x31\x92\xc9\x6a\xe3\x72\x72\x5e\x2a\xc4\xfe\x59\x4d\x71\xd2\x7a\xc8\xaa\xc2\xe5\xba\xde\xe7\xa4\xa0\x31\x12\x8a\xda\x03\x21\x21\x6a\xa8\x1e\x7e\x10\xb9\x56\x19\xa9\x47\xdc\x88\xf6\x38\x12\xd9\xb7\xf0\x93\x36\x80\x8f\xa2\xac\x0a\xca\x2e\x58\x9e\x42\x82\xc1\x92\xe6\x43\x30\x01\x95\x66\x09\x0b\x90\xc7\x1c\xc9\x8f\xaf\xa2\x49\xf2\x52\xf5\x73\xbd\xa3\x1f\x7b\x7d\x20\xfe\xb3\xdc\x5b\x54\x29\xb9\xb9\x56\xde\x1b\x53\x74\x9c\x4c\xb1\x33\x91\x7b\x1f\xeb\xf9\xf8\xc5\xb3\x8c\x78\x2f\x15\x7f\x52\xe9\x74\x0b\x57\x41\x92\x84\xeb\x20\xb1\xe6\xef\x03\x12\xc3\xe8\x8d\x90\x22\x5c\x40\x40\x2e\x4c\xda\x45\x22\xbe\xf4\xc5\xcd\xbb\xbf\xfd\xfc\xee\xfd\xfb\x97\x83\xd7\x93\x58\x0f\x19\xc5\x58\x24\x05\x0a\x55\x57\xeb\x60\x44\x23\x92\xad\x2d\xbd\xfa\xf4\x8e\x33\x09\xfe\x82\x5d\x62\xc2\xf1\x99\x42\xf7\xa0\xcd\xdd\x00\x6c\x25\x8c\xe3\x30\xdd\xae\x7b\xe6\x9d\x78\x64\x1d\x1d\x03\x1f\x49\x9c\xa3\x3a\x05\xc6\xb2\x8c\xae\xa1\x56\x4e\x0e\x2d\x8a\x50\x20\xd2\x52\x2a\x69\x9d\x11\x4e\x1b\x92\x23\x51\x3b\x5d\x0a\x2f\x35\x3a\x41\x6b\x21\x11\x94\x10\x7b\xc2\x60\x5f\xce\x46\xec\x1f\xbb\x99\xd6\xad\x50\x2c\x92\xc5\x18\x6e\xdd\x32\xbb\xd1\xb2\x10\x92\x86\xd3\xe4\x62\x08\xd1\x6b\x0e\xaa\xd6\xe8\x51\x6c\x30\x15\x0b\x1c\x9b\xd1\xe6\x4d\x63\x8a\xda\x81\xd8\x89\x20\xfe\x9f\x47\x0c\xad\x41\x9b\xf5\x69\xbf\xd4\x96\x2d\x0e\x5b\xc5\xe8\xdd\x3b\xa4\x6e\xb5\xb8\x15\x4a\x83\x7b\x92\x85\x81\x0f\x06\x78\x2b\x92\x1c\x50\x39\x73\x08\x49\x9d\x4c\xe9\x8c\x99\x44\xd3\xd4\x63\x0c\xda\x4a\x2b\xf6\x0a\x90\xe8\xb2\xd2\x0a\x55\x30\x1c\xa4\x67\x23\xae\xb2\x51\x0d\x0f\xb9\xc1\x83\x0c\x33\x0b\xce\xa8\xc9\xed\xcb\xcc\x18\x5f\x95\x56\x1b\x25\x8b\x35\xc3\x95\x18\xcc\x84\x0c\xae\x82\x04\x3a\x46\x20\x21\xc6\x39\x3e\x30\xfb\x82\x27\x25\xc1\xfe\x2b\x61\x8c\xe8\xbb\xd9\x3d\x2a\x8a\x99\xf1\x64\x92\x76\xf6\xb7\xce\x2f\x03\x91\x75\xe5\x13\x73\xb2\x10\x99\x7c\x5c\xfb\xe4\xab\x17\x36\xac\xc7\xec\x7a\x7c\x29\x19\x72\x25\x7f\xad\x43\x36\xf6\xf1\xc3\xfb\xff\x84\x77\x3f\xf1\xd3\xfc\x16\x1f\x8d\xe4\xc2\xb6\x4a\x56\x19\x7d\x2f\xd3\x21\x45\xc0\xb3\xa3\x1b\xc2\x10\x32\xde\xbc\x32\x74\x83\xae\x36\xca\x87\x0c\x6d\x85\xa5\x8d\x83\x26\x33\x3f\x97\x0b\xd5\x82\xa9\x84\xb5\x4d\xb8\xe4\xfd\x27\x83\xe0\x08\x72\xc7\x92\xb5\x93\x2a\x14\x0d\x9a\x03\x0e\x3d\x46\x9d\x65\xf2\xd1\xbb\xa0\x78\xa6\x00\x2e\x0f\x91\x01\xa7\xa9\x6d\x69\x12\x4c\x5d\xa0\x8d\x61\x03\xd1\x67\x68\xdc\x7c\x10\x12\x8b\x6f\x3b\x04\x67\x6a\x95\x74\xad\x50\x81\x6a\xef\xf2\x28\xa2\x1e\x0b\xb6\x33\xd2\x30\x69\x06\x30\x4b\x71\xe7\x75\xc0\x23\x17\xf8\xa5\x55\x87\xc7\x6c\xef\x06\xe4\xb7\x15\x26\xa4\x80\x23\x2e\x88\x42\xd5\x1c\x1b\x31\xf0\x39\xb8\x77\x10\xc1\x21\xc6\x98\x93\x28\xfb\xe1\xe3\x6d\x60\x1e\x08\xf8\xf3\xab\xbf\xc2\x66\xc4\xaf\x5b\x87\x22\x5d\x37\xe9\x01\x4a\x0e\x5b\xc2\x63\x3f\xbc\x7a\x0d\xd7\x3e\xf7\x24\x1f\xf2\x97\x57\xaf\x3c\x77\x3e\xa3\xb0\x5a\x85\xc2\x1c\xe9\xaf\xae\xc7\x92\xcf\x54\x26\xc2\xf9\x68\xa0\x2b\xae\x09\x57\x5f\x42\xe0\x94\xe9\x5a\xa5\xd1\xdd\xfb\x38\xbc\x28\xb4\x73\x98\xae\x27\xcf\x1f\x24\x30\x94\x71\x0c\x92\x8d\x79\x11\x75\xaa\x38\x0c\x43\x4f\x46\x84\x33\xd3\x11\x21\x45\xf8\x4c\x10\x36\x3e\xcc\xc8\x51\xa4\x68\x5e\x32\x6b\xae\xaa\xaa\x90\x74\x74\x32\x2a\x32\x83\xa8\xc1\xec\xf6\x22\x97\x86\x0a\xf5\xbc\x7e\x46\xa6\x58\x56\xda\xa1\x4a\x0e\x67\x4b\x5d\x49\x10\x90\xa3\xb2\xf8\xc0\x34\x5d\x81\x25\x47\x49\x11\x8a\xf2\x79\x67\xaf\x54\x21\xe2\x21\x93\x0e\x40\xd0\xd9\x28\x0d\x53\xb4\xac\x09\xd6\x09\x87\xdb\x25\x19\xfd\xb3\xe4\x83\xdc\x27\x59\xe2\x36\xcf\xae\x54\xf7\xc7\xbe\x46\xc3\x1c\xd0\x45\xd1\xd4\xcc\x50\x65\x9a\xeb\x5d\x56\x97\x11\xe7\x11\xc1\xbe\x17\x46\x0a\xe5\x28\x65\x0c\x5e\x37\xd6\x8c\x42\xd4\xdd\xcf\x09\x85\xf7\x4f\x3a\xeb\xa1\x3b\x66\x2f\x29\x52\xba\xf7\x25\xcb\x03\x3a\x10\x9c\xaa\xe9\x5e\x41\xc8\x07\x5e\xb2\x20\x85\xe4\x18\xa0\x17\x37\x0e\x80\x92\x51\x64\x07\x40\x9e\x9b\xc2\x02\x12\xe5\x06\x0b\x4a\x81\x48\xe1\x1f\xa4\xc5\xf5\x51\x14\x91\x90\xcf\x4f\xd1\x8c\x18\xa2\x5a\x75\x40\xc4\xec\x34\x97\x69\x8a\x0a\x5e\x48\xc5\xc7\xbd\x78\x10\x2e\xc9\xf9\xcb\x3d\x92\x73\x2e\x0a\xfb\xd2\x87\x02\x5e\x7f\x67\x08\xa0\xce\x1d\x65\xaa\x85\x4c\x24\xa5\xba\xc2\xde\x79\xf7\xa3\x77\x6c\xdf\x8e\xde\xdf\xd4\x66\x47\x2a\x4b\xff\xc1\x51\xa3\xea\x1e\xcb\xdb\xb3\x75\x2f\xb6\x24\xd3\x57\x05\x91\xed\x44\x14\xa3\xf5\x6b\xb6\x40\xb5\x31\x6c\x82\x70\xc0\xd6\x50\x46\xa9\x8c\xbc\x97\x05\xee\x31\xe5\x9c\xcb\xd7\xd3\x7c\x8e\x38\x74\x15\x5c\x66\x6e\xdf\x1b\xf2\x52\xd9\x66\xbf\xeb\x98\x1e\x06\xab\xc9\x4f\x90\x69\x0a\x79\xe6\x00\xe4\xee\x00\x42\x1d\xf8\xd5\x6c\xca\xde\xbc\xfd\xf4\xf9\xed\xf5\xd5\xed\xdb\x37\xb0\xe9\xa1\xcb\x25\x72\x4a\x18\x8a\x2a\x17\x41\x64\x89\x67\xa3\x91\x5d\xa7\x78\x24\x15\xdc\xbf\xde\xbe\xfe\xcb\xf6\xd8\x28\x55\x33\xcd\x86\xca\x67\x87\xc3\x2f\x8e\x94\xf5\x53\xc8\x22\x27\x75\x27\x74\x0e\x28\x14\xc6\x47\x4c\x6a\x87\x23\x20\x39\x6d\xf5\x05\xcf\x26\x4c\x6e\x13\x2c\x8a\x42\x7c\xa9\x63\xeb\xa5\xc4\x77\xe8\xac\x8b\x58\x4e\x40\xec\x99\x90\x40\x8d\x58\x08\x81\x4c\xc8\x82\x10\x37\x68\xeb\xc2\x75\x6a\x06\x38\xaf\xfa\x1c\xd9\x70\x33\xa5\x89\xab\xb8\xce\xaa\x59\xd3\xa3\xdf\x1b\xd3\x4d\x8a\x6b\x3a\xca\x30\x0a\x99\x9e\x0f\x67\x25\x90\xa2\x28\xa2\x0a\x6e\x47\x7e\x3f\x11\x23\x9f\xe2\x6d\x48\xea\x46\xc2\xe1\x09\x26\x77\x3b\x17\x31\x27\x65\xb6\x32\x5d\xdb\x94\x83\xd2\x90\xe6\x84\x53\x7c\xe9\x54\x94\x82\x99\x9c\xfc\xd9\x4c\xb0\x1f\xf3\x17\x1f\xd5\x4d\x9d\x63\xc3\xa8\xaf\xa6\x61\x4f\x98\xe2\xe9\x64\x22\x14\x18\xeb\xc2\x9d\x54\x8d\x77\x59\x5f\xb8\x7c\x40\x46\x34\xfc\x49\xc8\xa2\x36\x18\x83\xd9\x99\x4c\xaa\xa9\x90\xec\x10\x2a\x34\x56\xda\x50\x11\xb4\x4e\x1b\xb1\xc7\x28\x70\x2a\x66\x92\x94\x70\xd9\xda\xf8\xfe\x05\x39\xbd\x51\x9b\xe3\xbb\x3d\xbe\x7b\xc0\xb9\x58\xb0\xd6\xdd\x64\x6f\x8c\x2d\xa7\xa4\x6a\xbc\xc9\x3f\xd3\xa9\x7c\x5a\xc3\x7f\x52\x50\xfa\x83\x00\x4f\x6d\xfe\x4f\x82\x1d\x1d\x0a\x78\xca\x20\xc0\x24\xe4\x3f\x70\x40\xe0\x49\x0a\x95\xe8\x14\x17\xb1\xee\xa6\xde\xef\x7d\xf9\xfb\xef\xb7\xb7\x9f\x62\x16\x42\x8f\xb7\xed\x0f\x0a\x30\x6b\xbb\x86\x57\x20\xb3\x49\x4a\x84\xc2\xd4\x94\x11\xe8\xc4\x9a\x3f\xfe\x30\x7b\xaa\xb1\x98\xb3\x45\xdd\x09\x59\xd8\x45\x27\x7b\xfb\xe8\x50\x51\xaa\x9a\x0a\x27\x40\x58\xab\x13\xc9\xe1\x71\xa3\xbe\x86\x73\xaa\xad\x2f\xc9\xcc\xc8\x24\x67\x5e\x24\x19\x5e\xb6\x41\x3a\x0b\xfa\x41\x71\xe3\xdc\xbf\xc1\xa3\x75\x14\x84\x4e\x42\x6c\x6a\x11\xd1\xcb\x30\x86\x4d\xd2\x3f\xda\x6e\x4c\x34\xc5\xc9\xe5\xb4\x89\xd5\x1c\x7d\x04\x3d\xc3\xc7\x04\xab\x50\x30\xf2\x48\x37\x59\x41\x38\x0e\xd1\x7a\x8a\x57\xa7\x7d\x0e\x40\x22\x6a\x3b\xf7\xfd\x48\xdf\xfc\x9a\x1f\xf1\xb6\x18\xa4\x4a\x8a\x3a\x45\x0b\x25\x69\x4e\x20\x60\x87\x4b\x33\x80\xa1\xe5\xe0\x0d\x4b\x66\xc8\x8d\x33\x6f\x8d\xb7\xf0\x41\x3b\xf6\xb8\xdd\x6f\x39\x1a\x9c\x05\x1a\x4a\x1b\x01\x17\x4c\xc3\x11\xb7\x33\x0f\xcd\xf8\xed\xa7\xd0\xb2\x53\x11\x39\xf5\xa3\xe3\x14\xeb\x36\x8f\xa5\xa7\xe0\xd6\xfb\x83\x1e\x94\x8a\xf0\x31\xd2\x93\x70\x83\x2b\x47\x63\xb4\x59\x53\x88\x43\x1e\x97\xa5\x86\xc4\xfd\xdf\x6f\x3e\x7e\x00\x8b\x86\x23\x02\x31\xe5\x56\x06\x69\x79\xcb\x68\x48\x89\x29\x2a\x85\x4a\x5b\x97\xc9\x47\x88\x33\x1a\x6c\x66\x14\x9b\xa0\x05\x10\x85\xf3\xe6\x93\x6c\xee\x15\x09\x92\x8f\xa6\x7f\x43\xa3\x37\x52\xa5\xf8\x48\xf9\x15\xfc\x44\x14\x39\xcd\xf1\xe8\xeb\x2a\x14\xc6\xcb\x21\xd7\xcf\xb8\x31\x26\x39\x87\xf1\xb2\xaa\xb3\x20\x0b\x90\xd6\xb8\x84\x90\xda\xf3\xc4\x52\x66\x45\x1e\xbc\xac\x0b\x27\xab\x02\x3d\x75\x29\x5f\x09\x16\x80\x13\x85\xb7\xbe\x57\x64\x2f\x17\x80\xfe\x0a\xf0\xf5\x8c\x38\xf3\xf5\x0c\x36\xa1\x29\x47\xdc\x6f\x3e\x0c\xd5\xae\x90\x2d\x2d\x80\xd8\x08\x0c\x41\x66\x81\xfe\xaf\x57\xff\xbd\x9d\x79\xc5\x02\x98\x01\x89\x4c\x1a\xeb\x02\x0d\x43\xc1\x5b\xc5\x97\x7c\x3d\x3b\x0d\xe8\xa4\x97\xeb\xe4\x73\x68\xad\xd8\xe3\x13\xd5\xe7\x0a\xf2\xba\x14\x6a\x63\x50\xa4\xdc\x4a\xed\x7c\xdb\x4c\xf8\x10\xe7\x97\x9c\xd9\xff\x9c\x39\xbc\x85\xae\x27\x08\xf5\xcd\x76\x5a\x43\xd8\xcd\x8c\x77\xe8\xdb\x74\xb2\xd7\x29\x9a\xed\x73\x12\xcb\xbb\x80\x27\xd3\xaa\x14\x49\x2e\x15\xce\x51\x6b\xc1\xa1\x98\x9e\x47\xd4\x8a\x05\x59\x5f\xb7\x8d\x19\x38\xfd\xc2\x2c\x01\xc9\x0e\x93\xa3\x2f\x8a\x31\x08\x1b\x71\x2f\x64\x41\x38\x3e\x23\xdd\x4e\x24\x1a\x4b\x12\x8e\xa6\x58\xc8\x93\xc1\x4f\xf1\x9d\xfc\x44\x6b\xfd\x06\xd6\xfe\xa9\x8e\xd3\x87\x74\x3d\x0f\xb9\x5d\x7d\x27\x91\x8e\x87\x55\xe7\x53\x06\x3a\x15\x3d\xf1\x3b\x1f\x0a\x3e\x2a\x5f\x59\x6c\x07\xae\x7c\x28\xc7\x3d\x94\x59\xb8\x9d\x5e\x5e\x1c\x11\x69\x50\xfb\x59\xaa\xf4\x0f\x1a\x58\xfd\x26\x5e\xcc\x17\x05\xa6\x86\x1a\x7f\x57\x56\xc0\x8b\x30\x68\x87\x06\xc3\xd4\xb2\x54\xfb\x02\xa7\x53\xfb\x76\x30\x54\x26\x39\xe7\xb7\xbb\x68\x74\x76\x98\xbe\xfc\x6e\x81\xe5\x36\x06\xf7\x20\x26\xe6\xc4\xe6\x4a\x02\x4d\x37\x62\xdd\x6d\x7b\x34\x33\x64\x6d\x97\x78\xf6\x68\x8d\x54\x76\x26\x64\xfd\xcc\x6d\xba\x85\x1b\x92\x5b\x1f\x32\x84\x49\x6c\xdf\x55\x99\x37\x53\x6d\xb7\x86\x8b\x75\x4e\xdc\x85\x6a\x23\x67\xbb\x0e\x41\x24\xfc\xc2\x4d\x48\xf0\xb4\x8d\x2f\x59\x9d\x8c\x0e\x5b\x87\x16\x71\x81\x5c\x3f\xf8\x21\x21\xa7\xe1\x41\x48\xd7\x9c\x5c\xdc\x9d\xb4\xa8\x39\x0e\xd0\x9a\x63\xea\x92\x1c\x72\x59\x1e\xc9\x15\x75\xf9\x04\x6b\xf5\xe5\xdd\x9b\x63\x9d\xd8\x4e\x09\xf4\x6a\x51\xb8\x35\x25\xd4\x4f\x1e\x77\x6e\xc7\x07\xec\x9f\x6a\xf9\xdd\xb6\xe3\xa4\x9b\x9b\x33\xf3\xcf\xb0\x9f\xb0\x5a\x50\x69\xfc\xa6\x5d\x85\xd5\x02\x8d\xf9\xa6\xbd\x85\x49\xc0\x7f\xb8\x7b\x38\xc9\xde\x13\x61\xf2\x93\x83\xe3\x60\xe6\x4f\x95\xf5\x1a\x2b\xb7\xfd\x76\xc4\x87\x0b\x1a\xd3\x82\x77\xe3\x84\x4a\x85\x49\x7d\x23\xa3\x5d\x50\xf8\xc3\x19\xb2\xa8\x92\xa2\x49\x13\xea\xe5\xee\x3a\x3e\xd0\x5d\xe3\x90\x59\x33\xbb\xea\x47\xfc\xa1\x90\xa5\x9c\xcf\xff\x42\x96\xa6\x9a\xf9\x67\x4e\xcc\x9a\x3a\x54\x98\x81\x0d\x76\x3e\x34\x0a\x4e\xf9\xb3\x30\x0c\x91\x8b\x58\xd8\xe1\xda\x5b\x13\x8d\x73\xa8\xd1\x44\xf9\xba\x12\xbf\xd6\x38\x3a\xfa\xd7\x1f\xeb\xe0\x63\xc6\x6d\x09\x69\x2d\x3f\xa4\xc3\xd8\x44\x18\xaa\xd4\xc7\x8b\x49\xc2\x9d\xc6\x34\xed\x74\x1e\x9d\x6e\xb6\x5d\x3c\x5d\xf0\xb1\xe9\x36\x36\x27\x98\x27\x68\xec\x8a\x5e\x7b\x0e\xf9\x8e\x3e\x37\x8e\xac\x23\xf3\xe2\xc5\xb1\xed\x29\x56\xda\x8e\x4f\xfe\xf6\xb2\x9b\xac\x3b\x66\x92\x68\x95\xc9\x7d\x1d\x82\x06\xae\xef\xe4\x42\xed\xfd\xb4\x48\x5b\xc3\x10\xf3\x91\x2d\x3e\x40\x29\x55\x4d\x6c\xe5\xee\x77\x3b\x29\xd4\xfa\xb7\x58\xd0\xf7\x3e\x3f\x4a\xc5\x89\x40\x0d\x15\xd4\xd6\xdb\x75\xdf\x33\xf3\x92\xda\x19\x3e\xda\x61\x18\x78\x4b\x9a\x29\xd4\x59\x98\x41\x5a\xba\x15\x85\xd0\xaa\xc2\x35\xd4\xaa\x40\x6b\xe1\xa0\x6b\x7f\x0e\x83\x09\xca\xfb\x13\x58\xfa\x89\x4e\x7d\x87\xca\x3b\x09\xa1\x7c\xfc\x13\xad\xe3\x33\xc4\x95\x3d\x0a\x2e\x8f\x32\x6e\x5c\xdb\xf0\x69\xdc\xba\xed\xb0\xff\xfc\xdc\x36\x6d\x8b\x79\xaa\x05\xe6\xc5\x0d\xcb\xb8\xc1\x40\x90\x43\xcc\x11\x07\xe0\x62\xff\x68\x64\xa0\xea\xa8\xf4\x1e\xe6\x56\x99\xcb\x41\xd6\x3d\xd9\x83\x08\x6e\xe1\x1f\x7e\x48\x3b\xcc\x4b\x3a\xdf\xf7\x9f\x05\x2b\x1a\x33\xd0\x41\x85\xeb\x84\x2c\x92\x50\xab\xa6\xf1\xbe\x13\xc9\xdd\x12\x89\x89\x93\x5e\x4b\x56\x5c\x5a\x8f\x30\x0b\xf2\x19\xbc\x45\xa2\x95\x2f\xca\x25\x87\x4d\x18\x82\xd9\x08\x95\x6e\x1a\xf3\x90\x1c\xbe\x3b\xeb\xb3\x58\x64\xef\xa5\xba\x5b\x2c\x71\xf1\x01\x1f\xa5\x7d\xf9\xfc\xfe\x38\x38\x5b\xd0\xdc\x5d\xb8\x4d\xf4\x3b\x47\xa5\xf3\x35\xad\x27\x56\xb2\x1e\xf2\x30\x1a\xd2\x04\x2e\xab\x99\xda\x53\xb0\x4d\x67\xa1\x1b\x7c\x16\xa2\xa2\xf9\xb2\xd6\x5c\x7f\x68\xb2\x98\x05\x57\x71\x0e\x30\x29\x84\xf1\xc6\x41\x28\xdf\xb9\xf3\x2f\x9d\x89\x32\x52\x84\x5d\xed\x20\xd5\xe8\xfb\x4b\xfa\x1e\x8d\x91\x29\x82\x74\xdf\x1c\x96\xf9\x97\x2e\x0e\xca\x9a\x58\xb1\x53\x8e\xd9\xc2\x47\x85\xa0\xb3\x4b\x38\xbb\xa9\x93\x04\xad\x3d\x1b\x1b\xd8\x89\x7f\x0d\x95\x9f\x3b\x9a\xa3\x7c\x9e\x15\xd2\x9f\xe9\x1b\x43\xec\x19\x39\x9d\x9e\x71\xd8\x4c\xcc\xbf\x4c\x02\x2b\xc4\x0e\x8b\xdf\x7b\xfb\xf8\x17\xc1\xe3\xe1\x21\x75\xbb\xc3\x83\xb7\xcb\xbe\xe3\x3d\xf4\x24\x4e\x83\x36\x7b\xa1\xe4\x6f\x38\x3a\x45\x4a\x41\xe4\x5e\x1b\xf9\x1b\xc2\x0b\xbe\xd0\xc0\xef\x05\x63\x81\x89\x7b\xd9\x59\xf6\x15\x07\x28\x79\x8c\xcd\x7f\xa5\x8d\x1d\x9b\x7f\x34\x58\x15\x3c\xea\x4a\xba\xd0\x8c\x14\xda\x00\xd3\xdc\xcb\x04\xbf\x61\x73\xd8\xd3\x75\xf1\xd2\x70\x29\x94\xd8\x63\xea\xbb\x4d\xf3\xa3\x90\xbf\x74\x7f\x0a\xa5\xa8\x2c\x3c\x68\x73\x97\x15\xfa\x61\x23\xfd\xf8\x57\x74\xd9\x21\x92\x1d\x5b\x2e\xd5\x59\x6c\x2c\xf9\x1d\x22\x83\x11\x07\x6f\x77\x85\x6b\xa0\x86\x5e\xb4\xa4\x38\xdc\xba\xe2\x10\x66\x7a\x26\x42\x87\x5c\xd7\x16\xef\x10\x2b\xa9\xf6\x3e\xee\xf7\x13\x74\xee\x50\x51\x9c\x56\x1c\x42\x79\x4a\x9d\x3b\x50\xa1\x23\x1d\xb6\xaf\x6a\x95\xa2\xb1\x6e\x2c\x88\x6f\x4b\x46\x64\xb9\x22\x66\x51\x6a\x62\xbe\x72\xee\x5b\x8d\xeb\xde\x70\x68\xfc\x70\x48\x02\xd3\xce\xb7\x53\x60\xde\x0e\xcc\x8a\xaa\x2a\x0e\x50\x09\x97\x43\x21\xef\x10\xbe\x9e\x25\x72\x93\xa4\x5f\xcf\x7c\x58\x1b\x22\x79\x4f\xbf\xb1\x4d\x07\x51\x3c\x88\x43\x63\xcd\x1b\x6e\x84\xac\xa7\x45\x9f\xa5\xfd\x68\x57\x7d\x2c\x24\x89\x63\x2b\x5f\xd5\xf1\x6c\x2a\xcf\xfd\x79\x9d\x60\x4a\x74\x22\xf8\x38\xeb\xf7\x20\x5d\x3e\x36\xe1\xad\xb4\x93\x09\x0e\x26\x00\x27\x1a\xd1\xf3\xe9\xe7\xa9\x21\x9f\xbe\xd3\x9c\x9d\xf0\xe9\xdc\xe4\xd1\x69\x3f\xaf\x4e\x56\x12\x39\x55\xe5\x91\xef\xb8\x29\x8f\xa1\xca\x47\x84\x3a\xe3\xae\xc7\x45\x78\xc7\x19\xfc\xb3\xb6\x53\x30\x99\xe3\x5c\x87\xd5\xd5\xa6\x20\x1b\xdf\xc5\x38\xc8\x60\x58\xe5\x46\x72\x32\xc2\x1c\x58\xd3\x8c\x48\xee\x56\xd3\x75\xa1\xce\xf9\x44\x07\xe7\x1d\xfa\x36\x96\x64\x1b\x18\xb2\xb9\xb0\xef\xe5\x15\x66\x35\x99\xec\xdf\xa3\x71\x63\x33\xec\x0b\xbc\x4b\x36\x6a\x69\x66\xac\x3f\x38\x33\xd1\xb1\xee\x31\x37\x98\xa5\x4e\xca\x21\xfa\xfa\xb2\xfd\x96\xd1\x3b\x6f\x9a\xcc\x02\xe1\xf2\xd6\xd1\x0c\xf7\xa1\x42\xb0\xd0\xe8\x1e\x83\x9c\x89\x12\x73\xb4\xb8\x00\xe5\x49\x02\x37\x51\xc9\x02\xa4\x3f\x36\xa5\xfb\x70\xa7\x0e\xc1\x26\x8c\xdb\x9a\xbe\xaf\xf1\x16\x28\xd2\xe9\xec\x8a\xb5\xa1\xe7\x1e\xde\x72\xab\x7c\x87\x64\x58\x9a\x6b\x08\x48\x33\x78\x29\x82\xb7\x6c\x82\x17\x9e\x9e\xb5\xea\x2a\x19\xb9\x89\xf3\x2b\xb2\x8e\xe7\x6c\x75\xce\xbf\x70\x19\xf3\xfc\x9b\x28\xe4\xe4\x54\x63\xa9\xdf\x52\x92\x7e\x6f\xc3\x75\x37\xcd\x62\xb9\xbc\xe1\x11\x3c\x50\x24\x3c\x33\x35\xf6\xae\x59\x39\x09\xd6\xb9\xd9\xc2\x93\x59\x9f\x01\xe1\x80\xab\xb9\xbe\xc1\xd4\x7e\xe0\x82\x83\xcf\x88\xfa\x54\xc3\x57\x9d\x5a\x53\x3b\xe7\xe5\x96\x98\x2c\x87\x75\x1d\x32\xfc\x52\x81\x68\xef\xfa\xd8\xc2\x3b\xdb\xae\x3d\x8d\xde\x13\xe0\x57\x21\xfc\x10\xb4\x1f\x1c\x5c\xb7\x5b\xce\xdc\xfd\x6c\xaf\x15\x29\xc5\xc1\x5f\x70\xd0\xac\xac\x8f\xc9\x66\xbb\xab\x8c\xfd\x4d\x14\x6e\x25\x55\xe4\x58\x8c\x14\x2e\xf6\x0d\xbb\x96\x6f\x3b\xbe\xf0\x25\x2d\x54\x46\x96\xc2\x48\x5e\x87\x08\x93\x73\x24\xaa\xcd\x22\x47\xbb\x77\xe3\x83\xc3\x7e\xad\x2b\x6d\xae\xe8\x1a\x4a\xcb\x48\x89\xfe\x7b\xda\x28\x4c\xfb\xf3\xa5\xab\x3f\x0d\xa7\xe6\x43\xc0\x0f\xcd\xe5\x2d\x5d\x07\xea\x3f\x09\x5c\x47\x91\xe4\x9e\xa2\x7d\xa9\x18\x1e\xf8\x4a\x05\x3d\xe8\x5c\x09\x63\x81\x84\xe4\x5e\x14\x9e\xa7\x0c\xfe\xeb\x59\x8a\x99\xa8\x0b\xf7\xf5\xac\xfd\xe9\x9a\x12\xc1\x01\xc8\xee\x4f\x83\x45\x4b\x84\xd2\x8a\x0b\x75\xfd\xc1\xdc\x76\xc4\x2e\x96\x81\xc8\xc6\x44\x19\x1d\x5b\xa3\xdc\xa1\xbf\xc5\x2c\xf5\x43\x2d\x2d\xd6\x9b\xce\xc2\x9e\xee\xed\xe5\xb5\xdd\xc9\xf0\x92\xd5\xd4\x40\x75\xb8\xad\xe0\xab\x6a\x36\x75\x05\xbc\xf9\x70\xf3\x3f\xef\xaf\xfe\xed\xed\xfb\xed\xbc\x70\xac\xc6\x4a\x3d\x27\x85\xa5\xc1\xdf\x2e\x5e\x10\xd3\x0f\x0a\xcd\x67\xe4\xc5\xcd\x04\xe7\xd3\x85\xf7\x61\xff\x22\x52\x37\xc5\xca\xab\xcb\xee\x30\xd8\x4b\xba\x7a\xff\x7e\x92\x40\x21\x96\xe5\xb2\x33\x17\xea\x78\x2d\xa9\x99\x30\xef\xdd\x79\x13\x68\xb9\x17\x66\x27\xf6\x08\x09\x85\xe1\x89\x9b\xdb\x5e\x6d\x77\x23\x3a\x49\x48\x37\x88\x5f\xfb\x89\x76\xa1\xda\xe9\xaf\xa6\xdc\x3e\xce\xcc\x50\xbb\xd7\x6d\xf9\x38\x42\x6a\x26\x0b\x3a\x0b\x64\x6d\x3c\xc6\x91\xdc\x98\x9e\xdc\x72\xad\xa5\x8d\xd1\xba\x53\x7e\xd8\x84\x13\x1d\xa0\xdb\xff\x8b\xc8\xba\x1f\x46\x93\x26\xf9\xfd\xde\x6f\xf2\xd0\x7c\xd3\xc6\x47\x92\xb6\x78\x15\xcb\x02\x24\x88\xa7\xa6\xc6\x35\x5c\x7d\x78\x13\x3b\x0e\x2c\xb1\xcd\x8a\xef\x59\xa6\x0d\x52\x40\xae\xd2\x08\x77\x6a\x82\xaf\x59\xab\x0f\x02\xd0\x02\x6b\x19\x31\x58\x98\xbf\xc3\xc3\x86\xcd\xc0\x04\x50\x7f\x27\x19\xdf\xbe\x10\x53\x8d\xa0\x4b\x9d\xad\xa0\x2d\xbc\xf1\x36\x8c\x67\xfd\x33\x51\x58\xdc\xc2\xed\x54\xe8\xd5\xdc\xab\x14\x97\x91\x7d\xff\x8c\x12\x5c\x0b\x67\x1e\xc3\x33\xa8\xd0\x94\xd2\x76\xd9\xc3\x67\x59\x4f\x00\xd5\x71\xb9\x0f\xfe\xfc\xc3\x0f\xf0\xe2\x8b\x0a\x8b\x36\x5c\x67\x7c\xab\x9c\x74\x87\x97\x9d\x7b\x81\x7c\x57\x65\x8e\xd1\x3b\xad\x0b\x14\x63\x15\xc8\x56\x6a\x9f\xc2\xe1\x23\xe2\xb1\xca\x35\xab\x11\x0b\x34\x62\x19\x6e\xd3\x53\x02\x23\x33\x02\xc7\x62\xff\x47\x37\x6a\x4f\x68\xd4\xf4\x30\xd5\x48\x3c\x77\xea\x2c\xdf\x1f\x88\x2c\xc2\x79\x72\xba\x65\x66\xae\xe5\x39\x30\x9e\x9e\x40\x99\x45\x78\x6e\x01\x6c\xd3\xb1\xa7\xa3\x5f\x13\x67\x47\xbf\x98\x98\x2c\xdb\x10\x75\x9e\x23\xc4\x3f\xd1\xe8\x1b\x6c\x43\x87\x4e\x97\x8f\x76\xb8\xb2\xd4\x0e\xb2\x84\x8d\xc5\xb8\x92\xd4\x38\x84\xd5\xdc\x1c\xcc\x7c\x3f\x6f\xa2\x67\x37\xb2\xb0\xdc\xed\xe1\xfd\xd2\x69\xb7\x53\x0c\xa6\x2b\x27\x4b\x69\x9d\x4c\xa0\xd3\xc3\x5a\x87\x07\xf8\x1d\x3c\xb9\x35\x7d\x79\x80\x5f\x4b\x6e\xd3\x62\xad\xba\x37\x52\x6a\x13\x6b\x0d\x4d\x92\xd2\x5c\x89\x37\xdc\xdb\xe5\x91\x36\x4a\x18\x42\x22\x19\xca\xd1\xa2\x3b\x4d\xf0\xc4\xde\x61\xec\x17\xf2\xf5\x95\x65\xe7\x4e\x35\x9f\x6a\x13\x0d\x84\xbf\x34\x28\xa9\x0b\x61\x46\x30\x9f\xbc\xba\xcc\xce\xdd\xaf\xd3\x6b\x44\x2e\xeb\x9c\x4e\x76\x4b\x9f\xdb\x64\x2e\xe8\x56\x2e\x8e\x7c\xa7\xba\x92\xfd\x3d\xb4\xe5\x9d\xc8\x1e\x3d\x47\x77\xc5\x4f\x76\x1f\x27\x71\x1d\x31\x9b\x7d\x2d\x26\x83\x19\xb2\xa3\x90\xb1\x4b\x15\x2e\xd1\x50\x69\xc8\xe6\xbc\x7e\x1f\xdd\x1e\x38\x12\x47\x73\xec\xdc\x96\xd8\xdb\x3b\x46\xfa\xb7\xd9\x69\x05\xd6\x77\xc6\xb2\xba\x68\xb3\xe5\x11\xb1\xeb\x68\x55\xe7\xfe\xba\x78\x9d\xa1\xd3\x51\x67\xb5\x82\x4f\x5f\x6e\x7b\x77\x50\x76\xc5\x74\x6c\xb5\xfd\x64\xff\xfc\xdb\x5c\xc5\x42\x21\x1a\xb5\xcd\x25\xda\xfc\xf2\xd4\xcd\xbe\xf1\x32\xee\x19\x48\x47\x1f\x05\xd3\x1b\x02\xfb\x4d\xb8\xe9\xfb\xfe\x35\x97\xed\x5f\xaf\xba\xd3\x43\xbd\xfa\x6a\xd8\xe4\xf5\x9f\xfd\x6f\x00\x00\x00\xff\xff\x04\x8f\x7a\x2b\x8d\x5c\x00\x00"),
 		},
+		"/crds/mesh-insight.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "mesh-insight.yaml",
+			modTime:          time.Date(2026, 10, 19, 6, 11, 10, 799556100, time.UTC),
+			uncompressedSize: 23755,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x3c\x6b\x73\xdb\xc8\x91\xdf\xf9\x2b\xba\x94\x0f\xb2\xab\x48\xca\xde\x4d\xae\x2e\xfa\xa6\x93\xbd\x39\x5d\xfc\x2a\x4b\xbb\x57\x57\x71\xea\x6a\x08\x34\xc9\x89\x80\x19\x64\x66\x20\x99\xfb\xeb\xaf\xba\xe7\x01\x80\x78\x10\xb2\xb5\x9b\xf3\x27\x0b\x04\x1a\x3d\xfd\x7e\x42\x54\xf2\x17\x34\x56\x6a\x75\x09\xa2\x92\xf8\xd5\xa1\xa2\xbf\xec\xfa\xfe\xdf\xed\x5a\xea\x8b\x87\xd7\x1b\x74\xe2\xf5\xe2\x5e\xaa\xfc\x12\xae\x6b\xeb\x74\xf9\x19\xad\xae\x4d\x86\x6f\x70\x2b\x95\x74\x52\xab\x45\x89\x4e\xe4\xc2\x89\xcb\x05\x40\x66\x50\xd0\xc5\x3b\x59\xa2\x75\xa2\xac\x2e\x41\xd5\x45\xb1\x00\x50\xa2\xc4\x4b\x28\xd1\xee\xa5\xb2\x72\xb7\x77\x76\x7d\x5f\x97\x62\x2d\xf5\xc2\x56\x98\xd1\xc3\x3b\xa3\xeb\xea\x12\xe2\x65\xff\x8c\xa5\x5f\x00\x3c\x0e\xef\xd1\xee\x6f\xfc\xe3\x0b\x00\x80\xaa\xa8\x8d\x28\xba\x60\x17\x00\x36\xd3\x15\x5e\xc2\x75\x51\x5b\x87\x66\x01\xf0\x20\x0a\x99\x33\x62\x1e\x9a\xae\x50\x5d\x7d\xba\xf9\xe5\xc7\xdb\x6c\x8f\xa5\xf0\x17\x01\x72\xb4\x99\x91\x15\xdf\xd7\x7e\x17\x48\x0b\x6e\x8f\xe0\xef\x86\xad\x36\xfc\x27\xbd\x15\xe2\x6b\xe1\xea\xd3\xcd\x02\x00\x00\xa0\x32\xba\x42\xe3\x64\xc4\x1d\x00\xa0\x45\xec\x74\xed\xe8\x85\xe7\x84\x91\xbf\x07\x72\x22\x2f\xfa\xb7\x3e\xf8\x6b\x98\x83\xf5\xef\xd7\x5b\x70\x7b\x69\xc1\x60\x65\xd0\xa2\x72\x7c\xb2\x16\x58\xa0\x5b\x84\x02\xbd\xf9\x07\x66\x6e\x0d\xb7\x68\x08\x08\xd8\xbd\xae\x8b\x1c\x32\xad\x1e\xd0\x38\x30\x98\xe9\x9d\x92\xbf\x26\xc8\x16\x9c\xe6\x57\x16\xc2\xa1\x75\x1d\x88\x52\x39\x34\x4a\x14\x44\xcb\x1a\x97\x20\x54\x0e\xa5\x38\x80\x41\x7a\x07\xd4\xaa\x05\x8d\x6f\xb1\x6b\x78\xaf\x0d\x82\x54\x5b\x7d\x09\x7b\xe7\x2a\x7b\x79\x71\xb1\x93\x2e\x8a\x57\xa6\xcb\xb2\x56\xd2\x1d\x2e\x32\xad\x9c\x91\x9b\xda\x69\x63\x2f\x72\x7c\xc0\xe2\x42\x54\x72\xc5\x78\x2a\xc7\x22\x59\xe6\x7f\x30\x41\xf4\xec\x79\x0b\x31\x77\x20\x4e\x5b\x67\xa4\xda\xa5\xcb\x2c\x2c\xa3\x64\xfe\xab\x54\x39\x48\x0b\x22\x3c\xe6\xd1\x6d\xa8\x49\x97\x88\x08\x9f\xdf\xde\xde\x41\x7c\x29\x53\xbc\x4b\x62\x26\x6e\xf3\x98\x6d\xe8\x4c\x74\x91\x6a\x8b\x86\x9f\x82\xad\xd1\x25\x43\x44\x95\x57\x5a\x2a\xc7\x7f\x64\x85\x44\xd5\xa5\xb1\xad\x37\xa5\x74\xc4\xd8\x7f\xd6\x68\x1d\xb1\x63\x0d\xd7\x42\x29\xed\x60\x83\x50\x57\xb9\x70\x98\xaf\xe1\x46\xc1\xb5\x28\xb1\xb8\x16\x16\x9f\x9b\xca\x44\x50\xbb\x22\x0a\x9e\xa6\x73\x5b\xf3\x01\xc6\x85\x1f\x00\x80\x4f\xc1\x82\x7a\xf4\x03\x80\xc8\x73\xb6\x24\xa2\xf8\x34\xf2\xf0\x28\x06\x83\x6a\xd4\xbc\x89\xd9\xac\xa0\x56\xd6\x99\x3a\x73\xb5\xc1\x1c\xee\xf1\x10\x38\x5e\x8a\x0a\xac\xd3\x74\xf1\x51\xba\x7d\xef\x8d\xa2\xcd\x7d\xe1\x98\xad\x1b\x04\x8b\x0e\x36\x07\xc0\xaf\x41\x21\x9c\xd6\x05\xb1\xca\xc3\x62\xc5\x30\xe8\x8c\xc4\x07\xec\x83\x34\x1b\xe9\x8c\x30\x87\x44\xbb\x35\xdc\xed\xf1\x00\xc2\x20\x10\x9b\xff\x59\xa3\x39\x88\x4d\xe1\xe1\x04\x85\xdd\x20\xb0\x90\x99\x07\xcc\x7b\x20\x1f\xf7\xa8\xa0\xd4\xb9\xdc\x1e\x48\x72\xbd\x58\xf6\x95\xef\xf2\xe2\xe2\xbe\xde\xa0\x51\xe8\x90\x05\x23\xd7\x99\xbd\xa8\x2d\x9a\xd5\xae\x96\x39\x5e\xb4\x18\x74\xbe\x18\x22\xbd\x87\xdc\xf9\x29\xf3\x16\xf6\x03\xd9\xf6\x29\x9e\xdc\xed\x91\x8d\xb9\x37\x5d\x18\x9f\x83\xc7\xbd\xcc\xf6\x7c\xc5\x03\x87\x0d\x16\x5a\xed\xbc\xe0\xdf\x1d\x6b\x1c\x00\x80\xb4\x50\x5b\xcc\xc1\x69\xc8\xa5\x25\x5d\xad\xa5\xdd\x27\x46\x59\xe6\x24\x58\x51\x86\x17\x12\x15\xe9\x3f\xb6\x12\x19\x91\x03\x72\xb9\xdd\xa2\x39\xd6\xbc\xd6\x61\xac\x7f\x33\x6c\x25\x16\x6c\x27\x88\x2d\x16\x1d\x08\x75\x78\xdc\xa3\x41\x30\xec\x13\x94\x7e\x64\xe8\xa2\x92\xcc\x19\x03\x03\xe8\xee\x34\x5b\x13\x0d\x72\xa7\x98\x1f\x0e\xe4\x96\xa1\x49\xe5\x9d\x25\x82\x36\x41\xb3\xa3\xde\xaf\x17\x33\x25\xbf\xef\x6d\xa7\x98\x70\x76\x7d\x7c\x3b\xab\x07\xb8\xf4\x67\xcf\x04\xfa\x83\xf5\x55\x51\x96\xe8\xe5\x8e\xed\x5b\xe0\xdd\xa3\xb0\xe1\x48\x64\xa2\x5c\x24\xdd\xae\x16\x46\x28\x87\x9e\x69\x5e\x7f\xfa\x6c\x55\xb0\x17\x55\x85\xca\xae\x36\xb8\x25\x4a\x69\x93\xa3\x01\x91\x19\x6d\x2d\x58\xac\x84\x61\x5a\x55\x68\xbc\x8c\xae\xe1\x9a\x0d\xa8\xb7\xb6\x4a\xf7\x61\x5a\x74\x1e\x3f\xd6\xf6\x88\x52\x3a\x23\xe6\x20\x15\x7c\xfe\xe9\xfa\xc7\x1f\x7f\xfc\x33\xf9\xf4\x92\xd9\x29\x2d\x5d\xfe\xf9\xee\x7a\x0d\x5f\x54\x0f\xe6\x27\x5d\xd5\xe4\x1c\x73\xd8\x1c\x3c\x85\x0e\xd6\x61\xb9\x86\xcf\x28\xf2\x95\x56\xc5\x61\x0d\x1f\xea\xa2\x20\x78\x50\x48\xeb\x9e\xdd\x0b\x46\xbb\x71\x76\x84\x1b\x1d\x40\xb8\x4b\x20\x41\x5a\x11\x83\xe6\x0a\x51\x8e\x05\x12\xf4\xbf\x18\x91\xe1\x27\x34\x52\xe7\xb7\x98\x69\x95\xdb\x49\x69\xfa\x50\x97\x1b\x34\xa0\x49\x9a\xf9\x6e\x10\x45\xa1\x1f\x31\x0f\xe1\x51\x23\x17\x4e\xc3\x8e\x60\x6f\xeb\xa2\x38\xf4\x65\x09\x4d\x29\x95\x70\x08\x81\xf1\xd2\xc1\xa3\x2c\x0a\xd8\x20\x18\x2c\xf5\x03\xe6\x8d\x03\x8d\xd4\xfe\xa8\x8a\x03\xf3\x97\x84\xb0\x07\x32\x9e\xa8\x2b\xe7\x85\xd5\xf4\xc8\x1a\xde\x8b\x03\x10\xa7\x58\x16\xf7\xda\x38\x54\x98\xb7\x39\x38\x42\x59\xa9\xdc\xbf\xfd\x71\x90\xaa\x14\x1b\xed\x8e\xf4\xa4\x87\xc4\xb4\x6e\xbe\x19\xc2\xf9\xf3\x4f\xd7\xc0\xd2\x49\x4c\x65\xe9\x24\xc6\x82\x70\xc9\x70\x0e\x98\x9c\xe4\xb3\x22\x15\x19\x13\xcc\x8f\xcd\x5a\x70\x63\x8d\x9a\x33\x31\x41\x24\x66\x8d\xd2\xd5\xab\x11\x9b\xaa\x46\x11\xc8\x93\x2c\xa3\x06\x29\xed\x20\x97\x06\x33\xe7\xf9\xe4\xd8\xa3\x6d\xfa\xdc\x17\x21\x0c\x62\x2f\xd8\xa0\x2e\x2d\xe0\xd7\x0a\x33\x97\x8c\x46\x38\x04\xbc\x50\x1a\xc8\x45\xa0\x81\x07\x69\xe5\xa6\xe8\xfb\x58\x96\x96\x04\x8a\x95\xd0\x23\x46\x58\x19\x14\xd9\x3e\x60\xc3\x8e\xe1\x25\x88\xad\x43\x1f\xd0\x33\x75\x65\x5f\xa0\x5c\x22\xdc\x12\xb4\xe2\x70\x00\x61\x2b\x95\x28\xe4\xaf\x68\x2c\xbf\x83\x71\x2e\x2b\x77\x58\xc3\x95\x65\x14\x41\xd8\xa3\x1b\x7b\x80\xf9\x41\xd2\x7b\x21\x95\x05\xe9\xb0\xb4\xcb\x0e\x99\x37\x85\xce\xee\x89\x77\x1f\xe3\x6b\x7b\x72\x35\xe4\x22\x2d\xba\x65\xcb\xf6\x45\x13\xc9\x41\xa4\xb2\xe8\x40\x9b\x60\x89\x61\x5b\x1b\xb7\x47\x03\x52\x85\xd8\x7f\x5b\x53\x9c\xb4\xec\xb3\xaa\x70\x7b\x5d\xef\xf6\x20\x9b\x48\x28\x6a\x0f\x84\x8c\x28\x51\x3d\xdc\x10\xb9\x56\x19\xa9\x07\xdc\x88\xf6\x38\x12\xd9\xd7\xf0\x93\x36\x80\x5f\x45\x59\x15\x94\x5d\xb0\x3c\x85\x04\x83\x25\xcd\x87\x60\x02\x2a\xcd\x12\x16\x20\x0f\x39\x92\x1f\x5f\x45\x93\xe4\xa5\xea\xaf\xf5\x86\x6e\xf6\xfa\x40\xfc\x67\xb9\xb7\xa8\x72\x72\x73\x8d\xbc\x27\x53\x74\x9c\x4c\x01\x00\x58\xb9\xf3\xb1\x9e\x8f\x5f\x3c\xcb\x88\xf7\x52\xf1\x95\x4a\xe7\x6b\xb8\x0a\x92\x24\x5c\x0b\x89\x25\xb8\x06\x89\x7e\xf4\x46\x48\x11\x2e\x20\x60\x2f\x4c\xde\x46\x22\xbe\xf4\xc5\xed\xcd\x5f\xfe\x7a\xf3\xee\xdd\xcb\xde\xeb\x49\xac\xfb\x8c\x62\x2c\xb2\x02\x85\xaa\xab\x65\x30\xa2\x11\xc9\xc6\x96\x5e\x7d\xba\xe1\x4c\x82\x7f\x60\x97\x98\x71\x7c\xa6\xd0\x3d\x6a\x73\xdf\x03\x5b\x09\xe3\x38\x4c\xb7\xcb\x8e\x79\x27\x1e\x59\x47\xc7\xc0\xaf\xd2\xba\xa4\x4e\x81\xb1\x2c\xa3\x4b\xa8\x95\x93\x7d\x8b\x22\x14\x88\xbc\x94\x4a\x5a\x67\x84\xd3\x06\xb4\x01\x51\x3b\x5d\x0a\x2f\x35\x3a\x43\x6b\x21\x13\x0a\x72\xf4\x84\xc1\xae\x9c\x0d\xd8\x3f\x76\x33\x8d\x5b\xa1\x58\x64\x1b\x63\xb8\x65\xc3\xec\xa4\x65\x21\x24\x0d\xa7\xd9\x8b\x3e\x44\xaf\x39\xa8\x1a\xa3\x47\xb1\xc1\x58\x2c\x70\x6c\x46\xd3\x9b\x86\x14\xb5\x05\xb1\xf1\x3f\xff\xdf\x23\x86\xc6\xa0\x4d\xfa\xb4\xf7\xb5\x25\xba\x79\xab\x18\xbd\x7b\x8b\xd4\x8d\x16\x37\x42\x69\x70\x47\xb2\xd0\xf3\xc1\x00\x6f\x45\xb6\x07\x54\xce\x1c\x42\x52\x27\x73\x3a\xe3\x56\xa2\x49\x05\x19\x83\xb6\xd2\x8a\xbd\x02\x64\xba\xac\xb4\x42\x15\x0c\x07\xe9\xd9\x80\xab\x4c\xaa\xe1\x21\x27\x3c\xc8\x30\xb3\xe0\x0c\x9a\xdc\xae\xcc\x0c\xf1\x55\x69\xb5\x52\xb2\x58\x32\x5c\x89\xc1\x4c\xc8\xe0\x2a\x48\xa0\x63\x04\x12\x62\x9c\xe3\x03\xb3\x2f\x78\x52\x12\xec\x7f\x12\xc6\x88\xae\x9b\xdd\xa1\xa2\x98\x19\x4f\x26\x69\x67\x7f\x69\xdd\x19\x88\xac\x2b\x9f\x98\x43\x65\x70\x2b\xbf\x2e\x7d\xf2\xd5\x09\x1b\x96\x43\x76\x3d\xbe\x14\x04\xd4\x4a\xfe\xb3\x0e\xd9\xd8\xc7\x0f\xef\xfe\x07\x6e\x7e\xe2\xa7\xf9\x2d\xec\x54\x49\xe9\x1a\x25\xab\x8c\x7e\x90\x79\x9f\x22\xe0\xd9\xd1\x0e\x61\x08\x19\x6f\x5e\x19\xba\x41\x57\x1b\xe5\x43\x86\xa6\xc2\xd2\xc4\x41\xa3\x99\x9f\xdb\x0b\xd5\x80\xa9\x84\xb5\x29\x5c\xf2\xfe\x93\x41\x70\x04\xb9\x61\xc9\xda\x48\x15\x8a\x06\xe9\x80\x7d\x8f\x51\x6f\xb7\xf2\xab\x77\x41\xf1\x4c\x01\xdc\x3e\x44\x06\x9c\xa6\x36\xb5\x49\x30\x75\x81\x36\x86\x0d\x44\x9f\xbe\x71\xf3\x41\x48\x2c\xbe\x6d\x10\x9c\xa9\x55\xd6\xb6\x42\x05\xaa\x9d\xdb\x47\x11\xf5\x58\xb0\x9d\x91\x86\x49\xd3\x83\x59\x8a\x7b\xaf\x03\x1e\x39\x7f\x1c\xd0\xaa\xc5\x63\xb6\x77\x3d\xf2\x53\xdd\x96\x14\x70\xc0\x05\xa9\x9c\x9f\x8e\x62\xe0\x73\x70\xef\x20\xec\xb2\x05\xd8\x53\xf6\xc3\xc7\xbb\xc0\x3c\x10\xf0\xc7\x57\x7f\x86\xd5\x80\x5f\xb7\x0e\x45\xbe\x4c\xe9\x01\x4a\x0e\x5b\xc2\x63\x3f\xbc\x7a\x0d\xd7\x3e\xf7\x04\x6d\xe0\x4f\xaf\x5e\x79\xee\x7c\x46\x61\xb5\x0a\x85\x39\xd2\x5f\x5d\x0f\x25\x9f\xb9\xcc\x84\xf3\xd1\x40\x5b\x5c\x33\xae\xbe\x78\xc9\x84\xad\xae\x55\x1e\xdd\xbd\x8f\xc3\x8b\x42\x3b\x87\xf9\x72\xf4\xfc\x41\x02\x43\x19\xc7\x20\xd9\x98\x17\x51\xa7\x8a\x43\x3f\xf4\x64\x44\x38\x33\x1d\x10\x52\x84\xcf\x04\x61\xe5\xc3\x8c\x3d\x8a\x1c\xcd\x4b\x66\xcd\x55\x55\x15\x12\x73\x6f\x54\xe4\x16\xa2\x06\xb3\xdb\x8b\x5c\xea\x2b\xd4\xf3\xfa\x19\x99\x63\x59\x69\x87\x2a\x3b\x9c\xcd\x75\x25\x41\x40\x8e\xca\xe2\x3d\xd3\x74\x05\x96\x1c\xa5\xca\x10\x94\xcf\x3b\x3b\xa5\x0a\x11\x0f\x99\xb5\x00\x82\xde\x0e\xd2\x30\x47\xcb\x9a\x60\x9d\x70\xb8\x9e\x93\xd1\x3f\x4b\x3e\xc8\xdd\x92\x39\x6e\xf3\xec\x4a\xb5\x6f\x66\x43\xcc\x11\x9f\xd1\x45\x91\x6a\x66\xa8\xb6\x9a\xeb\x5d\x56\x97\x11\xe7\x01\xc1\x7e\x10\x46\x0a\xe5\x40\xb8\xe8\x75\x63\xcd\x28\x44\xdd\xdd\x9c\x50\x78\xff\xa4\xb7\x1d\x74\x87\xec\xa5\x83\xbd\x78\xf0\x25\xcb\x03\x3a\x10\x9c\xaa\xe9\x4e\x41\xc8\x07\x5e\xb2\x00\x6d\x7c\x0c\xd0\x89\x1b\x7b\x40\xc9\x28\xb2\x03\x20\xcf\x4d\x61\x41\x71\x68\x61\x41\x29\x10\x29\xfc\xa3\xb4\xb8\x3c\x8a\x22\x32\xf2\xf9\x39\x9a\x01\x43\x54\xab\x16\x88\x98\x9d\xee\x65\x9e\xa3\x82\x17\x52\xf1\x71\x2f\x1e\x85\xcb\xf6\xfc\xe3\x0e\x1d\x64\xa2\x28\xec\x4b\x1f\x0a\x78\xfd\x9d\x20\x80\x3a\x77\x94\xa9\x16\x32\x93\x94\xea\x0a\x7b\xef\xdd\x8f\xde\xb0\x7d\x3b\x7a\x7f\xaa\xcd\x0e\x54\x96\xfe\x9b\xa3\x46\xd5\x3e\x96\xb7\x67\xcb\x4e\x6c\x49\xa6\xaf\x0a\x22\xdb\x8a\x28\x06\xeb\xd7\x6c\x81\x6a\x63\xd8\x04\x61\x8f\xad\xa1\x8c\x52\x19\xf9\x20\x0b\xdc\x61\xce\x39\x97\xaf\xa7\xf1\xed\xfd\x8c\xcd\x97\x99\x9b\xf7\x86\xbc\x54\x36\xd9\xef\x32\xa6\x87\xc1\x6a\xf2\x13\x12\xf3\x98\x67\xf6\x40\x6e\x0e\x20\xd4\x81\x5f\x4d\x74\x81\x37\x6f\x3f\x7d\x7e\x7b\x7d\x75\xf7\xf6\x0d\xac\x3a\xe8\x82\xe0\xe2\x3a\x88\xa2\xda\x8b\x20\xb2\xc4\xb3\xc1\xc8\xae\x09\xac\x40\x2a\x78\x78\xbd\x7e\xfd\xa7\xf5\xb1\x51\xaa\x26\x9a\x0d\x95\xcf\x0e\xfb\x3f\x1c\x29\xeb\x27\x7f\xdf\xb8\xee\x84\xce\x41\x6d\x49\x4e\x30\xab\x1d\x0e\x80\x04\x90\x2a\x14\x3c\x53\x98\x9c\x14\x05\xa4\x8d\xa5\x8e\xb5\x97\x12\xdf\xa1\xb3\x2e\x62\x39\x02\xb1\x63\x42\x02\x35\x62\x21\x04\xb6\x42\x16\x84\xb8\x41\x5b\x17\xae\x55\x33\xc0\x69\xd5\x07\x00\xf0\xcd\x94\x14\x57\x59\x74\xe0\x34\x6b\x7a\xf4\x7b\x43\xba\x09\xc2\xb6\xf5\x79\x10\x32\x3d\x1f\xce\x0a\x4e\x93\x83\x8d\x2a\xb8\x1e\xb8\x7f\x24\x46\x3e\xc5\x5b\x00\x80\xd0\x8f\x1e\xf9\xed\x88\xc9\xed\xce\x45\xcc\x49\x99\xad\xd2\x76\x52\x0e\x4a\x43\xd2\x09\xc7\xf8\xd2\xaa\x28\x05\x33\x39\x7a\xdb\x44\xb0\x0f\x00\x00\x29\xaa\x1b\x3b\xc7\x8a\x51\x5f\x8c\xc3\x1e\x31\xc5\xe3\xc9\x84\x7f\x2b\x89\xcc\x49\xd5\xb8\xd9\x76\x85\x8b\x6d\x14\xd3\xf0\x27\x21\x8b\xda\x60\x0c\x66\x27\x32\xa9\x54\x21\xd9\x20\x54\xd4\x06\xb7\xa1\x22\x48\xad\x36\xb1\xc3\x28\x70\x2a\x66\x92\x94\x70\xd9\xda\xf8\xfe\x85\x70\xa0\x07\x6d\x0e\x00\x44\xb9\xf2\xb9\x58\xb0\xd6\xed\x64\x6f\xbd\x78\xba\x54\x0d\x37\xf9\x01\x9e\xa9\xe1\x3f\x02\x13\x8e\x06\x01\x9e\xda\xfc\x1f\x05\x3b\x38\x14\xf0\x94\x41\x80\x51\xc8\xbf\xe3\x80\xc0\x93\x14\x2a\xd3\x39\xce\x62\xdd\x6d\xbd\xdb\xf9\xf2\xf7\x7f\xde\xdd\x7d\x8a\x59\x08\x3d\xde\xb4\x3f\x28\xc0\xac\xed\x12\x5e\x81\xdc\x8e\xc0\x84\x58\x98\x1a\x33\x02\xad\x58\xf3\xc7\x1f\x26\x4f\x35\x14\x73\x36\xa8\x3b\x21\x0b\x3b\xeb\x64\x6f\x69\x0c\x28\xc7\x1c\xa8\x64\x04\xc2\x5a\x9d\x49\x0e\x8f\x93\xfa\x1a\xce\xa9\xd6\xbe\x24\x33\x21\x93\x74\x17\x4b\x86\x97\x6d\x90\xce\x82\x7e\x54\x80\xe9\x0d\x1e\xad\xa3\x20\x74\x14\x62\xcc\x9b\xa2\xd2\x7b\x0c\x53\xd2\x3f\xd8\x6e\xcc\x34\xc5\xc9\xe5\x28\x4c\xa7\x39\xfa\x08\x7a\x86\x5f\x33\xac\x42\xc1\xc8\x23\x9d\xb2\x82\x70\x1c\xa2\xf5\x18\xaf\x4e\xfb\x1c\x80\x4c\xd4\x76\xea\xf7\x81\xbe\xf9\x35\x3f\xe2\x6d\x31\x48\x95\x15\x75\x8e\x16\x4a\x6d\x30\x12\xb0\xc5\xa5\x09\xc0\xd0\x70\xf0\x96\x25\x33\xe4\xc6\x5b\x6f\x8d\xd7\xf0\x41\x3b\xf6\xb8\xed\x5f\x39\x1a\x9c\x04\x1a\x4a\x1b\x01\x17\xcc\xc3\x11\xd7\x13\x0f\x4d\xf8\xed\xa7\xd0\x12\x00\x62\x45\xe4\xd4\x4d\xc7\x29\xd6\xdd\x3e\x78\x9f\xe8\xd6\xbb\x83\x1e\x7b\x61\xfd\x31\xf2\x93\x70\x83\x2b\x47\x63\x34\xb5\xbf\x2c\x7b\x5c\x96\x1a\xe9\x2c\xfc\xd7\xed\xc7\x0f\x60\xd1\x70\x44\x20\xc6\xdc\xca\xf1\xbf\xf7\x0d\xa3\x21\x27\xa6\xa8\x1c\x2a\x6d\x1d\x15\x72\xe2\x8c\x06\x9b\x19\xc5\x26\x68\x06\x44\xe1\xbc\xf9\x24\x9b\x7b\x45\x82\xe4\xa3\xe9\x5f\xd1\xe8\x95\x54\x39\x7e\xa5\xfc\x0a\x7e\x22\x8a\x9c\xe6\x78\xf4\x75\x15\x0a\xe3\xe5\x90\xeb\x67\xdc\x18\x93\x0a\x84\x0a\xb2\xaa\xb7\x41\x16\x20\xaf\x71\x0e\x21\xb5\xe7\x89\xa5\xcc\x8a\x3c\x78\x59\x17\x4e\x56\x05\x7a\xea\x52\xbe\x12\x2c\x00\x27\x0a\x6f\x7d\xaf\xc8\x5e\xce\x00\xfd\x05\xe0\xcb\x19\x71\xe6\xcb\x19\xac\xc0\x25\xee\xa7\x8b\x5a\xb5\xb3\xa5\x19\x10\x93\xc0\x10\x64\x16\xe8\xbf\xbd\xfa\xfb\x7a\xe2\x15\x33\x60\x06\x24\xb6\xd2\x58\x17\x68\x18\x0a\xde\x2a\xbe\xe4\xcb\xd9\x69\x40\x27\xbd\x5c\xf3\xaf\x44\x6b\xc5\x0e\x9f\xa8\x3e\x57\xb0\xaf\x4b\xa1\x56\x06\x45\xce\xad\xd4\xd6\xaf\x69\xc2\x87\x38\x3f\xe7\xcc\xfe\x76\xe6\xf0\x1a\xda\x9e\x20\xd4\x37\x9b\x69\x0d\x61\x57\x13\xde\xa1\x6b\xd3\xc1\x70\x75\x6c\xfd\x9c\xc4\xf2\x2e\xe0\xc9\xb4\x2a\x45\xb6\x97\x0a\xa7\xa8\xb5\x38\x7d\x28\xa6\xe7\x11\xb5\x62\x41\x96\xa3\xa9\x94\x81\xd3\x1d\x66\x0e\x48\x76\x98\x1c\x7d\x51\x8c\x41\xd8\x88\x07\x21\x0b\xc2\xf1\x19\xe9\x76\x22\xd1\xe8\xde\x36\x9c\x70\xc4\x7f\x7e\x3e\xf8\x29\xbe\x93\x9f\x68\xac\x5f\xcf\xda\x3f\xd5\x71\xfa\x90\xae\xe3\x21\xd7\x8b\xef\x24\xd2\xf1\xb0\xea\xe4\xa1\xce\xe9\x54\xf4\xc4\x6f\x7c\x28\xf8\xa8\x7c\x65\xb1\x19\xb8\xf2\xa1\x1c\xf7\x50\x26\xe1\xb6\x7a\x79\xa1\xb7\xd9\xa0\x46\xa3\xb7\xbf\xd3\xc0\xea\x37\xf1\x62\xba\x28\x30\x36\xd4\xf8\x9b\xb2\x02\x5e\x84\x41\x3b\x34\x18\xa6\x96\xa5\xda\x15\x38\x9e\xda\x27\xa8\x5c\x28\xce\x84\xf2\x93\x18\x84\xf9\x06\xf3\x97\xdf\x2d\xb0\xdc\xc6\xe0\x1e\xc4\xc8\x9c\xd8\x28\xc5\x6e\xb6\x4d\x37\x62\xd9\x6e\x7b\xa4\x19\xb2\xa6\x4b\x3c\x79\xb4\x24\x95\xad\x09\x59\x3f\x73\x9b\xaf\xe1\x56\x97\xc1\x44\xc6\x49\x6c\xdf\x55\x59\x4c\x47\x71\xa9\x5b\xc3\xc5\x3a\x47\x4d\x31\xae\x36\x72\xb6\xeb\x10\x44\xc6\x2f\x5c\x85\x04\x4f\xdb\xf8\x92\x13\x70\x3b\x0e\x2d\xe2\x02\x7b\xfd\xe8\x87\x84\x9c\x86\x47\x21\x5d\x3a\xb9\xb8\x3f\x69\x51\xf7\xd8\x43\x6b\x8a\xa9\x73\x72\x48\x98\x95\x47\x02\x00\xd4\xf2\x09\xd6\xea\xe7\x9b\x37\xc7\x3a\xb1\x1e\x13\xe8\xc5\xac\x70\x6b\x4c\xa8\x9f\x3c\xee\xdc\x8c\x0f\xd8\x3f\xd4\xf2\xbb\x6d\xc7\x49\x37\x37\x65\xe6\x9f\x61\x3f\x61\x31\x29\x80\xdf\xb1\xab\xb0\x98\xa1\x31\xdf\xb4\xb7\x30\x0a\xf8\x77\x77\x0f\x27\xd9\x7b\x22\x4c\x7e\x72\x70\x1c\xcc\xfc\xa9\xb2\x5e\xb2\x72\xeb\x6f\x47\xbc\xbf\xa0\x31\x2e\x78\xb7\x4e\xa8\x5c\x98\xdc\x37\x32\xe2\xb3\xff\x02\x7f\x3d\xab\x92\xa2\x49\x13\xea\xf9\xee\x3a\x3e\xd0\x5e\xe3\x90\xdb\x34\xbb\xca\x7f\x0b\x28\x64\x29\xdd\x62\x46\x96\xa6\xd2\xfc\x33\x27\x66\xa9\x0e\x15\x66\x60\x83\x9d\x0f\x8d\x82\x53\xfe\x2c\x0c\x43\xec\x45\x2c\xec\x70\xed\x2d\x45\xe3\x1c\x6a\xa4\x28\x5f\x57\x82\x26\x14\x86\x46\xff\xda\xff\xc2\x31\xe3\xb6\x84\xb4\x96\x1f\xd2\x61\x6c\x22\x0c\x55\xea\xe3\xc5\x24\xe1\x4e\x63\x9a\x37\x1d\x40\x70\x3a\x6d\xbb\x78\xba\xe0\xd7\xd4\x6d\x4c\x27\x98\x26\x68\xec\x8a\x5e\x7b\x0e\xf9\x8e\x3e\x37\x8e\xac\x43\xe5\x82\x38\x36\x3d\xc5\x4a\xdb\xe1\xc9\xdf\xf6\xbf\xc0\xda\x40\x59\xaa\x03\xca\x5d\xed\xd5\xc9\xd7\x77\xf6\x42\xed\xfc\xb4\x48\x53\xc3\x10\xd3\x91\x2d\x3e\x42\x29\x15\x95\x51\x7c\xf7\xbb\x99\x14\x6a\xfc\x5b\x2c\xe8\x7b\x9f\x1f\xa5\xe2\x44\xa0\x86\x0a\x6a\xeb\xed\xba\xef\x99\x79\x49\x6d\x0d\x1f\x6d\x30\x0c\xbc\x65\x69\x0a\x75\x12\x66\x90\x96\x76\x45\x21\xb4\xaa\x90\x86\x31\x0b\xb4\x16\x0e\xba\xf6\xe7\x30\x98\xa1\x7c\x38\x81\x25\xa3\xe6\xf4\x3d\x2a\xef\x24\x84\xf2\xf1\x4f\xb4\x8e\xcf\x10\x57\x76\x28\x38\x3f\xca\xb8\x75\x4d\xc3\x27\xb9\x75\xdb\x62\xff\xf9\xb9\x4d\x6d\x8b\x69\xaa\xf9\x57\x47\xcb\x9c\x36\x18\x08\x72\x88\x39\xe2\x00\x5c\xec\x1f\x0d\x0c\x54\x75\x31\x8d\x73\xab\xcc\xe5\x20\xeb\x9e\xec\x41\x04\xd7\xf0\x8b\x1f\xd2\x0e\xf3\x92\xce\xf7\xfd\x27\xc1\x8a\x64\x06\x5a\xa8\x70\x9d\x90\x45\x12\x6a\x95\x1a\xef\x1b\x91\xdd\xcf\x91\x98\x38\xe9\x35\x67\xc5\xa5\xf1\x08\x93\x20\x9f\xc1\x5b\x64\x5a\xf9\xa2\x5c\x76\x58\x85\x21\x98\x95\x50\xf9\x2a\x99\x87\xec\xf0\xdd\x59\x9f\xc5\x62\xfb\x4e\xaa\xfb\xd9\x12\x17\x1f\xf0\x51\xda\xcf\x9f\xdf\x1d\x07\x67\x33\x9a\xbb\x30\x6f\x9b\xe8\x37\x8e\x4a\xa7\x6b\x5a\x4f\xac\x64\x3d\xee\xc3\x68\x48\x0a\x5c\x46\xb1\x97\x69\x70\xfe\x2c\x74\x83\xcf\x42\x54\x34\x5d\xd6\x9a\xea\x0f\x8d\x16\xb3\xe0\x2a\xce\x01\x66\x85\x30\xde\x38\x08\xe5\x3b\x77\xfe\xa5\x13\x51\x46\x8e\xb0\xa9\x1d\xe4\x1a\x7d\x7f\x49\x3f\xa0\x31\x32\x47\x90\xee\x9b\xc3\x32\xff\xd2\xd9\x41\x59\x8a\x15\x5b\xe5\x18\xaa\xd0\x20\xe8\xed\x25\x9c\xdd\xd6\x19\x8d\x24\x9c\x0d\x0d\xec\xc4\x7f\x89\xca\xcf\x1d\xcd\x51\x3e\xcf\x0a\xe9\xcf\xf4\x8d\x21\xf6\x84\x9c\x8e\xcf\x38\xac\x46\xe6\x5f\x46\x81\x15\x62\x83\xc5\x6f\xbd\x7d\xfc\x5e\xf0\x78\xb8\xbf\x93\x96\x8d\xbd\x5d\xf6\x1d\xef\xbe\x27\x71\x1a\xb4\xd9\x09\x6a\x97\x0f\x4e\x91\x52\x10\xb9\xd3\x46\xfe\x8a\xf0\x82\x3f\x6b\xc0\x57\x2d\x16\x98\xb9\x97\xad\x65\x5f\x71\x80\x92\xc7\xd8\xfc\x4f\xda\xd8\xa1\xf9\x47\x83\x34\xaa\xe6\xf5\xa3\x19\x29\xb4\x01\xa6\x79\x90\x19\x7e\xc3\xe6\xb0\xa7\xeb\xec\xa5\xe1\x52\x28\xb1\xc3\xdc\x77\x9b\xa6\x47\x21\xdf\xb7\x6f\x85\x52\x54\x16\x68\x37\x65\x5b\xe8\xc7\x95\xf4\xe3\x5f\xd1\x65\x7b\x0f\x37\xb8\x5c\xaa\xb7\xb1\xb1\xc4\xe4\x17\x06\x23\x0e\xde\xee\x0a\x97\xa0\x86\x5e\xb4\xa4\x38\xdc\xba\xe2\x10\x66\x7a\x46\x42\x87\xbd\xae\x2d\xde\x23\x56\x52\xed\x7c\xdc\xef\x27\xe8\xdc\xa1\xa2\x38\xad\x38\x84\xf2\x14\x4d\x09\xaa\xd0\x91\x0e\xdb\x57\xb5\xca\xd1\x58\x37\x14\xc4\x37\x25\x23\xb2\x5c\x11\xb3\x28\x35\x31\x5f\x39\xf7\xad\xc6\x65\x67\x38\x34\x5e\xec\x93\xc0\x34\xf3\xed\x14\x98\x37\x03\xb3\xa2\xaa\x68\x08\x50\xb8\x3d\x14\xf2\x1e\xe1\xcb\x59\x26\x57\x59\xfe\xe5\xcc\x87\xb5\x21\x92\xf7\xf4\x1b\xda\x74\x10\xc5\xa3\x38\x24\x6b\x9e\xb8\x11\xb2\x9e\x06\x7d\x96\xf6\xa3\x5d\xf5\xa1\x90\x24\xf8\x4d\xf8\xa2\x8e\x67\x53\x79\xee\xcf\xeb\x04\x53\xa2\x15\xc1\xc7\x59\x3f\x2a\xa4\x0e\x4d\x78\x2b\xed\x64\x86\xbd\x09\xc0\x91\x46\xf4\x74\xfa\x79\x6a\xc8\xa7\xeb\x34\x27\x27\x7c\x5a\x5f\xf2\x68\xb5\x9f\x17\x13\xf1\xb7\xa7\x06\xa7\xaa\x3c\xf2\x1d\x37\xe5\x31\x54\xf9\x40\x5a\x38\xe3\xae\xc7\x45\x78\xc7\x19\xfc\xa3\xb6\x63\x30\x99\xe3\x84\x90\xd3\xd5\xaa\x20\x1b\xdf\xc6\x38\xc8\x60\x58\xe5\x46\x72\x32\xc2\x1c\xc0\x69\x70\x46\x64\xf7\xa3\x78\x76\xce\x27\x5a\x38\x6f\xd0\xb7\xb1\x24\xdb\xc0\x90\xcd\x85\x7d\x2f\xaf\x30\x8b\x31\x37\xcc\x43\x4b\x43\x33\xec\x33\xbc\xcb\x76\xd0\xd2\x4c\x58\x7f\x70\xa6\xc6\xd3\xcc\x0d\x66\xa9\x95\x72\x88\xae\xbe\xac\xbf\x65\xf4\xce\x9b\x26\x33\x43\xb8\xbc\x75\x34\xfd\x7d\x28\xbd\xed\xea\x1e\x83\x9c\x88\x12\xf7\x68\x71\x06\xca\xa3\x04\x4e\x51\xc9\x0c\xa4\x3f\xc6\x7b\xe3\x47\x75\x08\x36\x61\x9c\x80\x84\x1a\x6f\x81\x22\x1f\xcf\xae\x58\x1b\x3a\xee\xe1\x2d\xb7\xca\x37\x48\x86\x25\x7d\x86\x80\x34\x83\xe2\x68\xbf\x65\x13\xbc\xf0\xf8\xac\x55\x5b\xc9\x84\x41\x38\xa7\xc5\x8a\xc3\x39\x5b\x9d\xf3\x9f\xb9\x8c\x79\xfe\x4d\x14\xa2\x3e\xc7\x0c\xe2\xdc\x49\xbf\xb7\xe1\xda\x9b\x66\xb1\x5c\x9e\x78\x04\x8f\x68\x70\x6a\x6a\xec\x26\xad\x9c\x04\xeb\x9c\xb6\xf0\xe4\xb6\xcb\x80\x70\xc0\xc5\x54\xdf\x60\x6c\x3f\x70\xc6\xc1\x27\x44\x7d\xac\xe1\xab\x4e\xad\xa9\x9d\xf3\x72\x4b\x4c\x96\xc3\xba\x0e\x19\x7e\xa9\x40\x34\xdf\xfa\x58\xc3\x8d\x4d\xc1\xe3\xf0\x77\x02\xfc\x2a\x84\xda\x25\xf3\x6b\x97\xcd\x96\x33\x77\x3f\xd3\x0f\x5c\x7e\xe2\x0f\x1c\xa4\x95\xf5\x21\xd9\x6c\x76\x95\xb1\xbb\x89\x02\x42\x91\xc5\x36\xba\x32\x52\xb8\xd8\x37\x6c\x5b\xbe\xf5\xf0\xc2\x97\xb4\x50\x19\x59\x0a\x23\x79\x1d\x22\x4c\xce\x91\xa8\xa6\x45\x8e\x66\xef\xc6\x07\x87\xdd\x5a\x57\x9e\x3e\xd4\xd5\x97\x96\x81\x12\xfd\xf7\xb4\x51\x98\xf6\xe7\x73\x57\x7f\x12\xa7\xa6\x43\xc0\x0f\xf1\xb6\x8e\x03\xf5\x57\x02\xd7\x69\xa5\x1f\x54\x5f\x2a\xfa\x07\xbe\x52\x41\x0f\xd2\xcb\x41\x5a\x20\x21\x79\x10\x85\xe7\x29\x83\xff\x72\x96\xe3\x56\xd4\x85\xfb\x72\xd6\xdc\xba\xa4\x44\xb0\x07\xb2\x7d\x6b\xb0\x68\x99\x50\x5a\x11\x57\x8f\x06\x73\x9b\x11\xbb\x10\xb7\x83\x30\x98\x64\x74\x68\x8d\x72\x83\xfe\x5b\x66\x39\xfd\xd1\x12\xee\x30\x61\xc4\xe6\x2c\x05\x11\xde\x6c\x35\xdd\xc9\xf0\x92\xe1\x95\xf3\x68\x11\x38\xd0\x8a\x9b\xba\x02\xde\x7c\xb8\xfd\xdf\x77\x57\xff\xf1\xf6\xdd\x7a\x5a\x38\xfa\xa1\xf0\x1c\x61\x49\xf8\xdb\xd9\x0b\x62\xfa\x51\xa1\xf9\x8c\xbc\xb8\x99\xe1\x74\xba\xf0\x2e\xec\x5f\x84\x83\x43\x8e\x95\x57\x97\xcd\xa1\xb7\x97\x74\xf5\xee\xdd\x28\x81\x42\x2c\xcb\x65\x67\x2e\xd4\xf1\x5a\x52\x9a\x30\xef\x7c\xf3\x26\xd0\x72\x27\xcc\x46\xec\x10\x32\x0a\xc3\x33\x37\xb5\xbd\xda\xec\x46\xb4\x92\x90\x76\x10\x4f\x6f\xf0\xbb\x40\x69\xfa\x2b\x95\xdb\x87\x99\x19\x6a\xf7\xba\x29\x1f\x47\x48\x69\xb2\xa0\xb9\xd8\x8a\xc7\xe8\x09\x33\xa4\x27\x77\x5c\x6b\x69\x62\xb4\xf6\x94\x1f\xa6\x70\xa2\x05\x74\xfd\xaf\x88\xac\xbb\x61\x34\x82\xf1\x62\xe2\xbe\xc9\x43\xf3\x97\x36\x3e\x92\xb4\xc5\x4f\xb1\xcc\x40\x82\x78\x6a\x68\x08\xfe\xea\xc3\x9b\xd8\x71\x60\x89\x4d\x2b\xbe\x67\xd4\xd5\xa7\x80\x5c\xe5\x11\xee\xd8\x04\x5f\x5a\xab\x0f\x02\xd0\x00\x6b\x18\xd1\x5b\x98\xbf\xc7\xc3\x8a\xcd\xc0\x08\x50\xff\x4d\x32\xfe\xfa\x42\x4c\x35\x82\x2e\xb5\xb6\x82\xd6\xf0\xc6\xdb\x30\x0b\x4e\xc3\x56\x14\x96\x7a\x4e\x63\xa1\x57\xfa\xae\x52\x5c\x46\xe6\x7c\x94\x13\x5c\x0b\x67\x1e\xc3\x33\xa8\xa8\xec\x6d\xdb\xec\xe1\xb3\x2c\x47\x80\xea\xb8\xdc\x07\x7f\xfc\xe1\x07\x78\xf1\xb3\x0a\x8b\x36\x5c\x67\x7c\xab\x9c\x74\x87\x97\xad\xef\x02\xf9\xae\xca\x14\xa3\x37\x5a\x17\x28\xd4\x62\x30\x99\x08\x52\xfb\x14\x0e\x1f\x11\x8f\x55\x2e\xad\x46\xcc\xd0\x88\x79\xb8\x8d\x4f\x09\x0c\xcc\x08\x1c\x8b\xfd\xef\xdd\xa8\x3d\xa1\x51\xe3\xc3\x54\x03\xf1\xdc\xa9\xb3\x7c\x7f\x20\x32\x0b\xe7\xd1\xe9\x96\x89\xb9\x96\xe7\xc0\x78\x7c\x02\x65\x12\xe1\xa9\x05\xb0\x55\xcb\x9e\x0e\xfe\x4c\x9c\x1d\xfc\x61\x64\xb2\x6c\x45\xd4\x79\x8e\x10\xff\x44\xa3\xaf\xb7\x0d\x1d\x3a\x5d\x6c\xe6\x7c\x65\xa9\x19\x64\x09\x1b\x8b\x71\x25\x29\x39\x84\xe1\xaa\xda\xac\x7e\xde\x48\xcf\x6e\x60\x61\xb9\xdd\xc3\x7b\xdf\x6a\xb7\x53\x0c\x46\xdb\x2a\xa5\xb4\x4e\x66\xd0\xea\x61\x2d\xc3\x03\xfc\x0e\x9e\xdc\x1a\xff\x78\x80\x5f\x4b\x6e\xd2\x62\xad\xda\x5f\xa4\xd4\x26\xd6\x1a\xe2\xa5\xe6\x93\x78\x3d\x90\x7e\xa4\x8d\x12\x86\x90\x48\xfa\x44\xb8\xd5\x46\x7c\x7a\xef\x30\xf6\x0b\xf9\xf3\x95\x65\xeb\x9b\x6a\x3e\xd5\x26\x1a\x08\xff\xd1\xa0\xac\x2e\x84\x19\xc0\x7c\xf4\xd3\x65\x76\xea\xfb\x3a\x9d\x46\xe4\xbc\xce\xe9\x68\xb7\xf4\xb9\x4d\xe6\x8c\x6e\xe5\xec\xc8\x77\xac\x2b\xd9\xdd\x43\x9b\xdf\x89\xec\xd0\x73\x70\x57\xfc\x64\xf7\x71\x14\xd7\x01\xb3\xd9\xd5\x62\x32\x98\x21\x3b\x0a\x19\xbb\x54\xe1\x23\x1a\x2a\x0f\xd9\x9c\xd7\xef\xa3\xaf\x07\x0e\xc4\xd1\x0e\x64\xbb\xc4\xde\x7c\x63\xa4\xfb\x35\x3b\xad\xc0\xfa\xce\x18\x7d\x84\x29\x65\xcb\x03\x62\xd7\xd2\xaa\xd6\xf7\xeb\xe2\xe7\x0c\x9d\x8e\x3a\xab\x15\x7c\xfa\xf9\xae\xf3\x0d\xca\xb6\x98\x0e\xad\xb6\x9f\xec\x9f\x7f\x9b\xab\x98\x29\x44\x83\xb6\xb9\xdf\x7c\x1c\xb8\xed\xe8\x52\xb0\xab\xe1\x29\xef\x21\x2e\xe1\xe1\x35\xd7\xe4\x5f\x2f\x5a\x06\x21\xef\x14\x4f\xc3\x9a\x6e\xb8\xd6\xbc\x59\x64\xb4\x68\x87\xf9\x87\xe3\x0f\x7c\x9f\x9d\x75\xbe\xeb\xcd\x7f\xd2\x64\xb0\xff\xf0\xd8\x25\xfc\xed\xef\x0b\x08\xdf\xec\xfd\x25\xe2\x04\x7f\xfb\xfb\xff\x0d\x00\x62\xac\x24\x3c\xcb\x5c\x00\x00"),
+		},
 		"/crds/meshes.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "meshes.yaml",
 			modTime:          time.Date(2020, 7, 31, 11, 8, 25, 954972275, time.UTC),
@@ -97,6 +132,27 @@ var HelmTemplates = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x7c\x5b\x73\xdc\x36\x96\xf0\x7b\xff\x8a\x53\x9a\x07\xd9\x55\xdd\x2d\x3b\x99\xf9\xea\x1b\xbd\x69\x65\x67\xd6\x1b\xc7\x76\x59\x4a\xb6\xb6\xc6\xa9\x2d\x34\x79\xd8\xc4\x88\x04\x18\x00\x94\xd4\xf9\xf5\x5b\xe7\x00\xe0\xa5\x79\x69\xca\x56\xb2\xeb\x37\xb7\xc8\xc3\x83\x73\xbf\x42\x54\xf2\x17\x34\x56\x6a\x75\x09\xa2\x92\xf8\xe8\x50\xd1\xff\xec\xf6\xee\xff\xdb\xad\xd4\x17\xf7\xaf\x77\xe8\xc4\xeb\xd5\x9d\x54\xe9\x25\x5c\xd7\xd6\xe9\xf2\x33\x5a\x5d\x9b\x04\xdf\x60\x26\x95\x74\x52\xab\x55\x89\x4e\xa4\xc2\x89\xcb\x15\x40\x62\x50\xd0\x8f\xb7\xb2\x44\xeb\x44\x59\x5d\x82\xaa\x8b\x62\x05\xa0\x44\x89\x97\x50\x19\xfd\x78\x70\x58\x56\x85\x70\x68\xb7\x77\x75\x29\xb6\x52\xaf\x6c\x85\x09\xbd\xbe\x37\xba\xae\x2e\x21\xfe\xec\xdf\xb2\xf4\x17\x00\x8f\xc5\x27\x02\x70\x1b\x00\xf0\xef\x55\x51\x1b\x51\x1c\x83\x5e\x01\xd8\x44\x57\x78\x09\x67\x67\x2b\x80\x7b\x51\xc8\x94\x31\xf3\xc0\x74\x85\xea\xea\xd3\xbb\x5f\xbe\xbf\x49\x72\x2c\x85\xff\x11\x20\x45\x9b\x18\x59\xf1\x73\xfd\x4f\x81\xb4\xe0\x72\x04\xff\x3c\x64\xda\xf0\x7f\xfb\x1f\x85\xab\x4f\xef\x02\xa4\xca\xe8\x0a\x8d\x93\x11\x7b\xfa\xd7\x21\x78\xf3\xdb\xd1\x37\xcf\x09\x29\xff\x0c\xa4\x44\x62\xf4\x9f\xbd\xf7\xbf\x61\x0a\xd6\x23\xa0\x33\x70\xb9\xb4\x60\xb0\x32\x68\x51\x39\x3e\x5c\x07\x2c\xd0\x23\x42\x81\xde\xfd\x0b\x13\xb7\x85\x1b\x34\x04\x04\x6c\xae\xeb\x22\x85\x44\xab\x7b\x34\x0e\x0c\x26\x7a\xaf\xe4\xef\x0d\x64\x0b\x4e\xf3\x27\xf9\x44\xae\x07\x51\x2a\x87\x46\x89\x82\xc8\x59\xe3\x1a\x84\x4a\xa1\x14\x07\x30\x48\xdf\x80\x5a\x75\xa0\xf1\x23\x76\x0b\x3f\x69\x83\x20\x55\xa6\x2f\x21\x77\xae\xb2\x97\x17\x17\x7b\xe9\xa2\x88\x25\xba\x2c\x6b\x25\xdd\xe1\x22\xd1\xca\x19\xb9\xab\x9d\x36\xf6\x22\xc5\x7b\x2c\x2e\x44\x25\x37\x8c\xa7\x72\x2c\x96\x65\xfa\x17\x13\xc4\xcf\x9e\x77\x10\x73\x07\xe2\xb3\x75\x46\xaa\x7d\xf3\x33\x8b\xcb\x24\x99\x7f\x94\x2a\x25\x96\x8a\xf0\x9a\x47\xb7\xa5\x26\xfd\x44\x44\xf8\xfc\xf6\xe6\x16\xe2\x47\x99\xe2\x7d\x12\x33\x71\xdb\xd7\x6c\x4b\x67\xa2\x8b\x54\x19\x1a\xcf\xa7\xcc\xe8\x92\x21\xa2\x4a\x2b\x2d\x95\xe3\xff\x24\x85\x44\xd5\xa7\xb1\xad\x77\xa5\x74\xc4\xd8\xdf\x6a\xb4\x8e\xd8\xb1\x85\x6b\xa1\x94\x76\xb0\x43\xa8\xab\x54\x38\x4c\xb7\xf0\x4e\xc1\xb5\x28\xb1\xb8\x16\x16\x9f\x9b\xca\x44\x50\xbb\x21\x0a\x9e\xa6\x73\x57\xfb\xe3\xbf\x31\xe1\x67\x05\xa0\x53\xb0\xa0\x1e\xfd\x01\x40\xa4\x29\x5b\x13\x51\x7c\x9a\x78\x79\x12\x83\x51\x35\x6a\xbf\xc4\x6c\x56\x50\x2b\xeb\x4c\x9d\xb8\xda\x60\x0a\x77\x78\x08\x1c\x2f\x45\x05\xd6\x69\xfa\xf1\x41\xba\x7c\xf0\x45\xd1\xe5\xbe\x70\xcc\xd6\x1d\x82\x45\x07\xbb\x03\x90\xcd\x64\x85\x70\x5a\x17\xac\x39\x0c\x8b\x15\xc3\xa0\x33\x12\xef\x71\x08\xd2\xec\xa4\x33\xc2\x1c\x1a\xda\x6d\xe1\x36\xc7\x03\x08\x83\x40\x6c\xfe\xad\x46\x73\x10\xbb\xc2\xc3\x09\x0a\xbb\x23\x63\x83\x96\xa4\x2b\x1d\x80\x7c\xc8\x51\x41\xa9\x53\x99\x1d\x48\x72\xbd\x58\x0e\x95\xef\xf2\xe2\xe2\xae\xde\xa1\x51\x48\xc6\x57\xea\x8b\x54\x27\xf6\xa2\xb6\x68\x36\xfb\x5a\xa6\x78\xd1\x61\xd0\xf9\x6a\x8c\xf4\x1e\x72\xef\x4f\x49\x51\x5b\x87\xe6\x03\xd9\xf7\x39\x9e\xdc\xe6\xc8\xe6\xdc\x9b\x2e\x8c\xef\xc1\x43\x2e\x93\x9c\x7f\x09\xda\xb4\xc3\x42\xab\xbd\x17\xfc\xdb\x63\x8d\x63\x33\x64\xa1\xb6\x98\x12\xb9\x53\x69\x49\x57\x6b\x69\xf3\x86\x51\x96\x39\x09\x96\xbe\xc5\x1f\x24\x2a\xb2\x23\xa9\x44\x42\xe4\x80\x54\x66\x19\x9a\x63\xcd\xeb\x1c\xc6\xfa\x2f\x43\x26\xb1\x60\x3b\x41\x6c\x21\x9e\x0b\x75\x78\xc8\xd1\x20\x18\xb9\xcf\x1d\x28\xfd\xc0\xd0\x45\x25\x99\x33\x06\x46\xd0\xdd\x6b\xb6\x26\x1a\xe4\x5e\x31\x3f\x1c\xc8\x8c\xa1\x49\xe5\x1d\x26\x82\x36\x41\xb3\xa3\xde\x6f\x57\x0b\x25\x7f\xe8\x71\xe7\x98\x70\x76\x7d\xfc\xb8\xb7\x82\xae\xf9\xef\xc0\x04\xfa\x83\x0d\x55\x51\x96\xe8\xe5\x8e\xed\x5b\xe0\xdd\x83\xb0\xe1\x48\x64\xa2\x5c\x24\xdd\xbe\x16\x46\x28\x87\x9e\x69\x5e\x7f\x86\x6c\x55\x90\x8b\xaa\x42\x65\x37\x3b\xcc\x88\x52\xda\xa4\x68\x40\x24\x46\x5b\x0b\x16\x2b\x61\x98\x56\x15\x1a\x2f\xa3\x5b\xb8\x66\x03\xea\xad\xad\xd2\x43\x98\x44\x65\xc6\x8f\xb5\x3d\xa2\xd4\x9c\x11\x53\xfa\xea\xe7\x1f\xae\xbf\xff\xfe\xfb\xbf\x93\x53\x2f\x99\x9d\xd2\xd2\xcf\x3f\xdf\x5e\x6f\xe1\x8b\x1a\xc0\xfc\xa4\xab\x9a\x9c\x63\x4a\x16\x80\x29\x74\xb0\x0e\xcb\x2d\x7c\x46\x91\x6e\xb4\x2a\x0e\x5b\xf8\x50\x17\x05\x07\x09\x85\xb4\xee\xd9\xbd\x60\xb4\x1b\x67\x47\xb8\xd1\x01\x84\xbb\x04\x12\xa4\x0d\x31\x68\xa9\x10\xa5\x58\x20\x41\xff\x87\x11\x09\x7e\x42\x23\x75\x7a\x83\x89\x56\xa9\x9d\x95\xa6\x0f\x75\xb9\x43\x43\x0a\x6d\xfd\xd3\x20\x8a\x42\x3f\x60\x1a\xe2\xa3\x56\x2e\x9c\x86\x3d\xc1\xce\xea\xa2\x38\x0c\x65\x09\x4d\x29\x15\xf1\x36\x30\x5e\x3a\x78\x90\x45\x41\x92\x62\xb0\xd4\xf7\x04\x31\x3a\xd0\x48\xed\x8f\xaa\x38\x30\x7f\x49\x08\x07\x20\xe3\x89\xfa\x72\x5e\x58\x4d\xaf\x6c\xe1\x27\x71\x00\xe2\x14\xcb\x62\xae\x8d\x43\x45\x12\xdb\x72\x70\x82\xb2\x52\xb9\xff\xf7\xd7\x51\xaa\x52\x6c\xb4\x3f\xd2\x93\x01\x12\xf3\xba\xf9\x66\x0c\xe7\xcf\x3f\x5c\x03\x4b\x27\x5b\x07\x92\x4e\xd6\x3c\xe1\x1a\xc3\x39\x62\x72\x1a\x9f\x15\xa9\xc8\x98\xd0\x09\xfb\x66\x2d\xb8\xb1\x56\xcd\xbd\x46\x8b\x86\x59\x93\x74\xf5\x6a\xc4\xa6\xaa\x55\x04\xf2\x24\xeb\xa8\x41\xa4\xf7\xa9\x34\x98\x38\xcf\x27\xc7\x1e\x6d\x77\x18\x71\xb1\x3e\x0c\x62\x2f\xd8\xa2\x2e\x2d\xe0\x63\x85\x89\x6b\x8c\x46\x38\x04\xbc\x50\x1a\xc8\x45\xa0\x81\x7b\x69\xe5\xae\x18\xfa\x58\x96\x96\x06\x14\x2b\xa1\x47\x8c\xb0\x32\x28\x92\x3c\x60\xc3\x8e\xe1\x25\x88\xcc\xa1\x8f\xe8\x99\xba\x72\x28\x50\xae\x21\xdc\x1a\xb4\xe2\x70\x00\x21\x93\x4a\x14\xf2\x77\x8a\xf7\xe8\x1b\x8c\x73\x59\xb9\xc3\x16\xae\x2c\xa3\x08\xc2\x1e\x3d\x38\x00\xcc\x2f\x92\xde\x0b\x49\xc1\x8a\xc3\xd2\xae\x7b\x64\xde\x15\x3a\xb9\x23\xde\x7d\x8c\x9f\x1d\xc8\xd5\x98\x8b\xb4\xe8\xd6\x1d\xdb\x17\x4d\x24\x07\x91\x8a\x18\xaf\x4d\x8c\x64\xb2\xda\xb8\x9c\x9c\x97\x0a\xb1\x7f\x56\x53\x9c\xb4\x1e\xb2\xaa\x70\xb9\xae\xf7\x39\x29\x68\x8c\x84\xa2\xf6\x40\x48\x89\x1a\xaa\x87\x07\x22\xd7\x2a\x23\xf5\x88\x1b\xd1\x1e\x47\x22\xfb\x16\x7e\xd0\x06\xf0\x51\x94\x55\x41\xd9\x05\xcb\x53\x48\x30\x58\xd2\x7c\x08\x26\xa0\xd2\x2c\x61\x01\xf2\x98\x23\xf9\xfe\x55\x34\x49\x5e\xaa\x7e\xac\x77\xf4\xb0\xd7\x07\xe2\x3f\xcb\xbd\x45\x95\x92\x9b\x6b\xe5\xbd\x31\x45\xc7\xc9\x14\x3b\x13\xb9\xf7\xb1\x9e\x8f\x5f\x3c\xcb\x88\xf7\x52\xf9\x64\x50\xa7\x5b\xb8\x0a\x92\x24\x5c\x07\x89\x35\xff\x3d\x20\x31\x8c\xde\x08\x29\xc2\x05\x04\xe4\xc2\xa4\x5d\x24\xe2\x47\x5f\xdc\xbc\xfb\xc7\x8f\xef\xde\xbf\x7f\x39\xf8\x3c\x89\xf5\x90\x51\x8c\x45\x52\xa0\x50\x75\xb5\x0e\x46\x34\x22\xd9\xda\xd2\xab\x4f\xef\x38\x93\xf0\xa9\x2c\xb9\xc4\x84\xe3\x33\x85\xee\x41\x9b\xbb\x01\xd8\x4a\x18\xc7\x61\xba\x5d\xf7\xcc\x3b\xf1\xc8\x3a\x3a\x06\x3e\x92\x38\x47\x75\x0a\x8c\x65\x19\x5d\x43\xad\x9c\x1c\x5a\x14\xa1\x40\xa4\xa5\x54\xd2\x3a\x23\x9c\x36\x24\x47\xa2\x76\xba\x14\x5e\x6a\x74\x82\xd6\x42\x22\x28\x21\xf6\x84\xc1\xbe\x9c\x8d\xd8\x3f\x76\x33\xad\x5b\xa1\x58\x24\x8b\x31\xdc\xba\x65\x76\xa3\x65\x21\x24\x0d\xa7\xc9\xc5\x10\xa2\xd7\x1c\x54\xad\xd1\xa3\xd8\x60\x2a\x16\x38\x36\xa3\xcd\x97\xc6\x14\xb5\x03\xb1\x13\x41\xfc\x1f\x8f\x18\x5a\x83\x36\xeb\xd3\x7e\xaa\x2d\x5b\x1c\xb6\x8a\xd1\xbb\x77\x48\xdd\x6a\x71\x2b\x94\x06\xf7\x24\x0b\x03\x1f\x0c\xf0\x56\x24\x39\xa0\x72\xe6\x10\x92\x3a\x99\xd2\x19\x33\x89\xa6\xa9\xc8\x18\xb4\x95\x56\xec\x15\x20\xd1\x65\xa5\x15\xaa\x60\x38\x48\xcf\x46\x5c\x65\xa3\x1a\x1e\x72\x83\x07\x19\x66\x16\x9c\x51\x93\xdb\x97\x99\x31\xbe\x2a\xad\x36\x4a\x16\x6b\x86\x2b\x31\x98\x09\x19\x5c\x05\x09\x74\x8c\x40\x42\x8c\x73\x7c\x60\xf6\x05\x4f\x4a\x82\xfd\x9f\x84\x31\xa2\xef\x66\xf7\xa8\x28\x66\xc6\x93\x49\xda\xd9\x3f\x3a\x4f\x06\x22\xeb\xca\x27\xe6\x64\x21\x32\xf9\xb8\xf6\xc9\x57\x2f\x6c\x58\x8f\xd9\xf5\xf8\x51\x32\xe4\x4a\xfe\x56\x87\x6c\xec\xe3\x87\xf7\xff\x05\xef\x7e\xe0\xb7\xf9\x2b\x3e\x1a\xc9\x85\x6d\x95\xac\x32\xfa\x5e\xa6\x43\x8a\x80\x67\x47\x37\x84\x21\x64\xbc\x79\x65\xe8\x06\x5d\x6d\x94\x0f\x19\xda\x0a\x4b\x1b\x07\x4d\x66\x7e\x2e\x17\xaa\x05\x53\x09\x6b\x9b\x70\xc9\xfb\x4f\x06\xc1\x11\xe4\x8e\x25\x6b\x27\x55\x28\x1a\x34\x07\x1c\x7a\x8c\x3a\xcb\xe4\xa3\x77\x41\xf1\x4c\x01\x5c\x1e\x22\x03\x4e\x53\xdb\xf2\x24\x98\xba\x40\x1b\xc3\x06\xa2\xcf\xd0\xb8\xf9\x20\x24\x16\xdf\x76\x08\xce\xd4\x2a\xe9\x5a\xa1\x02\xd5\xde\xe5\x51\x44\x3d\x16\x6c\x67\xa4\x61\xd2\x0c\x60\x96\xe2\xce\xeb\x80\x47\x2e\xf0\x4b\xab\x0e\x8f\xd9\xde\x0d\xc8\x6f\x2b\x4c\x48\x01\x47\x5c\x10\x85\xaa\x39\x36\x62\xe0\x73\x70\xef\x20\x82\x43\x8c\x31\x27\x51\xf6\xc3\xc7\xdb\xc0\x3c\x10\xf0\xd7\x57\x7f\x87\xcd\x88\x5f\xb7\x0e\x45\xba\x6e\xd2\x03\x94\x1c\xb6\x84\xd7\xbe\x7b\xf5\x1a\xae\x7d\xee\x49\x3e\xe4\x6f\xaf\x5e\x79\xee\x7c\x46\x61\xb5\x0a\x85\x39\xd2\x5f\x5d\x8f\x25\x9f\xa9\x4c\x84\xf3\xd1\x40\x57\x5c\x13\xae\xbe\x84\xc0\x29\xd3\xb5\x4a\xa3\xbb\xf7\x71\x78\x51\x68\xe7\x30\x5d\x4f\x9e\x3f\x48\x60\x28\xe3\x18\x24\x1b\xf3\x22\xea\x54\x71\x18\x86\x9e\x8c\x08\x67\xa6\x23\x42\x8a\xf0\x99\x20\x6c\x7c\x98\x91\xa3\x48\xd1\xbc\x64\xd6\x5c\x55\x55\x21\xe9\xe8\x64\x54\x64\x06\x51\x83\xd9\xed\x45\x2e\x0d\x15\xea\x79\xfd\x8c\x4c\xb1\xac\xb4\x43\x95\x1c\xce\x96\xba\x92\x20\x20\x47\x65\xf1\x81\x69\xba\x02\x4b\x8e\x92\x22\x14\xe5\xf3\xce\x5e\xa9\x42\xc4\x43\x26\x1d\x80\xa0\xb3\x51\x1a\xa6\x68\x59\x13\xac\x13\x0e\xb7\x4b\x32\xfa\x67\xc9\x07\xb9\x63\xb2\xc4\x6d\x9e\x5d\xa9\xee\xc3\xbe\x46\xc3\x1c\xd0\x45\xd1\xd4\xcc\x50\x65\x9a\xeb\x5d\x56\x97\x11\xe7\x11\xc1\xbe\x17\x46\x0a\xe5\x28\x65\x0c\x5e\x37\xd6\x8c\x42\xd4\xdd\xcf\x09\x85\xf7\x4f\x3a\xeb\xa1\x3b\x66\x2f\x29\x52\xba\xf7\x25\xcb\x03\x3a\x10\x9c\xaa\xe9\x5e\x41\xc8\x07\x5e\xb2\x20\x85\xe4\x18\xa0\x17\x37\x0e\x80\x92\x51\x64\x07\x40\x9e\x9b\xc2\x02\x12\xe5\x06\x0b\x4a\x81\x48\xe1\x1f\xa4\xc5\xf5\x51\x14\x91\x90\xcf\x4f\xd1\x8c\x18\xa2\x5a\x75\x40\xc4\xec\x34\x97\x69\x8a\x0a\x5e\x48\xc5\xc7\xbd\x78\x10\x2e\xc9\xf9\x8f\x7b\x24\xe7\x5c\x14\xf6\xa5\x0f\x05\xbc\xfe\xce\x10\x40\x9d\x3b\xca\x54\x0b\x99\x48\x4a\x75\x85\xbd\xf3\xee\x47\xef\xd8\xbe\x1d\x7d\xbf\xa9\xcd\x8e\x54\x96\xfe\x93\xa3\x46\xd5\x3d\x96\xb7\x67\xeb\x5e\x6c\x49\xa6\xaf\x0a\x22\xdb\x89\x28\x46\xeb\xd7\x6c\x81\x6a\x63\xd8\x04\xe1\x80\xad\xa1\x8c\x52\x19\x79\x2f\x0b\xdc\x63\xca\x39\x97\xaf\xa7\xf9\x1c\x71\xe8\x2a\xb8\xcc\xdc\x7e\x37\xe4\xa5\xb2\xcd\x7e\xd7\x31\x3d\x0c\x56\x93\xdf\x20\xd3\x14\xf2\xcc\x01\xc8\xdd\x01\x84\x3a\xf0\xa7\xd9\x94\xbd\x79\xfb\xe9\xf3\xdb\xeb\xab\xdb\xb7\x6f\x60\xd3\x43\x97\x4b\xe4\x94\x30\x14\x55\x2e\x82\xc8\x12\xcf\x46\x23\xbb\x4e\xf1\x48\x2a\xb8\x7f\xbd\x7d\xfd\xb7\xed\xb1\x51\xaa\x66\x9a\x0d\x95\xcf\x0e\x87\x7f\x38\xee\x13\x86\x2c\x72\x52\x77\x42\xe7\x80\x42\x61\x7c\xc4\xa4\x76\x38\x02\x92\xd3\x56\x5f\xf0\x6c\xc2\xe4\x36\xc1\xa2\x28\xc4\x97\x3a\xb6\x5e\x4a\x7c\x87\xce\xba\x88\xe5\x04\xc4\x9e\x09\x09\xd4\x88\x85\x10\xc8\x84\x2c\x08\x71\x83\xb6\x2e\x5c\xa7\x66\x80\xf3\xaa\xcf\x91\x0d\x37\x53\x9a\xb8\x8a\xeb\xac\x9a\x35\x3d\xfa\xbd\x31\xdd\xa4\xb8\xa6\xa3\x0c\xa3\x90\xe9\xfd\x70\x56\x02\x29\x8a\x22\xaa\xe0\x76\xe4\xf9\x89\x18\xf9\x14\x6f\x43\x52\x37\x12\x0e\x4f\x30\xb9\xdb\xb9\x88\x39\x29\xb3\x95\xe9\xda\xa6\x1c\x94\x86\x34\x27\x9c\xe2\x4b\xa7\xa2\x14\xcc\xe4\xe4\x63\x33\xc1\x7e\xcc\x5f\x7c\x54\x37\x75\x8e\x0d\xa3\xbe\x9a\x86\x3d\x61\x8a\xa7\x93\x89\x50\x60\xac\x0b\x77\x52\x35\xde\x65\x7d\xe1\xf2\x01\x19\xd1\xf0\x07\x21\x8b\xda\x60\x0c\x66\x67\x32\xa9\xa6\x42\xb2\x43\xa8\xd0\x58\x69\x43\x45\xd0\x3a\x6d\xc4\x1e\xa3\xc0\xa9\x98\x49\x52\xc2\x65\x6b\xe3\xfb\x17\xe4\xf4\x46\x6d\x8e\xef\xf6\xf8\xee\x01\xe7\x62\xc1\x5a\x77\x93\xbd\x31\xb6\x9c\x92\xaa\xf1\x26\xff\x4c\xa7\xf2\x69\x0d\xff\x49\x41\xe9\x0f\x02\x3c\xb5\xf9\x3f\x09\x76\x74\x28\xe0\x29\x83\x00\x93\x90\xff\xc4\x01\x81\x27\x29\x54\xa2\x53\x5c\xc4\xba\x9b\x7a\xbf\xf7\xe5\xef\x7f\xbf\xbd\xfd\x14\xb3\x10\x7a\xbd\x6d\x7f\x50\x80\x59\xdb\x35\xbc\x02\x99\x4d\x52\x22\x14\xa6\xa6\x8c\x40\x27\xd6\xfc\xfe\xbb\xd9\x53\x8d\xc5\x9c\x2d\xea\x4e\xc8\xc2\x2e\x3a\xd9\xdb\x47\x87\x8a\x52\xd5\x54\x38\x01\xc2\x5a\x9d\x48\x0e\x8f\x1b\xf5\x35\x9c\x53\x6d\x7d\x49\x66\x46\x26\x39\xf3\x22\xc9\xf0\xb2\x0d\xd2\x59\xd0\x0f\x8a\x1b\xe7\xfe\x0b\x1e\xad\xa3\x20\x74\x12\x62\x53\x8b\x88\x5e\x86\x31\x6c\x92\xfe\xd1\x76\x63\xa2\x29\x4e\x2e\xa7\x4d\xac\xe6\xe8\x23\xe8\x19\x3e\x26\x58\x85\x82\x91\x47\xba\xc9\x0a\xc2\x71\x88\xd6\x53\xbc\x3a\xed\x73\x00\x12\x51\xdb\xb9\xbf\x8f\xf4\xcd\xaf\xf9\x15\x6f\x8b\x41\xaa\xa4\xa8\x53\xb4\x50\x92\xe6\x04\x02\x76\xb8\x34\x03\x18\x5a\x0e\xde\xb0\x64\x86\xdc\x38\xf3\xd6\x78\x0b\x1f\xb4\x63\x8f\xdb\xfd\x2b\x47\x83\xb3\x40\x43\x69\x23\xe0\x82\x69\x38\xe2\x76\xe6\xa5\x19\xbf\xfd\x14\x5a\x76\x2a\x22\xa7\x1e\x3a\x4e\xb1\x6e\xf3\x58\x7a\x0a\x6e\xbd\x3f\xe8\x41\xa9\x08\x1f\x23\x3d\x09\x37\xb8\x72\x34\x46\x9b\x35\x85\x38\xe4\x71\x59\x6a\x48\xdc\xff\xe3\xe6\xe3\x07\xb0\x68\x38\x22\x10\x53\x6e\x65\x90\x96\xb7\x8c\x86\x94\x98\xa2\x52\xa8\xb4\x75\x99\x7c\x84\x38\xa3\xc1\x66\x46\xb1\x09\x5a\x00\x51\x38\x6f\x3e\xc9\xe6\x5e\x91\x20\xf9\x68\xfa\x77\x34\x7a\x23\x55\x8a\x8f\x94\x5f\xc1\x0f\x44\x91\xd3\x1c\x8f\xbe\xae\x42\x61\xbc\x1c\x72\xfd\x8c\x1b\x63\x92\x73\x18\x2f\xab\x3a\x0b\xb2\x00\x69\x8d\x4b\x08\xa9\x3d\x4f\x2c\x65\x56\xe4\xc1\xcb\xba\x70\xb2\x2a\xd0\x53\x97\xf2\x95\x60\x01\x38\x51\x78\xeb\x7b\x45\xf6\x72\x01\xe8\x2f\x00\x5f\xce\x88\x33\x5f\xce\x60\x13\x9a\x72\xc4\xfd\xe6\xc7\x50\xed\x0a\xd9\xd2\x02\x88\x8d\xc0\x10\x64\x16\xe8\x7f\xbe\xfa\x75\x3b\xf3\x89\x05\x30\x03\x12\x99\x34\xd6\x05\x1a\x86\x82\xb7\x8a\x1f\xf9\x72\x76\x1a\xd0\x49\x2f\xd7\xc9\xe7\xd0\x5a\xb1\xc7\x27\xaa\xcf\x15\xe4\x75\x29\xd4\xc6\xa0\x48\xb9\x95\xda\xf9\x6b\x33\xe1\x43\x9c\x5f\x72\x66\xff\x38\x73\x78\x0b\x5d\x4f\x10\xea\x9b\xed\xb4\x86\xb0\x9b\x19\xef\xd0\xb7\xe9\x64\xaf\x53\x34\xdb\xe7\x24\x96\x77\x01\x4f\xa6\x55\x29\x92\x5c\x2a\x9c\xa3\xd6\x82\x43\x31\x3d\x8f\xa8\x15\x0b\xb2\xbe\x6e\x1b\x33\x70\x7a\xc2\x2c\x01\xc9\x0e\x93\xa3\x2f\x8a\x31\x08\x1b\x71\x2f\x64\x41\x38\x3e\x23\xdd\x4e\x24\x1a\x4b\x12\x8e\xa6\x58\xc8\x13\xc2\x4f\xf1\x9d\xfc\x46\x6b\xfd\x06\xd6\xfe\xa9\x8e\xd3\x87\x74\x3d\x0f\xb9\x5d\x7d\x23\x91\x8e\x87\x55\xe7\x53\x06\x3a\x15\xbd\xf1\x07\x1f\x0a\x3e\x2a\x5f\x59\x6c\x07\xae\x7c\x28\xc7\x3d\x94\x59\xb8\x9d\x5e\x5e\x1c\x11\x69\x50\xfb\x51\xaa\xf4\x4f\x1a\x58\xfd\x2a\x5e\xcc\x17\x05\xa6\x86\x1a\xff\x50\x56\xc0\x8b\x30\x68\x87\x06\xc3\xd4\xb2\x54\xfb\x02\xa7\x53\xfb\x76\x30\x54\x26\x39\xe7\xb7\xbb\x68\x74\x76\x98\xbe\xfc\x66\x81\xe5\x36\x06\xf7\x20\x26\xe6\xc4\xe6\x4a\x02\x4d\x37\x62\xdd\x6d\x7b\x34\x33\x64\x6d\x97\x78\xf6\x68\x8d\x54\x76\x26\x64\xfd\xcc\x6d\xba\x85\x1b\x92\x5b\x1f\x32\x84\x49\x6c\xdf\x55\x99\x37\x53\x6d\xb7\x86\x8b\x75\x4e\xdc\x85\x6a\x23\x67\xbb\x0e\x41\x24\xfc\xc1\x4d\x48\xf0\xb4\x8d\x1f\x59\x9d\x8c\x0e\x5b\x87\x16\x71\x81\x5c\x3f\xf8\x21\x21\xa7\xe1\x41\x48\xd7\x9c\x5c\xdc\x9d\xb4\xa8\x39\x0e\xd0\x9a\x63\xea\x92\x1c\x72\x59\x1e\xc9\x15\x75\xf9\x04\x6b\xf5\xf3\xbb\x37\xc7\x3a\xb1\x9d\x12\xe8\xd5\xa2\x70\x6b\x4a\xa8\x9f\x3c\xee\xdc\x8e\x0f\xd8\xbf\xd4\xf2\x9b\x6d\xc7\x49\x37\x37\x67\xe6\x9f\x61\x3f\x61\xb5\xa0\xd2\xf8\x55\xbb\x0a\xab\x05\x1a\xf3\x55\x7b\x0b\x93\x80\xff\x74\xf7\x70\x92\xbd\x27\xc2\xe4\x27\x07\xc7\xc1\xcc\x9f\x2a\xeb\x35\x56\x6e\xfb\xf5\x88\x0f\x17\x34\xa6\x05\xef\xc6\x09\x95\x0a\x93\xfa\x46\x46\xbb\xa0\xf0\xa7\x33\x64\x51\x25\x45\x93\x26\xd4\xcb\xdd\x75\x7c\xa1\xbb\xc6\x21\xb3\x66\x76\xd5\x8f\xf8\x43\x21\x4b\x39\x9f\xff\x85\x2c\x4d\x35\xf3\xcf\x9c\x98\x35\x75\xa8\x30\x03\x1b\xec\x7c\x68\x14\x9c\xf2\x67\x61\x18\x22\x17\xb1\xb0\xc3\xb5\xb7\x26\x1a\xe7\x50\xa3\x89\xf2\x75\x25\x7e\xab\x71\x74\xf4\xaf\x3f\xd6\xc1\xc7\x8c\xdb\x12\xd2\x5a\x7e\x49\x87\xb1\x89\x30\x54\xa9\x8f\x17\x93\x84\x3b\x8d\x69\xda\xe9\x3c\x3a\xdd\x6c\xbb\x78\xba\xe0\x63\xd3\x6d\x6c\x4e\x30\x4f\xd0\xd8\x15\xbd\xf6\x1c\xf2\x1d\x7d\x6e\x1c\x59\x47\xe6\xc5\x8b\x63\xdb\x53\xac\xb4\x1d\x9f\xfc\xed\x65\x37\x59\x77\xcc\x24\xd1\x2a\x93\xfb\x3a\x04\x0d\x5c\xdf\xc9\x85\xda\xfb\x69\x91\xb6\x86\x21\xe6\x23\x5b\x7c\x80\x52\xaa\x9a\xd8\xca\xdd\xef\x76\x52\xa8\xf5\x6f\xb1\xa0\xef\x7d\x7e\x94\x8a\x13\x81\x1a\x2a\xa8\xad\xb7\xeb\xbe\x67\xe6\x25\xb5\x33\x7c\xb4\xc3\x30\xf0\x96\x34\x53\xa8\xb3\x30\x83\xb4\x74\x2b\x0a\xa1\x55\x85\x6b\xa8\x55\x81\xd6\xc2\x41\xd7\xfe\x1c\x06\x13\x94\xf7\x27\xb0\xf4\x13\x9d\xfa\x0e\x95\x77\x12\x42\xf9\xf8\x27\x5a\xc7\x67\x88\x2b\x7b\x14\x5c\x1e\x65\xdc\xb8\xb6\xe1\xd3\xb8\x75\xdb\x61\xff\xf9\xb9\x6d\xda\x16\xf3\x54\x0b\xcc\x8b\x1b\x96\x71\x83\x81\x20\x87\x98\x23\x0e\xc0\xc5\xfe\xd1\xc8\x40\xd5\x51\xe9\x3d\xcc\xad\x32\x97\x83\xac\x7b\xb2\x07\x11\xdc\xc2\x2f\x7e\x48\x3b\xcc\x4b\x3a\xdf\xf7\x9f\x05\x2b\x1a\x33\xd0\x41\x85\xeb\x84\x2c\x92\x50\xab\xa6\xf1\xbe\x13\xc9\xdd\x12\x89\x89\x93\x5e\x4b\x56\x5c\x5a\x8f\x30\x0b\xf2\x19\xbc\x45\xa2\x95\x2f\xca\x25\x87\x4d\x18\x82\xd9\x08\x95\x6e\x1a\xf3\x90\x1c\xbe\x39\xeb\xb3\x58\x64\xef\xa5\xba\x5b\x2c\x71\xf1\x05\x1f\xa5\xfd\xfc\xf9\xfd\x71\x70\xb6\xa0\xb9\xbb\x70\x9b\xe8\x0f\x8e\x4a\xe7\x6b\x5a\x4f\xac\x64\x3d\xe4\x61\x34\xa4\x09\x5c\x56\x33\xb5\xa7\x60\x9b\xce\x42\x37\xf8\x2c\x44\x45\xf3\x65\xad\xb9\xfe\xd0\x64\x31\x0b\xae\xe2\x1c\x60\x52\x08\xe3\x8d\x83\x50\xbe\x73\xe7\x3f\x3a\x13\x65\xa4\x08\xbb\xda\x41\xaa\xd1\xf7\x97\xf4\x3d\x1a\x23\x53\x04\xe9\xbe\x3a\x2c\xf3\x1f\x5d\x1c\x94\x35\xb1\x62\xa7\x1c\xb3\x85\x8f\x0a\x41\x67\x97\x70\x76\x53\x27\x09\x5a\x7b\x36\x36\xb0\x13\xff\x35\x54\x7e\xee\x68\x8e\xf2\x79\x56\x48\x7f\xa6\xaf\x0c\xb1\x67\xe4\x74\x7a\xc6\x61\x33\x31\xff\x32\x09\xac\x10\x3b\x2c\xfe\xe8\xed\xe3\x9f\x04\x8f\x87\x87\xd4\xed\x0e\x0f\xde\x2e\xfb\x8e\xf7\xd0\x93\x38\x0d\xda\xec\x85\x92\xbf\xe3\xe8\x14\x29\x05\x91\x7b\x6d\xe4\xef\x08\x2f\xf8\x52\x03\xbf\x17\x8c\x05\x26\xee\x65\x67\xd9\x57\x1c\xa0\xe4\x31\x36\xff\x27\x6d\xec\xd8\xfc\xa3\xc1\xaa\xe0\x51\x57\xd2\x85\x66\xa4\xd0\x06\x98\xe6\x5e\x26\xf8\x15\x9b\xc3\x9e\xae\x8b\x97\x86\x4b\xa1\xc4\x1e\x53\xdf\x6d\x9a\x1f\x85\xfc\xa9\xfb\x28\x94\xa2\xb2\xf0\xa0\xcd\x5d\x56\xe8\x87\x8d\xf4\xe3\x5f\xd1\x65\x87\x48\x76\x6c\xb9\x54\x67\xb1\xb1\xe4\x77\x88\x0c\x46\x1c\xbc\xdd\x15\xae\x81\x1a\x7a\xd1\x92\xe2\x70\xeb\x8a\x43\x98\xe9\x99\x08\x1d\x72\x5d\x5b\xbc\x43\xac\xa4\xda\xfb\xb8\xdf\x4f\xd0\xb9\x43\x45\x71\x5a\x71\x08\xe5\x29\x75\xee\x40\x85\x8e\x74\xd8\xbe\xaa\x55\x8a\xc6\xba\xb1\x20\xbe\x2d\x19\x91\xe5\x8a\x98\x45\xa9\x89\xf9\xca\xb9\x6f\x35\xae\x7b\xc3\xa1\xf1\xc7\x21\x09\x4c\x3b\xdf\x4e\x81\x79\x3b\x30\x2b\xaa\xaa\x38\x40\x25\x5c\x0e\x85\xbc\x43\xf8\x72\x96\xc8\x4d\x92\x7e\x39\xf3\x61\x6d\x88\xe4\x3d\xfd\xc6\x36\x1d\x44\xf1\x20\x0e\x8d\x35\x6f\xb8\x11\xb2\x9e\x16\x7d\x96\xf6\xa3\x5d\xf5\xb1\x90\x24\x8e\xad\x7c\x51\xc7\xb3\xa9\x3c\xf7\xe7\x75\x82\x29\xd1\x89\xe0\xe3\xac\xdf\x83\x74\xf9\xd8\x84\xb7\xd2\x4e\x26\x38\x98\x00\x9c\x68\x44\xcf\xa7\x9f\xa7\x86\x7c\xfa\x4e\x73\x76\xc2\xa7\x73\x93\x47\xa7\xfd\xbc\x3a\x59\x49\xe4\x54\x95\x47\xbe\xe3\xa6\x3c\x86\x2a\x1f\x11\xea\x8c\xbb\x1e\x17\xe1\x1b\x67\xf0\xaf\xda\x4e\xc1\x64\x8e\x73\x1d\x56\x57\x9b\x82\x6c\x7c\x17\xe3\x20\x83\x61\x95\x1b\xc9\xc9\x08\x73\x60\x4d\x33\x22\xb9\x5b\x4d\xd7\x85\x3a\xe7\x13\x1d\x9c\x77\xe8\xdb\x58\x92\x6d\x60\xc8\xe6\xc2\xbe\x97\x57\x98\xd5\x64\xb2\x7f\x8f\xc6\x8d\xcd\xb0\x2f\xf0\x2e\xd9\xa8\xa5\x99\xb1\xfe\xe0\xcc\x44\xc7\xba\xc7\xdc\x60\x96\x3a\x29\x87\xe8\xeb\xcb\xf6\x6b\x46\xef\xbc\x69\x32\x0b\x84\xcb\x5b\x47\x33\xdc\x87\x0a\xc1\x42\xa3\x7b\x0c\x72\x26\x4a\xcc\xd1\xe2\x02\x94\x27\x09\xdc\x44\x25\x0b\x90\xfe\xd8\x94\xee\xc3\xad\x3a\x04\x9b\x30\x6e\x6b\xfa\xbe\xc6\x5b\xa0\x48\xa7\xb3\x2b\xd6\x86\x9e\x7b\x78\xcb\xad\xf2\x1d\x92\x61\x69\xae\x21\x20\xcd\xe0\xa5\x08\xde\xb2\x09\x5e\x78\x7a\xd6\xaa\xab\x64\xe4\x26\xce\xaf\xc8\x3a\x9e\xb3\xd5\x39\xff\x99\xcb\x98\xe7\x5f\x45\x21\x27\xa7\x1a\x4b\xfd\x96\x92\xf4\x7b\x1b\xae\xbb\x69\x16\xcb\xe5\x0d\x8f\xe0\x81\x22\xe1\x99\xa9\xb1\x77\xcd\xca\x49\xb0\xce\xcd\x16\x9e\xcc\xfa\x0c\x08\x07\x5c\xcd\xf5\x0d\xa6\xf6\x03\x17\x1c\x7c\x46\xd4\xa7\x1a\xbe\xea\xd4\x9a\xda\x39\x2f\xb7\xc4\x64\x39\xac\xeb\x90\xe1\x97\x0a\x44\x7b\xd7\xc7\x16\xde\xd9\x76\xed\x69\xf4\x9e\x00\xbf\x0a\xe1\x87\xa0\xfd\xe0\xe0\xba\xdd\x72\xe6\xee\x67\x7b\xad\x48\x29\x0e\xfe\x82\x83\x66\x65\x7d\x4c\x36\xdb\x5d\x65\xec\x6f\xa2\x70\x2b\xa9\x22\xc7\x62\xa4\x70\xb1\x6f\xd8\xb5\x7c\xdb\xf1\x85\x2f\x69\xa1\x32\xb2\x14\x46\xf2\x3a\x44\x98\x9c\x23\x51\x6d\x16\x39\xda\xbd\x1b\x1f\x1c\xf6\x6b\x5d\x69\x73\x59\xd7\x50\x5a\x46\x4a\xf4\xdf\xd2\x46\x61\xda\x9f\x2f\x5d\xfd\x69\x38\x35\x1f\x02\x7e\x68\x2e\x6f\xe9\x3a\x50\xff\x4b\xe0\x3a\x8a\x24\xf7\x14\xed\x4b\xc5\xf0\xc0\x57\x2a\xe8\x41\xe7\x4a\x18\x0b\x24\x24\xf7\xa2\xf0\x3c\x65\xf0\x5f\xce\x52\xcc\x44\x5d\xb8\x2f\x67\xed\xa3\x6b\x4a\x04\x07\x20\xbb\x8f\x06\x8b\x96\x08\xa5\x15\x17\xea\xfa\x83\xb9\xed\x88\x5d\x2c\x03\x91\x8d\x89\x32\x3a\xb6\x46\xb9\x43\x7f\x93\x59\xea\x87\x5a\x5a\xac\x37\x9d\x85\x3d\xdd\xdb\xcb\x6b\xbb\x93\xe1\x23\xab\xa9\x81\xea\x70\x5b\xc1\x17\xd5\x6c\xea\x0a\x78\xf3\xe1\xe6\xbf\xdf\x5f\xfd\xdb\xdb\xf7\xdb\x79\xe1\x58\x8d\x95\x7a\x4e\x0a\x4b\x83\xbf\x5d\xbc\x20\xa6\x1f\x14\x9a\xcf\xc8\x8b\x9b\x09\xce\xa7\x0b\xef\xc3\xfe\x45\xa4\x6e\x8a\x95\x57\x97\xdd\x61\xb0\x97\x74\xf5\xfe\xfd\x24\x81\x42\x2c\xcb\x65\x67\x2e\xd4\xf1\x5a\x52\x33\x61\xde\xbb\xf3\x26\xd0\x72\x2f\xcc\x4e\xec\x11\x12\x0a\xc3\x13\x37\xb7\xbd\xda\xee\x46\x74\x92\x90\x6e\x10\xbf\xf6\x13\xed\x42\xb5\xd3\x5f\x4d\xb9\x7d\x9c\x99\xa1\x76\xaf\xdb\xf2\x71\x84\xd4\x4c\x16\x74\x16\xc8\xda\x78\x8c\x23\xb9\x31\x3d\xb9\xe5\x5a\x4b\x1b\xa3\x75\xa7\xfc\xb0\x09\x27\x3a\x40\xb7\xff\x1b\x91\x75\x3f\x8c\x26\x4d\xf2\xfb\xbd\x5f\xe5\xa1\xf9\xa6\x8d\x8f\x24\x6d\xf1\x2a\x96\x05\x48\x10\x4f\x4d\x8d\x6b\xb8\xfa\xf0\x26\x76\x1c\x58\x62\x9b\x15\xdf\xb3\x4c\x1b\xa4\x80\x5c\xa5\x11\xee\xd4\x04\x5f\xb3\x56\x1f\x04\xa0\x05\xd6\x32\x62\xb0\x30\x7f\x87\x87\x0d\x9b\x81\x09\xa0\xfe\x4e\x32\xbe\x7d\x21\xa6\x1a\x41\x97\x3a\x5b\x41\x5b\x78\xe3\x6d\x18\xcf\xfa\x67\xa2\xb0\xb8\x85\xdb\xa9\xd0\xab\xb9\x57\x29\x2e\x23\xfb\xfe\x19\x25\xb8\x16\xce\x3c\x86\x67\x50\xa1\x29\xa5\xed\xb2\x87\xcf\xb2\x9e\x00\xaa\xe3\x72\x1f\xfc\xf5\xbb\xef\xe0\xc5\xcf\x2a\x2c\xda\x70\x9d\xf1\xad\x72\xd2\x1d\x5e\x76\xee\x05\xf2\x5d\x95\x39\x46\xef\xb4\x2e\x50\x8c\x55\x20\x5b\xa9\x7d\x0a\x87\x8f\x88\xc7\x2a\xd7\xac\x46\x2c\xd0\x88\x65\xb8\x4d\x4f\x09\x8c\xcc\x08\x1c\x8b\xfd\x9f\xdd\xa8\x3d\xa1\x51\xd3\xc3\x54\x23\xf1\xdc\xa9\xb3\x7c\x7b\x20\xb2\x08\xe7\xc9\xe9\x96\x99\xb9\x96\xe7\xc0\x78\x7a\x02\x65\x16\xe1\xb9\x05\xb0\x4d\xc7\x9e\x8e\xfe\x99\x38\x3b\xfa\x87\x89\xc9\xb2\x0d\x51\xe7\x39\x42\xfc\x13\x8d\xbe\xc1\x36\x74\xe8\x74\xf9\x68\x87\x2b\x4b\xed\x20\x4b\xd8\x58\x8c\x2b\x49\x8d\x43\x58\xcd\xcd\xc1\xcc\xf7\xf3\x26\x7a\x76\x23\x0b\xcb\xdd\x1e\xde\x4f\x9d\x76\x3b\xc5\x60\xba\x72\xb2\x94\xd6\xc9\x04\x3a\x3d\xac\x75\x78\x81\xbf\xc1\x93\x5b\xd3\x97\x07\xf8\xb5\xe4\x36\x2d\xd6\xaa\x7b\x23\xa5\x36\xb1\xd6\xd0\x24\x29\xcd\x95\x78\xc3\xbd\x5d\x1e\x69\xa3\x84\x21\x24\x92\xa1\x1c\x2d\xba\xd3\x04\x4f\xec\x1d\xc6\x7e\x21\x5f\x5f\x59\x76\xee\x54\xf3\xa9\x36\xd1\x40\xf8\x4b\x83\x92\xba\x10\x66\x04\xf3\xc9\xab\xcb\xec\xdc\xfd\x3a\xbd\x46\xe4\xb2\xce\xe9\x64\xb7\xf4\xb9\x4d\xe6\x82\x6e\xe5\xe2\xc8\x77\xaa\x2b\xd9\xdf\x43\x5b\xde\x89\xec\xd1\x73\x74\x57\xfc\x64\xf7\x71\x12\xd7\x11\xb3\xd9\xd7\x62\x32\x98\x21\x3b\x0a\x19\xbb\x54\xe1\x12\x0d\x95\x86\x6c\xce\xeb\xf7\xd1\xed\x81\x23\x71\x34\xc7\xce\x6d\x89\xbd\xbd\x63\xa4\x7f\x9b\x9d\x56\x60\x7d\x67\x2c\xab\x8b\x36\x5b\x1e\x11\xbb\x8e\x56\x75\xee\xaf\x8b\xd7\x19\x3a\x1d\x75\x56\x2b\xf8\xf4\xf3\x6d\xef\x0e\xca\xae\x98\x8e\xad\xb6\x9f\xec\x9f\x7f\x9d\xab\x58\x28\x44\xa3\xb6\xb9\x44\x9b\x5f\x9e\xba\xd9\x37\x5e\xca\x3d\x0b\x69\xd8\xc6\x1c\x79\xec\xe8\xa7\x60\xa1\xc3\x5b\x9b\x70\x35\xf8\xfd\x6b\xae\xee\xbf\x5e\x75\x87\x8c\x7a\x65\xd8\xb0\xf0\x1b\x7e\x6b\xbf\x2c\x92\x04\x2b\x87\xe9\x87\xe3\xcb\xc2\xc3\xa2\x4c\xbc\x21\x9c\xff\x9b\x68\xe5\xab\xbd\xf6\x12\xfe\xf9\xeb\x2a\x44\xc7\xe9\x2f\x11\x27\xf8\xe7\xaf\xff\x13\x00\x00\xff\xff\x8b\x9f\x00\x1f\x19\x5d\x00\x00"),
 		},
+		"/crds/role.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "role.yaml",
+			modTime:          time.Date(2026, 10, 19, 6, 33, 2, 721420523, time.UTC),
+			uncompressedSize: 23663,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x3c\x6b\x73\xdb\x48\x72\xdf\xf5\x2b\xba\x74\x1f\x64\x57\x91\x94\xbd\xbe\x4b\xe5\xf4\x4d\x91\xed\x8d\xb2\x7e\x95\x25\x6f\x2a\x15\xa7\x52\x43\xa0\x49\xce\x09\x98\xc1\xce\x0c\x24\x73\x7f\x7d\xaa\x7b\x1e\x00\x88\x07\x21\x5b\xbb\x17\x7f\xb2\x40\xa0\xd1\xd3\xef\x27\x44\x25\x7f\x45\x63\xa5\x56\x17\x20\x2a\x89\xdf\x1c\x2a\xfa\xcb\xae\xee\xfe\xd5\xae\xa4\x3e\xbf\x7f\xb9\x46\x27\x5e\x9e\xdc\x49\x95\x5f\xc0\x55\x6d\x9d\x2e\x3f\xa3\xd5\xb5\xc9\xf0\x35\x6e\xa4\x92\x4e\x6a\x75\x52\xa2\x13\xb9\x70\xe2\xe2\x04\x20\x33\x28\xe8\xe2\xad\x2c\xd1\x3a\x51\x56\x17\xa0\xea\xa2\x38\x01\x50\xa2\xc4\x0b\x30\xba\x40\xbb\xba\xab\x4b\xb1\x92\xfa\xc4\x56\x98\xd1\x53\x5b\xa3\xeb\xea\x02\xe2\x65\x7f\xb3\xa5\x5f\x00\xfc\xcb\x3f\xeb\x02\xf9\xcf\xaa\xa8\x8d\x28\x02\xa0\x13\x00\x9b\xe9\x0a\x2f\xe0\xaa\xa8\xad\x43\x73\x02\x70\x2f\x0a\x99\x33\x0e\xfe\x79\x5d\xa1\xba\xfc\x74\xfd\xeb\xab\x9b\x6c\x87\xa5\xf0\x17\x01\x72\xb4\x99\x91\x15\xdf\xc7\xd0\x41\x5a\x70\x3b\x04\x7f\x1b\x6c\xb4\xe1\x3f\xe9\x3d\x70\xf9\xe9\x3a\x3c\x56\x19\x5d\xa1\x71\x32\x62\x07\x00\xd0\xa2\x63\xba\x76\xf0\x82\x33\xc2\xc0\xdf\x03\x39\x51\x0e\xfd\xcb\xee\xfd\x35\xcc\xc1\xfa\xd7\xea\x0d\xb8\x9d\xb4\x60\xb0\x32\x68\x51\x39\x3e\x49\x0b\x2c\xd0\x2d\x42\x81\x5e\xff\x03\x33\xb7\x82\x1b\x34\x04\x04\xec\x4e\xd7\x45\x0e\x99\x56\xf7\x68\x1c\x18\xcc\xf4\x56\xc9\xdf\x13\x64\x0b\x4e\xf3\x2b\x0b\xe1\xd0\xba\x0e\x44\xa9\x1c\x1a\x25\x0a\xa2\x5d\x8d\x0b\x10\x2a\x87\x52\xec\xc1\x20\xbd\x03\x6a\xd5\x82\xc6\xb7\xd8\x15\xbc\xd7\x06\x41\xaa\x8d\xbe\x80\x9d\x73\x95\xbd\x38\x3f\xdf\x4a\x17\x25\x27\xd3\x65\x59\x2b\xe9\xf6\xe7\x99\x56\xce\xc8\x75\xed\xb4\xb1\xe7\x39\xde\x63\x71\x2e\x2a\xb9\x64\x3c\x95\x63\x69\x2b\xf3\xbf\x98\x20\x55\xf6\xac\x85\x98\xdb\x13\x67\xad\x33\x52\x6d\xd3\x65\x16\x87\x51\x32\xff\x22\x55\x0e\xd2\x82\x08\x8f\x79\x74\x1b\x6a\xd2\x25\x22\xc2\xe7\x37\x37\xb7\x10\x5f\xca\x14\xef\x92\x98\x89\xdb\x3c\x66\x1b\x3a\x13\x5d\xa4\xda\xa0\xe1\xa7\x60\x63\x74\xc9\x10\x51\xe5\x95\x96\xca\xf1\x1f\x59\x21\x51\x75\x69\x6c\xeb\x75\x29\x1d\x31\xf6\xb7\x1a\xad\x23\x76\xac\xe0\x4a\x28\xa5\x1d\xac\x11\xea\x2a\x17\x0e\xf3\x15\x5c\x2b\xb8\x12\x25\x16\x57\xc2\xe2\x53\x53\x99\x08\x6a\x97\x44\xc1\xe3\x74\x6e\x2b\x35\xc0\xb8\xf0\x03\x00\xf0\x29\x58\x50\x0f\x7e\x00\x10\x79\xce\x46\x42\x14\x9f\x46\x1e\x1e\xc5\x60\x50\x8d\x9a\x37\x31\x9b\x15\xd4\xca\x3a\x53\x67\xae\x36\x98\xc3\x1d\xee\x03\xc7\x4b\x51\x81\x75\x9a\x2e\x3e\x48\xb7\xeb\xbd\x51\xb4\xb9\x2f\x1c\xb3\x75\x8d\x60\xd1\xc1\x7a\x0f\xf8\x2d\x28\x84\xd3\xba\x20\x56\x79\x58\xac\x18\x06\x9d\x91\x78\x8f\x7d\x90\x66\x2d\x9d\x11\x66\x9f\x68\xb7\x82\xdb\x1d\xee\x41\x18\x04\x62\xf3\x6f\x35\x9a\xbd\x58\x17\x1e\x4e\x50\xd8\x35\x02\x0b\x99\xb9\xc7\xbc\x07\xf2\x61\x87\x0a\x4a\x9d\xcb\xcd\x9e\x24\xd7\x8b\x65\x5f\xf9\x2e\xce\xcf\xef\xea\x35\x1a\x85\x0e\x59\x30\x72\x9d\xd9\xf3\xda\xa2\x59\x6e\x6b\x99\xe3\x79\x8b\x41\x67\x27\x43\xa4\xf7\x90\x3b\x3f\x65\xde\xa2\x7e\x20\xb3\x3d\xc5\x93\xdb\x1d\xb2\xb9\xf6\xa6\x0b\xe3\x73\xf0\xb0\x93\xd9\x8e\xaf\x78\xe0\xb0\xc6\x42\xab\xad\x17\xfc\xdb\x43\x8d\x03\x00\x90\x16\x6a\x8b\x39\x38\x0d\xb9\xb4\xa4\xab\xb5\xb4\xbb\xc4\x28\xcb\x9c\x04\x2b\xca\xf0\x42\xa2\x22\xfd\xc7\x56\x22\x23\x72\x40\x2e\x37\x1b\x34\x87\x9a\xd7\x3a\x8c\xf5\x6f\x86\x8d\xc4\x82\xed\x04\xb1\xc5\xa2\x03\xa1\xf6\x0f\x3b\x34\x08\x46\x6e\x77\x0e\x94\x7e\x60\xe8\xa2\x92\xcc\x19\x03\x03\xe8\x6e\x35\x5b\x13\x0d\x72\xab\x98\x1f\x0e\xe4\x86\xa1\x49\xe5\xfd\x20\x82\x36\x41\xb3\xa3\xde\xaf\x4e\x66\x4a\x7e\xdf\x91\x4e\x31\xe1\xf4\xea\xf0\x76\x56\x0f\x70\xe9\xcf\x9e\x09\xf4\x07\xeb\xab\xa2\x2c\xd1\xcb\x1d\xdb\xb7\xc0\xbb\x07\x61\xc3\x91\xc8\x44\xb9\x48\xba\x6d\x2d\x8c\x50\x0e\x3d\xd3\xbc\xfe\xf4\xd9\xaa\x60\x27\xaa\x0a\x95\x5d\xae\x71\x43\x94\xd2\x26\x47\x03\x22\x33\xda\x5a\xb0\x58\x09\xc3\xb4\xaa\xd0\x78\x19\x5d\xc1\x15\x1b\x50\x6f\x6d\x95\xee\xc3\xb4\xe8\x3c\x7e\xac\xed\x11\xa5\x74\x46\xcc\x41\x2a\xf8\xfc\xf6\xea\xd5\xab\x57\x7f\x27\x57\x5e\x32\x3b\xa5\xa5\xcb\x5f\x6e\xaf\x56\xf0\x55\xf5\x60\x7e\xd2\x55\x4d\xce\x31\x87\xf5\xde\x53\x68\x6f\x1d\x96\x2b\xf8\x8c\x22\x5f\x6a\x55\xec\x57\xf0\xa1\x2e\x0a\x82\x07\x85\xb4\xee\xc9\xbd\x60\xb4\x1b\xa7\x07\xb8\xd1\x01\x84\xbb\x00\x12\xa4\x25\x31\x68\xae\x10\xe5\x58\x20\x41\xff\xd9\x88\x0c\x3f\xa1\x91\x3a\xbf\xc1\x4c\xab\xdc\x4e\x4a\xd3\x87\xba\x5c\xa3\x01\x4d\xd2\xcc\x77\x83\x28\x0a\xfd\x80\x79\x88\x8a\x1a\xb9\x70\x1a\xb6\x04\x7b\x53\x17\xc5\xbe\x2f\x4b\x68\x4a\xa9\x84\x43\x08\x8c\x97\x0e\x1e\x64\x51\xc0\x1a\xc1\x60\xa9\xef\x31\x6f\x1c\x68\xa4\xf6\x47\x55\xec\x99\xbf\x24\x84\x3d\x90\xf1\x44\x5d\x39\x2f\xac\xa6\x47\x56\xf0\x5e\xec\x81\x38\xc5\xb2\xb8\xd3\xc6\xa1\xc2\xbc\xcd\xc1\x11\xca\x4a\xe5\xfe\xe5\xaf\x83\x54\xa5\xd8\x68\x7b\xa0\x27\x3d\x24\xa6\x75\xf3\xf5\x10\xce\x9f\xdf\x5e\x01\x4b\x27\x31\x95\xa5\x93\x18\x0b\xc2\x25\xc3\x39\x60\x72\x92\xcf\x8a\x54\x64\x4c\x30\x3f\x34\x6b\xc1\x8d\x35\x6a\xce\xc4\x04\x91\x98\x35\x4a\x57\xaf\x46\x6c\xaa\x1a\x45\x20\x4f\xb2\x88\x1a\xa4\xb4\x83\x5c\x1a\xcc\x9c\xe7\x93\x63\x8f\xb6\xee\x73\x5f\x84\x30\x88\xbd\x60\x83\xba\xb4\x80\xdf\x2a\xcc\x5c\x32\x1a\xe1\x10\xf0\x4c\x69\x20\x17\x81\x06\xee\xa5\x95\xeb\xa2\xef\x63\x59\x5a\x12\x28\x56\x42\x8f\x18\x61\x65\x50\x64\xbb\x80\x0d\x3b\x86\xe7\x20\x36\x0e\x7d\x1c\xcf\xd4\x95\x7d\x81\x72\x89\x70\x0b\xd0\x8a\xc3\x01\x84\x8d\x54\xa2\x90\xbf\xa3\xb1\xfc\x0e\xc6\xb9\xac\xdc\x7e\x05\x97\x96\x51\x04\x61\x0f\x6e\xec\x01\xe6\x07\x49\xef\x85\x54\x16\xa4\xc3\xd2\x2e\x3a\x64\x5e\x17\x3a\xbb\x23\xde\x7d\x8c\xaf\xed\xc9\xd5\x90\x8b\xb4\xe8\x16\x2d\xdb\x17\x4d\x24\x07\x91\xca\xa2\x03\x6d\x82\x25\x86\x4d\x6d\xdc\x0e\x0d\x48\x15\x62\xff\x4d\x4d\x71\xd2\xa2\xcf\xaa\xc2\xed\x74\xbd\xdd\x81\x6c\x22\xa1\xa8\x3d\x10\x13\xa1\x48\xf5\x70\x43\xe4\x5a\x65\xa4\x1e\x70\x23\xda\xe3\x48\x64\x5f\xc1\x5b\x6d\x00\xbf\x89\xb2\x2a\x28\xbb\x60\x79\x0a\x09\x06\x4b\x9a\x0f\xc1\x04\x54\x9a\x25\x2c\x40\x1e\x72\x24\xaf\x5e\x44\x93\xe4\xa5\xea\x97\x7a\x4d\x37\x7b\x7d\x20\xfe\xb3\xdc\x5b\x54\x39\xb9\xb9\x46\xde\x93\x29\x3a\x4c\xa6\x00\x00\xac\xdc\xfa\x58\xcf\xc7\x2f\x9e\x65\xc4\x7b\xa9\xf8\x4a\xa5\xf3\x15\x5c\x06\x49\x12\xae\x85\xc4\x02\x5c\x83\x44\x3f\x7a\x23\xa4\x08\x17\x10\xb0\x13\x26\x6f\x23\x11\x5f\xfa\xec\xe6\xfa\xe7\x5f\xae\xdf\xbd\x7b\xde\x7b\x3d\x89\x75\x9f\x51\x8c\x45\x56\xa0\x50\x75\xb5\x08\x46\x34\x22\xd9\xd8\xd2\xcb\x4f\xd7\x9c\x49\xf0\x0f\xec\x12\x33\x8e\xcf\x14\xba\x07\x6d\xee\x7a\x60\x2b\x61\x1c\x87\xe9\x76\xd1\x31\xef\xc4\x23\xeb\xe8\x18\xf8\x4d\x5a\x97\xd4\x29\x30\x96\x65\x74\x01\xb5\x72\xb2\x6f\x51\x84\x02\x91\x97\x52\x49\xeb\x8c\x70\xda\x80\x36\x20\x6a\xa7\x4b\xe1\xa5\x46\x67\x68\x2d\x64\x42\x41\x8e\x9e\x30\xd8\x95\xb3\x01\xfb\xc7\x6e\xa6\x71\x2b\x14\x8b\x6c\x62\x0c\xb7\x68\x98\x9d\xb4\x2c\x84\xa4\xe1\x34\x3b\xd1\x87\xe8\x35\x07\x55\x63\xf4\x28\x36\x18\x8b\x05\x0e\xcd\x68\x7a\xd3\x90\xa2\xb6\x20\x36\xfe\xe7\xff\x7b\xc4\xd0\x18\xb4\x49\x9f\xf6\xbe\xb6\x44\x37\x6f\x15\xa3\x77\x6f\x91\xba\xd1\xe2\x46\x28\x0d\x6e\x49\x16\x7a\x3e\x18\xe0\x8d\xc8\x76\x80\xca\x99\x7d\x48\xea\x64\x4e\x67\xdc\x48\x34\x4d\x1d\x06\x6d\xa5\x15\x7b\x05\xc8\x74\x59\x69\x85\x2a\x18\x0e\xd2\xb3\x01\x57\x99\x54\xc3\x43\x4e\x78\x90\x61\x66\xc1\x19\x34\xb9\x5d\x99\x19\xe2\xab\xd2\x6a\xa9\x64\xb1\x60\xb8\x12\x83\x99\x90\xc1\x55\x90\x40\xc7\x08\x24\xc4\x38\x87\x07\x66\x5f\xf0\xa8\x24\xd8\xff\x24\x8c\x11\x5d\x37\xbb\x45\x45\x31\x33\x1e\x4d\xd2\x4e\x7f\x6e\xdd\x19\x88\xac\x2b\x9f\x98\x43\x65\x70\x23\xbf\x2d\x7c\xf2\xd5\x09\x1b\x16\x43\x76\x3d\xbe\x14\x04\xd4\x4a\xfe\x56\x87\x6c\xec\xe3\x87\x77\xff\x05\xd7\x6f\xf9\x69\x7e\x0b\x3b\x55\x52\xba\x46\xc9\x2a\xa3\xef\x65\xde\xa7\x08\x78\x76\xb4\x43\x18\x42\xc6\x9b\x57\x86\x6e\xd0\xd5\x46\xf9\x90\xa1\xa9\xb0\x34\x71\xd0\x68\xe6\xe7\x76\x42\x35\x60\x2a\x61\x6d\x0a\x97\xbc\xff\x64\x10\x1c\x41\xae\x59\xb2\xd6\x52\x85\xa2\x41\x3a\x60\xdf\x63\xd4\x9b\x8d\xfc\xe6\x5d\x50\x3c\x53\x00\xb7\x0b\x91\x01\xa7\xa9\x4d\x2d\x12\x4c\x5d\xa0\x8d\x61\x03\xd1\xa7\x6f\xdc\x7c\x10\x12\x8b\x6f\x6b\x04\x67\x6a\x95\xb5\xad\x50\x81\x6a\xeb\x76\x51\x44\x3d\x16\x6c\x67\xa4\x61\xd2\xf4\x60\x96\xe2\xce\xeb\x80\x47\xce\x1f\x07\xb4\x6a\xf1\x98\xed\x5d\x8f\xfc\x54\x99\x25\x05\x1c\x70\x41\x2a\xe7\xa7\xa3\x18\xf8\x1c\xdc\x3b\x08\xbb\x68\x01\xf6\x94\xfd\xf0\xf1\x36\x30\x0f\x04\xfc\xf5\xc5\xdf\x61\x39\xe0\xd7\xad\x43\x91\x2f\x52\x7a\x80\x92\xc3\x96\xf0\xd8\x4f\x2f\x5e\xc2\x95\xcf\x3d\x41\x1b\xf8\xdb\x8b\x17\x9e\x3b\x9f\x51\x58\xad\x42\x61\x8e\xf4\x57\xd7\x43\xc9\x67\x2e\x33\xe1\x7c\x34\xd0\x16\xd7\x8c\xab\x2f\x5e\x32\x61\xa3\x6b\x95\x47\x77\xef\xe3\xf0\xa2\xd0\xce\x61\xbe\x18\x3d\x7f\x90\xc0\x50\xc6\x31\x48\x36\xe6\x59\xd4\xa9\x62\xdf\x0f\x3d\x19\x11\xce\x4c\x07\x84\x14\xe1\x33\x41\x58\xfa\x30\x63\x87\x22\x47\xf3\x9c\x59\x73\x59\x55\x85\xc4\xdc\x1b\x15\xb9\x81\xa8\xc1\xec\xf6\x22\x97\xfa\x0a\xf5\xb4\x7e\x46\xe6\x58\x56\xda\xa1\xca\xf6\xa7\x73\x5d\x49\x10\x90\x83\xb2\x78\xcf\x34\x5d\x82\x25\x47\xa9\x32\x04\xe5\xf3\xce\x4e\xa9\x42\xc4\x43\x66\x2d\x80\xa0\x37\x83\x34\xcc\xd1\xb2\x26\x58\x27\x1c\xae\xe6\x64\xf4\x4f\x92\x0f\x72\x23\x64\x8e\xdb\x3c\xbd\x54\xed\x9b\xd9\x10\x73\xc4\x67\x74\x51\xa4\x9a\x19\xaa\x8d\xe6\x7a\x97\xd5\x65\xc4\x79\x40\xb0\xef\x85\x91\x42\x39\x10\x2e\x7a\xdd\x58\x33\x0a\x51\x77\x37\x27\x14\xde\x3f\xe9\x4d\x07\xdd\x21\x7b\xe9\x60\x27\xee\x7d\xc9\x72\x8f\x0e\x04\xa7\x6a\xba\x53\x10\xf2\x81\x97\x2c\x40\x1b\x1f\x03\x74\xe2\xc6\x1e\x50\x32\x8a\xec\x00\xc8\x73\x53\x58\x50\xec\x5b\x58\x50\x0a\x44\x0a\xff\x20\x2d\x2e\x0e\xa2\x88\x8c\x7c\x7e\x8e\x66\xc0\x10\xd5\xaa\x05\x22\x66\xa7\x3b\x99\xe7\xa8\xe0\x99\x54\x7c\xdc\xf3\x07\xe1\xb2\x1d\xff\xb8\x45\x07\x99\x28\x0a\xfb\xdc\x87\x02\x5e\x7f\x27\x08\xa0\xce\x1c\x65\xaa\x85\xcc\x24\xa5\xba\xc2\xde\x79\xf7\xa3\xd7\x6c\xdf\x0e\xde\x9f\x6a\xb3\x03\x95\xa5\xff\xe4\xa8\x51\xb5\x8f\xe5\xed\xd9\xa2\x13\x5b\x92\xe9\xab\x82\xc8\xb6\x22\x8a\xc1\xfa\x35\x5b\xa0\xda\x18\x36\x41\xd8\x63\x6b\x28\xa3\x54\x46\xde\xcb\x02\xb7\x98\x73\xce\xe5\xeb\x69\x7c\x7b\x3f\x63\xf3\x65\xe6\xe6\xbd\x21\x2f\x95\x4d\xf6\xbb\x88\xe9\x61\xb0\x9a\xfc\x84\xc4\x3c\xe6\x99\x3d\x90\xeb\x3d\x08\xb5\xe7\x57\x13\x5d\xe0\xf5\x9b\x4f\x9f\xdf\x5c\x5d\xde\xbe\x79\x0d\xcb\x0e\xba\x20\xb8\xb8\x0e\xa2\xa8\x76\x22\x88\x2c\xf1\x6c\x30\xb2\x6b\x02\x2b\x90\x0a\xee\x5f\xae\x5e\xfe\x6d\x75\x68\x94\xaa\x89\x66\x43\xe5\xb3\xc3\xfe\x0f\x07\xca\xfa\xc9\xdf\x37\xae\x3b\xa1\x73\x50\x5b\x92\x13\xcc\x6a\x87\x03\x20\x01\xa4\x0a\x05\xcf\x14\x26\x27\x45\x01\x69\x63\xa9\x63\xe5\xa5\xc4\x77\xe8\xac\x8b\x58\x8e\x40\xec\x98\x90\x40\x8d\x58\x08\x81\x8d\x90\x05\x21\x6e\xd0\xd6\x85\x6b\xd5\x0c\x70\x5a\xf5\x01\x00\x7c\x33\x25\xc5\x55\x16\x1d\x38\xcd\x9a\x1e\xfd\xde\x90\x6e\x82\xb0\x6d\x7d\x1e\x84\x4c\xcf\x87\xb3\x82\xd3\xe4\x60\xa3\x0a\xae\x06\xee\x1f\x89\x91\x8f\xf1\x16\x00\x20\xb4\x9a\x47\x7e\x3b\x60\x72\xbb\x73\x11\x73\x52\x66\xab\xb4\x9d\x94\x83\xd2\x90\x74\xc2\x31\xbe\xb4\x2a\x4a\xc1\x4c\x8e\xde\x36\x11\xec\x03\x00\x40\x8a\xea\xc6\xce\xb1\x64\xd4\x4f\xc6\x61\x8f\x98\xe2\xf1\x64\xc2\xbf\x95\x44\xe6\xa8\x6a\x5c\x6f\xba\xc2\xc5\x36\x8a\x69\xf8\x56\xc8\xa2\x36\x18\x83\xd9\x89\x4c\x2a\x55\x48\xd6\x08\x15\xb5\xc1\x6d\xa8\x08\x52\xab\x4d\x6c\x31\x0a\x9c\x8a\x99\x24\x25\x5c\xb6\x36\xbe\x7f\x21\x1c\xe8\x41\x9b\x03\x00\x51\xae\x7c\x2e\x16\xac\x75\x3b\xd9\x5b\x9d\x3c\x5e\xaa\x86\x9b\xfc\x00\x4f\xd4\xf0\x1f\x81\x09\x07\x83\x00\x8f\x6d\xfe\x8f\x82\x1d\x1c\x0a\x78\xcc\x20\xc0\x28\xe4\x3f\x71\x40\xe0\x51\x0a\x95\xe9\x1c\x67\xb1\xee\xa6\xde\x6e\x7d\xf9\xfb\xdf\x6f\x6f\x3f\xc5\x2c\x84\x1e\x6f\xda\x1f\x14\x60\xd6\x76\x01\x2f\x40\x6e\x46\x60\x42\x2c\x4c\x8d\x19\x81\x56\xac\xf9\xea\xa7\xc9\x53\x0d\xc5\x9c\x0d\xea\x4e\xc8\xc2\xce\x3a\xd9\x1b\x9a\xf0\xc9\x31\x07\x2a\x19\x81\xb0\x56\x67\x92\xc3\xe3\xa4\xbe\x86\x73\xaa\x95\x2f\xc9\x4c\xc8\x24\xdd\xc5\x92\xe1\x65\x1b\xa4\xb3\xa0\x1f\x14\x60\x7a\x83\x47\xeb\x20\x08\x1d\x85\x18\xf3\xa6\xa8\xf4\x1e\xc3\x94\xf4\x0f\xb6\x1b\x33\x4d\x71\x72\x39\x0a\xd3\x69\x8e\x3e\x82\x9e\xe1\xb7\x0c\xab\x50\x30\xf2\x48\xa7\xac\x20\x1c\x87\x68\x3d\xc6\xab\xe3\x3e\x07\x20\x13\xb5\x9d\xfa\x7d\xa0\x6f\x7e\xc5\x8f\x78\x5b\x0c\x52\x65\x45\x9d\xa3\x85\x52\x1b\x8c\x04\x6c\x71\x69\x02\x30\x34\x1c\xbc\x61\xc9\x0c\xb9\xf1\xc6\x5b\xe3\x15\x7c\xd0\x8e\x3d\x6e\xfb\x57\x8e\x06\x27\x81\x86\xd2\x46\xc0\x05\xf3\x70\xc4\xd5\xc4\x43\x13\x7e\xfb\x31\xb4\x04\x80\x58\x11\x39\x76\xd3\x61\x8a\x75\xbb\x0b\xde\x27\xba\xf5\xee\xa0\xc7\x4e\x58\x7f\x8c\xfc\x28\xdc\xe0\xca\xd1\x18\x4d\xed\x2f\xcb\x1e\x97\xa5\x46\x3a\x0b\xff\x71\xf3\xf1\x03\x58\x34\x1c\x11\x88\x31\xb7\x72\xf8\xef\x7d\xc3\x68\xc8\x89\x29\x2a\x87\x4a\x5b\x47\x85\x9c\x38\xa3\xc1\x66\x46\xb1\x09\x9a\x01\x51\x38\x6f\x3e\xc9\xe6\x5e\x92\x20\xf9\x68\xfa\x77\x34\x7a\x29\x55\x8e\xdf\x28\xbf\x82\xb7\x44\x91\xe3\x1c\x8f\xbe\xae\x42\x61\xbc\x1c\x72\xfd\x8c\x1b\x63\x52\x81\x50\x41\x56\xf5\x26\xc8\x02\xe4\x35\xce\x21\xa4\xf6\x3c\xb1\x94\x59\x91\x07\x2f\xeb\xc2\xc9\xaa\x40\x4f\x5d\xca\x57\x82\x05\xe0\x44\xe1\x8d\xef\x15\xd9\x8b\x19\xa0\xbf\x02\x7c\x3d\x25\xce\x7c\x3d\x85\x25\xb8\xc4\xfd\x74\x51\xab\x76\xb6\x34\x03\x62\x12\x18\x82\xcc\x02\xfd\xdf\x2f\xfe\x67\x35\xf1\x8a\x19\x30\x03\x12\x1b\x69\xac\x0b\x34\x0c\x05\x6f\x15\x5f\xf2\xf5\xf4\x38\xa0\xa3\x5e\xae\xf9\x57\xa2\xb5\x62\x8b\x8f\x54\x9f\x4b\xd8\xd5\xa5\x50\x4b\x83\x22\xe7\x56\x6a\xeb\xd7\x34\xe1\x43\x9c\x9f\x73\x66\x7f\x3b\x73\x78\x05\x6d\x4f\x10\xea\x9b\xcd\xb4\x86\xb0\xcb\x09\xef\xd0\xb5\xe9\x60\xb8\x3a\xb6\x7a\x4a\x62\x79\x17\xf0\x68\x5a\x95\x22\xdb\x49\x85\x53\xd4\x3a\x39\x7e\x28\xa6\xe7\x01\xb5\x62\x41\x96\xa3\xa9\x94\x81\xd3\x1d\x66\x0e\x48\x76\x98\x1c\x7d\x51\x8c\x41\xd8\x88\x7b\x21\x0b\xc2\xf1\x09\xe9\x76\x24\xd1\xe8\xde\x36\x9c\x70\xc4\x7f\x7e\x02\xf8\x31\xbe\x93\x9f\x68\xac\x5f\xcf\xda\x3f\xd6\x71\xfa\x90\xae\xe3\x21\x57\x27\x3f\x48\xa4\xc3\x61\xd5\xc9\x43\x9d\xd1\xa9\xe8\x89\x3f\xf8\x50\xf0\x51\xf9\xca\x62\x33\x70\xe5\x43\x39\xee\xa1\x4c\xc2\x6d\xf5\xf2\x42\x6f\xb3\x41\x8d\x46\x6f\xff\xa4\x81\xd5\xef\xe2\xc5\x74\x51\x60\x6c\xa8\xf1\x0f\x65\x05\x3c\x0b\x83\x76\x68\x30\x4c\x2d\x4b\xb5\x2d\x70\x3c\xb5\x4f\x50\xb9\x50\x9c\x09\xe5\x27\x31\x08\xf3\x35\xe6\xcf\x7f\x58\x60\xb9\x8d\xc1\x3d\x88\x91\x39\xb1\x51\x8a\x5d\x6f\x9a\x6e\xc4\xa2\xdd\xf6\x48\x33\x64\x4d\x97\x78\xf2\x68\x49\x2a\x5b\x13\xb2\x7e\xe6\x36\x5f\xc1\x8d\x2e\x83\x89\x8c\x93\xd8\xbe\xab\x72\x32\x1d\xc5\xa5\x6e\x0d\x17\xeb\x1c\x35\xc5\xb8\xda\xc8\xd9\xae\x43\x10\x19\xbf\x70\x19\x12\x3c\x6d\xe3\x4b\x8e\xc0\xed\x38\xb4\x88\x0b\xec\xf4\x83\x1f\x12\x72\x1a\x1e\x84\x74\xe9\xe4\xe2\xee\xa8\x45\xdd\x61\x0f\xad\x29\xa6\xce\xc9\x21\x61\x56\x1e\x09\x00\x50\xcb\x47\x58\xab\x2f\xd7\xaf\x0f\x75\x62\x35\x26\xd0\x27\xb3\xc2\xad\x31\xa1\x7e\xf4\xb8\x73\x33\x3e\x60\xff\x52\xcb\x1f\xb6\x1d\x47\xdd\xdc\x94\x99\x7f\x82\xfd\x84\x93\x49\x01\xfc\x81\x5d\x85\x93\x19\x1a\xf3\x5d\x7b\x0b\xa3\x80\xff\x74\xf7\x70\x94\xbd\x47\xc2\xe4\x47\x07\xc7\xc1\xcc\x1f\x2b\xeb\x25\x2b\xb7\xfa\x7e\xc4\xfb\x0b\x1a\xe3\x82\x77\xe3\x84\xca\x85\xc9\x7d\x23\x23\x3e\xfb\x4f\xf0\xd7\xb3\x2a\x29\x9a\x34\xa1\x9e\xef\xae\xe3\x03\xed\x35\x0e\xb9\x49\xb3\xab\xfc\xb7\x80\x42\x96\xd2\x9d\xcc\xc8\xd2\x54\x9a\x7f\xe6\xc4\x2c\xd5\xa1\xc2\x0c\x6c\xb0\xf3\xa1\x51\x70\xcc\x9f\x85\x61\x88\x9d\x88\x85\x1d\xae\xbd\xa5\x68\x9c\x43\x8d\x14\xe5\xeb\x4a\xd0\x84\xc2\xd0\xe8\x5f\xfb\x5f\x38\x66\xdc\x96\x90\xd6\xf2\x43\x3a\x8c\x4d\x84\xa1\x4a\x7d\xb8\x98\x24\xdc\x71\x4c\xf3\xa6\x03\x08\x4e\xa7\x6d\x17\x4f\x17\xfc\x96\xba\x8d\xe9\x04\xd3\x04\x8d\x5d\xd1\x2b\xcf\x21\xdf\xd1\xe7\xc6\x91\x75\xa8\x5c\x10\xc7\xa6\xa7\x58\x69\x3b\x3c\xf9\xdb\xfe\x17\x58\x1b\x28\x4b\x75\x40\xb9\xad\xbd\x3a\xf9\xfa\xce\x4e\xa8\xad\x9f\x16\x69\x6a\x18\x62\x3a\xb2\xc5\x07\x28\xa5\xa2\x32\x8a\xef\x7e\x37\x93\x42\x8d\x7f\x8b\x05\x7d\xef\xf3\xa3\x54\x1c\x09\xd4\x50\x41\x6d\xbd\x5d\xf7\x3d\x33\x2f\xa9\xad\xe1\xa3\x35\x86\x81\xb7\x2c\x4d\xa1\x4e\xc2\x0c\xd2\xd2\xae\x28\x84\x56\x15\xd2\x30\x66\x81\xd6\xc2\x5e\xd7\xfe\x1c\x06\x33\x94\xf7\x47\xb0\x64\xd4\x9c\xbe\x43\xe5\x9d\x84\x50\x3e\xfe\x89\xd6\xf1\x09\xe2\xca\x0e\x05\xe7\x47\x19\x37\xae\x69\xf8\x24\xb7\x6e\x5b\xec\x3f\x3b\xb3\xa9\x6d\x31\x4d\x35\xff\xea\x68\x99\xd3\x06\x03\x41\x0e\x31\x47\x1c\x80\x8b\xfd\xa3\x81\x81\xaa\x2e\xa6\x71\x6e\x95\xb9\x1c\x64\xdd\x93\x3d\x88\xe0\x0a\x7e\xf5\x43\xda\x61\x5e\xd2\xf9\xbe\xff\x24\x58\x91\xcc\x40\x0b\x15\xae\x13\xb2\x48\x42\xad\x52\xe3\x7d\x2d\xb2\xbb\x39\x12\x13\x27\xbd\xe6\xac\xb8\x34\x1e\x61\x12\xe4\x13\x78\x8b\x4c\x2b\x5f\x94\xcb\xf6\xcb\x30\x04\xb3\x14\x2a\x5f\x26\xf3\x90\xed\x7f\x38\xeb\xb3\x58\x6c\xde\x49\x75\x37\x5b\xe2\xe2\x03\x3e\x4a\xfb\xf2\xf9\xdd\x61\x70\x36\xa3\xb9\x0b\xf3\xb6\x89\xfe\xe0\xa8\x74\xba\xa6\xf5\xc8\x4a\xd6\xc3\x2e\x8c\x86\xa4\xc0\x65\x14\x7b\x99\x06\xe7\x4f\x43\x37\xf8\x34\x44\x45\xd3\x65\xad\xa9\xfe\xd0\x68\x31\x0b\x2e\xe3\x1c\x60\x56\x08\xe3\x8d\x83\x50\xbe\x73\xe7\x5f\x3a\x11\x65\xe4\x08\xeb\xda\x41\xae\xd1\xf7\x97\xf4\x3d\x1a\x23\x73\x04\xe9\xbe\x3b\x2c\xf3\x2f\x9d\x1d\x94\xa5\x58\xb1\x55\x8e\xa1\x0a\x0d\x82\xde\x5c\xc0\xe9\x4d\x9d\xd1\x48\xc2\xe9\xd0\xc0\x4e\xfc\x97\xa8\xfc\xd4\xd1\x1c\xe5\xf3\xac\x90\xfe\x4c\xdf\x19\x62\x4f\xc8\xe9\xf8\x8c\xc3\x72\x64\xfe\x65\x14\x58\x21\xd6\x58\xfc\xd1\xdb\xc7\xef\x05\x8f\x87\xfb\x3b\x69\xd9\xd8\xdb\x65\xdf\xf1\xee\x7b\x12\xa7\x41\x9b\xad\xa0\x76\xf9\xe0\x14\x29\x05\x91\x5b\x6d\xe4\xef\x08\xcf\xf8\x33\x06\x7c\xd5\x62\x81\x99\x7b\xde\x5a\xf6\x15\x7b\x28\x79\x8c\xcd\xff\xa4\x8d\x1d\x9a\x7f\x34\x48\xa3\x6a\x5e\x3f\x9a\x91\x42\x1b\x60\x9a\x7b\x99\xe1\x77\x6c\x0e\x7b\xba\xce\x5e\x1a\x2e\x85\x12\x5b\xcc\x7d\xb7\x69\x7a\x14\xf2\x7d\xfb\x56\x28\x45\x65\x81\x76\x53\x36\x85\x7e\x58\x4a\x3f\xfe\x15\x5d\xb6\xf7\x70\x83\xcb\xa5\x7a\x13\x1b\x4b\x4c\x7e\x61\x30\xe2\xe0\xed\xae\x70\x09\x6a\xe8\x45\x4b\x8a\xc3\xad\x2b\xf6\x61\xa6\x67\x24\x74\xd8\xe9\xda\xe2\x1d\x62\x25\xd5\xd6\xc7\xfd\x7e\x82\xce\xed\x2b\x8a\xd3\x8a\x7d\x28\x4f\xd1\x94\xa0\x0a\x1d\xe9\xb0\x7d\x55\xab\x1c\x8d\x75\x43\x41\x7c\x53\x32\x22\xcb\x15\x31\x8b\x52\x13\xf3\x95\x33\xdf\x6a\x5c\x74\x86\x43\xe3\xc5\x3e\x09\x4c\x33\xdf\x4e\x81\x79\x33\x30\x2b\xaa\x8a\x86\x00\x85\xdb\x41\x21\xef\x10\xbe\x9e\x66\x72\x99\xe5\x5f\x4f\x7d\x58\x1b\x22\x79\x4f\xbf\xa1\x4d\x07\x51\x3c\x88\x7d\xb2\xe6\x89\x1b\x21\xeb\x69\xd0\x67\x69\x3f\xd8\x55\x1f\x0a\x49\x82\xdf\x84\xaf\xea\x70\x36\x95\xe7\xfe\xbc\x4e\x30\x25\x5a\x11\x7c\x9c\xf5\xa3\x42\xea\xd0\x84\xb7\xd2\x4e\x66\xd8\x9b\x00\x1c\x69\x44\x4f\xa7\x9f\xc7\x86\x7c\xba\x4e\x73\x72\xc2\xa7\xf5\x25\x8f\x56\xfb\xf9\x64\x22\xfe\xf6\xd4\xe0\x54\x95\x47\xbe\xe3\xa6\x3c\x86\x2a\x1f\x48\x0b\xa7\xdc\xf5\x38\x0f\xef\x38\x85\x7f\xd4\x76\x0c\x26\x73\x9c\x10\x72\xba\x5a\x16\x64\xe3\xdb\x18\x07\x19\x0c\xab\xdc\x48\x4e\x46\x98\x3d\x38\x0d\xce\x88\xec\x6e\x14\xcf\xce\xf9\x44\x0b\xe7\x35\xfa\x36\x96\x64\x1b\x18\xb2\xb9\xb0\xef\xe5\x15\xe6\x64\xcc\x0d\xf3\xd0\xd2\xd0\x0c\xfb\x0c\xef\xb2\x19\xb4\x34\x13\xd6\x1f\x9c\xa9\xf1\x38\x73\x83\x59\x6a\xa5\x1c\xa2\xab\x2f\xab\xef\x19\xbd\xf3\xa6\xc9\xcc\x10\x2e\x6f\x1d\x4d\x7f\x1f\x4a\x6f\xba\xba\xc7\x20\x27\xa2\xc4\x1d\x5a\x9c\x81\xf2\x28\x81\x53\x54\x32\x03\xe9\x8f\xf1\xde\xf8\x2d\x1d\x82\x4d\x18\x27\x20\xa1\xc6\x5b\xa0\xc8\xc7\xb3\x2b\xd6\x86\x8e\x7b\x78\xc3\xad\xf2\x35\x92\x61\x49\x9f\x21\x20\xcd\xa0\x38\xda\x6f\xd9\x04\x2f\x3c\x3e\x6b\xd5\x56\x32\x61\x10\xce\x68\xb1\x62\x7f\xc6\x56\xe7\xec\x0b\x97\x31\xcf\xbe\x8b\x42\xd4\xe7\x98\x41\x9c\x5b\xe9\xf7\x36\x5c\x7b\xd3\x2c\x96\xcb\x13\x8f\xe0\x01\x0d\x4e\x4d\x8d\x5d\xa7\x95\x93\x60\x9d\xd3\x16\x9e\xdc\x74\x19\x10\x0e\x78\x32\xd5\x37\x18\xdb\x0f\x9c\x71\xf0\x09\x51\x1f\x6b\xf8\xaa\x63\x6b\x6a\x67\xbc\xdc\x12\x93\xe5\xb0\xae\x43\x86\x5f\x2a\x10\xcd\xb7\x3e\x56\x70\x6d\x53\xf0\x38\xfc\x9d\x00\xbf\x0a\xa1\xb6\xc9\xfc\xda\x45\xb3\xe5\xcc\xdd\xcf\xf4\x03\x97\x9f\xf8\x03\x07\x69\x65\x7d\x48\x36\x9b\x5d\x65\xec\x6e\xa2\x80\x50\x64\xb1\x8d\xae\x8c\x14\x2e\xf6\x0d\xdb\x96\x6f\x35\xbc\xf0\x25\x2d\x54\x46\x96\xc2\x48\x5e\x87\x08\x93\x73\x24\xaa\x69\x91\xa3\xd9\xbb\xf1\xc1\x61\xb7\xd6\x95\xa7\x6f\x70\xf5\xa5\x65\xa0\x44\xff\x23\x6d\x14\xa6\xfd\xd9\xdc\xd5\x9f\xc4\xa9\xe9\x10\xf0\x43\xbc\xad\xe3\x40\xfd\x95\xc0\x75\x5a\xe9\x07\xd5\x97\x8a\xfe\x81\x2f\x55\xd0\x83\xf4\x72\x90\x16\x48\x48\xee\x45\xe1\x79\xca\xe0\xbf\x9e\xe6\xb8\x11\x75\xe1\xbe\x9e\x36\xb7\x2e\x28\x11\xec\x81\x6c\xdf\x1a\x2c\x5a\x26\x94\x56\xc4\xd5\x83\xc1\xdc\x66\xc4\x2e\xc4\xed\x20\x0c\x26\x19\x1d\x5a\xa3\x5c\xa3\xff\x76\x59\x4e\x7f\xb4\x84\x3b\x4c\x18\xb1\x39\x4b\x41\x84\x37\x5b\x4d\x77\x32\xbc\x64\x78\xe5\x3c\x5a\x04\x0e\xb4\xe2\xa6\xae\x80\xd7\x1f\x6e\xfe\xf7\xdd\xe5\xbf\xbd\x79\xb7\x9a\x16\x8e\x7e\x28\x3c\x47\x58\x12\xfe\x76\xf6\x82\x98\x7e\x50\x68\x3e\x23\x2f\x6e\x66\x38\x9d\x2e\xbc\x0b\xfb\x17\xe1\xe0\x90\x63\xe5\xd5\x65\xbd\xef\xed\x25\x5d\xbe\x7b\x37\x4a\xa0\x10\xcb\x72\xd9\x99\x0b\x75\xbc\x96\x94\x26\xcc\x3b\xdf\xbc\x09\xb4\xdc\x0a\xb3\x16\x5b\x84\x8c\xc2\xf0\xcc\x4d\x6d\xaf\x36\xbb\x11\xad\x24\xa4\x1d\xc4\xd3\x1b\xfc\x2e\x50\x9a\xfe\x4a\xe5\xf6\x61\x66\x86\xda\xbd\x6e\xca\xc7\x11\x52\x9a\x2c\x68\x2e\xb6\xe2\x31\x7a\xc2\x0c\xe9\xc9\x2d\xd7\x5a\x9a\x18\xad\x3d\xe5\x87\x29\x9c\x68\x01\x5d\xfd\x33\x22\xeb\x6e\x18\x8d\x60\xbc\x98\xb8\xef\xf2\xd0\xfc\xa5\x8d\x8f\x24\x6d\xf1\x53\x2c\x33\x90\x20\x9e\x1a\x1a\x82\xbf\xfc\xf0\x3a\x76\x1c\x58\x62\xd3\x8a\xef\x29\x75\xf5\x29\x20\x57\x79\x84\x3b\x36\xc1\x97\xd6\xea\x83\x00\x34\xc0\x1a\x46\xf4\x16\xe6\xef\x70\xbf\x64\x33\x30\x02\xd4\x7f\x93\x8c\xbf\xbe\x10\x53\x8d\xa0\x4b\xad\xad\xa0\x15\xbc\xf6\x36\xcc\x82\xd3\xb0\x11\x85\xa5\x9e\xd3\x58\xe8\x95\xbe\xab\x14\x97\x91\x39\x1f\xe5\x04\xd7\xc2\xa9\xc7\xf0\x14\x2a\x2a\x7b\xdb\x36\x7b\xf8\x2c\x8b\x11\xa0\x3a\x2e\xf7\xc1\x5f\x7f\xfa\x09\x9e\x7d\x51\x61\xd1\x86\xeb\x8c\x6f\x94\x93\x6e\xff\xbc\xf5\x5d\x20\xdf\x55\x99\x62\xf4\x5a\xeb\x02\x85\x3a\x19\x4c\x26\x82\xd4\x3e\x86\xc3\x07\xc4\x63\x95\x4b\xab\x11\x33\x34\x62\x1e\x6e\xe3\x53\x02\x03\x33\x02\x87\x62\xff\x67\x37\x6a\x8f\x68\xd4\xf8\x30\xd5\x40\x3c\x77\xec\x2c\x3f\x1e\x88\xcc\xc2\x79\x74\xba\x65\x62\xae\xe5\x29\x30\x1e\x9f\x40\x99\x44\x78\x6a\x01\x6c\xd9\xb2\xa7\x83\x3f\x13\x67\x07\x7f\x18\x99\x2c\x5b\x12\x75\x9e\x22\xc4\x3f\xd2\xe8\xeb\x6d\x43\x87\x4e\x17\x9b\x39\x5f\x59\x6a\x06\x59\xc2\xc6\x62\x5c\x49\x4a\x0e\x61\xb8\xaa\x36\xab\x9f\x37\xd2\xb3\x1b\x58\x58\x6e\xf7\xf0\xde\xb7\xda\xed\x14\x83\xd1\xb6\x4a\x29\xad\x93\x19\xb4\x7a\x58\x8b\xf0\x00\xbf\x83\x27\xb7\xc6\x3f\x1e\xe0\xd7\x92\x9b\xb4\x58\xab\xf6\x17\x29\xb5\x89\xb5\x86\x78\xa9\xf9\x24\x5e\x0f\xa4\x1f\x69\xa3\x84\x21\x24\x92\x3e\x11\x6e\xb5\x11\x1f\xdf\x3b\x8c\xfd\x42\xfe\x7c\x65\xd9\xfa\xa6\x9a\x4f\xb5\x89\x06\xc2\x7f\x34\x28\xab\x0b\x61\x06\x30\x1f\xfd\x74\x99\x9d\xfa\xbe\x4e\xa7\x11\x39\xaf\x73\x3a\xda\x2d\x7d\x6a\x93\x39\xa3\x5b\x39\x3b\xf2\x1d\xeb\x4a\x76\xf7\xd0\xe6\x77\x22\x3b\xf4\x1c\xdc\x15\x3f\xda\x7d\x1c\xc5\x75\xc0\x6c\x76\xb5\x98\x0c\x66\xc8\x8e\x42\xc6\x2e\x55\xf8\x88\x86\xca\x43\x36\xe7\xf5\xfb\xe0\xeb\x81\x03\x71\xb4\x03\xd9\x2e\xb1\x37\xdf\x18\xe9\x7e\xcd\x4e\x2b\xb0\xbe\x33\x46\x1f\x61\x4a\xd9\xf2\x80\xd8\xb5\xb4\xaa\xf5\xfd\xba\xf8\x39\x43\xa7\xa3\xce\x6a\x05\x9f\xbe\xdc\x76\xbe\x41\xd9\x16\xd3\xa1\xd5\xf6\xa3\xfd\xf3\xef\x73\x15\x33\x85\x68\xd0\x36\x97\x68\x77\x17\xc7\xbe\xec\x1b\x3f\xba\x3d\x01\xe9\xe0\x52\x30\xbd\x21\xb0\xf7\x4e\xe4\x02\xee\x5f\x72\xd9\xfe\xe5\x49\xcb\x66\xe4\x9d\xfa\x6a\xd8\xe4\x0d\xd7\xfe\x6f\x00\x2a\xff\x08\x32\x6f\x5c\x00\x00"),
+		},
+		"/crds/service-insight.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "service-insight.yaml",
+			modTime:          time.Date(2026, 10, 19, 5, 55, 30, 563556100, time.UTC),
+			uncompressedSize: 23770,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x3c\x6b\x73\xdb\xc8\x91\xdf\xf9\x2b\xba\x94\x0f\xb2\xab\x48\xca\xde\x4d\xae\x2e\xfa\xa6\x93\xbd\x39\x5d\xfc\x2a\x4b\xbb\x57\x57\x71\xea\x6a\x08\x34\xc9\x89\x80\x19\x64\x66\x20\x99\xfb\xeb\xaf\xba\xe7\x01\x80\x78\x10\xb2\xb5\x9b\xf3\x27\x0b\x04\x1a\x3d\xfd\x7e\x42\x54\xf2\x17\x34\x56\x6a\x75\x09\xa2\x92\xf8\xd5\xa1\xa2\xbf\xec\xfa\xfe\xdf\xed\x5a\xea\x8b\x87\xd7\x1b\x74\xe2\xf5\xe2\x5e\xaa\xfc\x12\xae\x6b\xeb\x74\xf9\x19\xad\xae\x4d\x86\x6f\x70\x2b\x95\x74\x52\xab\x45\x89\x4e\xe4\xc2\x89\xcb\x05\x40\x66\x50\xd0\xc5\x3b\x59\xa2\x75\xa2\xac\x2e\x41\xd5\x45\xb1\x00\x50\xa2\xc4\x4b\xb0\x68\x1e\x64\x86\x52\x59\xb9\xdb\x3b\xbb\xbe\xaf\x4b\xb1\x96\x7a\x61\x2b\xcc\xe8\xf9\x9d\xd1\x75\x75\x09\xf1\xb2\x7f\xcc\xd2\x2f\x00\x1e\x8d\x5b\x0f\xe1\xc6\x43\x58\x00\x00\x54\x45\x6d\x44\xd1\x03\xbe\x00\xb0\x99\xae\xf0\x12\xae\x8b\xda\x3a\x34\x0b\x80\x07\x51\xc8\x9c\x31\xf4\x30\x75\x85\xea\xea\xd3\xcd\x2f\x3f\xde\x66\x7b\x2c\x85\xbf\x08\x90\xa3\xcd\x8c\xac\xf8\xbe\xa3\x37\x82\xb4\xe0\xf6\x08\xfe\x01\xd8\x6a\xc3\x7f\x86\x77\x43\x7c\x39\x5c\x7d\xba\x59\x00\x00\x00\x54\x46\x57\x68\x9c\x8c\xe7\x00\x00\x68\xd1\x3e\x5d\x3b\x7a\xed\x39\xe1\xe5\xef\x81\x9c\xa8\x8d\xfe\xc5\x0f\xfe\x1a\xe6\x60\x3d\x0a\x7a\x0b\x6e\x2f\x2d\x18\xac\x0c\x5a\x54\x8e\xcf\xd7\x02\x0b\x74\x8b\x50\xa0\x37\xff\xc0\xcc\xad\xf9\x40\x68\x2c\xd8\xbd\xae\x8b\x1c\x32\xad\x1e\xd0\x38\x30\x98\xe9\x9d\x92\xbf\x26\xc8\x16\x9c\xe6\x57\x16\xc2\xa1\x75\x1d\x88\x52\x39\x34\x4a\x14\x44\xd1\x1a\x97\x20\x54\x0e\xa5\x38\x80\x41\x7a\x07\xd4\xaa\x05\x8d\x6f\xb1\x6b\x78\xaf\x0d\x11\x68\xab\x2f\x61\xef\x5c\x65\x2f\x2f\x2e\x76\xd2\x45\x69\xcb\x74\x59\xd6\x4a\xba\xc3\x45\xa6\x95\x33\x72\x53\x3b\x6d\xec\x45\x8e\x0f\x58\x5c\x88\x4a\xae\x18\x4f\xe5\x58\x42\xcb\xfc\x0f\x26\x48\xa2\x3d\x6f\x21\xe6\x0e\xc4\x6f\xeb\x8c\x54\xbb\x74\x99\x05\x67\x94\xcc\x7f\x95\x2a\x07\x69\x41\x84\xc7\x3c\xba\x0d\x35\xe9\x12\x11\xe1\xf3\xdb\xdb\x3b\x88\x2f\x65\x8a\x77\x49\xcc\xc4\x6d\x1e\xb3\x0d\x9d\x89\x2e\x52\x6d\xd1\xf0\x53\xb0\x35\xba\x64\x88\xa8\xf2\x4a\x4b\xe5\xf8\x8f\xac\x90\xa8\xba\x34\xb6\xf5\xa6\x94\x8e\x18\xfb\xcf\x1a\xad\x23\x76\xac\xe1\x5a\x28\xa5\x1d\x6c\x10\xea\x2a\x17\x0e\xf3\x35\xdc\x28\xb8\x16\x25\x16\xd7\xc2\xe2\x73\x53\x99\x08\x6a\x57\x44\xc1\xd3\x74\x6e\x1b\x02\x80\x71\xe1\x07\x00\xe0\x53\xb0\xa0\x1e\xfd\x00\x20\xf2\x9c\x0d\x8b\x28\x3e\x8d\x3c\x3c\x8a\xc1\xa0\x1a\x35\x6f\x62\x36\x2b\xa8\x95\x75\xa6\xce\x5c\x6d\x30\x87\x7b\x3c\x04\x8e\x97\xa2\x02\xeb\x34\x5d\x7c\x94\x6e\xdf\x7b\xa3\x68\x73\x5f\x38\x66\xeb\x06\xc1\xa2\x83\xcd\x01\xf0\x6b\x50\x08\xa7\x75\x41\xac\xf2\xb0\x58\x31\x0c\x3a\x23\xf1\x01\xfb\x20\xcd\x46\x3a\x23\xcc\x21\xd1\x6e\x0d\x77\x7b\x3c\x80\x30\x08\xc4\xe6\x7f\xd6\x68\x0e\x62\x53\x78\x38\x41\x61\x37\x08\x2c\x64\xe6\x01\xf3\x1e\xc8\xc7\x3d\x2a\x28\x75\x2e\xb7\x07\x92\x5c\x2f\x96\x7d\xe5\xbb\xbc\xb8\xb8\xaf\x37\x68\x14\x3a\x64\xc1\xc8\x75\x66\x2f\x6a\x8b\x66\xb5\xab\x65\x8e\x17\x2d\x06\x9d\x2f\x86\x48\xef\x21\x77\x7e\xca\xbc\x9d\xfd\x40\xa6\x7e\x8a\x27\x77\x7b\x64\xc3\xee\x4d\x17\xc6\xe7\xe0\x71\x2f\xb3\x3d\x5f\xf1\xc0\x61\x83\x85\x56\x3b\x2f\xf8\x77\xc7\x1a\x07\x00\x20\x2d\xd4\x16\x73\x70\x1a\x72\x69\x49\x57\x6b\x69\xf7\x89\x51\x96\x39\x09\x56\x94\xe1\x85\x44\x45\xfa\x8f\xad\x04\x1b\x6b\xc8\xe5\x76\x8b\xe6\x58\xf3\x5a\x87\xb1\xfe\xcd\xb0\x95\x58\xb0\x9d\x20\xb6\x58\x74\x20\xd4\xe1\x71\x8f\x06\xc1\xb0\x5b\x50\xfa\x91\xa1\x8b\x4a\x32\x67\x0c\x0c\xa0\xbb\xd3\x6c\x4d\x34\xc8\x9d\x62\x7e\x38\x90\x5b\x86\x26\x95\xf7\x9d\x08\xda\x04\xcd\x8e\x7a\xbf\x5e\xcc\x94\xfc\xbe\xf3\x9d\x62\xc2\xd9\xf5\xf1\xed\xac\x1e\xe0\xd2\x9f\x3d\x13\xe8\x0f\xd6\x57\x45\x59\xa2\x97\x3b\xb6\x6f\x81\x77\x8f\xc2\x86\x23\x91\x89\x72\x91\x74\xbb\x5a\x18\xa1\x1c\x7a\xa6\x79\xfd\xe9\xb3\x55\xc1\x5e\x54\x15\x2a\xbb\xda\xe0\x96\x28\xa5\x4d\x8e\x06\x44\x66\xb4\xb5\x60\xb1\x12\x86\x69\x55\xa1\xf1\x32\xba\x86\x6b\x36\xa0\xde\xda\x2a\xdd\x87\x69\xd1\x79\xfc\x58\xdb\x23\x4a\xe9\x8c\x98\x83\x54\xf0\xf9\xa7\xeb\x1f\x7f\xfc\xf1\xcf\xe4\xd6\x4b\x66\xa7\xb4\x74\xf9\xe7\xbb\xeb\x35\x7c\x51\x3d\x98\x9f\x74\x55\x93\x73\xcc\x61\x73\xf0\x14\x3a\x58\x87\xe5\x1a\x3e\xa3\xc8\x57\x5a\x15\x87\x35\x7c\xa8\x8b\x82\xe0\x41\x21\xad\x7b\x76\x2f\x18\xed\xc6\xd9\x11\x6e\x74\x00\xe1\x2e\x81\x04\x69\x45\x0c\x9a\x2b\x44\x39\x16\x48\xd0\xff\x62\x44\x86\x9f\xd0\x48\x9d\xdf\x62\xa6\x55\x6e\x27\xa5\xe9\x43\x5d\x6e\xd0\x80\x26\x69\xe6\xbb\x41\x14\x85\x7e\xc4\x3c\x44\x48\x8d\x5c\x38\x0d\x3b\x82\xbd\xad\x8b\xe2\xd0\x97\x25\x34\xa5\x54\xc2\x21\x04\xc6\x4b\x07\x8f\xb2\x28\x60\x83\x60\xb0\xd4\x0f\x98\x37\x0e\x34\x52\xfb\xa3\x2a\x0e\xcc\x5f\x12\xc2\x1e\xc8\x78\xa2\xae\x9c\x17\x56\xd3\x23\x6b\x78\x2f\x0e\x40\x9c\x62\x59\xdc\x6b\xe3\x50\x61\xde\xe6\xe0\x08\x65\xa5\x72\xff\xf6\xc7\x41\xaa\x52\x6c\xb4\x3b\xd2\x93\x1e\x12\xd3\xba\xf9\x66\x08\xe7\xcf\x3f\x5d\x03\x4b\x27\x31\x95\xa5\x93\x18\x0b\xc2\x25\xc3\x39\x60\x72\x92\xcf\x8a\x54\x64\x4c\x30\x3f\x36\x6b\xc1\x8d\x35\x6a\xce\xc4\x04\x91\x98\x35\x4a\x57\xaf\x46\x6c\xaa\x1a\x45\x20\x4f\xb2\x8c\x1a\xa4\xb4\x83\x5c\x1a\xcc\x9c\xe7\x93\x63\x8f\xb6\xe9\x73\x5f\x84\x30\x88\xbd\x60\x83\xba\xb4\x80\x5f\x2b\xcc\x5c\x32\x1a\xe1\x10\xf0\x42\x69\x20\x17\x81\x06\x1e\xa4\x95\x9b\xa2\xef\x63\x59\x5a\x12\x28\x56\x42\x8f\x18\x61\x65\x50\x64\xfb\x80\x0d\x3b\x86\x97\x20\xb6\x0e\x7d\x4c\xcf\xd4\x95\x7d\x81\x72\x89\x70\x4b\xd0\x8a\xc3\x01\x84\xad\x54\xa2\x90\xbf\xa2\xb1\xfc\x0e\xc6\xb9\xac\xdc\x61\x0d\x57\x96\x51\x04\x61\x8f\x6e\xec\x01\xe6\x07\x49\xef\x85\x54\x16\xa4\xc3\xd2\x2e\x3b\x64\xde\x14\x3a\xbb\x27\xde\x7d\x8c\xaf\xed\xc9\xd5\x90\x8b\xb4\xe8\x96\x2d\xdb\x17\x4d\x24\x07\x91\xca\xa2\x03\x6d\x82\x25\x86\x6d\x6d\xdc\x1e\x0d\x48\x15\x62\xff\x6d\x4d\x71\xd2\xb2\xcf\xaa\xc2\xed\x75\xbd\xdb\x83\x6c\x22\xa1\xa8\x3d\x10\x92\xa2\x44\xf5\x70\x43\xe4\x5a\x65\xa4\x1e\x70\x23\xda\xe3\x48\x64\x5f\xc3\x4f\xda\x00\x7e\x15\x65\x55\x50\x76\xc1\xf2\x14\x12\x0c\x96\x34\x1f\x82\x09\xa8\x34\x4b\x58\x80\x3c\xe4\x48\x7e\x7c\x15\x4d\x92\x97\xaa\xbf\xd6\x1b\xba\xd9\xeb\x03\xf1\x9f\xe5\xde\xa2\xca\xc9\xcd\x35\xf2\x9e\x4c\xd1\x71\x32\x05\x00\x60\xe5\xce\xc7\x7a\x3e\x7e\xf1\x2c\x23\xde\x4b\xc5\x57\x2a\x9d\xaf\xe1\x2a\x48\x92\x70\x2d\x24\x96\xe0\x1a\x24\xfa\xd1\x1b\x21\x45\xb8\x80\x80\xbd\x30\x79\x1b\x89\xf8\xd2\x17\xb7\x37\x7f\xf9\xeb\xcd\xbb\x77\x2f\x7b\xaf\x27\xb1\xee\x33\x8a\xb1\xc8\x0a\x14\xaa\xae\x96\xc1\x88\x46\x24\x1b\x5b\x7a\xf5\xe9\x86\x33\x09\xfe\x81\x5d\x62\xc6\xf1\x99\x42\xf7\xa8\xcd\x7d\x0f\x6c\x25\x8c\xe3\x30\xdd\x2e\x3b\xe6\x9d\x78\x64\x1d\x1d\x03\xbf\x4a\xeb\x92\x3a\x05\xc6\xb2\x8c\x2e\xa1\x56\x4e\xf6\x2d\x8a\x50\x20\xf2\x52\x2a\x69\x9d\x11\x4e\x1b\xd0\x06\x44\xed\x74\x29\xbc\xd4\xe8\x0c\xad\x85\x4c\x28\xc8\xd1\x13\x06\xbb\x72\x36\x60\xff\xd8\xcd\x34\x6e\x85\x62\x91\x6d\x8c\xe1\x96\x0d\xb3\x93\x96\x85\x90\x34\x9c\x66\x2f\xfa\x10\xbd\xe6\xa0\x6a\x8c\x1e\xc5\x06\x63\xb1\xc0\xb1\x19\x4d\x6f\x1a\x52\xd4\x16\xc4\xc6\xff\xfc\x7f\x8f\x18\x1a\x83\x36\xe9\xd3\xde\xd7\x96\xe8\xe6\xad\x62\xf4\xee\x2d\x52\x37\x5a\xdc\x08\xa5\xc1\x1d\xc9\x42\xcf\x07\x03\xbc\x15\xd9\x1e\x50\x39\x73\x08\x49\x9d\xcc\xe9\x8c\x5b\x89\x26\xd5\x64\x0c\xda\x4a\x2b\xf6\x0a\x90\xe9\xb2\xd2\x0a\x55\x30\x1c\xa4\x67\x03\xae\x32\xa9\x86\x87\x9c\xf0\x20\xc3\xcc\x82\x33\x68\x72\xbb\x32\x33\xc4\x57\xa5\xd5\x4a\xc9\x62\xc9\x70\x25\x06\x33\x21\x83\xab\x20\x81\x8e\x11\x48\x88\x71\x8e\x0f\xcc\xbe\xe0\x49\x49\xb0\xff\x49\x18\x23\xba\x6e\x76\x87\x8a\x62\x66\x3c\x99\xa4\x9d\xfd\xa5\x75\x67\x20\xb2\xae\x7c\x62\x0e\x95\xc1\xad\xfc\xba\xf4\xc9\x57\x27\x6c\x58\x0e\xd9\xf5\xf8\x52\x10\x50\x2b\xf9\xcf\x3a\x64\x63\x1f\x3f\xbc\xfb\x1f\xb8\xf9\x89\x9f\xe6\xb7\xb0\x53\x25\xa5\x6b\x94\xac\x32\xfa\x41\xe6\x7d\x8a\x80\x67\x47\x3b\x84\x21\x64\xbc\x79\x65\xe8\x06\x5d\x6d\x94\x0f\x19\x9a\x0a\x4b\x13\x07\x8d\x66\x7e\x6e\x2f\x54\x03\xa6\x12\xd6\xa6\x70\xc9\xfb\x4f\x06\xc1\x11\xe4\x86\x25\x6b\x23\x55\x28\x1a\xa4\x03\xf6\x3d\x46\xbd\xdd\xca\xaf\xde\x05\xc5\x33\x05\x70\xfb\x10\x19\x70\x9a\xda\x54\x28\xc1\xd4\x05\xda\x18\x36\x10\x7d\xfa\xc6\xcd\x07\x21\xb1\xf8\xb6\x41\x70\xa6\x56\x59\xdb\x0a\x15\xa8\x76\x6e\x1f\x45\xd4\x63\xc1\x76\x46\x1a\x26\x4d\x0f\x66\x29\xee\xbd\x0e\x78\xe4\xfc\x71\x40\xab\x16\x8f\xd9\xde\xf5\xc8\x4f\x35\x5c\x52\xc0\x01\x17\xa4\x72\x7e\x3a\x8a\x81\xcf\xc1\xbd\x83\xb0\xcb\x16\x60\x4f\xd9\x0f\x1f\xef\x02\xf3\x40\xc0\x1f\x5f\xfd\x19\x56\x03\x7e\xdd\x3a\x14\xf9\x32\xa5\x07\x28\x39\x6c\x09\x8f\xfd\xf0\xea\x35\x5c\xfb\xdc\x13\xb4\x81\x3f\xbd\x7a\xe5\xb9\xf3\x19\x85\xd5\x2a\x14\xe6\x48\x7f\x75\x3d\x94\x7c\xe6\x32\x13\xce\x47\x03\x6d\x71\xcd\xb8\xfa\xe2\x25\x13\xb6\xba\x56\x79\x74\xf7\x3e\x0e\x2f\x0a\xed\x1c\xe6\xcb\xd1\xf3\x07\x09\x0c\x65\x1c\x83\x64\x63\x5e\x44\x9d\x2a\x0e\xfd\xd0\x93\x11\xe1\xcc\x74\x40\x48\x11\x3e\x13\x84\x95\x0f\x33\xf6\x28\x72\x34\x2f\x99\x35\x57\x55\x55\x48\xcc\xbd\x51\x91\x5b\x88\x1a\xcc\x6e\x2f\x72\xa9\xaf\x50\xcf\xeb\x67\x64\x8e\x65\xa5\x1d\xaa\xec\x70\x36\xd7\x95\x04\x01\x39\x2a\x8b\xf7\x4c\xd3\x15\x58\x72\x94\x2a\x43\x50\x3e\xef\xec\x94\x2a\x44\x3c\x64\xd6\x02\x08\x7a\x3b\x48\xc3\x1c\x2d\x6b\x82\x75\xc2\xe1\x7a\x4e\x46\xff\x2c\xf9\x20\x37\x4f\xe6\xb8\xcd\xb3\x2b\xd5\xbe\x99\x0d\x31\x47\x7c\x46\x17\x45\xaa\x99\xa1\xda\x6a\xae\x77\x59\x5d\x46\x9c\x07\x04\xfb\x41\x18\x29\x94\x03\xe1\xa2\xd7\x8d\x35\xa3\x10\x75\x77\x73\x42\xe1\xfd\x93\xde\x76\xd0\x1d\xb2\x97\x0e\xf6\xe2\xc1\x97\x2c\x0f\xe8\x40\x70\xaa\xa6\x3b\x05\x21\x1f\x78\xc9\x02\xb4\xf1\x31\x40\x27\x6e\xec\x01\x25\xa3\xc8\x0e\x80\x3c\x37\x85\x05\xc5\xa1\x85\x05\xa5\x40\xa4\xf0\x8f\xd2\xe2\xf2\x28\x8a\xc8\xc8\xe7\xe7\x68\x06\x0c\x51\xad\x5a\x20\x62\x76\xba\x97\x79\x8e\x0a\x5e\x48\xc5\xc7\xbd\x78\x14\x2e\xdb\xf3\x8f\x3b\x74\x90\x89\xa2\xb0\x2f\x7d\x28\xe0\xf5\x77\x82\x00\xea\xdc\x51\xa6\x5a\xc8\x4c\x52\xaa\x2b\xec\xbd\x77\x3f\x7a\xc3\xf6\xed\xe8\xfd\xa9\x36\x3b\x50\x59\xfa\x6f\x8e\x1a\x55\xfb\x58\xde\x9e\x2d\x3b\xb1\x25\x99\xbe\x2a\x88\x6c\x2b\xa2\x18\xac\x5f\xb3\x05\xaa\x8d\x61\x13\x84\x3d\xb6\x86\x32\x4a\x65\xe4\x83\x2c\x70\x87\x39\xe7\x5c\xbe\x9e\xc6\xb7\xf7\x33\x36\x5f\x66\x6e\xde\x1b\xf2\x52\xd9\x64\xbf\xcb\x98\x1e\x06\xab\xc9\x4f\x48\xcc\x63\x9e\xd9\x03\xb9\x39\x80\x50\x07\x7e\x35\xd1\x05\xde\xbc\xfd\xf4\xf9\xed\xf5\xd5\xdd\xdb\x37\xb0\xea\xa0\x0b\x82\x8b\xeb\x20\x8a\x6a\x2f\x82\xc8\x12\xcf\x06\x23\xbb\x26\xb0\x02\xa9\xe0\xe1\xf5\xfa\xf5\x9f\xd6\xc7\x46\xa9\x9a\x68\x36\x54\x3e\x3b\xec\xff\x70\xa4\xac\x9f\xfc\x7d\xe3\xba\x13\x3a\x07\xb5\x25\x39\xc1\xac\x76\x38\x00\x12\x40\xaa\x50\xf0\x4c\x61\x72\x52\x14\x90\x36\x96\x3a\xd6\x5e\x4a\x7c\x87\xce\xba\x88\xe5\x08\xc4\x8e\x09\x09\xd4\x88\x85\x10\xd8\x0a\x59\x10\xe2\x06\x6d\x5d\xb8\x56\xcd\x00\xa7\x55\x1f\x00\xc0\x37\x53\x52\x5c\x65\xd1\x81\xd3\xac\xe9\xd1\xef\x0d\xe9\x26\x08\xdb\xd6\xe7\x41\xc8\xf4\x7c\x38\x2b\x38\x4d\x0e\x36\xaa\xe0\x7a\xe0\xfe\x91\x18\xf9\x14\x6f\x01\x00\x42\x7b\x7a\xe4\xb7\x23\x26\xb7\x3b\x17\x31\x27\x65\xb6\x4a\xdb\x49\x39\x28\x0d\x49\x27\x1c\xe3\x4b\xab\xa2\x14\xcc\xe4\xe8\x6d\x13\xc1\x3e\x00\x00\xa4\xa8\x6e\xec\x1c\x2b\x46\x7d\x31\x0e\x7b\xc4\x14\x8f\x27\x13\xfe\xad\x24\x32\x27\x55\xe3\x66\xdb\x15\x2e\xb6\x51\x4c\xc3\x9f\x84\x2c\x6a\x83\x31\x98\x9d\xc8\xa4\x52\x85\x64\x83\x50\x51\x1b\xdc\x86\x8a\x20\xb5\xda\xc4\x0e\xa3\xc0\xa9\x98\x49\x52\xc2\x65\x6b\xe3\xfb\x17\xc2\x81\x1e\xb4\x39\x00\x10\xe5\xca\xe7\x62\xc1\x5a\xb7\x93\xbd\xf5\xe2\xe9\x52\x35\xdc\xe4\x07\x78\xa6\x86\xff\x08\x4c\x38\x1a\x04\x78\x6a\xf3\x7f\x14\xec\xe0\x50\xc0\x53\x06\x01\x46\x21\xff\x8e\x03\x02\x4f\x52\xa8\x4c\xe7\x38\x8b\x75\xb7\xf5\x6e\xe7\xcb\xdf\xff\x79\x77\xf7\x29\x66\x21\xf4\x78\xd3\xfe\xa0\x00\xb3\xb6\x4b\x78\x05\x72\x3b\x02\x13\x62\x61\x6a\xcc\x08\xb4\x62\xcd\x1f\x7f\x98\x3c\xd5\x50\xcc\xd9\xa0\xee\x84\x2c\xec\xac\x93\xbd\xa5\xa9\xa0\x1c\x73\xa0\x92\x11\x08\x6b\x75\x26\x39\x3c\x4e\xea\x6b\x38\xa7\x5a\xfb\x92\xcc\x84\x4c\xd2\x5d\x2c\x19\x5e\xb6\x41\x3a\x0b\xfa\x51\x01\xa6\x37\x78\xb4\x8e\x82\xd0\x51\x88\x31\x6f\x8a\x4a\xef\x31\x4c\x49\xff\x60\xbb\x31\xd3\x14\x27\x97\xa3\x30\x9d\xe6\xe8\x23\xe8\x19\x7e\xcd\xb0\x0a\x05\x23\x8f\x74\xca\x0a\xc2\x71\x88\xd6\x63\xbc\x3a\xed\x73\x00\x32\x51\xdb\xa9\xdf\x07\xfa\xe6\xd7\xfc\x88\xb7\xc5\x20\x55\x56\xd4\x39\x5a\x28\xb5\xc1\x48\xc0\x16\x97\x26\x00\x43\xc3\xc1\x5b\x96\xcc\x90\x1b\x6f\xbd\x35\x5e\xc3\x07\xed\xd8\xe3\xb6\x7f\xe5\x68\x70\x12\x68\x28\x6d\x04\x5c\x30\x0f\x47\x5c\x4f\x3c\x34\xe1\xb7\x9f\x42\x4b\x00\x88\x15\x91\x53\x37\x1d\xa7\x58\x77\xfb\xe0\x7d\xa2\x5b\xef\x0e\x7a\xec\x85\xf5\xc7\xc8\x4f\xc2\x0d\xae\x1c\x8d\xd1\xd4\xfe\xb2\xec\x71\x59\x6a\xa4\xb3\xf0\x5f\xb7\x1f\x3f\x80\x45\xc3\x11\x81\x18\x73\x2b\xc7\xff\xde\x37\x8c\x86\x9c\x98\xa2\x72\xa8\xb4\x75\x54\xc8\x89\x33\x1a\x6c\x66\x14\x9b\xa0\x19\x10\x85\xf3\xe6\x93\x6c\xee\x15\x09\x92\x8f\xa6\x7f\x45\xa3\x57\x52\xe5\xf8\x95\xf2\x2b\xf8\x89\x28\x72\x9a\xe3\xd1\xd7\x55\x28\x8c\x97\x43\xae\x9f\x71\x63\x4c\x2a\x10\x2a\xc8\xaa\xde\x06\x59\x80\xbc\xc6\x39\x84\xd4\x9e\x27\x96\x32\x2b\xf2\xe0\x65\x5d\x38\x59\x15\xe8\xa9\x4b\xf9\x4a\xb0\x00\x9c\x28\xbc\xf5\xbd\x22\x7b\x39\x03\xf4\x17\x80\x2f\x67\xc4\x99\x2f\x67\xb0\x02\x97\xb8\x9f\x2e\x6a\xd5\xce\x96\x66\x40\x4c\x02\x43\x90\x59\xa0\xff\xf6\xea\xef\xeb\x89\x57\xcc\x80\x19\x90\xd8\x4a\x63\x5d\xa0\x61\x28\x78\xab\xf8\x92\x2f\x67\xa7\x01\x9d\xf4\x72\xcd\xbf\x12\xad\x15\x3b\x7c\xa2\xfa\x5c\xc1\xbe\x2e\x85\x5a\x19\x14\x39\xb7\x52\x5b\xbf\xa6\x09\x1f\xe2\xfc\x9c\x33\xfb\xdb\x99\xc3\x6b\x68\x7b\x82\x50\xdf\x6c\xa6\x35\x84\x5d\x4d\x78\x87\xae\x4d\x07\xc3\xd5\xb1\xf5\x73\x12\xcb\xbb\x80\x27\xd3\xaa\x14\xd9\x5e\x2a\x9c\xa2\xd6\xe2\xf4\xa1\x98\x9e\x47\xd4\x8a\x05\x59\x8e\xa6\x52\x06\x4e\x77\x98\x39\x20\xd9\x61\x72\xf4\x45\x31\x06\x61\x23\x1e\x84\x2c\x08\xc7\x67\xa4\xdb\x89\x44\xa3\x7b\xdb\x70\xc2\x11\xff\xf9\x59\xe1\xa7\xf8\x4e\x7e\xa2\xb1\x7e\x3d\x6b\xff\x54\xc7\xe9\x43\xba\x8e\x87\x5c\x2f\xbe\x93\x48\xc7\xc3\xaa\x93\x87\x3a\xa7\x53\xd1\x13\xbf\xf1\xa1\xe0\xa3\xf2\x95\xc5\x66\xe0\xca\x87\x72\xdc\x43\x99\x84\xdb\xea\xe5\x85\xde\x66\x83\x1a\x8d\xde\xfe\x4e\x03\xab\xdf\xc4\x8b\xe9\xa2\xc0\xd8\x50\xe3\x6f\xca\x0a\x78\x11\x06\xed\xd0\x60\x98\x5a\x96\x6a\x57\xe0\x78\x6a\x9f\xa0\x72\xa1\x38\x13\xca\x4f\x62\x10\xe6\x1b\xcc\x5f\x7e\xb7\xc0\x72\x1b\x83\x7b\x10\x23\x73\x62\xa3\x14\xbb\xd9\x36\xdd\x88\x65\xbb\xed\x91\x66\xc8\x9a\x2e\xf1\xe4\xd1\x92\x54\xb6\x26\x64\xfd\xcc\x6d\xbe\x86\x5b\x5d\x06\x13\x19\x27\xb1\x7d\x57\x65\x31\x1d\xc5\xa5\x6e\x0d\x17\xeb\x1c\x35\xc5\xb8\xda\xc8\xd9\xae\x43\x10\x19\xbf\x70\x15\x12\x3c\x6d\xe3\x4b\x4e\xc0\xed\x38\xb4\x88\x0b\xec\xf5\xa3\x1f\x12\x72\x1a\x1e\x85\x74\xe9\xe4\xe2\xfe\xa4\x45\xdd\x63\x0f\xad\x29\xa6\xce\xc9\x21\x61\x56\x1e\x09\x00\x50\xcb\x27\x58\xab\x9f\x6f\xde\x1c\xeb\xc4\x7a\x4c\xa0\x17\xb3\xc2\xad\x31\xa1\x7e\xf2\xb8\x73\x33\x3e\x60\xff\x50\xcb\xef\xb6\x1d\x27\xdd\xdc\x94\x99\x7f\x86\xfd\x84\xc5\xa4\x00\x7e\xc7\xae\xc2\x62\x86\xc6\x7c\xd3\xde\xc2\x28\xe0\xdf\xdd\x3d\x9c\x64\xef\x89\x30\xf9\xc9\xc1\x71\x30\xf3\xa7\xca\x7a\xc9\xca\xad\xbf\x1d\xf1\xfe\x82\xc6\xb8\xe0\xdd\x3a\xa1\x72\x61\x72\xdf\xc8\x88\xcf\xfe\x0b\xfc\xf5\xac\x4a\x8a\x26\x4d\xa8\xe7\xbb\xeb\xf8\x40\x7b\x8d\x43\x6e\xd3\xec\x2a\xff\x2d\xa0\x90\xa5\x74\x8b\x19\x59\x9a\x4a\xf3\xcf\x9c\x98\xa5\x3a\x54\x98\x81\x0d\x76\x3e\x34\x0a\x4e\xf9\xb3\x30\x0c\xb1\x17\xb1\xb0\xc3\xb5\xb7\x14\x8d\x73\xa8\x91\xa2\x7c\x5d\x09\x9a\x50\x18\x1a\xfd\x6b\xff\x0b\xc7\x8c\xdb\x12\xd2\x5a\x7e\x48\x87\xb1\x89\x30\x54\xa9\x8f\x17\x93\x84\x3b\x8d\x69\xde\x74\x00\xc1\xe9\xb4\xed\xe2\xe9\x82\x5f\x53\xb7\x31\x9d\x60\x9a\xa0\xb1\x2b\x7a\xed\x39\xe4\x3b\xfa\xdc\x38\xb2\x0e\x95\x0b\xe2\xd8\xf4\x14\x2b\x6d\x87\x27\x7f\xdb\xff\x02\x6b\x03\x65\xa9\x0e\x28\x77\xb5\x57\x27\x5f\xdf\xd9\x0b\xb5\xf3\xd3\x22\x4d\x0d\x43\x4c\x47\xb6\xf8\x08\xa5\x54\x54\x46\xf1\xdd\xef\x66\x52\xa8\xf1\x6f\xb1\xa0\xef\x7d\x7e\x94\x8a\x13\x81\x1a\x2a\xa8\xad\xb7\xeb\xbe\x67\xe6\x25\xb5\x35\x7c\xb4\xc1\x30\xf0\x96\xa5\x29\xd4\x49\x98\x41\x5a\xda\x15\x85\xd0\xaa\x42\x1a\xc6\x2c\xd0\x5a\x38\xe8\xda\x9f\xc3\x60\x86\xf2\xe1\x04\x96\x8c\x9a\xd3\xf7\xa8\xbc\x93\x10\xca\xc7\x3f\xd1\x3a\x3e\x43\x5c\xd9\xa1\xe0\xfc\x28\xe3\xd6\x35\x0d\x9f\xe4\xd6\x6d\x8b\xfd\xe7\xe7\x36\xb5\x2d\xa6\xa9\xe6\x5f\x1d\x2d\x73\xda\x60\x20\xc8\x21\xe6\x88\x03\x70\xb1\x7f\x34\x30\x50\xd5\xc5\x34\xce\xad\x32\x97\x83\xac\x7b\xb2\x07\x11\x5c\xc3\x2f\x7e\x48\x3b\xcc\x4b\x3a\xdf\xf7\x9f\x04\x2b\x92\x19\x68\xa1\xc2\x75\x42\x16\x49\xa8\x55\x6a\xbc\x6f\x44\x76\x3f\x47\x62\xe2\xa4\xd7\x9c\x15\x97\xc6\x23\x4c\x82\x7c\x06\x6f\x91\x69\xe5\x8b\x72\xd9\x61\x15\x86\x60\x56\x42\xe5\xab\x64\x1e\xb2\xc3\x77\x67\x7d\x16\x8b\xed\x3b\xa9\xee\x67\x4b\x5c\x7c\xc0\x47\x69\x3f\x7f\x7e\x77\x1c\x9c\xcd\x68\xee\xc2\xbc\x6d\xa2\xdf\x38\x2a\x9d\xae\x69\x3d\xb1\x92\xf5\xb8\x0f\xa3\x21\x29\x70\x19\xc5\x5e\xa6\xc1\xf9\xb3\xd0\x0d\x3e\x0b\x51\xd1\x74\x59\x6b\xaa\x3f\x34\x5a\xcc\x82\xab\x38\x07\x98\x15\xc2\x78\xe3\x20\x94\xef\xdc\xf9\x97\x4e\x44\x19\x39\xc2\xa6\x76\x90\x6b\xf4\xfd\x25\xfd\x80\xc6\xc8\x1c\x41\xba\x6f\x0e\xcb\xfc\x4b\x67\x07\x65\x29\x56\x6c\x95\x63\xa8\x42\x83\xa0\xb7\x97\x70\x76\x5b\x67\x34\x92\x70\x36\x34\xb0\x13\xff\x25\x2a\x3f\x77\x34\x47\xf9\x3c\x2b\xa4\x3f\xd3\x37\x86\xd8\x13\x72\x3a\x3e\xe3\xb0\x1a\x99\x7f\x19\x05\x56\x88\x0d\x16\xbf\xf5\xf6\xf1\x7b\xc1\xe3\xe1\xfe\x4e\x5a\x36\xf6\x76\xd9\x77\xbc\xfb\x9e\xc4\x69\xd0\x66\x27\xa8\x5d\x3e\x38\x45\x4a\x41\xe4\x4e\x1b\xf9\x2b\xc2\x0b\xfe\xb8\x01\x5f\xb5\x58\x60\xe6\x5e\xb6\x96\x7d\xc5\x01\x4a\x1e\x63\xf3\x3f\x69\x63\x87\xe6\x1f\x0d\xd2\xa8\x9a\xd7\x8f\x66\xa4\xd0\x06\x98\xfc\x41\x83\x6f\xd8\x1c\xf6\x74\x9d\xbd\x34\x5c\x0a\x25\x76\x98\xfb\x6e\xd3\xf4\x28\xe4\xfb\xf6\xad\x50\x8a\xca\x02\xed\xa6\x6c\x0b\xfd\xb8\x92\x7e\xfc\x2b\xba\x6c\xef\xe1\x06\x97\x4b\xf5\x36\x36\x96\x98\xfc\xc2\x60\xc4\xc1\xdb\x5d\xe1\x12\xd4\xd0\x8b\x96\x14\x87\x5b\x57\x1c\xc2\x4c\xcf\x48\xe8\xb0\xd7\xb5\xc5\x7b\xc4\x4a\xaa\x9d\x8f\xfb\xfd\x04\x9d\x3b\x54\x14\xa7\x15\x87\x50\x9e\xa2\x29\x41\x15\x3a\xd2\x61\xfb\xaa\x56\x39\x1a\xeb\x86\x82\xf8\xa6\x64\x44\x96\x2b\x62\x16\xa5\x26\xe6\x2b\xe7\xbe\xd5\xb8\xec\x0c\x87\xc6\x8b\x7d\x12\x98\x66\xbe\x9d\x02\xf3\x66\x60\x56\x54\x15\x0d\x01\x0a\xb7\x87\x42\xde\x23\x7c\x39\xcb\xe4\x2a\xcb\xbf\x9c\xf9\xb0\x36\x44\xf2\x9e\x7e\x43\x9b\x0e\xa2\x78\x14\x87\x64\xcd\x13\x37\x42\xd6\xd3\xa0\xcf\xd2\x7e\xb4\xab\x3e\x14\x92\x04\xbf\x09\x5f\xd4\xf1\x6c\x2a\xcf\xfd\x79\x9d\x60\x4a\xb4\x22\xf8\x38\xeb\x47\x85\xd4\xa1\x09\x6f\xa5\x9d\xcc\xb0\x37\x01\x38\xd2\x88\x9e\x4e\x3f\x4f\x0d\xf9\x74\x9d\xe6\xe4\x84\x4f\xeb\x4b\x1e\xad\xf6\xf3\x62\x22\xfe\xf6\xd4\xe0\x54\x95\x47\xbe\xe3\xa6\x3c\x86\x2a\x1f\x48\x0b\x67\xdc\xf5\xb8\x08\xef\x38\x83\x7f\xd4\x76\x0c\x26\x73\x9c\x10\x72\xba\x5a\x15\x64\xe3\xdb\x18\x07\x19\x0c\xab\xdc\x48\x4e\x46\x98\x03\x38\x0d\xce\x88\xec\x7e\x14\xcf\xce\xf9\x44\x0b\xe7\x0d\xfa\x36\x96\x64\x1b\x18\xb2\xb9\xb0\xef\xe5\x15\x66\x31\xe6\x86\x79\x68\x69\x68\x86\x7d\x86\x77\xd9\x0e\x5a\x9a\x09\xeb\x0f\xce\xd4\x78\x9a\xb9\xc1\x2c\xb5\x52\x0e\xd1\xd5\x97\xf5\xb7\x8c\xde\x79\xd3\x64\x66\x08\x97\xb7\x8e\xa6\xbf\x0f\xa5\xb7\x5d\xdd\x63\x90\x13\x51\xe2\x1e\x2d\xce\x40\x79\x94\xc0\x29\x2a\x99\x81\xf4\xc7\x78\x6f\xfc\xae\x0e\xc1\x26\x8c\x13\x90\x50\xe3\x2d\x50\xe4\xe3\xd9\x15\x6b\x43\xc7\x3d\xbc\xe5\x56\xf9\x06\xc9\xb0\xa4\xcf\x10\x90\x66\x50\x1c\xed\xb7\x6c\x82\x17\x1e\x9f\xb5\x6a\x2b\x99\x30\x08\xe7\xb4\x58\x71\x38\x67\xab\x73\xfe\x33\x97\x31\xcf\xbf\x89\x42\xd4\xe7\x98\x41\x9c\x3b\xe9\xf7\x36\x5c\x7b\xd3\x2c\x96\xcb\x13\x8f\xe0\x11\x0d\x4e\x4d\x8d\xdd\xa4\x95\x93\x60\x9d\xd3\x16\x9e\xdc\x76\x19\x10\x0e\xb8\x98\xea\x1b\x8c\xed\x07\xce\x38\xf8\x84\xa8\x8f\x35\x7c\xd5\xa9\x35\xb5\x73\x5e\x6e\x89\xc9\x72\x58\xd7\x21\xc3\x2f\x15\x88\xe6\x5b\x1f\x6b\xb8\xb1\x29\x78\x1c\xfe\x4e\x80\x5f\x85\x50\xbb\x64\x7e\xed\xb2\xd9\x72\xe6\xee\x67\xfa\x81\xcb\x4f\xfc\x81\x83\xb4\xb2\x3e\x24\x9b\xcd\xae\x32\x76\x37\x51\x40\x28\xb2\xd8\x46\x57\x46\x0a\x17\xfb\x86\x6d\xcb\xb7\x1e\x5e\xf8\x92\x16\x2a\x23\x4b\x61\x24\xaf\x43\x84\xc9\x39\x12\xd5\xb4\xc8\xd1\xec\xdd\xf8\xe0\xb0\x5b\xeb\xca\xd3\x77\xbb\xfa\xd2\x32\x50\xa2\xff\x9e\x36\x0a\xd3\xfe\x7c\xee\xea\x4f\xe2\xd4\x74\x08\xf8\x21\xde\xd6\x71\xa0\xfe\x4a\xe0\x3a\xad\xf4\x83\xea\x4b\x45\xff\xc0\x57\x2a\xe8\x41\x7a\x39\x48\x0b\x24\x24\x0f\xa2\xf0\x3c\x65\xf0\x5f\xce\x72\xdc\x8a\xba\x70\x5f\xce\x9a\x5b\x97\x94\x08\xf6\x40\xb6\x6f\x0d\x16\x2d\x13\x4a\x2b\xe2\xea\xd1\x60\x6e\x33\x62\x17\xe2\x76\x10\x06\x93\x8c\x0e\xad\x51\x6e\xd0\x7f\xd1\x2c\xa7\x3f\x5a\xc2\x1d\x26\x8c\xd8\x9c\xa5\x20\xc2\x9b\xad\xa6\x3b\x19\x5e\x32\xbc\x72\x1e\x2d\x02\x07\x5a\x71\x53\x57\xc0\x9b\x0f\xb7\xff\xfb\xee\xea\x3f\xde\xbe\x5b\x4f\x0b\x47\x3f\x14\x9e\x23\x2c\x09\x7f\x3b\x7b\x41\x4c\x3f\x2a\x34\x9f\x91\x17\x37\x33\x9c\x4e\x17\xde\x85\xfd\x8b\x70\x70\xc8\xb1\xf2\xea\xb2\x39\xf4\xf6\x92\xae\xde\xbd\x1b\x25\x50\x88\x65\xb9\xec\xcc\x85\x3a\x5e\x4b\x4a\x13\xe6\x9d\x6f\xde\x04\x5a\xee\x84\xd9\x88\x1d\x42\x46\x61\x78\xe6\xa6\xb6\x57\x9b\xdd\x88\x56\x12\xd2\x0e\xe2\xe9\x0d\x7e\x17\x28\x4d\x7f\xa5\x72\xfb\x30\x33\x43\xed\x5e\x37\xe5\xe3\x08\x29\x4d\x16\x34\x17\x5b\xf1\x18\x3d\x61\x86\xf4\xe4\x8e\x6b\x2d\x4d\x8c\xd6\x9e\xf2\xc3\x14\x4e\xb4\x80\xae\xff\x15\x91\x75\x37\x8c\x46\x30\x5e\x4c\xdc\x37\x79\x68\xfe\xd2\xc6\x47\x92\xb6\xf8\x29\x96\x19\x48\x10\x4f\x0d\x0d\xc1\x5f\x7d\x78\x13\x3b\x0e\x2c\xb1\x69\xc5\xf7\x8c\xba\xfa\x14\x90\xab\x3c\xc2\x1d\x9b\xe0\x4b\x6b\xf5\x41\x00\x1a\x60\x0d\x23\x7a\x0b\xf3\xf7\x78\x58\xb1\x19\x18\x01\xea\xbf\x49\xc6\x5f\x5f\x88\xa9\x46\xd0\xa5\xd6\x56\xd0\x1a\xde\x78\x1b\x66\xc1\x69\xd8\x8a\xc2\x52\xcf\x69\x2c\xf4\x4a\xdf\x55\x8a\xcb\xc8\x9c\x8f\x72\x82\x6b\xe1\xcc\x63\x78\x06\x15\x95\xbd\x6d\x9b\x3d\x7c\x96\xe5\x08\x50\x1d\x97\xfb\xe0\x8f\x3f\xfc\x00\x2f\x7e\x56\x61\xd1\x86\xeb\x8c\x6f\x95\x93\xee\xf0\xb2\xf5\x5d\x20\xdf\x55\x99\x62\xf4\x46\xeb\x02\x85\x5a\x0c\x26\x13\x41\x6a\x9f\xc2\xe1\x23\xe2\xb1\xca\xa5\xd5\x88\x19\x1a\x31\x0f\xb7\xf1\x29\x81\x81\x19\x81\x63\xb1\xff\xbd\x1b\xb5\x27\x34\x6a\x7c\x98\x6a\x20\x9e\x3b\x75\x96\xef\x0f\x44\x66\xe1\x3c\x3a\xdd\x32\x31\xd7\xf2\x1c\x18\x8f\x4f\xa0\x4c\x22\x3c\xb5\x00\xb6\x6a\xd9\xd3\xc1\x9f\x89\xb3\x83\x3f\x8c\x4c\x96\xad\x88\x3a\xcf\x11\xe2\x9f\x68\xf4\xf5\xb6\xa1\x43\xa7\x8b\xcd\x9c\xaf\x2c\x35\x83\x2c\x61\x63\x31\xae\x24\x25\x87\x30\x5c\x55\x9b\xd5\xcf\x1b\xe9\xd9\x0d\x2c\x2c\xb7\x7b\x78\xef\x5b\xed\x76\x8a\xc1\x68\x5b\xa5\x94\xd6\xc9\x0c\x5a\x3d\xac\x65\x78\x80\xdf\xc1\x93\x5b\xe3\x1f\x0f\xf0\x6b\xc9\x4d\x5a\xac\x55\xfb\x8b\x94\xda\xc4\x5a\x43\xbc\xd4\x7c\x12\xaf\x07\xd2\x8f\xb4\x51\xc2\x10\x12\x49\x9f\x08\xb7\xda\x88\x4f\xef\x1d\xc6\x7e\x21\x7f\xbe\xb2\x6c\x7d\x53\xcd\xa7\xda\x44\x03\xe1\x3f\x1a\x94\xd5\x85\x30\x03\x98\x8f\x7e\xba\xcc\x4e\x7d\x5f\xa7\xd3\x88\x9c\xd7\x39\x1d\xed\x96\x3e\xb7\xc9\x9c\xd1\xad\x9c\x1d\xf9\x8e\x75\x25\x8f\x3e\x55\x3c\xbb\x13\xd9\xa1\xe7\xe0\xae\xf8\xc9\xee\xe3\x28\xae\x03\x66\xb3\xab\xc5\x64\x30\x43\x76\x14\x32\x76\xa9\xc2\x47\x34\x54\x1e\xb2\x39\xaf\xdf\x47\x5f\x0f\x1c\x88\xa3\x1d\xc8\x76\x89\xbd\xf9\xc6\x48\xf7\x6b\x76\x5a\x81\xf5\x9d\x31\xfa\x08\x53\xca\x96\x07\xc4\xae\xa5\x55\xad\xef\xd7\xc5\xcf\x19\x3a\x1d\x75\x56\x2b\xf8\xf4\xf3\x5d\xe7\x1b\x94\x6d\x31\x1d\x5a\x6d\x3f\xd9\x3f\xff\x36\x57\x31\x53\x88\x06\x6d\x73\xbf\xf9\x38\x70\xdb\xd1\xa5\x60\x57\xc3\x53\xde\x43\x5c\xc2\xc3\x6b\xae\xc9\xbf\x5e\xb4\x0c\x42\xde\x29\x9e\x86\x35\xdd\x70\xad\x79\xb3\xc8\x68\xd1\x0e\xf3\x0f\xc7\x1f\xfb\x3e\x3b\xeb\x7c\xe0\x9b\xff\xa4\xc9\x60\xff\xe1\xb1\x4b\xf8\xdb\xdf\x17\x10\xbe\xd9\xfb\x4b\xc4\x09\xfe\xf6\xf7\xff\x1b\x00\x1c\x6e\xfd\xcd\xda\x5c\x00\x00"),
+		},
+		"/crds/sidecar-templates.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "sidecar-templates.yaml",
+			modTime:          time.Date(2026, 10, 19, 10, 39, 25, 116430115, time.UTC),
+			uncompressedSize: 23786,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x3c\x6b\x73\xdb\x48\x72\xdf\xf9\x2b\xba\x74\x1f\x64\x57\x91\x94\xbd\x7b\x97\xca\xe9\x9b\x22\x7b\x2f\xca\xf9\x55\x96\x7c\xa9\x54\x9c\x4a\x0d\x81\x26\x39\x27\x60\x06\x37\x33\x90\xcc\xfd\xf5\xa9\xee\x79\x00\x20\x1e\x84\x6c\xed\x5e\xfc\xc9\x02\x81\x46\x4f\xbf\x9f\x10\x95\xfc\x1b\x1a\x2b\xb5\xba\x04\x51\x49\xfc\xe6\x50\xd1\x5f\x76\x7d\xff\xaf\x76\x2d\xf5\xc5\xc3\xeb\x0d\x3a\xf1\x7a\x71\x2f\x55\x7e\x09\xd7\xb5\x75\xba\xfc\x8c\x56\xd7\x26\xc3\x37\xb8\x95\x4a\x3a\xa9\xd5\xa2\x44\x27\x72\xe1\xc4\xe5\x02\x20\x33\x28\xe8\xe2\x9d\x2c\xd1\x3a\x51\x56\x97\xa0\xea\xa2\x58\x00\x28\x51\xe2\x25\x58\x99\x63\x26\x8c\xc3\xb2\x2a\x84\x43\xbb\xbe\xaf\x4b\xb1\x96\x7a\x61\x2b\xcc\x08\xc0\xce\xe8\xba\xba\x84\x78\xd9\x3f\x67\xe9\x17\x00\x8f\xc7\xad\x07\x71\x17\x40\x2c\x00\x00\xaa\xa2\x36\xa2\xe8\x83\x5f\x00\xd8\x4c\x57\x78\x09\x67\x67\x0b\x80\x07\x51\xc8\x9c\xf1\xf3\x00\x75\x85\xea\xea\xd3\xcd\xdf\x7e\xbe\xcd\xf6\x58\x0a\x7f\x11\x20\x47\x9b\x19\x59\xf1\x7d\xc7\xaf\x03\x69\xc1\xed\x11\xfc\x13\xb0\xd5\x86\xff\x3c\x7e\x31\x5c\x7d\xba\x59\x00\x00\x00\x54\x46\x57\x68\x9c\x8c\xa7\x00\x00\x68\x91\x3e\x5d\x3b\x7a\xef\x39\x21\xe6\xef\x81\x9c\x88\x8d\xfe\xc5\x0f\xfe\x1a\xe6\x60\x3d\x0a\x7a\x0b\x6e\x2f\x2d\x18\xac\x0c\x5a\x54\x8e\x0f\xd8\x02\x0b\x74\x8b\x50\xa0\x37\x7f\xc7\xcc\xad\xe1\x16\x0d\x01\x01\xbb\xd7\x75\x91\x43\xa6\xd5\x03\x1a\x07\x06\x33\xbd\x53\xf2\xd7\x04\xd9\x82\xd3\xfc\x4a\x3e\x91\xeb\x40\x94\xca\xa1\x51\xa2\x20\x92\xd6\xb8\x04\xa1\x72\x28\xc5\x01\x0c\xd2\x3b\xa0\x56\x2d\x68\x7c\x8b\x5d\xc3\x7b\x6d\x10\xa4\xda\xea\x4b\xd8\x3b\x57\xd9\xcb\x8b\x8b\x9d\x74\x51\xd8\x32\x5d\x96\xb5\x92\xee\x70\x91\x69\xe5\x8c\xdc\xd4\x4e\x1b\x7b\x91\xe3\x03\x16\x17\xa2\x92\x2b\xc6\x53\x39\x16\xd0\x32\xff\x83\x09\x82\x68\xcf\x5b\x88\xb9\x03\xf1\xda\x3a\x23\xd5\x2e\x5d\x66\xb1\x19\x25\xf3\x5f\xa5\xca\x41\x5a\x10\xe1\x31\x8f\x6e\x43\x4d\xba\x44\x44\xf8\xfc\xf6\xf6\x0e\xe2\x4b\x99\xe2\x5d\x12\x33\x71\x9b\xc7\x6c\x43\x67\xa2\x8b\x54\x5b\x34\xfc\x14\x6c\x8d\x2e\x19\x22\xaa\xbc\xd2\x52\x39\xfe\x23\x2b\x24\xaa\x2e\x8d\x6d\xbd\x29\xa5\x23\xc6\xfe\xa3\x46\xeb\x88\x1d\x6b\xb8\x16\x4a\x69\x07\x1b\x84\xba\xca\x85\xc3\x7c\x0d\x37\x0a\xae\x45\x89\xc5\xb5\xb0\xf8\xdc\x54\x26\x82\xda\x15\x51\xf0\x34\x9d\xdb\x76\x00\x60\x5c\xf8\x01\x00\xf8\x14\x2c\xa8\x47\x3f\x00\x88\x3c\x67\xbb\x22\x8a\x4f\x23\x0f\x8f\x62\x30\xa8\x46\xcd\x9b\x98\xcd\x0a\x6a\x65\x9d\xa9\x33\x57\x1b\xcc\xe1\x1e\x0f\x81\xe3\xa5\xa8\xc0\x3a\x4d\x17\x1f\xa5\xdb\xf7\xde\x28\xda\xdc\x17\x8e\xd9\xba\x41\xb0\xe8\x60\x73\x00\xfc\x16\x14\xc2\x69\x5d\x10\xab\x3c\x2c\x56\x0c\x83\xce\x48\x7c\xc0\x3e\x48\xb3\x91\xce\x08\x73\x48\xb4\x5b\xc3\xdd\x1e\x0f\x20\x0c\x02\xb1\xf9\x1f\x35\x9a\x83\xd8\x14\x1e\x4e\x50\xd8\x0d\x02\x0b\x99\x79\xc0\xbc\x07\xf2\x71\x8f\x0a\x4a\x9d\xcb\xed\x81\x24\xd7\x8b\x65\x5f\xf9\x2e\x2f\x2e\xee\xeb\x0d\x1a\x85\x64\x84\xa5\xbe\xc8\x75\x66\x2f\x6a\x8b\x66\xb5\xab\x65\x8e\x17\x2d\x06\x9d\x2f\x86\x48\xef\x21\x77\x7e\xca\x8a\xda\x3a\x34\x1f\xc8\xd2\x4f\xf1\xe4\x6e\x8f\x6c\xd6\xbd\xe9\xc2\xf8\x1c\x3c\xee\x65\xb6\xe7\x2b\x1e\x38\x6c\xb0\xd0\x6a\xe7\x05\xff\xee\x58\xe3\x00\x00\xa4\x85\xda\x62\x0e\x4e\x43\x2e\x2d\xe9\x6a\x2d\xed\x3e\x31\xca\x32\x27\xc1\x8a\x32\xbc\x90\xa8\x48\xff\xb1\x95\xc8\x88\x1c\x90\xcb\xed\x16\xcd\xb1\xe6\xb5\x0e\x63\xfd\x9b\x61\x2b\xb1\x60\x3b\x41\x6c\xb1\xe8\x40\xa8\xc3\xe3\x1e\x0d\x82\x91\xbb\xbd\x03\xa5\x1f\x19\xba\xa8\x24\x73\xc6\xc0\x00\xba\x3b\xcd\xd6\x44\x83\xdc\x29\xe6\x87\x03\xb9\x65\x68\x52\x79\xd7\x89\xa0\x4d\xd0\xec\xa8\xf7\xeb\xc5\x4c\xc9\xef\xfb\xde\x29\x26\x9c\x5d\x1f\xdf\xce\xea\x01\x2e\xfd\xd9\x33\x81\xfe\x60\x7d\x55\x94\x25\x7a\xb9\x63\xfb\x16\x78\xf7\x28\x6c\x38\x12\x99\x28\x17\x49\xb7\xab\x85\x11\xca\xa1\x67\x9a\xd7\x9f\x3e\x5b\x15\xec\x45\x55\xa1\xb2\xab\x0d\x6e\x89\x52\xda\xe4\x68\x40\x64\x46\x5b\x0b\x16\x2b\x61\x98\x56\x15\x1a\x2f\xa3\x6b\xb8\x66\x03\xea\xad\xad\xd2\x7d\x98\x16\x9d\xc7\x8f\xb5\x3d\xa2\x94\xce\x88\x39\x48\x05\x9f\x7f\xb9\xfe\xf9\xe7\x9f\xff\x4c\x6e\xbd\x64\x76\x4a\x4b\x97\xbf\xdc\x5d\xaf\xe1\xab\xea\xc1\xfc\xa4\xab\x9a\x9c\x63\x0e\x9b\x83\xa7\xd0\xc1\x3a\x2c\xd7\xf0\x19\x45\xbe\xd2\xaa\x38\xac\xe1\x43\x5d\x14\x04\x0f\x0a\x69\xdd\xb3\x7b\xc1\x68\x37\xce\x8e\x70\xa3\x03\x08\x77\x09\x24\x48\x2b\x62\xd0\x5c\x21\xca\xb1\x40\x82\xfe\x17\x23\x32\xfc\x84\x46\xea\xfc\x16\x33\xad\x72\x3b\x29\x4d\x1f\xea\x72\x83\x06\x34\x49\x33\xdf\x0d\xa2\x28\xf4\x23\xe6\x21\x42\x6a\xe4\xc2\x69\xd8\x11\xec\x6d\x5d\x14\x87\xbe\x2c\xa1\x29\xa5\x12\x0e\x21\x30\x5e\x3a\x78\x94\x45\x01\x1b\x04\x83\xa5\x7e\xc0\xbc\x71\xa0\x91\xda\x1f\x55\x71\x60\xfe\x92\x10\xf6\x40\xc6\x13\x75\xe5\xbc\xb0\x9a\x1e\x59\xc3\x7b\x71\x00\xe2\x14\xcb\xe2\x5e\x1b\x87\x0a\xf3\x36\x07\x47\x28\x2b\x95\xfb\x97\x3f\x0e\x52\x95\x62\xa3\xdd\x91\x9e\xf4\x90\x98\xd6\xcd\x37\x43\x38\x7f\xfe\xe5\x1a\x58\x3a\x89\xa9\x2c\x9d\xc4\x58\x10\x2e\x19\xce\x01\x93\x93\x7c\x56\xa4\x22\x63\x82\xf9\xb1\x59\x0b\x6e\xac\x51\x73\x26\x26\x88\xc4\xac\x51\xba\x7a\x35\x62\x53\xd5\x28\x02\x79\x92\x65\xd4\x20\xa5\x1d\xe4\xd2\x60\xe6\x3c\x9f\x1c\x7b\xb4\x4d\x9f\xfb\x22\x84\x41\xec\x05\x1b\xd4\xa5\x05\xfc\x56\x61\xe6\x92\xd1\x08\x87\x80\x17\x4a\x03\xb9\x08\x34\xf0\x20\xad\xdc\x14\x7d\x1f\xcb\xd2\x92\x40\xb1\x12\x7a\xc4\x08\x2b\x83\x22\xdb\x07\x6c\xd8\x31\xbc\x04\xb1\x75\xe8\x63\x7a\xa6\xae\xec\x0b\x94\x4b\x84\x5b\x82\x56\x1c\x0e\x20\x6c\xa5\x12\x85\xfc\x15\x8d\xe5\x77\x30\xce\x65\xe5\x0e\x6b\xb8\xb2\x8c\x22\x08\x7b\x74\x63\x0f\x30\x3f\x48\x7a\x2f\xa4\xb2\x20\x1d\x96\x76\xd9\x21\xf3\xa6\xd0\xd9\x3d\xf1\xee\x63\x7c\x6d\x4f\xae\x86\x5c\xa4\x45\xb7\x6c\xd9\xbe\x68\x22\x39\x88\x54\x16\x1d\x68\x13\x2c\x31\x6c\x6b\xe3\xf6\x68\x40\xaa\x10\xfb\x6f\x6b\x8a\x93\x96\x7d\x56\x15\x6e\xaf\xeb\xdd\x1e\x64\x13\x09\x45\xed\x81\x90\x14\x25\xaa\x87\x1b\x22\xd7\x2a\x23\xf5\x80\x1b\xd1\x1e\x47\x22\xfb\x1a\x7e\xd1\x06\xf0\x9b\x28\xab\x82\xb2\x0b\x96\xa7\x90\x60\xb0\xa4\xf9\x10\x4c\x40\xa5\x59\xc2\x02\xe4\x21\x47\xf2\xf3\xab\x68\x92\xbc\x54\xfd\xb5\xde\xd0\xcd\x5e\x1f\x88\xff\x2c\xf7\x16\x55\x4e\x6e\xae\x91\xf7\x64\x8a\x8e\x93\x29\x00\x00\x2b\x77\x3e\xd6\xf3\xf1\x8b\x67\x19\xf1\x5e\x2a\xbe\x52\xe9\x7c\x0d\x57\x41\x92\x84\x6b\x21\xb1\x04\xd7\x20\xd1\x8f\xde\x08\x29\xc2\x05\x04\xec\x85\xc9\xdb\x48\xc4\x97\xbe\xb8\xbd\xf9\xcb\x5f\x6f\xde\xbd\x7b\xd9\x7b\x3d\x89\x75\x9f\x51\x8c\x45\x56\xa0\x50\x75\xb5\x0c\x46\x34\x22\xd9\xd8\xd2\xab\x4f\x37\x9c\x49\xf0\x0f\xec\x12\x33\x8e\xcf\x14\xba\x47\x6d\xee\x7b\x60\x2b\x61\x1c\x87\xe9\x76\xd9\x31\xef\xc4\x23\xeb\xe8\x18\xf8\x4d\x5a\x97\xd4\x29\x30\x96\x65\x74\x09\xb5\x72\xb2\x6f\x51\x84\x02\x91\x97\x52\x49\xeb\x8c\x70\xda\x80\x36\x20\x6a\xa7\x4b\xe1\xa5\x46\x67\x68\x2d\x64\x42\x41\x8e\x9e\x30\xd8\x95\xb3\x01\xfb\xc7\x6e\xa6\x71\x2b\x14\x8b\x6c\x63\x0c\xb7\x6c\x98\x9d\xb4\x2c\x84\xa4\xe1\x34\x7b\xd1\x87\xe8\x35\x07\x55\x63\xf4\x28\x36\x18\x8b\x05\x8e\xcd\x68\x7a\xd3\x90\xa2\xb6\x20\x36\xfe\xe7\xff\x7b\xc4\xd0\x18\xb4\x49\x9f\xf6\xbe\xb6\x44\x37\x6f\x15\xa3\x77\x6f\x91\xba\xd1\xe2\x46\x28\x0d\xee\x48\x16\x7a\x3e\x18\xe0\xad\xc8\xf6\x80\xca\x99\x43\x48\xea\x64\x4e\x67\xdc\x4a\x34\xa9\x26\x63\xd0\x56\x5a\xb1\x57\x80\x4c\x97\x95\x56\xa8\x82\xe1\x20\x3d\x1b\x70\x95\x49\x35\x3c\xe4\x84\x07\x19\x66\x16\x9c\x41\x93\xdb\x95\x99\x21\xbe\x2a\xad\x56\x4a\x16\x4b\x86\x2b\x31\x98\x09\x19\x5c\x05\x09\x74\x8c\x40\x42\x8c\x73\x7c\x60\xf6\x05\x4f\x4a\x82\xfd\x4f\xc2\x18\xd1\x75\xb3\x3b\x54\x14\x33\xe3\xc9\x24\xed\xec\x2f\xad\x3b\x03\x91\x75\xe5\x13\x73\xa8\x0c\x6e\xe5\xb7\xa5\x4f\xbe\x3a\x61\xc3\x72\xc8\xae\xc7\x97\x82\x80\x5a\xc9\x7f\xd4\x21\x1b\xfb\xf8\xe1\xdd\x7f\xc1\xcd\x2f\xfc\x34\xbf\x85\x9d\x2a\x29\x5d\xa3\x64\x95\xd1\x0f\x32\xef\x53\x04\x3c\x3b\xda\x21\x0c\x21\xe3\xcd\x2b\x43\x37\xe8\x6a\xa3\x7c\xc8\xd0\x54\x58\x9a\x38\x68\x34\xf3\x73\x7b\xa1\x1a\x30\x95\xb0\x36\x85\x4b\xde\x7f\x32\x08\x8e\x20\x37\x2c\x59\x1b\xa9\x42\xd1\x20\x1d\xb0\xef\x31\xea\xed\x56\x7e\xf3\x2e\x28\x9e\x29\x80\xdb\x87\xc8\x80\xd3\xd4\xa6\x44\x09\xa6\x2e\xd0\xc6\xb0\x81\xe8\xd3\x37\x6e\x3e\x08\x89\xc5\xb7\x0d\x82\x33\xb5\xca\xda\x56\xa8\x40\xb5\x73\xfb\x28\xa2\x1e\x0b\xb6\x33\xd2\x30\x69\x7a\x30\x4b\x71\xef\x75\xc0\x23\xe7\x8f\x03\x5a\xb5\x78\xcc\xf6\xae\x47\x7e\xaa\xe0\x92\x02\x0e\xb8\x20\x95\xf3\xd3\x51\x0c\x7c\x0e\xee\x1d\x84\x5d\xb6\x00\x7b\xca\x7e\xf8\x78\x17\x98\x07\x02\xfe\xf8\xea\xcf\xb0\x1a\xf0\xeb\xd6\xa1\xc8\x97\x29\x3d\x40\xc9\x61\x4b\x78\xec\xa7\x57\xaf\xe1\xda\xe7\x9e\xa0\x0d\xfc\xe9\xd5\x2b\xcf\x9d\xcf\x28\xac\x56\xa1\x30\x47\xfa\xab\xeb\xa1\xe4\x33\x97\x99\x70\x3e\x1a\x68\x8b\x6b\xc6\xd5\x17\x2f\x99\xb0\xd5\xb5\xca\xa3\xbb\xf7\x71\x78\x51\x68\xe7\x30\x5f\x8e\x9e\x3f\x48\x60\x28\xe3\x18\x24\x1b\xf3\x22\xea\x54\x71\xe8\x87\x9e\x8c\x08\x67\xa6\x03\x42\x8a\xf0\x99\x20\xac\x7c\x98\xb1\x47\x91\xa3\x79\xc9\xac\xb9\xaa\xaa\x42\x62\xee\x8d\x8a\xdc\x42\xd4\x60\x76\x7b\x91\x4b\x7d\x85\x7a\x5e\x3f\x23\x73\x2c\x2b\xed\x50\x65\x87\xb3\xb9\xae\x24\x08\xc8\x51\x59\xbc\x67\x9a\xae\xc0\x92\xa3\x54\x19\x82\xf2\x79\x67\xa7\x54\x21\xe2\x21\xb3\x16\x40\xd0\xdb\x41\x1a\xe6\x68\x59\x13\xac\x13\x0e\xd7\x73\x32\xfa\x67\xc9\x07\xb9\x77\x32\xc7\x6d\x9e\x5d\xa9\xf6\xcd\x6c\x88\x39\xe2\x33\xba\x28\x52\xcd\x0c\xd5\x56\x73\xbd\xcb\xea\x32\xe2\x3c\x20\xd8\x0f\xc2\x48\xa1\x1c\x08\x17\xbd\x6e\xac\x19\x85\xa8\xbb\x9b\x13\x0a\xef\x9f\xf4\xb6\x83\xee\x90\xbd\x74\xb0\x17\x0f\xbe\x64\x79\x40\x07\x82\x53\x35\xdd\x29\x08\xf9\xc0\x4b\x16\xa0\x8d\x8f\x01\x3a\x71\x63\x0f\x28\x19\x45\x76\x00\xe4\xb9\x29\x2c\x28\x0e\x2d\x2c\x28\x05\x22\x85\x7f\x94\x16\x97\x47\x51\x44\x46\x3e\x3f\x47\x33\x60\x88\x6a\xd5\x02\x11\xb3\xd3\xbd\xcc\x73\x54\xf0\x42\x2a\x3e\xee\xc5\xa3\x70\xd9\x9e\x7f\xdc\xa1\x83\x4c\x14\x85\x7d\xe9\x43\x01\xaf\xbf\x13\x04\x50\xe7\x8e\x32\xd5\x42\x66\x92\x52\x5d\x61\xef\xbd\xfb\xd1\x1b\xb6\x6f\x47\xef\x4f\xb5\xd9\x81\xca\xd2\x7f\x72\xd4\xa8\xda\xc7\xf2\xf6\x6c\xd9\x89\x2d\xc9\xf4\x55\x41\x64\x5b\x11\xc5\x60\xfd\x9a\x2d\x50\x6d\x0c\x9b\x20\xec\xb1\x35\x94\x51\x2a\x23\x1f\x64\x81\x3b\xcc\x39\xe7\xf2\xf5\x34\xbe\xbd\x9f\xb1\xf9\x32\x73\xf3\xde\x90\x97\xca\x26\xfb\x5d\xc6\xf4\x30\x58\x4d\x7e\x42\x62\x1e\xf3\xcc\x1e\xc8\xcd\x01\x84\x3a\xf0\xab\x89\x2e\xf0\xe6\xed\xa7\xcf\x6f\xaf\xaf\xee\xde\xbe\x81\x55\x07\x5d\x10\x5c\x5c\x07\x51\x54\x7b\x11\x44\x96\x78\x36\x18\xd9\x35\x81\x15\x48\x05\x0f\xaf\xd7\xaf\xff\xb4\x3e\x36\x4a\xd5\x44\xb3\xa1\xf2\xd9\x61\xff\x87\x23\x65\xfd\xe4\xef\x1b\xd7\x9d\xd0\x39\xa8\x2d\xc9\x09\x66\xb5\xc3\x01\x90\x00\x52\x85\x82\x67\x0a\x93\x93\xa2\x80\xb4\xb1\xd4\xb1\xf6\x52\xe2\x3b\x74\xd6\x45\x2c\x47\x20\x76\x4c\x48\xa0\x46\x2c\x84\xc0\x56\xc8\x82\x10\x37\x68\xeb\xc2\xb5\x6a\x06\x38\xad\xfa\x00\x00\xbe\x99\x92\xe2\x2a\x8b\x0e\x9c\x66\x4d\x8f\x7e\x6f\x48\x37\x41\xd8\xb6\x3e\x0f\x42\xa6\xe7\xc3\x59\xc1\x69\x72\xb0\x51\x05\xd7\x03\xf7\x8f\xc4\xc8\xa7\x78\x0b\x00\x10\xba\xd3\x23\xbf\x1d\x31\xb9\xdd\xb9\x88\x39\x29\xb3\x55\xda\x4e\xca\x41\x69\x48\x3a\xe1\x18\x5f\x5a\x15\xa5\x60\x26\x47\x6f\x9b\x08\xf6\x01\x00\x20\x45\x75\x63\xe7\x58\x31\xea\x8b\x71\xd8\x23\xa6\x78\x3c\x99\xf0\x6f\x25\x91\x39\xa9\x1a\x37\xdb\xae\x70\xb1\x8d\x62\x1a\xfe\x22\x64\x51\x1b\x8c\xc1\xec\x44\x26\x95\x2a\x24\x1b\x84\x8a\xda\xe0\x36\x54\x04\xa9\xd5\x26\x76\x18\x05\x4e\xc5\x4c\x92\x12\x2e\x5b\x1b\xdf\xbf\x10\x0e\xf4\xa0\xcd\x01\x80\x28\x57\x3e\x17\x0b\xd6\xba\x9d\xec\xad\x17\x4f\x97\xaa\xe1\x26\x3f\xc0\x33\x35\xfc\x47\x60\xc2\xd1\x20\xc0\x53\x9b\xff\xa3\x60\x07\x87\x02\x9e\x32\x08\x30\x0a\xf9\x77\x1c\x10\x78\x92\x42\x65\x3a\xc7\x59\xac\xbb\xad\x77\x3b\x5f\xfe\xfe\xf7\xbb\xbb\x4f\x31\x0b\xa1\xc7\x9b\xf6\x07\x05\x98\xb5\x5d\xc2\x2b\x90\xdb\x11\x98\x10\x0b\x53\x63\x46\xa0\x15\x6b\xfe\xfc\xd3\xe4\xa9\x86\x62\xce\x06\x75\x27\x64\x61\x67\x9d\xec\x2d\x0d\x05\xe5\x98\x03\x95\x8c\x40\x58\xab\x33\xc9\xe1\x71\x52\x5f\xc3\x39\xd5\xda\x97\x64\x26\x64\x92\xee\x62\xc9\xf0\xb2\x0d\xd2\x59\xd0\x8f\x0a\x30\xbd\xc1\xa3\x75\x14\x84\x8e\x42\x8c\x79\x53\x54\x7a\x8f\x61\x4a\xfa\x07\xdb\x8d\x99\xa6\x38\xb9\x1c\x85\xe9\x34\x47\x1f\x41\xcf\xf0\x5b\x86\x55\x28\x18\x79\xa4\x53\x56\x10\x8e\x43\xb4\x1e\xe3\xd5\x69\x9f\x03\x90\x89\xda\x4e\xfd\x3e\xd0\x37\xbf\xe6\x47\xbc\x2d\x06\xa9\xb2\xa2\xce\xd1\x42\xa9\x0d\x46\x02\xb6\xb8\x34\x01\x18\x1a\x0e\xde\xb2\x64\x86\xdc\x78\xeb\xad\xf1\x1a\x3e\x68\xc7\x1e\xb7\xfd\x2b\x47\x83\x93\x40\x43\x69\x23\xe0\x82\x79\x38\xe2\x7a\xe2\xa1\x09\xbf\xfd\x14\x5a\x02\x40\xac\x88\x9c\xba\xe9\x38\xc5\xba\xdb\x07\xef\x13\xdd\x7a\x77\xd0\x63\x2f\xac\x3f\x46\x7e\x12\x6e\x70\xe5\x68\x8c\xa6\xf6\x97\x65\x8f\xcb\x52\x23\x9d\x85\xff\xb8\xfd\xf8\x01\x2c\x1a\x8e\x08\xc4\x98\x5b\x39\xfe\xf7\xbe\x61\x34\xe4\xc4\x14\x95\x43\xa5\xad\xa3\x42\x4e\x9c\xd1\x60\x33\xa3\xd8\x04\xcd\x80\x28\x9c\x37\x9f\x64\x73\xaf\x48\x90\x7c\x34\xfd\x2b\x1a\xbd\x92\x2a\xc7\x6f\x94\x5f\xc1\x2f\x44\x91\xd3\x1c\x8f\xbe\xae\x42\x61\xbc\x1c\x72\xfd\x8c\x1b\x63\x52\x81\x50\x41\x56\xf5\x36\xc8\x02\xe4\x35\xce\x21\xa4\xf6\x3c\xb1\x94\x59\x91\x07\x2f\xeb\xc2\xc9\xaa\x40\x4f\x5d\xca\x57\x82\x05\xe0\x44\xe1\xad\xef\x15\xd9\xcb\x19\xa0\xbf\x02\x7c\x3d\x23\xce\x7c\x3d\x83\x15\xb8\xc4\xfd\x74\x51\xab\x76\xb6\x34\x03\x62\x12\x18\x82\xcc\x02\xfd\xdf\xaf\xfe\x67\x3d\xf1\x8a\x19\x30\x03\x12\x5b\x69\xac\x0b\x34\x0c\x05\x6f\x15\x5f\xf2\xf5\xec\x34\xa0\x93\x5e\xae\xf9\x57\xa2\xb5\x62\x87\x4f\x54\x9f\x2b\xd8\xd7\xa5\x50\x2b\x83\x22\xe7\x56\x6a\xeb\xd7\x34\xe1\x43\x9c\x9f\x73\x66\x7f\x3b\x73\x78\x0d\x6d\x4f\x10\xea\x9b\xcd\xb4\x86\xb0\xab\x09\xef\xd0\xb5\xe9\x60\xb8\x3a\xb6\x7e\x4e\x62\x79\x17\xf0\x64\x5a\x95\x22\xdb\x4b\x85\x53\xd4\x5a\x9c\x3e\x14\xd3\xf3\x88\x5a\xb1\x20\xcb\xd1\x54\xca\xc0\xe9\x0e\x33\x07\x24\x3b\x4c\x8e\xbe\x28\xc6\x20\x6c\xc4\x83\x90\x05\xe1\xf8\x8c\x74\x3b\x91\x68\x74\x6f\x1b\x4e\x38\xe2\x3f\x3f\x29\xfc\x14\xdf\xc9\x4f\x34\xd6\xaf\x67\xed\x9f\xea\x38\x7d\x48\xd7\xf1\x90\xeb\xc5\x0f\x12\xe9\x78\x58\x75\xf2\x50\xe7\x74\x2a\x7a\xe2\x37\x3e\x14\x7c\x54\xbe\xb2\xd8\x0c\x5c\xf9\x50\x8e\x7b\x28\x93\x70\x5b\xbd\xbc\xd0\xdb\x6c\x50\xa3\xd1\xdb\xdf\x69\x60\xf5\xbb\x78\x31\x5d\x14\x18\x1b\x6a\xfc\x4d\x59\x01\x2f\xc2\xa0\x1d\x1a\x0c\x53\xcb\x52\xed\x0a\x1c\x4f\xed\x13\x54\x2e\x14\x67\x42\xf9\x49\x0c\xc2\x7c\x83\xf9\xcb\x1f\x16\x58\x6e\x63\x70\x0f\x62\x64\x4e\x6c\x94\x62\x37\xdb\xa6\x1b\xb1\x6c\xb7\x3d\xd2\x0c\x59\xd3\x25\x9e\x3c\x5a\x92\xca\xd6\x84\xac\x9f\xb9\xcd\xd7\x70\xab\xcb\x60\x22\xe3\x24\xb6\xef\xaa\x2c\xa6\xa3\xb8\xd4\xad\xe1\x62\x9d\xa3\xa6\x18\x57\x1b\x39\xdb\x75\x08\x22\xe3\x17\xae\x42\x82\xa7\x6d\x7c\xc9\x09\xb8\x1d\x87\x16\x71\x81\xbd\x7e\xf4\x43\x42\x4e\xc3\xa3\x90\x2e\x9d\x5c\xdc\x9f\xb4\xa8\x7b\xec\xa1\x35\xc5\xd4\x39\x39\x24\xcc\xca\x23\x01\x00\x6a\xf9\x04\x6b\xf5\xe5\xe6\xcd\xb1\x4e\xac\xc7\x04\x7a\x31\x2b\xdc\x1a\x13\xea\x27\x8f\x3b\x37\xe3\x03\xf6\x0f\xb5\xfc\x61\xdb\x71\xd2\xcd\x4d\x99\xf9\x67\xd8\x4f\x58\x4c\x0a\xe0\x0f\xec\x2a\x2c\x66\x68\xcc\x77\xed\x2d\x8c\x02\xfe\xdd\xdd\xc3\x49\xf6\x9e\x08\x93\x9f\x1c\x1c\x07\x33\x7f\xaa\xac\x97\xac\xdc\xfa\xfb\x11\xef\x2f\x68\x8c\x0b\xde\xad\x13\x2a\x17\x26\xf7\x8d\x8c\xf8\xec\x3f\xc1\x5f\xcf\xaa\xa4\x68\xd2\x84\x7a\xbe\xbb\x8e\x0f\xb4\xd7\x38\xe4\x36\xcd\xae\xf2\xdf\x02\x0a\x59\x4a\xb7\x98\x91\xa5\xa9\x34\xff\xcc\x89\x59\xaa\x43\x85\x19\xd8\x60\xe7\x43\xa3\xe0\x94\x3f\x0b\xc3\x10\x7b\x11\x0b\x3b\x5c\x7b\x4b\xd1\x38\x87\x1a\x29\xca\xd7\x95\xa0\x09\x85\xa1\xd1\xbf\xf6\xbf\x70\xcc\xb8\x2d\x21\xad\xe5\x87\x74\x18\x9b\x08\x43\x95\xfa\x78\x31\x49\xb8\xd3\x98\xe6\x4d\x07\x10\x9c\x4e\xdb\x2e\x9e\x2e\xf8\x2d\x75\x1b\xd3\x09\xa6\x09\x1a\xbb\xa2\xd7\x9e\x43\xbe\xa3\xcf\x8d\x23\xeb\x50\xb9\x20\x8e\x4d\x4f\xb1\xd2\x76\x78\xf2\xb7\xfd\x2f\xb0\x36\x50\x96\xea\x80\x72\x57\x7b\x75\xf2\xf5\x9d\xbd\x50\x3b\x3f\x2d\xd2\xd4\x30\xc4\x74\x64\x8b\x8f\x50\x4a\x45\x65\x14\xdf\xfd\x6e\x26\x85\x1a\xff\x16\x0b\xfa\xde\xe7\x47\xa9\x38\x11\xa8\xa1\x82\xda\x7a\xbb\xee\x7b\x66\x5e\x52\x5b\xc3\x47\x1b\x0c\x03\x6f\x59\x9a\x42\x9d\x84\x19\xa4\xa5\x5d\x51\x08\xad\x2a\xa4\x61\xcc\x02\xad\x85\x83\xae\xfd\x39\x0c\x66\x28\x1f\x4e\x60\xc9\xa8\x39\x7d\x8f\xca\x3b\x09\xa1\x7c\xfc\x13\xad\xe3\x33\xc4\x95\x1d\x0a\xce\x8f\x32\x6e\x5d\xd3\xf0\x49\x6e\xdd\xb6\xd8\x7f\x7e\x6e\x53\xdb\x62\x9a\x6a\xfe\xd5\xd1\x32\xa7\x0d\x06\x82\x1c\x62\x8e\x38\x00\x17\xfb\x47\x03\x03\x55\x5d\x4c\xe3\xdc\x2a\x73\x39\xc8\xba\x27\x7b\x10\xc1\x35\xfc\xcd\x0f\x69\x87\x79\x49\xe7\xfb\xfe\x93\x60\x45\x32\x03\x2d\x54\xb8\x4e\xc8\x22\x09\xb5\x4a\x8d\xf7\x8d\xc8\xee\xe7\x48\x4c\x9c\xf4\x9a\xb3\xe2\xd2\x78\x84\x49\x90\xcf\xe0\x2d\x32\xad\x7c\x51\x2e\x3b\xac\xc2\x10\xcc\x4a\xa8\x7c\x95\xcc\x43\x76\xf8\xe1\xac\xcf\x62\xb1\x7d\x27\xd5\xfd\x6c\x89\x8b\x0f\xf8\x28\xed\xcb\xe7\x77\xc7\xc1\xd9\x8c\xe6\x2e\xcc\xdb\x26\xfa\x8d\xa3\xd2\xe9\x9a\xd6\x13\x2b\x59\x8f\xfb\x30\x1a\x92\x02\x97\x51\xec\x65\x1a\x9c\x3f\x0b\xdd\xe0\xb3\x10\x15\x4d\x97\xb5\xa6\xfa\x43\xa3\xc5\x2c\xb8\x8a\x73\x80\x59\x21\x8c\x37\x0e\x42\xf9\xce\x9d\x7f\xe9\x44\x94\x91\x23\x6c\x6a\x07\xb9\x46\xdf\x5f\xd2\x0f\x68\x8c\xcc\x11\xa4\xfb\xee\xb0\xcc\xbf\x74\x76\x50\x96\x62\xc5\x56\x39\x86\x2a\x34\x08\x7a\x7b\x09\x67\xb7\x75\x46\x23\x09\x67\x43\x03\x3b\xf1\x5f\xa2\xf2\x73\x47\x73\x94\xcf\xb3\x42\xfa\x33\x7d\x67\x88\x3d\x21\xa7\xe3\x33\x0e\xab\x91\xf9\x97\x51\x60\x85\xd8\x60\xf1\x5b\x6f\x1f\xbf\x17\x3c\x1e\xee\xef\xa4\x65\x63\x6f\x97\x7d\xc7\xbb\xef\x49\x9c\x06\x6d\x76\x82\xda\xe5\x83\x53\xa4\x14\x44\xee\xb4\x91\xbf\x22\xbc\xe0\x0f\x1b\xf0\x55\x8b\x05\x66\xee\x65\x6b\xd9\x57\x1c\xa0\xe4\x31\x36\xff\x93\x36\x76\x68\xfe\xd1\x20\x8d\xaa\x79\xfd\x68\x46\x0a\x6d\x80\x69\x1e\x64\x86\xdf\xb1\x39\xec\xe9\x3a\x7b\x69\xb8\x14\x4a\xec\x30\xf7\xdd\xa6\xe9\x51\xc8\xf7\xed\x5b\xa1\x14\x95\x05\xda\x4d\xd9\x16\xfa\x71\x25\xfd\xf8\x57\x74\xd9\xde\xc3\x0d\x2e\x97\xea\x6d\x6c\x2c\x31\xf9\x85\xc1\x88\x83\xb7\xbb\xc2\x25\xa8\xa1\x17\x2d\x29\x0e\xb7\xae\x38\x84\x99\x9e\x91\xd0\x61\xaf\x6b\x8b\xf7\x88\x95\x54\x3b\x1f\xf7\xfb\x09\x3a\x77\xa8\x28\x4e\x2b\x0e\xa1\x3c\x45\x53\x82\x2a\x74\xa4\xc3\xf6\x55\xad\x72\x34\xd6\x0d\x05\xf1\x4d\xc9\x88\x2c\x57\xc4\x2c\x4a\x4d\xcc\x57\xce\x7d\xab\x71\xd9\x19\x0e\x8d\x17\xfb\x24\x30\xcd\x7c\x3b\x05\xe6\xcd\xc0\xac\xa8\x2a\x1a\x02\x14\x6e\x0f\x85\xbc\x47\xf8\x7a\x96\xc9\x55\x96\x7f\x3d\xf3\x61\x6d\x88\xe4\x3d\xfd\x86\x36\x1d\x44\xf1\x28\x0e\xc9\x9a\x27\x6e\x84\xac\xa7\x41\x9f\xa5\xfd\x68\x57\x7d\x28\x24\x09\x7e\x13\xbe\xaa\xe3\xd9\x54\x9e\xfb\xf3\x3a\xc1\x94\x68\x45\xf0\x71\xd6\x8f\x0a\xa9\x43\x13\xde\x4a\x3b\x99\x61\x6f\x02\x70\xa4\x11\x3d\x9d\x7e\x9e\x1a\xf2\xe9\x3a\xcd\xc9\x09\x9f\xd6\x97\x3c\x5a\xed\xe7\xc5\x44\xfc\xed\xa9\xc1\xa9\x2a\x8f\x7c\xc7\x4d\x79\x0c\x55\x3e\x90\x16\xce\xb8\xeb\x71\x11\xde\x71\x06\x7f\xaf\xed\x18\x4c\xe6\x38\x21\xe4\x74\xb5\x2a\xc8\xc6\xb7\x31\x0e\x32\x18\x56\xb9\x91\x9c\x8c\x30\x07\x70\x1a\x9c\x11\xd9\xfd\x28\x9e\x9d\xf3\x89\x16\xce\x1b\xf4\x6d\x2c\xc9\x36\x30\x64\x73\x61\xdf\xcb\x2b\xcc\x62\xcc\x0d\xf3\xd0\xd2\xd0\x0c\xfb\x0c\xef\xb2\x1d\xb4\x34\x13\xd6\x1f\x9c\xa9\xf1\x34\x73\x83\x59\x6a\xa5\x1c\xa2\xab\x2f\xeb\xef\x19\xbd\xf3\xa6\xc9\xcc\x10\x2e\x6f\x1d\x4d\x7f\x1f\x4a\x6f\xbb\xba\xc7\x20\x27\xa2\xc4\x3d\x5a\x9c\x81\xf2\x28\x81\x53\x54\x32\x03\xe9\x8f\xf1\xde\xf8\x5d\x1d\x82\x4d\x18\x27\x20\xa1\xc6\x5b\xa0\xc8\xc7\xb3\x2b\xd6\x86\x8e\x7b\x78\xcb\xad\xf2\x0d\x92\x61\x49\x9f\x21\x20\xcd\xa0\x38\xda\x6f\xd9\x04\x2f\x3c\x3e\x6b\xd5\x56\x32\x61\x10\xce\x69\xb1\xe2\x70\xce\x56\xe7\xfc\x0b\x97\x31\xcf\xbf\x8b\x42\xd4\xe7\x98\x41\x9c\x3b\xe9\xf7\x36\x5c\x7b\xd3\x2c\x96\xcb\x13\x8f\xe0\x11\x0d\x4e\x4d\x8d\xdd\xa4\x95\x93\x60\x9d\xd3\x16\x9e\xdc\x76\x19\x10\x0e\xb8\x98\xea\x1b\x8c\xed\x07\xce\x38\xf8\x84\xa8\x8f\x35\x7c\xd5\xa9\x35\xb5\x73\x5e\x6e\x89\xc9\x72\x58\xd7\x21\xc3\x2f\x15\x88\xe6\x5b\x1f\x6b\xb8\xb1\x29\x78\x1c\xfe\x4e\x80\x5f\x85\x50\xbb\x64\x7e\xed\xb2\xd9\x72\xe6\xee\x67\xfa\x81\xcb\x4f\xfc\x81\x83\xb4\xb2\x3e\x24\x9b\xcd\xae\x32\x76\x37\x51\x40\x28\xb2\xd8\x46\x57\x46\x0a\x17\xfb\x86\x6d\xcb\xb7\x1e\x5e\xf8\x92\x16\x2a\x23\x4b\x61\x24\xaf\x43\x84\xc9\x39\x12\xd5\xb4\xc8\xd1\xec\xdd\xf8\xe0\xb0\x5b\xeb\xca\xd3\x67\xbb\xfa\xd2\x32\x50\xa2\xff\x91\x36\x0a\xd3\xfe\x7c\xee\xea\x4f\xe2\xd4\x74\x08\xf8\x21\xde\xd6\x71\xa0\xfe\x4a\xe0\x3a\xad\xf4\x83\xea\x4b\x45\xff\xc0\x57\x2a\xe8\x41\x7a\x39\x48\x0b\x24\x24\x0f\xa2\xf0\x3c\x65\xf0\x5f\xcf\x72\xdc\x8a\xba\x70\x5f\xcf\x9a\x5b\x97\x94\x08\xf6\x40\xb6\x6f\x0d\x16\x2d\x13\x4a\x2b\xe2\xea\xd1\x60\x6e\x33\x62\x17\xe2\x76\x10\x06\x93\x8c\x0e\xad\x51\x6e\xd0\x7f\xcd\x2c\xa7\x3f\x5a\xc2\x1d\x26\x8c\xd8\x9c\xa5\x20\xc2\x9b\xad\xa6\x3b\x19\x5e\x32\xbc\x72\x1e\x2d\x02\x07\x5a\x71\x53\x57\xc0\x9b\x0f\xb7\xff\xfb\xee\xea\xdf\xde\xbe\x5b\x4f\x0b\x47\x3f\x14\x9e\x23\x2c\x09\x7f\x3b\x7b\x41\x4c\x3f\x2a\x34\x9f\x91\x17\x37\x33\x9c\x4e\x17\xde\x85\xfd\x8b\x70\x70\xc8\xb1\xf2\xea\xb2\x39\xf4\xf6\x92\xae\xde\xbd\x1b\x25\x50\x88\x65\xb9\xec\xcc\x85\x3a\x5e\x4b\x4a\x13\xe6\x9d\x6f\xde\x04\x5a\xee\x84\xd9\x88\x1d\x42\x46\x61\x78\xe6\xa6\xb6\x57\x9b\xdd\x88\x56\x12\xd2\x0e\xe2\xe9\x0d\x7e\x17\x28\x4d\x7f\xa5\x72\xfb\x30\x33\x43\xed\x5e\x37\xe5\xe3\x08\x29\x4d\x16\x34\x17\x5b\xf1\x18\x3d\x61\x86\xf4\xe4\x8e\x6b\x2d\x4d\x8c\xd6\x9e\xf2\xc3\x14\x4e\xb4\x80\xae\xff\x19\x91\x75\x37\x8c\x46\x30\x5e\x4c\xdc\x77\x79\x68\xfe\xd2\xc6\x47\x92\xb6\xf8\x29\x96\x19\x48\x10\x4f\x0d\x0d\xc1\x5f\x7d\x78\x13\x3b\x0e\x2c\xb1\x69\xc5\xf7\x8c\xba\xfa\x14\x90\xab\x3c\xc2\x1d\x9b\xe0\x4b\x6b\xf5\x41\x00\x1a\x60\x0d\x23\x7a\x0b\xf3\xf7\x78\x58\xb1\x19\x18\x01\xea\xbf\x49\xc6\x5f\x5f\x88\xa9\x46\xd0\xa5\xd6\x56\xd0\x1a\xde\x78\x1b\x66\xc1\x69\xd8\x8a\xc2\x52\xcf\x69\x2c\xf4\x4a\xdf\x55\x8a\xcb\xc8\x9c\x8f\x72\x82\x6b\xe1\xcc\x63\x78\x06\x15\x95\xbd\x6d\x9b\x3d\x7c\x96\xe5\x08\x50\x1d\x97\xfb\xe0\x8f\x3f\xfd\x04\x2f\xbe\xa8\xb0\x68\xc3\x75\xc6\xb7\xca\x49\x77\x78\xd9\xfa\x2e\x90\xef\xaa\x4c\x31\x7a\xa3\x75\x81\x42\x2d\x06\x93\x89\x20\xb5\x4f\xe1\xf0\x11\xf1\x58\xe5\xd2\x6a\xc4\x0c\x8d\x98\x87\xdb\xf8\x94\xc0\xc0\x8c\xc0\xb1\xd8\xff\xde\x8d\xda\x13\x1a\x35\x3e\x4c\x35\x10\xcf\x9d\x3a\xcb\x8f\x07\x22\xb3\x70\x1e\x9d\x6e\x99\x98\x6b\x79\x0e\x8c\xc7\x27\x50\x26\x11\x9e\x5a\x00\x5b\xb5\xec\xe9\xe0\xcf\xc4\xd9\xc1\x1f\x46\x26\xcb\x56\x44\x9d\xe7\x08\xf1\x4f\x34\xfa\x7a\xdb\xd0\xa1\xd3\xc5\x66\xce\x57\x96\x9a\x41\x96\xb0\xb1\x18\x57\x92\x92\x43\x18\xae\xaa\xcd\xea\xe7\x8d\xf4\xec\x06\x16\x96\xdb\x3d\xbc\xf7\xad\x76\x3b\xc5\x60\xb4\xad\x52\x4a\xeb\x64\x06\xad\x1e\xd6\x32\x3c\xc0\xef\xe0\xc9\xad\xf1\x8f\x07\xf8\xb5\xe4\x26\x2d\xd6\xaa\xfd\x45\x4a\x6d\x62\xad\x21\x5e\x6a\x3e\x89\xd7\x03\xe9\x47\xda\x28\x61\x08\x89\xa4\x4f\x84\x5b\x6d\xc4\xa7\xf7\x0e\x63\xbf\x90\x3f\x5f\x59\xb6\xbe\xa9\xe6\x53\x6d\xa2\x81\xf0\x1f\x0d\xca\xea\x42\x98\x01\xcc\x47\x3f\x5d\x66\xa7\xbe\xaf\xd3\x69\x44\xce\xeb\x9c\x8e\x76\x4b\x9f\xdb\x64\xce\xe8\x56\xce\x8e\x7c\xc7\xba\x92\xdd\x3d\xb4\xf9\x9d\xc8\x0e\x3d\x07\x77\xc5\x4f\x76\x1f\x47\x71\x1d\x30\x9b\x5d\x2d\x26\x83\x19\xb2\xa3\x90\xb1\x4b\x15\x3e\xa2\xa1\xf2\x90\xcd\x79\xfd\x3e\xfa\x7a\xe0\x40\x1c\xed\x40\xb6\x4b\xec\xcd\x37\x46\xba\x5f\xb3\xd3\x0a\xac\xef\x8c\xd1\x47\x98\x52\xb6\x3c\x20\x76\x2d\xad\x6a\x7d\xbf\x2e\x7e\xce\xd0\xe9\xa8\xb3\x5a\xc1\xa7\x2f\x77\x9d\x6f\x50\xb6\xc5\x74\x68\xb5\xfd\x64\xff\xfc\xfb\x5c\xc5\x4c\x21\x1a\xb4\xcd\x25\xda\xfd\xe5\xa9\x2f\xfb\xc6\x8f\x73\x9f\x0a\xdc\xd3\x97\xc8\x2e\xe7\x75\x7f\x06\x2e\x1f\x5d\x0a\x26\x3c\xbc\xc7\x3b\xa3\x4b\x78\x78\xcd\xe5\xff\xd7\x8b\x96\xed\xc9\x3b\x75\xda\xb0\x11\x1c\xae\xfd\xdf\x00\xc8\xc7\xe1\x5c\xea\x5c\x00\x00"),
+		},
 		"/crds/traffic-logs.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "traffic-logs.yaml",
 			modTime:          time.Date(2020, 7, 31, 11, 8, 25, 955504047, time.UTC),
@@ -187,10 +243,10 @@ var HelmTemplates = func() http.FileSystem {
 		},
 		"/templates/cp-deployment.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cp-deployment.yaml",
-			modTime:          time.Date(2020, 9, 17, 12, 52, 10, 936301747, time.UTC),
-			uncompressedSize: 5252,
+			modTime:          time.Date(2026, 10, 19, 10, 5, 50, 131669285, time.UTC),
+			uncompressedSize: 5569,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x57\x51\x73\xda\x38\x10\x7e\xcf\xaf\xd0\x30\x7d\x68\x1f\x30\x49\x9a\xa4\x3d\xcf\xf4\xc1\x01\x35\xf5\x05\x1b\xc6\x36\x99\xc9\xbd\x78\x14\x7b\x03\x1e\x64\xc9\x95\x64\x7a\x5c\x9a\xff\x7e\x23\x1b\x08\x18\x1b\x70\x7b\x73\x3c\x81\xb4\xbb\xdf\xb7\xab\xd5\xc7\x8a\x64\xc9\x03\x08\x99\x70\x66\x22\x92\x65\xb2\xb7\xb8\x38\x9b\x27\x2c\x36\xd1\x00\x32\xca\x97\x29\x30\x75\x96\x82\x22\x31\x51\xc4\x3c\x43\x88\x91\x14\x4c\xf4\xf2\x82\x12\x16\xd1\x3c\x06\xd4\x99\xe7\x29\x31\xf4\x72\x07\x19\xe8\xf5\xb5\x1b\x71\xa6\x04\xa7\xdd\x8c\x12\x06\x2b\x0f\x99\x91\xa8\x74\x33\x3c\xa0\x40\x24\x18\xee\x7a\x19\xbd\xbe\x9e\x21\x44\xc9\x13\x50\xa9\x11\x10\x7a\x79\xe9\x56\xc2\x97\xbb\x1a\xe0\x27\x62\x09\x8b\x81\x29\x74\x55\x3a\x22\xcd\xdb\x44\xda\xac\x02\x2d\x33\x88\x74\x40\xa9\x04\x51\x30\x5d\x96\xc1\x05\xa7\x34\x61\xd3\x49\x16\x13\x05\xe5\x12\x42\x29\xf9\xdb\xcf\xc5\x14\x4c\x74\xf1\xb6\x32\x61\x64\x41\x12\x4a\x9e\x28\x98\xe8\x5c\x07\x02\x0a\x91\xe2\xa2\xf4\x4a\x89\x8a\x66\xc3\x2d\xda\x75\xc4\xd7\x2e\xc3\x9a\x04\x6e\xd6\x09\x34\xa7\x80\x90\x82\x34\xa3\x1b\xa6\xdb\x27\x51\xf8\x31\xc6\x15\x51\x09\x67\x1b\x0e\x08\x45\x33\x88\xe6\x32\x4f\x7b\x11\x67\xcf\xc9\x74\xe7\xb4\xde\x67\x22\x61\x0a\xbd\x33\x82\x55\x5c\xe3\x96\x48\x18\x13\x35\x43\x9d\x5e\x94\x75\x4b\x97\x94\x64\xc6\x92\xa4\xb4\xf3\xa1\x20\x2c\x67\xe4\xf2\xfa\x46\xe6\xe9\x1b\xe1\x2d\x14\x45\x65\x57\x42\x24\x40\xc9\x56\x50\x3f\xe0\x69\xc6\xf9\x5c\x76\x09\x8b\xd7\x01\x8e\xa0\xd2\x9d\x6a\xb7\xad\xf7\xe7\x6d\xfa\xcd\x15\x47\x68\xdd\x38\xc5\x77\x10\x8b\x24\x02\x2b\x8a\x78\xce\x94\xdb\xba\xfb\xd7\x34\x7f\x24\x6a\x86\x8c\x07\x42\x73\x90\xc6\xca\x68\xac\x6d\x0c\xc6\x63\xf0\x57\xb4\xdf\x18\x6e\xaf\x6e\x27\x8c\x14\x7f\x24\x29\x6d\x4a\x4c\x43\x01\x8b\xdf\x16\x34\x14\x49\x18\x88\xad\xb2\x75\x57\xb7\xb8\x8e\xab\xfe\x24\x29\x99\xd6\xa5\xf9\xcc\x45\x4a\x94\xad\x77\x3b\xe8\x7d\x9c\x44\x0a\x75\x92\xf2\x57\x6d\x66\xc5\x1e\xea\x08\xce\x55\x07\xbd\xfb\x80\x7e\xa2\xef\x39\x57\xb0\x7d\x0c\x2b\xb0\x71\x4e\xe9\x98\xd3\x24\x5a\x96\x22\xd1\x18\xcd\xc8\x36\x96\xbb\x61\x80\x2d\xcc\xad\x9f\x6f\x49\xde\x4f\x1c\x2b\xc4\xee\x83\xed\x8d\x5c\x07\xbb\xc1\x8e\x11\x42\x0b\x8d\x64\xea\x04\x9f\x40\x30\x50\x20\x3b\xcd\x61\xfc\x60\xe4\xe1\x30\x78\x1c\xe3\xdf\x8f\x72\x3f\xb9\xc5\x9e\x8b\x03\xec\x87\xfe\xa3\x1f\x60\x27\x74\x2d\x07\xfb\x63\xab\xdf\x10\xbc\x5e\x3c\x6b\x6b\x5a\x01\xbc\xc3\x2e\xf6\xac\x61\x68\x0d\x1e\xb0\x17\xd8\x3e\x1e\x84\xdf\x46\x7e\xa0\xf1\x1a\xa1\x4e\x6b\x70\xe3\x80\xa2\x1f\x21\x13\x0c\xfd\xb0\x8f\xbd\x20\xfc\x6a\x0f\x1b\x68\xf4\x16\x44\xf4\x44\xce\x7a\x2b\x75\xe8\x15\x5c\x12\x5e\x48\x4e\x04\x42\xe9\x2f\x46\x24\xd4\x69\x68\xf7\xf8\xf1\xb7\xc1\xe6\xb0\xdc\x71\x2f\x14\xe8\x19\xc1\xf7\xfa\x9e\x4d\xb9\x2e\xa1\x80\x94\x2b\xe8\x1c\x2c\x8b\x33\x19\x06\x76\x7f\x38\xf1\x03\xec\x85\x1e\x76\x46\x01\x0e\xef\x86\xa3\xdb\xe2\xd8\x06\x1e\xf6\xfd\xe6\xae\xa8\x43\x9e\xc7\xf2\x8e\xf2\x27\x42\xad\x38\x16\x20\x65\x15\x7c\x4f\x28\x6a\x38\x59\x63\x3b\xf4\xb1\xf7\x50\x30\xb2\x06\xe1\xc8\x1d\x3e\x36\x34\xbe\x12\x39\x1c\x68\x79\x6f\xe2\x06\xb6\xb3\xd3\xf4\xd6\xc0\xb1\x7d\xdf\x1e\xb9\x6b\x88\xf1\xc8\x6b\xba\x9c\xd7\x57\x57\x1f\x7f\x33\x7a\xd1\x6a\x03\xdb\xfb\xc5\xc3\x6f\x05\x6e\xbb\x7f\xe2\x7e\x30\xf2\xc2\xbe\x6b\x87\xd8\xb5\x6e\x87\x78\x70\xfc\xf4\x58\x62\x00\xd3\xc3\x46\x7c\xd2\xa5\x3e\x84\xeb\xdb\x03\xdc\xb7\xbc\xb0\x3f\x72\x03\xcb\x76\xb1\x17\xda\x8e\x75\x77\xea\x65\x3f\x41\xe6\xf5\x0c\xd2\x42\xe3\x2b\xd4\x37\x3c\x6d\xd7\x0e\xfe\x17\x92\x2c\x29\x4d\x5b\x12\x1d\xe0\xaf\xd6\x64\x18\xf8\xa1\x7f\x6f\x8f\x43\x07\xfb\xdf\xc2\xbe\x87\xad\xc0\x1e\xb9\xed\x6e\x63\x0c\xcf\x24\xa7\x4a\x1a\x72\x9e\x64\x0e\xc8\x59\x5f\x40\x31\xb6\x9d\x44\xc3\x19\x0d\x70\x3b\xbc\x42\x77\x1a\x42\xaf\x24\xab\xd6\xef\x1f\xce\xa0\xb5\x4c\xfd\x35\x72\x5b\xd2\x2b\x60\x6a\xe9\xd5\xca\x92\x5e\x14\x84\x4d\x01\xbd\x03\x0a\xfa\x49\x82\xcc\x2f\xf5\x91\x57\xf7\xb7\x29\x87\x97\x97\x4d\x08\x03\xb3\x45\xd5\x6c\x45\xfc\xab\xe0\xa9\x59\xd9\xd0\x93\xa0\x0e\x7d\x0f\x4b\x0f\x9e\xf7\x77\x51\x0d\x82\x5f\x78\xec\x83\xe8\xcf\x1c\x96\xbb\xc6\xf7\xb0\x3c\xa1\x14\x44\x4c\x65\x75\xce\x11\x39\xab\xac\x74\xbb\x94\x4f\xbb\x14\x16\x40\xbf\x34\x9d\x01\xe5\xd3\xa1\x36\xd8\x2f\x55\x77\xf5\x0a\xe8\x3e\x27\x14\xbe\xf4\x40\x45\x1b\x39\xdc\x1f\x98\x57\x8f\x8c\x62\x70\xdf\x8a\x93\x71\xa1\xf6\x88\x6e\x66\xd1\x31\x17\xca\x44\xd7\x37\x9f\x2f\x8e\x58\x5c\x5d\x7d\xac\xeb\x5d\x06\x87\xfe\x6e\xa7\xc5\xff\x5e\xcd\xdf\xed\x1e\xfe\xa7\x4f\x47\x2d\x3e\x1f\xb5\xf8\xe3\x68\x96\x97\xc7\x2c\xae\x3f\x56\x3a\x24\x13\x5c\xf1\x88\x53\x13\x4d\x06\xe3\x63\x3d\x41\x93\x05\x30\x90\x72\x2c\xf8\x13\xec\xd6\x7c\xa6\x54\x76\x07\xaa\xda\xae\x19\x51\x33\x13\xf5\x66\x40\xa8\x9a\x2d\xab\x9b\x6b\xda\xe7\x5b\x1b\x02\x48\x9c\xfc\x12\x88\xf6\x3c\x11\x42\xf2\x5c\x44\x50\x69\x9b\x43\x72\xb5\x71\xd9\xd7\xb8\x63\x0e\x3f\xd7\x0f\xa9\xb7\x67\xd4\xc5\x65\xed\x90\x44\x25\x1c\x1f\xf1\x1a\x7a\x4e\xc0\xf7\x1c\x64\xf5\x26\x20\x14\x65\xb9\x89\xae\xcf\xcf\xd3\xca\x7a\x0a\x29\x17\x4b\x13\x5d\x5e\xdf\x38\x49\x3d\x95\x36\x08\x17\xa7\x23\xd4\x76\xd6\x82\xd3\x3c\x05\x47\xbf\x7c\xa5\xd9\x24\xa8\x8d\x2f\x85\xda\xd9\x09\xa1\x54\x87\x1b\x97\xcd\xd1\x6a\xec\x2a\xbb\x70\xc4\xe8\xd2\x44\x7a\xde\x6c\x4d\x68\x47\xb8\x56\x22\x77\x80\xdc\x61\xe1\x3b\x85\x5a\x59\xbf\x9a\x97\x77\xcb\xb2\x95\xe5\xd9\x3d\x81\x72\xcd\x6d\x1f\xee\x3f\xa8\x54\xb9\xe0\x90\x6c\x97\xd2\x2f\xc6\xfd\x37\x00\x00\xff\xff\x3d\xf1\x32\x7b\x84\x14\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x58\x4b\x6f\xdb\x30\x12\xbe\xe7\x57\x10\x46\x0f\xed\xc1\x72\x92\x26\x69\x57\x40\x0f\x8a\xcd\xa6\xda\xe8\x61\x48\x72\x80\xec\x45\x60\xa4\x89\x2d\x98\x22\x55\x92\x72\xd7\x9b\xe6\xbf\x2f\x28\xd9\x8e\x1f\x92\x1f\xc9\x62\x75\xb2\x87\xc3\xf9\xbe\x19\x8e\x3e\x0f\x4d\x8a\xec\x01\x84\xcc\x38\x33\x11\x29\x0a\xd9\x9b\x5d\x9c\x4d\x33\x96\x9a\x68\x00\x05\xe5\xf3\x1c\x98\x3a\xcb\x41\x91\x94\x28\x62\x9e\x21\xc4\x48\x0e\x26\x7a\x79\x41\x19\x4b\x68\x99\x02\xea\x4c\xcb\x9c\x18\xda\xdc\x41\x06\x7a\x7d\xed\x26\x9c\x29\xc1\x69\xb7\xa0\x84\xc1\x62\x87\x2c\x48\x52\x6f\x33\x02\xa0\x40\x24\x18\xde\xd2\x8c\x5e\x5f\xcf\x10\xa2\xe4\x09\xa8\xd4\x08\x08\xbd\xbc\x74\xb7\xc2\xd7\xab\x1a\xe0\x2f\x62\x19\x4b\x81\x29\x74\x55\x6f\x44\x9a\xb7\x89\xb4\xdb\x16\xb4\x2c\x20\xd1\x01\xa5\x12\x44\xc1\x78\x5e\x07\x17\x9c\xd2\x8c\x8d\x47\x45\x4a\x14\xd4\x26\x84\x72\xf2\xef\xb0\x14\x63\x30\xd1\xc5\x9b\x65\xc4\xc8\x8c\x64\x94\x3c\x51\x30\xd1\xb9\x0e\x04\x14\x12\xc5\x45\xbd\x2b\x27\x2a\x99\x38\x6b\xb4\x9b\x88\x2f\xb7\x38\x0d\x09\xdc\x2c\x13\x68\x4f\x01\x21\x05\x79\x41\x57\x4c\xd7\x4f\x42\x3f\x84\x31\xae\x88\xca\x38\x5b\x71\x40\x28\x99\x40\x32\x95\x65\xde\x4b\x38\x7b\xce\xc6\x1b\xa7\xf5\xb9\x10\x19\x53\xe8\x93\x11\x2d\xe2\x1a\xb7\x44\xc2\x90\xa8\x09\xea\xf4\x92\xa2\x5b\x6f\xc9\x49\x61\xcc\x49\x4e\x3b\x5f\x2a\xc2\x72\x42\x2e\xaf\x6f\x64\x99\xbf\x11\x5e\x43\x51\x54\x76\x25\x24\x02\x94\x3c\x09\xea\x0f\x3c\x4d\x38\x9f\xca\x2e\x61\xe9\x32\xc0\x01\x54\xba\x51\xed\x53\xeb\xfd\x7d\x9d\x7e\x7b\xc5\x11\x5a\x36\x4e\xf5\x19\xc4\x2c\x4b\xc0\x4a\x12\x5e\x32\xe5\x9d\xdc\xfd\x4b\x9a\x7f\x32\x35\x41\xc6\x03\xa1\x25\x48\x63\xe1\x34\xd4\x3e\x06\xe3\x29\x84\x0b\xda\x6f\x0c\xd7\xad\xeb\x09\x23\xc5\x1f\x49\x4e\xdb\x12\xd3\x50\xc0\xd2\x37\x83\x86\x22\x19\x03\xb1\x56\xb6\xee\xe2\x2d\x6e\xe2\xaa\x9f\x2c\x27\xe3\xa6\x34\x9f\xb9\xc8\x89\xb2\xf5\x6a\x07\x7d\x4e\xb3\x44\xa1\x4e\x56\x7f\x6b\xcc\xac\x5a\x43\x1d\xc1\xb9\xea\xa0\x4f\x5f\xd0\x5f\xf4\xbb\xe4\x0a\xd6\x8f\x61\x01\x36\x2c\x29\x1d\x72\x9a\x25\xf3\x5a\x24\x5a\xa3\x19\xc5\xca\x73\x33\x0c\xb0\x99\xb9\xf6\xf5\x2d\xc9\xfb\x91\x6b\xc5\xd8\x7b\xb0\x03\xdf\x73\xb1\x17\x6d\x38\x21\x34\xd3\x48\xa6\x4e\xf0\x09\x04\x03\x05\xb2\xd3\x1e\x26\x8c\xfc\x00\xc7\xd1\xe3\x10\x7f\x3c\xca\xfd\xe8\x16\x07\x1e\x8e\x70\x18\x87\x8f\x61\x84\xdd\xd8\xb3\x5c\x1c\x0e\xad\x7e\x4b\xf0\x66\xf1\x6c\xac\xe9\x16\xe0\x1d\xf6\x70\x60\x39\xb1\x35\x78\xc0\x41\x64\x87\x78\x10\xff\xf2\xc3\x48\xe3\xb5\x42\x1d\xd7\xe0\xc6\x1e\x45\x3f\x40\x26\x72\xc2\xb8\x8f\x83\x28\xfe\x69\x3b\x2d\x34\x7a\x33\x22\x7a\xa2\x64\xbd\x85\x3a\xf4\x2a\x2e\x19\xaf\x24\x27\x01\xa1\xf4\x07\x23\x11\xea\x38\xb4\x7b\xfc\xf8\x61\xb0\x29\xcc\x37\xb6\x57\x0a\xf4\x8c\xe0\x77\x73\xcf\xe6\x5c\x97\x50\x40\xce\x15\x74\xf6\x96\xc5\x1d\x39\x91\xdd\x77\x46\x61\x84\x83\x38\xc0\xae\x1f\xe1\xf8\xce\xf1\x6f\xab\x63\x1b\x04\x38\x0c\xdb\xbb\xa2\x09\x79\x9a\xca\x3b\xca\x9f\x08\xb5\xd2\x54\x80\x94\xdb\xe0\x3b\x42\xd1\xc0\xc9\x1a\xda\x71\x88\x83\x87\x8a\x91\x35\x88\x7d\xcf\x79\x6c\x69\x7c\x25\x4a\xd8\xd3\xf2\xc1\xc8\x8b\x6c\x77\xa3\xe9\xad\x81\x6b\x87\xa1\xed\x7b\x4b\x88\xa1\x1f\xb4\xbd\x9c\xd7\x57\x57\x5f\x3f\x18\xbd\x6a\xb5\x81\x1d\xbc\xf3\xf0\x4f\x02\xb7\xbd\x7f\xe2\x7e\xe4\x07\x71\xdf\xb3\x63\xec\x59\xb7\x0e\x1e\x1c\x3e\x3d\x96\x19\xc0\xf4\xb0\x91\x1e\xf5\x52\xef\xc3\x0d\xed\x01\xee\x5b\x41\xdc\xf7\xbd\xc8\xb2\x3d\x1c\xc4\xb6\x6b\xdd\x1d\xfb\xb2\x1f\x21\xf3\x7a\x06\x39\x41\xe3\xb7\xa8\xaf\x78\xda\x9e\x1d\xfd\x5f\x48\xb2\x4c\xd9\xef\x20\x3a\xc0\x3f\xad\x91\x13\x85\x71\x78\x6f\x0f\x63\x17\x87\xbf\xe2\x7e\x80\xad\xc8\xf6\xbd\xd3\xde\xc6\x14\x9e\x49\x49\x95\x34\xe4\x34\x2b\x5c\x90\x93\xbe\x80\x6a\x6c\x3b\x8a\x86\xeb\x0f\xf0\x69\x78\x95\xee\xbc\xbb\x8b\xee\xb4\xdc\x54\xe7\x12\xf8\x8e\x83\x83\xe3\x7b\x78\xe3\xf7\x9a\x8d\xb5\xf0\xf4\x6b\x1b\x05\xf1\xf1\xf6\xde\x21\xb6\x32\x39\x56\x18\x7e\x94\xde\xd2\x42\x89\x94\x6d\x1c\x17\x7a\xdf\x18\xf1\x3f\x9c\xc1\xc9\x1a\xff\x2f\xdf\x3b\xf1\x6c\x2b\x98\x46\x7a\x8d\x9a\xae\x8d\x82\xb0\x31\xa0\x4f\x40\x41\xdf\xe7\x90\xf9\xa3\x39\xf2\x42\xfc\xda\x72\x78\x79\x59\x85\x30\x30\x9b\x6d\xbb\x2d\x88\xff\x14\x3c\x37\xb7\x16\x10\xaa\x43\xdf\xc3\x3c\x80\xe7\xdd\x55\xd4\x80\x10\x56\x3b\x76\x41\xf4\x33\x85\xf9\xa6\xf3\x3d\xcc\x8f\x28\x05\x11\x63\xb9\x3d\x24\x8a\x92\x6d\x59\xba\x5d\xca\xc7\x5d\x0a\x33\xa0\x3f\xda\xce\x80\xf2\xb1\xa3\x1d\x76\x4b\xd5\x5d\x5c\xa1\xba\xcf\x19\x85\x1f\x3d\x50\xc9\xea\xb7\x64\xf7\xb6\xb1\xb8\xa1\x55\xb7\x9e\xb5\x38\x05\x17\x6a\x87\xe8\x6a\x90\x1f\x72\xa1\x4c\x74\x7d\xf3\xfd\xe2\x80\xc7\xd5\xd5\xd7\xa6\xde\x65\xb0\x6f\x56\x19\x57\x43\x43\xc3\xac\xb2\x83\xff\xed\xdb\x41\x8f\xef\x07\x3d\xfe\x71\x30\xcb\xcb\x43\x1e\xd7\x9b\x59\x22\x54\x08\xae\x78\xc2\xa9\x89\x46\x83\xe1\xa1\x9e\xa0\xd9\x0c\x18\x48\x39\x14\xfc\x09\x36\x6b\x3e\x51\xaa\xb8\x03\x65\x6e\x87\x27\x6a\x62\xa2\xde\x04\x08\x55\x93\xf9\xf6\xe2\x92\xf6\xf9\xda\x82\x00\x92\x66\xef\x02\xd1\x3b\x8f\x84\x90\xbc\x14\x09\x6c\xb5\xcd\x3e\xb9\x5a\x6d\xd9\xd5\xb8\x43\x1b\xfe\x2e\x6f\xa1\x6f\x77\xd0\x8b\xcb\xc6\x09\x93\x4a\x38\x3c\x1f\xb7\xf4\x9c\x80\xdf\x25\xc8\xed\x37\x01\xa1\xa4\x28\x4d\x74\x7d\x7e\x9e\x6f\xd9\x73\xc8\xb9\x98\x9b\xe8\xf2\xfa\xc6\xcd\x9a\xa9\x9c\x82\x70\x71\x3c\x42\x63\x67\xcd\x38\x2d\x73\x70\xf5\xdf\x06\xd2\x6c\x13\xd4\xd6\x6b\x56\xe3\xe0\x89\x50\xae\xc3\x0d\xeb\xe6\x38\x69\x66\xad\xbb\xd0\x67\x74\x6e\x22\x3d\xac\x9f\x4c\x68\x43\xb8\x16\x22\xb7\x87\xdc\x7e\xe1\x3b\x86\x5a\x5d\xbf\x86\xbf\x2d\x4e\x2c\x5b\x5d\x9e\xcd\x13\xa8\x6d\xde\xe9\xe1\xfe\x07\x95\xaa\x0d\x2e\x29\x36\x29\xbd\x33\xee\x7f\x07\x00\x5c\x79\xa6\xcf\xc1\x15\x00\x00"),
 		},
 		"/templates/cp-global-sync-service.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cp-global-sync-service.yaml",
@@ -201,10 +257,10 @@ var HelmTemplates = func() http.FileSystem {
 		},
 		"/templates/cp-rbac.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cp-rbac.yaml",
-			modTime:          time.Date(2020, 8, 31, 9, 49, 40, 375001562, time.UTC),
-			uncompressedSize: 3364,
+			modTime:          time.Date(2026, 10, 19, 13, 28, 22, 935693833, time.UTC),
+			uncompressedSize: 4403,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x56\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\x10\xd9\x6d\x80\x5d\x0c\xd8\xa1\xc8\x6d\xdb\x61\x97\x61\x18\xda\xa1\x77\x45\x7e\x8e\x39\xcb\x92\x27\x51\xe9\xd6\x2c\xff\x7d\x90\x9d\x26\x69\x3e\xba\xc6\x6d\xb7\x35\x27\x8b\x0a\xc9\x47\x52\x7c\xa4\x6a\xf9\x0a\x3e\xb0\xb3\x13\x9a\xbf\x19\xd5\x6c\x8b\x09\x5d\xc2\xcf\x59\xe3\x9d\xd6\x2e\x5a\x19\x35\x10\x55\x28\x51\x93\x11\x91\x55\x0d\x26\xb4\x58\x10\x5b\x6d\x62\x01\x1a\xd7\xb1\x51\x79\x12\x8f\x29\xa7\xe5\x32\xd3\xce\x8a\x77\x26\x6b\x8d\xb2\x58\x69\x84\x56\xe9\x5e\x2d\xbf\x80\x81\x0a\xc8\x3f\xdf\x8a\x69\xb9\x1c\x11\x19\x35\x85\x09\xc9\xc3\x62\x91\xed\x18\xef\xef\x92\xf9\x5f\x64\xd9\x16\xb0\x42\x6f\x93\x5a\x96\x65\xa3\xed\x00\xfc\x54\xe9\x5c\x45\xa9\x9c\xe7\x1b\x25\xec\x6c\x5e\x9f\x87\x9c\xdd\xd9\x3a\xb4\x0f\x26\x06\x81\xbf\x70\x06\xa7\xc5\x35\xd9\x8d\x6b\x10\x62\x1f\x0d\x3a\x9d\x8c\x54\xcb\x1f\xbd\x8b\x6d\x77\x4c\xbf\x8c\xc6\xe3\xee\xd3\x23\xb8\xe8\x35\xb6\x6e\xd6\x49\x0c\x6b\x51\xeb\x8a\xcd\x21\xf4\x15\xeb\x05\x73\xf8\xe9\x96\xee\x0c\xb2\xfe\x36\x1c\x36\x87\x6b\x25\xba\x3a\x1d\x0b\xe6\xb0\x72\xd0\x93\xf6\x50\x82\x0d\xc2\xfb\xec\x77\x79\x62\x77\xcc\x49\xaa\x4b\x97\xe9\xb0\x2f\x62\x1b\x78\x56\xc9\xe6\xa6\x41\xa8\xb6\xfe\x78\xe3\xec\xce\xe9\x8e\xc6\xa9\xe9\x39\x18\x5b\x6c\x8b\x43\xa1\xae\x90\xc2\xa0\xbb\x1c\x12\x79\xeb\xdd\x8f\x9f\x82\xa6\x35\x4a\xf0\x02\x11\x9f\x05\x51\x12\xff\x08\xfc\x08\x9c\x21\xfe\xc5\xab\xb2\x64\xdd\xc2\x37\x1c\x12\x13\x84\xdd\x2b\xe3\x66\x7b\x32\xef\xa2\x60\x4f\x2a\xfe\x4e\x93\x95\x2a\x1a\x61\xfb\x0d\x5a\xee\xd8\xd5\xec\x75\x64\x99\x7a\xa8\x1a\x7e\x23\xaf\xa0\x8c\x54\xba\x82\xae\x87\x97\xae\xe3\x93\x92\xf0\x9d\xf2\x2b\x65\x22\x42\xbe\xe2\x9e\x2f\xe9\xf5\xe7\x8d\x4b\x3c\xe3\xd1\x38\xc1\xb8\x67\xcf\x47\x14\x3c\x39\x83\x2d\x7a\x3b\x27\x32\x41\xa2\xa0\xb3\x92\xad\x32\x7c\x73\x9b\x85\x9d\x80\xc7\xaf\xc7\x03\xab\xda\x37\xf5\xb3\x99\xdf\x10\xcc\x83\x5c\xbc\xa2\xb9\x32\x9c\x12\x4a\xf5\x79\x20\x71\x35\x2c\x4d\x51\x3a\x0f\xe2\x10\x22\xd8\xce\xa8\xf9\xfa\xe9\x92\x34\xbc\x1c\xc3\x94\x66\x13\xac\xb0\xde\x1e\x4e\x47\x9f\x75\xf2\xe1\x31\x67\x5c\xdf\xc7\xb6\x8f\x1b\x82\xef\xd9\x16\x6c\x67\xff\x64\x16\x3a\x83\x0b\x94\x49\xeb\x36\x55\xf7\xa0\x1f\x11\xed\x4f\xf0\xd3\xb1\x86\x38\x4d\xcd\xbc\x9a\xc1\x07\xd7\x9d\x94\xdd\xd3\xd7\x9c\x87\x2d\x3a\x03\x8a\xf5\x04\xab\xca\x33\xae\x60\x43\x17\x9a\x00\xed\xf1\x9f\x0c\xe4\xe3\x28\xb5\xb3\x25\xcf\x1a\xd5\xfe\x65\xa0\x03\x9f\xc9\xd3\x34\xf3\x43\x5e\xcb\xc0\xde\x7d\x99\x4d\xfb\x3b\x00\x00\xff\xff\xd7\x24\x70\x59\x24\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x57\x4d\x6f\x23\x37\x0c\xbd\xfb\x57\x10\xce\xad\x80\x27\x28\xd0\xc3\x22\xb7\x76\x0b\x2c\x8a\x2e\x8a\x22\x5b\xec\x5d\xa3\x79\x33\x66\xad\x91\xa6\x14\xe5\xec\x26\xcd\x7f\x2f\xe4\xf1\x67\xc6\xce\x26\x13\x27\xc5\xfa\x64\x91\x43\xf1\xf1\x89\x14\x29\xd3\xf1\x67\x48\xe4\xe0\xaf\x68\xf9\xe3\x64\xc1\xbe\xba\xa2\x4f\x90\x25\x5b\xfc\x6c\x6d\x48\x5e\x27\x2d\xd4\x54\x46\xcd\xd5\x84\xc8\x9b\x16\x57\x74\x77\x47\xec\xad\x4b\x15\x68\xba\x48\xad\x29\xb2\x78\x4a\x05\xdd\xdf\xcf\x6c\xf0\x2a\xc1\xcd\x3a\x67\x3c\xd6\x16\xb1\x33\xb6\x37\x2b\xae\xe1\x60\x22\x8a\x3f\x36\x62\xba\xbf\x9f\x10\x39\x53\xc2\xc5\xec\xe1\xee\x6e\xf6\x60\xf3\x5e\x97\xb7\xff\x97\x3c\xfb\x0a\x5e\xe9\xa7\x6c\x36\x9b\xcd\x26\xfb\x01\x48\x69\x6c\x61\x92\xce\x83\xf0\xad\x51\x0e\xbe\x58\xbc\x8b\x05\x87\xcb\x6d\x68\xef\x5d\x8a\x0a\xb9\x0e\x0e\xcf\x8b\xeb\xea\x61\x5c\xa3\x10\x4b\x72\x58\xd9\xcc\xc8\x74\xfc\x41\x42\xea\x56\xcb\xfc\x9b\xd1\x74\xba\xfa\x2b\x88\x21\x89\xc5\x9e\x66\x4b\x62\xdc\x8a\xba\x50\xed\x16\xb1\x3f\xb1\x5e\xb0\x84\x94\x7b\xb6\x0d\x74\xfb\xdf\x71\xdc\x2d\x6e\x8c\xda\xf9\xf3\xb1\x60\x09\xaf\x47\x3d\x59\x81\x51\xec\x10\x3e\xb6\xff\x8a\x27\x0e\xa7\x9c\xe4\x73\x59\x31\x1d\x87\x22\xf6\x91\x9b\xb9\xee\x69\xd0\x21\x93\x6c\x19\x71\xa0\x6c\x11\xe7\x38\x5c\x0e\xbe\x91\xe0\x30\x20\x73\xf0\xd5\x6d\xf0\x38\x5c\x1d\x7c\xf2\x5c\xda\x8f\x72\x96\xba\xea\x18\x85\x9b\x38\x1d\x14\x23\x19\xed\x24\x7c\xf9\xaa\x68\x3b\x67\x14\xdf\x21\xe2\xcb\xa8\x46\xd3\x37\x81\x9f\x80\x33\xc6\x7f\xe4\x0a\xd6\xc8\xcb\x38\xbb\x20\x81\x0d\x52\x51\x85\xa8\xec\x57\xb7\x52\x24\x07\x23\x1e\x15\xd5\x12\x5a\xd2\x39\x28\x94\x39\xeb\x50\x91\x8a\xa9\x6b\xb6\x14\x6a\xfa\x75\xbf\x06\x46\x15\xd1\x5e\x5d\x9c\xef\x66\xf8\x86\xd3\x75\x00\x1d\xa4\xe5\x98\xef\xe5\xf8\x50\xe5\x42\x33\x90\x49\x48\x8a\x81\x54\xe5\xe0\xca\xab\x4d\x72\xca\xfe\x6f\x58\x3d\xd8\xd7\xb2\xd8\xc4\x5a\x0a\xcc\x02\xb2\x93\xcf\x61\x9c\xce\xed\x1c\x76\xb1\x13\x36\x46\x71\x63\xbe\xe6\x78\xe1\x21\x03\xc5\x1e\x92\x31\xf5\xb1\x6a\x06\x35\xe1\x1f\x2a\x3e\x1b\x97\x10\x8b\x75\xe3\xf8\x33\x9f\x64\xd1\x86\xdc\x24\x04\x6d\x50\x4c\xfb\xd6\xf7\x82\xaa\xca\xce\xe0\xab\x7e\x9f\xb5\xe7\xa3\x6e\xd9\x37\x82\x18\xdf\xf7\x32\x07\x29\xe0\x4d\xe9\xb0\x36\xbd\x20\x4d\xe2\xe9\xf7\x54\x42\x3c\x14\x91\x7e\xeb\x0d\x10\x89\xbd\x06\xfa\xd0\x73\xf3\x71\x43\x1a\x19\x5f\x6d\x84\xd7\x1b\xc2\x8e\x66\x8b\x87\xde\x04\x59\xb0\x6f\xd6\xbd\x78\xab\xc1\x17\x85\xdf\x25\xc8\x91\x54\xe2\x0d\x88\xb7\x68\x6b\x11\x56\xa0\x6f\x57\x27\xaf\x9c\x87\xe7\xc8\xa8\x67\x32\x98\x27\x92\xcb\x9a\xbd\x71\x7c\x0b\x39\x0a\x7d\xfa\xc3\x74\x24\x5d\x7d\x1b\x7f\xb5\xed\x77\xf3\xc6\x93\x5c\x5c\xd0\xd2\x38\xce\x84\xd2\xe2\x5d\x24\x0d\x0b\x78\x2a\x51\x07\x01\x71\x8c\x09\xec\x1b\x6a\xff\xfa\xf8\x89\x2c\x44\x4f\x61\xca\xa3\x2a\xbc\xb2\xdd\x9f\x55\x4f\xde\xab\xd9\x87\x60\xc9\xb8\x79\x6c\xf8\x7a\xd9\x4c\xfc\x0b\xfb\x8a\x7d\xf3\xbf\x8c\xc6\xc1\xe1\x1a\x75\xb6\xda\x50\xf5\x08\xfa\x09\xd1\x00\xfc\x08\xac\x31\x95\xb9\x9b\xac\x47\xf2\xa3\xaf\x1f\xa2\x31\xaf\x9e\xa7\xbd\x7b\x46\x1c\xd6\x19\x5e\x2e\xaf\xf8\x22\x1b\xfb\xbe\x79\xc9\xe5\x7b\xee\xa9\xf4\x34\x4a\x1b\x7c\xcd\x4d\x6b\xba\x37\x06\x3a\x32\x4d\xce\x53\xcc\x4f\xc9\x96\x91\xb5\xfb\x7d\x16\xed\x7f\x03\x00\x1a\x91\xaf\x44\x33\x11\x00\x00"),
 		},
 		"/templates/cp-service.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cp-service.yaml",
@@ -215,10 +271,10 @@ var HelmTemplates = func() http.FileSystem {
 		},
 		"/templates/cp-webhooks-and-secrets.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "cp-webhooks-and-secrets.yaml",
-			modTime:          time.Date(2020, 9, 18, 9, 19, 48, 12545199, time.UTC),
-			uncompressedSize: 5397,
+			modTime:          time.Date(2026, 10, 19, 10, 51, 37, 281662748, time.UTC),
+			uncompressedSize: 6088,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xe4\x58\x5f\x6f\xdb\x36\x10\x7f\xd7\xa7\x20\x84\x16\x68\x0b\x50\x8a\x93\x38\xc8\x04\xf4\xa1\x6b\xd3\x62\x58\x5b\x04\x4d\x96\x3d\x53\xd4\xc9\xe2\x4c\x91\x1a\x79\x72\xe6\xb9\xfe\xee\x03\x29\xc9\x96\x14\x27\x71\xba\x02\xcb\x52\xbd\xe9\xfe\xdf\xfd\x7e\x3c\xca\x5e\xad\x28\x79\x86\xd2\x5e\x31\x69\x49\xf2\x9a\x44\x57\x4c\xd6\x60\x23\xae\x15\x1a\x2d\xcf\x25\x53\x10\xa1\xb4\x64\xbd\x0e\x56\xab\xf8\x55\xf0\x01\x14\x18\x86\x40\x38\x18\x14\xb9\xe0\x0c\xc1\x06\x16\x20\x21\x05\x62\x65\x93\x38\x2e\x99\x45\x30\xa5\x50\x99\x8d\x66\x02\x8b\x3a\x8d\x84\x8e\x6d\x65\xc4\x2c\xe6\x66\x59\xa1\x8e\x0a\x2c\xe5\xc8\x09\x32\x51\x97\x11\xd7\x65\xac\xea\x85\xa6\x33\xa3\xeb\x8a\x22\xf0\x22\x2e\xf5\x02\xe8\x52\xd7\x86\xba\x9c\x96\xa2\xa6\x05\xc8\x92\x1e\xe7\xd3\xfc\x64\x72\x74\x74\xca\x38\x1b\x06\x6b\xb3\xfa\x60\x80\xd7\xda\xcc\x2d\x98\x85\xe0\x50\x82\x2d\x76\x89\x52\xa9\xd3\xf8\xf4\xe0\x98\x65\xd3\x83\xc3\x93\x34\x9d\x66\x69\x76\x78\x3a\xe5\x87\x87\x07\xf9\x64\x7a\xf4\xd3\x34\x87\xc3\x29\x1c\x9f\xe4\x53\xc8\xd2\x38\x83\x4a\xea\x65\x09\x0a\x6d\xec\x2a\x89\x95\x2d\x63\x5e\x30\x83\x36\x66\x59\x29\xac\x15\x5a\xd1\x6b\x48\x0b\xad\xe7\x31\x42\x59\x49\x37\xa5\x9b\x3a\x6a\x81\x1b\xc0\x08\x2b\x19\xbc\x8a\xd7\xeb\x80\x10\x0f\x08\xd7\x65\xa9\xd5\x67\x56\x82\xc3\xe4\x85\x50\x5c\xd6\x19\x90\x70\x5e\x97\x6c\x08\x4d\xdb\x84\x33\x0d\x49\xf4\x92\x6c\x63\x30\x89\x4e\xea\x51\x95\xc2\x22\x79\x51\x19\xa1\x30\x27\xe1\x73\x1b\x3d\xb7\xe1\x20\x4b\xf4\x05\x24\x30\x0b\x91\x77\xa9\x18\x87\x97\x23\xfb\xc8\x2e\xf8\xfd\x3e\xb4\xd7\x03\x18\xbc\xbc\xfc\xe8\xd2\x1f\x9d\x4c\x0f\x06\x2a\xe6\xa4\x33\x50\x6f\xdf\x34\x3d\x51\xce\xc2\xad\x87\xb3\xec\x4c\x9d\x11\x18\x6c\xed\x2f\xc4\x4c\x41\xe6\x05\xfd\x4a\x94\x90\xbd\x7e\x37\x71\x5c\x9e\x51\x41\x2e\x4e\x17\x33\xf2\x71\xb6\xfa\x39\x2c\x07\xea\x5f\x61\x49\xfa\x35\xff\x5c\xab\x4c\x36\x80\x3c\xe3\xac\xf1\xfe\x4a\xd2\x93\x63\x50\xbc\xdf\xb9\xc8\xb7\x27\x6a\x94\xfd\xf5\x46\x13\xf1\xc6\xdd\xc0\x9f\xb5\x30\x90\x91\xf0\x53\x6d\x91\x54\x46\x2f\x44\x06\xb7\x1e\x43\xef\x16\x8e\x8a\xee\x45\x75\xaf\x0f\x0f\x3a\x87\x65\xb8\xab\xd5\xd7\x6d\xd9\x5d\x97\x5b\x1b\x50\x99\x7b\x09\x28\xa5\x01\xab\xc4\x15\x18\x47\xeb\x84\x2c\x26\xc1\x5c\xa8\x2c\x21\x17\x9e\xd8\x01\x2e\x2b\x48\xc8\xbc\x4e\xc1\x28\x40\xb0\x6e\x11\xa0\xb4\x41\x09\xc8\x32\x86\x2c\x09\x08\x51\xac\x84\x84\xac\x56\x64\x48\x73\xd5\x50\x9a\xac\xd7\x14\xa5\xf5\x47\xbf\x35\xf6\x5c\xf3\x1e\x37\x29\xd8\x94\x28\x59\x0a\xd2\x26\x1d\x20\x83\xb8\x8d\xce\x45\xfe\x4a\x94\x50\x19\x28\x24\xc7\xce\xad\xab\xc7\x8f\xd9\xa0\x4f\xb0\xab\xff\x76\x62\x8d\xde\x0d\x7c\xa0\x1f\x4f\x64\x73\xe6\x0d\xcc\x84\x45\xc3\x50\x68\x15\xcd\x4f\xfd\x28\x16\x93\x14\x90\x75\x33\xfb\x54\x23\x43\xa1\x66\xbf\x37\xcb\xe1\xad\x56\xb9\x98\xd5\x8d\xc7\x03\x27\xb6\xdd\x34\x65\x1b\x74\xb3\x72\xf8\x20\xec\xb7\x4c\x74\xdf\x81\xb6\x19\xbd\x17\x6d\x8b\x76\xdb\x36\xca\x20\x67\xb5\x44\x30\x91\x3f\xfc\x9b\x62\xfd\x6b\x24\x74\x40\x08\x21\x39\x13\xb2\x36\x70\xae\xa5\xe0\xcb\x84\xbc\x67\x42\x7a\x39\x97\x02\x14\x36\xc3\x49\xbc\x84\x90\x8e\xb1\x2d\x66\x1d\x7f\x7d\xe5\xee\x69\xb7\x64\x67\xbe\x6f\xd3\x5b\xdb\x1d\xd3\xbe\x63\x0d\xf7\xbd\x2b\x86\x45\x42\xe2\xb6\x65\xea\x1b\x16\x9a\x2e\x26\x4c\x56\x05\x9b\x50\x37\x10\x6f\x6c\x6a\x09\xb6\xab\x90\x12\x56\x89\x0f\xee\x0a\xb4\xdb\xa2\x9d\xb8\x3f\x21\xf7\x6c\xa9\x36\x32\xec\x12\x6c\x84\xba\x82\x06\xf3\x91\xe1\xdb\x2f\x67\x6f\x2e\xcf\x06\xa2\xdf\xce\xdf\xf5\x45\x06\xac\xae\x0d\x87\x91\xa3\xab\x1c\xac\x17\x59\x91\xc1\x59\x9e\x03\x47\x9b\x90\xcf\x5a\x41\x0f\x71\x7d\xad\xc0\x50\x03\x39\x18\x50\x1c\x7e\x1c\xc8\x47\x8d\xdf\x80\xfe\x31\xa1\x7e\x0b\xc4\x5c\x18\x5e\x0b\x4c\x0d\xb0\x39\x18\x3b\xd0\x79\x3e\x0b\xf5\x07\x70\x1f\x7e\xa0\x43\xc3\xf2\x5c\x70\xa9\x67\x3b\xe5\x95\xfb\x3a\xf4\xf0\xef\x54\x1b\x5d\x23\xec\xd4\xa0\x61\x7c\xa4\x29\x80\x49\x2c\x78\x01\x7c\x3e\x54\x54\x46\xff\xb5\xdc\x7c\x7b\xdd\x4b\xd3\x06\x1d\xdf\x8e\x36\x77\xb1\xd2\x91\x67\xd7\x45\xda\xf9\xbe\xef\x9b\x77\xb4\xf8\x7f\x32\xb8\x69\x89\xba\xb1\x71\x66\x1e\xc0\xd7\x30\xdc\x87\xaa\xdf\x89\xa4\x95\xce\x6e\x83\xf7\x5f\x5c\xc6\x57\x4c\x8a\xec\x7b\x5d\xc7\x8b\x4d\xb0\x47\x72\x0d\xb7\x05\xe9\x1f\xe8\x06\x6e\x5b\x7e\xdc\x7b\x78\xc7\xed\xeb\x44\xef\xce\x3e\x9e\x3d\x89\x6d\xed\x8e\x4c\xe5\xf0\xda\x73\x89\xf7\xbe\x31\xee\xd8\xeb\x9d\xea\x6f\xad\xf6\xd8\xf4\x2d\x53\xa2\x6f\x39\x03\xbf\xcc\x94\x36\xf0\x14\x4e\xc1\x62\x42\x5b\xe3\xff\x7c\xaf\xef\xff\xc9\xd9\x56\xbc\x0f\xc6\xfe\x7f\x95\xbd\x20\xde\x60\x70\x01\xd2\xdf\xe0\x5d\xce\x92\x21\x2f\x3e\x6e\x36\x6e\xf3\xb4\x9e\xb1\x5d\x5a\x84\x92\xf6\x00\x0c\xd1\xd4\x10\x3e\x01\xd6\xdc\x4b\x1b\xff\xd3\xfe\x11\xb2\x66\xff\x55\xd9\xf4\x70\x1b\x8f\xfe\x09\x00\x00\xff\xff\xe8\x5d\x2e\xa5\x15\x15\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x58\xdf\x6f\xdb\xb6\x13\x7f\xd7\x5f\x41\x08\x2d\xd0\x16\xa0\x14\x27\x71\x90\xaf\x80\x3e\xf4\xdb\xa6\xc5\xb0\xb4\x08\x9a\x2c\x7b\xa6\xa8\x93\xc5\x99\x22\x35\xf2\xe4\xcc\x73\xfd\xbf\x0f\xa4\x24\x5b\x52\x9c\xd6\xe9\x8a\x2d\x4d\xa7\x07\x03\xba\x5f\xbc\xbb\xcf\xfd\xa0\xb5\x5a\x51\xf2\x04\xa5\xbd\x66\xd2\x92\xe4\x25\x89\xae\x99\xac\xc1\x46\x5c\x2b\x34\x5a\x5e\x48\xa6\x20\x42\x69\xc9\x7a\x1d\xac\x56\xf1\x8b\xe0\x1d\x28\x30\x0c\x81\x70\x30\x28\x72\xc1\x19\x82\x0d\x2c\x40\x42\x0a\xc4\xca\x26\x71\x5c\x32\x8b\x60\x4a\xa1\x32\x1b\xcd\x04\x16\x75\x1a\x09\x1d\xdb\xca\x88\x59\xcc\xcd\xb2\x42\x1d\x15\x58\xca\x91\x12\x64\xa2\x2e\x23\xae\xcb\x58\xd5\x0b\x4d\x67\x46\xd7\x15\x45\xe0\x45\x5c\xea\x05\xd0\xa5\xae\x0d\x75\x67\x5a\x8a\x9a\x16\x20\x4b\x7a\x9c\x4f\xf3\x93\xc9\xd1\xd1\x29\xe3\x6c\x68\xac\x3d\xd5\x1b\x03\xbc\xd1\x66\x6e\xc1\x2c\x04\x87\x12\x6c\xb1\x8b\x94\x4a\x9d\xc6\xa7\x07\xc7\x2c\x9b\x1e\x1c\x9e\xa4\xe9\x34\x4b\xb3\xc3\xd3\x29\x3f\x3c\x3c\xc8\x27\xd3\xa3\xff\x4d\x73\x38\x9c\xc2\xf1\x49\x3e\x85\x2c\x8d\x33\xa8\xa4\x5e\x96\xa0\xd0\xc6\xce\x93\x58\xd9\x32\xe6\x05\x33\x68\x63\x96\x95\xc2\x5a\xa1\x15\xbd\x81\xb4\xd0\x7a\x1e\x23\x94\x95\x74\x59\xba\xcd\xa3\x16\xb8\x01\x8c\xb0\x92\xc1\x8b\x78\xbd\x0e\x08\xf1\x80\x70\x5d\x96\x5a\x7d\x60\x25\x38\x4c\x9e\x09\xc5\x65\x9d\x01\x09\xe7\x75\xc9\x86\xd0\xb4\x41\x38\xd1\x90\x44\xcf\xc9\xd6\x06\x93\xe8\xa8\x1e\x55\x29\x2c\x92\x67\x95\x11\x0a\x73\x12\x3e\xb5\xd1\x53\x1b\x0e\x4e\x89\x3e\x82\x04\x66\x21\xf2\x2a\x15\xe3\xf0\x7c\x24\x1f\xd9\x05\xff\xb2\x0e\xed\xc5\x00\x06\xaf\xae\xce\xdd\xf1\x47\x27\xd3\x83\x01\x8b\x39\xea\x0c\xd4\xeb\x57\x4d\x4c\x94\xb3\x70\xab\xe1\x24\x3b\x51\x27\x04\x06\x5b\xf9\x4b\x31\x53\x90\x79\x42\xdf\x13\x25\x64\x2f\xde\x8d\x1d\x77\xce\xc8\x21\x67\xa7\xb3\x19\xb9\x9f\x5e\xc2\xe6\xb0\x1c\xb0\x7f\x86\x25\xe9\xfb\xfc\xff\x5a\x65\xb2\x01\xe4\x09\x67\x8d\xf6\x27\x92\x9e\x1c\x83\xe2\xfd\xc8\x45\xbe\xed\xa8\xd1\xe9\x2f\x37\x9c\x88\x37\xea\x06\x7e\xaf\x85\x81\x8c\x84\xef\x6b\x8b\xa4\x32\x7a\x21\x32\xb8\xb3\x0d\xbd\x5a\x38\x72\xba\x67\xd5\xbd\xde\xdf\xe8\x1c\x96\xe1\xae\x50\x5f\xb6\x6e\x77\x51\x6e\x65\x40\x65\xee\x25\xa0\x94\x06\xac\x12\xd7\x60\x5c\x59\x27\x64\x31\x09\xe6\x42\x65\x09\xb9\xf4\x85\x1d\xe0\xb2\x82\x84\xcc\xeb\x14\x8c\x02\x04\xeb\x06\x01\x4a\x1b\x94\x80\x2c\x63\xc8\x92\x80\x10\xc5\x4a\x48\xc8\x6a\x45\x86\x65\xae\x9a\x92\x26\xeb\x35\x45\x69\x7d\xeb\xb7\xc2\xbe\xd6\xbc\xc6\xed\x12\x6c\x5c\x94\x2c\x05\x69\x93\x0e\x90\x81\xdd\x86\xe7\x2c\x7f\x22\x4a\xa8\x0c\x14\x92\x63\xa7\xd6\xf9\xe3\xd3\x6c\xd0\x1f\xb0\x2b\xfe\x36\x63\x0d\xdf\x25\x7c\xc0\x1f\x67\x64\xd3\xf3\x06\x66\xc2\xa2\x61\x28\xb4\x8a\xe6\xa7\x3e\x15\x8b\x49\x0a\xc8\xba\x9c\xbd\xaf\x91\xa1\x50\xb3\x5f\x9b\xe1\xf0\x5a\xab\x5c\xcc\xea\x46\xe3\x9e\x19\xdb\x4e\x9a\xb2\x35\xba\x19\x39\x7c\x60\xf6\x6b\x32\xba\x6f\x42\xdb\x13\xbd\x16\x6d\x9d\x76\xd3\x36\xca\x20\x67\xb5\x44\x30\x91\x6f\xfe\x8d\xb3\xfe\x35\x12\x3a\x20\x84\x90\x9c\x09\x59\x1b\xb8\xd0\x52\xf0\x65\x42\xde\x32\x21\x3d\x9d\x4b\x01\x0a\x9b\xe4\x24\x9e\x42\x48\x57\xb1\x2d\x66\xed\x5b\xe3\xb9\x7b\xda\x29\xd9\x89\xef\x1b\xf4\x56\x76\x47\xb6\x3f\x33\x86\xfb\xda\x15\xc3\x22\x21\x71\x1b\x32\xf5\x01\x0b\x4d\x17\x13\x26\xab\x82\x4d\xa8\x4b\x88\x17\x36\xb5\x04\xdb\x79\x48\x09\xab\xc4\x3b\xb7\x02\xed\xd6\x69\x47\xee\x67\xc8\x3d\xdb\x52\x1b\x09\x76\x07\x6c\x88\xba\x82\x06\xf3\x91\xe0\xeb\x8f\x67\xaf\xae\xce\x06\xa4\x5f\x2e\xde\xf4\x49\x06\xac\xae\x0d\x87\x91\xa2\xf3\x1c\xac\x27\x59\x91\xc1\x59\x9e\x03\x47\x9b\x90\x0f\x5a\x41\x0f\x71\x7d\xa3\xc0\x50\x03\x39\x18\x50\x1c\x7e\x1c\xc8\x47\x81\xdf\x82\xfe\x21\xa1\x7e\x07\xc4\x5c\x18\x5e\x0b\x4c\x0d\xb0\x39\x18\x3b\xe0\xf9\x7a\x16\xea\x37\xe0\xde\xfc\x80\x87\x86\xe5\xb9\xe0\x52\xcf\x76\xd2\x2b\x30\x2d\xfc\x3b\xd9\x46\xd7\x08\x3b\x39\x68\x18\x1f\x71\x0a\x60\x12\x0b\x5e\x00\x9f\x0f\x19\x95\xd1\x7f\x2c\x37\x77\xaf\x01\x6b\xc6\x10\x6e\xd8\xd2\xdd\x8a\xdc\x6d\x76\x27\x73\x87\x13\x19\x54\xe0\xc6\x1b\x17\x7b\x94\x7d\x83\xb6\x4f\x8f\x36\x9f\xab\xf2\xd5\x6a\xf7\x62\xee\x74\xdf\xf6\xc5\xbb\x32\xfb\x3e\x3b\xa2\x09\x89\xba\xb4\x71\x66\xee\x51\xff\x61\xb8\x4f\xe9\x7f\xa3\xa2\xaf\x74\x76\x17\xbc\x7f\x63\xb9\x5f\x33\x29\xb2\x6f\xb5\xde\x17\x1b\x63\x0f\x64\xad\xb7\x0e\xe9\x1f\x68\xa3\xb7\x21\x3f\xec\xb9\xbe\x63\x9b\x3b\xd2\x9b\xb3\xf3\xb3\x47\x31\xfd\x5d\xcb\x54\x0e\xaf\x3d\x97\x42\xef\xce\xf2\x0f\xee\x89\x8e\xf1\xa7\x56\x7b\x6c\x8e\xb6\xf2\xa2\xaf\xe9\xa9\x9f\x66\x4a\x1b\x78\x0c\x5d\xb5\x98\xd0\x56\xf8\x5f\xdf\x13\xfb\x5f\x89\x5b\x8f\xf7\xc0\xb8\x59\x81\x5d\xd9\x45\xff\xcd\xcf\xde\x5f\xa2\x51\x72\xbe\xcf\x7f\x47\xa3\x20\xf6\x69\x7b\xff\x29\x70\xaf\x4a\xd8\x80\x75\x09\xd2\x5f\x12\xbb\xb3\x4b\x86\xbc\x38\xdf\x2c\xf5\xe6\x69\x35\x63\xbb\xb4\x08\x25\xed\x21\x1d\xa2\xa9\x21\x7c\x04\x83\xe4\x8b\x93\xc4\x7f\x8d\x7a\x80\x83\x64\xff\x6d\xdc\xc4\x70\x57\x1d\xfd\x35\x00\x82\x26\x7b\xea\xc8\x17\x00\x00"),
 		},
 		"/templates/ingress-deployment.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "ingress-deployment.yaml",
@@ -257,10 +313,10 @@ var HelmTemplates = func() http.FileSystem {
 		},
 		"/values.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "values.yaml",
-			modTime:          time.Date(2020, 9, 18, 8, 21, 4, 142273771, time.UTC),
-			uncompressedSize: 1514,
+			modTime:          time.Date(2026, 10, 19, 10, 5, 50, 131986482, time.UTC),
+			uncompressedSize: 1650,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x54\xd1\x6e\xeb\x44\x10\x7d\xf7\x57\x8c\x9c\xe7\xe6\xba\x40\x2b\xe4\xb7\x4b\x11\xa8\xa2\xf7\x2a\x22\x15\x3c\x4f\xd6\x13\x7b\xc9\x7a\xc6\xec\x8c\x03\x06\xf1\xef\x68\x77\x93\x36\x17\x45\x05\xa4\xfb\x16\xcf\xce\x9c\x3d\x7b\xe6\x9c\xac\xe0\x79\x20\xe8\x68\x8f\x73\x30\x88\xd4\x7b\xb5\xb8\x00\x72\x07\x86\x3d\x98\xc0\xac\x04\x7b\x89\x80\x21\xc0\x0f\xf3\x88\xe0\x47\xec\x49\xab\x3e\xc8\x0e\x43\x5b\x01\x30\x8e\xa4\x13\x3a\x6a\xa1\x3e\xcc\x23\xde\xe8\xa2\x46\x63\x5d\x41\xe9\x4d\x3d\xf0\x82\x9d\x9a\x84\xfb\x9b\x4e\xdc\x81\xe2\x4d\x1e\x28\xbf\xd7\x3b\xcf\x16\x71\x59\x7b\xa9\xf3\xc8\x0a\xbe\x2d\xc4\x34\x11\x59\x3f\x0c\x18\x6d\xfd\x7e\x9a\x7e\xa2\xa8\x5e\xf8\xd4\x63\xd8\xb7\x50\xd7\x55\xb5\x82\x9f\x07\xb2\x81\x22\x48\x04\x16\x4b\x43\x13\x9a\x1b\xc0\x06\x02\xc3\xd8\x93\xbd\x92\x85\xdf\xbc\x95\x93\x42\x17\x02\xee\x28\x54\x79\x60\x9b\x2b\x1f\x5f\xdf\x65\x71\xa6\xaa\x72\xc2\x16\x25\x6c\x02\x72\x7e\x54\x90\xfe\x89\x8e\x14\x5a\xa8\x3d\xef\x33\xe9\x51\xba\x24\x83\x1a\x72\x87\x41\x98\x52\x71\x05\x7f\x08\x53\x21\x09\x70\xe8\xf4\xfb\xac\xdd\xfb\xae\x8b\xa4\x7a\xae\xb3\x74\xb4\xa5\x40\xce\x24\x16\xc9\x0e\xf3\x8e\x22\x93\x91\xae\xbd\xbc\x13\x6d\x21\x78\x9e\x7f\xbf\x72\x86\xd1\x0d\x2d\xe0\xd8\xdd\x7f\x95\xa0\x3c\xff\x92\x61\xbe\x43\x1f\xe6\x48\x1b\x09\xde\x2d\x2d\x3c\xf6\x2c\x91\x52\x83\x52\x3c\x7a\x77\xda\xcc\x2a\x8b\x92\x69\xa4\x4f\x5b\x26\x6a\xe1\x21\xcc\x6a\x14\x1f\x37\xb9\x86\xcc\x62\x68\x5e\x58\x5b\xf8\xf3\xaf\x04\x51\xf6\xff\x23\x8d\x62\xb4\x5d\xd8\x6d\xff\x15\xf2\x49\xb0\xfb\x06\x03\xb2\xa3\x78\x15\x35\xd5\x26\x89\xd6\xc2\xdd\xfd\xd7\x77\xe9\x92\x93\x31\xb5\xa0\xea\xc1\x4f\x1f\x48\x87\x87\x48\x79\xaa\x85\x3d\x06\xcd\x0f\x5a\x25\x83\xa9\xcc\xd1\x51\x6e\x5e\x41\xf1\xdc\xaf\x33\xa9\x5d\x54\x00\xdc\x34\xb7\x70\xdb\x34\xe3\x45\x6d\xa4\x51\x92\x33\xbf\xb8\xbb\xff\xe0\x0b\xdc\xf3\xd3\x36\xdb\xfe\x88\xd1\xcb\xac\x59\x31\x8a\x5a\x86\x2c\xbc\x42\x3a\x4a\x84\xeb\xfa\xfc\x7d\xa0\xe5\xbc\xd1\x0b\xf7\x4f\x73\x08\x2f\x6b\xd8\x7f\x14\xdb\x44\x52\x62\x3b\x45\x63\x12\xf5\x26\x25\x1c\x29\x10\x6e\x2a\x78\x4a\x2e\x92\x15\x75\x1c\xfb\x84\x45\x8c\xbb\x40\xdd\xf9\xed\x97\x2e\x4c\x26\xfc\xdc\x4e\xba\x1a\xe0\x53\x5e\xcf\x39\xfd\x84\x7f\x90\xdd\x41\x8e\x3e\x2c\xf8\xce\xb3\x1a\x86\x70\xe3\xd8\x9f\x7c\x90\xa3\xda\xac\x9b\xf5\x6d\xce\xeb\x83\xf0\xde\xf7\x73\xcc\xdb\xcc\x72\xa7\x3c\x26\x05\xa0\x43\xc3\x29\x05\x0d\xd4\x77\xe4\x30\x56\xa9\xf2\x12\xbd\x4f\x78\xfd\x53\xbd\x6e\xaa\xdf\x16\x3d\x6b\xfb\xc6\xe5\x9e\xbd\xc1\x34\xa0\x12\x78\x2e\xff\x11\x27\x12\x90\xcf\x1e\xdf\xba\x3d\x35\xd4\x55\xe5\xb9\xcf\xe9\xbe\xb6\xb2\x91\x74\x68\xcf\xe6\x4e\x36\x8f\xe8\xf9\xd9\xa7\xc4\x7c\xd9\xe8\x7f\xca\xe7\xff\x08\xd3\x6d\xd3\x34\xb7\xd5\xdf\x01\x00\x00\xff\xff\x47\xb7\x1c\x52\xea\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x54\xd1\x8e\xeb\x34\x10\x7d\xf7\x57\x1c\xa5\xcf\xdb\xdb\x05\x76\x85\xf2\x76\x29\x02\xad\xd8\x7b\xb5\xa2\x2b\x78\x9e\x3a\xd3\xc4\xd4\xf1\x04\x7b\xd2\x4b\x40\xfc\x3b\x8a\x9d\x6e\xf7\xa2\x6a\x01\x89\xb7\x64\x3c\x33\x3e\x3e\x73\xce\xac\xf0\xdc\x31\x1a\x3e\xd0\xe8\x15\x91\x5b\x97\x34\x4e\xa0\xd0\x40\xa9\x85\x0a\xc6\xc4\x38\x48\x04\x79\x8f\x1f\xc6\x9e\xe0\x7a\x6a\x39\x99\xd6\xcb\x9e\x7c\x6d\x80\x40\x3d\xa7\x81\x2c\xd7\xa8\x8e\x63\x4f\x37\x69\x4a\xca\x7d\x65\x50\x72\xe7\x1c\xbc\xf4\x9e\x93\x24\xb4\x37\x8d\xd8\x23\xc7\x9b\x5c\x50\xbe\xd7\x7b\x17\x34\xd2\xb4\x76\x52\xe5\x92\x15\xbe\x2d\xc0\x12\x54\xb0\xde\x76\x14\x75\xfd\x7e\x18\x7e\xe2\x98\x9c\x84\x25\x47\xa9\xad\x51\x55\xc6\xac\xf0\x73\xc7\xda\x71\x84\x44\x04\xd1\xb9\x68\x20\xb5\x1d\xb4\x63\x28\xc5\x96\xf5\x02\x16\x9f\x9c\x96\x93\x02\x17\x9e\xf6\xec\x4d\x2e\xd8\xe5\xc8\xc7\xcb\xbb\x34\x8e\x6c\x8c\x95\xa0\x51\xfc\x93\xa7\x90\x1f\xe5\xa5\x7d\xe4\x13\xfb\x1a\x95\x0b\x87\x0c\xba\x97\x66\xa6\x21\x29\x85\x86\xbc\x04\x9e\x83\x2b\xfc\x2e\x81\x0b\x48\xe0\xd8\xa4\xef\x33\x77\xef\x9b\x26\x72\x4a\xe7\x78\x90\x86\x77\xec\xd9\xaa\xc4\x42\xd9\x71\xdc\x73\x0c\xac\x9c\xd6\x4e\xde\x49\xaa\xe1\x5d\x18\x7f\xbb\x72\x46\xd1\x76\x35\xa8\x6f\xee\xbf\x32\x06\x70\xe1\x97\xdc\xe6\x3b\x72\x7e\x8c\xfc\x24\xde\xd9\xa9\xc6\x43\x1b\x24\xf2\x9c\x90\x38\x9e\x9c\x5d\x26\xb3\xca\xa4\x64\x18\x00\xa0\xd3\xc0\x35\xb6\x7e\x4c\xca\xf1\xe1\x29\xc7\x28\x04\x51\x52\x27\x21\xd5\xf8\xe3\x4f\x63\x80\x32\xff\x1f\xb9\x17\xe5\xdd\x14\xec\xee\x1f\x5b\x3e\x0a\x35\xdf\x90\xa7\x60\x39\x5e\xed\x0a\x00\x83\x44\xad\x71\x77\xff\xf5\x9d\x31\x38\x0b\x33\x95\xae\xe9\xe8\x86\x0f\x9c\xba\x6d\xe4\x5c\x55\xe3\x40\x3e\xe5\x07\xad\xf0\x3c\xc6\x80\x87\xd0\xce\x94\x72\x82\x1c\xf2\x6c\x5b\x77\xe2\x00\xeb\x29\x25\xb8\xa0\x52\x24\xdc\x92\xf2\x27\x9a\x60\x25\x1c\x5c\x3b\xc6\xdc\x2d\xf3\x96\xcb\xb7\x65\xce\x9e\x97\x39\x70\xa0\xbd\xe7\xe6\x7c\x1d\x70\xc9\x9c\x1b\x2f\xb2\xaf\x0a\x0e\x44\x4e\x32\x46\xcb\x19\xf4\x0a\x45\xfb\xbf\x8e\x9c\xf4\x55\x04\xb0\xc3\x58\xe3\x76\xb3\xe9\x5f\xc5\x7a\xee\x65\x76\xc8\x17\x77\xf7\x1f\xdc\xf2\xac\xc7\x5d\xb6\xdf\x89\xa2\x93\x31\xe5\xc9\x71\x4c\xa5\x48\xfd\xa5\xa5\xe5\xa8\x0b\xe1\xf9\xff\xc8\xd3\x59\x59\xaf\x5c\x38\x8c\xde\xbf\xc8\xe1\xf0\x51\xf4\x29\x72\xe2\xa0\x8b\x45\x07\x49\x4e\xa5\x98\x74\x36\xa6\x1d\x4a\xbf\xc4\x36\xb2\x96\x29\xd9\xe0\x6a\x73\x85\x94\x8b\x1b\x66\x33\xfc\xdf\x8a\xbe\xba\x48\x96\xbd\x71\xde\x17\x9f\xe1\xf7\xb2\x3f\xca\xc9\xf9\x89\xde\xb9\x90\x94\xbc\xbf\xb1\xc1\x95\xc4\xb2\x32\x36\xeb\xcd\xfa\x36\xef\x8d\xed\x6b\x1d\x64\xba\x67\xed\xcc\x0c\xa0\x21\xa5\x61\x36\x3c\x92\x6b\xd8\x52\x34\x73\xe4\x65\x05\x7c\x86\xeb\xef\xec\x35\x43\xf5\x36\xe9\x99\xdb\x37\x2e\x77\xc1\x29\x86\x8e\x12\xc3\x85\x1c\x3e\x83\x40\x3e\x7b\x78\xeb\xf6\x39\xa1\x32\x66\x51\xea\xd5\x91\xf5\x9c\xba\xfa\x6c\x32\x03\x34\x91\x5c\x78\x76\xb3\x73\xbf\xdc\xa4\x7f\xb5\x27\xfe\x83\xa9\x6f\x37\x9b\xcd\xad\xf9\x6b\x00\x9d\xff\xc6\x8b\x72\x06\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -275,10 +331,18 @@ var HelmTemplates = func() http.FileSystem {
 		fs["/crds/circuit-breaker.yaml"].(os.FileInfo),
 		fs["/crds/data-planes.yaml"].(os.FileInfo),
 		fs["/crds/dataplane-insight.yaml"].(os.FileInfo),
+		fs["/crds/dependencies-insight.yaml"].(os.FileInfo),
+		fs["/crds/dependencies.yaml"].(os.FileInfo),
 		fs["/crds/fault-injections.yaml"].(os.FileInfo),
+		fs["/crds/gateway-listeners.yaml"].(os.FileInfo),
+		fs["/crds/gateway-routes.yaml"].(os.FileInfo),
 		fs["/crds/healthchecks.yaml"].(os.FileInfo),
+		fs["/crds/mesh-insight.yaml"].(os.FileInfo),
 		fs["/crds/meshes.yaml"].(os.FileInfo),
 		fs["/crds/proxy-templates.yaml"].(os.FileInfo),
+		fs["/crds/role.yaml"].(os.FileInfo),
+		fs["/crds/service-insight.yaml"].(os.FileInfo),
+		fs["/crds/sidecar-templates.yaml"].(os.FileInfo),
 		fs["/crds/traffic-logs.yaml"].(os.FileInfo),
 		fs["/crds/traffic-permissions.yaml"].(os.FileInfo),
 		fs["/crds/traffic-routes.yaml"].(os.FileInfo),
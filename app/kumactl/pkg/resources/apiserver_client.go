@@ -18,7 +18,7 @@ type ApiServerClient interface {
 }
 
 func NewAPIServerClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (ApiServerClient, error) {
-	client, err := apiServerClient(coordinates.Url)
+	client, err := apiServerClient(coordinates)
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
 	util_http "github.com/kumahq/kuma/pkg/util/http"
 )
 
@@ -16,14 +17,37 @@ const (
 	Timeout = 60 * time.Second
 )
 
-func apiServerClient(apiUrl string) (util_http.Client, error) {
-	baseURL, err := url.Parse(apiUrl)
+func apiServerClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (util_http.Client, error) {
+	return apiServerClientWithTimeout(coordinates, Timeout)
+}
+
+// apiServerClientWithTimeout creates a client to the API Server. Timeout equal to 0 means no timeout.
+func apiServerClientWithTimeout(coordinates *config_proto.ControlPlaneCoordinates_ApiServer, timeout time.Duration) (util_http.Client, error) {
+	baseURL, err := url.Parse(coordinates.Url)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to parse API Server URL")
 	}
 	client := &http.Client{
-		Timeout:   Timeout,
+		Timeout:   timeout,
 		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
 	}
-	return util_http.ClientWithBaseURL(client, baseURL), nil
+	if coordinates.ClientCertFile != "" {
+		if err := util_http.ConfigureTlsWithoutServerVerification(client, coordinates.ClientCertFile, coordinates.ClientKeyFile); err != nil {
+			return nil, errors.Wrap(err, "could not configure client certificate")
+		}
+	}
+	return util_http.ClientWithBaseURL(withCredentials(client, coordinates), baseURL), nil
+}
+
+// withCredentials adds User Token or username and password to every request to the API Server.
+func withCredentials(delegate util_http.Client, coordinates *config_proto.ControlPlaneCoordinates_ApiServer) util_http.Client {
+	return util_http.ClientFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case coordinates.AuthToken != "":
+			req.Header.Set("Authorization", "Bearer "+coordinates.AuthToken)
+		case coordinates.Username != "":
+			req.SetBasicAuth(coordinates.Username, coordinates.Password)
+		}
+		return delegate.Do(req)
+	})
 }
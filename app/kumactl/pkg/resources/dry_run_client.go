@@ -0,0 +1,84 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	kuma_rest "github.com/kumahq/kuma/pkg/api-server/definitions"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+	remote_resources "github.com/kumahq/kuma/pkg/plugins/resources/remote"
+	kuma_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type ResourceDryRunClient interface {
+	// DryRun sends a resource to the API Server which defaults and validates it without persisting.
+	// It returns the resource as it would be stored.
+	DryRun(ctx context.Context, resource *rest.Resource) (model.Resource, error)
+}
+
+func NewResourceDryRunClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (ResourceDryRunClient, error) {
+	client, err := apiServerClient(coordinates)
+	if err != nil {
+		return nil, err
+	}
+	return &httpResourceDryRunClient{
+		Client: client,
+		api:    kuma_rest.AllApis(),
+	}, nil
+}
+
+type httpResourceDryRunClient struct {
+	Client kuma_http.Client
+	api    rest.Api
+}
+
+func (d *httpResourceDryRunClient) DryRun(ctx context.Context, resource *rest.Resource) (model.Resource, error) {
+	resType := model.ResourceType(resource.Meta.Type)
+	resourceApi, err := d.api.GetResourceApi(resType)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to construct URI to dry run %q", resType)
+	}
+	body, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", resourceApi.Item(resource.Meta.Mesh, resource.Meta.Name)+"?dryRun=true", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	resp, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		kumaErr := types.Error{}
+		if err := json.Unmarshal(b, &kumaErr); err == nil && kumaErr.Title != "" && kumaErr.Details != "" {
+			return nil, &kumaErr
+		}
+		return nil, errors.Errorf("(%d): %s", resp.StatusCode, string(b))
+	}
+	res, err := registry.Global().NewObject(resType)
+	if err != nil {
+		return nil, err
+	}
+	if err := remote_resources.Unmarshal(b, res); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal a resource")
+	}
+	return res, nil
+}
@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	kuma_rest "github.com/kumahq/kuma/pkg/api-server/definitions"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+)
+
+var _ = Describe("httpResourceDryRunClient", func() {
+	Describe("DryRun()", func() {
+		It("should send the resource and parse the defaulted one", func() {
+			// given
+			client := httpResourceDryRunClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						Expect(req.Method).To(Equal("PUT"))
+						Expect(req.URL.String()).To(Equal("/meshes/demo?dryRun=true"))
+						body, err := ioutil.ReadAll(req.Body)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(body).To(MatchJSON(`{"type":"Mesh","name":"demo","creationTime":"0001-01-01T00:00:00Z","modificationTime":"0001-01-01T00:00:00Z","labels":{"team":"web"}}`))
+						return &http.Response{
+							StatusCode: http.StatusCreated,
+							Body:       ioutil.NopCloser(strings.NewReader(`{"type":"Mesh","name":"demo","labels":{"team":"web"},"mtls":{"enabledBackend":"ca-1"}}`)),
+						}, nil
+					}),
+				},
+				api: kuma_rest.AllApis(),
+			}
+
+			// when
+			res, err := client.DryRun(context.Background(), &rest.Resource{
+				Meta: rest.ResourceMeta{Type: string(mesh.MeshType), Name: "demo", Labels: map[string]string{"team": "web"}},
+				Spec: &mesh_proto.Mesh{},
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.GetMeta().GetName()).To(Equal("demo"))
+			Expect(res.GetMeta().GetLabels()).To(Equal(map[string]string{"team": "web"}))
+			Expect(res.(*mesh.MeshResource).Spec.GetMtls().GetEnabledBackend()).To(Equal("ca-1"))
+		})
+
+		It("should return the error from the API Server", func() {
+			// given
+			client := httpResourceDryRunClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: http.StatusBadRequest,
+							Body:       ioutil.NopCloser(strings.NewReader(`{"title":"Could not process a resource","details":"Resource is not valid"}`)),
+						}, nil
+					}),
+				},
+				api: kuma_rest.AllApis(),
+			}
+
+			// when
+			_, err := client.DryRun(context.Background(), &rest.Resource{
+				Meta: rest.ResourceMeta{Type: string(mesh.MeshType), Name: "demo"},
+				Spec: &mesh_proto.Mesh{},
+			})
+
+			// then
+			Expect(err).To(Equal(&types.Error{
+				Title:   "Could not process a resource",
+				Details: "Resource is not valid",
+			}))
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kuma_rest "github.com/kumahq/kuma/pkg/api-server/definitions"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+	remote_resources "github.com/kumahq/kuma/pkg/plugins/resources/remote"
+	kuma_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+// ResourceRevision is a state of a resource after a change recorded by the Control Plane.
+type ResourceRevision struct {
+	Revision  uint32
+	Operation string
+	User      string
+	Time      time.Time
+	Resource  model.Resource
+}
+
+type ResourceHistoryClient interface {
+	// History returns revisions of the resource from the oldest to the newest.
+	History(ctx context.Context, resType model.ResourceType, name string, mesh string) ([]ResourceRevision, error)
+}
+
+func NewResourceHistoryClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (ResourceHistoryClient, error) {
+	client, err := apiServerClient(coordinates)
+	if err != nil {
+		return nil, err
+	}
+	return &httpResourceHistoryClient{
+		Client: client,
+		api:    kuma_rest.AllApis(),
+	}, nil
+}
+
+type httpResourceHistoryClient struct {
+	Client kuma_http.Client
+	api    rest.Api
+}
+
+func (h *httpResourceHistoryClient) History(ctx context.Context, resType model.ResourceType, name string, mesh string) ([]ResourceRevision, error) {
+	resourceApi, err := h.api.GetResourceApi(resType)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to construct URI to fetch the history of %q", resType)
+	}
+	req, err := http.NewRequest("GET", resourceApi.Item(mesh, name)+"/_history", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := h.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		kumaErr := types.Error{}
+		if err := json.Unmarshal(b, &kumaErr); err == nil && kumaErr.Title != "" && kumaErr.Details != "" {
+			return nil, &kumaErr
+		}
+		return nil, errors.Errorf("(%d): %s", resp.StatusCode, string(b))
+	}
+	list := rest.RevisionListReceiver{}
+	if err := json.Unmarshal(b, &list); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal the history")
+	}
+	var revisions []ResourceRevision
+	for _, item := range list.Items {
+		res, err := registry.Global().NewObject(resType)
+		if err != nil {
+			return nil, err
+		}
+		if err := remote_resources.Unmarshal(item.Resource, res); err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal revision %d", item.Revision)
+		}
+		revisions = append(revisions, ResourceRevision{
+			Revision:  item.Revision,
+			Operation: item.Operation,
+			User:      item.User,
+			Time:      item.Time,
+			Resource:  res,
+		})
+	}
+	return revisions, nil
+}
@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	kuma_rest "github.com/kumahq/kuma/pkg/api-server/definitions"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+)
+
+var _ = Describe("httpResourceHistoryClient", func() {
+	Describe("History()", func() {
+		It("should fetch and parse revisions of the resource", func() {
+			// given
+			client := httpResourceHistoryClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						Expect(req.Method).To(Equal("GET"))
+						Expect(req.URL.String()).To(Equal("/meshes/demo/traffic-routes/route-1/_history"))
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body: ioutil.NopCloser(strings.NewReader(`
+							{
+								"items": [
+									{
+										"revision": 1,
+										"operation": "Create",
+										"user": "john",
+										"time": "2020-10-19T10:00:00Z",
+										"resource": {
+											"type": "TrafficRoute",
+											"mesh": "demo",
+											"name": "route-1",
+											"labels": {"team": "web"},
+											"conf": [{"weight": 100, "destination": {"kuma.io/service": "backend"}}]
+										}
+									}
+								]
+							}`)),
+						}, nil
+					}),
+				},
+				api: kuma_rest.AllApis(),
+			}
+
+			// when
+			revisions, err := client.History(context.Background(), mesh.TrafficRouteType, "route-1", "demo")
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revisions).To(HaveLen(1))
+			Expect(revisions[0].Revision).To(Equal(uint32(1)))
+			Expect(revisions[0].Operation).To(Equal("Create"))
+			Expect(revisions[0].User).To(Equal("john"))
+			Expect(revisions[0].Resource.GetMeta().GetLabels()).To(Equal(map[string]string{"team": "web"}))
+			route := revisions[0].Resource.(*mesh.TrafficRouteResource)
+			Expect(route.Spec.GetConf()[0].GetDestination()).To(Equal(map[string]string{"kuma.io/service": "backend"}))
+		})
+
+		It("should return the error from the API Server", func() {
+			// given
+			client := httpResourceHistoryClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: http.StatusForbidden,
+							Body:       ioutil.NopCloser(strings.NewReader(`{"title":"Could not retrieve the history of a resource","details":"Access Denied"}`)),
+						}, nil
+					}),
+				},
+				api: kuma_rest.AllApis(),
+			}
+
+			// when
+			_, err := client.History(context.Background(), mesh.TrafficRouteType, "route-1", "demo")
+
+			// then
+			Expect(err).To(Equal(&types.Error{
+				Title:   "Could not retrieve the history of a resource",
+				Details: "Access Denied",
+			}))
+		})
+	})
+})
@@ -0,0 +1,133 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/api-server/definitions"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+	kuma_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type InspectClient interface {
+	DataplanePolicies(ctx context.Context, meshName string, name string) (*api_server_types.DataplanePolicies, error)
+	PolicyDataplanes(ctx context.Context, meshName string, policyType model.ResourceType, name string) (*api_server_types.PolicyDataplanes, error)
+	DataplaneXds(ctx context.Context, meshName string, name string) (*api_server_types.DataplaneXds, error)
+	Simulate(ctx context.Context, meshName string, simulationReq api_server_types.SimulationRequest) (*api_server_types.Simulation, error)
+}
+
+func NewInspectClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (InspectClient, error) {
+	client, err := apiServerClient(coordinates)
+	if err != nil {
+		return nil, err
+	}
+	return &httpInspectClient{
+		Client: client,
+	}, nil
+}
+
+type httpInspectClient struct {
+	Client kuma_http.Client
+}
+
+func (d *httpInspectClient) DataplanePolicies(ctx context.Context, meshName string, name string) (*api_server_types.DataplanePolicies, error) {
+	policies := api_server_types.DataplanePolicies{}
+	if err := d.get(ctx, fmt.Sprintf("/meshes/%s/dataplanes/%s/policies", meshName, name), &policies); err != nil {
+		return nil, err
+	}
+	return &policies, nil
+}
+
+func (d *httpInspectClient) PolicyDataplanes(ctx context.Context, meshName string, policyType model.ResourceType, name string) (*api_server_types.PolicyDataplanes, error) {
+	path := ""
+	for _, def := range definitions.All {
+		if def.ResourceFactory().GetType() == policyType {
+			path = def.Path
+		}
+	}
+	if path == "" {
+		return nil, errors.Errorf("unknown policy type %q", policyType)
+	}
+	dataplanes := api_server_types.PolicyDataplanes{}
+	if err := d.get(ctx, fmt.Sprintf("/meshes/%s/%s/%s/dataplanes", meshName, path, name), &dataplanes); err != nil {
+		return nil, err
+	}
+	return &dataplanes, nil
+}
+
+func (d *httpInspectClient) DataplaneXds(ctx context.Context, meshName string, name string) (*api_server_types.DataplaneXds, error) {
+	xds := api_server_types.DataplaneXds{}
+	if err := d.get(ctx, fmt.Sprintf("/meshes/%s/dataplanes/%s/xds", meshName, name), &xds); err != nil {
+		return nil, err
+	}
+	return &xds, nil
+}
+
+func (d *httpInspectClient) Simulate(ctx context.Context, meshName string, simulationReq api_server_types.SimulationRequest) (*api_server_types.Simulation, error) {
+	body, err := json.Marshal(simulationReq)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", fmt.Sprintf("/meshes/%s/simulate", meshName), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	statusCode, b, err := d.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	simulation := api_server_types.Simulation{}
+	if err := json.Unmarshal(b, &simulation); err != nil {
+		return nil, err
+	}
+	return &simulation, nil
+}
+
+func (d *httpInspectClient) get(ctx context.Context, path string, result interface{}) error {
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return err
+	}
+	statusCode, b, err := d.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+	if statusCode != 200 {
+		return errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	return json.Unmarshal(b, result)
+}
+
+func (d *httpInspectClient) doRequest(ctx context.Context, req *http.Request) (int, []byte, error) {
+	resp, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, err
+	}
+	if resp.StatusCode/100 >= 4 {
+		kumaErr := types.Error{}
+		if err := json.Unmarshal(b, &kumaErr); err == nil {
+			if kumaErr.Title != "" && kumaErr.Details != "" {
+				return resp.StatusCode, b, &kumaErr
+			}
+		}
+	}
+	return resp.StatusCode, b, nil
+}
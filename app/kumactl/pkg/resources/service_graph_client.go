@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+	kuma_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type ServiceGraphClient interface {
+	Get(ctx context.Context, meshName string) (*api_server_types.ServiceGraph, error)
+}
+
+func NewServiceGraphClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (ServiceGraphClient, error) {
+	client, err := apiServerClient(coordinates)
+	if err != nil {
+		return nil, err
+	}
+	return &httpServiceGraphClient{
+		Client: client,
+	}, nil
+}
+
+type httpServiceGraphClient struct {
+	Client kuma_http.Client
+}
+
+func (d *httpServiceGraphClient) Get(ctx context.Context, meshName string) (*api_server_types.ServiceGraph, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("/meshes/%s/service-graph", meshName), nil)
+	if err != nil {
+		return nil, err
+	}
+	statusCode, b, err := d.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	graph := api_server_types.ServiceGraph{}
+	if err := json.Unmarshal(b, &graph); err != nil {
+		return nil, err
+	}
+	return &graph, nil
+}
+
+func (d *httpServiceGraphClient) doRequest(ctx context.Context, req *http.Request) (int, []byte, error) {
+	resp, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, err
+	}
+	if resp.StatusCode/100 >= 4 {
+		kumaErr := types.Error{}
+		if err := json.Unmarshal(b, &kumaErr); err == nil {
+			if kumaErr.Title != "" && kumaErr.Details != "" {
+				return resp.StatusCode, b, &kumaErr
+			}
+		}
+	}
+	return resp.StatusCode, b, nil
+}
@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	kuma_rest "github.com/kumahq/kuma/pkg/api-server/definitions"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+	remote_resources "github.com/kumahq/kuma/pkg/plugins/resources/remote"
+	kuma_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+// WatchHandler is called for every event of a watch. Returning an error stops the watch.
+type WatchHandler func(eventType rest.WatchEventType, resource model.Resource) error
+
+type ResourceWatchClient interface {
+	// Watch streams changes of resources of given type in a mesh. If name is not empty, only a single resource is watched.
+	// It blocks until the context is cancelled, the handler returns an error or the server closes the stream.
+	Watch(ctx context.Context, resType model.ResourceType, mesh string, name string, handler WatchHandler) error
+}
+
+func NewResourceWatchClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (ResourceWatchClient, error) {
+	// watch is a long running request, therefore it cannot be limited by the default timeout
+	client, err := apiServerClientWithTimeout(coordinates, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &httpResourceWatchClient{
+		Client: client,
+		api:    kuma_rest.AllApis(),
+	}, nil
+}
+
+type httpResourceWatchClient struct {
+	Client kuma_http.Client
+	api    rest.Api
+}
+
+func (w *httpResourceWatchClient) Watch(ctx context.Context, resType model.ResourceType, mesh string, name string, handler WatchHandler) error {
+	resourceApi, err := w.api.GetResourceApi(resType)
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct URI to watch %q", resType)
+	}
+	path := resourceApi.List(mesh)
+	if name != "" {
+		path = resourceApi.Item(mesh, name)
+	}
+	req, err := http.NewRequest("GET", path+"?watch=true", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := w.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		kumaErr := types.Error{}
+		if err := json.Unmarshal(b, &kumaErr); err == nil && kumaErr.Title != "" && kumaErr.Details != "" {
+			return &kumaErr
+		}
+		return errors.Errorf("(%d): %s", resp.StatusCode, string(b))
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		event := rest.WatchEventReceiver{}
+		if err := decoder.Decode(&event); err != nil {
+			if err == io.EOF || ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrap(err, "could not decode an event")
+		}
+		res, err := registry.Global().NewObject(resType)
+		if err != nil {
+			return err
+		}
+		if err := remote_resources.Unmarshal(event.Object, res); err != nil {
+			return errors.Wrap(err, "could not unmarshal a resource")
+		}
+		if err := handler(event.Type, res); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package resources
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	kuma_rest "github.com/kumahq/kuma/pkg/api-server/definitions"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+)
+
+var _ = Describe("httpResourceWatchClient", func() {
+	Describe("Watch()", func() {
+		It("should create url and parse streamed events", func() {
+			// given
+			events := `{"type":"ADDED","object":{"type":"TrafficRoute","mesh":"default","name":"route-1","conf":[{"weight":100,"destination":{"kuma.io/service":"backend"}}]}}
+{"type":"DELETED","object":{"type":"TrafficRoute","mesh":"default","name":"route-2"}}
+`
+			client := httpResourceWatchClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						Expect(req.URL.String()).To(Equal("/meshes/default/traffic-routes?watch=true"))
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       ioutil.NopCloser(strings.NewReader(events)),
+						}, nil
+					}),
+				},
+				api: kuma_rest.AllApis(),
+			}
+
+			// when
+			var received []string
+			var first *mesh.TrafficRouteResource
+			err := client.Watch(context.Background(), mesh.TrafficRouteType, "default", "", func(eventType rest.WatchEventType, resource model.Resource) error {
+				if first == nil {
+					first = resource.(*mesh.TrafficRouteResource)
+				}
+				received = append(received, string(eventType)+" "+resource.GetMeta().GetName())
+				return nil
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(received).To(Equal([]string{"ADDED route-1", "DELETED route-2"}))
+			Expect(first.Spec.Conf).To(HaveLen(1))
+			Expect(first.Spec.Conf[0].Destination).To(Equal(map[string]string{mesh_proto.ServiceTag: "backend"}))
+		})
+
+		It("should watch a single resource", func() {
+			// given
+			client := httpResourceWatchClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						Expect(req.URL.String()).To(Equal("/meshes/demo?watch=true"))
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       ioutil.NopCloser(strings.NewReader("")),
+						}, nil
+					}),
+				},
+				api: kuma_rest.AllApis(),
+			}
+
+			// when
+			err := client.Watch(context.Background(), mesh.MeshType, "default", "demo", func(rest.WatchEventType, model.Resource) error {
+				return nil
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should return an error from the server", func() {
+			// given
+			client := httpResourceWatchClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: http.StatusForbidden,
+							Body:       ioutil.NopCloser(strings.NewReader(`{"title":"Access Denied","details":"user \"john\" cannot list \"TrafficRoute\""}`)),
+						}, nil
+					}),
+				},
+				api: kuma_rest.AllApis(),
+			}
+
+			// when
+			err := client.Watch(context.Background(), mesh.TrafficRouteType, "default", "", func(rest.WatchEventType, model.Resource) error {
+				return nil
+			})
+
+			// then
+			Expect(err).To(MatchError(`Access Denied (user "john" cannot list "TrafficRoute")`))
+		})
+	})
+})
@@ -20,6 +20,16 @@ const (
 )
 
 func NewDataplaneTokenClient(address string, config *kumactl_config.Context_AdminApiCredentials) (DataplaneTokenClient, error) {
+	client, err := adminServerClient(address, config)
+	if err != nil {
+		return nil, err
+	}
+	return &httpDataplaneTokenClient{
+		client: client,
+	}, nil
+}
+
+func adminServerClient(address string, config *kumactl_config.Context_AdminApiCredentials) (util_http.Client, error) {
 	baseURL, err := url.Parse(address)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to parse Dataplane Token Server URL")
@@ -36,10 +46,7 @@ func NewDataplaneTokenClient(address string, config *kumactl_config.Context_Admi
 			return nil, errors.Wrap(err, "could not configure tls for dataplane token client")
 		}
 	}
-	client := util_http.ClientWithBaseURL(httpClient, baseURL)
-	return &httpDataplaneTokenClient{
-		client: client,
-	}, nil
+	return util_http.ClientWithBaseURL(httpClient, baseURL), nil
 }
 
 type DataplaneTokenClient interface {
@@ -62,12 +69,16 @@ func (h *httpDataplaneTokenClient) Generate(name string, mesh string, tags map[s
 	if err != nil {
 		return "", errors.Wrap(err, "could not marshal token request to json")
 	}
-	req, err := http.NewRequest("POST", "/tokens", bytes.NewReader(reqBytes))
+	return requestToken(h.client, "/tokens", reqBytes)
+}
+
+func requestToken(client util_http.Client, path string, reqBytes []byte) (string, error) {
+	req, err := http.NewRequest("POST", path, bytes.NewReader(reqBytes))
 	if err != nil {
 		return "", errors.Wrap(err, "could not construct the request")
 	}
 	req.Header.Set("content-type", "application/json")
-	resp, err := h.client.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		return "", errors.Wrap(err, "could not execute the request")
 	}
@@ -0,0 +1,45 @@
+package tokens
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kumactl_config "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+func NewUserTokenClient(address string, config *kumactl_config.Context_AdminApiCredentials) (UserTokenClient, error) {
+	client, err := adminServerClient(address, config)
+	if err != nil {
+		return nil, err
+	}
+	return &httpUserTokenClient{
+		client: client,
+	}, nil
+}
+
+type UserTokenClient interface {
+	Generate(name string, groups []string, validFor time.Duration) (string, error)
+}
+
+type httpUserTokenClient struct {
+	client util_http.Client
+}
+
+var _ UserTokenClient = &httpUserTokenClient{}
+
+func (h *httpUserTokenClient) Generate(name string, groups []string, validFor time.Duration) (string, error) {
+	tokenReq := &types.UserTokenRequest{
+		Name:     name,
+		Groups:   groups,
+		ValidFor: validFor.String(),
+	}
+	reqBytes, err := json.Marshal(tokenReq)
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal token request to json")
+	}
+	return requestToken(h.client, "/user-tokens", reqBytes)
+}
@@ -0,0 +1,33 @@
+package admin_server
+
+import (
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+// backupWs returns a webservice that streams a backup of the Resource Store. Only admins can take backups,
+// because the backup contains all resources including secrets.
+func backupWs(backuper core_store.Backuper) *restful.WebService {
+	ws := new(restful.WebService).
+		Produces("application/octet-stream")
+	ws.Path("/store/backup").
+		Route(ws.GET("").To(func(request *restful.Request, response *restful.Response) {
+			caller, _ := user.FromContext(request.Request.Context())
+			if !caller.IsAdmin() {
+				rest_errors.HandleError(response, &rbac.AccessDeniedError{User: caller.Name, Verb: "get", ResourceType: "Backup"}, "Could not take a backup")
+				return
+			}
+			response.Header().Set("Content-Type", "application/octet-stream")
+			response.Header().Set("Content-Disposition", `attachment; filename="kuma.db"`)
+			if err := backuper.Backup(response); err != nil {
+				log.Error(err, "could not take a backup")
+			}
+		}).
+			Doc("Backup of the Resource Store").
+			Returns(200, "OK", nil))
+	return ws
+}
@@ -10,12 +10,14 @@ import (
 
 	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/rbac"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_system "github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_rest "github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
 	core_validators "github.com/kumahq/kuma/pkg/core/validators"
 )
 
@@ -23,6 +25,31 @@ import (
 // It's mostly copy paste, but it will be gone once we merge Admin Server with API Server
 type secretsEndpoints struct {
 	secretManager manager.ResourceManager
+	authorizer    rbac.Authorizer
+}
+
+func (s *secretsEndpoints) authorize(request *restful.Request, verb string) error {
+	u, _ := user.FromContext(request.Request.Context())
+	return s.authorizer.Authorize(request.Request.Context(), u, verb, core_system.SecretType, request.PathParameter("mesh"))
+}
+
+func (s *secretsEndpoints) authorizeSecret(request *restful.Request, verb string) error {
+	u, _ := user.FromContext(request.Request.Context())
+	key := core_model.ResourceKey{Mesh: request.PathParameter("mesh"), Name: request.PathParameter("name")}
+	return s.authorizer.AuthorizeResource(request.Request.Context(), u, verb, core_system.SecretType, key)
+}
+
+// accessible filters out Secrets that the user cannot access even though they can list Secrets of the mesh.
+func (s *secretsEndpoints) accessible(request *restful.Request, list *core_system.SecretResourceList) {
+	u, _ := user.FromContext(request.Request.Context())
+	var items []*core_system.SecretResource
+	for _, item := range list.Items {
+		key := core_model.MetaToResourceKey(item.GetMeta())
+		if err := s.authorizer.AuthorizeResource(request.Request.Context(), u, core_system.VerbList, core_system.SecretType, key); err == nil {
+			items = append(items, item)
+		}
+	}
+	list.Items = items
 }
 
 func (s *secretsEndpoints) addFindEndpoint(ws *restful.WebService) {
@@ -59,6 +86,11 @@ func (s *secretsEndpoints) findSecret(request *restful.Request, response *restfu
 	name := request.PathParameter("name")
 	meshName := request.PathParameter("mesh")
 
+	if err := s.authorizeSecret(request, core_system.VerbGet); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a resource")
+		return
+	}
+
 	secret := core_system.SecretResource{}
 
 	err := s.secretManager.Get(request.Request.Context(), &secret, core_store.GetByKey(name, meshName))
@@ -75,10 +107,16 @@ func (s *secretsEndpoints) findSecret(request *restful.Request, response *restfu
 func (s *secretsEndpoints) listSecrets(request *restful.Request, response *restful.Response) {
 	meshName := request.PathParameter("mesh")
 
+	if err := s.authorize(request, core_system.VerbList); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve resources")
+		return
+	}
+
 	list := core_system.SecretResourceList{}
 	if err := s.secretManager.List(request.Request.Context(), &list, core_store.ListByMesh(meshName)); err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve resources")
 	} else {
+		s.accessible(request, &list)
 		restList := core_rest.From.ResourceList(&list)
 		if err := response.WriteAsJson(restList); err != nil {
 			rest_errors.HandleError(response, err, "Could not list resources")
@@ -113,11 +151,19 @@ func (s *secretsEndpoints) createOrUpdateSecret(request *restful.Request, respon
 	secret := &core_system.SecretResource{}
 	if err := s.secretManager.Get(request.Request.Context(), secret, core_store.GetByKey(name, meshName)); err != nil {
 		if core_store.IsResourceNotFound(err) {
+			if err := s.authorizeSecret(request, core_system.VerbCreate); err != nil {
+				rest_errors.HandleError(response, err, "Could not create a resource")
+				return
+			}
 			s.createResource(request.Request.Context(), name, meshName, resourceRes.Spec, response)
 		} else {
 			rest_errors.HandleError(response, err, "Could not find a resource")
 		}
 	} else {
+		if err := s.authorizeSecret(request, core_system.VerbUpdate); err != nil {
+			rest_errors.HandleError(response, err, "Could not update a resource")
+			return
+		}
 		s.updateResource(request.Request.Context(), secret, resourceRes, response)
 	}
 }
@@ -145,6 +191,11 @@ func (s *secretsEndpoints) deleteResource(request *restful.Request, response *re
 	name := request.PathParameter("name")
 	meshName := request.PathParameter("mesh")
 
+	if err := s.authorizeSecret(request, core_system.VerbDelete); err != nil {
+		rest_errors.HandleError(response, err, "Could not delete a resource")
+		return
+	}
+
 	if err := s.secretManager.Delete(request.Request.Context(), &core_system.SecretResource{}, core_store.DeleteByKey(name, meshName)); err != nil {
 		rest_errors.HandleError(response, err, "Could not delete a resource")
 	}
@@ -16,12 +16,17 @@ import (
 	"github.com/slok/go-http-metrics/middleware"
 	"go.uber.org/multierr"
 
+	"github.com/kumahq/kuma/pkg/api-server/authn"
 	admin_server "github.com/kumahq/kuma/pkg/config/admin-server"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/tokens/builtin"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 	tokens_server "github.com/kumahq/kuma/pkg/tokens/builtin/server"
 	util_prometheus "github.com/kumahq/kuma/pkg/util/prometheus"
 )
@@ -53,6 +58,7 @@ func NewAdminServer(cfg admin_server.AdminServerConfig, metrics metrics.Metrics,
 		}),
 	})
 	container.Filter(util_prometheus.MetricsHandler("", promMiddleware))
+	container.Filter(audit.SourceFilter(audit.OriginAdminServer))
 
 	return &AdminServer{
 		cfg:       cfg,
@@ -174,10 +180,25 @@ func requireClientCerts(certsDir string) (*tls.Config, error) {
 func SetupServer(rt runtime.Runtime) error {
 	var webservices []*restful.WebService
 
+	authorizer := rbac.AllowAll
+	var authenticator authn.Authenticator
+	if rt.Config().ApiServer.Auth.Enabled {
+		a, err := authn.NewAuthenticator(rt.Config().ApiServer.Auth, builtin.NewUserTokenIssuer(rt))
+		if err != nil {
+			return errors.Wrap(err, "could not create an authenticator")
+		}
+		authenticator = a
+		authorizer = rbac.NewRoleAuthorizer(rt.ReadOnlyResourceManager(), issuer.SigningKeyResourceKeys...)
+	}
+
 	ws := new(restful.WebService).
 		Consumes(restful.MIME_JSON).
-		Produces(restful.MIME_JSON)
-	endpoints := secretsEndpoints{rt.ResourceManager()}
+		Produces(restful.MIME_JSON).
+		Filter(authenticate(authenticator))
+	endpoints := secretsEndpoints{
+		secretManager: rt.ResourceManager(),
+		authorizer:    authorizer,
+	}
 	endpoints.addFindEndpoint(ws)
 	endpoints.addListEndpoint(ws)
 	if !rt.Config().ApiServer.ReadOnly {
@@ -186,6 +207,12 @@ func SetupServer(rt runtime.Runtime) error {
 	}
 	webservices = append(webservices, ws)
 
+	webservices = append(webservices, tokens_server.NewUserTokenWebservice(builtin.NewUserTokenIssuer(rt)).Filter(authenticate(authenticator)))
+
+	if backuper, ok := core_store.FromBackuperContext(rt.Extensions()); ok {
+		webservices = append(webservices, backupWs(backuper).Filter(authenticate(authenticator)))
+	}
+
 	ws, err := dataplaneTokenWs(rt)
 	if err != nil {
 		return err
@@ -198,6 +225,25 @@ func SetupServer(rt runtime.Runtime) error {
 	return rt.Add(srv)
 }
 
+// authenticate puts the user that made the request into the context of the request.
+// Requests to the local server are made by the admin, because the server is available only on localhost.
+// Requests to the public server are made by the admin if authentication of the API Server is disabled,
+// because the client certificate was already verified by the TLS server.
+func authenticate(authenticator authn.Authenticator) restful.FilterFunction {
+	if authenticator == nil {
+		return authn.AdminFilter()
+	}
+	publicFilter := authn.Filter(authenticator)
+	localFilter := authn.AdminFilter()
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		if request.Request.TLS == nil {
+			localFilter(request, response, chain)
+		} else {
+			publicFilter(request, response, chain)
+		}
+	}
+}
+
 func dataplaneTokenWs(rt runtime.Runtime) (*restful.WebService, error) {
 	if !rt.Config().AdminServer.Apis.DataplaneToken.Enabled {
 		log.Info("Dataplane Token Webservice is disabled. Dataplane Tokens won't be verified.")
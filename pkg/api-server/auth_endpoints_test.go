@@ -0,0 +1,160 @@
+package api_server_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Authentication and authorization", func() {
+	var apiServer *api_server.ApiServer
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resourceStore := memory.NewStore()
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		cfg := config.DefaultApiServerConfig()
+		cfg.Auth.Enabled = true
+		cfg.Auth.HtpasswdFile = filepath.Join("testdata", "htpasswd")
+		apiServer = createTestApiServer(resourceStore, cfg, true, metrics)
+
+		err = resourceStore.Create(context.Background(), &mesh_core.MeshResource{}, store.CreateByKey("demo", "demo"))
+		Expect(err).ToNot(HaveOccurred())
+		err = resourceStore.Create(context.Background(), &mesh_core.MeshResource{}, store.CreateByKey("other", "other"))
+		Expect(err).ToNot(HaveOccurred())
+		role := &system.RoleResource{
+			Spec: system_proto.Role{
+				Users: []string{"john"},
+				Rules: []*system_proto.Role_Rule{
+					{
+						Meshes: []string{"demo"},
+						Types:  []string{string(mesh_core.TrafficRouteType)},
+						Verbs:  []string{system.VerbGet, system.VerbList},
+					},
+				},
+			},
+		}
+		err = resourceStore.Create(context.Background(), role, store.CreateByKey("demo-viewer", "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/", // index does not require credentials
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	type testCase struct {
+		method       string
+		path         string
+		username     string
+		password     string
+		body         string
+		expectedCode int
+	}
+
+	DescribeTable("should authenticate and authorize requests",
+		func(given testCase) {
+			// given
+			req, err := http.NewRequest(given.method, fmt.Sprintf("http://%s%s", apiServer.Address(), given.path), strings.NewReader(given.body))
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("content-type", "application/json")
+			if given.username != "" {
+				req.SetBasicAuth(given.username, given.password)
+			}
+
+			// when
+			resp, err := http.DefaultClient.Do(req)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(given.expectedCode))
+		},
+		Entry("index without credentials", testCase{
+			method:       "GET",
+			path:         "/",
+			expectedCode: 200,
+		}),
+		Entry("resources without credentials", testCase{
+			method:       "GET",
+			path:         "/meshes/demo/traffic-routes",
+			expectedCode: 401,
+		}),
+		Entry("resources with invalid password", testCase{
+			method:       "GET",
+			path:         "/meshes/demo/traffic-routes",
+			username:     "john",
+			password:     "wrong",
+			expectedCode: 401,
+		}),
+		Entry("resources allowed by the role", testCase{
+			method:       "GET",
+			path:         "/meshes/demo/traffic-routes",
+			username:     "john",
+			password:     "secret",
+			expectedCode: 200,
+		}),
+		Entry("resources in other mesh", testCase{
+			method:       "GET",
+			path:         "/meshes/other/traffic-routes",
+			username:     "john",
+			password:     "secret",
+			expectedCode: 403,
+		}),
+		Entry("resources in all meshes", testCase{
+			method:       "GET",
+			path:         "/traffic-routes",
+			username:     "john",
+			password:     "secret",
+			expectedCode: 403,
+		}),
+		Entry("resources of other type", testCase{
+			method:       "GET",
+			path:         "/meshes/demo/traffic-permissions",
+			username:     "john",
+			password:     "secret",
+			expectedCode: 403,
+		}),
+		Entry("modification of resources not allowed by the role", testCase{
+			method:       "PUT",
+			path:         "/meshes/demo/traffic-routes/route-1",
+			username:     "john",
+			password:     "secret",
+			body:         `{"type": "TrafficRoute", "mesh": "demo", "name": "route-1", "sources": [{"match": {"kuma.io/service": "*"}}], "destinations": [{"match": {"kuma.io/service": "*"}}], "conf": [{"weight": 100, "destination": {"kuma.io/service": "backend"}}]}`,
+			expectedCode: 403,
+		}),
+		Entry("roles by user without access to them", testCase{
+			method:       "GET",
+			path:         "/roles",
+			username:     "john",
+			password:     "secret",
+			expectedCode: 403,
+		}),
+	)
+})
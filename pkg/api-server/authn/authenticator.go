@@ -0,0 +1,98 @@
+package authn
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+// Authenticator retrieves the user from the request.
+// It returns nil user and nil error if the request does not carry credentials supported by the Authenticator.
+type Authenticator interface {
+	Authenticate(request *http.Request) (*user.User, error)
+}
+
+// Chain returns an Authenticator that uses the first Authenticator which recognizes credentials of the request.
+func Chain(authenticators ...Authenticator) Authenticator {
+	return chain(authenticators)
+}
+
+type chain []Authenticator
+
+func (c chain) Authenticate(request *http.Request) (*user.User, error) {
+	for _, authenticator := range c {
+		u, err := authenticator.Authenticate(request)
+		if err != nil || u != nil {
+			return u, err
+		}
+	}
+	return nil, nil
+}
+
+// NewClientCertAuthenticator authenticates users with a client certificate verified by the TLS server.
+// Common Name of the certificate is a name of the user and Organizations are groups of the user.
+func NewClientCertAuthenticator() Authenticator {
+	return &clientCertAuthenticator{}
+}
+
+type clientCertAuthenticator struct{}
+
+func (c *clientCertAuthenticator) Authenticate(request *http.Request) (*user.User, error) {
+	if request.TLS == nil || len(request.TLS.VerifiedChains) == 0 || len(request.TLS.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+	cert := request.TLS.VerifiedChains[0][0]
+	if cert.Subject.CommonName == "" {
+		return nil, errors.New("client certificate does not have a Common Name")
+	}
+	return &user.User{
+		Name:   cert.Subject.CommonName,
+		Groups: cert.Subject.Organization,
+	}, nil
+}
+
+// NewUserTokenAuthenticator authenticates users with a User Token passed as a Bearer token.
+func NewUserTokenAuthenticator(tokenIssuer issuer.UserTokenIssuer) Authenticator {
+	return &userTokenAuthenticator{
+		issuer: tokenIssuer,
+	}
+}
+
+type userTokenAuthenticator struct {
+	issuer issuer.UserTokenIssuer
+}
+
+const bearerPrefix = "Bearer "
+
+func (u *userTokenAuthenticator) Authenticate(request *http.Request) (*user.User, error) {
+	header := request.Header.Get("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return nil, nil
+	}
+	usr, err := u.issuer.Validate(strings.TrimPrefix(header, bearerPrefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid User Token")
+	}
+	return &usr, nil
+}
+
+// NewAuthenticator builds an Authenticator of all methods enabled in the configuration.
+func NewAuthenticator(cfg *api_server.ApiServerAuthConfig, tokenIssuer issuer.UserTokenIssuer) (Authenticator, error) {
+	authenticators := []Authenticator{
+		NewClientCertAuthenticator(),
+		NewUserTokenAuthenticator(tokenIssuer),
+	}
+	if cfg.HtpasswdFile != "" {
+		htpasswd, err := NewHtpasswdAuthenticator(cfg.HtpasswdFile)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, htpasswd)
+	}
+	return Chain(authenticators...), nil
+}
@@ -0,0 +1,175 @@
+package authn_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/kumahq/kuma/pkg/api-server/authn"
+	config_api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+var _ = Describe("Authenticator", func() {
+
+	var authenticator authn.Authenticator
+	var tokenIssuer issuer.UserTokenIssuer
+	var dir string
+
+	BeforeEach(func() {
+		d, err := ioutil.TempDir("", "authn")
+		Expect(err).ToNot(HaveOccurred())
+		dir = d
+
+		hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+		Expect(err).ToNot(HaveOccurred())
+		htpasswd := "# users\n" +
+			"john:" + string(hash) + "\n" +
+			"jane:{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ=\n" // "secret"
+		htpasswdFile := filepath.Join(dir, "htpasswd")
+		Expect(ioutil.WriteFile(htpasswdFile, []byte(htpasswd), 0600)).To(Succeed())
+
+		tokenIssuer = issuer.NewUserTokenIssuer(func() ([]byte, error) {
+			return []byte("signing-key"), nil
+		})
+		authenticator, err = authn.NewAuthenticator(&config_api_server.ApiServerAuthConfig{
+			Enabled:      true,
+			HtpasswdFile: htpasswdFile,
+		}, tokenIssuer)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest("GET", "http://localhost:5681/meshes", nil)
+		Expect(err).ToNot(HaveOccurred())
+		return req
+	}
+
+	It("should authenticate with client certificate", func() {
+		// given
+		req := newRequest()
+		req.TLS = &tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{
+				{
+					{Subject: pkix.Name{CommonName: "john", Organization: []string{"operators"}}},
+				},
+			},
+		}
+
+		// when
+		u, err := authenticator.Authenticate(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*u).To(Equal(user.User{Name: "john", Groups: []string{"operators"}}))
+	})
+
+	It("should authenticate with user token", func() {
+		// given
+		token, err := tokenIssuer.Generate(user.User{Name: "john", Groups: []string{"operators"}}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		req := newRequest()
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		// when
+		u, err := authenticator.Authenticate(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*u).To(Equal(user.User{Name: "john", Groups: []string{"operators"}}))
+	})
+
+	It("should not authenticate with expired user token", func() {
+		// given
+		core.Now = func() time.Time {
+			return time.Now().Add(-2 * time.Hour)
+		}
+		defer func() {
+			core.Now = time.Now
+		}()
+		token, err := tokenIssuer.Generate(user.User{Name: "john", Groups: []string{"operators"}}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		req := newRequest()
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		// when
+		_, err = authenticator.Authenticate(req)
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not authenticate with invalid user token", func() {
+		// given
+		req := newRequest()
+		req.Header.Set("Authorization", "Bearer invalid")
+
+		// when
+		_, err := authenticator.Authenticate(req)
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should authenticate with bcrypt password", func() {
+		// given
+		req := newRequest()
+		req.SetBasicAuth("john", "secret")
+
+		// when
+		u, err := authenticator.Authenticate(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u.Name).To(Equal("john"))
+	})
+
+	It("should authenticate with SHA1 password", func() {
+		// given
+		req := newRequest()
+		req.SetBasicAuth("jane", "secret")
+
+		// when
+		u, err := authenticator.Authenticate(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u.Name).To(Equal("jane"))
+	})
+
+	It("should not authenticate with invalid password", func() {
+		// given
+		req := newRequest()
+		req.SetBasicAuth("john", "wrong")
+
+		// when
+		_, err := authenticator.Authenticate(req)
+
+		// then
+		Expect(err).To(MatchError("invalid username or password"))
+	})
+
+	It("should return no user when there are no credentials", func() {
+		// when
+		u, err := authenticator.Authenticate(newRequest())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u).To(BeNil())
+	})
+})
@@ -0,0 +1,30 @@
+package authn
+
+import (
+	"crypto/x509"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// LoadClientCAs loads all the *.pem certificates from the directory to the pool used for verifying client certificates.
+func LoadClientCAs(dir string) (*x509.CertPool, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read directory %s", dir)
+	}
+	pool := x509.NewCertPool()
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".pem" {
+			continue
+		}
+		path := filepath.Join(dir, file.Name())
+		pem, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read certificate %s", path)
+		}
+		pool.AppendCertsFromPEM(pem)
+	}
+	return pool, nil
+}
@@ -0,0 +1,40 @@
+package authn
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+// Filter authenticates the request and puts the user into the context of the request.
+// Requests to the excluded paths and CORS preflight requests do not have to be authenticated.
+func Filter(authenticator Authenticator, excludedPaths ...string) restful.FilterFunction {
+	excluded := map[string]bool{}
+	for _, path := range excludedPaths {
+		excluded[path] = true
+	}
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		if request.Request.Method == http.MethodOptions || excluded[request.Request.URL.Path] {
+			chain.ProcessFilter(request, response)
+			return
+		}
+		u, err := authenticator.Authenticate(request.Request)
+		if err != nil || u == nil {
+			rest_errors.HandleUnauthenticated("Could not authenticate a user", err, response)
+			return
+		}
+		request.Request = request.Request.WithContext(user.NewContext(request.Request.Context(), *u))
+		chain.ProcessFilter(request, response)
+	}
+}
+
+// AdminFilter treats every request as made by the admin. It is used when authentication is disabled.
+func AdminFilter() restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		request.Request = request.Request.WithContext(user.NewContext(request.Request.Context(), user.Admin))
+		chain.ProcessFilter(request, response)
+	}
+}
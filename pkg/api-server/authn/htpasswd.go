@@ -0,0 +1,78 @@
+package authn
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+const shaPrefix = "{SHA}"
+
+// NewHtpasswdAuthenticator authenticates users with Basic authentication against the htpasswd file.
+// Only bcrypt and SHA1 passwords are supported.
+func NewHtpasswdAuthenticator(file string) (Authenticator, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open htpasswd file")
+	}
+	defer f.Close()
+
+	passwords := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid htpasswd entry %q", parts[0])
+		}
+		if !strings.HasPrefix(parts[1], shaPrefix) && !strings.HasPrefix(parts[1], "$2") {
+			return nil, errors.Errorf("unsupported password format of user %q. Only bcrypt and SHA1 are supported", parts[0])
+		}
+		passwords[parts[0]] = parts[1]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "could not read htpasswd file")
+	}
+	return &htpasswdAuthenticator{
+		passwords: passwords,
+	}, nil
+}
+
+type htpasswdAuthenticator struct {
+	passwords map[string]string
+}
+
+func (h *htpasswdAuthenticator) Authenticate(request *http.Request) (*user.User, error) {
+	username, password, ok := request.BasicAuth()
+	if !ok {
+		return nil, nil
+	}
+	hash, found := h.passwords[username]
+	if !found || !matchesPassword(hash, password) {
+		return nil, errors.New("invalid username or password")
+	}
+	return &user.User{
+		Name: username,
+	}, nil
+}
+
+func matchesPassword(hash string, password string) bool {
+	if strings.HasPrefix(hash, shaPrefix) {
+		sum := sha1.Sum([]byte(password))
+		expected := shaPrefix + base64.StdEncoding.EncodeToString(sum[:])
+		return subtle.ConstantTimeCompare([]byte(hash), []byte(expected)) == 1
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
@@ -0,0 +1,15 @@
+package api_server
+
+import (
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+// authorize checks whether the user that made the request can execute the verb on resources of a given type in a given mesh.
+func authorize(authorizer rbac.Authorizer, request *restful.Request, verb string, resourceType model.ResourceType, mesh string) error {
+	u, _ := user.FromContext(request.Request.Context())
+	return authorizer.Authorize(request.Request.Context(), u, verb, resourceType, mesh)
+}
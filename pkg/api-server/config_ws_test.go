@@ -52,6 +52,11 @@ var _ = Describe("Config WS", func() {
 		json := fmt.Sprintf(`
         {
           "apiServer": {
+            "auth": {
+              "clientCertsDir": "",
+              "enabled": false,
+              "htpasswdFile": ""
+            },
             "catalog": {
               "bootstrap": {
                 "url": ""
@@ -66,8 +71,15 @@ var _ = Describe("Config WS", func() {
             "corsAllowedDomains": [
               ".*"
             ],
+            "https": {
+              "enabled": false,
+              "port": 5682,
+              "tlsCertFile": "",
+              "tlsKeyFile": ""
+            },
             "port": %s,
-            "readOnly": false
+            "readOnly": false,
+            "watchInterval": "1s"
           },
           "bootstrapServer": {
             "params": {
@@ -106,7 +118,9 @@ var _ = Describe("Config WS", func() {
           "dnsServer": {
             "domain": "mesh",
             "port": 5653,
-            "CIDR": "240.0.0.0/4"
+            "CIDR": "240.0.0.0/4",
+            "upstreams": [],
+            "searchDomains": []
           },
           "environment": "universal",
           "general": {
@@ -126,6 +140,10 @@ var _ = Describe("Config WS", func() {
             "zone": {
               "enabled": true,
               "subscriptionLimit": 10
+            },
+            "serviceGraph": {
+              "reportInterval": "30s",
+              "expirationTime": "1m30s"
             }
           },
           "mode": "standalone",
@@ -160,6 +178,14 @@ var _ = Describe("Config WS", func() {
                 "certDir": "",
                 "port": 5443
               },
+              "ingressController": {
+                "enabled": false,
+                "ingressClass": "kuma"
+              },
+              "learnedDestinations": {
+                "limit": 100,
+                "ttl": "24h0m0s"
+              },
               "injector": {
                 "cniEnabled": false,
                 "initContainer": {
@@ -170,6 +196,7 @@ var _ = Describe("Config WS", func() {
                   "drainTime": "30s",
                   "gid": 5678,
                   "image": "kuma/kuma-dp:latest",
+                  "lifecyclePort": 9902,
                   "livenessProbe": {
                     "failureThreshold": 12,
                     "initialDelaySeconds": 60,
@@ -212,7 +239,12 @@ var _ = Describe("Config WS", func() {
             "dataplaneConfigurationRefreshInterval": "1s"
           },
           "store": {
+            "embedded": {
+              "lockTimeout": "5s",
+              "path": "/var/lib/kuma/kuma.db"
+            },
             "kubernetes": {
+              "namespaceScopedPolicies": false,
               "systemNamespace": "kuma-system"
             },
             "postgres": {
@@ -234,6 +266,9 @@ var _ = Describe("Config WS", func() {
               "enabled": true,
               "expirationTime": "1s"
             },
+            "history": {
+              "maxRevisions": 10
+            },
             "type": "memory"
           },
           "xdsServer": {
@@ -246,6 +281,19 @@ var _ = Describe("Config WS", func() {
           },
           "diagnostics": {
             "debugEndpoints": false
+          },
+          "audit": {
+            "enabled": false,
+            "sink": "stdout",
+            "file": {
+              "path": "/var/log/kuma/audit.log",
+              "maxSize": 100,
+              "maxBackups": 5
+            },
+            "webhook": {
+              "url": "",
+              "timeout": "5s"
+            }
           }
         }
 		`, port)
@@ -2,14 +2,15 @@ package api_server
 
 import (
 	"context"
-	"strings"
 
 	"github.com/emicklei/go-restful"
 	"github.com/golang/protobuf/proto"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/rbac"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
@@ -19,6 +20,7 @@ import (
 type dataplaneOverviewEndpoints struct {
 	publicURL  string
 	resManager manager.ResourceManager
+	authorizer rbac.Authorizer
 }
 
 func (r *dataplaneOverviewEndpoints) addFindEndpoint(ws *restful.WebService, pathPrefix string) {
@@ -31,10 +33,9 @@ func (r *dataplaneOverviewEndpoints) addFindEndpoint(ws *restful.WebService, pat
 }
 
 func (r *dataplaneOverviewEndpoints) addListEndpoint(ws *restful.WebService, pathPrefix string) {
-	ws.Route(ws.GET(pathPrefix+"/dataplanes+insights").To(r.inspectDataplanes).
+	ws.Route(addFilterParams(ws, ws.GET(pathPrefix+"/dataplanes+insights").To(r.inspectDataplanes)).
 		Doc("Inspect all dataplanes").
 		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
-		Param(ws.QueryParameter("tag", "Tag to filter in key:value format").DataType("string")).
 		Param(ws.QueryParameter("gateway", "Param to filter gateway dataplanes").DataType("boolean")).
 		Param(ws.QueryParameter("ingress", "Param to filter ingress dataplanes").DataType("boolean")).
 		Returns(200, "OK", nil))
@@ -44,6 +45,11 @@ func (r *dataplaneOverviewEndpoints) inspectDataplane(request *restful.Request,
 	name := request.PathParameter("name")
 	meshName := request.PathParameter("mesh")
 
+	if err := authorize(r.authorizer, request, system.VerbGet, mesh.DataplaneType, meshName); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a dataplane overview")
+		return
+	}
+
 	overview, err := r.fetchOverview(request.Request.Context(), name, meshName)
 	if err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve a dataplane overview")
@@ -79,35 +85,44 @@ func (r *dataplaneOverviewEndpoints) fetchOverview(ctx context.Context, name str
 
 func (r *dataplaneOverviewEndpoints) inspectDataplanes(request *restful.Request, response *restful.Response) {
 	meshName := request.PathParameter("mesh")
+	if err := authorize(r.authorizer, request, system.VerbList, mesh.DataplaneType, meshName); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve dataplane overviews")
+		return
+	}
 	page, err := pagination(request)
 	if err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve dataplane overviews")
 		return
 	}
 
-	// todo(jakubdyszkiewicz) for now pagination + filtering is not supported
+	filters, err := listFilters(request)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve dataplane overviews")
+		return
+	}
+
+	// todo(jakubdyszkiewicz) for now pagination + filtering of gateways and ingresses is not supported
 	if (request.QueryParameter("size") != "" || request.QueryParameter("offset") != "") &&
-		(request.QueryParameter("tag") != "" || request.QueryParameter("gateway") != "" || request.QueryParameter("ingress") != "") {
+		(request.QueryParameter("gateway") != "" || request.QueryParameter("ingress") != "") {
 		rest_errors.HandleError(response, types.PaginationNotSupported, "Could not retrieve dataplane overviews")
 		return
 	}
 
-	overviews, err := r.fetchOverviews(request.Request.Context(), page, meshName)
+	overviews, err := r.fetchOverviews(request.Request.Context(), page, meshName, filters)
 	if err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve dataplane overviews")
 		return
 	}
 
-	tags := parseTags(request.QueryParameters("tag"))
 	if request.QueryParameter("gateway") == "true" {
 		overviews.RetainGatewayDataplanes()
+		// pagination is not supported yet so we need to override pagination total items after retaining dataplanes
+		overviews.GetPagination().SetTotal(uint32(len(overviews.Items)))
 	}
 	if request.QueryParameter("ingress") == "true" {
 		overviews.RetainIngressDataplanes()
+		overviews.GetPagination().SetTotal(uint32(len(overviews.Items)))
 	}
-	overviews.RetainMatchingTags(tags)
-	// pagination is not supported yet so we need to override pagination total items after retaining dataplanes
-	overviews.GetPagination().SetTotal(uint32(len(overviews.Items)))
 	restList := rest.From.ResourceList(&overviews)
 	next, err := nextLink(request, r.publicURL, &overviews)
 	if err != nil {
@@ -120,9 +135,9 @@ func (r *dataplaneOverviewEndpoints) inspectDataplanes(request *restful.Request,
 	}
 }
 
-func (r *dataplaneOverviewEndpoints) fetchOverviews(ctx context.Context, p page, meshName string) (mesh.DataplaneOverviewResourceList, error) {
+func (r *dataplaneOverviewEndpoints) fetchOverviews(ctx context.Context, p page, meshName string, filters []store.ListOptionsFunc) (mesh.DataplaneOverviewResourceList, error) {
 	dataplanes := mesh.DataplaneResourceList{}
-	if err := r.resManager.List(ctx, &dataplanes, store.ListByMesh(meshName), store.ListByPage(p.size, p.offset)); err != nil {
+	if err := r.resManager.List(ctx, &dataplanes, append(filters, store.ListByMesh(meshName), store.ListByPage(p.size, p.offset))...); err != nil {
 		return mesh.DataplaneOverviewResourceList{}, err
 	}
 
@@ -134,17 +149,3 @@ func (r *dataplaneOverviewEndpoints) fetchOverviews(ctx context.Context, p page,
 
 	return mesh.NewDataplaneOverviews(dataplanes, insights), nil
 }
-
-// Tags should be passed in form of ?tag=service:mobile&tag=version:v1
-func parseTags(queryParamValues []string) map[string]string {
-	tags := make(map[string]string)
-	for _, value := range queryParamValues {
-		tagKv := strings.Split(value, ":")
-		if len(tagKv) != 2 {
-			// ignore invalid formatted tags
-			continue
-		}
-		tags[tagKv[0]] = tagKv[1]
-	}
-	return tags
-}
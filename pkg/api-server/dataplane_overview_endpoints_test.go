@@ -275,6 +275,26 @@ var _ = Describe("Dataplane Overview Endpoints", func() {
 				url:          "/meshes/mesh1/dataplanes+insights?tag=service:backend&tag=version:v2",
 				expectedJson: `{"total": 0, "items": [], "next": null}`,
 			}),
+			Entry("should list with a tag in a set of values", testCase{
+				url:          "/meshes/mesh1/dataplanes+insights?tag=service:backend,gateway",
+				expectedJson: fmt.Sprintf(`{"total": 2, "items": [%s,%s], "next": null}`, dp1Json, dp2Json),
+			}),
+			Entry("should list with a negated tag", testCase{
+				url:          "/meshes/mesh1/dataplanes+insights?tag=service:!backend",
+				expectedJson: fmt.Sprintf(`{"total": 2, "items": [%s,%s], "next": null}`, dp1Json, dp3Json),
+			}),
+			Entry("should list with an existing tag", testCase{
+				url:          "/meshes/mesh1/dataplanes+insights?tag=version:*",
+				expectedJson: fmt.Sprintf(`{"total": 1, "items": [%s], "next": null}`, dp2Json),
+			}),
+			Entry("should list with a name prefix", testCase{
+				url:          "/meshes/mesh1/dataplanes+insights?namePrefix=dp-2",
+				expectedJson: fmt.Sprintf(`{"total": 1, "items": [%s], "next": null}`, dp2Json),
+			}),
+			Entry("should list with a tag using pagination", testCase{
+				url:          "/meshes/mesh1/dataplanes+insights?tag=service:*&size=1",
+				expectedJson: fmt.Sprintf(`{"total": 2, "items": [%s], "next": "/meshes/mesh1/dataplanes+insights?offset=1&size=1&tag=service%%3A%%2A"}`, dp1Json),
+			}),
 			Entry("should list only gateway dataplanes", testCase{
 				url:          "/meshes/mesh1/dataplanes+insights?gateway=true",
 				expectedJson: fmt.Sprintf(`{"total": 1, "items": [%s], "next": null}`, dp1Json),
@@ -2,8 +2,10 @@ package definitions
 
 var All = []ResourceWsDefinition{
 	MeshWsDefinition,
+	MeshInsightWsDefinition,
 	DataplaneWsDefinition,
 	DataplaneInsightWsDefinition,
+	ServiceInsightWsDefinition,
 	HealthCheckWsDefinition,
 	ProxyTemplateWsDefinition,
 	TrafficPermissionWsDefinition,
@@ -12,6 +14,9 @@ var All = []ResourceWsDefinition{
 	TrafficTraceWsDefinition,
 	FaultInjectionWsDefinition,
 	CircuitBreakerWsDefinition,
+	GatewayListenerWsDefinition,
+	GatewayRouteWsDefinition,
 	ZoneWsDefinition,
 	ZoneInsightWsDefinition,
+	RoleWsDefinition,
 }
@@ -0,0 +1,17 @@
+package definitions
+
+import (
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+var GatewayListenerWsDefinition = ResourceWsDefinition{
+	Name: "Gateway Listener",
+	Path: "gateway-listeners",
+	ResourceFactory: func() model.Resource {
+		return &mesh.GatewayListenerResource{}
+	},
+	ResourceListFactory: func() model.ResourceList {
+		return &mesh.GatewayListenerResourceList{}
+	},
+}
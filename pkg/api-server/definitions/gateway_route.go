@@ -0,0 +1,17 @@
+package definitions
+
+import (
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+var GatewayRouteWsDefinition = ResourceWsDefinition{
+	Name: "Gateway Route",
+	Path: "gateway-routes",
+	ResourceFactory: func() model.Resource {
+		return &mesh.GatewayRouteResource{}
+	},
+	ResourceListFactory: func() model.ResourceList {
+		return &mesh.GatewayRouteResourceList{}
+	},
+}
@@ -0,0 +1,17 @@
+package definitions
+
+import (
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+var RoleWsDefinition = ResourceWsDefinition{
+	Name: "Role",
+	Path: "roles",
+	ResourceFactory: func() model.Resource {
+		return &system.RoleResource{}
+	},
+	ResourceListFactory: func() model.ResourceList {
+		return &system.RoleResourceList{}
+	},
+}
@@ -0,0 +1,67 @@
+package api_server
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// addFilterParams documents query parameters parsed by listFilters
+func addFilterParams(ws *restful.WebService, route *restful.RouteBuilder) *restful.RouteBuilder {
+	return route.
+		Param(ws.QueryParameter("namePrefix", "retain only resources which name starts with the prefix").DataType("string")).
+		Param(ws.QueryParameter("tag", "tag to filter in key:value, key:value1,value2, key:!value1,value2 or key:* format").DataType("string").AllowMultiple(true)).
+		Param(ws.QueryParameter("modifiedSince", "retain only resources modified after the time in RFC 3339 format").DataType("string")).
+		Param(ws.QueryParameter("label", "label to filter in key:value format").DataType("string").AllowMultiple(true))
+}
+
+// listFilters parses filters of list endpoints in form of ?namePrefix=web&tag=kuma.io/service:web&modifiedSince=2020-01-01T00:00:00Z&label=team:web
+func listFilters(request *restful.Request) ([]store.ListOptionsFunc, error) {
+	var verr validators.ValidationError
+	var filters []store.ListOptionsFunc
+	if prefix := request.QueryParameter("namePrefix"); prefix != "" {
+		filters = append(filters, store.ListByNamePrefix(prefix))
+	}
+	var tags []store.TagFilter
+	for _, value := range request.QueryParameters("tag") {
+		tag, err := store.ParseTagFilter(value)
+		if err != nil {
+			verr.AddViolation("tag", err.Error())
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	if len(tags) > 0 {
+		filters = append(filters, store.ListByTags(tags...))
+	}
+	if value := request.QueryParameter("modifiedSince"); value != "" {
+		since, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			verr.AddViolation("modifiedSince", "must be a time in RFC 3339 format")
+		} else {
+			filters = append(filters, store.ListModifiedSince(since))
+		}
+	}
+	labels := map[string]string{}
+	for _, value := range request.QueryParameters("label") {
+		parts := strings.SplitN(value, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			verr.AddViolation("label", fmt.Sprintf("invalid label filter %q, expected format key:value", value))
+			continue
+		}
+		labels[parts[0]] = parts[1]
+	}
+	if len(labels) > 0 {
+		filters = append(filters, store.ListByLabels(labels))
+	}
+	if len(verr.Violations) > 0 {
+		return nil, &types.InvalidFilters{ValidationError: verr}
+	}
+	return filters, nil
+}
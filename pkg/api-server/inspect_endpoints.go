@@ -0,0 +1,176 @@
+package api_server
+
+import (
+	"context"
+	"sort"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/api-server/definitions"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/xds/topology"
+)
+
+// inspectedPolicies are policies that can be matched with Dataplanes.
+var inspectedPolicies = map[model.ResourceType]bool{
+	mesh.TrafficPermissionType: true,
+	mesh.FaultInjectionType:    true,
+	mesh.TrafficRouteType:      true,
+	mesh.TrafficLogType:        true,
+	mesh.HealthCheckType:       true,
+	mesh.CircuitBreakerType:    true,
+	mesh.TrafficTraceType:      true,
+	mesh.ProxyTemplateType:     true,
+}
+
+type inspectEndpoints struct {
+	resManager manager.ResourceManager
+	authorizer rbac.Authorizer
+}
+
+func (i *inspectEndpoints) addDataplanePoliciesEndpoint(ws *restful.WebService) {
+	ws.Route(ws.GET("/meshes/{mesh}/dataplanes/{name}/policies").To(i.inspectDataplanePolicies).
+		Doc("Inspect policies applied to a dataplane").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (i *inspectEndpoints) addPolicyDataplanesEndpoint(ws *restful.WebService, definition definitions.ResourceWsDefinition) {
+	ws.Route(ws.GET("/meshes/{mesh}/"+definition.Path+"/{name}/dataplanes").To(i.inspectPolicyDataplanes(definition)).
+		Doc("Inspect dataplanes that a policy is applied to").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Param(ws.PathParameter("name", "Name of a policy").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (i *inspectEndpoints) inspectDataplanePolicies(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	meshName := request.PathParameter("mesh")
+
+	if err := authorize(i.authorizer, request, system.VerbGet, mesh.DataplaneType, meshName); err != nil {
+		rest_errors.HandleError(response, err, "Could not inspect policies of a dataplane")
+		return
+	}
+
+	ctx := request.Request.Context()
+	dataplane := &mesh.DataplaneResource{}
+	if err := i.resManager.Get(ctx, dataplane, store.GetByKey(name, meshName)); err != nil {
+		rest_errors.HandleError(response, err, "Could not inspect policies of a dataplane")
+		return
+	}
+	policies, err := i.meshPolicies(ctx, meshName)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not inspect policies of a dataplane")
+		return
+	}
+	matched, err := topology.MatchPolicies(dataplane, policies)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not inspect policies of a dataplane")
+		return
+	}
+
+	result := types.DataplanePolicies{
+		Mesh:      meshName,
+		Dataplane: name,
+		Items:     []types.MatchedPolicy{},
+	}
+	for _, m := range matched {
+		result.Items = append(result.Items, types.MatchedPolicy{
+			Type:       string(m.Policy.GetType()),
+			Name:       m.Policy.GetMeta().GetName(),
+			Attachment: toAttachment(m.Attachment),
+		})
+	}
+	if err := response.WriteAsJson(result); err != nil {
+		rest_errors.HandleError(response, err, "Could not inspect policies of a dataplane")
+	}
+}
+
+func (i *inspectEndpoints) inspectPolicyDataplanes(definition definitions.ResourceWsDefinition) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		name := request.PathParameter("name")
+		meshName := request.PathParameter("mesh")
+		policyType := definition.ResourceFactory().GetType()
+
+		for _, resType := range []model.ResourceType{policyType, mesh.DataplaneType} {
+			if err := authorize(i.authorizer, request, system.VerbGet, resType, meshName); err != nil {
+				rest_errors.HandleError(response, err, "Could not inspect dataplanes of a policy")
+				return
+			}
+		}
+
+		ctx := request.Request.Context()
+		if err := i.resManager.Get(ctx, definition.ResourceFactory(), store.GetByKey(name, meshName)); err != nil {
+			rest_errors.HandleError(response, err, "Could not inspect dataplanes of a policy")
+			return
+		}
+		policies, err := i.meshPolicies(ctx, meshName)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not inspect dataplanes of a policy")
+			return
+		}
+		dataplanes := &mesh.DataplaneResourceList{}
+		if err := i.resManager.List(ctx, dataplanes, store.ListByMesh(meshName)); err != nil {
+			rest_errors.HandleError(response, err, "Could not inspect dataplanes of a policy")
+			return
+		}
+
+		result := types.PolicyDataplanes{
+			Mesh:  meshName,
+			Type:  string(policyType),
+			Name:  name,
+			Items: []types.MatchedDataplane{},
+		}
+		for _, dataplane := range dataplanes.Items {
+			matched, err := topology.MatchPolicies(dataplane, policies)
+			if err != nil {
+				rest_errors.HandleError(response, err, "Could not inspect dataplanes of a policy")
+				return
+			}
+			var attachments []types.PolicyAttachment
+			for _, m := range matched {
+				if m.Policy.GetType() == policyType && m.Policy.GetMeta().GetName() == name {
+					attachments = append(attachments, toAttachment(m.Attachment))
+				}
+			}
+			if len(attachments) > 0 {
+				result.Items = append(result.Items, types.MatchedDataplane{
+					Dataplane:   dataplane.GetMeta().GetName(),
+					Attachments: attachments,
+				})
+			}
+		}
+		sort.Slice(result.Items, func(i, j int) bool {
+			return result.Items[i].Dataplane < result.Items[j].Dataplane
+		})
+		if err := response.WriteAsJson(result); err != nil {
+			rest_errors.HandleError(response, err, "Could not inspect dataplanes of a policy")
+		}
+	}
+}
+
+func (i *inspectEndpoints) meshPolicies(ctx context.Context, meshName string) (*topology.MeshPolicies, error) {
+	meshRes := &mesh.MeshResource{}
+	if err := i.resManager.Get(ctx, meshRes, store.GetByKey(meshName, meshName)); err != nil {
+		return nil, err
+	}
+	return topology.GetMeshPolicies(ctx, meshRes, i.resManager)
+}
+
+func toAttachment(attachment topology.Attachment) types.PolicyAttachment {
+	return types.PolicyAttachment{
+		Type:    string(attachment.Type),
+		Name:    attachment.Name,
+		Service: attachment.Service,
+	}
+}
@@ -0,0 +1,185 @@
+package api_server_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Inspect Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resourceStore := memory.NewStore()
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig(), true, metrics)
+
+		resources := []struct {
+			name string
+			res  model.Resource
+		}{
+			{
+				name: "mesh1",
+				res:  &mesh_core.MeshResource{},
+			},
+			{
+				name: "web-01",
+				res: &mesh_core.DataplaneResource{
+					Spec: mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "192.168.0.1",
+							Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+								{Port: 8080, ServicePort: 18080, Tags: map[string]string{"kuma.io/service": "web"}},
+							},
+							Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+								{Service: "backend", Port: 10001},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "backend-01",
+				res: &mesh_core.DataplaneResource{
+					Spec: mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "192.168.0.2",
+							Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+								{Port: 8080, ServicePort: 18080, Tags: map[string]string{"kuma.io/service": "backend"}},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "allow-all",
+				res: &mesh_core.TrafficPermissionResource{
+					Spec: mesh_proto.TrafficPermission{
+						Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+						Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+					},
+				},
+			},
+			{
+				name: "web-to-backend",
+				res: &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchService("web")}},
+						Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("backend")}},
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{
+							{Weight: 100, Destination: mesh_proto.MatchService("backend")},
+						},
+					},
+				},
+			},
+		}
+		for _, r := range resources {
+			err := resourceStore.Create(context.Background(), r.res, store.CreateByKey(r.name, "mesh1"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	It("should return policies applied to a dataplane", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/dataplanes/web-01/policies", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`
+		{
+			"mesh": "mesh1",
+			"dataplane": "web-01",
+			"items": [
+				{
+					"type": "TrafficPermission",
+					"name": "allow-all",
+					"attachment": {"type": "inbound", "name": "192.168.0.1:8080:18080", "service": "web"}
+				},
+				{
+					"type": "TrafficRoute",
+					"name": "web-to-backend",
+					"attachment": {"type": "outbound", "name": "127.0.0.1:10001", "service": "backend"}
+				}
+			]
+		}`))
+	})
+
+	It("should return dataplanes that a policy is applied to", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/traffic-permissions/allow-all/dataplanes", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`
+		{
+			"mesh": "mesh1",
+			"type": "TrafficPermission",
+			"name": "allow-all",
+			"items": [
+				{
+					"dataplane": "backend-01",
+					"attachments": [{"type": "inbound", "name": "192.168.0.2:8080:18080", "service": "backend"}]
+				},
+				{
+					"dataplane": "web-01",
+					"attachments": [{"type": "inbound", "name": "192.168.0.1:8080:18080", "service": "web"}]
+				}
+			]
+		}`))
+	})
+
+	It("should return 404 for non-existing dataplane", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/dataplanes/unknown/policies", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(404))
+	})
+
+	It("should return 404 for non-existing policy", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/traffic-routes/unknown/dataplanes", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(404))
+	})
+})
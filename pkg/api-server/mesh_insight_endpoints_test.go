@@ -0,0 +1,139 @@
+package api_server_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Mesh Insight Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var stop chan struct{}
+	t1, _ := time.Parse(time.RFC3339, "2018-07-17T16:05:36.995+00:00")
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig(), true, metrics)
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	createMeshInsight := func(name string, online, offline uint32) {
+		err := resourceStore.Create(context.Background(), &mesh_core.MeshResource{}, store.CreateByKey(name, name), store.CreatedAt(t1))
+		Expect(err).ToNot(HaveOccurred())
+
+		insight := mesh_core.MeshInsightResource{
+			Spec: mesh_proto.MeshInsight{
+				Dataplanes: &mesh_proto.MeshInsight_DataplaneStat{
+					Total:   online + offline,
+					Online:  online,
+					Offline: offline,
+				},
+			},
+		}
+		err = resourceStore.Create(context.Background(), &insight, store.CreateByKey(name, name), store.CreatedAt(t1))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		createMeshInsight("mesh-1", 1, 2)
+		createMeshInsight("mesh-2", 3, 0)
+	})
+
+	mesh1Json := `
+{
+ "type": "MeshInsight",
+ "name": "mesh-1",
+ "creationTime": "2018-07-17T16:05:36.995Z",
+ "modificationTime": "2018-07-17T16:05:36.995Z",
+ "dataplanes": {
+  "total": 3,
+  "online": 1,
+  "offline": 2
+ }
+}`
+
+	mesh2Json := `
+{
+ "type": "MeshInsight",
+ "name": "mesh-2",
+ "creationTime": "2018-07-17T16:05:36.995Z",
+ "modificationTime": "2018-07-17T16:05:36.995Z",
+ "dataplanes": {
+  "total": 3,
+  "online": 3
+ }
+}`
+
+	Describe("On GET", func() {
+		It("should return an existing resource", func() {
+			// when
+			response, err := http.Get("http://" + apiServer.Address() + "/mesh-insights/mesh-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(mesh1Json))
+		})
+
+		It("should list resources", func() {
+			// when
+			response, err := http.Get("http://" + apiServer.Address() + "/mesh-insights")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(fmt.Sprintf(`{"total": 2, "items": [%s,%s], "next": null}`, mesh1Json, mesh2Json)))
+		})
+	})
+
+	Describe("On PUT", func() {
+		It("should not allow to modify a resource", func() {
+			// given
+			json := `{"type": "MeshInsight", "name": "mesh-1"}`
+			request, err := http.NewRequest(http.MethodPut, "http://"+apiServer.Address()+"/mesh-insights/mesh-1", bytes.NewBufferString(json))
+			Expect(err).ToNot(HaveOccurred())
+			request.Header.Add("content-type", "application/json")
+
+			// when
+			response, err := http.DefaultClient.Do(request)
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(405))
+		})
+	})
+})
@@ -0,0 +1,55 @@
+package api_server
+
+import (
+	"strings"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+// etag is an entity tag of a resource built from the version of the resource in the store.
+func etag(res model.Resource) string {
+	return `"` + res.GetMeta().GetVersion() + `"`
+}
+
+func setETag(response *restful.Response, res model.Resource) {
+	if res.GetMeta() != nil && res.GetMeta().GetVersion() != "" {
+		response.Header().Set(headerETag, etag(res))
+	}
+}
+
+// ifMatch returns entity tags from If-Match header. It returns nil when the header is not set.
+func ifMatch(request *restful.Request) []string {
+	header := request.HeaderParameter(headerIfMatch)
+	if header == "" {
+		return nil
+	}
+	var tags []string
+	for _, tag := range strings.Split(header, ",") {
+		tags = append(tags, strings.TrimSpace(tag))
+	}
+	return tags
+}
+
+// matches checks that the current version of the resource matches one of the entity tags from If-Match header.
+// A nil resource means that the resource does not exist.
+func matches(tags []string, res model.Resource) bool {
+	if tags == nil {
+		return true
+	}
+	if res == nil {
+		return false
+	}
+	for _, tag := range tags {
+		if tag == "*" || tag == etag(res) {
+			return true
+		}
+	}
+	return false
+}
@@ -59,13 +59,34 @@ func (r *resourceApiClient) delete(name string) *http.Response {
 	return response
 }
 
+func (r *resourceApiClient) deleteIfMatch(name string, etag string) *http.Response {
+	request, err := http.NewRequest(
+		"DELETE",
+		r.fullAddress()+"/"+name,
+		nil,
+	)
+	Expect(err).ToNot(HaveOccurred())
+	request.Header.Add("If-Match", etag)
+	response, err := http.DefaultClient.Do(request)
+	Expect(err).ToNot(HaveOccurred())
+	return response
+}
+
 func (r *resourceApiClient) put(res rest.Resource) *http.Response {
+	return r.putIfMatch(res, "")
+}
+
+func (r *resourceApiClient) putIfMatch(res rest.Resource, etag string) *http.Response {
 	jsonBytes, err := res.MarshalJSON()
 	Expect(err).ToNot(HaveOccurred())
-	return r.putJson(res.Meta.Name, jsonBytes)
+	return r.putJsonIfMatch(res.Meta.Name, jsonBytes, etag)
 }
 
 func (r *resourceApiClient) putJson(name string, json []byte) *http.Response {
+	return r.putJsonIfMatch(name, json, "")
+}
+
+func (r *resourceApiClient) putJsonIfMatch(name string, json []byte, etag string) *http.Response {
 	request, err := http.NewRequest(
 		"PUT",
 		r.fullAddress()+"/"+name,
@@ -73,6 +94,9 @@ func (r *resourceApiClient) putJson(name string, json []byte) *http.Response {
 	)
 	Expect(err).ToNot(HaveOccurred())
 	request.Header.Add("content-type", "application/json")
+	if etag != "" {
+		request.Header.Add("If-Match", etag)
+	}
 	response, err := http.DefaultClient.Do(request)
 	Expect(err).ToNot(HaveOccurred())
 	return response
@@ -109,7 +133,7 @@ func createTestApiServer(store store.ResourceStore, config *config_api_server.Ap
 	resources := manager.NewResourceManager(store)
 	cfg := kuma_cp.DefaultConfig()
 	cfg.ApiServer = config
-	apiServer, err := api_server.NewApiServer(resources, defs, &cfg, enableGUI, metrics)
+	apiServer, err := api_server.NewApiServer(resources, defs, &cfg, enableGUI, metrics, nil, nil, nil, nil)
 	Expect(err).ToNot(HaveOccurred())
 	return apiServer
 }
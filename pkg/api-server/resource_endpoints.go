@@ -11,7 +11,10 @@ import (
 
 	"github.com/kumahq/kuma/pkg/api-server/definitions"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/rbac"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
@@ -42,14 +45,29 @@ type resourceEndpoints struct {
 	mode            config_core.CpMode
 	publicURL       string
 	resManager      manager.ResourceManager
+	authorizer      rbac.Authorizer
 	meshFromRequest meshFromRequestFn
+	// meshForAuthorization is a mesh against which Roles are matched. If not set, meshFromRequest is used.
+	meshForAuthorization meshFromRequestFn
+	watches              *watchHub
+	history              history.Reader
 	definitions.ResourceWsDefinition
 }
 
+func (r *resourceEndpoints) authorize(request *restful.Request, verb string) error {
+	meshFromRequest := r.meshFromRequest
+	if r.meshForAuthorization != nil {
+		meshFromRequest = r.meshForAuthorization
+	}
+	return authorize(r.authorizer, request, verb, r.ResourceFactory().GetType(), meshFromRequest(request))
+}
+
 func (r *resourceEndpoints) addFindEndpoint(ws *restful.WebService, pathPrefix string) {
 	ws.Route(ws.GET(pathPrefix+"/{name}").To(r.findResource).
 		Doc(fmt.Sprintf("Get a %s", r.Name)).
 		Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", r.Name)).DataType("string")).
+		Param(ws.QueryParameter("watch", "stream changes of the resource").DataType("boolean")).
+		Produces(restful.MIME_JSON, mimeEventStream).
 		Returns(200, "OK", nil).
 		Returns(404, "Not found", nil))
 }
@@ -58,11 +76,29 @@ func (r *resourceEndpoints) findResource(request *restful.Request, response *res
 	name := request.PathParameter("name")
 	meshName := r.meshFromRequest(request)
 
+	if err := r.authorize(request, system.VerbGet); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a resource")
+		return
+	}
+
+	if isWatchRequest(request) {
+		watchResources(request, response, r.watches, r.ResourceListFactory, func(resources []model.Resource) []model.Resource {
+			for _, resource := range resources {
+				if resource.GetMeta().GetName() == name && resource.GetMeta().GetMesh() == meshName {
+					return []model.Resource{resource}
+				}
+			}
+			return nil
+		})
+		return
+	}
+
 	resource := r.ResourceFactory()
 	err := r.resManager.Get(request.Request.Context(), resource, store.GetByKey(name, meshName))
 	if err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve a resource")
 	} else {
+		setETag(response, resource)
 		res := rest.From.Resource(resource)
 		if err := response.WriteAsJson(res); err != nil {
 			core.Log.Error(err, "Could not write the response")
@@ -71,16 +107,46 @@ func (r *resourceEndpoints) findResource(request *restful.Request, response *res
 }
 
 func (r *resourceEndpoints) addListEndpoint(ws *restful.WebService, pathPrefix string) {
-	ws.Route(ws.GET(pathPrefix).To(r.listResources).
+	ws.Route(addFilterParams(ws, ws.GET(pathPrefix).To(r.listResources)).
 		Doc(fmt.Sprintf("List of %s", r.Name)).
 		Param(ws.PathParameter("size", "size of page").DataType("int")).
 		Param(ws.PathParameter("offset", "offset of page to list").DataType("string")).
+		Param(ws.QueryParameter("watch", "stream changes of resources instead of returning a page").DataType("boolean")).
+		Produces(restful.MIME_JSON, mimeEventStream).
 		Returns(200, "OK", nil))
 }
 
 func (r *resourceEndpoints) listResources(request *restful.Request, response *restful.Response) {
 	meshName := r.meshFromRequest(request)
 
+	if err := r.authorize(request, system.VerbList); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve resources")
+		return
+	}
+
+	filters, err := listFilters(request)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve resources")
+		return
+	}
+
+	if isWatchRequest(request) {
+		opts := store.NewListOptions(filters...)
+		watchResources(request, response, r.watches, r.ResourceListFactory, func(resources []model.Resource) []model.Resource {
+			var selected []model.Resource
+			for _, resource := range resources {
+				if meshName != "" && resource.GetMeta().GetMesh() != meshName {
+					continue
+				}
+				if opts.Filter(resource) {
+					selected = append(selected, resource)
+				}
+			}
+			return selected
+		})
+		return
+	}
+
 	page, err := pagination(request)
 	if err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve resources")
@@ -88,7 +154,7 @@ func (r *resourceEndpoints) listResources(request *restful.Request, response *re
 	}
 
 	list := r.ResourceListFactory()
-	if err := r.resManager.List(request.Request.Context(), list, store.ListByMesh(meshName), store.ListByPage(page.size, page.offset)); err != nil {
+	if err := r.resManager.List(request.Request.Context(), list, append(filters, store.ListByMesh(meshName), store.ListByPage(page.size, page.offset))...); err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve resources")
 	} else {
 		restList := rest.From.ResourceList(list)
@@ -108,8 +174,12 @@ func (r *resourceEndpoints) addCreateOrUpdateEndpoint(ws *restful.WebService, pa
 	ws.Route(ws.PUT(pathPrefix+"/{name}").To(r.createOrUpdateResource).
 		Doc(fmt.Sprintf("Updates a %s", r.Name)).
 		Param(ws.PathParameter("name", fmt.Sprintf("Name of the %s", r.Name)).DataType("string")).
+		Param(ws.QueryParameter("dryRun", "if true, the resource is defaulted and validated without being persisted and the result is returned").DataType("boolean")).
+		Param(ws.HeaderParameter(headerIfMatch, "update the resource only if its ETag matches").DataType("string")).
 		Returns(200, "OK", nil).
-		Returns(201, "Created", nil))
+		Returns(201, "Created", nil).
+		Returns(409, "Conflict", nil).
+		Returns(412, "Precondition Failed", nil))
 }
 
 func (r *resourceEndpoints) createOrUpdateResource(request *restful.Request, response *restful.Response) {
@@ -130,37 +200,94 @@ func (r *resourceEndpoints) createOrUpdateResource(request *restful.Request, res
 		return
 	}
 
+	dryRun := request.QueryParameter("dryRun") == "true"
+	tags := ifMatch(request)
 	resource := r.ResourceFactory()
 	if err := r.resManager.Get(request.Request.Context(), resource, store.GetByKey(name, meshName)); err != nil {
 		if store.IsResourceNotFound(err) {
-			r.createResource(request.Request.Context(), name, meshName, resourceRes.Spec, response)
+			if err := r.authorize(request, system.VerbCreate); err != nil {
+				rest_errors.HandleError(response, err, "Could not create a resource")
+				return
+			}
+			if !matches(tags, nil) {
+				rest_errors.HandleError(response, store.ErrorResourcePreconditionFailed(resource.GetType(), name, meshName), "Could not create a resource")
+				return
+			}
+			if dryRun {
+				r.dryRunCreate(request.Request.Context(), name, meshName, resourceRes, response)
+				return
+			}
+			r.createResource(request.Request.Context(), name, meshName, resourceRes, response)
 		} else {
 			rest_errors.HandleError(response, err, "Could not find a resource")
 		}
 	} else {
+		if err := r.authorize(request, system.VerbUpdate); err != nil {
+			rest_errors.HandleError(response, err, "Could not update a resource")
+			return
+		}
+		if !matches(tags, resource) {
+			rest_errors.HandleError(response, store.ErrorResourcePreconditionFailed(resource.GetType(), name, meshName), "Could not update a resource")
+			return
+		}
+		if dryRun {
+			r.dryRunUpdate(request.Request.Context(), resource, resourceRes, response)
+			return
+		}
 		r.updateResource(request.Request.Context(), resource, resourceRes, response)
 	}
 }
 
-func (r *resourceEndpoints) createResource(ctx context.Context, name string, meshName string, spec model.ResourceSpec, response *restful.Response) {
+func (r *resourceEndpoints) createResource(ctx context.Context, name string, meshName string, restRes rest.Resource, response *restful.Response) {
 	res := r.ResourceFactory()
-	_ = res.SetSpec(spec)
-	if err := r.resManager.Create(ctx, res, store.CreateByKey(name, meshName)); err != nil {
+	_ = res.SetSpec(restRes.Spec)
+	if err := r.resManager.Create(ctx, res, store.CreateByKey(name, meshName), store.CreateWithLabels(restRes.Meta.Labels)); err != nil {
 		rest_errors.HandleError(response, err, "Could not create a resource")
 	} else {
+		setETag(response, res)
 		response.WriteHeader(201)
 	}
 }
 
 func (r *resourceEndpoints) updateResource(ctx context.Context, res model.Resource, restRes rest.Resource, response *restful.Response) {
 	_ = res.SetSpec(restRes.Spec)
-	if err := r.resManager.Update(ctx, res); err != nil {
+	// the version of the resource is checked by the store, so the changes made after the resource was fetched are not overwritten
+	if err := r.resManager.Update(ctx, res, store.UpdateWithLabels(restRes.Meta.Labels)); err != nil {
 		rest_errors.HandleError(response, err, "Could not update a resource")
 	} else {
+		setETag(response, res)
 		response.WriteHeader(200)
 	}
 }
 
+// dryRunCreate defaults and validates a resource by the manager the same way as on create, but without persisting it.
+// The defaulted resource is returned, so a client can see what would be stored.
+func (r *resourceEndpoints) dryRunCreate(ctx context.Context, name string, meshName string, restRes rest.Resource, response *restful.Response) {
+	res := r.ResourceFactory()
+	_ = res.SetSpec(restRes.Spec)
+	if err := r.resManager.Create(ctx, res, store.CreateByKey(name, meshName), store.CreateWithLabels(restRes.Meta.Labels), store.CreateDryRun()); err != nil {
+		rest_errors.HandleError(response, err, "Could not process a resource")
+		return
+	}
+	restRes.Spec = res.GetSpec()
+	if err := response.WriteHeaderAndJson(201, &restRes, restful.MIME_JSON); err != nil {
+		core.Log.Error(err, "Could not write the response")
+	}
+}
+
+// dryRunUpdate defaults and validates a resource by the manager the same way as on update, but without persisting it.
+func (r *resourceEndpoints) dryRunUpdate(ctx context.Context, res model.Resource, restRes rest.Resource, response *restful.Response) {
+	_ = res.SetSpec(restRes.Spec)
+	if err := r.resManager.Update(ctx, res, store.UpdateWithLabels(restRes.Meta.Labels), store.UpdateDryRun()); err != nil {
+		rest_errors.HandleError(response, err, "Could not process a resource")
+		return
+	}
+	restRes.Spec = res.GetSpec()
+	if err := response.WriteHeaderAndJson(200, &restRes, restful.MIME_JSON); err != nil {
+		core.Log.Error(err, "Could not write the response")
+	}
+}
+
 func (r *resourceEndpoints) addCreateOrUpdateEndpointReadOnly(ws *restful.WebService, pathPrefix string) {
 	ws.Route(ws.PUT(pathPrefix+"/{name}").To(r.createOrUpdateResourceReadOnly).
 		Doc("Not allowed in read-only mode.").
@@ -178,15 +305,37 @@ func (r *resourceEndpoints) addDeleteEndpoint(ws *restful.WebService, pathPrefix
 	ws.Route(ws.DELETE(pathPrefix+"/{name}").To(r.deleteResource).
 		Doc(fmt.Sprintf("Deletes a %s", r.Name)).
 		Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", r.Name)).DataType("string")).
-		Returns(200, "OK", nil))
+		Param(ws.HeaderParameter(headerIfMatch, "delete the resource only if its ETag matches").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(412, "Precondition Failed", nil))
 }
 
 func (r *resourceEndpoints) deleteResource(request *restful.Request, response *restful.Response) {
 	name := request.PathParameter("name")
 	meshName := r.meshFromRequest(request)
 
+	if err := r.authorize(request, system.VerbDelete); err != nil {
+		rest_errors.HandleError(response, err, "Could not delete a resource")
+		return
+	}
+
+	deleteOpts := []store.DeleteOptionsFunc{store.DeleteByKey(name, meshName)}
+	if tags := ifMatch(request); tags != nil {
+		current := r.ResourceFactory()
+		if err := r.resManager.Get(request.Request.Context(), current, store.GetByKey(name, meshName)); err != nil {
+			rest_errors.HandleError(response, err, "Could not delete a resource")
+			return
+		}
+		if !matches(tags, current) {
+			rest_errors.HandleError(response, store.ErrorResourcePreconditionFailed(current.GetType(), name, meshName), "Could not delete a resource")
+			return
+		}
+		// the store deletes the resource only in the matched version, so it cannot be modified in the meantime
+		deleteOpts = append(deleteOpts, store.DeleteByVersion(current.GetMeta().GetVersion()))
+	}
+
 	resource := r.ResourceFactory()
-	if err := r.resManager.Delete(request.Request.Context(), resource, store.DeleteByKey(name, meshName)); err != nil {
+	if err := r.resManager.Delete(request.Request.Context(), resource, deleteOpts...); err != nil {
 		rest_errors.HandleError(response, err, "Could not delete a resource")
 	}
 }
@@ -218,6 +367,11 @@ func (r *resourceEndpoints) validateResourceRequest(request *restful.Request, re
 		err.AddViolation("mesh", "mesh from the URL has to be the same as in body")
 	}
 	err.AddError("", mesh.ValidateMeta(name, meshName, r.ResourceFactory().Scope()))
+	for key := range resource.Meta.Labels {
+		if key == "" {
+			err.AddViolation("labels", "label key cannot be empty")
+		}
+	}
 	return err.OrNil()
 }
 
@@ -88,6 +88,18 @@ var _ = Describe("Resource Endpoints", func() {
 			Expect(body).To(MatchJSON(json))
 		})
 
+		It("should return the version of the resource as ETag", func() {
+			// given
+			putSampleResourceIntoStore(resourceStore, "tr-1", mesh)
+
+			// when
+			response := client.get("tr-1")
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(response.Header.Get("ETag")).To(Equal(`"1"`))
+		})
+
 		It("should return 404 for non existing resource", func() {
 			// when
 			response := client.get("non-existing-resource")
@@ -253,6 +265,115 @@ var _ = Describe("Resource Endpoints", func() {
 			Expect(body).To(MatchJSON(json))
 		})
 
+		It("should list resources filtered by name prefix", func() {
+			// given
+			putSampleResourceIntoStore(resourceStore, "web-1", mesh)
+			putSampleResourceIntoStore(resourceStore, "backend-1", mesh)
+
+			// when
+			client = resourceApiClient{
+				address: apiServer.Address(),
+				path:    "/meshes/" + mesh + "/sample-traffic-routes?namePrefix=web-",
+			}
+			response := client.list()
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"total": 1,
+				"items": [
+					{
+						"type": "SampleTrafficRoute",
+						"name": "web-1",
+						"mesh": "default",
+						"creationTime": "0001-01-01T00:00:00Z",
+						"modificationTime": "0001-01-01T00:00:00Z",
+						"path": "/sample-path"
+					}
+				],
+				"next": null
+			}`))
+		})
+
+		It("should list resources filtered by labels", func() {
+			// given
+			err := resourceStore.Create(context.Background(), &sample_model.TrafficRouteResource{
+				Spec: sample_proto.TrafficRoute{
+					Path: "/sample-path",
+				},
+			}, store.CreateByKey("web-1", mesh), store.CreateWithLabels(map[string]string{"team": "web"}))
+			Expect(err).ToNot(HaveOccurred())
+			putSampleResourceIntoStore(resourceStore, "backend-1", mesh)
+
+			// when
+			client = resourceApiClient{
+				address: apiServer.Address(),
+				path:    "/meshes/" + mesh + "/sample-traffic-routes?label=team:web",
+			}
+			response := client.list()
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"total": 1,
+				"items": [
+					{
+						"type": "SampleTrafficRoute",
+						"name": "web-1",
+						"mesh": "default",
+						"labels": {
+							"team": "web"
+						},
+						"creationTime": "0001-01-01T00:00:00Z",
+						"modificationTime": "0001-01-01T00:00:00Z",
+						"path": "/sample-path"
+					}
+				],
+				"next": null
+			}`))
+		})
+
+		It("should return 400 with error on invalid filters", func() {
+			// when
+			client = resourceApiClient{
+				address: apiServer.Address(),
+				path:    "/sample-traffic-routes?tag=version&label=team&modifiedSince=yesterday",
+			}
+			response := client.list()
+
+			// then
+			Expect(response.StatusCode).To(Equal(400))
+			// and
+			bytes, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes).To(MatchJSON(`
+			{
+				"title": "Could not retrieve resources",
+				"details": "Invalid filters",
+				"causes": [
+					{
+						"field": "tag",
+						"message": "invalid tag filter \"version\", expected format key:value"
+					},
+					{
+						"field": "modifiedSince",
+						"message": "must be a time in RFC 3339 format"
+					},
+					{
+						"field": "label",
+						"message": "invalid label filter \"team\", expected format key:value"
+					}
+				]
+			}
+			`))
+		})
+
 		It("should return 400 with error on invalid offset", func() {
 			// when
 			client = resourceApiClient{
@@ -382,6 +503,190 @@ var _ = Describe("Resource Endpoints", func() {
 			Expect(resource.Spec.Path).To(Equal("/update-sample-path"))
 		})
 
+		It("should update a resource when If-Match matches the current version", func() {
+			// given
+			name := "tr-1"
+			putSampleResourceIntoStore(resourceStore, name, mesh)
+			etag := client.get(name).Header.Get("ETag")
+
+			// when
+			res := rest.Resource{
+				Meta: rest.ResourceMeta{
+					Name: name,
+					Mesh: mesh,
+					Type: string(sample_model.TrafficRouteType),
+				},
+				Spec: &sample_proto.TrafficRoute{
+					Path: "/update-sample-path",
+				},
+			}
+			response := client.putIfMatch(res, etag)
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(response.Header.Get("ETag")).To(Equal(`"2"`))
+		})
+
+		It("should return 412 when If-Match does not match the current version", func() {
+			// given
+			name := "tr-1"
+			putSampleResourceIntoStore(resourceStore, name, mesh)
+
+			// when
+			res := rest.Resource{
+				Meta: rest.ResourceMeta{
+					Name: name,
+					Mesh: mesh,
+					Type: string(sample_model.TrafficRouteType),
+				},
+				Spec: &sample_proto.TrafficRoute{
+					Path: "/update-sample-path",
+				},
+			}
+			response := client.putIfMatch(res, `"42"`)
+
+			// then
+			Expect(response.StatusCode).To(Equal(412))
+			bytes, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes).To(MatchJSON(`
+			{
+				"title": "Could not update a resource",
+				"details": "Precondition Failed"
+			}
+			`))
+
+			// and the resource is not modified
+			resource := sample_model.TrafficRouteResource{}
+			err = resourceStore.Get(context.Background(), &resource, store.GetByKey(name, mesh))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resource.Spec.Path).To(Equal("/sample-path"))
+		})
+
+		It("should return 412 when If-Match is set and the resource does not exist", func() {
+			// when
+			res := rest.Resource{
+				Meta: rest.ResourceMeta{
+					Name: "new-resource",
+					Mesh: mesh,
+					Type: string(sample_model.TrafficRouteType),
+				},
+				Spec: &sample_proto.TrafficRoute{
+					Path: "/sample-path",
+				},
+			}
+			response := client.putIfMatch(res, "*")
+
+			// then
+			Expect(response.StatusCode).To(Equal(412))
+		})
+
+		It("should store labels of a resource", func() {
+			// given
+			res := rest.Resource{
+				Meta: rest.ResourceMeta{
+					Name:   "tr-1",
+					Mesh:   mesh,
+					Type:   string(sample_model.TrafficRouteType),
+					Labels: map[string]string{"team": "web"},
+				},
+				Spec: &sample_proto.TrafficRoute{
+					Path: "/sample-path",
+				},
+			}
+
+			// when
+			response := client.put(res)
+			Expect(response.StatusCode).To(Equal(201))
+
+			// then
+			resource := sample_model.TrafficRouteResource{}
+			err := resourceStore.Get(context.Background(), &resource, store.GetByKey("tr-1", mesh))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resource.Meta.GetLabels()).To(Equal(map[string]string{"team": "web"}))
+
+			// when
+			res.Meta.Labels = nil
+			response = client.put(res)
+			Expect(response.StatusCode).To(Equal(200))
+
+			// then
+			resource = sample_model.TrafficRouteResource{}
+			err = resourceStore.Get(context.Background(), &resource, store.GetByKey("tr-1", mesh))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resource.Meta.GetLabels()).To(BeEmpty())
+		})
+
+		It("should return a validated resource without persisting it on dry run", func() {
+			// given
+			json := `
+			{
+				"type": "SampleTrafficRoute",
+				"name": "tr-1",
+				"mesh": "default",
+				"path": "/sample-path"
+			}
+			`
+
+			// when
+			response := client.putJson("tr-1?dryRun=true", []byte(json))
+
+			// then
+			Expect(response.StatusCode).To(Equal(201))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"type": "SampleTrafficRoute",
+				"name": "tr-1",
+				"mesh": "default",
+				"creationTime": "0001-01-01T00:00:00Z",
+				"modificationTime": "0001-01-01T00:00:00Z",
+				"path": "/sample-path"
+			}
+			`))
+
+			// and
+			err = resourceStore.Get(context.Background(), &sample_model.TrafficRouteResource{}, store.GetByKey("tr-1", mesh))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should return 400 on dry run when mesh does not exist", func() {
+			// setup
+			err := resourceStore.Delete(context.Background(), &mesh_res.MeshResource{}, store.DeleteByKey("default", "default"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// given
+			json := `
+			{
+				"type": "SampleTrafficRoute",
+				"name": "tr-1",
+				"mesh": "default",
+				"path": "/sample-path"
+			}
+			`
+
+			// when
+			response := client.putJson("tr-1?dryRun=true", []byte(json))
+
+			// then
+			Expect(response.StatusCode).To(Equal(400))
+			respBytes, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(respBytes).To(MatchJSON(`
+			{
+				"title": "Could not process a resource",
+				"details": "Mesh is not found",
+				"causes": [
+					{
+						"field": "mesh",
+						"message": "mesh of name default is not found"
+					}
+				]
+			}
+			`))
+		})
+
 		It("should return 400 on the type in url that is different from request", func() {
 			// given
 			json := `
@@ -621,6 +926,42 @@ var _ = Describe("Resource Endpoints", func() {
 			Expect(err).To(Equal(store.ErrorResourceNotFound(resource.GetType(), name, mesh)))
 		})
 
+		It("should not delete a resource when If-Match does not match the current version", func() {
+			// given
+			name := "tr-1"
+			putSampleResourceIntoStore(resourceStore, name, mesh)
+
+			// when
+			response := client.deleteIfMatch(name, `"42"`)
+
+			// then
+			Expect(response.StatusCode).To(Equal(412))
+
+			// and
+			resource := sample_model.TrafficRouteResource{}
+			err := resourceStore.Get(context.Background(), &resource, store.GetByKey(name, mesh))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should delete a resource when If-Match matches the current version", func() {
+			// given
+			name := "tr-1"
+			putSampleResourceIntoStore(resourceStore, name, mesh)
+			current := sample_model.TrafficRouteResource{}
+			Expect(resourceStore.Get(context.Background(), &current, store.GetByKey(name, mesh))).To(Succeed())
+
+			// when
+			response := client.deleteIfMatch(name, `"`+current.GetMeta().GetVersion()+`"`)
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+
+			// and
+			resource := sample_model.TrafficRouteResource{}
+			err := resourceStore.Get(context.Background(), &resource, store.GetByKey(name, mesh))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
 		It("should delete non-existing resource", func() {
 			// when
 			response := client.delete("non-existing-resource")
@@ -0,0 +1,63 @@
+package api_server
+
+import (
+	"fmt"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+)
+
+func (r *resourceEndpoints) addHistoryEndpoint(ws *restful.WebService, pathPrefix string) {
+	ws.Route(ws.GET(pathPrefix+"/{name}/_history").To(r.resourceHistory).
+		Doc(fmt.Sprintf("Get the history of changes of a %s", r.Name)).
+		Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", r.Name)).DataType("string")).
+		Returns(200, "OK", nil))
+}
+
+func (r *resourceEndpoints) resourceHistory(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	meshName := r.meshFromRequest(request)
+
+	if err := r.authorize(request, system.VerbGet); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve the history of a resource")
+		return
+	}
+
+	factory := r.ResourceFactory()
+	revisions, err := r.history.History(request.Request.Context(), factory.GetType(), model.ResourceKey{Name: name, Mesh: meshName})
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve the history of a resource")
+		return
+	}
+	list := rest.RevisionList{
+		Items: []*rest.Revision{},
+	}
+	for _, revision := range revisions {
+		meta := rest.ResourceMeta{
+			Type:   string(factory.GetType()),
+			Name:   name,
+			Labels: revision.Labels,
+		}
+		if factory.Scope() == model.ScopeMesh {
+			meta.Mesh = meshName
+		}
+		list.Items = append(list.Items, &rest.Revision{
+			Revision:  revision.Revision,
+			Operation: string(revision.Operation),
+			User:      revision.User,
+			Time:      revision.Time,
+			Resource: &rest.Resource{
+				Meta: meta,
+				Spec: revision.Spec,
+			},
+		})
+	}
+	if err := response.WriteAsJson(list); err != nil {
+		core.Log.Error(err, "Could not write the response")
+	}
+}
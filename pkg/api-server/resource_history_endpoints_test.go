@@ -0,0 +1,149 @@
+package api_server_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	"github.com/kumahq/kuma/pkg/api-server/definitions"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	"github.com/kumahq/kuma/pkg/core"
+	mesh_res "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test"
+	sample_proto "github.com/kumahq/kuma/pkg/test/apis/sample/v1alpha1"
+	sample_model "github.com/kumahq/kuma/pkg/test/resources/apis/sample"
+)
+
+var _ = Describe("Resource History Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var client resourceApiClient
+	var stop chan struct{}
+
+	const mesh = "default"
+	changedAt, _ := time.Parse(time.RFC3339, "2020-10-19T10:00:00Z")
+
+	BeforeEach(func() {
+		core.Now = func() time.Time {
+			return changedAt
+		}
+		historyStore := history.NewHistoryStore(memory.NewStore(), []model.ResourceType{sample_model.TrafficRouteType}, 10)
+		err := historyStore.Create(context.Background(), &mesh_res.MeshResource{}, store.CreateByKey(mesh, mesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		port, err := test.GetFreePort()
+		Expect(err).NotTo(HaveOccurred())
+		cfg := kuma_cp.DefaultConfig()
+		cfg.ApiServer = config.DefaultApiServerConfig()
+		cfg.ApiServer.Port = port
+		metrics, err := core_metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		defs := append(definitions.All, SampleTrafficRouteWsDefinition)
+		apiServer, err = api_server.NewApiServer(manager.NewResourceManager(historyStore), defs, &cfg, true, metrics, nil, nil, nil, historyStore)
+		Expect(err).ToNot(HaveOccurred())
+
+		client = resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes/" + mesh + "/sample-traffic-routes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+		core.Now = time.Now
+	})
+
+	It("should return revisions of a resource", func() {
+		// given
+		for _, path := range []string{"/v1", "/v2"} {
+			response := client.put(rest.Resource{
+				Meta: rest.ResourceMeta{
+					Name:   "tr-1",
+					Mesh:   mesh,
+					Type:   string(sample_model.TrafficRouteType),
+					Labels: map[string]string{"team": "web"},
+				},
+				Spec: &sample_proto.TrafficRoute{
+					Path: path,
+				},
+			})
+			Expect(response.StatusCode).To(Or(Equal(200), Equal(201)))
+		}
+
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/%s/sample-traffic-routes/tr-1/_history", apiServer.Address(), mesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`
+		{
+			"items": [
+				{
+					"revision": 1,
+					"operation": "Create",
+					"user": "admin",
+					"time": "2020-10-19T10:00:00Z",
+					"resource": {
+						"type": "SampleTrafficRoute",
+						"mesh": "default",
+						"name": "tr-1",
+						"creationTime": "0001-01-01T00:00:00Z",
+						"modificationTime": "0001-01-01T00:00:00Z",
+						"labels": {"team": "web"},
+						"path": "/v1"
+					}
+				},
+				{
+					"revision": 2,
+					"operation": "Update",
+					"user": "admin",
+					"time": "2020-10-19T10:00:00Z",
+					"resource": {
+						"type": "SampleTrafficRoute",
+						"mesh": "default",
+						"name": "tr-1",
+						"creationTime": "0001-01-01T00:00:00Z",
+						"modificationTime": "0001-01-01T00:00:00Z",
+						"labels": {"team": "web"},
+						"path": "/v2"
+					}
+				}
+			]
+		}`))
+	})
+
+	It("should return no revisions of an unknown resource", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/%s/sample-traffic-routes/unknown/_history", apiServer.Address(), mesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`{"items": []}`))
+	})
+})
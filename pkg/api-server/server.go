@@ -3,9 +3,11 @@ package api_server
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 
 	"github.com/emicklei/go-restful"
@@ -15,16 +17,26 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/app/kuma-ui/pkg/resources"
+	"github.com/kumahq/kuma/pkg/api-server/authn"
 	"github.com/kumahq/kuma/pkg/api-server/definitions"
+	config_api_server "github.com/kumahq/kuma/pkg/config/api-server"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	"github.com/kumahq/kuma/pkg/core/rbac"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/runtime"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/insights/graph"
 	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 	util_prometheus "github.com/kumahq/kuma/pkg/util/prometheus"
+	xds_server "github.com/kumahq/kuma/pkg/xds/server"
 )
 
 var (
@@ -32,7 +44,12 @@ var (
 )
 
 type ApiServer struct {
-	server *http.Server
+	server      *http.Server
+	httpsServer *http.Server
+	httpsConfig *config_api_server.ApiServerHTTPSConfig
+	// cancelRequests cancels context of in-flight requests, so long running watches do not block the shutdown
+	cancelRequests context.CancelFunc
+	watches        *watchHub
 }
 
 func (a *ApiServer) NeedLeaderElection() bool {
@@ -61,7 +78,7 @@ func init() {
 	}
 }
 
-func NewApiServer(resManager manager.ResourceManager, defs []definitions.ResourceWsDefinition, cfg *kuma_cp.Config, enableGUI bool, metrics metrics.Metrics) (*ApiServer, error) {
+func NewApiServer(resManager manager.ResourceManager, defs []definitions.ResourceWsDefinition, cfg *kuma_cp.Config, enableGUI bool, metrics metrics.Metrics, serviceGraph graph.Provider, xdsContext core_xds.XdsContext, simulator xds_server.Simulator, historyReader history.Reader) (*ApiServer, error) {
 	serverConfig := cfg.ApiServer
 	container := restful.NewContainer()
 	srv := &http.Server{
@@ -92,7 +109,57 @@ func NewApiServer(resManager manager.ResourceManager, defs []definitions.Resourc
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
 
-	addResourcesEndpoints(ws, defs, resManager, cfg)
+	authorizer := rbac.AllowAll
+	var authenticator authn.Authenticator
+	if serverConfig.Auth.Enabled {
+		tokenIssuer := issuer.NewUserTokenIssuer(func() ([]byte, error) {
+			return issuer.GetUserTokenSigningKey(resManager)
+		})
+		a, err := authn.NewAuthenticator(serverConfig.Auth, tokenIssuer)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create an authenticator")
+		}
+		authenticator = a
+		authorizer = rbac.NewRoleAuthorizer(resManager, issuer.SigningKeyResourceKeys...)
+	}
+
+	watches := newWatchHub(resManager, serverConfig.WatchInterval)
+	addResourcesEndpoints(ws, defs, resManager, authorizer, watches, cfg, historyReader)
+	if serviceGraph != nil {
+		serviceGraphEndpoints := serviceGraphEndpoints{
+			resManager: resManager,
+			authorizer: authorizer,
+			provider:   serviceGraph,
+		}
+		serviceGraphEndpoints.addFindEndpoint(ws)
+	}
+	if xdsContext != nil {
+		xdsEndpoints := xdsEndpoints{
+			resManager: resManager,
+			authorizer: authorizer,
+			xds:        xdsContext,
+		}
+		xdsEndpoints.addFindEndpoint(ws)
+	}
+	if xdsContext != nil && simulator != nil {
+		simulateEndpoints := simulateEndpoints{
+			resManager: resManager,
+			authorizer: authorizer,
+			xds:        xdsContext,
+			simulator:  simulator,
+		}
+		simulateEndpoints.addSimulateEndpoint(ws)
+	}
+	inspectEndpoints := inspectEndpoints{
+		resManager: resManager,
+		authorizer: authorizer,
+	}
+	inspectEndpoints.addDataplanePoliciesEndpoint(ws)
+	for _, definition := range defs {
+		if inspectedPolicies[definition.ResourceFactory().GetType()] {
+			inspectEndpoints.addPolicyDataplanesEndpoint(ws, definition)
+		}
+	}
 	container.Add(ws)
 
 	if err := addIndexWsEndpoints(ws); err != nil {
@@ -105,13 +172,49 @@ func NewApiServer(resManager manager.ResourceManager, defs []definitions.Resourc
 	}
 	container.Add(configWs)
 
-	zonesWs := zonesWs(resManager)
+	zonesWs := zonesWs(resManager, authorizer)
 	container.Add(zonesWs)
 
 	container.Filter(cors.Filter)
+	container.Filter(audit.SourceFilter(audit.OriginApiServer))
+	if authenticator != nil {
+		// index and catalog are used by kuma-dp and health checks which do not have credentials of a user
+		container.Filter(authn.Filter(authenticator, "/", "/catalog"))
+	} else {
+		container.Filter(authn.AdminFilter())
+	}
 
 	newApiServer := &ApiServer{
-		server: srv,
+		server:      srv,
+		httpsConfig: serverConfig.HTTPS,
+		watches:     watches,
+	}
+	if serverConfig.HTTPS.Enabled {
+		tlsConfig := &tls.Config{}
+		if serverConfig.Auth.ClientCertsDir != "" {
+			clientCAs, err := authn.LoadClientCAs(serverConfig.Auth.ClientCertsDir)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not load client certificates")
+			}
+			tlsConfig.ClientCAs = clientCAs
+			// clients can still authenticate with a token or a password, so client certificate is not required
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+		newApiServer.httpsServer = &http.Server{
+			Addr:      fmt.Sprintf(":%d", serverConfig.HTTPS.Port),
+			Handler:   container.ServeMux,
+			TLSConfig: tlsConfig,
+		}
+	}
+
+	// requests are derived from the base context, so watches are finished when the server is stopped
+	baseCtx, cancelRequests := context.WithCancel(context.Background())
+	newApiServer.cancelRequests = cancelRequests
+	srv.BaseContext = func(net.Listener) context.Context {
+		return baseCtx
+	}
+	if newApiServer.httpsServer != nil {
+		newApiServer.httpsServer.BaseContext = srv.BaseContext
 	}
 
 	// Handle the GUI
@@ -123,11 +226,12 @@ func NewApiServer(resManager manager.ResourceManager, defs []definitions.Resourc
 	return newApiServer, nil
 }
 
-func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWsDefinition, resManager manager.ResourceManager, cfg *kuma_cp.Config) {
+func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWsDefinition, resManager manager.ResourceManager, authorizer rbac.Authorizer, watches *watchHub, cfg *kuma_cp.Config, historyReader history.Reader) {
 	config := cfg.ApiServer
 	endpoints := dataplaneOverviewEndpoints{
 		publicURL:  config.Catalog.ApiServer.Url,
 		resManager: resManager,
+		authorizer: authorizer,
 	}
 	endpoints.addListEndpoint(ws, "/meshes/{mesh}")
 	endpoints.addFindEndpoint(ws, "/meshes/{mesh}")
@@ -136,6 +240,7 @@ func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWs
 	zoneOverviewEndpoints := zoneOverviewEndpoints{
 		publicURL:  config.Catalog.ApiServer.Url,
 		resManager: resManager,
+		authorizer: authorizer,
 	}
 	zoneOverviewEndpoints.addFindEndpoint(ws)
 	zoneOverviewEndpoints.addListEndpoint(ws)
@@ -147,7 +252,10 @@ func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWs
 				mode:                 cfg.Mode,
 				publicURL:            config.Catalog.ApiServer.Url,
 				resManager:           resManager,
+				authorizer:           authorizer,
+				watches:              watches,
 				ResourceWsDefinition: definition,
+				history:              historyReader,
 				meshFromRequest:      meshFromPathParam("name"),
 			}
 			if config.ReadOnly || definition.ReadOnly {
@@ -159,29 +267,59 @@ func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWs
 			}
 			endpoints.addFindEndpoint(ws, "/meshes")
 			endpoints.addListEndpoint(ws, "/meshes")
-		case system.ZoneType:
+			if historyReader != nil {
+				endpoints.addHistoryEndpoint(ws, "/meshes")
+			}
+		case mesh.MeshInsightType:
+			endpoints := resourceEndpoints{
+				mode:                 cfg.Mode,
+				publicURL:            config.Catalog.ApiServer.Url,
+				resManager:           resManager,
+				authorizer:           authorizer,
+				watches:              watches,
+				ResourceWsDefinition: definition,
+				meshFromRequest:      meshFromPathParam("name"),
+			}
+			endpoints.addCreateOrUpdateEndpointReadOnly(ws, "/"+definition.Path)
+			endpoints.addDeleteEndpointReadOnly(ws, "/"+definition.Path)
+			endpoints.addFindEndpoint(ws, "/"+definition.Path)
+			endpoints.addListEndpoint(ws, "/"+definition.Path)
+		case system.ZoneType, system.RoleType:
 			endpoints := resourceEndpoints{
 				publicURL:            config.Catalog.ApiServer.Url,
 				resManager:           resManager,
+				authorizer:           authorizer,
+				watches:              watches,
 				ResourceWsDefinition: definition,
+				history:              historyReader,
 				meshFromRequest: func(request *restful.Request) string {
 					return "default"
 				},
+				// those resources are stored in the default mesh, but they are not part of any mesh
+				meshForAuthorization: func(request *restful.Request) string {
+					return ""
+				},
 			}
 			if config.ReadOnly || definition.ReadOnly {
-				endpoints.addCreateOrUpdateEndpointReadOnly(ws, "/zones")
-				endpoints.addDeleteEndpointReadOnly(ws, "/zones")
+				endpoints.addCreateOrUpdateEndpointReadOnly(ws, "/"+definition.Path)
+				endpoints.addDeleteEndpointReadOnly(ws, "/"+definition.Path)
 			} else {
-				endpoints.addCreateOrUpdateEndpoint(ws, "/zones")
-				endpoints.addDeleteEndpoint(ws, "/zones")
+				endpoints.addCreateOrUpdateEndpoint(ws, "/"+definition.Path)
+				endpoints.addDeleteEndpoint(ws, "/"+definition.Path)
+			}
+			endpoints.addFindEndpoint(ws, "/"+definition.Path)
+			endpoints.addListEndpoint(ws, "/"+definition.Path)
+			if historyReader != nil {
+				endpoints.addHistoryEndpoint(ws, "/"+definition.Path)
 			}
-			endpoints.addFindEndpoint(ws, "/zones")
-			endpoints.addListEndpoint(ws, "/zones")
 		default:
 			endpoints := resourceEndpoints{
 				publicURL:            config.Catalog.ApiServer.Url,
 				resManager:           resManager,
+				authorizer:           authorizer,
+				watches:              watches,
 				ResourceWsDefinition: definition,
+				history:              historyReader,
 				meshFromRequest:      meshFromPathParam("mesh"),
 			}
 			if config.ReadOnly || definition.ReadOnly {
@@ -194,12 +332,30 @@ func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWs
 			endpoints.addFindEndpoint(ws, "/meshes/{mesh}/"+definition.Path)
 			endpoints.addListEndpoint(ws, "/meshes/{mesh}/"+definition.Path)
 			endpoints.addListEndpoint(ws, "/"+definition.Path) // listing all resources in all meshes
+			if historyReader != nil {
+				endpoints.addHistoryEndpoint(ws, "/meshes/{mesh}/"+definition.Path)
+			}
 		}
 	}
 }
 
 func (a *ApiServer) Start(stop <-chan struct{}) error {
 	errChan := make(chan error)
+	if a.httpsServer != nil {
+		go func() {
+			err := a.httpsServer.ListenAndServeTLS(a.httpsConfig.TlsCertFile, a.httpsConfig.TlsKeyFile)
+			if err != nil {
+				switch err {
+				case http.ErrServerClosed:
+					log.Info("Shutting down HTTPS server")
+				default:
+					log.Error(err, "Could not start an HTTPS Server")
+					errChan <- err
+				}
+			}
+		}()
+		log.Info("starting", "interface", "0.0.0.0", "port", a.httpsServer.Addr, "tls", true)
+	}
 	go func() {
 		err := a.server.ListenAndServe()
 		if err != nil {
@@ -216,6 +372,12 @@ func (a *ApiServer) Start(stop <-chan struct{}) error {
 	select {
 	case <-stop:
 		log.Info("Stopping down API Server")
+		a.cancelRequests()
+		if a.httpsServer != nil {
+			if err := a.httpsServer.Shutdown(context.Background()); err != nil {
+				return err
+			}
+		}
 		return a.server.Shutdown(context.Background())
 	case err := <-errChan:
 		return err
@@ -247,15 +409,38 @@ func SetupServer(rt runtime.Runtime) error {
 					definitions.All[i].ReadOnly = true
 				}
 			case config_core.Remote:
-				if definition.ResourceFactory().GetType() != mesh.DataplaneType {
+				// Roles are not synchronized from Global, every Control Plane has its own Roles
+				if definition.ResourceFactory().GetType() != mesh.DataplaneType && definition.ResourceFactory().GetType() != system.RoleType {
 					definitions.All[i].ReadOnly = true
 				}
 			}
 		}
 	}
-	apiServer, err := NewApiServer(rt.ResourceManager(), definitions.All, &cfg, enableGUI, rt.Metrics())
+	var serviceGraph graph.Provider
+	// dataplanes report their traffic only to zones, therefore the service graph is built only in zones
+	if cfg.Mode != config_core.Global {
+		serviceGraph = graph.NewBuilder(rt.ReadOnlyResourceManager(), cfg.Metrics.ServiceGraph.ExpirationTime)
+	}
+	var xdsContext core_xds.XdsContext
+	var simulator xds_server.Simulator
+	// dataplanes are connected only to zones
+	if cfg.Mode != config_core.Global {
+		xdsContext = rt.XDS()
+		s, err := xds_server.NewSimulator(rt)
+		if err != nil {
+			return err
+		}
+		simulator = s
+	}
+	historyReader, _ := history.FromReaderContext(rt.Extensions())
+	apiServer, err := NewApiServer(rt.ResourceManager(), definitions.All, &cfg, enableGUI, rt.Metrics(), serviceGraph, xdsContext, simulator, historyReader)
 	if err != nil {
 		return err
 	}
+	if notifier, ok := store.FromChangeNotifierContext(rt.Extensions()); ok {
+		if err := rt.Add(&watchChangeListener{notifier: notifier, hub: apiServer.watches}); err != nil {
+			return err
+		}
+	}
 	return rt.Add(apiServer)
 }
@@ -0,0 +1,51 @@
+package api_server
+
+import (
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/insights/graph"
+)
+
+type serviceGraphEndpoints struct {
+	resManager manager.ResourceManager
+	authorizer rbac.Authorizer
+	provider   graph.Provider
+}
+
+func (s *serviceGraphEndpoints) addFindEndpoint(ws *restful.WebService) {
+	ws.Route(ws.GET("/meshes/{mesh}/service-graph").To(s.inspectServiceGraph).
+		Doc("Inspect service-to-service traffic of a mesh").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (s *serviceGraphEndpoints) inspectServiceGraph(request *restful.Request, response *restful.Response) {
+	meshName := request.PathParameter("mesh")
+
+	// service graph is built from the traffic between services, so it is guarded by the same rules as ServiceInsights
+	if err := authorize(s.authorizer, request, system.VerbGet, mesh.ServiceInsightType, meshName); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a service graph")
+		return
+	}
+
+	if err := s.resManager.Get(request.Request.Context(), &mesh.MeshResource{}, store.GetByKey(meshName, meshName)); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a service graph")
+		return
+	}
+
+	serviceGraph, err := s.provider.ServiceGraph(request.Request.Context(), meshName)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a service graph")
+		return
+	}
+	if err := response.WriteAsJson(serviceGraph); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a service graph")
+	}
+}
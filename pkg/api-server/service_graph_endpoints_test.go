@@ -0,0 +1,148 @@
+package api_server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	"github.com/kumahq/kuma/pkg/api-server/definitions"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+type staticServiceGraphProvider map[string]*types.ServiceGraph
+
+func (s staticServiceGraphProvider) ServiceGraph(_ context.Context, mesh string) (*types.ServiceGraph, error) {
+	if graph, ok := s[mesh]; ok {
+		return graph, nil
+	}
+	return &types.ServiceGraph{
+		Mesh:  mesh,
+		Nodes: []types.ServiceGraphNode{},
+		Edges: []types.ServiceGraphEdge{},
+	}, nil
+}
+
+var _ = Describe("Service Graph Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var stop chan struct{}
+	generatedAt, _ := time.Parse(time.RFC3339, "2018-07-17T16:05:36.995+00:00")
+
+	BeforeEach(func() {
+		resourceStore := memory.NewStore()
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+
+		port, err := test.GetFreePort()
+		Expect(err).NotTo(HaveOccurred())
+		cfg := kuma_cp.DefaultConfig()
+		cfg.ApiServer = config.DefaultApiServerConfig()
+		cfg.ApiServer.Port = port
+
+		provider := staticServiceGraphProvider{
+			"mesh1": {
+				Mesh:        "mesh1",
+				GeneratedAt: generatedAt,
+				Nodes: []types.ServiceGraphNode{
+					{Service: "backend", Instances: 2},
+					{Service: "web", Instances: 1},
+				},
+				Edges: []types.ServiceGraphEdge{
+					{Source: "web", Destination: "backend", RequestRate: 12.5, ErrorRate: 0.1, LatencyMs: 3},
+				},
+			},
+		}
+		apiServer, err = api_server.NewApiServer(manager.NewResourceManager(resourceStore), definitions.All, &cfg, true, metrics, provider, nil, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, mesh := range []string{"mesh1", "mesh2"} {
+			err := resourceStore.Create(context.Background(), &mesh_core.MeshResource{}, store.CreateByKey(mesh, mesh))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	It("should return the service graph of a mesh", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/service-graph", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`
+		{
+			"mesh": "mesh1",
+			"generatedAt": "2018-07-17T16:05:36.995Z",
+			"nodes": [
+				{"service": "backend", "instances": 2},
+				{"service": "web", "instances": 1}
+			],
+			"edges": [
+				{
+					"source": "web",
+					"destination": "backend",
+					"requestRate": 12.5,
+					"errorRate": 0.1,
+					"latencyMs": 3,
+					"connectionRate": 0
+				}
+			]
+		}`))
+	})
+
+	It("should return an empty graph when there is no traffic", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh2/service-graph", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		graph := types.ServiceGraph{}
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(json.Unmarshal(body, &graph)).To(Succeed())
+		Expect(graph.Mesh).To(Equal("mesh2"))
+		Expect(graph.Nodes).To(BeEmpty())
+		Expect(graph.Edges).To(BeEmpty())
+	})
+
+	It("should return 404 for non-existing mesh", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/unknown/service-graph", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(404))
+	})
+})
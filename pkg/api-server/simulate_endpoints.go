@@ -0,0 +1,157 @@
+package api_server
+
+import (
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	xds_server "github.com/kumahq/kuma/pkg/xds/server"
+)
+
+type simulateEndpoints struct {
+	resManager manager.ResourceManager
+	authorizer rbac.Authorizer
+	xds        core_xds.XdsContext
+	simulator  xds_server.Simulator
+}
+
+func (s *simulateEndpoints) addSimulateEndpoint(ws *restful.WebService) {
+	ws.Route(ws.POST("/meshes/{mesh}/simulate").To(s.simulate).
+		Doc("Preview Envoy configuration of a dataplane with candidate resources. Nothing is persisted.").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Reads(types.SimulationRequest{}).
+		Returns(200, "OK", nil).
+		Returns(400, "Bad request", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (s *simulateEndpoints) simulate(request *restful.Request, response *restful.Response) {
+	meshName := request.PathParameter("mesh")
+
+	if err := authorize(s.authorizer, request, system.VerbGet, mesh.DataplaneType, meshName); err != nil {
+		rest_errors.HandleError(response, err, "Could not simulate Envoy configuration")
+		return
+	}
+	simulationReq := types.SimulationRequest{}
+	if err := request.ReadEntity(&simulationReq); err != nil {
+		rest_errors.HandleError(response, err, "Could not process a simulation request")
+		return
+	}
+	dataplane, resources, err := s.parseSimulationRequest(request, meshName, simulationReq)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not process a simulation request")
+		return
+	}
+
+	snapshot, err := s.simulator.Simulate(request.Request.Context(), dataplane, resources)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not simulate Envoy configuration")
+		return
+	}
+	simulated, err := snapshotResources(snapshot)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not simulate Envoy configuration")
+		return
+	}
+	var live []types.XdsResources
+	// there is no live snapshot when the dataplane is hypothetical or not connected
+	proxyId := core_xds.FromResourceKey(model.MetaToResourceKey(dataplane.GetMeta()))
+	if snapshot, err := s.xds.Cache().GetSnapshot(proxyId.String()); err == nil {
+		if live, err = snapshotResources(snapshot); err != nil {
+			rest_errors.HandleError(response, err, "Could not simulate Envoy configuration")
+			return
+		}
+	}
+	diff, err := xdsDiff(live, simulated, "live", "simulated")
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not simulate Envoy configuration")
+		return
+	}
+	result := types.Simulation{
+		Mesh:      meshName,
+		Dataplane: dataplane.GetMeta().GetName(),
+		Resources: simulated,
+		Diff:      diff,
+	}
+	if err := response.WriteAsJson(result); err != nil {
+		core.Log.Error(err, "Could not write the response")
+	}
+}
+
+type defaulter interface {
+	Default() error
+}
+
+// parseSimulationRequest returns the simulated Dataplane, either an existing one or a hypothetical one given in resources,
+// and the candidate resources.
+func (s *simulateEndpoints) parseSimulationRequest(request *restful.Request, meshName string, simulationReq types.SimulationRequest) (*mesh.DataplaneResource, []model.Resource, error) {
+	var verr validators.ValidationError
+	var dataplane *mesh.DataplaneResource
+	var resources []model.Resource
+	for i, raw := range simulationReq.Resources {
+		path := validators.RootedAt("resources").Index(i)
+		res, err := rest.UnmarshallToCore(raw)
+		if err != nil {
+			verr.AddViolationAt(path, err.Error())
+			continue
+		}
+		if res.Scope() != model.ScopeMesh || res.GetMeta().GetMesh() != meshName {
+			verr.AddViolationAt(path.Field("mesh"), "mesh from the URL has to be the same as in body")
+			continue
+		}
+		if defaulter, ok := res.(defaulter); ok {
+			if err := defaulter.Default(); err != nil {
+				verr.AddViolationAt(path, err.Error())
+				continue
+			}
+		}
+		if err := res.Validate(); err != nil {
+			if resErr, ok := err.(*validators.ValidationError); ok {
+				verr.AddErrorAt(path, *resErr)
+			} else {
+				verr.AddViolationAt(path, err.Error())
+			}
+			continue
+		}
+		if dp, ok := res.(*mesh.DataplaneResource); ok {
+			if dataplane != nil {
+				verr.AddViolationAt(path, "only one Dataplane can be simulated")
+				continue
+			}
+			dataplane = dp
+			continue
+		}
+		resources = append(resources, res)
+	}
+	if err := verr.OrNil(); err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case simulationReq.Dataplane != "" && dataplane != nil:
+		verr.AddViolation("dataplane", "either a name of an existing Dataplane or a Dataplane in resources has to be given, not both")
+	case simulationReq.Dataplane == "" && dataplane == nil:
+		verr.AddViolation("dataplane", "either a name of an existing Dataplane or a Dataplane in resources has to be given")
+	case dataplane == nil:
+		dataplane = &mesh.DataplaneResource{}
+		if err := s.resManager.Get(request.Request.Context(), dataplane, store.GetByKey(simulationReq.Dataplane, meshName)); err != nil {
+			return nil, nil, err
+		}
+	}
+	if dataplane != nil && dataplane.Spec.IsIngress() {
+		verr.AddViolation("dataplane", "simulation of an Ingress is not supported")
+	}
+	if err := verr.OrNil(); err != nil {
+		return nil, nil, err
+	}
+	return dataplane, resources, nil
+}
@@ -0,0 +1,266 @@
+package api_server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v2"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	"github.com/kumahq/kuma/pkg/api-server/definitions"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+type fakeSimulator struct {
+	dataplane *mesh_core.DataplaneResource
+	resources []core_model.Resource
+	snapshot  envoy_cache.Snapshot
+}
+
+func (f *fakeSimulator) Simulate(_ context.Context, dataplane *mesh_core.DataplaneResource, resources []core_model.Resource) (envoy_cache.Snapshot, error) {
+	f.dataplane = dataplane
+	f.resources = resources
+	return f.snapshot, nil
+}
+
+var _ = Describe("Simulate Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var xdsContext core_xds.XdsContext
+	var simulator *fakeSimulator
+	var stop chan struct{}
+
+	cluster := func(timeout time.Duration) *envoy.Cluster {
+		return &envoy.Cluster{
+			Name:           "backend",
+			ConnectTimeout: ptypes.DurationProto(timeout),
+		}
+	}
+
+	simulate := func(req types.SimulationRequest) (*http.Response, []byte) {
+		reqBody, err := json.Marshal(req)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := http.Post(fmt.Sprintf("http://%s/meshes/mesh1/simulate", apiServer.Address()), "application/json", bytes.NewReader(reqBody))
+		Expect(err).ToNot(HaveOccurred())
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return response, body
+	}
+
+	const trafficRoute = `
+{
+  "type": "TrafficRoute",
+  "mesh": "mesh1",
+  "name": "route-v2",
+  "sources": [{"match": {"kuma.io/service": "*"}}],
+  "destinations": [{"match": {"kuma.io/service": "backend"}}],
+  "conf": [{"weight": 100, "destination": {"kuma.io/service": "backend", "version": "v2"}}]
+}`
+
+	BeforeEach(func() {
+		resourceStore := memory.NewStore()
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+
+		port, err := test.GetFreePort()
+		Expect(err).NotTo(HaveOccurred())
+		cfg := kuma_cp.DefaultConfig()
+		cfg.ApiServer = config.DefaultApiServerConfig()
+		cfg.ApiServer.Port = port
+
+		xdsContext = core_xds.NewXdsContext()
+		simulator = &fakeSimulator{}
+		simulator.snapshot.Resources[envoy_types.Cluster] = envoy_cache.NewResources("", []envoy_types.Resource{cluster(5 * time.Second)})
+		apiServer, err = api_server.NewApiServer(manager.NewResourceManager(resourceStore), definitions.All, &cfg, true, metrics, nil, xdsContext, simulator, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = resourceStore.Create(context.Background(), &mesh_core.MeshResource{}, store.CreateByKey("mesh1", "mesh1"))
+		Expect(err).ToNot(HaveOccurred())
+		dataplane := &mesh_core.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{Port: 8080, Tags: map[string]string{"kuma.io/service": "web"}},
+					},
+				},
+			},
+		}
+		err = resourceStore.Create(context.Background(), dataplane, store.CreateByKey("web-01", "mesh1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	It("should simulate configuration of an existing dataplane and diff it against the live configuration", func() {
+		// given live configuration
+		snapshot := envoy_cache.Snapshot{}
+		snapshot.Resources[envoy_types.Cluster] = envoy_cache.NewResources("v1", []envoy_types.Resource{cluster(time.Second)})
+		Expect(xdsContext.Cache().SetSnapshot("mesh1.web-01", snapshot)).To(Succeed())
+
+		// when
+		response, body := simulate(types.SimulationRequest{
+			Dataplane: "web-01",
+			Resources: []json.RawMessage{json.RawMessage(trafficRoute)},
+		})
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(simulator.dataplane.GetMeta().GetName()).To(Equal("web-01"))
+		Expect(simulator.resources).To(HaveLen(1))
+		Expect(simulator.resources[0].GetMeta().GetName()).To(Equal("route-v2"))
+
+		// and
+		result := types.Simulation{}
+		Expect(json.Unmarshal(body, &result)).To(Succeed())
+		Expect(result.Dataplane).To(Equal("web-01"))
+		Expect(result.Resources).To(HaveLen(4))
+		Expect(result.Resources[0].TypeUrl).To(Equal(envoy_resource.ClusterType))
+		Expect(result.Resources[0].Resources[0]).To(MatchJSON(`{"name": "backend", "connectTimeout": "5s"}`))
+		Expect(result.Diff).To(Equal(`--- live type.googleapis.com/envoy.api.v2.Cluster
++++ simulated type.googleapis.com/envoy.api.v2.Cluster
+@@ -1,2 +1,2 @@
+-- connectTimeout: 1s
++- connectTimeout: 5s
+   name: backend
+`))
+	})
+
+	It("should simulate configuration of a hypothetical dataplane", func() {
+		// when
+		response, body := simulate(types.SimulationRequest{
+			Resources: []json.RawMessage{json.RawMessage(`
+{
+  "type": "Dataplane",
+  "mesh": "mesh1",
+  "name": "web-02",
+  "networking": {
+    "address": "192.168.0.2",
+    "inbound": [{"port": 8080, "tags": {"kuma.io/service": "web"}}]
+  }
+}`)},
+		})
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(simulator.dataplane.GetMeta().GetName()).To(Equal("web-02"))
+		Expect(simulator.resources).To(BeEmpty())
+
+		// and everything is added because there is no live configuration
+		result := types.Simulation{}
+		Expect(json.Unmarshal(body, &result)).To(Succeed())
+		Expect(result.Dataplane).To(Equal("web-02"))
+		Expect(result.Diff).To(Equal(`--- live type.googleapis.com/envoy.api.v2.Cluster
++++ simulated type.googleapis.com/envoy.api.v2.Cluster
+@@ -0,0 +1,2 @@
++- connectTimeout: 5s
++  name: backend
+`))
+	})
+
+	It("should not persist candidate resources", func() {
+		// when
+		response, _ := simulate(types.SimulationRequest{
+			Dataplane: "web-01",
+			Resources: []json.RawMessage{json.RawMessage(trafficRoute)},
+		})
+		Expect(response.StatusCode).To(Equal(200))
+
+		// then
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/traffic-routes/route-v2", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+
+	It("should return 404 for non-existing dataplane", func() {
+		// when
+		response, _ := simulate(types.SimulationRequest{
+			Dataplane: "unknown",
+		})
+
+		// then
+		Expect(response.StatusCode).To(Equal(404))
+	})
+
+	It("should validate the request", func() {
+		// when
+		response, body := simulate(types.SimulationRequest{
+			Resources: []json.RawMessage{
+				json.RawMessage(`{"type": "TrafficRoute", "mesh": "other", "name": "route"}`),
+				json.RawMessage(`{"type": "TrafficRoute", "mesh": "mesh1", "name": "route"}`),
+			},
+		})
+
+		// then
+		Expect(response.StatusCode).To(Equal(400))
+		Expect(body).To(MatchJSON(`
+		{
+			"title": "Could not process a simulation request",
+			"details": "Resource is not valid",
+			"causes": [
+				{
+					"field": "resources[0].mesh",
+					"message": "mesh from the URL has to be the same as in body"
+				},
+				{
+					"field": "resources[1].sources",
+					"message": "must have at least one element"
+				},
+				{
+					"field": "resources[1].destinations",
+					"message": "must have at least one element"
+				},
+				{
+					"field": "resources[1].conf",
+					"message": "must have at least one element"
+				}
+			]
+		}`))
+	})
+
+	It("should require a dataplane", func() {
+		// when
+		response, body := simulate(types.SimulationRequest{
+			Resources: []json.RawMessage{json.RawMessage(trafficRoute)},
+		})
+
+		// then
+		Expect(response.StatusCode).To(Equal(400))
+		Expect(string(body)).To(ContainSubstring("either a name of an existing Dataplane or a Dataplane in resources has to be given"))
+	})
+})
@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core/validators"
 )
 
 func NewMaxPageSizeExceeded(pageSize, limit int) error {
@@ -17,3 +19,13 @@ func IsMaxPageSizeExceeded(err error) bool {
 var InvalidPageSize = errors.New("Invalid page size")
 
 var PaginationNotSupported = errors.New("Pagination and filtering at the same time is not supported")
+
+// InvalidFilters is returned when query parameters filtering a list of resources are invalid
+type InvalidFilters struct {
+	validators.ValidationError
+}
+
+func IsInvalidFilters(err error) bool {
+	_, ok := err.(*InvalidFilters)
+	return ok
+}
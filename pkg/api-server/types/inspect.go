@@ -0,0 +1,36 @@
+package types
+
+// DataplanePolicies lists policies applied to a Dataplane.
+type DataplanePolicies struct {
+	Mesh      string          `json:"mesh"`
+	Dataplane string          `json:"dataplane"`
+	Items     []MatchedPolicy `json:"items"`
+}
+
+type MatchedPolicy struct {
+	Type       string           `json:"type"`
+	Name       string           `json:"name"`
+	Attachment PolicyAttachment `json:"attachment"`
+}
+
+// PolicyAttachment is a part of a Dataplane that a policy is applied to.
+type PolicyAttachment struct {
+	// One of: inbound, outbound, service, dataplane.
+	Type string `json:"type"`
+	// Inbound or outbound interface, name of a destination service or name of a Dataplane.
+	Name    string `json:"name"`
+	Service string `json:"service,omitempty"`
+}
+
+// PolicyDataplanes lists Dataplanes that a policy is applied to.
+type PolicyDataplanes struct {
+	Mesh  string             `json:"mesh"`
+	Type  string             `json:"type"`
+	Name  string             `json:"name"`
+	Items []MatchedDataplane `json:"items"`
+}
+
+type MatchedDataplane struct {
+	Dataplane   string             `json:"dataplane"`
+	Attachments []PolicyAttachment `json:"attachments"`
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"time"
+)
+
+// ServiceGraph represents observed service-to-service traffic in a Mesh.
+type ServiceGraph struct {
+	Mesh        string             `json:"mesh"`
+	GeneratedAt time.Time          `json:"generatedAt"`
+	Nodes       []ServiceGraphNode `json:"nodes"`
+	Edges       []ServiceGraphEdge `json:"edges"`
+}
+
+type ServiceGraphNode struct {
+	Service string `json:"service"`
+	// Number of online dataplanes of the service.
+	Instances int `json:"instances"`
+}
+
+type ServiceGraphEdge struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	// Requests per second.
+	RequestRate float64 `json:"requestRate"`
+	// Fraction of requests that resulted in 5xx, between 0 and 1.
+	ErrorRate float64 `json:"errorRate"`
+	// Average request latency in milliseconds.
+	LatencyMs float64 `json:"latencyMs"`
+	// New connections per second.
+	ConnectionRate float64 `json:"connectionRate"`
+}
@@ -0,0 +1,20 @@
+package types
+
+import "encoding/json"
+
+// SimulationRequest describes a Dataplane and candidate resources to simulate Envoy configuration with.
+type SimulationRequest struct {
+	// Dataplane is a name of an existing Dataplane. It is empty when a hypothetical Dataplane is given in Resources.
+	Dataplane string `json:"dataplane,omitempty"`
+	// Resources are candidate resources that are applied on top of the store for the simulation only.
+	Resources []json.RawMessage `json:"resources"`
+}
+
+// Simulation is Envoy configuration of a Dataplane generated with candidate resources.
+type Simulation struct {
+	Mesh      string         `json:"mesh"`
+	Dataplane string         `json:"dataplane"`
+	Resources []XdsResources `json:"resources"`
+	// Diff is a unified diff between the live and simulated resources, empty when candidates change nothing.
+	Diff string `json:"diff"`
+}
@@ -0,0 +1,21 @@
+package types
+
+import "encoding/json"
+
+// DataplaneXds is Envoy configuration of a Dataplane generated by the Control Plane and ACKed by Envoy.
+type DataplaneXds struct {
+	Mesh      string         `json:"mesh"`
+	Dataplane string         `json:"dataplane"`
+	Generated []XdsResources `json:"generated"`
+	Acked     []XdsResources `json:"acked"`
+	// Diff is a unified diff between ACKed and generated resources, empty when Envoy is in sync.
+	Diff string `json:"diff"`
+}
+
+// XdsResources are resources of a single xDS type, e.g. clusters.
+type XdsResources struct {
+	TypeUrl   string            `json:"typeUrl"`
+	Version   string            `json:"version"`
+	Error     string            `json:"error,omitempty"`
+	Resources []json.RawMessage `json:"resources"`
+}
@@ -0,0 +1,131 @@
+package api_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+)
+
+const mimeEventStream = "text/event-stream"
+
+// selectResourcesFn selects watched resources out of all resources of a type
+type selectResourcesFn = func(resources []model.Resource) []model.Resource
+
+func isWatchRequest(request *restful.Request) bool {
+	return request.QueryParameter("watch") == "true"
+}
+
+// watchResources streams changes of resources as ADDED, MODIFIED and DELETED events.
+// Resources of a type are listed by the hub, which shares every snapshot between all watches of the type,
+// and compared by version, therefore it works the same way for every store.
+// Events are sent as newline delimited JSON or as Server-Sent Events if a client accepts text/event-stream.
+// The stream ends when the client disconnects or the API Server is stopped.
+func watchResources(request *restful.Request, response *restful.Response, hub *watchHub, newList func() model.ResourceList, selectResources selectResourcesFn) {
+	ctx := request.Request.Context()
+	updates, unsubscribe := hub.subscribe(newList)
+	defer unsubscribe()
+
+	var snapshot watchSnapshot
+	select {
+	case <-ctx.Done():
+		return
+	case snapshot = <-updates:
+	}
+	if snapshot.err != nil {
+		rest_errors.HandleError(response, snapshot.err, "Could not watch resources")
+		return
+	}
+
+	sse := strings.Contains(request.HeaderParameter("Accept"), mimeEventStream)
+	if sse {
+		response.Header().Set("Content-Type", mimeEventStream)
+	} else {
+		response.Header().Set("Content-Type", restful.MIME_JSON)
+	}
+	response.Header().Set("Cache-Control", "no-cache")
+	response.WriteHeader(200)
+	response.Flush()
+
+	send := func(eventType rest.WatchEventType, resource model.Resource) error {
+		bytes, err := json.Marshal(rest.WatchEvent{
+			Type:   eventType,
+			Object: rest.From.Resource(resource),
+		})
+		if err != nil {
+			return err
+		}
+		if sse {
+			_, err = fmt.Fprintf(response, "event: %s\ndata: %s\n\n", eventType, bytes)
+		} else {
+			_, err = fmt.Fprintf(response, "%s\n", bytes)
+		}
+		if err != nil {
+			return err
+		}
+		response.Flush()
+		return nil
+	}
+
+	known := map[string]model.Resource{}
+	for _, resource := range selectResources(snapshot.resources) {
+		if err := send(rest.WatchEventAdded, resource); err != nil {
+			log.V(1).Info("could not send an event, closing the watch", "err", err)
+			return
+		}
+		known[watchKey(resource)] = resource
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case snapshot := <-updates:
+			if snapshot.err != nil {
+				log.Error(snapshot.err, "could not list resources, closing the watch")
+				return
+			}
+			current := map[string]model.Resource{}
+			for _, resource := range selectResources(snapshot.resources) {
+				key := watchKey(resource)
+				current[key] = resource
+				previous, ok := known[key]
+				var err error
+				switch {
+				case !ok:
+					err = send(rest.WatchEventAdded, resource)
+				case previous.GetMeta().GetVersion() != resource.GetMeta().GetVersion():
+					err = send(rest.WatchEventModified, resource)
+				}
+				if err != nil {
+					log.V(1).Info("could not send an event, closing the watch", "err", err)
+					return
+				}
+			}
+			var deleted []string
+			for key := range known {
+				if _, ok := current[key]; !ok {
+					deleted = append(deleted, key)
+				}
+			}
+			sort.Strings(deleted)
+			for _, key := range deleted {
+				if err := send(rest.WatchEventDeleted, known[key]); err != nil {
+					log.V(1).Info("could not send an event, closing the watch", "err", err)
+					return
+				}
+			}
+			known = current
+		}
+	}
+}
+
+func watchKey(resource model.Resource) string {
+	return resource.GetMeta().GetMesh() + "/" + resource.GetMeta().GetName()
+}
@@ -0,0 +1,154 @@
+package api_server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+// watchSnapshot is the state of all resources of a type at the time they were listed.
+type watchSnapshot struct {
+	resources []model.Resource
+	err       error
+}
+
+// watchHub lists resources on behalf of all watches, so the store is listed once per interval and type
+// no matter how many clients watch the type. Only types that are watched are listed.
+// When the store notifies about changes, the changed type is listed right away instead of waiting for the interval.
+// On Kubernetes, resources are listed from the informer caches of the store.
+type watchHub struct {
+	resManager manager.ReadOnlyResourceManager
+	interval   time.Duration
+
+	sync.Mutex
+	pollers map[model.ResourceType]*watchPoller
+}
+
+var _ store.ChangeHandler = &watchHub{}
+
+type watchPoller struct {
+	newList     func() model.ResourceList
+	subscribers map[chan watchSnapshot]bool
+	// last is the latest snapshot, it is sent to new subscribers right away
+	last    *watchSnapshot
+	trigger chan struct{}
+	stop    chan struct{}
+}
+
+func newWatchHub(resManager manager.ReadOnlyResourceManager, interval time.Duration) *watchHub {
+	return &watchHub{
+		resManager: resManager,
+		interval:   interval,
+		pollers:    map[model.ResourceType]*watchPoller{},
+	}
+}
+
+// subscribe returns a channel with the latest snapshot of resources of the type of a given list.
+// Snapshots that the subscriber did not receive yet are replaced by newer ones.
+// The returned function has to be called once the subscriber is not interested in snapshots anymore.
+func (h *watchHub) subscribe(newList func() model.ResourceList) (<-chan watchSnapshot, func()) {
+	resourceType := newList().GetItemType()
+	h.Lock()
+	defer h.Unlock()
+	poller, ok := h.pollers[resourceType]
+	if !ok {
+		poller = &watchPoller{
+			newList:     newList,
+			subscribers: map[chan watchSnapshot]bool{},
+			trigger:     make(chan struct{}, 1),
+			stop:        make(chan struct{}),
+		}
+		h.pollers[resourceType] = poller
+		go h.poll(poller)
+	}
+	updates := make(chan watchSnapshot, 1)
+	if poller.last != nil {
+		updates <- *poller.last
+	}
+	poller.subscribers[updates] = true
+	return updates, func() {
+		h.Lock()
+		defer h.Unlock()
+		delete(poller.subscribers, updates)
+		if len(poller.subscribers) == 0 && h.pollers[resourceType] == poller {
+			close(poller.stop)
+			delete(h.pollers, resourceType)
+		}
+	}
+}
+
+func (h *watchHub) poll(poller *watchPoller) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		list := poller.newList()
+		snapshot := watchSnapshot{}
+		if err := h.resManager.List(context.Background(), list); err != nil {
+			snapshot.err = err
+		} else {
+			snapshot.resources = list.GetItems()
+		}
+		h.Lock()
+		poller.last = &snapshot
+		for updates := range poller.subscribers {
+			// only the poller sends to the channel, so after dropping a stale snapshot the send does not block
+			select {
+			case <-updates:
+			default:
+			}
+			updates <- snapshot
+		}
+		h.Unlock()
+
+		select {
+		case <-poller.stop:
+			return
+		case <-ticker.C:
+		case <-poller.trigger:
+		}
+	}
+}
+
+func (h *watchHub) OnChange(event store.ResourceChangedEvent) {
+	h.Lock()
+	defer h.Unlock()
+	if poller, ok := h.pollers[event.Type]; ok {
+		poller.refresh()
+	}
+}
+
+func (h *watchHub) OnResync() {
+	h.Lock()
+	defer h.Unlock()
+	for _, poller := range h.pollers {
+		poller.refresh()
+	}
+}
+
+func (p *watchPoller) refresh() {
+	select {
+	case p.trigger <- struct{}{}:
+	default: // the poller is already going to list resources
+	}
+}
+
+// watchChangeListener refreshes watches as soon as resources are changed by any instance of the Control Plane.
+type watchChangeListener struct {
+	notifier store.ChangeNotifier
+	hub      *watchHub
+}
+
+var _ component.Component = &watchChangeListener{}
+
+func (w *watchChangeListener) Start(stop <-chan struct{}) error {
+	return w.notifier.Listen(stop, w.hub)
+}
+
+func (w *watchChangeListener) NeedLeaderElection() bool {
+	return false
+}
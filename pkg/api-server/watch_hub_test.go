@@ -0,0 +1,117 @@
+package api_server
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+type countingManager struct {
+	manager.ResourceManager
+	lists int32
+}
+
+func (c *countingManager) List(ctx context.Context, list model.ResourceList, fs ...store.ListOptionsFunc) error {
+	atomic.AddInt32(&c.lists, 1)
+	return c.ResourceManager.List(ctx, list, fs...)
+}
+
+var _ = Describe("Watch Hub", func() {
+
+	var resManager *countingManager
+	var hub *watchHub
+
+	newMeshList := func() model.ResourceList {
+		return &core_mesh.MeshResourceList{}
+	}
+
+	names := func(snapshot watchSnapshot) []string {
+		var names []string
+		for _, resource := range snapshot.resources {
+			names = append(names, resource.GetMeta().GetName())
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		resManager = &countingManager{ResourceManager: manager.NewResourceManager(memory.NewStore())}
+		hub = newWatchHub(resManager, time.Hour)
+		err := resManager.Create(context.Background(), &core_mesh.MeshResource{}, store.CreateByKey("mesh-1", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should list resources once for all watches of a type", func() {
+		// when
+		updates1, unsubscribe1 := hub.subscribe(newMeshList)
+		defer unsubscribe1()
+		Expect(names(<-updates1)).To(Equal([]string{"mesh-1"}))
+		updates2, unsubscribe2 := hub.subscribe(newMeshList)
+		defer unsubscribe2()
+
+		// then
+		Expect(names(<-updates2)).To(Equal([]string{"mesh-1"}))
+		Expect(atomic.LoadInt32(&resManager.lists)).To(Equal(int32(1)))
+	})
+
+	It("should list resources right after they are changed", func() {
+		// given
+		updates, unsubscribe := hub.subscribe(newMeshList)
+		defer unsubscribe()
+		Expect(names(<-updates)).To(Equal([]string{"mesh-1"}))
+
+		// when
+		err := resManager.Create(context.Background(), &core_mesh.MeshResource{}, store.CreateByKey("mesh-2", "mesh-2"))
+		Expect(err).ToNot(HaveOccurred())
+		hub.OnChange(store.ResourceChangedEvent{
+			Operation: store.CreateOperation,
+			Type:      core_mesh.MeshType,
+			Name:      "mesh-2",
+			Mesh:      "mesh-2",
+		})
+
+		// then
+		Eventually(updates).Should(Receive(WithTransform(names, Equal([]string{"mesh-1", "mesh-2"}))))
+	})
+
+	It("should not list resources of a type that is not changed", func() {
+		// given
+		updates, unsubscribe := hub.subscribe(newMeshList)
+		defer unsubscribe()
+		Expect(names(<-updates)).To(Equal([]string{"mesh-1"}))
+
+		// when
+		hub.OnChange(store.ResourceChangedEvent{
+			Operation: store.CreateOperation,
+			Type:      core_mesh.DataplaneType,
+			Name:      "dp-1",
+			Mesh:      "mesh-1",
+		})
+
+		// then
+		Consistently(updates, "100ms").ShouldNot(Receive())
+		Expect(atomic.LoadInt32(&resManager.lists)).To(Equal(int32(1)))
+	})
+
+	It("should stop listing resources when there are no watches of a type", func() {
+		// given
+		updates, unsubscribe := hub.subscribe(newMeshList)
+		Expect(names(<-updates)).To(Equal([]string{"mesh-1"}))
+
+		// when
+		unsubscribe()
+
+		// then
+		hub.Lock()
+		defer hub.Unlock()
+		Expect(hub.pollers).To(BeEmpty())
+	})
+})
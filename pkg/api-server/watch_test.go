@@ -0,0 +1,158 @@
+package api_server_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	mesh_res "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	sample_model "github.com/kumahq/kuma/pkg/test/resources/apis/sample"
+)
+
+var _ = Describe("Watch", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var client resourceApiClient
+	var stop chan struct{}
+
+	const mesh = "default"
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		serverConfig := config.DefaultApiServerConfig()
+		serverConfig.WatchInterval = 50 * time.Millisecond
+		metrics, err := core_metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		apiServer = createTestApiServer(resourceStore, serverConfig, true, metrics)
+		client = resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes/" + mesh + "/sample-traffic-routes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+
+		err = resourceStore.Create(context.Background(), &mesh_res.MeshResource{}, store.CreateByKey(mesh, mesh))
+		Expect(err).ToNot(HaveOccurred())
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	watch := func(path string, accept string) (*http.Response, *bufio.Reader) {
+		request, err := http.NewRequest("GET", client.fullAddress()+path+"?watch=true", nil)
+		Expect(err).ToNot(HaveOccurred())
+		if accept != "" {
+			request.Header.Set("Accept", accept)
+		}
+		response, err := http.DefaultClient.Do(request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(200))
+		return response, bufio.NewReader(response.Body)
+	}
+
+	nextEvent := func(reader *bufio.Reader) rest.WatchEventReceiver {
+		line, err := reader.ReadString('\n')
+		Expect(err).ToNot(HaveOccurred())
+		event := rest.WatchEventReceiver{}
+		Expect(json.Unmarshal([]byte(line), &event)).To(Succeed())
+		return event
+	}
+
+	eventName := func(event rest.WatchEventReceiver) string {
+		meta := rest.ResourceMeta{}
+		Expect(json.Unmarshal(event.Object, &meta)).To(Succeed())
+		return string(event.Type) + " " + meta.Name
+	}
+
+	It("should stream changes of the list of resources", func() {
+		// given
+		putSampleResourceIntoStore(resourceStore, "tr-1", mesh)
+
+		// when
+		response, reader := watch("", "")
+		defer response.Body.Close()
+
+		// then
+		Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+		Expect(eventName(nextEvent(reader))).To(Equal("ADDED tr-1"))
+
+		// when
+		putSampleResourceIntoStore(resourceStore, "tr-2", mesh)
+
+		// then
+		Expect(eventName(nextEvent(reader))).To(Equal("ADDED tr-2"))
+
+		// when
+		res := &sample_model.TrafficRouteResource{}
+		Expect(resourceStore.Get(context.Background(), res, store.GetByKey("tr-1", mesh))).To(Succeed())
+		res.Spec.Path = "/another-path"
+		Expect(resourceStore.Update(context.Background(), res)).To(Succeed())
+
+		// then
+		event := nextEvent(reader)
+		Expect(eventName(event)).To(Equal("MODIFIED tr-1"))
+		Expect(string(event.Object)).To(ContainSubstring(`"path":"/another-path"`))
+
+		// when
+		Expect(resourceStore.Delete(context.Background(), &sample_model.TrafficRouteResource{}, store.DeleteByKey("tr-2", mesh))).To(Succeed())
+
+		// then
+		Expect(eventName(nextEvent(reader))).To(Equal("DELETED tr-2"))
+	})
+
+	It("should stream changes of a single resource as Server-Sent Events", func() {
+		// when
+		response, reader := watch("/tr-1", "text/event-stream")
+		defer response.Body.Close()
+
+		// then
+		Expect(response.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+		// when
+		putSampleResourceIntoStore(resourceStore, "tr-1", mesh)
+		putSampleResourceIntoStore(resourceStore, "tr-2", mesh)
+
+		// then
+		eventLine, err := reader.ReadString('\n')
+		Expect(err).ToNot(HaveOccurred())
+		Expect(eventLine).To(Equal("event: ADDED\n"))
+		dataLine, err := reader.ReadString('\n')
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dataLine).To(HavePrefix("data: "))
+		Expect(dataLine).To(ContainSubstring(`"name":"tr-1"`))
+		Expect(dataLine).ToNot(ContainSubstring(`"name":"tr-2"`))
+	})
+
+	It("should finish the watch when the server is stopped", func() {
+		// given
+		response, reader := watch("", "")
+		defer response.Body.Close()
+
+		// when
+		close(stop)
+		stop = make(chan struct{})
+
+		// then
+		_, err := reader.ReadString('\n')
+		Expect(err).To(HaveOccurred())
+		Expect(strings.Contains(err.Error(), "EOF")).To(BeTrue())
+	})
+})
@@ -0,0 +1,210 @@
+package api_server
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v2"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// xdsTypes are types of resources included in the config dump, sorted by the type URL.
+// Secrets are never included, so the config dump does not reveal private keys.
+var xdsTypes = []struct {
+	typeUrl      string
+	responseType envoy_types.ResponseType
+}{
+	{typeUrl: envoy_resource.ClusterType, responseType: envoy_types.Cluster},
+	{typeUrl: envoy_resource.EndpointType, responseType: envoy_types.Endpoint},
+	{typeUrl: envoy_resource.ListenerType, responseType: envoy_types.Listener},
+	{typeUrl: envoy_resource.RouteType, responseType: envoy_types.Route},
+}
+
+type xdsEndpoints struct {
+	resManager manager.ResourceManager
+	authorizer rbac.Authorizer
+	xds        core_xds.XdsContext
+}
+
+func (x *xdsEndpoints) addFindEndpoint(ws *restful.WebService) {
+	ws.Route(ws.GET("/meshes/{mesh}/dataplanes/{name}/xds").To(x.inspectXds).
+		Doc("Inspect Envoy configuration generated for a dataplane and ACKed by it").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (x *xdsEndpoints) inspectXds(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	meshName := request.PathParameter("mesh")
+
+	if err := authorize(x.authorizer, request, system.VerbGet, mesh.DataplaneType, meshName); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve Envoy configuration")
+		return
+	}
+	if err := x.resManager.Get(request.Request.Context(), &mesh.DataplaneResource{}, store.GetByKey(name, meshName)); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve Envoy configuration")
+		return
+	}
+
+	proxyId := core_xds.ProxyId{Mesh: meshName, Name: name}
+	result := types.DataplaneXds{
+		Mesh:      meshName,
+		Dataplane: name,
+		Generated: []types.XdsResources{},
+		Acked:     []types.XdsResources{},
+	}
+	// there is no snapshot when the dataplane is not connected
+	if snapshot, err := x.xds.Cache().GetSnapshot(proxyId.String()); err == nil {
+		generated, err := snapshotResources(snapshot)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not retrieve Envoy configuration")
+			return
+		}
+		result.Generated = generated
+	}
+	if acked, ok := x.xds.ConfigTracker().AckedConfig(proxyId); ok {
+		for _, discoveryResources := range acked {
+			if discoveryResources.TypeUrl == envoy_resource.SecretType {
+				continue
+			}
+			resources, err := ackedResources(discoveryResources)
+			if err != nil {
+				rest_errors.HandleError(response, err, "Could not retrieve Envoy configuration")
+				return
+			}
+			result.Acked = append(result.Acked, resources)
+		}
+	}
+	diff, err := xdsDiff(result.Acked, result.Generated, "acked", "generated")
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve Envoy configuration")
+		return
+	}
+	result.Diff = diff
+
+	if err := response.WriteAsJson(result); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve Envoy configuration")
+	}
+}
+
+func snapshotResources(snapshot envoy_cache.Snapshot) ([]types.XdsResources, error) {
+	var result []types.XdsResources
+	for _, xdsType := range xdsTypes {
+		resources, err := generatedResources(xdsType.typeUrl, snapshot.Resources[xdsType.responseType])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resources)
+	}
+	return result, nil
+}
+
+func generatedResources(typeUrl string, resources envoy_cache.Resources) (types.XdsResources, error) {
+	var names []string
+	for name := range resources.Items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result := types.XdsResources{
+		TypeUrl:   typeUrl,
+		Version:   resources.Version,
+		Resources: []json.RawMessage{},
+	}
+	for _, name := range names {
+		bytes, err := util_proto.ToJSON(resources.Items[name])
+		if err != nil {
+			return types.XdsResources{}, err
+		}
+		result.Resources = append(result.Resources, bytes)
+	}
+	return result, nil
+}
+
+func ackedResources(acked core_xds.DiscoveryResources) (types.XdsResources, error) {
+	items := map[string]envoy_types.Resource{}
+	for _, res := range acked.Resources {
+		msg := &ptypes.DynamicAny{}
+		if err := ptypes.UnmarshalAny(res, msg); err != nil {
+			return types.XdsResources{}, err
+		}
+		items[envoy_cache.GetResourceName(msg.Message)] = msg.Message
+	}
+	result, err := generatedResources(acked.TypeUrl, envoy_cache.Resources{Version: acked.Version, Items: items})
+	if err != nil {
+		return types.XdsResources{}, err
+	}
+	result.Error = acked.Error
+	return result, nil
+}
+
+// xdsDiff compares resources of every type in the unified diff format.
+func xdsDiff(from []types.XdsResources, to []types.XdsResources, fromLabel string, toLabel string) (string, error) {
+	fromByType := map[string]types.XdsResources{}
+	for _, resources := range from {
+		fromByType[resources.TypeUrl] = resources
+	}
+	var diffs []string
+	for _, resources := range to {
+		fromYAML, err := resourcesYAML(fromByType[resources.TypeUrl].Resources)
+		if err != nil {
+			return "", err
+		}
+		toYAML, err := resourcesYAML(resources.Resources)
+		if err != nil {
+			return "", err
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        yamlLines(fromYAML),
+			B:        yamlLines(toYAML),
+			FromFile: fromLabel + " " + resources.TypeUrl,
+			ToFile:   toLabel + " " + resources.TypeUrl,
+			Context:  3,
+		})
+		if err != nil {
+			return "", err
+		}
+		diffs = append(diffs, diff)
+	}
+	return strings.Join(diffs, ""), nil
+}
+
+func resourcesYAML(resources []json.RawMessage) (string, error) {
+	if len(resources) == 0 {
+		return "", nil
+	}
+	bytes, err := json.Marshal(resources)
+	if err != nil {
+		return "", err
+	}
+	bytes, err = yaml.JSONToYAML(bytes)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+func yamlLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	// SplitLines would return an additional empty line for the trailing new line
+	return difflib.SplitLines(strings.TrimSuffix(s, "\n"))
+}
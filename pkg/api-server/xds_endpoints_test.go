@@ -0,0 +1,177 @@
+package api_server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v2"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	"github.com/kumahq/kuma/pkg/api-server/definitions"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+var _ = Describe("Xds Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var xdsContext core_xds.XdsContext
+	var stop chan struct{}
+
+	cluster := func(timeout time.Duration) *envoy.Cluster {
+		return &envoy.Cluster{
+			Name:           "backend",
+			ConnectTimeout: ptypes.DurationProto(timeout),
+		}
+	}
+
+	BeforeEach(func() {
+		resourceStore := memory.NewStore()
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+
+		port, err := test.GetFreePort()
+		Expect(err).NotTo(HaveOccurred())
+		cfg := kuma_cp.DefaultConfig()
+		cfg.ApiServer = config.DefaultApiServerConfig()
+		cfg.ApiServer.Port = port
+
+		xdsContext = core_xds.NewXdsContext()
+		apiServer, err = api_server.NewApiServer(manager.NewResourceManager(resourceStore), definitions.All, &cfg, true, metrics, nil, xdsContext, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = resourceStore.Create(context.Background(), &mesh_core.MeshResource{}, store.CreateByKey("mesh1", "mesh1"))
+		Expect(err).ToNot(HaveOccurred())
+		for _, name := range []string{"web-01", "web-02"} {
+			dataplane := &mesh_core.DataplaneResource{
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{Port: 8080, Tags: map[string]string{"kuma.io/service": "web"}},
+						},
+					},
+				},
+			}
+			err := resourceStore.Create(context.Background(), dataplane, store.CreateByKey(name, "mesh1"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	It("should return generated and ACKed configuration with a diff", func() {
+		// given generated configuration
+		snapshot := envoy_cache.Snapshot{}
+		snapshot.Resources[envoy_types.Cluster] = envoy_cache.NewResources("v2", []envoy_types.Resource{cluster(5 * time.Second)})
+		Expect(xdsContext.Cache().SetSnapshot("mesh1.web-01", snapshot)).To(Succeed())
+
+		// and configuration ACKed by Envoy
+		acked, err := ptypes.MarshalAny(cluster(time.Second))
+		Expect(err).ToNot(HaveOccurred())
+		tracker := xdsContext.ConfigTracker()
+		Expect(tracker.OnStreamRequest(1, &envoy.DiscoveryRequest{
+			Node:    &envoy_core.Node{Id: "mesh1.web-01"},
+			TypeUrl: envoy_resource.ClusterType,
+		})).To(Succeed())
+		tracker.OnStreamResponse(1, nil, &envoy.DiscoveryResponse{
+			TypeUrl:     envoy_resource.ClusterType,
+			VersionInfo: "v1",
+			Nonce:       "1",
+			Resources:   []*any.Any{acked},
+		})
+		Expect(tracker.OnStreamRequest(1, &envoy.DiscoveryRequest{
+			TypeUrl:       envoy_resource.ClusterType,
+			VersionInfo:   "v1",
+			ResponseNonce: "1",
+		})).To(Succeed())
+
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/dataplanes/web-01/xds", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		result := types.DataplaneXds{}
+		Expect(json.Unmarshal(body, &result)).To(Succeed())
+		Expect(result.Generated).To(HaveLen(4))
+		Expect(result.Generated[0].TypeUrl).To(Equal(envoy_resource.ClusterType))
+		Expect(result.Generated[0].Version).To(Equal("v2"))
+		Expect(result.Generated[0].Resources).To(HaveLen(1))
+		Expect(result.Generated[0].Resources[0]).To(MatchJSON(`{"name": "backend", "connectTimeout": "5s"}`))
+		Expect(result.Acked).To(HaveLen(1))
+		Expect(result.Acked[0].Version).To(Equal("v1"))
+		Expect(result.Acked[0].Resources[0]).To(MatchJSON(`{"name": "backend", "connectTimeout": "1s"}`))
+		Expect(result.Diff).To(Equal(`--- acked type.googleapis.com/envoy.api.v2.Cluster
++++ generated type.googleapis.com/envoy.api.v2.Cluster
+@@ -1,2 +1,2 @@
+-- connectTimeout: 1s
++- connectTimeout: 5s
+   name: backend
+`))
+	})
+
+	It("should return empty configuration of a dataplane that is not connected", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/dataplanes/web-02/xds", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`
+		{
+			"mesh": "mesh1",
+			"dataplane": "web-02",
+			"generated": [],
+			"acked": [],
+			"diff": ""
+		}`))
+	})
+
+	It("should return 404 for non-existing dataplane", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/dataplanes/unknown/xds", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(404))
+	})
+})
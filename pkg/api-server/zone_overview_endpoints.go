@@ -7,6 +7,7 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/rbac"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
@@ -18,6 +19,7 @@ import (
 type zoneOverviewEndpoints struct {
 	publicURL  string
 	resManager manager.ResourceManager
+	authorizer rbac.Authorizer
 }
 
 func (r *zoneOverviewEndpoints) addFindEndpoint(ws *restful.WebService) {
@@ -29,7 +31,7 @@ func (r *zoneOverviewEndpoints) addFindEndpoint(ws *restful.WebService) {
 }
 
 func (r *zoneOverviewEndpoints) addListEndpoint(ws *restful.WebService) {
-	ws.Route(ws.GET("/zones+insights").To(r.inspectZones).
+	ws.Route(addFilterParams(ws, ws.GET("/zones+insights").To(r.inspectZones)).
 		Doc("Inspect all zones").
 		Returns(200, "OK", nil))
 }
@@ -37,6 +39,11 @@ func (r *zoneOverviewEndpoints) addListEndpoint(ws *restful.WebService) {
 func (r *zoneOverviewEndpoints) inspectZone(request *restful.Request, response *restful.Response) {
 	name := request.PathParameter("name")
 
+	if err := authorize(r.authorizer, request, system.VerbGet, system.ZoneType, ""); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a zone overview")
+		return
+	}
+
 	overview, err := r.fetchOverview(request.Request.Context(), name)
 	if err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve a zone overview")
@@ -71,13 +78,24 @@ func (r *zoneOverviewEndpoints) fetchOverview(ctx context.Context, name string)
 }
 
 func (r *zoneOverviewEndpoints) inspectZones(request *restful.Request, response *restful.Response) {
+	if err := authorize(r.authorizer, request, system.VerbList, system.ZoneType, ""); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve zone overviews")
+		return
+	}
+
 	page, err := pagination(request)
 	if err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve dataplane overviews")
 		return
 	}
 
-	overviews, err := r.fetchOverviews(request.Request.Context(), page, core_model.DefaultMesh)
+	filters, err := listFilters(request)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve zone overviews")
+		return
+	}
+
+	overviews, err := r.fetchOverviews(request.Request.Context(), page, core_model.DefaultMesh, filters)
 	if err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve dataplane overviews")
 		return
@@ -97,9 +115,9 @@ func (r *zoneOverviewEndpoints) inspectZones(request *restful.Request, response
 	}
 }
 
-func (r *zoneOverviewEndpoints) fetchOverviews(ctx context.Context, p page, meshName string) (system.ZoneOverviewResourceList, error) {
+func (r *zoneOverviewEndpoints) fetchOverviews(ctx context.Context, p page, meshName string, filters []store.ListOptionsFunc) (system.ZoneOverviewResourceList, error) {
 	zones := system.ZoneResourceList{}
-	if err := r.resManager.List(ctx, &zones, store.ListByMesh(meshName), store.ListByPage(p.size, p.offset)); err != nil {
+	if err := r.resManager.List(ctx, &zones, append(filters, store.ListByMesh(meshName), store.ListByPage(p.size, p.offset))...); err != nil {
 		return system.ZoneOverviewResourceList{}, err
 	}
 
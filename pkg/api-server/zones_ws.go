@@ -5,6 +5,7 @@ import (
 
 	"github.com/emicklei/go-restful"
 
+	"github.com/kumahq/kuma/pkg/core/rbac"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
@@ -20,9 +21,14 @@ type Zone struct {
 
 type Zones []Zone
 
-func zonesWs(resManager manager.ResourceManager) *restful.WebService {
+func zonesWs(resManager manager.ResourceManager, authorizer rbac.Authorizer) *restful.WebService {
 	ws := new(restful.WebService).Path("/status/zones")
 	return ws.Route(ws.GET("").To(func(request *restful.Request, response *restful.Response) {
+		if err := authorize(authorizer, request, system.VerbList, system.ZoneType, ""); err != nil {
+			rest_errors.HandleError(response, err, "Could not retrieve a zone overview")
+			return
+		}
+
 		zoneOverviews, err := fetchOverviews(resManager, request.Request.Context())
 		if err != nil {
 			rest_errors.HandleError(response, err, "Could not retrieve a zone overview")
@@ -1,7 +1,9 @@
 package api_server
 
 import (
-	"errors"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/config"
 	"github.com/kumahq/kuma/pkg/config/api-server/catalog"
@@ -19,15 +21,88 @@ type ApiServerConfig struct {
 	Catalog *catalog.CatalogConfig `yaml:"catalog"`
 	// Allowed domains for Cross-Origin Resource Sharing. The value can be either domain or regexp
 	CorsAllowedDomains []string `yaml:"corsAllowedDomains" envconfig:"kuma_api_server_cors_allowed_domains"`
+	// HTTPS configuration of the API Server
+	HTTPS *ApiServerHTTPSConfig `yaml:"https"`
+	// Authentication configuration of the API Server
+	Auth *ApiServerAuthConfig `yaml:"auth"`
+	// How often resources are checked for changes when a client watches them with ?watch=true
+	WatchInterval time.Duration `yaml:"watchInterval" envconfig:"kuma_api_server_watch_interval"`
+}
+
+// API Server HTTPS configuration
+type ApiServerHTTPSConfig struct {
+	// If true then API Server is also exposed over HTTPS
+	Enabled bool `yaml:"enabled" envconfig:"kuma_api_server_https_enabled"`
+	// Port on which the HTTPS server will be exposed
+	Port uint32 `yaml:"port" envconfig:"kuma_api_server_https_port"`
+	// Path to TLS certificate file
+	TlsCertFile string `yaml:"tlsCertFile" envconfig:"kuma_api_server_https_tls_cert_file"`
+	// Path to TLS key file
+	TlsKeyFile string `yaml:"tlsKeyFile" envconfig:"kuma_api_server_https_tls_key_file"`
+}
+
+var _ config.Config = &ApiServerHTTPSConfig{}
+
+func (h *ApiServerHTTPSConfig) Sanitize() {
+}
+
+func (h *ApiServerHTTPSConfig) Validate() error {
+	if h.Port > 65535 {
+		return errors.New("Port must be in the range [0, 65535]")
+	}
+	if h.Enabled {
+		if h.TlsCertFile == "" {
+			return errors.New("TlsCertFile cannot be empty if HTTPS is enabled")
+		}
+		if h.TlsKeyFile == "" {
+			return errors.New("TlsKeyFile cannot be empty if HTTPS is enabled")
+		}
+	}
+	return nil
+}
+
+// API Server authentication configuration
+type ApiServerAuthConfig struct {
+	// If true then every request to the API Server has to be authenticated and authorized with Roles.
+	// Users can authenticate with a client certificate, a User Token or a username and password from the htpasswd file.
+	Enabled bool `yaml:"enabled" envconfig:"kuma_api_server_auth_enabled"`
+	// Directory of CA certificates used to verify client certificates. Common Name of the certificate is a name of the user
+	// and Organizations are groups of the user. Client certificates can be used only with HTTPS.
+	ClientCertsDir string `yaml:"clientCertsDir" envconfig:"kuma_api_server_auth_client_certs_dir"`
+	// Path to htpasswd file with users that can authenticate with Basic authentication. Only bcrypt and SHA1 passwords are supported.
+	HtpasswdFile string `yaml:"htpasswdFile" envconfig:"kuma_api_server_auth_htpasswd_file"`
+}
+
+var _ config.Config = &ApiServerAuthConfig{}
+
+func (a *ApiServerAuthConfig) Sanitize() {
+}
+
+func (a *ApiServerAuthConfig) Validate() error {
+	return nil
 }
 
 func (a *ApiServerConfig) Sanitize() {
+	a.HTTPS.Sanitize()
+	a.Auth.Sanitize()
 }
 
 func (a *ApiServerConfig) Validate() error {
 	if a.Port < 0 {
 		return errors.New("Port cannot be negative")
 	}
+	if err := a.HTTPS.Validate(); err != nil {
+		return errors.Wrap(err, "HTTPS validation failed")
+	}
+	if err := a.Auth.Validate(); err != nil {
+		return errors.Wrap(err, "Auth validation failed")
+	}
+	if a.Auth.ClientCertsDir != "" && !a.HTTPS.Enabled {
+		return errors.New("Auth.ClientCertsDir requires HTTPS to be enabled")
+	}
+	if a.WatchInterval <= 0 {
+		return errors.New("WatchInterval must be positive")
+	}
 	return nil
 }
 
@@ -37,5 +112,17 @@ func DefaultApiServerConfig() *ApiServerConfig {
 		ReadOnly:           false,
 		Catalog:            &catalog.CatalogConfig{},
 		CorsAllowedDomains: []string{".*"},
+		HTTPS: &ApiServerHTTPSConfig{
+			Enabled:     false,
+			Port:        5682,
+			TlsCertFile: "",
+			TlsKeyFile:  "",
+		},
+		Auth: &ApiServerAuthConfig{
+			Enabled:        false,
+			ClientCertsDir: "",
+			HtpasswdFile:   "",
+		},
+		WatchInterval: 1 * time.Second,
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/kumahq/kuma/pkg/config"
 	admin_server "github.com/kumahq/kuma/pkg/config/admin-server"
 	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/config/audit"
 	"github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
 	dns_server "github.com/kumahq/kuma/pkg/config/dns-server"
@@ -40,6 +41,8 @@ func (d *Defaults) Validate() error {
 type Metrics struct {
 	Dataplane *DataplaneMetrics `yaml:"dataplane"`
 	Zone      *ZoneMetrics      `yaml:"zone"`
+	// ServiceGraph configures the service graph built out of traffic stats reported by Dataplanes
+	ServiceGraph *ServiceGraphMetrics `yaml:"serviceGraph"`
 }
 
 func (m *Metrics) Sanitize() {
@@ -49,6 +52,9 @@ func (m *Metrics) Validate() error {
 	if err := m.Dataplane.Validate(); err != nil {
 		return errors.Wrap(err, "Dataplane validation failed")
 	}
+	if err := m.ServiceGraph.Validate(); err != nil {
+		return errors.Wrap(err, "ServiceGraph validation failed")
+	}
 	return nil
 }
 
@@ -82,6 +88,26 @@ func (d *ZoneMetrics) Validate() error {
 	return nil
 }
 
+type ServiceGraphMetrics struct {
+	// How often Dataplanes report traffic stats of their upstream clusters
+	ReportInterval time.Duration `yaml:"reportInterval" envconfig:"kuma_metrics_service_graph_report_interval"`
+	// Traffic stats of a Dataplane that were not reported for this long are not included in the service graph
+	ExpirationTime time.Duration `yaml:"expirationTime" envconfig:"kuma_metrics_service_graph_expiration_time"`
+}
+
+func (s *ServiceGraphMetrics) Sanitize() {
+}
+
+func (s *ServiceGraphMetrics) Validate() error {
+	if s.ReportInterval <= 0 {
+		return errors.New("ReportInterval must be positive")
+	}
+	if s.ExpirationTime <= s.ReportInterval {
+		return errors.New("ExpirationTime must be greater than ReportInterval")
+	}
+	return nil
+}
+
 type Reports struct {
 	// If true then usage stats will be reported
 	Enabled bool `yaml:"enabled" envconfig:"kuma_reports_enabled"`
@@ -124,6 +150,8 @@ type Config struct {
 	DNSServer *dns_server.DNSServerConfig `yaml:"dnsServer,omitempty"`
 	// Diagnostics configuration
 	Diagnostics *diagnostics.DiagnosticsConfig `yaml:"diagnostics,omitempty"`
+	// Audit log configuration
+	Audit *audit.AuditConfig `yaml:"audit,omitempty"`
 }
 
 func (c *Config) Sanitize() {
@@ -142,6 +170,7 @@ func (c *Config) Sanitize() {
 	c.DNSServer.Sanitize()
 	c.Multicluster.Sanitize()
 	c.Diagnostics.Sanitize()
+	c.Audit.Sanitize()
 }
 
 func DefaultConfig() Config {
@@ -168,6 +197,10 @@ func DefaultConfig() Config {
 				Enabled:           true,
 				SubscriptionLimit: 10,
 			},
+			ServiceGraph: &ServiceGraphMetrics{
+				ReportInterval: 30 * time.Second,
+				ExpirationTime: 90 * time.Second,
+			},
 		},
 		Reports: &Reports{
 			Enabled: true,
@@ -177,6 +210,7 @@ func DefaultConfig() Config {
 		DNSServer:    dns_server.DefaultDNSServerConfig(),
 		Multicluster: multicluster.DefaultMulticlusterConfig(),
 		Diagnostics:  diagnostics.DefaultDiagnosticsConfig(),
+		Audit:        audit.DefaultAuditConfig(),
 	}
 }
 
@@ -261,6 +295,9 @@ func (c *Config) Validate() error {
 	if err := c.Diagnostics.Validate(); err != nil {
 		return errors.Wrap(err, "Diagnostics validation failed")
 	}
+	if err := c.Audit.Validate(); err != nil {
+		return errors.Wrap(err, "Audit validation failed")
+	}
 	return nil
 }
 
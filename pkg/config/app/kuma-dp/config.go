@@ -144,6 +144,19 @@ type Dataplane struct {
 	AdminPort config_types.PortRange `yaml:"adminPort,omitempty" envconfig:"kuma_dataplane_admin_port"`
 	// Drain time for listeners.
 	DrainTime time.Duration `yaml:"drainTime,omitempty" envconfig:"kuma_dataplane_drain_time"`
+	// Number of worker threads of Envoy.
+	// Zero value indicates that Envoy runs a worker thread per hardware thread.
+	Concurrency uint32 `yaml:"concurrency,omitempty" envconfig:"kuma_dataplane_concurrency"`
+	// Port for the lifecycle server of Kuma DP to listen on (on 127.0.0.1).
+	// The lifecycle server lets a process manager (e.g. pre-stop hook on Kubernetes) drain the Dataplane before shutdown.
+	// Zero value indicates that the lifecycle server is disabled.
+	LifecyclePort uint32 `yaml:"lifecyclePort,omitempty" envconfig:"kuma_dataplane_lifecycle_port"`
+	// If true, the Dataplane is ready only when the application accepts connections on all inbound ports.
+	ReadinessCheckInbound bool `yaml:"readinessCheckInbound,omitempty" envconfig:"kuma_dataplane_readiness_check_inbound"`
+	// How often destinations reached through the passthrough cluster are reported to the Control Plane,
+	// so they are learned by Dependencies that select the Dataplane.
+	// Zero value indicates that destinations are not reported.
+	DependenciesReportInterval time.Duration `yaml:"dependenciesReportInterval,omitempty" envconfig:"kuma_dataplane_dependencies_report_interval"`
 }
 
 // DataplaneRuntime defines the context in which dataplane (Envoy) runs.
@@ -218,6 +231,9 @@ func (d *Dataplane) Validate() (errs error) {
 	if d.DrainTime <= 0 {
 		errs = multierr.Append(errs, errors.Errorf(".DrainTime must be positive"))
 	}
+	if d.DependenciesReportInterval < 0 {
+		errs = multierr.Append(errs, errors.Errorf(".DependenciesReportInterval must not be negative"))
+	}
 	return
 }
 
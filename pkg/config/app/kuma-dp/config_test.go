@@ -30,6 +30,10 @@ var _ = Describe("Config", func() {
 		Expect(cfg.ControlPlane.ApiServer.URL).To(Equal("https://kuma-control-plane.internal:5682"))
 		Expect(cfg.Dataplane.AdminPort).To(Equal(config_types.MustExactPort(2345)))
 		Expect(cfg.Dataplane.DrainTime).To(Equal(60 * time.Second))
+		Expect(cfg.Dataplane.Concurrency).To(Equal(uint32(4)))
+		Expect(cfg.Dataplane.LifecyclePort).To(Equal(uint32(9902)))
+		Expect(cfg.Dataplane.ReadinessCheckInbound).To(BeTrue())
+		Expect(cfg.Dataplane.DependenciesReportInterval).To(Equal(30 * time.Second))
 	})
 
 	Context("with modified environment variables", func() {
@@ -60,6 +64,10 @@ var _ = Describe("Config", func() {
 				"KUMA_DATAPLANE_NAME":                                    "example",
 				"KUMA_DATAPLANE_ADMIN_PORT":                              "2345",
 				"KUMA_DATAPLANE_DRAIN_TIME":                              "60s",
+				"KUMA_DATAPLANE_CONCURRENCY":                             "4",
+				"KUMA_DATAPLANE_LIFECYCLE_PORT":                          "9902",
+				"KUMA_DATAPLANE_READINESS_CHECK_INBOUND":                 "true",
+				"KUMA_DATAPLANE_DEPENDENCIES_REPORT_INTERVAL":            "30s",
 				"KUMA_DATAPLANE_RUNTIME_BINARY_PATH":                     "envoy.sh",
 				"KUMA_DATAPLANE_RUNTIME_CONFIG_DIR":                      "/var/run/envoy",
 				"KUMA_DATAPLANE_RUNTIME_TOKEN_PATH":                      "/tmp/token",
@@ -87,6 +95,10 @@ var _ = Describe("Config", func() {
 			Expect(cfg.Dataplane.Name).To(Equal("example"))
 			Expect(cfg.Dataplane.AdminPort).To(Equal(config_types.MustExactPort(2345)))
 			Expect(cfg.Dataplane.DrainTime).To(Equal(60 * time.Second))
+			Expect(cfg.Dataplane.Concurrency).To(Equal(uint32(4)))
+			Expect(cfg.Dataplane.LifecyclePort).To(Equal(uint32(9902)))
+			Expect(cfg.Dataplane.ReadinessCheckInbound).To(BeTrue())
+			Expect(cfg.Dataplane.DependenciesReportInterval).To(Equal(30 * time.Second))
 			Expect(cfg.DataplaneRuntime.BinaryPath).To(Equal("envoy.sh"))
 			Expect(cfg.DataplaneRuntime.ConfigDir).To(Equal("/var/run/envoy"))
 			Expect(cfg.DataplaneRuntime.TokenPath).To(Equal("/tmp/token"))
@@ -172,7 +172,21 @@ func (m *ControlPlaneCoordinates) GetApiServer() *ControlPlaneCoordinates_ApiSer
 
 type ControlPlaneCoordinates_ApiServer struct {
 	// URL defines URL of the Control Plane API Server.
-	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// ClientCertFile defines path to certificate of a client used to
+	// authenticate to the API Server.
+	ClientCertFile string `protobuf:"bytes,2,opt,name=client_cert_file,json=clientCertFile,proto3" json:"client_cert_file,omitempty"`
+	// ClientKeyFile defines path to certificate key of a client used to
+	// authenticate to the API Server.
+	ClientKeyFile string `protobuf:"bytes,3,opt,name=client_key_file,json=clientKeyFile,proto3" json:"client_key_file,omitempty"`
+	// AuthToken defines User Token used to authenticate to the API Server.
+	AuthToken string `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	// Username defines name of a user used to authenticate to the API Server
+	// with Basic authentication.
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	// Password defines password of a user used to authenticate to the API
+	// Server with Basic authentication.
+	Password             string   `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -210,6 +224,41 @@ func (m *ControlPlaneCoordinates_ApiServer) GetUrl() string {
 	return ""
 }
 
+func (m *ControlPlaneCoordinates_ApiServer) GetClientCertFile() string {
+	if m != nil {
+		return m.ClientCertFile
+	}
+	return ""
+}
+
+func (m *ControlPlaneCoordinates_ApiServer) GetClientKeyFile() string {
+	if m != nil {
+		return m.ClientKeyFile
+	}
+	return ""
+}
+
+func (m *ControlPlaneCoordinates_ApiServer) GetAuthToken() string {
+	if m != nil {
+		return m.AuthToken
+	}
+	return ""
+}
+
+func (m *ControlPlaneCoordinates_ApiServer) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ControlPlaneCoordinates_ApiServer) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
 // Context defines a context in which individual `kumactl` commands run.
 type Context struct {
 	// Name defines a reference name for a given context.
@@ -425,36 +474,40 @@ func init() {
 }
 
 var fileDescriptor_18c2b02c7dd453f4 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xd1, 0x6e, 0xd3, 0x3c,
-	0x18, 0x95, 0x97, 0xfe, 0xff, 0xd2, 0x2f, 0xeb, 0x40, 0xe6, 0xa2, 0x51, 0x10, 0x50, 0x55, 0x42,
-	0x2b, 0x52, 0x95, 0xb0, 0xc2, 0x15, 0xe2, 0xa6, 0x09, 0x5c, 0x81, 0xd0, 0x14, 0xc4, 0x0d, 0x42,
-	0x8a, 0x4c, 0xe2, 0x6d, 0x56, 0x53, 0xc7, 0x72, 0x9c, 0x6a, 0x7b, 0x03, 0xe0, 0x45, 0x78, 0x10,
-	0x78, 0xaa, 0x5d, 0xa1, 0x38, 0x4e, 0x67, 0x24, 0xb6, 0xc2, 0x9d, 0x7d, 0x7c, 0xce, 0xf9, 0x8e,
-	0xbf, 0xcf, 0x86, 0xb9, 0x58, 0x9d, 0x45, 0x79, 0xc5, 0x4f, 0xd9, 0x59, 0x44, 0x84, 0x88, 0x56,
-	0xcd, 0x9a, 0xe4, 0xaa, 0x8c, 0x36, 0xc7, 0xa4, 0x14, 0xe7, 0xe4, 0xd8, 0x9c, 0x85, 0x42, 0x56,
-	0xaa, 0xc2, 0x63, 0x73, 0x1c, 0x1a, 0xb4, 0x67, 0x05, 0xe3, 0x0d, 0x29, 0x59, 0x41, 0x14, 0x8d,
-	0xfa, 0x45, 0xa7, 0x98, 0xfe, 0x44, 0x30, 0x4a, 0x34, 0xb9, 0x91, 0x44, 0xb1, 0x8a, 0xe3, 0xb7,
-	0x70, 0x98, 0x57, 0x5c, 0xc9, 0xaa, 0xcc, 0x44, 0x49, 0x38, 0xad, 0x7d, 0x34, 0x71, 0x66, 0xde,
-	0xe2, 0x71, 0x78, 0x83, 0x79, 0x98, 0x74, 0xf4, 0x93, 0x96, 0x9d, 0x8e, 0x72, 0x6b, 0x57, 0xe3,
-	0x97, 0xe0, 0xb6, 0x00, 0xbd, 0x50, 0xb5, 0xbf, 0xa7, 0x7d, 0x26, 0xb7, 0xfa, 0xd0, 0x0b, 0x95,
-	0x6e, 0x15, 0xf8, 0x08, 0xee, 0xe4, 0x8d, 0x94, 0x94, 0xab, 0xcc, 0x60, 0xbe, 0x33, 0x41, 0xb3,
-	0x61, 0x7a, 0x68, 0x60, 0x23, 0x99, 0x7e, 0x45, 0x70, 0x60, 0xc7, 0xc0, 0xf7, 0x61, 0xc0, 0xc9,
-	0x9a, 0xfa, 0xa8, 0xa5, 0xc7, 0xfb, 0x57, 0xf1, 0x40, 0xee, 0xdd, 0x45, 0xa9, 0x06, 0xf1, 0x27,
-	0xf0, 0xf2, 0xaa, 0x92, 0x05, 0xe3, 0x44, 0xd1, 0x36, 0x17, 0x9a, 0x79, 0x8b, 0xa7, 0x7f, 0x75,
-	0xbf, 0xe4, 0x5a, 0x17, 0xbb, 0x57, 0xf1, 0x7f, 0xdf, 0x50, 0x6b, 0x6b, 0xdb, 0x4d, 0xbf, 0x23,
-	0x18, 0xdf, 0x20, 0xc1, 0x39, 0x00, 0x11, 0x2c, 0xab, 0xa9, 0xdc, 0x50, 0xa9, 0xc3, 0x79, 0x8b,
-	0x17, 0xff, 0x5a, 0x38, 0x5c, 0x0a, 0xf6, 0x5e, 0x3b, 0x58, 0x11, 0x86, 0xa4, 0x07, 0x83, 0x23,
-	0x18, 0x6e, 0x19, 0x38, 0x00, 0xa7, 0x91, 0xa5, 0xe9, 0x43, 0x4b, 0x97, 0xce, 0x17, 0x84, 0xd2,
-	0x16, 0x9c, 0xfe, 0x70, 0x60, 0xdf, 0x74, 0xf0, 0xf6, 0x86, 0xcd, 0x61, 0xf4, 0xdb, 0x9b, 0xd0,
-	0x2d, 0xb3, 0x58, 0x07, 0xf6, 0xd0, 0xf1, 0x6b, 0x70, 0x0b, 0x7a, 0x4a, 0x9a, 0x52, 0xd5, 0x7a,
-	0x5c, 0xde, 0xe2, 0xc9, 0xae, 0x99, 0x87, 0xaf, 0x8c, 0x20, 0xdd, 0x4a, 0xf1, 0x3b, 0xf0, 0x72,
-	0x49, 0x0b, 0xca, 0x15, 0x23, 0x65, 0xed, 0x0f, 0xb4, 0xd3, 0x7c, 0xa7, 0x53, 0x72, 0xad, 0x49,
-	0x6d, 0x83, 0xe0, 0x21, 0xb8, 0x7d, 0x15, 0x8c, 0x61, 0xb0, 0xa6, 0xf5, 0x79, 0x77, 0xdb, 0x54,
-	0xaf, 0x83, 0x0c, 0x3c, 0x4b, 0x8b, 0x4f, 0xc0, 0x25, 0xc5, 0x9a, 0xf1, 0xa5, 0x60, 0x66, 0x50,
-	0xcf, 0x77, 0xd6, 0x5e, 0x1a, 0x81, 0x9d, 0x61, 0xeb, 0x12, 0x7c, 0x80, 0x7b, 0x7f, 0x20, 0xe0,
-	0x47, 0xe0, 0xe5, 0x25, 0xd3, 0x6f, 0x9c, 0x4a, 0xd5, 0xb5, 0x36, 0x85, 0x0e, 0x4a, 0xa8, 0x54,
-	0xf8, 0x01, 0x98, 0x5d, 0xb6, 0xa2, 0x97, 0xe6, 0x03, 0x0c, 0x3b, 0xe4, 0x0d, 0xbd, 0x8c, 0xe1,
-	0xa3, 0xdb, 0x07, 0xf9, 0xfc, 0xbf, 0xfe, 0xd5, 0xcf, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0xf9,
-	0xa8, 0x77, 0x69, 0x37, 0x04, 0x00, 0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x95, 0xdb, 0x6e, 0x4b, 0x6f, 0xd6, 0x6d, 0x32, 0x0f, 0x8b, 0x82, 0x80, 0xaa, 0x12, 0x50,
+	0xa4, 0x2a, 0x65, 0x85, 0x27, 0xc4, 0x4b, 0x1b, 0xe0, 0x05, 0x84, 0xa6, 0x00, 0x2f, 0x08, 0x29,
+	0x32, 0x89, 0xbb, 0x5a, 0x75, 0x93, 0xc8, 0x71, 0xca, 0xf6, 0x07, 0xc0, 0x6f, 0xc1, 0x07, 0xc0,
+	0xef, 0xec, 0x09, 0xd9, 0x71, 0xd2, 0x4c, 0x62, 0x2b, 0xbc, 0xc5, 0xe7, 0x9e, 0x73, 0x7c, 0xaf,
+	0xef, 0x51, 0x60, 0x94, 0x2d, 0xcf, 0xc6, 0x51, 0x9a, 0xcc, 0xd9, 0xd9, 0x98, 0x64, 0xd9, 0x78,
+	0x59, 0xac, 0x48, 0x24, 0xf9, 0x78, 0x7d, 0x42, 0x78, 0xb6, 0x20, 0x27, 0xa6, 0xe6, 0x65, 0x22,
+	0x95, 0x29, 0x3e, 0x36, 0x65, 0xcf, 0xa0, 0x15, 0xcb, 0x3d, 0x5e, 0x13, 0xce, 0x62, 0x22, 0xe9,
+	0xb8, 0xfa, 0x28, 0x15, 0x83, 0x9f, 0x08, 0x7a, 0xbe, 0x26, 0x17, 0x82, 0x48, 0x96, 0x26, 0xf8,
+	0x0d, 0x1c, 0x44, 0x69, 0x22, 0x45, 0xca, 0xc3, 0x8c, 0x93, 0x84, 0xe6, 0x0e, 0xea, 0xb7, 0x87,
+	0xf6, 0xe4, 0xbe, 0x77, 0x8d, 0xb9, 0xe7, 0x97, 0xf4, 0x53, 0xc5, 0x0e, 0x7a, 0x51, 0xe3, 0x94,
+	0xe3, 0xe7, 0x60, 0x29, 0x80, 0x9e, 0xcb, 0xdc, 0x69, 0x69, 0x9f, 0xfe, 0x8d, 0x3e, 0xf4, 0x5c,
+	0x06, 0xb5, 0x02, 0x3f, 0x84, 0xc3, 0xa8, 0x10, 0x82, 0x26, 0x32, 0x34, 0x98, 0xd3, 0xee, 0xa3,
+	0x61, 0x37, 0x38, 0x30, 0xb0, 0x91, 0x0c, 0xbe, 0x21, 0xd8, 0x6f, 0xb6, 0x81, 0x6f, 0x43, 0x27,
+	0x21, 0x2b, 0xea, 0x20, 0x45, 0x9f, 0xed, 0x5d, 0xce, 0x3a, 0xa2, 0x75, 0x84, 0x02, 0x0d, 0xe2,
+	0x4f, 0x60, 0x47, 0x69, 0x2a, 0x62, 0x96, 0x10, 0x49, 0x55, 0x5f, 0x68, 0x68, 0x4f, 0x1e, 0xff,
+	0xd3, 0x7c, 0xfe, 0x46, 0x37, 0xb3, 0x2e, 0x67, 0x3b, 0xdf, 0x91, 0xb2, 0x6d, 0xda, 0x0d, 0x7e,
+	0xb7, 0xe0, 0xf8, 0x1a, 0x09, 0x8e, 0x00, 0x48, 0xc6, 0xc2, 0x9c, 0x8a, 0x35, 0x15, 0xba, 0x39,
+	0x7b, 0xf2, 0xec, 0x7f, 0x2f, 0xf6, 0xa6, 0x19, 0x7b, 0xa7, 0x1d, 0x1a, 0x2d, 0x74, 0x49, 0x05,
+	0xba, 0xbf, 0x10, 0x74, 0x6b, 0x0a, 0x76, 0xa1, 0x5d, 0x08, 0x6e, 0x1e, 0x42, 0xf1, 0x45, 0xfb,
+	0x2b, 0x42, 0x81, 0x02, 0xf1, 0x10, 0x8e, 0x22, 0xce, 0xf4, 0xf3, 0x52, 0x21, 0xc3, 0x39, 0xe3,
+	0xd4, 0x69, 0x99, 0x07, 0xd6, 0xb8, 0x4f, 0x85, 0x7c, 0xc5, 0x38, 0xc5, 0x0f, 0xe0, 0xd0, 0x30,
+	0x97, 0xf4, 0xa2, 0x24, 0x96, 0x9b, 0xe8, 0x95, 0xf0, 0x6b, 0x7a, 0xa1, 0x79, 0x77, 0x00, 0x48,
+	0x21, 0x17, 0xa1, 0x4c, 0x97, 0x34, 0x71, 0x3a, 0x9a, 0xd2, 0x55, 0xc8, 0x7b, 0x05, 0x60, 0x17,
+	0xac, 0x22, 0xa7, 0x42, 0xaf, 0x66, 0x47, 0x17, 0xeb, 0xb3, 0xaa, 0x65, 0x24, 0xcf, 0xbf, 0xa4,
+	0x22, 0x76, 0x76, 0xcb, 0x5a, 0x75, 0x1e, 0xfc, 0x68, 0xc3, 0x9e, 0xd9, 0xf5, 0xcd, 0xab, 0x1d,
+	0x41, 0xef, 0x4a, 0x7a, 0x9d, 0xd6, 0x55, 0xd6, 0x7e, 0x33, 0x9e, 0xf8, 0x25, 0x58, 0x31, 0x9d,
+	0x93, 0x82, 0xcb, 0x5c, 0x8f, 0x63, 0x4f, 0x1e, 0x6d, 0x4b, 0xa7, 0xf7, 0xc2, 0x08, 0x82, 0x5a,
+	0x8a, 0xdf, 0x82, 0x1d, 0x09, 0x1a, 0xd3, 0x44, 0x32, 0xc2, 0x73, 0x3d, 0xb5, 0x3d, 0x19, 0x6d,
+	0x75, 0xf2, 0x37, 0x9a, 0xa0, 0x69, 0xe0, 0xde, 0x05, 0xab, 0xba, 0x05, 0x63, 0xe8, 0xac, 0x68,
+	0xbe, 0x28, 0xa7, 0x0d, 0xf4, 0xb7, 0x1b, 0x82, 0xdd, 0xd0, 0xe2, 0x53, 0xb0, 0x48, 0xbc, 0x62,
+	0xc9, 0x34, 0x63, 0x26, 0x52, 0x4f, 0xb7, 0xde, 0x3d, 0x35, 0x82, 0x66, 0x0f, 0xb5, 0x8b, 0xfb,
+	0x01, 0x6e, 0xfd, 0x85, 0x80, 0xef, 0x81, 0xdd, 0x88, 0x8b, 0x49, 0x0a, 0x6c, 0x92, 0xa2, 0xb6,
+	0xbf, 0x49, 0x89, 0x09, 0x48, 0xb7, 0x0e, 0xc8, 0x0c, 0x3e, 0x5a, 0x55, 0x23, 0x9f, 0x77, 0xf5,
+	0xff, 0xe7, 0xc9, 0x9f, 0x01, 0x00, 0x67, 0x42, 0x43, 0x53, 0xe1, 0x04, 0x00, 0x00,
 }
@@ -425,6 +425,16 @@ func (m *ControlPlaneCoordinates_ApiServer) Validate() error {
 		}
 	}
 
+	// no validation rules for ClientCertFile
+
+	// no validation rules for ClientKeyFile
+
+	// no validation rules for AuthToken
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
 	return nil
 }
 
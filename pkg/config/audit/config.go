@@ -0,0 +1,122 @@
+package audit
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/config"
+)
+
+type SinkType = string
+
+const (
+	StdoutSink  SinkType = "stdout"
+	FileSink    SinkType = "file"
+	WebhookSink SinkType = "webhook"
+)
+
+// Audit log configuration
+type AuditConfig struct {
+	// If true, every create, update and delete of a policy or a secret is recorded in the audit log
+	Enabled bool `yaml:"enabled" envconfig:"kuma_audit_enabled"`
+	// Sink to which audit records are written. Available values: "stdout", "file", "webhook"
+	Sink SinkType `yaml:"sink" envconfig:"kuma_audit_sink"`
+	// File sink configuration
+	File *FileSinkConfig `yaml:"file"`
+	// Webhook sink configuration
+	Webhook *WebhookSinkConfig `yaml:"webhook"`
+}
+
+var _ config.Config = &AuditConfig{}
+
+func (a *AuditConfig) Sanitize() {
+	a.File.Sanitize()
+	a.Webhook.Sanitize()
+}
+
+func (a *AuditConfig) Validate() error {
+	if !a.Enabled {
+		return nil
+	}
+	switch a.Sink {
+	case StdoutSink:
+	case FileSink:
+		if err := a.File.Validate(); err != nil {
+			return errors.Wrap(err, "File validation failed")
+		}
+	case WebhookSink:
+		if err := a.Webhook.Validate(); err != nil {
+			return errors.Wrap(err, "Webhook validation failed")
+		}
+	default:
+		return errors.Errorf("Sink should be either %s, %s or %s", StdoutSink, FileSink, WebhookSink)
+	}
+	return nil
+}
+
+type FileSinkConfig struct {
+	// Path to the file with audit records
+	Path string `yaml:"path" envconfig:"kuma_audit_file_path"`
+	// Maximum size of the file in megabytes before it is rotated
+	MaxSize uint32 `yaml:"maxSize" envconfig:"kuma_audit_file_max_size"`
+	// Maximum number of rotated files to keep. 0 means that rotated files are removed.
+	MaxBackups uint32 `yaml:"maxBackups" envconfig:"kuma_audit_file_max_backups"`
+}
+
+var _ config.Config = &FileSinkConfig{}
+
+func (f *FileSinkConfig) Sanitize() {
+}
+
+func (f *FileSinkConfig) Validate() error {
+	if f.Path == "" {
+		return errors.New("Path should not be empty")
+	}
+	if f.MaxSize == 0 {
+		return errors.New("MaxSize should be greater than 0")
+	}
+	return nil
+}
+
+type WebhookSinkConfig struct {
+	// URL to which every audit record is sent as JSON in a POST request
+	Url string `yaml:"url" envconfig:"kuma_audit_webhook_url"`
+	// Timeout of a single request to the webhook
+	Timeout time.Duration `yaml:"timeout" envconfig:"kuma_audit_webhook_timeout"`
+}
+
+var _ config.Config = &WebhookSinkConfig{}
+
+func (w *WebhookSinkConfig) Sanitize() {
+}
+
+func (w *WebhookSinkConfig) Validate() error {
+	if w.Url == "" {
+		return errors.New("Url should not be empty")
+	}
+	if _, err := url.ParseRequestURI(w.Url); err != nil {
+		return errors.Wrap(err, "Url should be a valid url")
+	}
+	if w.Timeout <= 0 {
+		return errors.New("Timeout should be greater than 0")
+	}
+	return nil
+}
+
+func DefaultAuditConfig() *AuditConfig {
+	return &AuditConfig{
+		Enabled: false,
+		Sink:    StdoutSink,
+		File: &FileSinkConfig{
+			Path:       "/var/log/kuma/audit.log",
+			MaxSize:    100,
+			MaxBackups: 5,
+		},
+		Webhook: &WebhookSinkConfig{
+			Url:     "",
+			Timeout: 5 * time.Second,
+		},
+	}
+}
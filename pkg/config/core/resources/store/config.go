@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/config"
+	"github.com/kumahq/kuma/pkg/config/plugins/resources/embedded"
 	"github.com/kumahq/kuma/pkg/config/plugins/resources/k8s"
 	"github.com/kumahq/kuma/pkg/config/plugins/resources/postgres"
 )
@@ -18,18 +19,23 @@ const (
 	KubernetesStore StoreType = "kubernetes"
 	PostgresStore   StoreType = "postgres"
 	MemoryStore     StoreType = "memory"
+	EmbeddedStore   StoreType = "embedded"
 )
 
 // Resource Store configuration
 type StoreConfig struct {
-	// Type of Store used in the Control Plane. Can be either "kubernetes", "postgres" or "memory"
+	// Type of Store used in the Control Plane. Can be either "kubernetes", "postgres", "embedded" or "memory"
 	Type StoreType `yaml:"type" envconfig:"kuma_store_type"`
 	// Postgres Store configuration
 	Postgres *postgres.PostgresStoreConfig `yaml:"postgres"`
 	// Kubernetes Store configuration
 	Kubernetes *k8s.KubernetesStoreConfig `yaml:"kubernetes"`
+	// Embedded Store configuration
+	Embedded *embedded.EmbeddedStoreConfig `yaml:"embedded"`
 	// Cache configuration
 	Cache CacheStoreConfig `yaml:"cache"`
+	// History configuration
+	History HistoryStoreConfig `yaml:"history"`
 }
 
 func DefaultStoreConfig() *StoreConfig {
@@ -37,14 +43,18 @@ func DefaultStoreConfig() *StoreConfig {
 		Type:       MemoryStore,
 		Postgres:   postgres.DefaultPostgresStoreConfig(),
 		Kubernetes: k8s.DefaultKubernetesStoreConfig(),
+		Embedded:   embedded.DefaultEmbeddedStoreConfig(),
 		Cache:      DefaultCacheStoreConfig(),
+		History:    DefaultHistoryStoreConfig(),
 	}
 }
 
 func (s *StoreConfig) Sanitize() {
 	s.Kubernetes.Sanitize()
 	s.Postgres.Sanitize()
+	s.Embedded.Sanitize()
 	s.Cache.Sanitize()
+	s.History.Sanitize()
 }
 
 func (s *StoreConfig) Validate() error {
@@ -58,14 +68,21 @@ func (s *StoreConfig) Validate() error {
 			return errors.Wrap(err, "Kubernetes validation failed")
 		}
 		return nil
+	case EmbeddedStore:
+		if err := s.Embedded.Validate(); err != nil {
+			return errors.Wrap(err, "Embedded validation failed")
+		}
 	case MemoryStore:
 		return nil
 	default:
-		return errors.Errorf("Type should be either %s, %s or %s", PostgresStore, KubernetesStore, MemoryStore)
+		return errors.Errorf("Type should be either %s, %s, %s or %s", PostgresStore, KubernetesStore, EmbeddedStore, MemoryStore)
 	}
 	if err := s.Cache.Validate(); err != nil {
 		return errors.Wrap(err, "Cache validation failed")
 	}
+	if err := s.History.Validate(); err != nil {
+		return errors.Wrap(err, "History validation failed")
+	}
 	return nil
 }
 
@@ -89,3 +106,23 @@ func DefaultCacheStoreConfig() CacheStoreConfig {
 		ExpirationTime: time.Second,
 	}
 }
+
+var _ config.Config = &HistoryStoreConfig{}
+
+type HistoryStoreConfig struct {
+	// Maximum number of revisions kept for every resource. History is disabled when set to 0.
+	MaxRevisions uint32 `yaml:"maxRevisions" envconfig:"kuma_store_history_max_revisions"`
+}
+
+func (h HistoryStoreConfig) Sanitize() {
+}
+
+func (h HistoryStoreConfig) Validate() error {
+	return nil
+}
+
+func DefaultHistoryStoreConfig() HistoryStoreConfig {
+	return HistoryStoreConfig{
+		MaxRevisions: 10,
+	}
+}
@@ -13,8 +13,13 @@ type DNSServerConfig struct {
 	Domain string `yaml:"domain" envconfig:"kuma_dns_server_domain"`
 	// Port on which the server is exposed
 	Port uint32 `yaml:"port" envconfig:"kuma_dns_server_port"`
-	// CIDR used to allocate virtual IPs from
+	// CIDR used to allocate virtual IPs from. It can be either an IPv4 or an IPv6 range
 	CIDR string `yaml:"CIDR" envconfig:"kuma_dns_server_cidr"`
+	// Addresses (host:port) of DNS resolvers to which queries outside of the domain are forwarded
+	Upstreams []string `yaml:"upstreams" envconfig:"kuma_dns_server_upstreams"`
+	// Search domains of resolvers of Dataplanes. A name of the domain with labels ending with one of the search domains appended,
+	// e.g. backend.mesh.default.svc.cluster.local for cluster.local, is resolved like the name without them
+	SearchDomains []string `yaml:"searchDomains" envconfig:"kuma_dns_server_search_domains"`
 }
 
 func (g *DNSServerConfig) Sanitize() {
@@ -28,6 +33,16 @@ func (g *DNSServerConfig) Validate() error {
 	if err != nil {
 		return errors.New("Must provide a valid CIDR")
 	}
+	for _, upstream := range g.Upstreams {
+		if _, _, err := net.SplitHostPort(upstream); err != nil {
+			return errors.New("Upstreams must be in the format host:port")
+		}
+	}
+	for _, search := range g.SearchDomains {
+		if search == "" || search == "." {
+			return errors.New("SearchDomains must not contain empty domains")
+		}
+	}
 	return nil
 }
 
@@ -35,8 +50,10 @@ var _ config.Config = &DNSServerConfig{}
 
 func DefaultDNSServerConfig() *DNSServerConfig {
 	return &DNSServerConfig{
-		Domain: "mesh",
-		Port:   5653,
-		CIDR:   "240.0.0.0/4",
+		Domain:        "mesh",
+		Port:          5653,
+		CIDR:          "240.0.0.0/4",
+		Upstreams:     []string{},
+		SearchDomains: []string{},
 	}
 }
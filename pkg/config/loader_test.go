@@ -90,9 +90,25 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Store.Postgres.TLS.KeyPath).To(Equal("/path/to/key"))
 			Expect(cfg.Store.Postgres.TLS.CAPath).To(Equal("/path/to/rootCert"))
 
+			Expect(cfg.Store.Embedded.Path).To(Equal("/var/lib/kuma-cp/store.db"))
+			Expect(cfg.Store.Embedded.LockTimeout).To(Equal(10 * time.Second))
+
+			Expect(cfg.Store.History.MaxRevisions).To(Equal(uint32(25)))
+
+			Expect(cfg.Store.Kubernetes.SystemNamespace).To(Equal("kuma"))
+			Expect(cfg.Store.Kubernetes.NamespaceScopedPolicies).To(BeTrue())
+
 			Expect(cfg.ApiServer.Port).To(Equal(9090))
 			Expect(cfg.ApiServer.ReadOnly).To(Equal(true))
 			Expect(cfg.ApiServer.CorsAllowedDomains).To(Equal([]string{"https://kuma", "https://someapi"}))
+			Expect(cfg.ApiServer.HTTPS.Enabled).To(Equal(true))
+			Expect(cfg.ApiServer.HTTPS.Port).To(Equal(uint32(15682)))
+			Expect(cfg.ApiServer.HTTPS.TlsCertFile).To(Equal("/cert"))
+			Expect(cfg.ApiServer.HTTPS.TlsKeyFile).To(Equal("/key"))
+			Expect(cfg.ApiServer.Auth.Enabled).To(Equal(true))
+			Expect(cfg.ApiServer.Auth.ClientCertsDir).To(Equal("/certs"))
+			Expect(cfg.ApiServer.Auth.HtpasswdFile).To(Equal("/htpasswd"))
+			Expect(cfg.ApiServer.WatchInterval).To(Equal(3 * time.Second))
 
 			Expect(cfg.MonitoringAssignmentServer.GrpcPort).To(Equal(uint32(3333)))
 			Expect(cfg.MonitoringAssignmentServer.AssignmentRefreshInterval).To(Equal(12 * time.Second))
@@ -109,6 +125,10 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Runtime.Kubernetes.AdmissionServer.Address).To(Equal("127.0.0.2"))
 			Expect(cfg.Runtime.Kubernetes.AdmissionServer.Port).To(Equal(uint32(9443)))
 			Expect(cfg.Runtime.Kubernetes.AdmissionServer.CertDir).To(Equal("/var/run/secrets/kuma.io/kuma-admission-server/tls-cert"))
+			Expect(cfg.Runtime.Kubernetes.IngressController.Enabled).To(BeTrue())
+			Expect(cfg.Runtime.Kubernetes.IngressController.IngressClass).To(Equal("kuma-gateway"))
+			Expect(cfg.Runtime.Kubernetes.LearnedDestinations.TTL).To(Equal(time.Hour))
+			Expect(cfg.Runtime.Kubernetes.LearnedDestinations.Limit).To(Equal(uint32(10)))
 
 			Expect(cfg.Reports.Enabled).To(BeFalse())
 
@@ -117,6 +137,8 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.General.TlsKeyFile).To(Equal("/tmp/key"))
 
 			Expect(cfg.GuiServer.ApiServerUrl).To(Equal("http://localhost:1234"))
+			Expect(cfg.DNSServer.Upstreams).To(Equal([]string{"8.8.8.8:53", "1.1.1.1:53"}))
+			Expect(cfg.DNSServer.SearchDomains).To(Equal([]string{"svc.cluster.local", "cluster.local"}))
 			Expect(cfg.Mode).To(Equal(config_core.Remote))
 			Expect(cfg.Multicluster.Remote.Zone).To(Equal("zone-1"))
 
@@ -132,6 +154,14 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Defaults.SkipMeshCreation).To(BeTrue())
 
 			Expect(cfg.Diagnostics.DebugEndpoints).To(BeTrue())
+
+			Expect(cfg.Audit.Enabled).To(BeTrue())
+			Expect(cfg.Audit.Sink).To(Equal("webhook"))
+			Expect(cfg.Audit.File.Path).To(Equal("/tmp/audit.log"))
+			Expect(cfg.Audit.File.MaxSize).To(Equal(uint32(10)))
+			Expect(cfg.Audit.File.MaxBackups).To(Equal(uint32(3)))
+			Expect(cfg.Audit.Webhook.Url).To(Equal("http://localhost:8080/audit"))
+			Expect(cfg.Audit.Webhook.Timeout).To(Equal(2 * time.Second))
 		},
 		Entry("from config file", testCase{
 			envVars: map[string]string{},
@@ -152,9 +182,17 @@ store:
       certPath: /path/to/cert
       keyPath: /path/to/key
       caPath: /path/to/rootCert
+  embedded:
+    path: /var/lib/kuma-cp/store.db
+    lockTimeout: 10s
   cache:
     enabled: false
     expirationTime: 3s
+  history:
+    maxRevisions: 25
+  kubernetes:
+    systemNamespace: kuma
+    namespaceScopedPolicies: true
 xdsServer:
   grpcPort: 5000
   diagnosticsPort: 5003
@@ -170,6 +208,16 @@ apiServer:
   corsAllowedDomains:
     - https://kuma
     - https://someapi
+  https:
+    enabled: true
+    port: 15682
+    tlsCertFile: /cert
+    tlsKeyFile: /key
+  auth:
+    enabled: true
+    clientCertsDir: /certs
+    htpasswdFile: /htpasswd
+  watchInterval: 3s
 monitoringAssignmentServer:
   grpcPort: 3333
   assignmentRefreshInterval: 12s
@@ -192,6 +240,12 @@ runtime:
       address: 127.0.0.2
       port: 9443
       certDir: /var/run/secrets/kuma.io/kuma-admission-server/tls-cert
+    ingressController:
+      enabled: true
+      ingressClass: kuma-gateway
+    learnedDestinations:
+      ttl: 1h
+      limit: 10
 reports:
   enabled: false
 general:
@@ -217,10 +271,26 @@ multicluster:
 dnsServer:
   port: 15653
   CIDR: 127.1.0.0/16
+  upstreams:
+  - 8.8.8.8:53
+  - 1.1.1.1:53
+  searchDomains:
+  - svc.cluster.local
+  - cluster.local
 defaults:
   skipMeshCreation: true
 diagnostics:
   debugEndpoints: true
+audit:
+  enabled: true
+  sink: webhook
+  file:
+    path: /tmp/audit.log
+    maxSize: 10
+    maxBackups: 3
+  webhook:
+    url: http://localhost:8080/audit
+    timeout: 2s
 `,
 		}),
 		Entry("from env variables", testCase{
@@ -244,10 +314,23 @@ diagnostics:
 				"KUMA_STORE_POSTGRES_TLS_CERT_PATH":                             "/path/to/cert",
 				"KUMA_STORE_POSTGRES_TLS_KEY_PATH":                              "/path/to/key",
 				"KUMA_STORE_POSTGRES_TLS_CA_PATH":                               "/path/to/rootCert",
+				"KUMA_STORE_EMBEDDED_PATH":                                      "/var/lib/kuma-cp/store.db",
+				"KUMA_STORE_EMBEDDED_LOCK_TIMEOUT":                              "10s",
 				"KUMA_STORE_CACHE_ENABLED":                                      "false",
 				"KUMA_STORE_CACHE_EXPIRATION_TIME":                              "3s",
+				"KUMA_STORE_HISTORY_MAX_REVISIONS":                              "25",
+				"KUMA_STORE_KUBERNETES_SYSTEM_NAMESPACE":                        "kuma",
+				"KUMA_STORE_KUBERNETES_NAMESPACE_SCOPED_POLICIES":               "true",
 				"KUMA_API_SERVER_READ_ONLY":                                     "true",
 				"KUMA_API_SERVER_PORT":                                          "9090",
+				"KUMA_API_SERVER_HTTPS_ENABLED":                                 "true",
+				"KUMA_API_SERVER_HTTPS_PORT":                                    "15682",
+				"KUMA_API_SERVER_HTTPS_TLS_CERT_FILE":                           "/cert",
+				"KUMA_API_SERVER_HTTPS_TLS_KEY_FILE":                            "/key",
+				"KUMA_API_SERVER_AUTH_ENABLED":                                  "true",
+				"KUMA_API_SERVER_AUTH_CLIENT_CERTS_DIR":                         "/certs",
+				"KUMA_API_SERVER_AUTH_HTPASSWD_FILE":                            "/htpasswd",
+				"KUMA_API_SERVER_WATCH_INTERVAL":                                "3s",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_GRPC_PORT":                   "3333",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_ASSIGNMENT_REFRESH_INTERVAL": "12s",
 				"KUMA_ADMIN_SERVER_APIS_DATAPLANE_TOKEN_ENABLED":                "true",
@@ -262,6 +345,10 @@ diagnostics:
 				"KUMA_RUNTIME_KUBERNETES_ADMISSION_SERVER_ADDRESS":              "127.0.0.2",
 				"KUMA_RUNTIME_KUBERNETES_ADMISSION_SERVER_PORT":                 "9443",
 				"KUMA_RUNTIME_KUBERNETES_ADMISSION_SERVER_CERT_DIR":             "/var/run/secrets/kuma.io/kuma-admission-server/tls-cert",
+				"KUMA_RUNTIME_KUBERNETES_INGRESS_CONTROLLER_ENABLED":            "true",
+				"KUMA_RUNTIME_KUBERNETES_INGRESS_CONTROLLER_INGRESS_CLASS":      "kuma-gateway",
+				"KUMA_RUNTIME_KUBERNETES_LEARNED_DESTINATIONS_TTL":              "1h",
+				"KUMA_RUNTIME_KUBERNETES_LEARNED_DESTINATIONS_LIMIT":            "10",
 				"KUMA_GENERAL_ADVERTISED_HOSTNAME":                              "kuma.internal",
 				"KUMA_GENERAL_TLS_CERT_FILE":                                    "/tmp/cert",
 				"KUMA_GENERAL_TLS_KEY_FILE":                                     "/tmp/key",
@@ -270,6 +357,8 @@ diagnostics:
 				"KUMA_GUI_SERVER_API_SERVER_URL":                                "http://localhost:1234",
 				"KUMA_DNS_SERVER_PORT":                                          "15653",
 				"KUMA_DNS_CIDR":                                                 "127.1.0.0/16",
+				"KUMA_DNS_SERVER_UPSTREAMS":                                     "8.8.8.8:53,1.1.1.1:53",
+				"KUMA_DNS_SERVER_SEARCH_DOMAINS":                                "svc.cluster.local,cluster.local",
 				"KUMA_MODE":                                                     "remote",
 				"KUMA_MULTICLUSTER_GLOBAL_POLL_TIMEOUT":                         "750ms",
 				"KUMA_MULTICLUSTER_GLOBAL_KDS_GRPC_PORT":                        "1234",
@@ -281,6 +370,13 @@ diagnostics:
 				"KUMA_MULTICLUSTER_REMOTE_KDS_ROOT_CA_FILE":                     "/rootCa",
 				"KUMA_DEFAULTS_SKIP_MESH_CREATION":                              "true",
 				"KUMA_DIAGNOSTICS_DEBUG_ENDPOINTS":                              "true",
+				"KUMA_AUDIT_ENABLED":                                            "true",
+				"KUMA_AUDIT_SINK":                                               "webhook",
+				"KUMA_AUDIT_FILE_PATH":                                          "/tmp/audit.log",
+				"KUMA_AUDIT_FILE_MAX_SIZE":                                      "10",
+				"KUMA_AUDIT_FILE_MAX_BACKUPS":                                   "3",
+				"KUMA_AUDIT_WEBHOOK_URL":                                        "http://localhost:8080/audit",
+				"KUMA_AUDIT_WEBHOOK_TIMEOUT":                                    "2s",
 			},
 			yamlFileConfig: "",
 		}),
@@ -0,0 +1,39 @@
+package embedded
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/config"
+)
+
+var _ config.Config = &EmbeddedStoreConfig{}
+
+// Embedded store configuration
+type EmbeddedStoreConfig struct {
+	// Path to the file with all resources. The file is created if it does not exist.
+	Path string `yaml:"path" envconfig:"kuma_store_embedded_path"`
+	// Timeout of acquiring the lock of the file. The file can be opened only by one process at a time.
+	LockTimeout time.Duration `yaml:"lockTimeout" envconfig:"kuma_store_embedded_lock_timeout"`
+}
+
+func (e *EmbeddedStoreConfig) Sanitize() {
+}
+
+func (e *EmbeddedStoreConfig) Validate() error {
+	if len(e.Path) < 1 {
+		return errors.New("Path should not be empty")
+	}
+	if e.LockTimeout <= 0 {
+		return errors.New("LockTimeout should be greater than 0")
+	}
+	return nil
+}
+
+func DefaultEmbeddedStoreConfig() *EmbeddedStoreConfig {
+	return &EmbeddedStoreConfig{
+		Path:        "/var/lib/kuma/kuma.db",
+		LockTimeout: 5 * time.Second,
+	}
+}
@@ -16,6 +16,8 @@ func DefaultKubernetesStoreConfig() *KubernetesStoreConfig {
 type KubernetesStoreConfig struct {
 	// Namespace where Control Plane is installed to.
 	SystemNamespace string `yaml:"systemNamespace" envconfig:"kuma_store_kubernetes_system_namespace"`
+	// If true, policies applied in a namespace other than the system namespace select only Dataplanes of the same namespace.
+	NamespaceScopedPolicies bool `yaml:"namespaceScopedPolicies" envconfig:"kuma_store_kubernetes_namespace_scoped_policies"`
 }
 
 var _ config.Config = &KubernetesStoreConfig{}
@@ -27,6 +27,7 @@ func DefaultKubernetesRuntimeConfig() *KubernetesRuntimeConfig {
 				GID:                  5678,
 				AdminPort:            9901,
 				DrainTime:            30 * time.Second,
+				LifecyclePort:        9902,
 
 				ReadinessProbe: SidecarReadinessProbe{
 					InitialDelaySeconds: 1,
@@ -56,6 +57,14 @@ func DefaultKubernetesRuntimeConfig() *KubernetesRuntimeConfig {
 				Image: "kuma/kuma-init:latest",
 			},
 		},
+		IngressController: IngressController{
+			Enabled:      false,
+			IngressClass: "kuma",
+		},
+		LearnedDestinations: LearnedDestinations{
+			TTL:   24 * time.Hour,
+			Limit: 100,
+		},
 	}
 }
 
@@ -65,6 +74,10 @@ type KubernetesRuntimeConfig struct {
 	AdmissionServer AdmissionServerConfig `yaml:"admissionServer"`
 	// Injector-specific configuration
 	Injector Injector `yaml:"injector,omitempty"`
+	// IngressController-specific configuration
+	IngressController IngressController `yaml:"ingressController,omitempty"`
+	// LearnedDestinations defines how long and how many destinations learned by Dependencies are kept
+	LearnedDestinations LearnedDestinations `yaml:"learnedDestinations,omitempty"`
 }
 
 // Configuration of the Admission WebHook Server implemented by the Control Plane.
@@ -95,6 +108,24 @@ type Injector struct {
 	VirtualProbesPort uint32 `yaml:"virtualProbesPort" envconfig:"kuma_runtime_kubernetes_virtual_probes_enabled"`
 }
 
+// IngressController defines configuration of the controller that turns Kubernetes Ingresses
+// into GatewayListeners and GatewayRoutes of gateway Dataplanes.
+type IngressController struct {
+	// Enabled if true the Control Plane watches Kubernetes Ingresses and their TLS secrets in all namespaces
+	Enabled bool `yaml:"enabled" envconfig:"kuma_runtime_kubernetes_ingress_controller_enabled"`
+	// IngressClass is a class of Ingresses handled by the controller
+	IngressClass string `yaml:"ingressClass,omitempty" envconfig:"kuma_runtime_kubernetes_ingress_controller_ingress_class"`
+}
+
+// LearnedDestinations defines how long and how many destinations learned by Dependencies
+// from the observed traffic of Dataplanes are kept.
+type LearnedDestinations struct {
+	// TTL is how long a learned destination is kept after it was last seen in the traffic of a Dataplane
+	TTL time.Duration `yaml:"ttl" envconfig:"kuma_runtime_kubernetes_learned_destinations_ttl"`
+	// Limit is the maximum number of destinations learned by one Dependencies. Destinations reported least recently are forgotten first.
+	Limit uint32 `yaml:"limit" envconfig:"kuma_runtime_kubernetes_learned_destinations_limit"`
+}
+
 // SidecarContainer defines configuration of the Kuma sidecar container.
 type SidecarContainer struct {
 	// Image name.
@@ -111,6 +142,12 @@ type SidecarContainer struct {
 	AdminPort uint32 `yaml:"adminPort,omitempty" envconfig:"kuma_runtime_kubernetes_injector_sidecar_container_admin_port"`
 	// Drain time for listeners.
 	DrainTime time.Duration `yaml:"drainTime,omitempty" envconfig:"kuma_runtime_kubernetes_injector_sidecar_container_drain_time"`
+	// Port of the lifecycle server of Kuma DP, which is used by the pre-stop hook to drain the Dataplane.
+	// Zero value disables the pre-stop hook.
+	LifecyclePort uint32 `yaml:"lifecyclePort,omitempty" envconfig:"kuma_runtime_kubernetes_injector_sidecar_container_lifecycle_port"`
+	// How often the sidecar reports destinations reached through the passthrough cluster, so they are learned by Dependencies.
+	// Zero value disables reporting.
+	DependenciesReportInterval time.Duration `yaml:"dependenciesReportInterval,omitempty" envconfig:"kuma_runtime_kubernetes_injector_sidecar_container_dependencies_report_interval"`
 	// Readiness probe.
 	ReadinessProbe SidecarReadinessProbe `yaml:"readinessProbe,omitempty"`
 	// Liveness probe.
@@ -187,6 +224,12 @@ func (c *KubernetesRuntimeConfig) Validate() (errs error) {
 	if err := c.Injector.Validate(); err != nil {
 		errs = multierr.Append(errs, errors.Wrapf(err, ".Injector is not valid"))
 	}
+	if err := c.IngressController.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".IngressController is not valid"))
+	}
+	if err := c.LearnedDestinations.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".LearnedDestinations is not valid"))
+	}
 	return
 }
 
@@ -222,6 +265,18 @@ func (i *Injector) Validate() (errs error) {
 	return
 }
 
+var _ config.Config = &IngressController{}
+
+func (c *IngressController) Sanitize() {
+}
+
+func (c *IngressController) Validate() (errs error) {
+	if c.Enabled && c.IngressClass == "" {
+		errs = multierr.Append(errs, errors.Errorf(".IngressClass must be non-empty"))
+	}
+	return
+}
+
 var _ config.Config = &SidecarContainer{}
 
 func (c *SidecarContainer) Sanitize() {
@@ -246,6 +301,12 @@ func (c *SidecarContainer) Validate() (errs error) {
 	if c.DrainTime <= 0 {
 		errs = multierr.Append(errs, errors.Errorf(".DrainTime must be positive"))
 	}
+	if 65535 < c.LifecyclePort {
+		errs = multierr.Append(errs, errors.Errorf(".LifecyclePort must be in the range [0, 65535]"))
+	}
+	if c.DependenciesReportInterval < 0 {
+		errs = multierr.Append(errs, errors.Errorf(".DependenciesReportInterval must not be negative"))
+	}
 	if err := c.ReadinessProbe.Validate(); err != nil {
 		errs = multierr.Append(errs, errors.Wrapf(err, ".ReadinessProbe is not valid"))
 	}
@@ -361,3 +422,18 @@ func (c *SidecarResourceLimits) Validate() (errs error) {
 	}
 	return
 }
+
+var _ config.Config = &LearnedDestinations{}
+
+func (c *LearnedDestinations) Sanitize() {
+}
+
+func (c *LearnedDestinations) Validate() (errs error) {
+	if c.TTL <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".TTL must be positive"))
+	}
+	if c.Limit == 0 {
+		errs = multierr.Append(errs, errors.Errorf(".Limit must be positive"))
+	}
+	return
+}
@@ -0,0 +1,73 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+var log = core.Log.WithName("audit")
+
+// SystemPrincipal is recorded as the principal of changes made by the Control Plane itself.
+const SystemPrincipal = "system"
+
+// Origin is a component through which a change reached the Control Plane.
+type Origin string
+
+const (
+	OriginControlPlane Origin = "control-plane"
+	OriginApiServer    Origin = "api-server"
+	OriginAdminServer  Origin = "admin-server"
+	OriginKubernetes   Origin = "kubernetes"
+	OriginKDS          Origin = "kds"
+)
+
+// Record is a single entry of the audit log.
+type Record struct {
+	Time      time.Time             `json:"time"`
+	Principal string                `json:"principal"`
+	SourceIP  string                `json:"sourceIp,omitempty"`
+	Origin    Origin                `json:"origin"`
+	Operation store.ChangeOperation `json:"operation"`
+	Type      model.ResourceType    `json:"type"`
+	Mesh      string                `json:"mesh,omitempty"`
+	Name      string                `json:"name"`
+	// Diff is a unified diff of labels and spec of the resource. It is empty for secrets.
+	Diff string `json:"diff,omitempty"`
+}
+
+// Sink is a destination of audit records.
+type Sink interface {
+	Write(record Record) error
+}
+
+// Source describes where the request that made a change came from.
+type Source struct {
+	Origin Origin
+	IP     string
+}
+
+type sourceCtxKey struct{}
+
+func NewSourceContext(ctx context.Context, source Source) context.Context {
+	return context.WithValue(ctx, sourceCtxKey{}, source)
+}
+
+func SourceFromContext(ctx context.Context) (Source, bool) {
+	source, ok := ctx.Value(sourceCtxKey{}).(Source)
+	return source, ok
+}
+
+type recorderCtxKey struct{}
+
+func NewRecorderContext(ctx context.Context, recorder *Recorder) context.Context {
+	return context.WithValue(ctx, recorderCtxKey{}, recorder)
+}
+
+func FromRecorderContext(ctx context.Context) (recorder *Recorder, ok bool) {
+	recorder, ok = ctx.Value(recorderCtxKey{}).(*Recorder)
+	return
+}
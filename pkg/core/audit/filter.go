@@ -0,0 +1,23 @@
+package audit
+
+import (
+	"net"
+
+	"github.com/emicklei/go-restful"
+)
+
+// SourceFilter puts the origin and the IP of the client into the context of the request.
+func SourceFilter(origin Origin) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		ip, _, err := net.SplitHostPort(request.Request.RemoteAddr)
+		if err != nil {
+			ip = request.Request.RemoteAddr
+		}
+		source := Source{
+			Origin: origin,
+			IP:     ip,
+		}
+		request.Request = request.Request.WithContext(NewSourceContext(request.Request.Context(), source))
+		chain.ProcessFilter(request, response)
+	}
+}
@@ -0,0 +1,135 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ghodss/yaml"
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// Recorder turns changes of resources of the audited types into records and writes them to the sink.
+// Failures of the sink are logged and never fail the change itself.
+type Recorder struct {
+	sink  Sink
+	types map[model.ResourceType]bool
+}
+
+func NewRecorder(sink Sink, types []model.ResourceType) *Recorder {
+	audited := map[model.ResourceType]bool{}
+	for _, resType := range types {
+		audited[resType] = true
+	}
+	return &Recorder{
+		sink:  sink,
+		types: audited,
+	}
+}
+
+func (r *Recorder) Audits(resType model.ResourceType) bool {
+	return r.types[resType]
+}
+
+// Record writes a record of the change of the resource. The principal is taken from the user in the context
+// and the source from the source in the context, defaultOrigin is used when the context has no source.
+// before is nil for created resources and after is nil for deleted resources.
+func (r *Recorder) Record(ctx context.Context, defaultOrigin Origin, operation store.ChangeOperation, resType model.ResourceType, key model.ResourceKey, before, after model.Resource) {
+	if !r.Audits(resType) {
+		return
+	}
+	principal := SystemPrincipal
+	if u, ok := user.FromContext(ctx); ok {
+		principal = u.Name
+	}
+	source, ok := SourceFromContext(ctx)
+	if !ok {
+		source = Source{Origin: defaultOrigin}
+	}
+	r.Write(Record{
+		Time:      core.Now(),
+		Principal: principal,
+		SourceIP:  source.IP,
+		Origin:    source.Origin,
+		Operation: operation,
+		Type:      resType,
+		Mesh:      key.Mesh,
+		Name:      key.Name,
+		Diff:      r.diff(resType, before, after),
+	})
+}
+
+// Write writes the record to the sink as it is.
+func (r *Recorder) Write(record Record) {
+	if !r.Audits(record.Type) {
+		return
+	}
+	if err := r.sink.Write(record); err != nil {
+		log.Error(err, "could not write an audit record", "type", record.Type, "name", record.Name, "mesh", record.Mesh, "operation", record.Operation)
+	}
+}
+
+func (r *Recorder) diff(resType model.ResourceType, before, after model.Resource) string {
+	if resType == system.SecretType {
+		// values of secrets must not leak to the audit log
+		return ""
+	}
+	beforeYAML, err := diffView(before)
+	if err != nil {
+		log.Error(err, "could not compute a diff of the resource", "type", resType)
+		return ""
+	}
+	afterYAML, err := diffView(after)
+	if err != nil {
+		log.Error(err, "could not compute a diff of the resource", "type", resType)
+		return ""
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(beforeYAML),
+		B:        difflib.SplitLines(afterYAML),
+		FromFile: "before",
+		ToFile:   "after",
+		Context:  3,
+	})
+	if err != nil {
+		log.Error(err, "could not compute a diff of the resource", "type", resType)
+		return ""
+	}
+	return diff
+}
+
+// diffView renders only the parts of the resource that can be changed by a user,
+// so a diff is not polluted by versions and modification times.
+func diffView(res model.Resource) (string, error) {
+	if res == nil {
+		return "", nil
+	}
+	spec, err := util_proto.ToJSON(res.GetSpec())
+	if err != nil {
+		return "", err
+	}
+	view := struct {
+		Labels map[string]string `json:"labels,omitempty"`
+		Spec   json.RawMessage   `json:"spec"`
+	}{
+		Spec: spec,
+	}
+	if res.GetMeta() != nil {
+		view.Labels = res.GetMeta().GetLabels()
+	}
+	bytes, err := json.Marshal(view)
+	if err != nil {
+		return "", err
+	}
+	yamlBytes, err := yaml.JSONToYAML(bytes)
+	if err != nil {
+		return "", err
+	}
+	return string(yamlBytes), nil
+}
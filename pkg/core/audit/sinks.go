@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	audit_config "github.com/kumahq/kuma/pkg/config/audit"
+)
+
+func NewSink(cfg *audit_config.AuditConfig) (Sink, error) {
+	switch cfg.Sink {
+	case audit_config.StdoutSink:
+		return NewWriterSink(os.Stdout), nil
+	case audit_config.FileSink:
+		file, err := NewRotatingFile(cfg.File.Path, int64(cfg.File.MaxSize)*1024*1024, int(cfg.File.MaxBackups))
+		if err != nil {
+			return nil, err
+		}
+		return NewWriterSink(file), nil
+	case audit_config.WebhookSink:
+		return NewWebhookSink(cfg.Webhook.Url, &http.Client{Timeout: cfg.Webhook.Timeout}), nil
+	default:
+		return nil, errors.Errorf("unknown audit sink %s", cfg.Sink)
+	}
+}
+
+// writerSink writes every record as a single line of JSON.
+type writerSink struct {
+	sync.Mutex
+	writer io.Writer
+}
+
+func NewWriterSink(writer io.Writer) Sink {
+	return &writerSink{
+		writer: writer,
+	}
+}
+
+func (w *writerSink) Write(record Record) error {
+	bytes, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	w.Lock()
+	defer w.Unlock()
+	_, err = w.writer.Write(append(bytes, '\n'))
+	return err
+}
+
+// webhookSink sends every record as JSON in a POST request.
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSink(url string, client *http.Client) Sink {
+	return &webhookSink{
+		url:    url,
+		client: client,
+	}
+}
+
+func (w *webhookSink) Write(record Record) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "could not send an audit record")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return errors.Errorf("audit webhook responded with status code %d", resp.StatusCode)
+	}
+	return nil
+}
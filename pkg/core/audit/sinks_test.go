@@ -0,0 +1,134 @@
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/audit"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+var _ = Describe("Sinks", func() {
+
+	record := audit.Record{
+		Time:      time.Date(2020, 11, 1, 12, 0, 0, 0, time.UTC),
+		Principal: "john",
+		SourceIP:  "192.168.0.1",
+		Origin:    audit.OriginApiServer,
+		Operation: core_store.DeleteOperation,
+		Type:      core_mesh.TrafficRouteType,
+		Mesh:      "default",
+		Name:      "route-1",
+	}
+
+	It("should write records as lines of JSON", func() {
+		// given
+		buf := &bytes.Buffer{}
+		sink := audit.NewWriterSink(buf)
+
+		// when
+		Expect(sink.Write(record)).To(Succeed())
+		Expect(sink.Write(record)).To(Succeed())
+
+		// then
+		line := `{"time":"2020-11-01T12:00:00Z","principal":"john","sourceIp":"192.168.0.1","origin":"api-server","operation":"Delete","type":"TrafficRoute","mesh":"default","name":"route-1"}`
+		Expect(buf.String()).To(Equal(line + "\n" + line + "\n"))
+	})
+
+	It("should send records to the webhook", func() {
+		// given
+		received := make(chan audit.Record, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			defer GinkgoRecover()
+			Expect(request.Method).To(Equal(http.MethodPost))
+			Expect(request.Header.Get("Content-Type")).To(Equal("application/json"))
+			r := audit.Record{}
+			Expect(json.NewDecoder(request.Body).Decode(&r)).To(Succeed())
+			received <- r
+		}))
+		defer server.Close()
+		sink := audit.NewWebhookSink(server.URL, server.Client())
+
+		// when
+		Expect(sink.Write(record)).To(Succeed())
+
+		// then
+		Expect(<-received).To(Equal(record))
+	})
+
+	It("should fail when the webhook rejects the record", func() {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		sink := audit.NewWebhookSink(server.URL, server.Client())
+
+		// when
+		err := sink.Write(record)
+
+		// then
+		Expect(err).To(MatchError("audit webhook responded with status code 500"))
+	})
+
+	Describe("RotatingFile", func() {
+
+		var dir string
+
+		BeforeEach(func() {
+			d, err := ioutil.TempDir("", "audit")
+			Expect(err).ToNot(HaveOccurred())
+			dir = d
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("should rotate the file and keep only the last backups", func() {
+			// given
+			path := filepath.Join(dir, "audit.log")
+			file, err := audit.NewRotatingFile(path, 10, 2)
+			Expect(err).ToNot(HaveOccurred())
+			defer file.Close()
+
+			// when
+			for _, line := range []string{"line-1\n", "line-2\n", "line-3\n", "line-4\n"} {
+				_, err := file.Write([]byte(line))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// then
+			Expect(ioutil.ReadFile(path)).To(Equal([]byte("line-4\n")))
+			Expect(ioutil.ReadFile(path + ".1")).To(Equal([]byte("line-3\n")))
+			Expect(ioutil.ReadFile(path + ".2")).To(Equal([]byte("line-2\n")))
+			Expect(path + ".3").ToNot(BeAnExistingFile())
+		})
+
+		It("should append to the existing file", func() {
+			// given
+			path := filepath.Join(dir, "audit.log")
+			Expect(ioutil.WriteFile(path, []byte("line-1\n"), 0600)).To(Succeed())
+
+			// when
+			file, err := audit.NewRotatingFile(path, 100, 2)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = file.Write([]byte("line-2\n"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+
+			// then
+			Expect(ioutil.ReadFile(path)).To(Equal([]byte("line-1\nline-2\n")))
+		})
+	})
+})
@@ -0,0 +1,190 @@
+package audit
+
+import (
+	"context"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+// AuditingStore records every change of resources of the audited types made through the store.
+// Changes made with a context without a Source are recorded with the default origin.
+type AuditingStore struct {
+	delegate      store.ResourceStore
+	recorder      *Recorder
+	defaultOrigin Origin
+}
+
+func NewAuditingStore(delegate store.ResourceStore, recorder *Recorder, defaultOrigin Origin) *AuditingStore {
+	return &AuditingStore{
+		delegate:      delegate,
+		recorder:      recorder,
+		defaultOrigin: defaultOrigin,
+	}
+}
+
+var _ store.ResourceStore = &AuditingStore{}
+
+func (a *AuditingStore) Create(ctx context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
+	if err := a.delegate.Create(ctx, resource, fs...); err != nil {
+		return err
+	}
+	opts := store.NewCreateOptions(fs...)
+	a.recorder.Record(ctx, a.defaultOrigin, store.CreateOperation, resource.GetType(), model.ResourceKey{Name: opts.Name, Mesh: opts.Mesh}, nil, resource)
+	return nil
+}
+
+func (a *AuditingStore) Update(ctx context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
+	if !a.recorder.Audits(resource.GetType()) {
+		return a.delegate.Update(ctx, resource, fs...)
+	}
+	key := model.MetaToResourceKey(resource.GetMeta())
+	before, err := a.current(ctx, resource.GetType(), key)
+	if err != nil {
+		return err
+	}
+	if err := a.delegate.Update(ctx, resource, fs...); err != nil {
+		return err
+	}
+	a.recorder.Record(ctx, a.defaultOrigin, store.UpdateOperation, resource.GetType(), key, before, resource)
+	return nil
+}
+
+func (a *AuditingStore) Delete(ctx context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
+	if !a.recorder.Audits(resource.GetType()) {
+		return a.delegate.Delete(ctx, resource, fs...)
+	}
+	opts := store.NewDeleteOptions(fs...)
+	key := model.ResourceKey{Name: opts.Name, Mesh: opts.Mesh}
+	before, err := a.current(ctx, resource.GetType(), key)
+	if err != nil {
+		return err
+	}
+	if err := a.delegate.Delete(ctx, resource, fs...); err != nil {
+		return err
+	}
+	a.recorder.Record(ctx, a.defaultOrigin, store.DeleteOperation, resource.GetType(), key, before, nil)
+	if deletions, ok := ctx.Value(recordedDeletionsKey{}).(recordedDeletions); ok {
+		deletions[deletionKey{resType: resource.GetType(), key: key}] = true
+	}
+	return nil
+}
+
+func (a *AuditingStore) Get(ctx context.Context, resource model.Resource, fs ...store.GetOptionsFunc) error {
+	return a.delegate.Get(ctx, resource, fs...)
+}
+
+func (a *AuditingStore) List(ctx context.Context, list model.ResourceList, fs ...store.ListOptionsFunc) error {
+	return a.delegate.List(ctx, list, fs...)
+}
+
+// current returns the resource before the change, so it can be compared with the resource after the change.
+// A missing resource is returned as nil, the delegate reports the error of the change itself.
+func (a *AuditingStore) current(ctx context.Context, resType model.ResourceType, key model.ResourceKey) (model.Resource, error) {
+	res, err := registry.Global().NewObject(resType)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.delegate.Get(ctx, res, store.GetBy(key)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// AuditingManager records every change of resources of the audited types made through the manager.
+type AuditingManager struct {
+	*AuditingStore
+	delegate manager.ResourceManager
+}
+
+func NewAuditingManager(delegate manager.ResourceManager, recorder *Recorder) *AuditingManager {
+	return &AuditingManager{
+		AuditingStore: NewAuditingStore(delegate, recorder, OriginControlPlane),
+		delegate:      delegate,
+	}
+}
+
+var _ manager.ResourceManager = &AuditingManager{}
+
+// recordedDeletions are deletions recorded while a Mesh is deleted, so resources of the Mesh deleted through
+// the manager are not recorded again as deleted together with the Mesh.
+type recordedDeletions map[deletionKey]bool
+
+type deletionKey struct {
+	resType model.ResourceType
+	key     model.ResourceKey
+}
+
+type recordedDeletionsKey struct{}
+
+// Delete records also deletion of resources of a Mesh, which are deleted by the store together with the Mesh.
+func (a *AuditingManager) Delete(ctx context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
+	if resource.GetType() != core_mesh.MeshType {
+		return a.AuditingStore.Delete(ctx, resource, fs...)
+	}
+	opts := store.NewDeleteOptions(fs...)
+	resources, err := a.meshResources(ctx, opts.Name)
+	if err != nil {
+		return err
+	}
+	deletions := recordedDeletions{}
+	ctx = context.WithValue(ctx, recordedDeletionsKey{}, deletions)
+	if err := a.AuditingStore.Delete(ctx, resource, fs...); err != nil {
+		return err
+	}
+	for _, res := range resources {
+		key := model.MetaToResourceKey(res.GetMeta())
+		if deletions[deletionKey{resType: res.GetType(), key: key}] {
+			continue
+		}
+		current, err := a.current(ctx, res.GetType(), key)
+		if err != nil {
+			log.Error(err, "could not check whether a resource of the Mesh was deleted", "type", res.GetType(), "name", key.Name, "mesh", key.Mesh)
+			continue
+		}
+		if current == nil {
+			a.recorder.Record(ctx, a.defaultOrigin, store.DeleteOperation, res.GetType(), key, res, nil)
+		}
+	}
+	return nil
+}
+
+// meshResources returns resources of the audited types that belong to the Mesh.
+func (a *AuditingManager) meshResources(ctx context.Context, mesh string) ([]model.Resource, error) {
+	var resources []model.Resource
+	for _, resType := range registry.Global().ObjectTypes() {
+		if resType == core_mesh.MeshType || !a.recorder.Audits(resType) {
+			continue
+		}
+		obj, err := registry.Global().NewObject(resType)
+		if err != nil {
+			return nil, err
+		}
+		if obj.Scope() != model.ScopeMesh {
+			continue
+		}
+		list, err := registry.Global().NewList(resType)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.delegate.List(ctx, list, store.ListByMesh(mesh)); err != nil {
+			return nil, err
+		}
+		resources = append(resources, list.GetItems()...)
+	}
+	return resources, nil
+}
+
+func (a *AuditingManager) DeleteAll(ctx context.Context, list model.ResourceList, fs ...store.DeleteAllOptionsFunc) error {
+	if !a.recorder.Audits(list.GetItemType()) {
+		return a.delegate.DeleteAll(ctx, list, fs...)
+	}
+	// resources are deleted one by one, so every deletion is recorded
+	return manager.DeleteAllResources(a, ctx, list, fs...)
+}
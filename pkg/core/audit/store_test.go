@@ -0,0 +1,213 @@
+package audit_test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	mesh_managers "github.com/kumahq/kuma/pkg/core/managers/apis/mesh"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+type recordingSink struct {
+	sync.Mutex
+	records []audit.Record
+}
+
+func (r *recordingSink) Write(record audit.Record) error {
+	r.Lock()
+	defer r.Unlock()
+	r.records = append(r.records, record)
+	return nil
+}
+
+var _ = Describe("Auditing Manager", func() {
+
+	var sink *recordingSink
+	var resManager core_manager.ResourceManager
+	key := model.ResourceKey{Name: "route-1", Mesh: "default"}
+
+	BeforeEach(func() {
+		memoryStore := memory_resources.NewStore()
+		Expect(memoryStore.Create(context.Background(), &core_mesh.MeshResource{}, core_store.CreateByKey("default", "default"))).To(Succeed())
+		sink = &recordingSink{}
+		recorder := audit.NewRecorder(sink, []model.ResourceType{core_mesh.TrafficRouteType, system.SecretType})
+		resManager = audit.NewAuditingManager(core_manager.NewResourceManager(memoryStore), recorder)
+	})
+
+	routeTo := func(service string) mesh_proto.TrafficRoute {
+		return mesh_proto.TrafficRoute{
+			Sources:      []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "*"}}},
+			Destinations: []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "backend"}}},
+			Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+				Weight:      100,
+				Destination: map[string]string{"kuma.io/service": service},
+			}},
+		}
+	}
+
+	It("should record who changed the resource and how", func() {
+		// given
+		ctx := user.NewContext(context.Background(), user.User{Name: "john"})
+		ctx = audit.NewSourceContext(ctx, audit.Source{Origin: audit.OriginApiServer, IP: "192.168.0.1"})
+		route := &core_mesh.TrafficRouteResource{Spec: routeTo("backend-v1")}
+		Expect(resManager.Create(ctx, route, core_store.CreateBy(key))).To(Succeed())
+
+		// when
+		route.Spec = routeTo("backend-v2")
+		Expect(resManager.Update(ctx, route)).To(Succeed())
+
+		// then
+		Expect(sink.records).To(HaveLen(2))
+		Expect(sink.records[0].Operation).To(Equal(core_store.CreateOperation))
+		Expect(sink.records[1].Principal).To(Equal("john"))
+		Expect(sink.records[1].SourceIP).To(Equal("192.168.0.1"))
+		Expect(sink.records[1].Origin).To(Equal(audit.OriginApiServer))
+		Expect(sink.records[1].Operation).To(Equal(core_store.UpdateOperation))
+		Expect(sink.records[1].Type).To(Equal(core_mesh.TrafficRouteType))
+		Expect(sink.records[1].Mesh).To(Equal("default"))
+		Expect(sink.records[1].Name).To(Equal("route-1"))
+		Expect(sink.records[1].Diff).To(ContainSubstring("-      kuma.io/service: backend-v1"))
+		Expect(sink.records[1].Diff).To(ContainSubstring("+      kuma.io/service: backend-v2"))
+	})
+
+	It("should record changes made by the Control Plane", func() {
+		// given
+		route := &core_mesh.TrafficRouteResource{Spec: routeTo("backend-v1")}
+		Expect(resManager.Create(context.Background(), route, core_store.CreateBy(key))).To(Succeed())
+
+		// when
+		Expect(resManager.DeleteAll(context.Background(), &core_mesh.TrafficRouteResourceList{}, core_store.DeleteAllByMesh("default"))).To(Succeed())
+
+		// then
+		Expect(sink.records).To(HaveLen(2))
+		Expect(sink.records[1].Principal).To(Equal(audit.SystemPrincipal))
+		Expect(sink.records[1].Origin).To(Equal(audit.OriginControlPlane))
+		Expect(sink.records[1].Operation).To(Equal(core_store.DeleteOperation))
+		Expect(sink.records[1].Diff).To(ContainSubstring("-      kuma.io/service: backend-v1"))
+	})
+
+	It("should not record a diff of secrets", func() {
+		// given
+		secret := &system.SecretResource{
+			Spec: system_proto.Secret{
+				Data: &wrappers.BytesValue{Value: []byte("confidential")},
+			},
+		}
+
+		// when
+		Expect(resManager.Create(context.Background(), secret, core_store.CreateByKey("secret-1", "default"))).To(Succeed())
+
+		// then
+		Expect(sink.records).To(HaveLen(1))
+		Expect(sink.records[0].Type).To(Equal(system.SecretType))
+		Expect(sink.records[0].Diff).To(BeEmpty())
+	})
+
+	It("should not record changes of resources that are not audited", func() {
+		// when
+		dp := &core_mesh.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+						Port: 8080,
+						Tags: map[string]string{"kuma.io/service": "backend"},
+					}},
+				},
+			},
+		}
+		Expect(resManager.Create(context.Background(), dp, core_store.CreateByKey("dp-1", "default"))).To(Succeed())
+
+		// then
+		Expect(sink.records).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Auditing Store", func() {
+	It("should record changes with the default origin", func() {
+		// given
+		sink := &recordingSink{}
+		recorder := audit.NewRecorder(sink, []model.ResourceType{core_mesh.MeshType})
+		store := audit.NewAuditingStore(memory_resources.NewStore(), recorder, audit.OriginKDS)
+
+		// when
+		Expect(store.Create(context.Background(), &core_mesh.MeshResource{}, core_store.CreateByKey("mesh-1", "mesh-1"))).To(Succeed())
+
+		// then
+		Expect(sink.records).To(HaveLen(1))
+		Expect(sink.records[0].Origin).To(Equal(audit.OriginKDS))
+		Expect(sink.records[0].Principal).To(Equal(audit.SystemPrincipal))
+		Expect(sink.records[0].Name).To(Equal("mesh-1"))
+	})
+})
+
+var _ = Describe("Auditing Manager of Meshes", func() {
+
+	var memoryStore core_store.ResourceStore
+	var sink *recordingSink
+	var resManager core_manager.ResourceManager
+
+	BeforeEach(func() {
+		// managers are composed the same way as in the Control Plane
+		memoryStore = memory_resources.NewStore()
+		sink = &recordingSink{}
+		recorder := audit.NewRecorder(sink, []model.ResourceType{core_mesh.MeshType, system.SecretType})
+		customManagers := map[model.ResourceType]core_manager.ResourceManager{}
+		customizableManager := core_manager.NewCustomizableResourceManager(core_manager.NewResourceManager(memoryStore), customManagers)
+		resManager = audit.NewAuditingManager(customizableManager, recorder)
+		caManagers := core_ca.Managers{}
+		customManagers[core_mesh.MeshType] = mesh_managers.NewMeshManager(memoryStore, resManager, caManagers, registry.Global(), mesh_managers.MeshValidator{CaManagers: caManagers})
+	})
+
+	It("should record deletion of secrets deleted together with their Mesh", func() {
+		// given
+		Expect(resManager.Create(context.Background(), &core_mesh.MeshResource{}, core_store.CreateByKey("mesh-1", "mesh-1"))).To(Succeed())
+		secret := &system.SecretResource{Spec: system_proto.Secret{Data: &wrappers.BytesValue{Value: []byte("secret")}}}
+		Expect(resManager.Create(context.Background(), secret, core_store.CreateByKey("secret-1", "mesh-1"))).To(Succeed())
+		sink.records = nil
+
+		// when
+		Expect(resManager.Delete(context.Background(), &core_mesh.MeshResource{}, core_store.DeleteByKey("mesh-1", "mesh-1"))).To(Succeed())
+
+		// then
+		Expect(sink.records).To(HaveLen(2))
+		Expect(sink.records[0].Type).To(Equal(core_mesh.MeshType))
+		Expect(sink.records[0].Operation).To(Equal(core_store.DeleteOperation))
+		Expect(sink.records[1].Type).To(Equal(system.SecretType))
+		Expect(sink.records[1].Operation).To(Equal(core_store.DeleteOperation))
+		Expect(sink.records[1].Name).To(Equal("secret-1"))
+		Expect(sink.records[1].Mesh).To(Equal("mesh-1"))
+	})
+
+	It("should record once deletion of secrets deleted by the manager of Meshes", func() {
+		// given a secret that is not owned by the Mesh, like secrets on Kubernetes
+		Expect(resManager.Create(context.Background(), &core_mesh.MeshResource{}, core_store.CreateByKey("mesh-1", "mesh-1"))).To(Succeed())
+		secret := &system.SecretResource{Spec: system_proto.Secret{Data: &wrappers.BytesValue{Value: []byte("secret")}}}
+		Expect(memoryStore.Create(context.Background(), secret, core_store.CreateByKey("secret-1", "mesh-1"))).To(Succeed())
+		sink.records = nil
+
+		// when
+		Expect(resManager.Delete(context.Background(), &core_mesh.MeshResource{}, core_store.DeleteByKey("mesh-1", "mesh-1"))).To(Succeed())
+
+		// then the secret is recorded by the manager of Meshes, before the Mesh itself
+		Expect(sink.records).To(HaveLen(2))
+		Expect(sink.records[0].Type).To(Equal(system.SecretType))
+		Expect(sink.records[0].Name).To(Equal("secret-1"))
+		Expect(sink.records[1].Type).To(Equal(core_mesh.MeshType))
+	})
+})
@@ -6,9 +6,11 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/kumahq/kuma/pkg/api-server/definitions"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	"github.com/kumahq/kuma/pkg/core/datasource"
 	"github.com/kumahq/kuma/pkg/core/dns/lookup"
@@ -19,6 +21,7 @@ import (
 	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
 	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
@@ -59,6 +62,7 @@ func buildRuntime(cfg kuma_cp.Config) (core_runtime.Runtime, error) {
 		system.SecretType: builder.SecretStore(),
 		system.ConfigType: builder.ConfigStore(),
 	}))
+	initializeHistoryStore(cfg, builder)
 
 	if err := initializeConfigManager(cfg, builder); err != nil {
 		return nil, err
@@ -171,6 +175,9 @@ func initializeResourceStore(cfg kuma_cp.Config, builder *core_runtime.Builder)
 	case store.PostgresStore:
 		pluginName = core_plugins.Postgres
 		pluginConfig = cfg.Store.Postgres
+	case store.EmbeddedStore:
+		pluginName = core_plugins.Embedded
+		pluginConfig = cfg.Store.Embedded
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
 	}
@@ -181,6 +188,12 @@ func initializeResourceStore(cfg kuma_cp.Config, builder *core_runtime.Builder)
 	if rs, err := plugin.NewResourceStore(builder, pluginConfig); err != nil {
 		return err
 	} else {
+		if backuper, ok := rs.(core_store.Backuper); ok {
+			builder.WithExtensions(core_store.NewBackuperContext(builder.Extensions(), backuper))
+		}
+		if notifier, ok := rs.(core_store.ChangeNotifier); ok {
+			builder.WithExtensions(core_store.NewChangeNotifierContext(builder.Extensions(), notifier))
+		}
 		meteredStore, err := metrics_store.NewMeteredStore(rs, builder.Metrics())
 		if err != nil {
 			return err
@@ -190,13 +203,42 @@ func initializeResourceStore(cfg kuma_cp.Config, builder *core_runtime.Builder)
 	}
 }
 
+// initializeHistoryStore has to be called after Config store is added to the ResourceStore, because revisions
+// are persisted as Config resources.
+func initializeHistoryStore(cfg kuma_cp.Config, builder *core_runtime.Builder) {
+	if cfg.Store.History.MaxRevisions == 0 {
+		return
+	}
+	historyStore := history.NewHistoryStore(builder.ResourceStore(), historyTypes(), cfg.Store.History.MaxRevisions)
+	builder.WithExtensions(history.NewReaderContext(builder.Extensions(), historyStore))
+	builder.WithResourceStore(historyStore)
+}
+
+// historyTypes returns types of resources that are changed by users, which are Meshes and policies.
+func historyTypes() []core_model.ResourceType {
+	var types []core_model.ResourceType
+	for _, definition := range definitions.All {
+		resType := definition.ResourceFactory().GetType()
+		// dataplanes and insights are changed by the Control Plane all the time
+		if definition.ReadOnly || resType == mesh.DataplaneType || resType == mesh.DataplaneInsightType || resType == system.ZoneInsightType {
+			continue
+		}
+		types = append(types, resType)
+	}
+	return types
+}
+
+func auditTypes() []core_model.ResourceType {
+	return append(historyTypes(), system.SecretType)
+}
+
 func initializeSecretStore(cfg kuma_cp.Config, builder *core_runtime.Builder) error {
 	var pluginName core_plugins.PluginName
 	var pluginConfig core_plugins.PluginConfig
 	switch cfg.Store.Type {
 	case store.KubernetesStore:
 		pluginName = core_plugins.Kubernetes
-	case store.MemoryStore, store.PostgresStore:
+	case store.MemoryStore, store.PostgresStore, store.EmbeddedStore:
 		pluginName = core_plugins.Universal
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
@@ -219,7 +261,7 @@ func initializeConfigStore(cfg kuma_cp.Config, builder *core_runtime.Builder) er
 	switch cfg.Store.Type {
 	case store.KubernetesStore:
 		pluginName = core_plugins.Kubernetes
-	case store.MemoryStore, store.PostgresStore:
+	case store.MemoryStore, store.PostgresStore, store.EmbeddedStore:
 		pluginName = core_plugins.Universal
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
@@ -256,10 +298,22 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 	customManagers := map[core_model.ResourceType]core_manager.ResourceManager{}
 	customizableManager := core_manager.NewCustomizableResourceManager(defaultManager, customManagers)
 
+	var resourceManager core_manager.ResourceManager = customizableManager
+	if cfg.Audit.Enabled {
+		sink, err := audit.NewSink(cfg.Audit)
+		if err != nil {
+			return errors.Wrap(err, "could not create an audit sink")
+		}
+		recorder := audit.NewRecorder(sink, auditTypes())
+		builder.WithExtensions(audit.NewRecorderContext(builder.Extensions(), recorder))
+		resourceManager = audit.NewAuditingManager(customizableManager, recorder)
+	}
+
 	meshValidator := mesh_managers.MeshValidator{
 		CaManagers: builder.CaManagers(),
 	}
-	meshManager := mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), meshValidator)
+	// resources of a Mesh are deleted through the auditing manager, so their deletion is audited as well
+	meshManager := mesh_managers.NewMeshManager(builder.ResourceStore(), resourceManager, builder.CaManagers(), registry.Global(), meshValidator)
 	customManagers[mesh.MeshType] = meshManager
 
 	dpManager := dataplane.NewDataplaneManager(builder.ResourceStore(), builder.Config().Multicluster.Remote.Zone)
@@ -275,7 +329,7 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 	switch cfg.Store.Type {
 	case store.KubernetesStore:
 		cipher = secret_cipher.None() // deliberately turn encryption off on Kubernetes
-	case store.MemoryStore, store.PostgresStore:
+	case store.MemoryStore, store.PostgresStore, store.EmbeddedStore:
 		cipher = secret_cipher.TODO() // get back to encryption in universal case
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
@@ -290,13 +344,18 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 	secretManager := secret_manager.NewSecretManager(builder.SecretStore(), cipher, secretValidator)
 	customManagers[system.SecretType] = secretManager
 
-	builder.WithResourceManager(customizableManager)
+	builder.WithResourceManager(resourceManager)
 
 	if builder.Config().Store.Cache.Enabled {
 		cachedManager, err := core_manager.NewCachedManager(customizableManager, builder.Config().Store.Cache.ExpirationTime, builder.Metrics())
 		if err != nil {
 			return err
 		}
+		if notifier, ok := core_store.FromChangeNotifierContext(builder.Extensions()); ok {
+			if err := builder.ComponentManager().Add(core_manager.NewCacheInvalidator(notifier, cachedManager)); err != nil {
+				return err
+			}
+		}
 		builder.WithReadOnlyResourceManager(cachedManager)
 	} else {
 		builder.WithReadOnlyResourceManager(customizableManager)
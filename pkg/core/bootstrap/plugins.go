@@ -6,6 +6,7 @@ import (
 	_ "github.com/kumahq/kuma/pkg/plugins/bootstrap/k8s"
 	_ "github.com/kumahq/kuma/pkg/plugins/bootstrap/universal"
 
+	_ "github.com/kumahq/kuma/pkg/plugins/resources/embedded"
 	_ "github.com/kumahq/kuma/pkg/plugins/resources/k8s"
 	_ "github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	_ "github.com/kumahq/kuma/pkg/plugins/resources/postgres"
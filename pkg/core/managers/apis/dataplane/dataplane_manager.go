@@ -46,6 +46,9 @@ func (m *dataplaneManager) Create(ctx context.Context, resource core_model.Resou
 	if err := m.store.Get(ctx, owner, core_store.GetByKey(opts.Mesh, opts.Mesh)); err != nil {
 		return core_manager.MeshNotFound(opts.Mesh)
 	}
+	if opts.DryRun {
+		return nil
+	}
 
 	return m.store.Create(ctx, resource, append(fs, core_store.CreatedAt(core.Now()))...)
 }
@@ -39,6 +39,9 @@ func (m *dataplaneInsightManager) Create(ctx context.Context, resource core_mode
 	if err := m.store.Get(ctx, &dp, core_store.GetByKey(opts.Name, opts.Mesh)); err != nil {
 		return err
 	}
+	if opts.DryRun {
+		return nil
+	}
 	return m.store.Create(ctx, resource, append(fs, core_store.CreatedAt(core.Now()), core_store.CreateWithOwner(&dp))...)
 }
 
@@ -71,6 +71,9 @@ func (m *meshManager) Create(ctx context.Context, resource core_model.Resource,
 	if err := m.meshValidator.ValidateCreate(ctx, opts.Name, mesh); err != nil {
 		return err
 	}
+	if opts.DryRun {
+		return nil
+	}
 	if err := EnsureEnabledCA(ctx, m.caManagers, mesh, opts.Name); err != nil {
 		return err
 	}
@@ -131,6 +134,9 @@ func (m *meshManager) Update(ctx context.Context, resource core_model.Resource,
 	if err := m.meshValidator.ValidateUpdate(ctx, currentMesh, mesh); err != nil {
 		return err
 	}
+	if core_store.NewUpdateOptions(fs...).DryRun {
+		return nil
+	}
 	if err := EnsureEnabledCA(ctx, m.caManagers, mesh, mesh.Meta.GetName()); err != nil {
 		return err
 	}
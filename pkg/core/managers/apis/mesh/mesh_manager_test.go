@@ -182,6 +182,69 @@ var _ = Describe("Mesh Manager", func() {
 			// then
 			Expect(err).To(MatchError("mtls.backends[0].config.cert: has to be defined; mtls.backends[0].config.key: has to be defined; mtls.backends[1].config.cert: has to be defined; mtls.backends[1].config.key: has to be defined"))
 		})
+
+		It("should validate CAs without persisting Mesh nor creating CAs on dry run", func() {
+			// given
+			meshName := "mesh-1"
+			resKey := model.ResourceKey{
+				Mesh: meshName,
+				Name: meshName,
+			}
+			mesh := core_mesh.MeshResource{
+				Spec: mesh_proto.Mesh{
+					Mtls: &mesh_proto.Mesh_Mtls{
+						EnabledBackend: "builtin-1",
+						Backends: []*mesh_proto.CertificateAuthorityBackend{
+							{
+								Name: "builtin-1",
+								Type: "builtin",
+							},
+						},
+					},
+					Metrics: &mesh_proto.Metrics{
+						Backends: []*mesh_proto.MetricsBackend{
+							{
+								Name: "prometheus-1",
+								Type: mesh_proto.MetricsPrometheusType,
+							},
+						},
+					},
+				},
+			}
+
+			// when
+			err := resManager.Create(context.Background(), &mesh, store.CreateBy(resKey), store.CreateDryRun())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and defaults are applied
+			Expect(mesh.Spec.Metrics.Backends[0].Conf).ToNot(BeNil())
+
+			// and neither Mesh nor CA is created
+			err = resStore.Get(context.Background(), &core_mesh.MeshResource{}, store.GetBy(resKey))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+			_, err = builtinCaManager.GetRootCert(context.Background(), meshName, *mesh.Spec.Mtls.Backends[0])
+			Expect(err).To(HaveOccurred())
+
+			// when CA is invalid
+			invalid := core_mesh.MeshResource{
+				Spec: mesh_proto.Mesh{
+					Mtls: &mesh_proto.Mesh_Mtls{
+						EnabledBackend: "ca-1",
+						Backends: []*mesh_proto.CertificateAuthorityBackend{
+							{
+								Name: "ca-1",
+								Type: "provided",
+							},
+						},
+					},
+				},
+			}
+			err = resManager.Create(context.Background(), &invalid, store.CreateBy(resKey), store.CreateDryRun())
+
+			// then
+			Expect(err).To(MatchError("mtls.backends[0].config.cert: has to be defined; mtls.backends[0].config.key: has to be defined"))
+		})
 	})
 
 	Describe("Update()", func() {
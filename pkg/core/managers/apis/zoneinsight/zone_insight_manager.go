@@ -40,6 +40,9 @@ func (m *zoneInsightManager) Create(ctx context.Context, resource core_model.Res
 	if err := m.store.Get(ctx, &zone, core_store.GetByKey(opts.Name, opts.Mesh)); err != nil {
 		return err
 	}
+	if opts.DryRun {
+		return nil
+	}
 	return m.store.Create(ctx, resource, append(fs, core_store.CreatedAt(core.Now()), core_store.CreateWithOwner(&zone))...)
 }
 
@@ -22,6 +22,7 @@ const (
 	Universal  PluginName = "universal"
 	Memory     PluginName = "memory"
 	Postgres   PluginName = "postgres"
+	Embedded   PluginName = "embedded"
 
 	CaBuiltin  PluginName = "builtin"
 	CaProvided PluginName = "provided"
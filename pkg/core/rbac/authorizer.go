@@ -0,0 +1,151 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+// Authorizer decides whether the user can execute a verb on the resources of a given type in a given mesh.
+// Empty mesh means either a resource that is not scoped to a mesh (like Zone) or an operation on all meshes.
+type Authorizer interface {
+	Authorize(ctx context.Context, user user.User, verb string, resourceType model.ResourceType, mesh string) error
+	// AuthorizeResource decides whether the user can execute a verb on a single resource.
+	// Unlike Authorize it denies access to protected resources, to which Roles cannot grant access.
+	AuthorizeResource(ctx context.Context, user user.User, verb string, resourceType model.ResourceType, key model.ResourceKey) error
+}
+
+type AccessDeniedError struct {
+	User         string
+	Verb         string
+	ResourceType model.ResourceType
+	Mesh         string
+	Name         string
+}
+
+func (a *AccessDeniedError) Error() string {
+	if a.Name != "" {
+		return fmt.Sprintf("user %q cannot %s resource %q of type %q in mesh %q", a.User, a.Verb, a.Name, a.ResourceType, a.Mesh)
+	}
+	if a.Mesh == "" {
+		return fmt.Sprintf("user %q cannot %s resources of type %q", a.User, a.Verb, a.ResourceType)
+	}
+	return fmt.Sprintf("user %q cannot %s resources of type %q in mesh %q", a.User, a.Verb, a.ResourceType, a.Mesh)
+}
+
+func IsAccessDenied(err error) bool {
+	_, ok := errors.Cause(err).(*AccessDeniedError)
+	return ok
+}
+
+// AllowAll is an Authorizer used when authentication of the API Server is disabled.
+var AllowAll Authorizer = &allowAllAuthorizer{}
+
+type allowAllAuthorizer struct{}
+
+func (a *allowAllAuthorizer) Authorize(context.Context, user.User, string, model.ResourceType, string) error {
+	return nil
+}
+
+func (a *allowAllAuthorizer) AuthorizeResource(context.Context, user.User, string, model.ResourceType, model.ResourceKey) error {
+	return nil
+}
+
+// NewRoleAuthorizer returns an Authorizer that grants access based on Role resources.
+// Users in the user.AdminGroup are always granted access.
+// Protected Secrets, like Signing Keys of tokens, are accessible only to admins regardless of Roles,
+// because a Role granting access to Secrets of a mesh would otherwise let a user issue tokens of anyone.
+func NewRoleAuthorizer(resManager manager.ReadOnlyResourceManager, protectedSecrets ...model.ResourceKey) Authorizer {
+	protected := map[model.ResourceKey]bool{}
+	for _, key := range protectedSecrets {
+		protected[key] = true
+	}
+	return &roleAuthorizer{
+		resManager:       resManager,
+		protectedSecrets: protected,
+	}
+}
+
+type roleAuthorizer struct {
+	resManager       manager.ReadOnlyResourceManager
+	protectedSecrets map[model.ResourceKey]bool
+}
+
+func (r *roleAuthorizer) AuthorizeResource(ctx context.Context, u user.User, verb string, resourceType model.ResourceType, key model.ResourceKey) error {
+	if !u.IsAdmin() && resourceType == system.SecretType && r.protectedSecrets[key] {
+		return &AccessDeniedError{
+			User:         u.Name,
+			Verb:         verb,
+			ResourceType: resourceType,
+			Mesh:         key.Mesh,
+			Name:         key.Name,
+		}
+	}
+	return r.Authorize(ctx, u, verb, resourceType, key.Mesh)
+}
+
+func (r *roleAuthorizer) Authorize(ctx context.Context, u user.User, verb string, resourceType model.ResourceType, mesh string) error {
+	if u.IsAdmin() {
+		return nil
+	}
+	roles := &system.RoleResourceList{}
+	if err := r.resManager.List(ctx, roles, store.ListByMesh(model.DefaultMesh)); err != nil {
+		return errors.Wrap(err, "could not retrieve roles")
+	}
+	for _, role := range roles.Items {
+		if !boundTo(&role.Spec, u) {
+			continue
+		}
+		for _, rule := range role.Spec.GetRules() {
+			if matches(rule.GetVerbs(), verb) && matches(rule.GetTypes(), string(resourceType)) && matchesMesh(rule.GetMeshes(), mesh) {
+				return nil
+			}
+		}
+	}
+	return &AccessDeniedError{
+		User:         u.Name,
+		Verb:         verb,
+		ResourceType: resourceType,
+		Mesh:         mesh,
+	}
+}
+
+func boundTo(role *system_proto.Role, u user.User) bool {
+	if matches(role.GetUsers(), u.Name) {
+		return true
+	}
+	for _, group := range u.Groups {
+		if matches(role.GetGroups(), group) {
+			return true
+		}
+	}
+	return false
+}
+
+func matches(values []string, value string) bool {
+	for _, v := range values {
+		if v == system.RoleWildcard || v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesMesh matches empty mesh only with the wildcard, so operations on all meshes
+// and on resources that are not scoped to a mesh require access to every mesh.
+func matchesMesh(meshes []string, mesh string) bool {
+	for _, m := range meshes {
+		if m == system.RoleWildcard || (mesh != "" && m == mesh) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+package rbac_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Role Authorizer", func() {
+
+	var authorizer rbac.Authorizer
+	signingKey := model.ResourceKey{Mesh: model.DefaultMesh, Name: "signing-key"}
+
+	BeforeEach(func() {
+		store := memory.NewStore()
+		authorizer = rbac.NewRoleAuthorizer(core_manager.NewResourceManager(store), signingKey)
+
+		roles := map[string]system_proto.Role{
+			"demo-viewer": {
+				Users: []string{"john"},
+				Rules: []*system_proto.Role_Rule{
+					{
+						Meshes: []string{"demo"},
+						Types:  []string{"*"},
+						Verbs:  []string{system.VerbGet, system.VerbList},
+					},
+				},
+			},
+			"default-viewer": {
+				Users: []string{"mark"},
+				Rules: []*system_proto.Role_Rule{
+					{
+						Meshes: []string{model.DefaultMesh},
+						Types:  []string{"*"},
+						Verbs:  []string{system.VerbGet},
+					},
+				},
+			},
+			"traffic-operator": {
+				Groups: []string{"operators"},
+				Rules: []*system_proto.Role_Rule{
+					{
+						Meshes: []string{"*"},
+						Types:  []string{string(mesh.TrafficPermissionType), string(mesh.TrafficRouteType)},
+						Verbs:  []string{"*"},
+					},
+				},
+			},
+		}
+		for name, spec := range roles {
+			role := &system.RoleResource{Spec: spec}
+			err := store.Create(context.Background(), role, core_store.CreateByKey(name, model.DefaultMesh))
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	type testCase struct {
+		user         user.User
+		verb         string
+		resourceType model.ResourceType
+		mesh         string
+		allowed      bool
+	}
+
+	DescribeTable("should authorize",
+		func(given testCase) {
+			// when
+			err := authorizer.Authorize(context.Background(), given.user, given.verb, given.resourceType, given.mesh)
+
+			// then
+			if given.allowed {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(rbac.IsAccessDenied(err)).To(BeTrue())
+			}
+		},
+		Entry("admin regardless of roles", testCase{
+			user:         user.Admin,
+			verb:         system.VerbDelete,
+			resourceType: system.ZoneType,
+			allowed:      true,
+		}),
+		Entry("user bound by name to get resources in a mesh", testCase{
+			user:         user.User{Name: "john"},
+			verb:         system.VerbGet,
+			resourceType: mesh.DataplaneType,
+			mesh:         "demo",
+			allowed:      true,
+		}),
+		Entry("user bound by name to modify resources in a mesh", testCase{
+			user:         user.User{Name: "john"},
+			verb:         system.VerbUpdate,
+			resourceType: mesh.DataplaneType,
+			mesh:         "demo",
+			allowed:      false,
+		}),
+		Entry("user bound by name to get resources in other mesh", testCase{
+			user:         user.User{Name: "john"},
+			verb:         system.VerbGet,
+			resourceType: mesh.DataplaneType,
+			mesh:         "other",
+			allowed:      false,
+		}),
+		Entry("user bound by name to list resources in all meshes", testCase{
+			user:         user.User{Name: "john"},
+			verb:         system.VerbList,
+			resourceType: mesh.DataplaneType,
+			mesh:         "",
+			allowed:      false,
+		}),
+		Entry("user bound by group to create resources of a listed type", testCase{
+			user:         user.User{Name: "jane", Groups: []string{"operators"}},
+			verb:         system.VerbCreate,
+			resourceType: mesh.TrafficRouteType,
+			mesh:         "demo",
+			allowed:      true,
+		}),
+		Entry("user bound by group to list resources of a listed type in all meshes", testCase{
+			user:         user.User{Name: "jane", Groups: []string{"operators"}},
+			verb:         system.VerbList,
+			resourceType: mesh.TrafficPermissionType,
+			mesh:         "",
+			allowed:      true,
+		}),
+		Entry("user bound by group to create resources of other type", testCase{
+			user:         user.User{Name: "jane", Groups: []string{"operators"}},
+			verb:         system.VerbCreate,
+			resourceType: mesh.TrafficLogType,
+			mesh:         "demo",
+			allowed:      false,
+		}),
+		Entry("user without roles", testCase{
+			user:         user.User{Name: "anonymous"},
+			verb:         system.VerbGet,
+			resourceType: mesh.MeshType,
+			mesh:         "demo",
+			allowed:      false,
+		}),
+	)
+
+	type resourceTestCase struct {
+		user         user.User
+		resourceType model.ResourceType
+		key          model.ResourceKey
+		allowed      bool
+	}
+
+	DescribeTable("should authorize a single resource",
+		func(given resourceTestCase) {
+			// when
+			err := authorizer.AuthorizeResource(context.Background(), given.user, system.VerbGet, given.resourceType, given.key)
+
+			// then
+			if given.allowed {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(rbac.IsAccessDenied(err)).To(BeTrue())
+			}
+		},
+		Entry("secret granted by a role", resourceTestCase{
+			user:         user.User{Name: "mark"},
+			resourceType: system.SecretType,
+			key:          model.ResourceKey{Mesh: model.DefaultMesh, Name: "db-password"},
+			allowed:      true,
+		}),
+		Entry("protected secret granted by a role", resourceTestCase{
+			user:         user.User{Name: "mark"},
+			resourceType: system.SecretType,
+			key:          signingKey,
+			allowed:      false,
+		}),
+		Entry("protected secret to admin", resourceTestCase{
+			user:         user.Admin,
+			resourceType: system.SecretType,
+			key:          signingKey,
+			allowed:      true,
+		}),
+		Entry("resource of other type with a name of protected secret", resourceTestCase{
+			user:         user.User{Name: "mark"},
+			resourceType: mesh.DataplaneType,
+			key:          signingKey,
+			allowed:      true,
+		}),
+		Entry("secret in a mesh not granted by a role", resourceTestCase{
+			user:         user.User{Name: "mark"},
+			resourceType: system.SecretType,
+			key:          model.ResourceKey{Mesh: "demo", Name: "db-password"},
+			allowed:      false,
+		}),
+	)
+})
@@ -6,6 +6,7 @@ import (
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
 )
 
 const (
@@ -45,6 +46,20 @@ func (t *DataplaneResource) Scope() model.ResourceScope {
 	return model.ScopeMesh
 }
 
+var _ store.TaggedResource = &DataplaneResource{}
+
+// TagSets returns tags of every inbound and of the gateway
+func (t *DataplaneResource) TagSets() []map[string]string {
+	var tagSets []map[string]string
+	for _, inbound := range t.Spec.GetNetworking().GetInbound() {
+		tagSets = append(tagSets, inbound.GetTags())
+	}
+	if gateway := t.Spec.GetNetworking().GetGateway(); gateway != nil {
+		tagSets = append(tagSets, gateway.GetTags())
+	}
+	return tagSets
+}
+
 var _ model.ResourceList = &DataplaneResourceList{}
 
 type DataplaneResourceList struct {
@@ -19,6 +19,10 @@ const (
 	ProtocolHTTP    = "http"
 	ProtocolHTTP2   = "http2"
 	ProtocolGRPC    = "grpc"
+	ProtocolKafka   = "kafka"
+	ProtocolMongo   = "mongo"
+	ProtocolMySQL   = "mysql"
+	ProtocolRedis   = "redis"
 )
 
 func ParseProtocol(tag string) Protocol {
@@ -31,6 +35,14 @@ func ParseProtocol(tag string) Protocol {
 		return ProtocolTCP
 	case ProtocolGRPC:
 		return ProtocolGRPC
+	case ProtocolKafka:
+		return ProtocolKafka
+	case ProtocolMongo:
+		return ProtocolMongo
+	case ProtocolMySQL:
+		return ProtocolMySQL
+	case ProtocolRedis:
+		return ProtocolRedis
 	default:
 		return ProtocolUnknown
 	}
@@ -52,6 +64,10 @@ var SupportedProtocols = ProtocolList{
 	ProtocolGRPC,
 	ProtocolHTTP,
 	ProtocolHTTP2,
+	ProtocolKafka,
+	ProtocolMongo,
+	ProtocolMySQL,
+	ProtocolRedis,
 	ProtocolTCP,
 }
 
@@ -468,13 +468,21 @@ var _ = Describe("ParseProtocol()", func() {
 			tag:      "grpc",
 			expected: ProtocolGRPC,
 		}),
+		Entry("kafka", testCase{
+			tag:      "kafka",
+			expected: ProtocolKafka,
+		}),
 		Entry("mongo", testCase{
 			tag:      "mongo",
-			expected: ProtocolUnknown,
+			expected: ProtocolMongo,
 		}),
 		Entry("mysql", testCase{
 			tag:      "mysql",
-			expected: ProtocolUnknown,
+			expected: ProtocolMySQL,
+		}),
+		Entry("redis", testCase{
+			tag:      "redis",
+			expected: ProtocolRedis,
 		}),
 		Entry("unknown", testCase{
 			tag:      "unknown",
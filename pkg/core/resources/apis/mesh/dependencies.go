@@ -0,0 +1,86 @@
+package mesh
+
+import (
+	"errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+)
+
+const (
+	DependenciesType model.ResourceType = "Dependencies"
+)
+
+var _ model.Resource = &DependenciesResource{}
+
+type DependenciesResource struct {
+	Meta model.ResourceMeta
+	Spec mesh_proto.Dependencies
+}
+
+func (t *DependenciesResource) GetType() model.ResourceType {
+	return DependenciesType
+}
+func (t *DependenciesResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+func (t *DependenciesResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+func (t *DependenciesResource) GetSpec() model.ResourceSpec {
+	return &t.Spec
+}
+func (t *DependenciesResource) SetSpec(spec model.ResourceSpec) error {
+	dependencies, ok := spec.(*mesh_proto.Dependencies)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		t.Spec = *dependencies
+		return nil
+	}
+}
+func (t *DependenciesResource) Scope() model.ResourceScope {
+	return model.ScopeMesh
+}
+
+var _ model.ResourceList = &DependenciesResourceList{}
+
+type DependenciesResourceList struct {
+	Items      []*DependenciesResource
+	Pagination model.Pagination
+}
+
+func (l *DependenciesResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+func (l *DependenciesResourceList) GetItemType() model.ResourceType {
+	return DependenciesType
+}
+func (l *DependenciesResourceList) NewItem() model.Resource {
+	return &DependenciesResource{}
+}
+func (l *DependenciesResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*DependenciesResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*DependenciesResource)(nil), r)
+	}
+}
+func (l *DependenciesResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func init() {
+	registry.RegisterType(&DependenciesResource{})
+	registry.RegistryListType(&DependenciesResourceList{})
+}
+
+func (t *DependenciesResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.Selectors
+}
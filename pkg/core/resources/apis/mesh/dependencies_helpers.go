@@ -0,0 +1,15 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// Selects returns true if any of the selectors of Dependencies matches the Dataplane.
+func (d *DependenciesResource) Selects(dataplane *mesh_proto.Dataplane) bool {
+	for _, selector := range d.Spec.Selectors {
+		if dataplane.Matches(selector.Match) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package mesh_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+var _ = Describe("DependenciesResource", func() {
+
+	Describe("Selects()", func() {
+		dependencies := &DependenciesResource{
+			Spec: mesh_proto.Dependencies{
+				Selectors: []*mesh_proto.Selector{{
+					Match: map[string]string{
+						mesh_proto.ServiceTag: "web",
+						"version":             "v1",
+					},
+				}},
+			},
+		}
+
+		dataplaneWithTags := func(tags map[string]string) *mesh_proto.Dataplane {
+			return &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+						Port: 8080,
+						Tags: tags,
+					}},
+				},
+			}
+		}
+
+		It("should select a Dataplane matched by a selector", func() {
+			Expect(dependencies.Selects(dataplaneWithTags(map[string]string{
+				mesh_proto.ServiceTag: "web",
+				"version":             "v1",
+				"region":              "eu",
+			}))).To(BeTrue())
+		})
+
+		It("should not select a Dataplane that is not matched by any selector", func() {
+			Expect(dependencies.Selects(dataplaneWithTags(map[string]string{
+				mesh_proto.ServiceTag: "web",
+				"version":             "v2",
+			}))).To(BeFalse())
+		})
+	})
+})
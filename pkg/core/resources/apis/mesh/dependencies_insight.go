@@ -0,0 +1,87 @@
+package mesh
+
+import (
+	"errors"
+
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+const (
+	DependenciesInsightType model.ResourceType = "DependenciesInsight"
+)
+
+var _ model.Resource = &DependenciesInsightResource{}
+
+type DependenciesInsightResource struct {
+	Meta model.ResourceMeta
+	Spec mesh_proto.DependenciesInsight
+}
+
+func (t *DependenciesInsightResource) GetType() model.ResourceType {
+	return DependenciesInsightType
+}
+func (t *DependenciesInsightResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+func (t *DependenciesInsightResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+func (t *DependenciesInsightResource) GetSpec() model.ResourceSpec {
+	return &t.Spec
+}
+func (t *DependenciesInsightResource) SetSpec(spec model.ResourceSpec) error {
+	status, ok := spec.(*mesh_proto.DependenciesInsight)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		t.Spec = *status
+		return nil
+	}
+}
+func (t *DependenciesInsightResource) Validate() error {
+	return nil
+}
+func (t *DependenciesInsightResource) Scope() model.ResourceScope {
+	return model.ScopeMesh
+}
+
+var _ model.ResourceList = &DependenciesInsightResourceList{}
+
+type DependenciesInsightResourceList struct {
+	Items      []*DependenciesInsightResource
+	Pagination model.Pagination
+}
+
+func (l *DependenciesInsightResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+func (l *DependenciesInsightResourceList) GetItemType() model.ResourceType {
+	return DependenciesInsightType
+}
+func (l *DependenciesInsightResourceList) NewItem() model.Resource {
+	return &DependenciesInsightResource{}
+}
+func (l *DependenciesInsightResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*DependenciesInsightResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*DependenciesInsightResource)(nil), r)
+	}
+}
+
+func (l *DependenciesInsightResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func init() {
+	registry.RegisterType(&DependenciesInsightResource{})
+	registry.RegistryListType(&DependenciesInsightResourceList{})
+}
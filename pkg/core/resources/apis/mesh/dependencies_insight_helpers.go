@@ -0,0 +1,106 @@
+package mesh
+
+import (
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// LearnDestinations records destinations that a Dataplane reported at the given time.
+// Destinations that were not reported within ttl are forgotten and at most limit destinations are kept,
+// the ones reported least recently are forgotten first. Learned destinations are kept sorted by address.
+// Returns true if learned destinations have changed.
+func (d *DependenciesInsightResource) LearnDestinations(destinations []string, now time.Time, ttl time.Duration, limit int) bool {
+	lastSeen, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return false
+	}
+	changed := d.Prune(now, ttl)
+	known := map[string]*mesh_proto.DependenciesInsight_LearnedDestination{}
+	for _, learned := range d.Spec.LearnedDestinations {
+		known[learned.Address] = learned
+	}
+	for _, destination := range destinations {
+		if destination == "" {
+			continue
+		}
+		changed = true
+		if learned, ok := known[destination]; ok {
+			learned.LastSeen = lastSeen
+			continue
+		}
+		learned := &mesh_proto.DependenciesInsight_LearnedDestination{
+			Address:  destination,
+			LastSeen: lastSeen,
+		}
+		known[destination] = learned
+		d.Spec.LearnedDestinations = append(d.Spec.LearnedDestinations, learned)
+	}
+	if len(d.Spec.LearnedDestinations) > limit {
+		sort.SliceStable(d.Spec.LearnedDestinations, func(i, j int) bool {
+			return d.lastSeen(i).After(d.lastSeen(j))
+		})
+		d.Spec.LearnedDestinations = d.Spec.LearnedDestinations[:limit]
+	}
+	sort.Slice(d.Spec.LearnedDestinations, func(i, j int) bool {
+		return d.Spec.LearnedDestinations[i].Address < d.Spec.LearnedDestinations[j].Address
+	})
+	return changed
+}
+
+// RefreshDestinations records that already learned destinations are still in use at the given time.
+// Only destinations that were last reported at least refreshInterval ago are refreshed, so the insight is not
+// updated on every report. Unknown destinations are ignored. Returns true if learned destinations have changed.
+func (d *DependenciesInsightResource) RefreshDestinations(destinations []string, now time.Time, refreshInterval time.Duration) bool {
+	lastSeen, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return false
+	}
+	refresh := map[string]bool{}
+	for _, destination := range destinations {
+		refresh[destination] = true
+	}
+	changed := false
+	for i, learned := range d.Spec.LearnedDestinations {
+		if refresh[learned.Address] && now.Sub(d.lastSeen(i)) >= refreshInterval {
+			learned.LastSeen = lastSeen
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Prune forgets destinations that were not reported within ttl. Returns true if any destination was forgotten.
+func (d *DependenciesInsightResource) Prune(now time.Time, ttl time.Duration) bool {
+	var valid []*mesh_proto.DependenciesInsight_LearnedDestination
+	for i, learned := range d.Spec.LearnedDestinations {
+		if now.Sub(d.lastSeen(i)) < ttl {
+			valid = append(valid, learned)
+		}
+	}
+	pruned := len(valid) != len(d.Spec.LearnedDestinations)
+	d.Spec.LearnedDestinations = valid
+	return pruned
+}
+
+// Destinations returns addresses of destinations that were reported within ttl.
+func (d *DependenciesInsightResource) Destinations(now time.Time, ttl time.Duration) []string {
+	var destinations []string
+	for i, learned := range d.Spec.LearnedDestinations {
+		if now.Sub(d.lastSeen(i)) < ttl {
+			destinations = append(destinations, learned.Address)
+		}
+	}
+	return destinations
+}
+
+func (d *DependenciesInsightResource) lastSeen(i int) time.Time {
+	lastSeen, err := ptypes.Timestamp(d.Spec.LearnedDestinations[i].LastSeen)
+	if err != nil {
+		return time.Time{}
+	}
+	return lastSeen
+}
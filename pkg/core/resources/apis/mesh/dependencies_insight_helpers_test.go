@@ -0,0 +1,204 @@
+package mesh_test
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+var _ = Describe("DependenciesInsightResource", func() {
+
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	learned := func(address string, lastSeen time.Time) *mesh_proto.DependenciesInsight_LearnedDestination {
+		ts, err := ptypes.TimestampProto(lastSeen)
+		Expect(err).ToNot(HaveOccurred())
+		return &mesh_proto.DependenciesInsight_LearnedDestination{
+			Address:  address,
+			LastSeen: ts,
+		}
+	}
+
+	Describe("LearnDestinations()", func() {
+		It("should add new destinations and refresh known ones", func() {
+			// given
+			insight := &DependenciesInsightResource{
+				Spec: mesh_proto.DependenciesInsight{
+					LearnedDestinations: []*mesh_proto.DependenciesInsight_LearnedDestination{
+						learned("10.0.0.2:80", now.Add(-time.Hour)),
+					},
+				},
+			}
+
+			// when
+			changed := insight.LearnDestinations([]string{"10.0.0.3:80", "10.0.0.2:80", "10.0.0.1:443", "10.0.0.3:80"}, now, 24*time.Hour, 10)
+
+			// then
+			Expect(changed).To(BeTrue())
+			Expect(insight.Spec.LearnedDestinations).To(Equal([]*mesh_proto.DependenciesInsight_LearnedDestination{
+				learned("10.0.0.1:443", now),
+				learned("10.0.0.2:80", now),
+				learned("10.0.0.3:80", now),
+			}))
+		})
+
+		It("should forget destinations that were not reported within TTL", func() {
+			// given
+			insight := &DependenciesInsightResource{
+				Spec: mesh_proto.DependenciesInsight{
+					LearnedDestinations: []*mesh_proto.DependenciesInsight_LearnedDestination{
+						learned("10.0.0.1:80", now.Add(-25*time.Hour)),
+						learned("10.0.0.2:80", now.Add(-23*time.Hour)),
+					},
+				},
+			}
+
+			// when
+			changed := insight.LearnDestinations([]string{"10.0.0.3:80"}, now, 24*time.Hour, 10)
+
+			// then
+			Expect(changed).To(BeTrue())
+			Expect(insight.Spec.LearnedDestinations).To(Equal([]*mesh_proto.DependenciesInsight_LearnedDestination{
+				learned("10.0.0.2:80", now.Add(-23*time.Hour)),
+				learned("10.0.0.3:80", now),
+			}))
+		})
+
+		It("should forget destinations reported least recently above the limit", func() {
+			// given
+			insight := &DependenciesInsightResource{
+				Spec: mesh_proto.DependenciesInsight{
+					LearnedDestinations: []*mesh_proto.DependenciesInsight_LearnedDestination{
+						learned("10.0.0.1:80", now.Add(-time.Hour)),
+						learned("10.0.0.2:80", now.Add(-3*time.Hour)),
+						learned("10.0.0.3:80", now.Add(-2*time.Hour)),
+					},
+				},
+			}
+
+			// when
+			changed := insight.LearnDestinations([]string{"10.0.0.4:80"}, now, 24*time.Hour, 3)
+
+			// then
+			Expect(changed).To(BeTrue())
+			Expect(insight.Spec.LearnedDestinations).To(Equal([]*mesh_proto.DependenciesInsight_LearnedDestination{
+				learned("10.0.0.1:80", now.Add(-time.Hour)),
+				learned("10.0.0.3:80", now.Add(-2*time.Hour)),
+				learned("10.0.0.4:80", now),
+			}))
+		})
+
+		It("should not change anything when nothing is reported and nothing expired", func() {
+			// given
+			insight := &DependenciesInsightResource{
+				Spec: mesh_proto.DependenciesInsight{
+					LearnedDestinations: []*mesh_proto.DependenciesInsight_LearnedDestination{
+						learned("10.0.0.1:80", now.Add(-time.Hour)),
+					},
+				},
+			}
+
+			// when
+			changed := insight.LearnDestinations(nil, now, 24*time.Hour, 10)
+
+			// then
+			Expect(changed).To(BeFalse())
+			Expect(insight.Spec.LearnedDestinations).To(HaveLen(1))
+		})
+	})
+
+	Describe("RefreshDestinations()", func() {
+		It("should refresh only known destinations reported at least the refresh interval ago", func() {
+			// given
+			insight := &DependenciesInsightResource{
+				Spec: mesh_proto.DependenciesInsight{
+					LearnedDestinations: []*mesh_proto.DependenciesInsight_LearnedDestination{
+						learned("10.0.0.1:80", now.Add(-13*time.Hour)),
+						learned("10.0.0.2:80", now.Add(-time.Hour)),
+						learned("10.0.0.3:80", now.Add(-13*time.Hour)),
+					},
+				},
+			}
+
+			// when
+			changed := insight.RefreshDestinations([]string{"10.0.0.1:80", "10.0.0.2:80", "10.0.0.4:80"}, now, 12*time.Hour)
+
+			// then
+			Expect(changed).To(BeTrue())
+			Expect(insight.Spec.LearnedDestinations).To(Equal([]*mesh_proto.DependenciesInsight_LearnedDestination{
+				learned("10.0.0.1:80", now),
+				learned("10.0.0.2:80", now.Add(-time.Hour)),
+				learned("10.0.0.3:80", now.Add(-13*time.Hour)),
+			}))
+		})
+
+		It("should not change anything when destinations were refreshed recently", func() {
+			// given
+			insight := &DependenciesInsightResource{
+				Spec: mesh_proto.DependenciesInsight{
+					LearnedDestinations: []*mesh_proto.DependenciesInsight_LearnedDestination{
+						learned("10.0.0.1:80", now.Add(-time.Hour)),
+					},
+				},
+			}
+
+			// when
+			changed := insight.RefreshDestinations([]string{"10.0.0.1:80"}, now, 12*time.Hour)
+
+			// then
+			Expect(changed).To(BeFalse())
+		})
+	})
+
+	Describe("Prune()", func() {
+		It("should forget only expired destinations", func() {
+			// given
+			insight := &DependenciesInsightResource{
+				Spec: mesh_proto.DependenciesInsight{
+					LearnedDestinations: []*mesh_proto.DependenciesInsight_LearnedDestination{
+						learned("10.0.0.1:80", now.Add(-2*time.Hour)),
+						learned("10.0.0.2:80", now.Add(-30*time.Minute)),
+					},
+				},
+			}
+
+			// when
+			pruned := insight.Prune(now, time.Hour)
+
+			// then
+			Expect(pruned).To(BeTrue())
+			Expect(insight.Destinations(now, time.Hour)).To(Equal([]string{"10.0.0.2:80"}))
+
+			// when
+			pruned = insight.Prune(now, time.Hour)
+
+			// then
+			Expect(pruned).To(BeFalse())
+		})
+	})
+
+	Describe("Destinations()", func() {
+		It("should skip expired destinations", func() {
+			// given
+			insight := &DependenciesInsightResource{
+				Spec: mesh_proto.DependenciesInsight{
+					LearnedDestinations: []*mesh_proto.DependenciesInsight_LearnedDestination{
+						learned("10.0.0.1:80", now.Add(-2*time.Hour)),
+						learned("10.0.0.2:80", now.Add(-30*time.Minute)),
+					},
+				},
+			}
+
+			// expect
+			Expect(insight.Destinations(now, time.Hour)).To(Equal([]string{"10.0.0.2:80"}))
+			// and destinations are not pruned
+			Expect(insight.Spec.LearnedDestinations).To(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package mesh
+
+import (
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (d *DependenciesResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(validateSelectors(d.Spec.Selectors))
+	err.Add(d.validateServices())
+	return err.OrNil()
+}
+
+func (d *DependenciesResource) validateServices() (err validators.ValidationError) {
+	root := validators.RootedAt("services")
+	if len(d.Spec.Services) == 0 && !d.Spec.Learn {
+		err.AddViolationAt(root, "must have at least one element when learn is disabled")
+	}
+	for i, service := range d.Spec.Services {
+		if service == "" {
+			err.AddViolationAt(root.Index(i), "cannot be empty")
+		}
+	}
+	return
+}
@@ -0,0 +1,98 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Dependencies", func() {
+	Describe("Validate()", func() {
+		It("should pass validation", func() {
+			// given
+			dependencies := DependenciesResource{}
+			spec := `
+            selectors:
+            - match:
+                kuma.io/service: web
+            services:
+            - backend
+            learn: true
+`
+			Expect(util_proto.FromYAML([]byte(spec), &dependencies.Spec)).To(Succeed())
+
+			// when
+			err := dependencies.Validate()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		type testCase struct {
+			dependencies string
+			expected     string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				dependencies := DependenciesResource{}
+
+				// when
+				err := util_proto.FromYAML([]byte(given.dependencies), &dependencies.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := dependencies.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				dependencies: ``,
+				expected: `
+                violations:
+                - field: services
+                  message: must have at least one element when learn is disabled
+`,
+			}),
+			Entry("empty service", testCase{
+				dependencies: `
+                selectors:
+                - match:
+                    kuma.io/service: web
+                services:
+                - ""
+                learn: true
+`,
+				expected: `
+                violations:
+                - field: services[0]
+                  message: cannot be empty
+`,
+			}),
+			Entry("selector without service", testCase{
+				dependencies: `
+                selectors:
+                - match:
+                    version: v1
+                services:
+                - backend
+`,
+				expected: `
+                violations:
+                - field: selectors[0].match
+                  message: mandatory tag "kuma.io/service" is missing
+`,
+			}),
+		)
+	})
+})
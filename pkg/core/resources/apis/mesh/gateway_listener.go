@@ -0,0 +1,86 @@
+package mesh
+
+import (
+	"errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+)
+
+const (
+	GatewayListenerType model.ResourceType = "GatewayListener"
+)
+
+var _ model.Resource = &GatewayListenerResource{}
+
+type GatewayListenerResource struct {
+	Meta model.ResourceMeta
+	Spec mesh_proto.GatewayListener
+}
+
+func (t *GatewayListenerResource) GetType() model.ResourceType {
+	return GatewayListenerType
+}
+func (t *GatewayListenerResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+func (t *GatewayListenerResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+func (t *GatewayListenerResource) GetSpec() model.ResourceSpec {
+	return &t.Spec
+}
+func (t *GatewayListenerResource) SetSpec(spec model.ResourceSpec) error {
+	gatewayListener, ok := spec.(*mesh_proto.GatewayListener)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		t.Spec = *gatewayListener
+		return nil
+	}
+}
+func (t *GatewayListenerResource) Scope() model.ResourceScope {
+	return model.ScopeMesh
+}
+
+var _ model.ResourceList = &GatewayListenerResourceList{}
+
+type GatewayListenerResourceList struct {
+	Items      []*GatewayListenerResource
+	Pagination model.Pagination
+}
+
+func (l *GatewayListenerResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+func (l *GatewayListenerResourceList) GetItemType() model.ResourceType {
+	return GatewayListenerType
+}
+func (l *GatewayListenerResourceList) NewItem() model.Resource {
+	return &GatewayListenerResource{}
+}
+func (l *GatewayListenerResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*GatewayListenerResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*GatewayListenerResource)(nil), r)
+	}
+}
+func (l *GatewayListenerResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func init() {
+	registry.RegisterType(&GatewayListenerResource{})
+	registry.RegistryListType(&GatewayListenerResourceList{})
+}
+
+func (t *GatewayListenerResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.Selectors
+}
@@ -0,0 +1,56 @@
+package mesh
+
+import (
+	"fmt"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (l *GatewayListenerResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(validateSelectors(l.Spec.Selectors))
+	err.Add(l.validateListeners())
+	return err.OrNil()
+}
+
+func (l *GatewayListenerResource) validateListeners() (err validators.ValidationError) {
+	root := validators.RootedAt("listeners")
+	if len(l.Spec.Listeners) == 0 {
+		err.AddViolationAt(root, "must have at least one element")
+	}
+	protocols := map[uint32]mesh_proto.GatewayListener_Listener_Protocol{}
+	hostnames := map[string]bool{}
+	for i, listener := range l.Spec.Listeners {
+		path := root.Index(i)
+		if listener.Port == 0 || listener.Port > 65535 {
+			err.AddViolationAt(path.Field("port"), "port must be in the range [1, 65535]")
+		}
+		if protocol, ok := protocols[listener.Port]; ok && protocol != listener.Protocol {
+			err.AddViolationAt(path.Field("protocol"), fmt.Sprintf("port %d is already used by %s listener", listener.Port, protocol))
+		} else {
+			protocols[listener.Port] = listener.Protocol
+		}
+		hostname := listener.Hostname
+		if hostname == "" {
+			hostname = mesh_proto.MatchAllTag
+		}
+		key := fmt.Sprintf("%d:%s", listener.Port, hostname)
+		if hostnames[key] {
+			err.AddViolationAt(path.Field("hostname"), fmt.Sprintf("hostname %q is already defined for port %d", hostname, listener.Port))
+		}
+		hostnames[key] = true
+		if listener.Protocol == mesh_proto.GatewayListener_Listener_HTTP && listener.Tls != nil {
+			err.AddViolationAt(path.Field("tls"), "cannot be defined for HTTP listener")
+		}
+		if listener.Protocol == mesh_proto.GatewayListener_Listener_HTTPS {
+			if listener.GetTls().GetCertificate() == nil {
+				err.AddViolationAt(path.Field("tls").Field("certificate"), "has to be defined for HTTPS listener")
+			}
+			if listener.GetTls().GetKey() == nil {
+				err.AddViolationAt(path.Field("tls").Field("key"), "has to be defined for HTTPS listener")
+			}
+		}
+	}
+	return
+}
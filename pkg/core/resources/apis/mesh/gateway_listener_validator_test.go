@@ -0,0 +1,146 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("GatewayListener", func() {
+	Describe("Validate()", func() {
+		It("should pass validation", func() {
+			// given
+			listener := GatewayListenerResource{}
+			spec := `
+            selectors:
+            - match:
+                kuma.io/service: gateway
+            listeners:
+            - port: 80
+            - port: 443
+              protocol: HTTPS
+              hostname: example.com
+              tls:
+                certificate:
+                  secret: example-cert
+                key:
+                  secret: example-key
+`
+			Expect(util_proto.FromYAML([]byte(spec), &listener.Spec)).To(Succeed())
+
+			// when
+			err := listener.Validate()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		type testCase struct {
+			listener string
+			expected string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				listener := GatewayListenerResource{}
+
+				// when
+				err := util_proto.FromYAML([]byte(given.listener), &listener.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := listener.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				listener: ``,
+				expected: `
+                violations:
+                - field: listeners
+                  message: must have at least one element
+`,
+			}),
+			Entry("invalid port and HTTPS listener without TLS", testCase{
+				listener: `
+                selectors:
+                - match:
+                    kuma.io/service: gateway
+                listeners:
+                - port: 70000
+                - port: 443
+                  protocol: HTTPS
+`,
+				expected: `
+                violations:
+                - field: listeners[0].port
+                  message: port must be in the range [1, 65535]
+                - field: listeners[1].tls.certificate
+                  message: has to be defined for HTTPS listener
+                - field: listeners[1].tls.key
+                  message: has to be defined for HTTPS listener
+`,
+			}),
+			Entry("conflicting listeners", testCase{
+				listener: `
+                selectors:
+                - match:
+                    kuma.io/service: gateway
+                listeners:
+                - port: 8080
+                - port: 8080
+                  hostname: '*'
+                  tls:
+                    certificate:
+                      secret: cert
+                - port: 8080
+                  protocol: HTTPS
+                  hostname: example.com
+                  tls:
+                    certificate:
+                      secret: cert
+                    key:
+                      secret: key
+`,
+				expected: `
+                violations:
+                - field: listeners[1].hostname
+                  message: hostname "*" is already defined for port 8080
+                - field: listeners[1].tls
+                  message: cannot be defined for HTTP listener
+                - field: listeners[2].protocol
+                  message: port 8080 is already used by HTTP listener
+`,
+			}),
+			Entry("empty hostname matches all hostnames", testCase{
+				listener: `
+                selectors:
+                - match:
+                    kuma.io/service: gateway
+                listeners:
+                - port: 80
+                  hostname: '*'
+                - port: 80
+                  hostname: ''
+                - port: 8080
+                  hostname: ''
+`,
+				expected: `
+                violations:
+                - field: listeners[1].hostname
+                  message: hostname "*" is already defined for port 80
+`,
+			}),
+		)
+	})
+})
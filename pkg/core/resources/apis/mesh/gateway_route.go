@@ -0,0 +1,86 @@
+package mesh
+
+import (
+	"errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+)
+
+const (
+	GatewayRouteType model.ResourceType = "GatewayRoute"
+)
+
+var _ model.Resource = &GatewayRouteResource{}
+
+type GatewayRouteResource struct {
+	Meta model.ResourceMeta
+	Spec mesh_proto.GatewayRoute
+}
+
+func (t *GatewayRouteResource) GetType() model.ResourceType {
+	return GatewayRouteType
+}
+func (t *GatewayRouteResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+func (t *GatewayRouteResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+func (t *GatewayRouteResource) GetSpec() model.ResourceSpec {
+	return &t.Spec
+}
+func (t *GatewayRouteResource) SetSpec(spec model.ResourceSpec) error {
+	gatewayRoute, ok := spec.(*mesh_proto.GatewayRoute)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		t.Spec = *gatewayRoute
+		return nil
+	}
+}
+func (t *GatewayRouteResource) Scope() model.ResourceScope {
+	return model.ScopeMesh
+}
+
+var _ model.ResourceList = &GatewayRouteResourceList{}
+
+type GatewayRouteResourceList struct {
+	Items      []*GatewayRouteResource
+	Pagination model.Pagination
+}
+
+func (l *GatewayRouteResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+func (l *GatewayRouteResourceList) GetItemType() model.ResourceType {
+	return GatewayRouteType
+}
+func (l *GatewayRouteResourceList) NewItem() model.Resource {
+	return &GatewayRouteResource{}
+}
+func (l *GatewayRouteResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*GatewayRouteResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*GatewayRouteResource)(nil), r)
+	}
+}
+func (l *GatewayRouteResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func init() {
+	registry.RegisterType(&GatewayRouteResource{})
+	registry.RegistryListType(&GatewayRouteResourceList{})
+}
+
+func (t *GatewayRouteResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.Selectors
+}
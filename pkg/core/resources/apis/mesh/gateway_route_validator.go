@@ -0,0 +1,69 @@
+package mesh
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (r *GatewayRouteResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(validateSelectors(r.Spec.Selectors))
+	err.Add(r.validateHostnames())
+	err.Add(r.validateRules())
+	return err.OrNil()
+}
+
+func (r *GatewayRouteResource) validateHostnames() (err validators.ValidationError) {
+	root := validators.RootedAt("hostnames")
+	for i, hostname := range r.Spec.Hostnames {
+		if hostname == "" {
+			err.AddViolationAt(root.Index(i), "cannot be empty")
+		}
+	}
+	return
+}
+
+func (r *GatewayRouteResource) validateRules() (err validators.ValidationError) {
+	root := validators.RootedAt("rules")
+	if len(r.Spec.Rules) == 0 {
+		err.AddViolationAt(root, "must have at least one element")
+	}
+	for i, rule := range r.Spec.Rules {
+		for j, match := range rule.Matches {
+			err.Add(validateGatewayRouteMatch(root.Index(i).Field("matches").Index(j), match))
+		}
+		path := root.Index(i).Field("backends")
+		if len(rule.Backends) == 0 {
+			err.AddViolationAt(path, "must have at least one element")
+		}
+		for j, backend := range rule.Backends {
+			err.Add(ValidateSelector(path.Index(j).Field("destination"), backend.GetDestination(), ValidateSelectorOpts{
+				RequireAtLeastOneTag: true,
+				RequireService:       true,
+			}))
+		}
+	}
+	return
+}
+
+func validateGatewayRouteMatch(path validators.PathBuilder, match *mesh_proto.GatewayRoute_Rule_Match) (err validators.ValidationError) {
+	if value := match.GetPath().GetValue(); value != "" && !strings.HasPrefix(value, "/") {
+		err.AddViolationAt(path.Field("path").Field("value"), "must start with /")
+	}
+	for i, header := range match.GetHeaders() {
+		headerPath := path.Field("headers").Index(i)
+		if header.GetName() == "" {
+			err.AddViolationAt(headerPath.Field("name"), "cannot be empty")
+		}
+		if header.GetType() == mesh_proto.GatewayRoute_Rule_Match_Header_REGEX {
+			if _, rerr := regexp.Compile(header.GetValue()); rerr != nil {
+				err.AddViolationAt(headerPath.Field("value"), fmt.Sprintf("must be a valid regex: %s", rerr))
+			}
+		}
+	}
+	return
+}
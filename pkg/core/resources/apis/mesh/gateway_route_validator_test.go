@@ -0,0 +1,125 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("GatewayRoute", func() {
+	Describe("Validate()", func() {
+		It("should pass validation", func() {
+			// given
+			route := GatewayRouteResource{}
+			spec := `
+            selectors:
+            - match:
+                kuma.io/service: gateway
+            hostnames:
+            - example.com
+            rules:
+            - matches:
+              - path:
+                  type: EXACT
+                  value: /api
+              backends:
+              - destination:
+                  kuma.io/service: backend
+                weight: 100
+`
+			Expect(util_proto.FromYAML([]byte(spec), &route.Spec)).To(Succeed())
+
+			// when
+			err := route.Validate()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		type testCase struct {
+			route    string
+			expected string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				route := GatewayRouteResource{}
+
+				// when
+				err := util_proto.FromYAML([]byte(given.route), &route.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := route.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				route: ``,
+				expected: `
+                violations:
+                - field: rules
+                  message: must have at least one element
+`,
+			}),
+			Entry("rules without backends and backends without service", testCase{
+				route: `
+                rules:
+                - matches:
+                  - path:
+                      value: /
+                - backends:
+                  - destination:
+                      version: v1
+`,
+				expected: `
+                violations:
+                - field: rules[0].backends
+                  message: must have at least one element
+                - field: rules[1].backends[0].destination
+                  message: mandatory tag "kuma.io/service" is missing
+`,
+			}),
+			Entry("invalid hostnames and matches", testCase{
+				route: `
+                hostnames:
+                - ""
+                rules:
+                - matches:
+                  - path:
+                      value: api
+                    headers:
+                    - value: v1
+                    - name: x-version
+                      type: REGEX
+                      value: "v("
+                  backends:
+                  - destination:
+                      kuma.io/service: backend
+                    weight: 100
+`,
+				expected: `
+                violations:
+                - field: hostnames[0]
+                  message: cannot be empty
+                - field: rules[0].matches[0].path.value
+                  message: must start with /
+                - field: rules[0].matches[0].headers[0].name
+                  message: cannot be empty
+                - field: rules[0].matches[0].headers[1].value
+                  message: 'must be a valid regex: error parsing regexp: missing closing ): ` + "`v(`" + `'
+`,
+			}),
+		)
+	})
+})
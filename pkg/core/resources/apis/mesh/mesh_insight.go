@@ -0,0 +1,87 @@
+package mesh
+
+import (
+	"errors"
+
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+const (
+	MeshInsightType model.ResourceType = "MeshInsight"
+)
+
+var _ model.Resource = &MeshInsightResource{}
+
+type MeshInsightResource struct {
+	Meta model.ResourceMeta
+	Spec mesh_proto.MeshInsight
+}
+
+func (t *MeshInsightResource) GetType() model.ResourceType {
+	return MeshInsightType
+}
+func (t *MeshInsightResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+func (t *MeshInsightResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+func (t *MeshInsightResource) GetSpec() model.ResourceSpec {
+	return &t.Spec
+}
+func (t *MeshInsightResource) SetSpec(spec model.ResourceSpec) error {
+	status, ok := spec.(*mesh_proto.MeshInsight)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		t.Spec = *status
+		return nil
+	}
+}
+func (t *MeshInsightResource) Validate() error {
+	return nil
+}
+func (t *MeshInsightResource) Scope() model.ResourceScope {
+	return model.ScopeGlobal
+}
+
+var _ model.ResourceList = &MeshInsightResourceList{}
+
+type MeshInsightResourceList struct {
+	Items      []*MeshInsightResource
+	Pagination model.Pagination
+}
+
+func (l *MeshInsightResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+func (l *MeshInsightResourceList) GetItemType() model.ResourceType {
+	return MeshInsightType
+}
+func (l *MeshInsightResourceList) NewItem() model.Resource {
+	return &MeshInsightResource{}
+}
+func (l *MeshInsightResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshInsightResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*MeshInsightResource)(nil), r)
+	}
+}
+
+func (l *MeshInsightResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func init() {
+	registry.RegisterType(&MeshInsightResource{})
+	registry.RegistryListType(&MeshInsightResourceList{})
+}
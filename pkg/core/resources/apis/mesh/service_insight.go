@@ -0,0 +1,94 @@
+package mesh
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+const (
+	ServiceInsightType model.ResourceType = "ServiceInsight"
+)
+
+var _ model.Resource = &ServiceInsightResource{}
+
+// ServiceInsightName returns the name of the ServiceInsight that holds insights about all services of a given Mesh.
+// Mesh is part of the name because on Kubernetes ServiceInsights of all Meshes are cluster-scoped.
+func ServiceInsightName(mesh string) string {
+	return fmt.Sprintf("all-services-%s", mesh)
+}
+
+type ServiceInsightResource struct {
+	Meta model.ResourceMeta
+	Spec mesh_proto.ServiceInsight
+}
+
+func (t *ServiceInsightResource) GetType() model.ResourceType {
+	return ServiceInsightType
+}
+func (t *ServiceInsightResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+func (t *ServiceInsightResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+func (t *ServiceInsightResource) GetSpec() model.ResourceSpec {
+	return &t.Spec
+}
+func (t *ServiceInsightResource) SetSpec(spec model.ResourceSpec) error {
+	status, ok := spec.(*mesh_proto.ServiceInsight)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		t.Spec = *status
+		return nil
+	}
+}
+func (t *ServiceInsightResource) Validate() error {
+	return nil
+}
+func (t *ServiceInsightResource) Scope() model.ResourceScope {
+	return model.ScopeMesh
+}
+
+var _ model.ResourceList = &ServiceInsightResourceList{}
+
+type ServiceInsightResourceList struct {
+	Items      []*ServiceInsightResource
+	Pagination model.Pagination
+}
+
+func (l *ServiceInsightResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+func (l *ServiceInsightResourceList) GetItemType() model.ResourceType {
+	return ServiceInsightType
+}
+func (l *ServiceInsightResourceList) NewItem() model.Resource {
+	return &ServiceInsightResource{}
+}
+func (l *ServiceInsightResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*ServiceInsightResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*ServiceInsightResource)(nil), r)
+	}
+}
+
+func (l *ServiceInsightResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func init() {
+	registry.RegisterType(&ServiceInsightResource{})
+	registry.RegistryListType(&ServiceInsightResourceList{})
+}
@@ -0,0 +1,94 @@
+package system
+
+import (
+	"errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+)
+
+const (
+	RoleType model.ResourceType = "Role"
+)
+
+// Verbs of operations on resources that a Role can allow.
+const (
+	VerbGet    = "get"
+	VerbList   = "list"
+	VerbCreate = "create"
+	VerbUpdate = "update"
+	VerbDelete = "delete"
+
+	// RoleWildcard matches any mesh, type or verb in a rule of a Role.
+	RoleWildcard = "*"
+)
+
+var _ model.Resource = &RoleResource{}
+
+type RoleResource struct {
+	Meta model.ResourceMeta
+	Spec system_proto.Role
+}
+
+func (t *RoleResource) GetType() model.ResourceType {
+	return RoleType
+}
+func (t *RoleResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+func (t *RoleResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+func (t *RoleResource) GetSpec() model.ResourceSpec {
+	return &t.Spec
+}
+func (t *RoleResource) SetSpec(spec model.ResourceSpec) error {
+	value, ok := spec.(*system_proto.Role)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		t.Spec = *value
+		return nil
+	}
+}
+func (t *RoleResource) Scope() model.ResourceScope {
+	return model.ScopeGlobal
+}
+
+var _ model.ResourceList = &RoleResourceList{}
+
+type RoleResourceList struct {
+	Items      []*RoleResource
+	Pagination model.Pagination
+}
+
+func (l *RoleResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+func (l *RoleResourceList) GetItemType() model.ResourceType {
+	return RoleType
+}
+func (l *RoleResourceList) NewItem() model.Resource {
+	return &RoleResource{}
+}
+func (l *RoleResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*RoleResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*RoleResource)(nil), r)
+	}
+}
+func (l *RoleResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func init() {
+	registry.RegisterType(&RoleResource{})
+	registry.RegistryListType(&RoleResourceList{})
+}
@@ -0,0 +1,64 @@
+package system
+
+import (
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+var roleVerbs = map[string]bool{
+	VerbGet:      true,
+	VerbList:     true,
+	VerbCreate:   true,
+	VerbUpdate:   true,
+	VerbDelete:   true,
+	RoleWildcard: true,
+}
+
+func (r *RoleResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(r.validateSubjects())
+	err.Add(r.validateRules())
+	return err.OrNil()
+}
+
+func (r *RoleResource) validateSubjects() validators.ValidationError {
+	var verr validators.ValidationError
+	if len(r.Spec.GetUsers()) == 0 && len(r.Spec.GetGroups()) == 0 {
+		verr.AddViolation("", "must have at least one user or group")
+	}
+	for i, user := range r.Spec.GetUsers() {
+		if user == "" {
+			verr.AddViolationAt(validators.RootedAt("users").Index(i), "cannot be empty")
+		}
+	}
+	for i, group := range r.Spec.GetGroups() {
+		if group == "" {
+			verr.AddViolationAt(validators.RootedAt("groups").Index(i), "cannot be empty")
+		}
+	}
+	return verr
+}
+
+func (r *RoleResource) validateRules() validators.ValidationError {
+	var verr validators.ValidationError
+	if len(r.Spec.GetRules()) == 0 {
+		verr.AddViolation("rules", "must have at least one rule")
+	}
+	for i, rule := range r.Spec.GetRules() {
+		path := validators.RootedAt("rules").Index(i)
+		if len(rule.GetMeshes()) == 0 {
+			verr.AddViolationAt(path.Field("meshes"), "must have at least one mesh")
+		}
+		if len(rule.GetTypes()) == 0 {
+			verr.AddViolationAt(path.Field("types"), "must have at least one type")
+		}
+		if len(rule.GetVerbs()) == 0 {
+			verr.AddViolationAt(path.Field("verbs"), "must have at least one verb")
+		}
+		for j, verb := range rule.GetVerbs() {
+			if !roleVerbs[verb] {
+				verr.AddViolationAt(path.Field("verbs").Index(j), `must be one of "get", "list", "create", "update", "delete" or "*"`)
+			}
+		}
+	}
+	return verr
+}
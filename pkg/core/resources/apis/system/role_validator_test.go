@@ -0,0 +1,95 @@
+package system_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Role", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(roleYAML string) {
+				// setup
+				role := system.RoleResource{}
+
+				// when
+				err := util_proto.FromYAML([]byte(roleYAML), &role.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := role.Validate()
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("valid role", `
+            users:
+            - john.doe
+            groups:
+            - backend-team
+            rules:
+            - meshes: ["default"]
+              types: ["TrafficPermission", "TrafficRoute"]
+              verbs: ["get", "list", "create", "update", "delete"]
+            - meshes: ["*"]
+              types: ["*"]
+              verbs: ["get", "list"]`),
+		)
+
+		type testCase struct {
+			role     string
+			expected string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				role := system.RoleResource{}
+
+				// when
+				err := util_proto.FromYAML([]byte(given.role), &role.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := role.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("spec: empty", testCase{
+				role: ``,
+				expected: `
+               violations:
+                 - field: ""
+                   message: must have at least one user or group
+                 - field: rules
+                   message: must have at least one rule`}),
+			Entry("invalid rules", testCase{
+				role: `
+               users:
+               - ""
+               rules:
+               - verbs: ["watch"]`,
+				expected: `
+               violations:
+                 - field: users[0]
+                   message: cannot be empty
+                 - field: rules[0].meshes
+                   message: must have at least one mesh
+                 - field: rules[0].types
+                   message: must have at least one type
+                 - field: rules[0].verbs[0]
+                   message: must be one of "get", "list", "create", "update", "delete" or "*"`}),
+		)
+	})
+})
@@ -0,0 +1,40 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+// SystemUser is recorded as the author of changes made by the Control Plane itself.
+const SystemUser = "system"
+
+// Revision is a state of a resource after a change.
+type Revision struct {
+	// Revision is a number of the change. It grows with every change of the resource.
+	Revision  uint32
+	Operation store.ChangeOperation
+	User      string
+	Time      time.Time
+	Labels    map[string]string
+	// Spec of the resource after the change. For deleted resources, it is the spec before the deletion.
+	Spec model.ResourceSpec
+}
+
+type Reader interface {
+	// History returns revisions of the resource from the oldest to the newest.
+	History(ctx context.Context, resType model.ResourceType, key model.ResourceKey) ([]Revision, error)
+}
+
+type readerKey struct{}
+
+func NewReaderContext(ctx context.Context, reader Reader) context.Context {
+	return context.WithValue(ctx, readerKey{}, reader)
+}
+
+func FromReaderContext(ctx context.Context) (reader Reader, ok bool) {
+	reader, ok = ctx.Value(readerKey{}).(Reader)
+	return
+}
@@ -0,0 +1,225 @@
+package history
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+	config_model "github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var log = core.Log.WithName("history")
+
+// maxConflictRetries limits attempts to record a revision when other instances of the Control Plane change
+// the history of the same resource at the same time.
+const maxConflictRetries = 5
+
+// HistoryStore records revisions of resources of the given types that are changed through the store.
+// Revisions are persisted in the delegate store as Config resources, so they are shared by all instances
+// of the Control Plane and survive restarts. Only the last maxRevisions revisions of every resource are kept.
+type HistoryStore struct {
+	delegate     store.ResourceStore
+	types        map[model.ResourceType]bool
+	maxRevisions int
+}
+
+func NewHistoryStore(delegate store.ResourceStore, types []model.ResourceType, maxRevisions uint32) *HistoryStore {
+	tracked := map[model.ResourceType]bool{}
+	for _, resType := range types {
+		tracked[resType] = true
+	}
+	return &HistoryStore{
+		delegate:     delegate,
+		types:        tracked,
+		maxRevisions: int(maxRevisions),
+	}
+}
+
+var _ store.ResourceStore = &HistoryStore{}
+var _ Reader = &HistoryStore{}
+
+func (h *HistoryStore) Create(ctx context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
+	if err := h.delegate.Create(ctx, resource, fs...); err != nil {
+		return err
+	}
+	opts := store.NewCreateOptions(fs...)
+	h.record(ctx, store.CreateOperation, resource.GetType(), model.ResourceKey{Name: opts.Name, Mesh: opts.Mesh}, opts.Labels, resource.GetSpec())
+	return nil
+}
+
+func (h *HistoryStore) Update(ctx context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
+	if err := h.delegate.Update(ctx, resource, fs...); err != nil {
+		return err
+	}
+	opts := store.NewUpdateOptions(fs...)
+	labels := resource.GetMeta().GetLabels()
+	if opts.Labels != nil {
+		labels = opts.Labels
+	}
+	h.record(ctx, store.UpdateOperation, resource.GetType(), model.MetaToResourceKey(resource.GetMeta()), labels, resource.GetSpec())
+	return nil
+}
+
+func (h *HistoryStore) Delete(ctx context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
+	if !h.types[resource.GetType()] {
+		return h.delegate.Delete(ctx, resource, fs...)
+	}
+	opts := store.NewDeleteOptions(fs...)
+	// the resource is fetched first, so the revision of a deleted resource contains its last spec
+	deleted, err := registry.Global().NewObject(resource.GetType())
+	if err != nil {
+		return err
+	}
+	if err := h.delegate.Get(ctx, deleted, store.GetByKey(opts.Name, opts.Mesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return h.delegate.Delete(ctx, resource, fs...)
+		}
+		return err
+	}
+	if err := h.delegate.Delete(ctx, resource, fs...); err != nil {
+		return err
+	}
+	h.record(ctx, store.DeleteOperation, resource.GetType(), model.ResourceKey{Name: opts.Name, Mesh: opts.Mesh}, deleted.GetMeta().GetLabels(), deleted.GetSpec())
+	return nil
+}
+
+func (h *HistoryStore) Get(ctx context.Context, resource model.Resource, fs ...store.GetOptionsFunc) error {
+	return h.delegate.Get(ctx, resource, fs...)
+}
+
+func (h *HistoryStore) List(ctx context.Context, list model.ResourceList, fs ...store.ListOptionsFunc) error {
+	return h.delegate.List(ctx, list, fs...)
+}
+
+func (h *HistoryStore) History(ctx context.Context, resType model.ResourceType, key model.ResourceKey) ([]Revision, error) {
+	persisted, _, err := h.load(ctx, resType, key)
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]Revision, 0, len(persisted.Revisions))
+	for _, p := range persisted.Revisions {
+		res, err := registry.Global().NewObject(resType)
+		if err != nil {
+			return nil, err
+		}
+		if err := util_proto.FromJSON(p.Spec, res.GetSpec()); err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal revision %d", p.Revision)
+		}
+		revisions = append(revisions, Revision{
+			Revision:  p.Revision,
+			Operation: p.Operation,
+			User:      p.User,
+			Time:      p.Time,
+			Labels:    p.Labels,
+			Spec:      res.GetSpec(),
+		})
+	}
+	return revisions, nil
+}
+
+func (h *HistoryStore) record(ctx context.Context, operation store.ChangeOperation, resType model.ResourceType, key model.ResourceKey, labels map[string]string, spec model.ResourceSpec) {
+	if !h.types[resType] || h.maxRevisions == 0 {
+		return
+	}
+	author := SystemUser
+	if u, ok := user.FromContext(ctx); ok {
+		author = u.Name
+	}
+	specJSON, err := util_proto.ToJSON(spec)
+	if err != nil {
+		log.Error(err, "could not marshal a revision", "type", resType, "name", key.Name, "mesh", key.Mesh)
+		return
+	}
+	revision := persistedRevision{
+		Operation: operation,
+		User:      author,
+		Time:      core.Now(),
+		Labels:    labels,
+		Spec:      specJSON,
+	}
+	// the change of the resource already succeeded, so a failure to record it is not returned to the caller
+	for i := 0; i < maxConflictRetries; i++ {
+		err = h.append(ctx, resType, key, revision)
+		if !store.IsResourceConflict(err) && !store.IsResourceAlreadyExists(err) {
+			break
+		}
+	}
+	if err != nil {
+		log.Error(err, "could not record a revision", "type", resType, "name", key.Name, "mesh", key.Mesh)
+	}
+}
+
+// append adds the revision to the history of the resource. It returns a conflict when the history was changed
+// in the meantime by another instance of the Control Plane.
+func (h *HistoryStore) append(ctx context.Context, resType model.ResourceType, key model.ResourceKey, revision persistedRevision) error {
+	persisted, config, err := h.load(ctx, resType, key)
+	if err != nil {
+		return err
+	}
+	revision.Revision = 1
+	if len(persisted.Revisions) > 0 {
+		revision.Revision = persisted.Revisions[len(persisted.Revisions)-1].Revision + 1
+	}
+	persisted.Revisions = append(persisted.Revisions, revision)
+	if len(persisted.Revisions) > h.maxRevisions {
+		persisted.Revisions = persisted.Revisions[len(persisted.Revisions)-h.maxRevisions:]
+	}
+	bytes, err := json.Marshal(persisted)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal revisions")
+	}
+	config.Spec.Config = string(bytes)
+	if config.GetMeta() == nil {
+		return h.delegate.Create(ctx, config, store.CreateByKey(historyConfigName(resType, key), ""))
+	}
+	return h.delegate.Update(ctx, config)
+}
+
+// load returns persisted revisions of the resource together with the Config resource that holds them.
+// The Config resource has no meta when the resource has no history yet.
+func (h *HistoryStore) load(ctx context.Context, resType model.ResourceType, key model.ResourceKey) (*persistedRevisions, *config_model.ConfigResource, error) {
+	persisted := &persistedRevisions{Type: resType, Name: key.Name, Mesh: key.Mesh}
+	config := &config_model.ConfigResource{}
+	if err := h.delegate.Get(ctx, config, store.GetByKey(historyConfigName(resType, key), "")); err != nil {
+		if store.IsResourceNotFound(err) {
+			return persisted, &config_model.ConfigResource{}, nil
+		}
+		return nil, nil, err
+	}
+	if err := json.Unmarshal([]byte(config.Spec.Config), persisted); err != nil {
+		return nil, nil, errors.Wrap(err, "could not unmarshal revisions")
+	}
+	return persisted, config, nil
+}
+
+// historyConfigName returns the name of the Config resource with the history of the resource. Names of resources
+// can contain any separator, so the key is hashed to avoid collisions and to keep the name valid in every store.
+func historyConfigName(resType model.ResourceType, key model.ResourceKey) string {
+	return fmt.Sprintf("kuma-history-%x", sha256.Sum256([]byte(string(resType)+"/"+key.Mesh+"/"+key.Name)))
+}
+
+type persistedRevisions struct {
+	Type      model.ResourceType  `json:"type"`
+	Name      string              `json:"name"`
+	Mesh      string              `json:"mesh"`
+	Revisions []persistedRevision `json:"revisions"`
+}
+
+type persistedRevision struct {
+	Revision  uint32                `json:"revision"`
+	Operation store.ChangeOperation `json:"operation"`
+	User      string                `json:"user"`
+	Time      time.Time             `json:"time"`
+	Labels    map[string]string     `json:"labels,omitempty"`
+	Spec      json.RawMessage       `json:"spec"`
+}
@@ -0,0 +1,133 @@
+package history_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/history"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("History Store", func() {
+
+	var underlyingStore core_store.ResourceStore
+	var store *history.HistoryStore
+	key := model.ResourceKey{Name: "route-1", Mesh: "default"}
+
+	BeforeEach(func() {
+		underlyingStore = memory_resources.NewStore()
+		store = history.NewHistoryStore(underlyingStore, []model.ResourceType{core_mesh.TrafficRouteType}, 2)
+	})
+
+	historyOf := func(resType model.ResourceType, key model.ResourceKey) []history.Revision {
+		revisions, err := store.History(context.Background(), resType, key)
+		Expect(err).ToNot(HaveOccurred())
+		return revisions
+	}
+
+	routeTo := func(service string) mesh_proto.TrafficRoute {
+		return mesh_proto.TrafficRoute{
+			Sources:      []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "*"}}},
+			Destinations: []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "backend"}}},
+			Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+				Weight:      100,
+				Destination: map[string]string{"kuma.io/service": service},
+			}},
+		}
+	}
+
+	update := func(ctx context.Context, service string) {
+		route := &core_mesh.TrafficRouteResource{}
+		Expect(store.Get(ctx, route, core_store.GetBy(key))).To(Succeed())
+		route.Spec = routeTo(service)
+		Expect(store.Update(ctx, route)).To(Succeed())
+	}
+
+	It("should record who changed the resource and its spec", func() {
+		// given
+		ctx := user.NewContext(context.Background(), user.User{Name: "john"})
+
+		// when
+		route := &core_mesh.TrafficRouteResource{Spec: routeTo("backend-v1")}
+		Expect(store.Create(ctx, route, core_store.CreateBy(key), core_store.CreateWithLabels(map[string]string{"team": "web"}))).To(Succeed())
+		update(context.Background(), "backend-v2")
+
+		// then
+		revisions := historyOf(core_mesh.TrafficRouteType, key)
+		Expect(revisions).To(HaveLen(2))
+		Expect(revisions[0].Revision).To(Equal(uint32(1)))
+		Expect(revisions[0].Operation).To(Equal(core_store.CreateOperation))
+		Expect(revisions[0].User).To(Equal("john"))
+		Expect(revisions[0].Labels).To(Equal(map[string]string{"team": "web"}))
+		Expect(revisions[0].Spec.(*mesh_proto.TrafficRoute).GetConf()[0].GetDestination()["kuma.io/service"]).To(Equal("backend-v1"))
+		Expect(revisions[1].Revision).To(Equal(uint32(2)))
+		Expect(revisions[1].Operation).To(Equal(core_store.UpdateOperation))
+		Expect(revisions[1].User).To(Equal(history.SystemUser))
+		Expect(revisions[1].Spec.(*mesh_proto.TrafficRoute).GetConf()[0].GetDestination()["kuma.io/service"]).To(Equal("backend-v2"))
+	})
+
+	It("should keep only the last revisions", func() {
+		// given
+		route := &core_mesh.TrafficRouteResource{Spec: routeTo("backend-v1")}
+		Expect(store.Create(context.Background(), route, core_store.CreateBy(key))).To(Succeed())
+
+		// when
+		update(context.Background(), "backend-v2")
+		update(context.Background(), "backend-v3")
+
+		// then
+		revisions := historyOf(core_mesh.TrafficRouteType, key)
+		Expect(revisions).To(HaveLen(2))
+		Expect(revisions[0].Revision).To(Equal(uint32(2)))
+		Expect(revisions[1].Revision).To(Equal(uint32(3)))
+	})
+
+	It("should record the last spec of a deleted resource", func() {
+		// given
+		route := &core_mesh.TrafficRouteResource{Spec: routeTo("backend-v1")}
+		Expect(store.Create(context.Background(), route, core_store.CreateBy(key))).To(Succeed())
+
+		// when
+		Expect(store.Delete(context.Background(), &core_mesh.TrafficRouteResource{}, core_store.DeleteBy(key))).To(Succeed())
+
+		// then
+		revisions := historyOf(core_mesh.TrafficRouteType, key)
+		Expect(revisions).To(HaveLen(2))
+		Expect(revisions[1].Operation).To(Equal(core_store.DeleteOperation))
+		Expect(revisions[1].Spec.(*mesh_proto.TrafficRoute).GetConf()[0].GetDestination()["kuma.io/service"]).To(Equal("backend-v1"))
+	})
+
+	It("should persist revisions in the underlying store", func() {
+		// given
+		route := &core_mesh.TrafficRouteResource{Spec: routeTo("backend-v1")}
+		Expect(store.Create(context.Background(), route, core_store.CreateBy(key))).To(Succeed())
+		update(context.Background(), "backend-v2")
+
+		// when another instance of the Control Plane reads the history
+		revisions, err := history.NewHistoryStore(underlyingStore, []model.ResourceType{core_mesh.TrafficRouteType}, 2).
+			History(context.Background(), core_mesh.TrafficRouteType, key)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revisions).To(HaveLen(2))
+		Expect(revisions[0].Operation).To(Equal(core_store.CreateOperation))
+		Expect(revisions[1].Revision).To(Equal(uint32(2)))
+		Expect(revisions[1].Spec.(*mesh_proto.TrafficRoute).GetConf()[0].GetDestination()["kuma.io/service"]).To(Equal("backend-v2"))
+	})
+
+	It("should not record resources of other types", func() {
+		// when
+		err := store.Create(context.Background(), &core_mesh.MeshResource{}, core_store.CreateByKey("default", "default"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(historyOf(core_mesh.MeshType, model.ResourceKey{Name: "default", Mesh: "default"})).To(BeEmpty())
+	})
+})
@@ -3,6 +3,7 @@ package manager
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,8 +15,14 @@ import (
 	"github.com/kumahq/kuma/pkg/metrics"
 )
 
+// CachedManager is a ReadOnlyResourceManager which entries can be evicted when resources are changed.
+type CachedManager interface {
+	ReadOnlyResourceManager
+	store.ChangeHandler
+}
+
 // Cached version of the ReadOnlyResourceManager designed to be used only for use cases of eventual consistency.
-// This cache is NOT consistent across instances of the control plane.
+// This cache is NOT consistent across instances of the control plane, unless the entries are evicted on changes of resources.
 type cachedManager struct {
 	delegate ReadOnlyResourceManager
 	cache    *cache.Cache
@@ -23,11 +30,20 @@ type cachedManager struct {
 
 	mutexes  map[string]*sync.Mutex
 	mapMutex sync.Mutex // guards "mutexes" field
+
+	// generations are incremented on every eviction, so the results fetched before the eviction are not put into the cache
+	generations map[model.ResourceType]uint64
+	resyncs     uint64
+	genMutex    sync.RWMutex // guards "generations" and "resyncs" fields
+
+	// keys indexes entries of the cache by type, so evicting a type does not scan the whole cache
+	keys      map[model.ResourceType]map[string]struct{}
+	keysMutex sync.Mutex // guards "keys" field
 }
 
-var _ ReadOnlyResourceManager = &cachedManager{}
+var _ CachedManager = &cachedManager{}
 
-func NewCachedManager(delegate ReadOnlyResourceManager, expirationTime time.Duration, metrics metrics.Metrics) (ReadOnlyResourceManager, error) {
+func NewCachedManager(delegate ReadOnlyResourceManager, expirationTime time.Duration, metrics metrics.Metrics) (CachedManager, error) {
 	metric := prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "store_cache",
 		Help: "Summary of Store Cache",
@@ -35,12 +51,16 @@ func NewCachedManager(delegate ReadOnlyResourceManager, expirationTime time.Dura
 	if err := metrics.Register(metric); err != nil {
 		return nil, err
 	}
-	return &cachedManager{
-		delegate: delegate,
-		cache:    cache.New(expirationTime, time.Duration(int64(float64(expirationTime)*0.9))),
-		metrics:  metric,
-		mutexes:  map[string]*sync.Mutex{},
-	}, nil
+	c := &cachedManager{
+		delegate:    delegate,
+		cache:       cache.New(expirationTime, time.Duration(int64(float64(expirationTime)*0.9))),
+		metrics:     metric,
+		mutexes:     map[string]*sync.Mutex{},
+		generations: map[model.ResourceType]uint64{},
+		keys:        map[model.ResourceType]map[string]struct{}{},
+	}
+	c.cache.OnEvicted(c.unindex)
+	return c, nil
 }
 
 func (c *cachedManager) Get(ctx context.Context, res model.Resource, fs ...store.GetOptionsFunc) error {
@@ -56,11 +76,14 @@ func (c *cachedManager) Get(ctx context.Context, res model.Resource, fs ...store
 		if !found {
 			// After many goroutines are unlocked one by one, only one should execute this branch, the rest should retrieve object from the cache
 			c.metrics.WithLabelValues("get", string(res.GetType()), "miss").Inc()
+			generation := c.generation(res.GetType())
 			if err := c.delegate.Get(ctx, res, fs...); err != nil {
 				mutex.Unlock()
 				return err
 			}
-			c.cache.SetDefault(cacheKey, res)
+			if generation == c.generation(res.GetType()) {
+				c.set(res.GetType(), cacheKey, res)
+			}
 		} else {
 			c.metrics.WithLabelValues("get", string(res.GetType()), "hit-wait").Inc()
 		}
@@ -93,11 +116,14 @@ func (c *cachedManager) List(ctx context.Context, list model.ResourceList, fs ..
 		if !found {
 			// After many goroutines are unlocked one by one, only one should execute this branch, the rest should retrieve object from the cache
 			c.metrics.WithLabelValues("list", string(list.GetItemType()), "miss").Inc()
+			generation := c.generation(list.GetItemType())
 			if err := c.delegate.List(ctx, list, fs...); err != nil {
 				mutex.Unlock()
 				return err
 			}
-			c.cache.SetDefault(cacheKey, list.GetItems())
+			if generation == c.generation(list.GetItemType()) {
+				c.set(list.GetItemType(), cacheKey, list.GetItems())
+			}
 		} else {
 			c.metrics.WithLabelValues("list", string(list.GetItemType()), "hit-wait").Inc()
 		}
@@ -118,6 +144,66 @@ func (c *cachedManager) List(ctx context.Context, list model.ResourceList, fs ..
 	return nil
 }
 
+// OnChange evicts all entries of the type of the changed resource.
+func (c *cachedManager) OnChange(event store.ResourceChangedEvent) {
+	c.genMutex.Lock()
+	c.generations[event.Type]++
+	c.genMutex.Unlock()
+
+	c.keysMutex.Lock()
+	keys := c.keys[event.Type]
+	delete(c.keys, event.Type)
+	c.keysMutex.Unlock()
+
+	// the lock is not held while deleting, because the cache calls unindex on every deleted entry
+	for key := range keys {
+		c.cache.Delete(key)
+	}
+	c.metrics.WithLabelValues("evict", string(event.Type), "changed").Inc()
+}
+
+// OnResync evicts all entries.
+func (c *cachedManager) OnResync() {
+	c.genMutex.Lock()
+	c.resyncs++
+	c.genMutex.Unlock()
+
+	c.cache.Flush()
+	c.keysMutex.Lock()
+	c.keys = map[model.ResourceType]map[string]struct{}{}
+	c.keysMutex.Unlock()
+	c.metrics.WithLabelValues("evict", "", "resync").Inc()
+}
+
+func (c *cachedManager) set(resourceType model.ResourceType, key string, value interface{}) {
+	c.cache.SetDefault(key, value)
+	c.keysMutex.Lock()
+	defer c.keysMutex.Unlock()
+	if c.keys[resourceType] == nil {
+		c.keys[resourceType] = map[string]struct{}{}
+	}
+	c.keys[resourceType][key] = struct{}{}
+}
+
+// unindex removes entries that expired or were deleted from the index.
+// Keys have the form of "<operation>:<type>:<options>".
+func (c *cachedManager) unindex(key string, _ interface{}) {
+	parts := strings.SplitN(key, ":", 3)
+	if len(parts) < 2 {
+		return
+	}
+	c.keysMutex.Lock()
+	defer c.keysMutex.Unlock()
+	delete(c.keys[model.ResourceType(parts[1])], key)
+}
+
+// generation changes whenever entries of the type are evicted.
+func (c *cachedManager) generation(resourceType model.ResourceType) uint64 {
+	c.genMutex.RLock()
+	defer c.genMutex.RUnlock()
+	return c.generations[resourceType] + c.resyncs
+}
+
 func (c *cachedManager) mutexFor(key string) *sync.Mutex {
 	c.mapMutex.Lock()
 	defer c.mapMutex.Unlock()
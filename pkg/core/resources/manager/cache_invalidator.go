@@ -0,0 +1,29 @@
+package manager
+
+import (
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+// cacheInvalidator evicts entries of the cache as soon as resources are changed by any instance of the Control Plane.
+type cacheInvalidator struct {
+	notifier store.ChangeNotifier
+	cache    CachedManager
+}
+
+var _ component.Component = &cacheInvalidator{}
+
+func NewCacheInvalidator(notifier store.ChangeNotifier, cache CachedManager) component.Component {
+	return &cacheInvalidator{
+		notifier: notifier,
+		cache:    cache,
+	}
+}
+
+func (c *cacheInvalidator) Start(stop <-chan struct{}) error {
+	return c.notifier.Listen(stop, c.cache)
+}
+
+func (c *cacheInvalidator) NeedLeaderElection() bool {
+	return false
+}
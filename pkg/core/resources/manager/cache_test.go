@@ -43,7 +43,7 @@ var _ core_manager.ReadOnlyResourceManager = &countingResourcesManager{}
 var _ = Describe("Cached Resource Manager", func() {
 
 	var store core_store.ResourceStore
-	var cachedManager core_manager.ReadOnlyResourceManager
+	var cachedManager core_manager.CachedManager
 	var countingManager *countingResourcesManager
 	var res *core_mesh.DataplaneResource
 	var metrics core_metrics.Metrics
@@ -165,6 +165,80 @@ var _ = Describe("Cached Resource Manager", func() {
 		Expect(hits + hitWaits).To(Equal(100.0))
 	})
 
+	It("should evict entries of the changed resource type", func() {
+		// given cached Dataplanes and TrafficLogs
+		dataplanes := core_mesh.DataplaneResourceList{}
+		Expect(cachedManager.List(context.Background(), &dataplanes, core_store.ListByMesh("default"))).To(Succeed())
+		dataplane := core_mesh.DataplaneResource{}
+		Expect(cachedManager.Get(context.Background(), &dataplane, core_store.GetByKey("dp-1", "default"))).To(Succeed())
+		trafficLogs := core_mesh.TrafficLogResourceList{}
+		Expect(cachedManager.List(context.Background(), &trafficLogs, core_store.ListByMesh("default"))).To(Succeed())
+		Expect(countingManager.getQueries).To(Equal(1))
+		Expect(countingManager.listQueries).To(Equal(2))
+
+		// when
+		cachedManager.OnChange(core_store.ResourceChangedEvent{
+			Operation: core_store.UpdateOperation,
+			Type:      core_mesh.DataplaneType,
+			Name:      "dp-1",
+			Mesh:      "default",
+		})
+
+		// then entries of Dataplanes are fetched again
+		dataplanes = core_mesh.DataplaneResourceList{}
+		Expect(cachedManager.List(context.Background(), &dataplanes, core_store.ListByMesh("default"))).To(Succeed())
+		dataplane = core_mesh.DataplaneResource{}
+		Expect(cachedManager.Get(context.Background(), &dataplane, core_store.GetByKey("dp-1", "default"))).To(Succeed())
+		Expect(countingManager.getQueries).To(Equal(2))
+		Expect(countingManager.listQueries).To(Equal(3))
+
+		// and entries of TrafficLogs are still cached
+		trafficLogs = core_mesh.TrafficLogResourceList{}
+		Expect(cachedManager.List(context.Background(), &trafficLogs, core_store.ListByMesh("default"))).To(Succeed())
+		Expect(countingManager.listQueries).To(Equal(3))
+	})
+
+	It("should evict entries cached after the previous eviction", func() {
+		event := core_store.ResourceChangedEvent{
+			Operation: core_store.UpdateOperation,
+			Type:      core_mesh.DataplaneType,
+			Name:      "dp-1",
+			Mesh:      "default",
+		}
+		for i := 1; i <= 3; i++ {
+			// when
+			dataplanes := core_mesh.DataplaneResourceList{}
+			Expect(cachedManager.List(context.Background(), &dataplanes, core_store.ListByMesh("default"))).To(Succeed())
+			dataplanes = core_mesh.DataplaneResourceList{}
+			Expect(cachedManager.List(context.Background(), &dataplanes, core_store.ListByMesh("default"))).To(Succeed())
+
+			// then the entry is fetched once since the last eviction
+			Expect(countingManager.listQueries).To(Equal(i))
+
+			// when
+			cachedManager.OnChange(event)
+		}
+	})
+
+	It("should evict all entries on resync", func() {
+		// given cached Dataplanes and TrafficLogs
+		dataplanes := core_mesh.DataplaneResourceList{}
+		Expect(cachedManager.List(context.Background(), &dataplanes, core_store.ListByMesh("default"))).To(Succeed())
+		trafficLogs := core_mesh.TrafficLogResourceList{}
+		Expect(cachedManager.List(context.Background(), &trafficLogs, core_store.ListByMesh("default"))).To(Succeed())
+		Expect(countingManager.listQueries).To(Equal(2))
+
+		// when
+		cachedManager.OnResync()
+
+		// then
+		dataplanes = core_mesh.DataplaneResourceList{}
+		Expect(cachedManager.List(context.Background(), &dataplanes, core_store.ListByMesh("default"))).To(Succeed())
+		trafficLogs = core_mesh.TrafficLogResourceList{}
+		Expect(cachedManager.List(context.Background(), &trafficLogs, core_store.ListByMesh("default"))).To(Succeed())
+		Expect(countingManager.listQueries).To(Equal(4))
+	})
+
 	It("should let concurrent List() queries for different types and meshes", func(done Done) {
 		// given ongoing TrafficLog from mesh slow that takes a lot of time to complete
 		go func() {
@@ -0,0 +1,72 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+// overlayManager returns given resources in place of stored resources with the same type, mesh and name.
+// It is designed to preview the effect of resources without persisting them.
+type overlayManager struct {
+	delegate  ReadOnlyResourceManager
+	resources []model.Resource
+}
+
+var _ ReadOnlyResourceManager = &overlayManager{}
+
+func NewOverlayManager(delegate ReadOnlyResourceManager, resources []model.Resource) ReadOnlyResourceManager {
+	return &overlayManager{
+		delegate:  delegate,
+		resources: resources,
+	}
+}
+
+func (o *overlayManager) Get(ctx context.Context, res model.Resource, fs ...store.GetOptionsFunc) error {
+	opts := store.NewGetOptions(fs...)
+	for _, overlay := range o.resources {
+		if overlay.GetType() == res.GetType() && overlay.GetMeta().GetName() == opts.Name && overlay.GetMeta().GetMesh() == opts.Mesh {
+			if err := res.SetSpec(overlay.GetSpec()); err != nil {
+				return err
+			}
+			res.SetMeta(overlay.GetMeta())
+			return nil
+		}
+	}
+	return o.delegate.Get(ctx, res, fs...)
+}
+
+func (o *overlayManager) List(ctx context.Context, list model.ResourceList, fs ...store.ListOptionsFunc) error {
+	stored, err := registry.Global().NewList(list.GetItemType())
+	if err != nil {
+		return err
+	}
+	if err := o.delegate.List(ctx, stored, fs...); err != nil {
+		return err
+	}
+	opts := store.NewListOptions(fs...)
+	overlays := map[model.ResourceKey]bool{}
+	for _, overlay := range o.resources {
+		if overlay.GetType() == list.GetItemType() && (opts.Mesh == "" || overlay.GetMeta().GetMesh() == opts.Mesh) {
+			overlays[model.MetaToResourceKey(overlay.GetMeta())] = true
+		}
+	}
+	for _, item := range stored.GetItems() {
+		if overlays[model.MetaToResourceKey(item.GetMeta())] {
+			continue
+		}
+		if err := list.AddItem(item); err != nil {
+			return err
+		}
+	}
+	for _, overlay := range o.resources {
+		if overlays[model.MetaToResourceKey(overlay.GetMeta())] && overlay.GetType() == list.GetItemType() {
+			if err := list.AddItem(overlay); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
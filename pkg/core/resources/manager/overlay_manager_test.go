@@ -0,0 +1,86 @@
+package manager_test
+
+import (
+	"context"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Overlay Resource Manager", func() {
+
+	var overlayManager core_manager.ReadOnlyResourceManager
+
+	trafficRoute := func(mesh, name, destination string) *core_mesh.TrafficRouteResource {
+		return &core_mesh.TrafficRouteResource{
+			Meta: &rest.ResourceMeta{Type: string(core_mesh.TrafficRouteType), Mesh: mesh, Name: name},
+			Spec: mesh_proto.TrafficRoute{
+				Conf: []*mesh_proto.TrafficRoute_WeightedDestination{
+					{Weight: 100, Destination: mesh_proto.MatchService(destination)},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		store := memory.NewStore()
+		for _, route := range []*core_mesh.TrafficRouteResource{
+			trafficRoute("demo", "route-1", "backend"),
+			trafficRoute("demo", "route-2", "backend"),
+		} {
+			err := store.Create(context.Background(), &core_mesh.TrafficRouteResource{Spec: route.Spec}, core_store.CreateByKey(route.Meta.GetName(), "demo"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		overlayManager = core_manager.NewOverlayManager(core_manager.NewResourceManager(store), []core_model.Resource{
+			trafficRoute("demo", "route-2", "backend-v2"),
+			trafficRoute("demo", "route-3", "redis"),
+			trafficRoute("other", "route-4", "redis"),
+		})
+	})
+
+	It("should get overlaid resources in place of stored ones", func() {
+		// when
+		route := &core_mesh.TrafficRouteResource{}
+		err := overlayManager.Get(context.Background(), route, core_store.GetByKey("route-2", "demo"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(route.Spec.Conf[0].Destination[mesh_proto.ServiceTag]).To(Equal("backend-v2"))
+	})
+
+	It("should get stored resources", func() {
+		// when
+		route := &core_mesh.TrafficRouteResource{}
+		err := overlayManager.Get(context.Background(), route, core_store.GetByKey("route-1", "demo"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(route.Spec.Conf[0].Destination[mesh_proto.ServiceTag]).To(Equal("backend"))
+	})
+
+	It("should list stored and overlaid resources of a mesh", func() {
+		// when
+		routes := &core_mesh.TrafficRouteResourceList{}
+		err := overlayManager.List(context.Background(), routes, core_store.ListByMesh("demo"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		destinations := map[string]string{}
+		for _, route := range routes.Items {
+			destinations[route.Meta.GetName()] = route.Spec.Conf[0].Destination[mesh_proto.ServiceTag]
+		}
+		Expect(destinations).To(Equal(map[string]string{
+			"route-1": "backend",
+			"route-2": "backend-v2",
+			"route-3": "redis",
+		}))
+	})
+})
@@ -70,6 +70,8 @@ type ResourceMeta interface {
 	GetMesh() string
 	GetCreationTime() time.Time
 	GetModificationTime() time.Time
+	// GetLabels returns user defined key-value pairs used to select resources, e.g. by kumactl apply --prune
+	GetLabels() map[string]string
 }
 
 func MetaToResourceKey(meta ResourceMeta) ResourceKey {
@@ -0,0 +1,32 @@
+package rest
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Revision is a state of a resource after a change returned by the API Server from /{name}/_history
+type Revision struct {
+	Revision  uint32    `json:"revision"`
+	Operation string    `json:"operation"`
+	User      string    `json:"user"`
+	Time      time.Time `json:"time"`
+	Resource  *Resource `json:"resource"`
+}
+
+type RevisionList struct {
+	Items []*Revision `json:"items"`
+}
+
+// RevisionReceiver is a counterpart of Revision that can be unmarshalled without knowing the type of the resource upfront
+type RevisionReceiver struct {
+	Revision  uint32          `json:"revision"`
+	Operation string          `json:"operation"`
+	User      string          `json:"user"`
+	Time      time.Time       `json:"time"`
+	Resource  json.RawMessage `json:"resource"`
+}
+
+type RevisionListReceiver struct {
+	Items []*RevisionReceiver `json:"items"`
+}
@@ -0,0 +1,25 @@
+package rest
+
+import (
+	"encoding/json"
+)
+
+type WatchEventType string
+
+const (
+	WatchEventAdded    WatchEventType = "ADDED"
+	WatchEventModified WatchEventType = "MODIFIED"
+	WatchEventDeleted  WatchEventType = "DELETED"
+)
+
+// WatchEvent is a single change of a resource streamed by the API Server when resources are watched with ?watch=true
+type WatchEvent struct {
+	Type   WatchEventType `json:"type"`
+	Object *Resource      `json:"object"`
+}
+
+// WatchEventReceiver is a counterpart of WatchEvent that can be unmarshalled without knowing the type of the resource upfront
+type WatchEventReceiver struct {
+	Type   WatchEventType  `json:"type"`
+	Object json.RawMessage `json:"object"`
+}
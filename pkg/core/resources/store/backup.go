@@ -0,0 +1,23 @@
+package store
+
+import (
+	"context"
+	"io"
+)
+
+// Backuper is implemented by ResourceStores that can write a consistent copy of all their data
+// while the Control Plane is running.
+type Backuper interface {
+	Backup(w io.Writer) error
+}
+
+type backuperKey struct{}
+
+func NewBackuperContext(ctx context.Context, backuper Backuper) context.Context {
+	return context.WithValue(ctx, backuperKey{}, backuper)
+}
+
+func FromBackuperContext(ctx context.Context) (backuper Backuper, ok bool) {
+	backuper, ok = ctx.Value(backuperKey{}).(Backuper)
+	return
+}
@@ -0,0 +1,47 @@
+package store
+
+import (
+	"context"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+type ChangeOperation string
+
+const (
+	CreateOperation ChangeOperation = "Create"
+	UpdateOperation ChangeOperation = "Update"
+	DeleteOperation ChangeOperation = "Delete"
+)
+
+// ResourceChangedEvent describes a change of a resource made by any instance of the Control Plane.
+type ResourceChangedEvent struct {
+	Operation ChangeOperation
+	Type      model.ResourceType
+	Name      string
+	Mesh      string
+}
+
+type ChangeHandler interface {
+	OnChange(event ResourceChangedEvent)
+	// OnResync is called when some changes could have been missed, e.g. after the connection to the database is lost.
+	OnResync()
+}
+
+// ChangeNotifier is implemented by ResourceStores that notify about changes of resources,
+// including the changes made by other instances of the Control Plane.
+type ChangeNotifier interface {
+	// Listen calls the handler for every change until stop is closed.
+	Listen(stop <-chan struct{}, handler ChangeHandler) error
+}
+
+type changeNotifierKey struct{}
+
+func NewChangeNotifierContext(ctx context.Context, notifier ChangeNotifier) context.Context {
+	return context.WithValue(ctx, changeNotifierKey{}, notifier)
+}
+
+func FromChangeNotifierContext(ctx context.Context) (notifier ChangeNotifier, ok bool) {
+	notifier, ok = ctx.Value(changeNotifierKey{}).(ChangeNotifier)
+	return
+}
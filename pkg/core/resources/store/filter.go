@@ -0,0 +1,132 @@
+package store
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+const tagValueWildcard = "*"
+
+// TagFilter is a requirement for a single tag.
+//  * without values the tag has to be present
+//  * with values the tag has to be equal to one of the values
+//  * negated with values the tag has to be absent or not equal to any of the values
+type TagFilter struct {
+	Key    string
+	Values []string
+	Negate bool
+}
+
+// ParseTagFilter parses a tag filter in form of
+//  * key:value - equality
+//  * key:value1,value2 - value is in the set
+//  * key:!value1,value2 - value is not in the set
+//  * key:* - tag is present
+func ParseTagFilter(filter string) (TagFilter, error) {
+	parts := strings.SplitN(filter, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return TagFilter{}, errors.Errorf("invalid tag filter %q, expected format key:value", filter)
+	}
+	result := TagFilter{
+		Key: parts[0],
+	}
+	values := parts[1]
+	if strings.HasPrefix(values, "!") {
+		result.Negate = true
+		values = strings.TrimPrefix(values, "!")
+	}
+	if values == tagValueWildcard {
+		if result.Negate {
+			return TagFilter{}, errors.Errorf("invalid tag filter %q, wildcard cannot be negated", filter)
+		}
+		return result, nil
+	}
+	for _, value := range strings.Split(values, ",") {
+		if value == "" {
+			return TagFilter{}, errors.Errorf("invalid tag filter %q, value cannot be empty", filter)
+		}
+		result.Values = append(result.Values, value)
+	}
+	return result, nil
+}
+
+func (t TagFilter) String() string {
+	values := tagValueWildcard
+	if len(t.Values) > 0 {
+		values = strings.Join(t.Values, ",")
+	}
+	if t.Negate {
+		values = "!" + values
+	}
+	return t.Key + ":" + values
+}
+
+func (t TagFilter) Matches(tags map[string]string) bool {
+	value, exist := tags[t.Key]
+	if len(t.Values) == 0 {
+		return exist
+	}
+	in := false
+	if exist {
+		for _, v := range t.Values {
+			if v == value {
+				in = true
+				break
+			}
+		}
+	}
+	return in != t.Negate
+}
+
+// TaggedResource is implemented by resources that can be filtered by tags.
+type TaggedResource interface {
+	// TagSets returns sets of tags of the resource, a resource matches tag filters if any of the sets matches all of them.
+	TagSets() []map[string]string
+}
+
+// HasFilters returns true if any option besides mesh and pagination is set.
+func (l *ListOptions) HasFilters() bool {
+	return l.NamePrefix != "" || len(l.Tags) > 0 || !l.ModifiedSince.IsZero() || len(l.Labels) > 0
+}
+
+// Filter checks whether a resource matches the filters of the options.
+// It is meant for stores that cannot apply the filters natively.
+func (l *ListOptions) Filter(r core_model.Resource) bool {
+	if !strings.HasPrefix(r.GetMeta().GetName(), l.NamePrefix) {
+		return false
+	}
+	if !l.ModifiedSince.IsZero() && !r.GetMeta().GetModificationTime().After(l.ModifiedSince) {
+		return false
+	}
+	labels := r.GetMeta().GetLabels()
+	for key, value := range l.Labels {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	if len(l.Tags) == 0 {
+		return true
+	}
+	tagged, ok := r.(TaggedResource)
+	if !ok {
+		return false
+	}
+	for _, tags := range tagged.TagSets() {
+		if l.matchesTags(tags) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *ListOptions) matchesTags(tags map[string]string) bool {
+	for _, filter := range l.Tags {
+		if !filter.Matches(tags) {
+			return false
+		}
+	}
+	return true
+}
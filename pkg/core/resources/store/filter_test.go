@@ -0,0 +1,161 @@
+package store_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+var _ = Describe("ParseTagFilter", func() {
+	DescribeTable("should parse a filter",
+		func(given string, expected store.TagFilter) {
+			// when
+			filter, err := store.ParseTagFilter(given)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filter).To(Equal(expected))
+			Expect(filter.String()).To(Equal(given))
+		},
+		Entry("equality", "kuma.io/service:web", store.TagFilter{Key: "kuma.io/service", Values: []string{"web"}}),
+		Entry("set", "version:v1,v2", store.TagFilter{Key: "version", Values: []string{"v1", "v2"}}),
+		Entry("negated set", "version:!v1,v2", store.TagFilter{Key: "version", Values: []string{"v1", "v2"}, Negate: true}),
+		Entry("existence", "version:*", store.TagFilter{Key: "version"}),
+	)
+
+	DescribeTable("should reject an invalid filter",
+		func(given string, expectedErr string) {
+			// when
+			_, err := store.ParseTagFilter(given)
+
+			// then
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("no value", "version", `invalid tag filter "version", expected format key:value`),
+		Entry("empty key", ":v1", `invalid tag filter ":v1", expected format key:value`),
+		Entry("empty value in a set", "version:v1,", `invalid tag filter "version:v1,", value cannot be empty`),
+		Entry("negated wildcard", "version:!*", `invalid tag filter "version:!*", wildcard cannot be negated`),
+	)
+})
+
+var _ = Describe("ListOptions", func() {
+	now := time.Now()
+	dataplane := &mesh.DataplaneResource{
+		Meta: &test_model.ResourceMeta{
+			Name:             "web-01",
+			Mesh:             "default",
+			ModificationTime: now,
+			Labels:           map[string]string{"team": "web"},
+		},
+		Spec: mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+					{
+						Tags: map[string]string{
+							mesh_proto.ServiceTag: "web",
+							"version":             "v1",
+						},
+					},
+					{
+						Tags: map[string]string{
+							mesh_proto.ServiceTag: "web-admin",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tag := func(filter string) store.TagFilter {
+		tag, err := store.ParseTagFilter(filter)
+		if err != nil {
+			panic(err)
+		}
+		return tag
+	}
+
+	type testCase struct {
+		opts     []store.ListOptionsFunc
+		expected bool
+	}
+
+	DescribeTable("Filter()",
+		func(given testCase) {
+			Expect(store.NewListOptions(given.opts...).Filter(dataplane)).To(Equal(given.expected))
+		},
+		Entry("no filters", testCase{
+			expected: true,
+		}),
+		Entry("matching name prefix", testCase{
+			opts:     []store.ListOptionsFunc{store.ListByNamePrefix("web-")},
+			expected: true,
+		}),
+		Entry("not matching name prefix", testCase{
+			opts:     []store.ListOptionsFunc{store.ListByNamePrefix("backend-")},
+			expected: false,
+		}),
+		Entry("modified after given time", testCase{
+			opts:     []store.ListOptionsFunc{store.ListModifiedSince(now.Add(-time.Second))},
+			expected: true,
+		}),
+		Entry("not modified after given time", testCase{
+			opts:     []store.ListOptionsFunc{store.ListModifiedSince(now)},
+			expected: false,
+		}),
+		Entry("all tags matching the same inbound", testCase{
+			opts:     []store.ListOptionsFunc{store.ListByTags(tag("kuma.io/service:web"), tag("version:v1,v2"))},
+			expected: true,
+		}),
+		Entry("tags matching different inbounds", testCase{
+			opts:     []store.ListOptionsFunc{store.ListByTags(tag("kuma.io/service:web-admin"), tag("version:v1"))},
+			expected: false,
+		}),
+		Entry("negated tag matching an inbound without the tag", testCase{
+			opts:     []store.ListOptionsFunc{store.ListByTags(tag("version:!v1"))},
+			expected: true,
+		}),
+		Entry("negated tag not matching any inbound", testCase{
+			opts:     []store.ListOptionsFunc{store.ListByTags(tag("kuma.io/service:!web,web-admin"))},
+			expected: false,
+		}),
+		Entry("existing tag", testCase{
+			opts:     []store.ListOptionsFunc{store.ListByTags(tag("version:*"))},
+			expected: true,
+		}),
+		Entry("matching labels", testCase{
+			opts:     []store.ListOptionsFunc{store.ListByLabels(map[string]string{"team": "web"})},
+			expected: true,
+		}),
+		Entry("not matching labels", testCase{
+			opts:     []store.ListOptionsFunc{store.ListByLabels(map[string]string{"team": "web", "env": "prod"})},
+			expected: false,
+		}),
+	)
+
+	It("should not match tags of a resource without tags", func() {
+		// given
+		opts := store.NewListOptions(store.ListByTags(tag("version:!v1")))
+
+		// expect
+		Expect(opts.Filter(&mesh.MeshResource{Meta: &test_model.ResourceMeta{Name: "default"}})).To(BeFalse())
+	})
+
+	It("should compute different hash codes for different filters", func() {
+		// given
+		withPrefix := store.NewListOptions(store.ListByMesh("default"), store.ListByNamePrefix("web"))
+		withTag := store.NewListOptions(store.ListByMesh("default"), store.ListByTags(tag("version:v1")))
+		withoutFilters := store.NewListOptions(store.ListByMesh("default"))
+
+		// expect
+		Expect(withPrefix.HashCode()).ToNot(Equal(withTag.HashCode()))
+		Expect(withPrefix.HashCode()).ToNot(Equal(withoutFilters.HashCode()))
+		Expect(withoutFilters.HashCode()).To(Equal("default"))
+	})
+})
@@ -2,6 +2,8 @@ package store
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
@@ -13,6 +15,9 @@ type CreateOptions struct {
 	CreationTime time.Time
 	Owner        core_model.Resource
 	Synced       bool
+	Labels       map[string]string
+	// DryRun makes managers default and validate the resource without persisting it
+	DryRun bool
 }
 
 type CreateOptionsFunc func(*CreateOptions)
@@ -54,9 +59,25 @@ func CreateSynced() CreateOptionsFunc {
 	}
 }
 
+func CreateWithLabels(labels map[string]string) CreateOptionsFunc {
+	return func(opts *CreateOptions) {
+		opts.Labels = labels
+	}
+}
+
+func CreateDryRun() CreateOptionsFunc {
+	return func(opts *CreateOptions) {
+		opts.DryRun = true
+	}
+}
+
 type UpdateOptions struct {
 	ModificationTime time.Time
 	Synced           bool
+	// Labels replace labels of the resource, when nil the labels are preserved
+	Labels map[string]string
+	// DryRun makes managers default and validate the resource without persisting it
+	DryRun bool
 }
 
 func ModifiedAt(modificationTime time.Time) UpdateOptionsFunc {
@@ -71,6 +92,21 @@ func UpdateSynced() UpdateOptionsFunc {
 	}
 }
 
+func UpdateWithLabels(labels map[string]string) UpdateOptionsFunc {
+	return func(opts *UpdateOptions) {
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		opts.Labels = labels
+	}
+}
+
+func UpdateDryRun() UpdateOptionsFunc {
+	return func(opts *UpdateOptions) {
+		opts.DryRun = true
+	}
+}
+
 type UpdateOptionsFunc func(*UpdateOptions)
 
 func NewUpdateOptions(fs ...UpdateOptionsFunc) *UpdateOptions {
@@ -82,8 +118,9 @@ func NewUpdateOptions(fs ...UpdateOptionsFunc) *UpdateOptions {
 }
 
 type DeleteOptions struct {
-	Name string
-	Mesh string
+	Name    string
+	Mesh    string
+	Version string
 }
 
 type DeleteOptionsFunc func(*DeleteOptions)
@@ -107,6 +144,13 @@ func DeleteByKey(name, mesh string) DeleteOptionsFunc {
 	}
 }
 
+// DeleteByVersion makes the store delete the resource only if it was not modified since the given version.
+func DeleteByVersion(version string) DeleteOptionsFunc {
+	return func(opts *DeleteOptions) {
+		opts.Version = version
+	}
+}
+
 type DeleteAllOptions struct {
 	Mesh string
 }
@@ -168,6 +212,14 @@ type ListOptions struct {
 	Mesh       string
 	PageSize   int
 	PageOffset string
+	// NamePrefix retains only resources which name starts with the prefix
+	NamePrefix string
+	// Tags retains only resources which have a set of tags (an inbound or a gateway of a Dataplane) matching all the filters
+	Tags []TagFilter
+	// ModifiedSince retains only resources modified after given time
+	ModifiedSince time.Time
+	// Labels retains only resources which have all the labels
+	Labels map[string]string
 }
 
 type ListOptionsFunc func(*ListOptions)
@@ -193,6 +245,47 @@ func ListByPage(size int, offset string) ListOptionsFunc {
 	}
 }
 
+func ListByNamePrefix(prefix string) ListOptionsFunc {
+	return func(opts *ListOptions) {
+		opts.NamePrefix = prefix
+	}
+}
+
+func ListByTags(tags ...TagFilter) ListOptionsFunc {
+	return func(opts *ListOptions) {
+		opts.Tags = append(opts.Tags, tags...)
+	}
+}
+
+func ListModifiedSince(since time.Time) ListOptionsFunc {
+	return func(opts *ListOptions) {
+		opts.ModifiedSince = since
+	}
+}
+
+func ListByLabels(labels map[string]string) ListOptionsFunc {
+	return func(opts *ListOptions) {
+		if opts.Labels == nil {
+			opts.Labels = map[string]string{}
+		}
+		for key, value := range labels {
+			opts.Labels[key] = value
+		}
+	}
+}
+
 func (l *ListOptions) HashCode() string {
-	return l.Mesh
+	if !l.HasFilters() {
+		return l.Mesh
+	}
+	tags := make([]string, len(l.Tags))
+	for i, tag := range l.Tags {
+		tags[i] = tag.String()
+	}
+	var labels []string
+	for key, value := range l.Labels {
+		labels = append(labels, key+"="+value)
+	}
+	sort.Strings(labels)
+	return fmt.Sprintf("%s:%s:%s:%d:%s", l.Mesh, l.NamePrefix, strings.Join(tags, ","), l.ModifiedSince.UnixNano(), strings.Join(labels, ","))
 }
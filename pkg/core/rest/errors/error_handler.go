@@ -7,6 +7,7 @@ import (
 
 	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/rbac"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
@@ -19,18 +20,24 @@ func HandleError(response *restful.Response, err error, title string) {
 		handleNotFound(title, response)
 	case store.IsResourcePreconditionFailed(err):
 		handlePreconditionFailed(title, response)
+	case store.IsResourceConflict(err), store.IsResourceAlreadyExists(err):
+		handleConflict(title, response)
 	case err == store.ErrorInvalidOffset:
 		handleInvalidOffset(title, response)
 	case manager.IsMeshNotFound(err):
 		handleMeshNotFound(title, err.(*manager.MeshNotFoundError), response)
 	case validators.IsValidationError(err):
 		handleValidationError(title, err.(*validators.ValidationError), response)
+	case api_server_types.IsInvalidFilters(err):
+		handleInvalidFilters(title, err.(*api_server_types.InvalidFilters), response)
 	case api_server_types.IsMaxPageSizeExceeded(err):
 		handleMaxPageSizeExceeded(title, err, response)
 	case err == api_server_types.InvalidPageSize:
 		handleInvalidPageSize(title, response)
 	case err == api_server_types.PaginationNotSupported:
 		handlePaginationNotSupported(title, response)
+	case rbac.IsAccessDenied(err):
+		handleAccessDenied(title, err, response)
 	default:
 		handleUnknownError(err, title, response)
 	}
@@ -66,6 +73,20 @@ func handlePreconditionFailed(title string, response *restful.Response) {
 	writeError(response, 412, kumaErr)
 }
 
+func handleConflict(title string, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: "Conflict",
+		Causes: []types.Cause{
+			{
+				Field:   "",
+				Message: "the resource was modified concurrently, fetch the resource and try again",
+			},
+		},
+	}
+	writeError(response, 409, kumaErr)
+}
+
 func handleMeshNotFound(title string, err *manager.MeshNotFoundError, response *restful.Response) {
 	kumaErr := types.Error{
 		Title:   title,
@@ -94,6 +115,20 @@ func handleValidationError(title string, err *validators.ValidationError, respon
 	writeError(response, 400, kumaErr)
 }
 
+func handleInvalidFilters(title string, err *api_server_types.InvalidFilters, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: "Invalid filters",
+	}
+	for _, violation := range err.Violations {
+		kumaErr.Causes = append(kumaErr.Causes, types.Cause{
+			Field:   violation.Field,
+			Message: violation.Message,
+		})
+	}
+	writeError(response, 400, kumaErr)
+}
+
 func handleInvalidOffset(title string, response *restful.Response) {
 	kumaErr := types.Error{
 		Title:   title,
@@ -130,6 +165,37 @@ func handleMaxPageSizeExceeded(title string, err error, response *restful.Respon
 	writeError(response, 400, kumaErr)
 }
 
+func handleAccessDenied(title string, err error, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: "Access Denied",
+		Causes: []types.Cause{
+			{
+				Field:   "",
+				Message: err.Error(),
+			},
+		},
+	}
+	writeError(response, 403, kumaErr)
+}
+
+// HandleUnauthenticated writes the response for requests without valid credentials.
+func HandleUnauthenticated(title string, err error, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: "Unauthenticated",
+	}
+	if err != nil {
+		kumaErr.Causes = []types.Cause{
+			{
+				Field:   "",
+				Message: err.Error(),
+			},
+		}
+	}
+	writeError(response, 401, kumaErr)
+}
+
 func handleUnknownError(err error, title string, response *restful.Response) {
 	core.Log.Error(err, title)
 	kumaErr := types.Error{
@@ -12,6 +12,7 @@ import (
 	"github.com/kumahq/kuma/pkg/dns"
 
 	"github.com/kumahq/kuma/pkg/core/ca"
+	"github.com/kumahq/kuma/pkg/core/datasource"
 
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
@@ -42,6 +43,7 @@ type RuntimeContext interface {
 	SecretStore() store.SecretStore
 	ConfigStore() core_store.ResourceStore
 	CaManagers() ca.Managers
+	DataSourceLoader() datasource.Loader
 	Extensions() context.Context
 	DNSResolver() dns.DNSResolver
 	ConfigManager() config_manager.ConfigManager
@@ -88,6 +90,7 @@ type runtimeContext struct {
 	rom      core_manager.ReadOnlyResourceManager
 	cam      ca.Managers
 	xds      core_xds.XdsContext
+	dsl      datasource.Loader
 	ext      context.Context
 	dns      dns.DNSResolver
 	configm  config_manager.ConfigManager
@@ -124,6 +127,9 @@ func (rc *runtimeContext) ConfigStore() core_store.ResourceStore {
 func (rc *runtimeContext) ReadOnlyResourceManager() core_manager.ReadOnlyResourceManager {
 	return rc.rom
 }
+func (rc *runtimeContext) DataSourceLoader() datasource.Loader {
+	return rc.dsl
+}
 func (rc *runtimeContext) Extensions() context.Context {
 	return rc.ext
 }
@@ -63,6 +63,9 @@ func (s *secretManager) Create(ctx context.Context, resource model.Resource, fs
 	if !ok {
 		return newInvalidTypeError()
 	}
+	if core_store.NewCreateOptions(fs...).DryRun {
+		return nil
+	}
 	if err := s.encrypt(secret); err != nil {
 		return err
 	}
@@ -77,6 +80,9 @@ func (s *secretManager) Update(ctx context.Context, resource model.Resource, fs
 	if !ok {
 		return newInvalidTypeError()
 	}
+	if core_store.NewUpdateOptions(fs...).DryRun {
+		return nil
+	}
 	if err := s.encrypt(secret); err != nil {
 		return err
 	}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+)
+
+// AdminGroup is a group of users that are allowed to do any operation regardless of Roles.
+const AdminGroup = "kuma:admin"
+
+// User is an authenticated user of the Control Plane API.
+type User struct {
+	Name   string
+	Groups []string
+}
+
+// Admin is a user of requests that do not have to be authenticated, like requests to the local Admin Server
+// or requests when authentication is disabled.
+var Admin = User{
+	Name:   "admin",
+	Groups: []string{AdminGroup},
+}
+
+func (u User) IsAdmin() bool {
+	for _, group := range u.Groups {
+		if group == AdminGroup {
+			return true
+		}
+	}
+	return false
+}
+
+type userCtxKey struct{}
+
+func NewContext(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userCtxKey{}, user)
+}
+
+// FromContext returns a user that made the request.
+func FromContext(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(userCtxKey{}).(User)
+	return user, ok
+}
@@ -0,0 +1,151 @@
+package xds
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server/v2"
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+// DiscoveryResources is a set of resources of a single type sent to Envoy in a DiscoveryResponse.
+type DiscoveryResources struct {
+	TypeUrl   string
+	Version   string
+	Resources []*any.Any
+	// Error is a message of the last NACK of this type, if Envoy rejected a newer version.
+	Error string
+}
+
+// ConfigTracker tracks configuration that was ACKed by Envoys, so it can be compared with the generated one,
+// and metadata of their nodes, so configuration can be generated again outside of an xDS stream.
+type ConfigTracker struct {
+	mu      sync.RWMutex // protects access to the fields below
+	streams map[StreamID]*streamConfig
+}
+
+type streamConfig struct {
+	proxyId  string
+	metadata *DataplaneMetadata
+	sent     map[string]*envoy.DiscoveryResponse
+	acked    map[string]*envoy.DiscoveryResponse
+	errors   map[string]string
+}
+
+func NewConfigTracker() *ConfigTracker {
+	return &ConfigTracker{
+		streams: map[StreamID]*streamConfig{},
+	}
+}
+
+// AckedConfig returns resources ACKed by Envoy of a given proxy, sorted by the type URL.
+func (t *ConfigTracker) AckedConfig(proxyId ProxyId) ([]DiscoveryResources, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	latest := t.latestStream(proxyId)
+	if latest == nil {
+		return nil, false
+	}
+	var config []DiscoveryResources
+	for typeUrl, response := range latest.acked {
+		config = append(config, DiscoveryResources{
+			TypeUrl:   typeUrl,
+			Version:   response.VersionInfo,
+			Resources: response.Resources,
+			Error:     latest.errors[typeUrl],
+		})
+	}
+	for typeUrl, err := range latest.errors {
+		if _, acked := latest.acked[typeUrl]; !acked {
+			config = append(config, DiscoveryResources{TypeUrl: typeUrl, Error: err})
+		}
+	}
+	sort.Slice(config, func(i, j int) bool {
+		return config[i].TypeUrl < config[j].TypeUrl
+	})
+	return config, true
+}
+
+// Metadata returns metadata of a given proxy or empty metadata if the proxy is not connected.
+func (t *ConfigTracker) Metadata(proxyId ProxyId) *DataplaneMetadata {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if latest := t.latestStream(proxyId); latest != nil && latest.metadata != nil {
+		return latest.metadata
+	}
+	return &DataplaneMetadata{}
+}
+
+func (t *ConfigTracker) latestStream(proxyId ProxyId) *streamConfig {
+	var latest *streamConfig
+	var latestId StreamID
+	for id, stream := range t.streams {
+		// there can be more than one stream of a proxy for a moment when Envoy reconnects
+		if stream.proxyId == proxyId.String() && (latest == nil || id > latestId) {
+			latest, latestId = stream, id
+		}
+	}
+	return latest
+}
+
+var _ envoy_xds.Callbacks = &ConfigTracker{}
+
+func (t *ConfigTracker) OnStreamOpen(context.Context, int64, string) error {
+	return nil
+}
+
+func (t *ConfigTracker) OnStreamClosed(streamID int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.streams, streamID)
+}
+
+func (t *ConfigTracker) OnStreamRequest(streamID int64, req *envoy.DiscoveryRequest) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stream, ok := t.streams[streamID]
+	if !ok {
+		stream = &streamConfig{
+			sent:   map[string]*envoy.DiscoveryResponse{},
+			acked:  map[string]*envoy.DiscoveryResponse{},
+			errors: map[string]string{},
+		}
+		t.streams[streamID] = stream
+	}
+	if req.Node != nil {
+		// only the first request on a stream is guaranteed to carry the node identifier
+		if proxyId, err := ParseProxyId(req.Node); err == nil {
+			stream.proxyId = proxyId.String()
+		}
+		stream.metadata = DataplaneMetadataFromNode(req.Node)
+	}
+	sent, ok := stream.sent[req.TypeUrl]
+	if !ok || req.ResponseNonce == "" || req.ResponseNonce != sent.Nonce {
+		// initial request or a response to an outdated DiscoveryResponse
+		return nil
+	}
+	if req.ErrorDetail != nil {
+		stream.errors[req.TypeUrl] = req.ErrorDetail.Message
+		return nil
+	}
+	stream.acked[req.TypeUrl] = sent
+	delete(stream.errors, req.TypeUrl)
+	return nil
+}
+
+func (t *ConfigTracker) OnStreamResponse(streamID int64, _ *envoy.DiscoveryRequest, resp *envoy.DiscoveryResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if stream, ok := t.streams[streamID]; ok {
+		stream.sent[resp.TypeUrl] = resp
+	}
+}
+
+func (t *ConfigTracker) OnFetchRequest(context.Context, *envoy.DiscoveryRequest) error {
+	return nil
+}
+
+func (t *ConfigTracker) OnFetchResponse(*envoy.DiscoveryRequest, *envoy.DiscoveryResponse) {
+}
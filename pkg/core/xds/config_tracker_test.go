@@ -0,0 +1,119 @@
+package xds_test
+
+import (
+	"context"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+	"github.com/golang/protobuf/ptypes/any"
+	pstruct "github.com/golang/protobuf/ptypes/struct"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	rpc_status "google.golang.org/genproto/googleapis/rpc/status"
+
+	"github.com/kumahq/kuma/pkg/core/xds"
+)
+
+var _ = Describe("ConfigTracker", func() {
+
+	var tracker *xds.ConfigTracker
+	proxyId := xds.ProxyId{Mesh: "demo", Name: "web-01"}
+	clusters := []*any.Any{{TypeUrl: envoy_resource.ClusterType, Value: []byte("cluster")}}
+
+	BeforeEach(func() {
+		tracker = xds.NewConfigTracker()
+		Expect(tracker.OnStreamOpen(context.Background(), 1, "")).To(Succeed())
+		Expect(tracker.OnStreamRequest(1, &envoy.DiscoveryRequest{
+			Node:    &envoy_core.Node{Id: "demo.web-01"},
+			TypeUrl: envoy_resource.ClusterType,
+		})).To(Succeed())
+		tracker.OnStreamResponse(1, nil, &envoy.DiscoveryResponse{
+			TypeUrl:     envoy_resource.ClusterType,
+			VersionInfo: "v1",
+			Nonce:       "1",
+			Resources:   clusters,
+		})
+	})
+
+	It("should not return config that was not ACKed yet", func() {
+		// when
+		config, ok := tracker.AckedConfig(proxyId)
+
+		// then
+		Expect(ok).To(BeTrue())
+		Expect(config).To(BeEmpty())
+	})
+
+	It("should return ACKed config", func() {
+		// when
+		Expect(tracker.OnStreamRequest(1, &envoy.DiscoveryRequest{
+			TypeUrl:       envoy_resource.ClusterType,
+			VersionInfo:   "v1",
+			ResponseNonce: "1",
+		})).To(Succeed())
+		config, ok := tracker.AckedConfig(proxyId)
+
+		// then
+		Expect(ok).To(BeTrue())
+		Expect(config).To(Equal([]xds.DiscoveryResources{
+			{TypeUrl: envoy_resource.ClusterType, Version: "v1", Resources: clusters},
+		}))
+	})
+
+	It("should keep ACKed config and an error when a newer config is rejected", func() {
+		// given
+		Expect(tracker.OnStreamRequest(1, &envoy.DiscoveryRequest{
+			TypeUrl:       envoy_resource.ClusterType,
+			VersionInfo:   "v1",
+			ResponseNonce: "1",
+		})).To(Succeed())
+		tracker.OnStreamResponse(1, nil, &envoy.DiscoveryResponse{
+			TypeUrl:     envoy_resource.ClusterType,
+			VersionInfo: "v2",
+			Nonce:       "2",
+		})
+
+		// when
+		Expect(tracker.OnStreamRequest(1, &envoy.DiscoveryRequest{
+			TypeUrl:       envoy_resource.ClusterType,
+			VersionInfo:   "v1",
+			ResponseNonce: "2",
+			ErrorDetail:   &rpc_status.Status{Message: "invalid cluster"},
+		})).To(Succeed())
+		config, _ := tracker.AckedConfig(proxyId)
+
+		// then
+		Expect(config).To(Equal([]xds.DiscoveryResources{
+			{TypeUrl: envoy_resource.ClusterType, Version: "v1", Resources: clusters, Error: "invalid cluster"},
+		}))
+	})
+
+	It("should return metadata of a connected proxy", func() {
+		// when
+		Expect(tracker.OnStreamRequest(2, &envoy.DiscoveryRequest{
+			Node: &envoy_core.Node{
+				Id: "demo.web-02",
+				Metadata: &pstruct.Struct{
+					Fields: map[string]*pstruct.Value{
+						"dataplane.admin.port": {Kind: &pstruct.Value_StringValue{StringValue: "9901"}},
+					},
+				},
+			},
+			TypeUrl: envoy_resource.ClusterType,
+		})).To(Succeed())
+
+		// then
+		Expect(tracker.Metadata(xds.ProxyId{Mesh: "demo", Name: "web-02"}).GetAdminPort()).To(Equal(uint32(9901)))
+		Expect(tracker.Metadata(xds.ProxyId{Mesh: "demo", Name: "unknown"})).To(Equal(&xds.DataplaneMetadata{}))
+	})
+
+	It("should forget config when a stream is closed", func() {
+		// when
+		tracker.OnStreamClosed(1)
+		_, ok := tracker.AckedConfig(proxyId)
+
+		// then
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -13,6 +13,7 @@ import (
 type XdsContext interface {
 	Hasher() envoy_cache.NodeHash
 	Cache() envoy_cache.SnapshotCache
+	ConfigTracker() *ConfigTracker
 }
 
 func NewXdsContext() XdsContext {
@@ -28,6 +29,7 @@ func newXdsContext(name string, ads bool) XdsContext {
 		NodeHash:      hasher,
 		Logger:        logger,
 		SnapshotCache: cache,
+		configTracker: NewConfigTracker(),
 	}
 }
 
@@ -37,6 +39,7 @@ type xdsContext struct {
 	envoy_cache.NodeHash
 	envoy_log.Logger
 	envoy_cache.SnapshotCache
+	configTracker *ConfigTracker
 }
 
 func (c *xdsContext) Hasher() envoy_cache.NodeHash {
@@ -47,6 +50,10 @@ func (c *xdsContext) Cache() envoy_cache.SnapshotCache {
 	return c.SnapshotCache
 }
 
+func (c *xdsContext) ConfigTracker() *ConfigTracker {
+	return c.configTracker
+}
+
 var _ envoy_cache.NodeHash = &hasher{}
 
 type hasher struct {
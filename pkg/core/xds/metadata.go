@@ -3,8 +3,10 @@ package xds
 import (
 	"strconv"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 
 	"github.com/kumahq/kuma/pkg/core"
 
@@ -19,6 +21,7 @@ const (
 	fieldDataplaneTokenPath         = "dataplaneTokenPath"
 	fieldDataplaneAdminPort         = "dataplane.admin.port"
 	fieldDataplaneDataplaneResource = "dataplane.resource"
+	fieldVersion                    = "version"
 )
 
 // DataplaneMetadata represents environment-specific part of a dataplane configuration.
@@ -39,6 +42,7 @@ type DataplaneMetadata struct {
 	DataplaneTokenPath string
 	DataplaneResource  *core_mesh.DataplaneResource
 	AdminPort          uint32
+	Version            *mesh_proto.Version
 }
 
 func (m *DataplaneMetadata) GetDataplaneTokenPath() string {
@@ -62,6 +66,13 @@ func (m *DataplaneMetadata) GetAdminPort() uint32 {
 	return m.AdminPort
 }
 
+func (m *DataplaneMetadata) GetVersion() *mesh_proto.Version {
+	if m == nil {
+		return nil
+	}
+	return m.Version
+}
+
 func DataplaneMetadataFromNode(node *envoy_core.Node) *DataplaneMetadata {
 	metadata := DataplaneMetadata{}
 	if node.Metadata == nil {
@@ -88,5 +99,13 @@ func DataplaneMetadataFromNode(node *envoy_core.Node) *DataplaneMetadata {
 		}
 		metadata.DataplaneResource = dp
 	}
+	if value := node.Metadata.Fields[fieldVersion]; value != nil {
+		version := &mesh_proto.Version{}
+		if err := util_proto.ToTyped(value.GetStructValue(), version); err != nil {
+			metadataLog.Error(err, "invalid value in dataplane metadata", "field", fieldVersion, "value", value)
+		} else {
+			metadata.Version = version
+		}
+	}
 	return &metadata
 }
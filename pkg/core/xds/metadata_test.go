@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/xds"
 )
 
@@ -48,4 +49,46 @@ var _ = DescribeTable("DataplaneMetadataFromNode",
 			AdminPort:          1234,
 		},
 	}),
+	Entry("should parse version", testCase{
+		node: envoy_core.Node{
+			Metadata: &pstruct.Struct{
+				Fields: map[string]*pstruct.Value{
+					"version": &pstruct.Value{
+						Kind: &pstruct.Value_StructValue{
+							StructValue: &pstruct.Struct{
+								Fields: map[string]*pstruct.Value{
+									"kumaDp": &pstruct.Value{
+										Kind: &pstruct.Value_StructValue{
+											StructValue: &pstruct.Struct{
+												Fields: map[string]*pstruct.Value{
+													"version": &pstruct.Value{
+														Kind: &pstruct.Value_StringValue{
+															StringValue: "0.8.0",
+														},
+													},
+													"gitTag": &pstruct.Value{
+														Kind: &pstruct.Value_StringValue{
+															StringValue: "v0.8.0",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		expected: xds.DataplaneMetadata{
+			Version: &mesh_proto.Version{
+				KumaDp: &mesh_proto.KumaDpVersion{
+					Version: "0.8.0",
+					GitTag:  "v0.8.0",
+				},
+			},
+		},
+	}),
 )
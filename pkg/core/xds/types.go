@@ -68,6 +68,22 @@ type FaultInjectionMap map[mesh_proto.InboundInterface]*mesh_proto.FaultInjectio
 // TrafficPermissionMap holds the most specific TrafficPermissionResource for each InboundInterface
 type TrafficPermissionMap map[mesh_proto.InboundInterface]*mesh_core.TrafficPermissionResource
 
+// GatewayListener is a port and a hostname a gateway Dataplane accepts traffic on.
+// TLS certificate and key of HTTPS listeners are already loaded from data sources.
+type GatewayListener struct {
+	Port        uint32
+	Protocol    mesh_proto.GatewayListener_Listener_Protocol
+	Hostname    string
+	Certificate []byte
+	Key         []byte
+}
+
+// Gateway holds listeners and routes of a gateway Dataplane.
+type Gateway struct {
+	Listeners []GatewayListener
+	Routes    []*mesh_core.GatewayRouteResource
+}
+
 type Proxy struct {
 	Id                 ProxyId
 	Dataplane          *mesh_core.DataplaneResource
@@ -82,6 +98,7 @@ type Proxy struct {
 	TracingBackend     *mesh_proto.TracingBackend
 	Metadata           *DataplaneMetadata
 	FaultInjections    FaultInjectionMap
+	Gateway            *Gateway
 }
 
 func (s TagSelectorSet) Add(new mesh_proto.TagSelector) TagSelectorSet {
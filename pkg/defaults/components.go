@@ -63,6 +63,12 @@ func (d *defaultsComponent) Start(_ <-chan struct{}) error {
 	} else if err := d.createSigningKeyIfNotExist(); err != nil {
 		return errors.Wrap(err, "could not create the default Signing Key")
 	}
+
+	if !d.shouldCreateUserTokenSigningKey() {
+		log.V(1).Info("skip creating User Token Signing Key since CP with this mode is not required to have a key", "mode", d.cpMode)
+	} else if err := d.createUserTokenSigningKeyIfNotExist(); err != nil {
+		return errors.Wrap(err, "could not create the User Token Signing Key")
+	}
 	return nil
 }
 
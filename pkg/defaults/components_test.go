@@ -158,4 +158,40 @@ var _ = Describe("Defaults Component", func() {
 		}),
 	)
 
+	DescribeTable("create user token signing key",
+		func(given testCase) {
+			// given
+			store := resources_memory.NewStore()
+			manager := core_manager.NewResourceManager(store)
+			component := defaults.NewDefaultsComponent(&kuma_cp.Defaults{SkipMeshCreation: true}, given.cpMode, given.environment, manager, store)
+
+			// when
+			err := component.Start(nil)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			_, err = issuer.GetUserTokenSigningKey(manager)
+			if given.shouldCreate {
+				Expect(err).To(BeNil())
+			} else {
+				Expect(err).To(Equal(issuer.SigningKeyNotFound))
+			}
+		},
+		Entry("should succeed when mode is global", testCase{
+			cpMode:       core.Global,
+			environment:  core.UniversalEnvironment,
+			shouldCreate: true,
+		}),
+		Entry("should succeed when mode is standalone and env is kubernetes", testCase{
+			cpMode:       core.Standalone,
+			environment:  core.KubernetesEnvironment,
+			shouldCreate: true,
+		}),
+		Entry("should fail when mode is remote", testCase{
+			cpMode:       core.Remote,
+			environment:  core.UniversalEnvironment,
+			shouldCreate: false,
+		}),
+	)
+
 })
@@ -4,12 +4,13 @@ import (
 	"context"
 
 	config_core "github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
-func (d *defaultsComponent) signingKeyExists() (bool, error) {
-	_, err := issuer.GetSigningKey(d.resManager)
+func (d *defaultsComponent) signingKeyExists(getSigningKey func() ([]byte, error)) (bool, error) {
+	_, err := getSigningKey()
 	switch err {
 	case issuer.SigningKeyNotFound:
 		return false, nil
@@ -21,25 +22,37 @@ func (d *defaultsComponent) signingKeyExists() (bool, error) {
 }
 
 func (d *defaultsComponent) createSigningKeyIfNotExist() error {
-	exists, err := d.signingKeyExists()
+	return d.createKeyIfNotExist(issuer.SigningKeyResourceKey, func() ([]byte, error) {
+		return issuer.GetSigningKey(d.resManager)
+	}, "Signing Key for generating Dataplane Token")
+}
+
+func (d *defaultsComponent) createUserTokenSigningKeyIfNotExist() error {
+	return d.createKeyIfNotExist(issuer.UserTokenSigningKeyResourceKey, func() ([]byte, error) {
+		return issuer.GetUserTokenSigningKey(d.resManager)
+	}, "Signing Key for generating User Token")
+}
+
+func (d *defaultsComponent) createKeyIfNotExist(resKey model.ResourceKey, getSigningKey func() ([]byte, error), description string) error {
+	exists, err := d.signingKeyExists(getSigningKey)
 	if err != nil {
 		return err
 	}
 	if exists {
-		log.V(1).Info("default Signing Key already exists. Skip creating default Signing Key.")
+		log.V(1).Info("default Signing Key already exists. Skip creating default Signing Key.", "name", resKey.Name)
 	} else {
-		log.Info("trying to create Signing Key")
+		log.Info("trying to create Signing Key", "name", resKey.Name)
 		key, err := issuer.CreateSigningKey()
 		if err != nil {
 			return err
 		}
 		// We are using directly Resource Store instead of Resource Manager to bypass Mesh validation. Even if there is no default Mesh, we still want to create signing key.
 		// Drop this if we will switch to have Secrets not scoped to the Mesh.
-		if err := d.resStore.Create(context.Background(), &key, core_store.CreateBy(issuer.SigningKeyResourceKey)); err != nil {
-			log.V(1).Info("could not create Signing Key", "err", err)
+		if err := d.resStore.Create(context.Background(), &key, core_store.CreateBy(resKey)); err != nil {
+			log.V(1).Info("could not create Signing Key", "name", resKey.Name, "err", err)
 			return err
 		}
-		log.Info("Signing Key for generating Dataplane Token created")
+		log.Info(description + " created")
 	}
 	return nil
 }
@@ -55,3 +68,9 @@ func (d *defaultsComponent) shouldCreateSigningKey() bool {
 	}
 	return false
 }
+
+func (d *defaultsComponent) shouldCreateUserTokenSigningKey() bool {
+	// User Tokens authenticate users of the API Server which is available on every environment.
+	// Remote receives the key from Global, so a token generated on Global is also valid on Remote.
+	return d.cpMode != config_core.Remote
+}
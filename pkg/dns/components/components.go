@@ -6,7 +6,8 @@ import (
 )
 
 func SetupServer(rt runtime.Runtime) error {
-	server, err := dns.NewDNSServer(rt.Config().DNSServer.Port, rt.DNSResolver(), rt.Metrics())
+	cfg := rt.Config().DNSServer
+	server, err := dns.NewDNSServer(cfg.Port, rt.DNSResolver(), cfg.Upstreams, cfg.SearchDomains, rt.Metrics())
 	if err != nil {
 		return err
 	}
@@ -15,7 +16,7 @@ func SetupServer(rt runtime.Runtime) error {
 	if err != nil {
 		return err
 	}
-	ipam, err := dns.NewSimpleIPAM(rt.Config().DNSServer.CIDR)
+	ipam, err := dns.NewSimpleIPAM(cfg.CIDR)
 	if err != nil {
 		return err
 	}
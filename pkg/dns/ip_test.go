@@ -2,6 +2,7 @@ package dns_test
 
 import (
 	"math"
+	"net"
 
 	. "github.com/kumahq/kuma/pkg/dns"
 
@@ -51,4 +52,27 @@ var _ = Describe("DNS ip", func() {
 			Expect(err).ToNot(HaveOccurred())
 		}
 	})
+
+	It("should allocate IPv6 addresses", func() {
+		// given
+		ipam, err := NewSimpleIPAM("fd00:fd00::/64")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		ip1, err := ipam.AllocateIP()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(net.ParseIP(ip1).To4()).To(BeNil())
+
+		// when
+		ip2, err := ipam.AllocateIP()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ip2).ToNot(Equal(ip1))
+
+		// when
+		err = ipam.FreeIP(ip1)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
 })
@@ -1,27 +1,69 @@
 package dns
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/miekg/dns"
 	"github.com/pkg/errors"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 )
 
 type VIPList map[string]string
 
+// ServicePorts are ports on which instances of a service listen, by a service name.
+type ServicePorts map[string][]uint32
+
+// servicePortsOf returns sorted ports of inbounds of services implemented by the dataplanes.
+// Services available through an Ingress have no ports, because the Ingress does not carry them.
+func servicePortsOf(dataplanes []*core_mesh.DataplaneResource) ServicePorts {
+	unique := map[string]map[uint32]bool{}
+	for _, dp := range dataplanes {
+		for _, inbound := range dp.Spec.GetNetworking().GetInbound() {
+			service := inbound.GetService()
+			if service == "" {
+				continue
+			}
+			if unique[service] == nil {
+				unique[service] = map[uint32]bool{}
+			}
+			unique[service][inbound.Port] = true
+		}
+	}
+	ports := ServicePorts{}
+	for service, servicePorts := range unique {
+		for port := range servicePorts {
+			ports[service] = append(ports[service], port)
+		}
+		sort.Slice(ports[service], func(i, j int) bool {
+			return ports[service][i] < ports[service][j]
+		})
+	}
+	return ports
+}
+
+// VIPListenPort is a port of outbound listeners that Dataplanes expose on VIPs of services.
+const VIPListenPort = uint32(80)
+
 type DNSResolver interface {
 	GetDomain() string
 	SetVIPs(list VIPList)
+	SetServicePorts(ports ServicePorts)
 
 	ForwardLookup(service string) (string, error)
 	ForwardLookupFQDN(name string) (string, error)
 	ReverseLookup(ip string) (string, error)
+	// LookupPorts returns ports of a service. A service is always available on VIPListenPort of its VIP,
+	// when the ports of its instances are not known VIPListenPort is returned.
+	LookupPorts(service string) []uint32
 }
 
 type dnsResolver struct {
 	sync.RWMutex
 	domain  string
 	viplist VIPList
+	ports   ServicePorts
 }
 
 var _ DNSResolver = &dnsResolver{}
@@ -42,6 +84,21 @@ func (s *dnsResolver) SetVIPs(list VIPList) {
 	s.viplist = list
 }
 
+func (s *dnsResolver) SetServicePorts(ports ServicePorts) {
+	s.Lock()
+	defer s.Unlock()
+	s.ports = ports
+}
+
+func (s *dnsResolver) LookupPorts(service string) []uint32 {
+	s.RLock()
+	defer s.RUnlock()
+	if ports := s.ports[service]; len(ports) > 0 {
+		return ports
+	}
+	return []uint32{VIPListenPort}
+}
+
 func (s *dnsResolver) ForwardLookup(service string) (string, error) {
 	s.RLock()
 	defer s.RUnlock()
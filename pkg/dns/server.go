@@ -2,9 +2,13 @@ package dns
 
 import (
 	"fmt"
+	"net"
+	"strings"
 
 	"github.com/miekg/dns"
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/multierr"
 
 	"github.com/kumahq/kuma/pkg/core"
 	core_metrics "github.com/kumahq/kuma/pkg/metrics"
@@ -20,17 +24,27 @@ type DNSServer interface {
 }
 
 type SimpleDNSServer struct {
-	address  string
-	resolver DNSResolver
+	address       string
+	resolver      DNSResolver
+	upstreams     []string
+	searchDomains []string
+	client        *dns.Client
+	tcpClient     *dns.Client
+	mux           *dns.ServeMux
 
 	latencyMetric    prometheus.Summary
 	resolutionMetric *prometheus.CounterVec
 }
 
-func NewDNSServer(port uint32, resolver DNSResolver, metrics core_metrics.Metrics) (DNSServer, error) {
+func NewDNSServer(port uint32, resolver DNSResolver, upstreams []string, searchDomains []string, metrics core_metrics.Metrics) (DNSServer, error) {
 	handler := &SimpleDNSServer{
-		address:  fmt.Sprintf("0.0.0.0:%d", port),
-		resolver: resolver,
+		address:       fmt.Sprintf("0.0.0.0:%d", port),
+		resolver:      resolver,
+		upstreams:     upstreams,
+		searchDomains: searchDomains,
+		client:        &dns.Client{Net: "udp"},
+		tcpClient:     &dns.Client{Net: "tcp"},
+		mux:           dns.NewServeMux(),
 		latencyMetric: prometheus.NewSummary(prometheus.SummaryOpts{
 			Name:       "dns_server",
 			Help:       "Summary of DNS Server responses",
@@ -54,9 +68,14 @@ func NewDNSServer(port uint32, resolver DNSResolver, metrics core_metrics.Metric
 func (h *SimpleDNSServer) parseQuery(m *dns.Msg) {
 	for _, q := range m.Question {
 		switch q.Qtype {
-		case dns.TypeA:
+		case dns.TypeA, dns.TypeAAAA, dns.TypeSRV:
 			serverLog.V(1).Info("query for " + q.Name)
-			ip, err := h.resolver.ForwardLookupFQDN(q.Name)
+			name := q.Name
+			if q.Qtype == dns.TypeSRV {
+				name = srvTarget(q.Name)
+			}
+			lookupName := h.withoutSearchDomain(name)
+			ip, err := h.resolver.ForwardLookupFQDN(lookupName)
 			if err != nil {
 				serverLog.V(1).Info("unable to resolve", "Name", q.Name, "error", err.Error())
 				h.resolutionMetric.WithLabelValues("unresolved").Inc()
@@ -64,15 +83,68 @@ func (h *SimpleDNSServer) parseQuery(m *dns.Msg) {
 			}
 			h.resolutionMetric.WithLabelValues("resolved").Inc()
 
-			rr, err := dns.NewRR(fmt.Sprintf("%s %s IN A %s", q.Name, dnsTTL, ip))
+			address, err := addressRR(name, ip)
 			if err != nil {
 				serverLog.Error(err, "unable to create response for", "Name", q.Name)
 				return
 			}
 
-			m.Answer = append(m.Answer, rr)
+			switch q.Qtype {
+			case dns.TypeSRV:
+				service := dns.SplitDomainName(lookupName)[0]
+				for _, port := range h.resolver.LookupPorts(service) {
+					rr, err := dns.NewRR(fmt.Sprintf("%s %s IN SRV 0 0 %d %s", q.Name, dnsTTL, port, name))
+					if err != nil {
+						serverLog.Error(err, "unable to create response for", "Name", q.Name)
+						return
+					}
+					m.Answer = append(m.Answer, rr)
+				}
+				m.Extra = append(m.Extra, address)
+			default:
+				// an A query of a service with an IPv6 VIP (and vice versa) has no answer
+				if address.Header().Rrtype == q.Qtype {
+					m.Answer = append(m.Answer, address)
+				}
+			}
+		}
+	}
+}
+
+// addressRR returns an A or an AAAA record depending on the family of the IP.
+func addressRR(name string, ip string) (dns.RR, error) {
+	parsedIP := net.ParseIP(ip)
+	if parsedIP == nil {
+		return nil, errors.Errorf("invalid IP [%s]", ip)
+	}
+	if parsedIP.To4() != nil {
+		return dns.NewRR(fmt.Sprintf("%s %s IN A %s", name, dnsTTL, ip))
+	}
+	return dns.NewRR(fmt.Sprintf("%s %s IN AAAA %s", name, dnsTTL, ip))
+}
+
+// srvTarget strips the service and the protocol labels of a SRV query, e.g. "_http._tcp.backend.mesh." becomes "backend.mesh.".
+func srvTarget(name string) string {
+	labels := dns.SplitDomainName(name)
+	for len(labels) > 0 && strings.HasPrefix(labels[0], "_") {
+		labels = labels[1:]
+	}
+	return dns.Fqdn(strings.Join(labels, "."))
+}
+
+// withoutSearchDomain strips labels that a resolver of a client appended to a name of the domain out of its search list,
+// e.g. "backend.mesh.default.svc.cluster.local." becomes "backend.mesh." when "cluster.local" is one of the search domains.
+func (h *SimpleDNSServer) withoutSearchDomain(name string) string {
+	labels := dns.SplitDomainName(name)
+	if len(labels) < 3 || !strings.EqualFold(labels[1], h.resolver.GetDomain()) {
+		return name
+	}
+	for _, search := range h.searchDomains {
+		if dns.IsSubDomain(dns.Fqdn(search), name) {
+			return dns.Fqdn(labels[0] + "." + labels[1])
 		}
 	}
+	return name
 }
 
 func (h *SimpleDNSServer) handleDNSRequest(w dns.ResponseWriter, r *dns.Msg) {
@@ -91,42 +163,133 @@ func (h *SimpleDNSServer) handleDNSRequest(w dns.ResponseWriter, r *dns.Msg) {
 	}
 }
 
+// handleSearchDomainRequest answers queries for names of the domain with a search domain appended.
+// Other names of a search domain are handled like any other name outside of the domain.
+func (h *SimpleDNSServer) handleSearchDomainRequest(w dns.ResponseWriter, r *dns.Msg) {
+	if len(r.Question) > 0 {
+		name := srvTarget(r.Question[0].Name)
+		if h.withoutSearchDomain(name) != name {
+			h.handleDNSRequest(w, r)
+			return
+		}
+	}
+	if len(h.upstreams) > 0 {
+		h.forward(w, r)
+		return
+	}
+	dns.HandleFailed(w, r)
+}
+
+// forward passes a query outside of the domain to the first upstream resolver that answers it.
+func (h *SimpleDNSServer) forward(w dns.ResponseWriter, r *dns.Msg) {
+	for _, upstream := range h.upstreams {
+		response, err := h.exchange(r, upstream)
+		if err != nil {
+			serverLog.V(1).Info("unable to forward the query", "upstream", upstream, "error", err.Error())
+			continue
+		}
+		h.resolutionMetric.WithLabelValues("forwarded").Inc()
+		if _, ok := w.RemoteAddr().(*net.UDPAddr); ok {
+			// the client retries over TCP when the response does not fit into its UDP buffer
+			response.Truncate(udpSize(r))
+		}
+		if err := w.WriteMsg(response); err != nil {
+			serverLog.Error(err, "unable to write the DNS response.")
+		}
+		return
+	}
+	h.resolutionMetric.WithLabelValues("unresolved").Inc()
+	m := new(dns.Msg)
+	m.SetRcode(r, dns.RcodeServerFailure)
+	if err := w.WriteMsg(m); err != nil {
+		serverLog.Error(err, "unable to write the DNS response.")
+	}
+}
+
+// exchange sends a query to an upstream over UDP and retries it over TCP when the response was truncated.
+func (h *SimpleDNSServer) exchange(r *dns.Msg, upstream string) (*dns.Msg, error) {
+	response, _, err := h.client.Exchange(r, upstream)
+	if err == nil && response.Truncated {
+		response, _, err = h.tcpClient.Exchange(r, upstream)
+	}
+	return response, err
+}
+
+// udpSize returns the size of UDP responses that the client of a query accepts.
+func udpSize(r *dns.Msg) int {
+	if opt := r.IsEdns0(); opt != nil {
+		return int(opt.UDPSize())
+	}
+	return dns.MinMsgSize
+}
+
 func (d *SimpleDNSServer) NeedLeaderElection() bool {
 	return false
 }
 
 func (d *SimpleDNSServer) Start(stop <-chan struct{}) error {
-	server := &dns.Server{
-		Addr: d.address,
-		Net:  "udp",
+	// DNS clients fall back to TCP when a response over UDP is truncated
+	servers := []*dns.Server{
+		{Addr: d.address, Net: "udp", Handler: d.mux},
+		{Addr: d.address, Net: "tcp", Handler: d.mux},
 	}
 
-	errChan := make(chan error)
-	go func() {
-		defer close(errChan)
-		err := server.ListenAndServe()
-		if err != nil {
-			serverLog.Error(err, "failed to start the DNS listener.")
-			errChan <- err
+	started := make(chan struct{}, len(servers))
+	errChan := make(chan error, len(servers))
+	for _, server := range servers {
+		server.NotifyStartedFunc = func() {
+			started <- struct{}{}
 		}
-	}()
+		go func(server *dns.Server) {
+			if err := server.ListenAndServe(); err != nil {
+				serverLog.Error(err, "failed to start the DNS listener.", "net", server.Net)
+				errChan <- err
+			}
+		}(server)
+	}
+	for range servers {
+		select {
+		case <-started:
+		case err := <-errChan:
+			_ = shutdown(servers)
+			return err
+		}
+	}
 
 	serverLog.Info("starting", "address", d.address)
 	select {
 	case <-stop:
 		serverLog.Info("shutting down the DNS Server")
-		return server.Shutdown()
+		return shutdown(servers)
 	case err := <-errChan:
+		_ = shutdown(servers)
 		return err
 	}
 }
 
+func shutdown(servers []*dns.Server) (errs error) {
+	for _, server := range servers {
+		errs = multierr.Append(errs, server.Shutdown())
+	}
+	return
+}
+
 func (h *SimpleDNSServer) registerDNSHandler() {
-	dns.HandleFunc(h.resolver.GetDomain(), func(writer dns.ResponseWriter, msg *dns.Msg) {
+	h.mux.HandleFunc(h.resolver.GetDomain(), h.observed(h.handleDNSRequest))
+	for _, search := range h.searchDomains {
+		h.mux.HandleFunc(search, h.observed(h.handleSearchDomainRequest))
+	}
+	if len(h.upstreams) > 0 {
+		h.mux.HandleFunc(".", h.observed(h.forward))
+	}
+}
+
+func (h *SimpleDNSServer) observed(handler dns.HandlerFunc) dns.HandlerFunc {
+	return func(writer dns.ResponseWriter, msg *dns.Msg) {
 		start := core.Now()
 		defer func() {
 			h.latencyMetric.Observe(float64(core.Now().Sub(start).Milliseconds()))
 		}()
-		h.handleDNSRequest(writer, msg)
-	})
+		handler(writer, msg)
+	}
 }
@@ -2,6 +2,7 @@ package dns_test
 
 import (
 	"fmt"
+	"net"
 
 	"github.com/miekg/dns"
 	. "github.com/onsi/ginkgo"
@@ -20,11 +21,32 @@ var _ = Describe("DNS server", func() {
 		var port uint32
 		stop := make(chan struct{})
 		done := make(chan struct{})
+		stopUpstream := make(chan struct{})
+		doneUpstream := make(chan struct{})
 		var metrics core_metrics.Metrics
 
 		BeforeEach(func() {
-			// setup
+			// setup upstream resolver
 			p, err := test.GetFreePort()
+			Expect(err).ToNot(HaveOccurred())
+			upstreamPort := uint32(p)
+
+			upstreamResolver := NewDNSResolver("upstream")
+			upstreamResolver.SetVIPs(map[string]string{
+				"web": "240.0.0.2",
+			})
+			upstreamMetrics, err := core_metrics.NewMetrics("Standalone")
+			Expect(err).ToNot(HaveOccurred())
+			upstream, err := NewDNSServer(upstreamPort, upstreamResolver, nil, nil, upstreamMetrics)
+			Expect(err).ToNot(HaveOccurred())
+			go func() {
+				err := upstream.Start(stopUpstream)
+				Expect(err).ToNot(HaveOccurred())
+				doneUpstream <- struct{}{}
+			}()
+
+			// setup
+			p, err = test.GetFreePort()
 			port = uint32(p)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -32,10 +54,14 @@ var _ = Describe("DNS server", func() {
 			m, err := core_metrics.NewMetrics("Standalone")
 			metrics = m
 			Expect(err).ToNot(HaveOccurred())
-			server, err := NewDNSServer(port, resolver, metrics)
+			server, err := NewDNSServer(port, resolver, []string{fmt.Sprintf("127.0.0.1:%d", upstreamPort)}, []string{"cluster.local"}, metrics)
 			Expect(err).ToNot(HaveOccurred())
 			resolver.SetVIPs(map[string]string{
-				"service": "240.0.0.1",
+				"service":    "240.0.0.1",
+				"service-v6": "fd00::1",
+			})
+			resolver.SetServicePorts(ServicePorts{
+				"service": {8080, 9090},
 			})
 
 			// given
@@ -53,8 +79,23 @@ var _ = Describe("DNS server", func() {
 			// stop the resolver
 			stop <- struct{}{}
 			<-done
+			stopUpstream <- struct{}{}
+			<-doneUpstream
 		})
 
+		exchange := func(name string, qtype uint16) *dns.Msg {
+			client := new(dns.Client)
+			message := new(dns.Msg)
+			_ = message.SetQuestion(name, qtype)
+			var response *dns.Msg
+			Eventually(func() error {
+				var err error
+				response, _, err = client.Exchange(message, fmt.Sprintf("127.0.0.1:%d", port))
+				return err
+			}).ShouldNot(HaveOccurred())
+			return response
+		}
+
 		It("should resolve", func() {
 			// when
 			client := new(dns.Client)
@@ -119,5 +160,186 @@ var _ = Describe("DNS server", func() {
 			// and metrics are published
 			Expect(test_metrics.FindMetric(metrics, "dns_server_resolution", "result", "unresolved").Counter.GetValue()).To(Equal(1.0))
 		})
+
+		It("should resolve IPv6", func() {
+			// when
+			response := exchange("service-v6.mesh.", dns.TypeAAAA)
+
+			// then
+			Expect(response.Answer).To(HaveLen(1))
+			Expect(response.Answer[0].String()).To(Equal("service-v6.mesh.\t60\tIN\tAAAA\tfd00::1"))
+
+			// when
+			response = exchange("service-v6.mesh.", dns.TypeA)
+
+			// then
+			Expect(response.Answer).To(BeEmpty())
+		})
+
+		It("should resolve SRV", func() {
+			// when
+			response := exchange("_http._tcp.service.mesh.", dns.TypeSRV)
+
+			// then
+			Expect(response.Answer).To(HaveLen(2))
+			Expect(response.Answer[0].String()).To(Equal("_http._tcp.service.mesh.\t60\tIN\tSRV\t0 0 8080 service.mesh."))
+			Expect(response.Answer[1].String()).To(Equal("_http._tcp.service.mesh.\t60\tIN\tSRV\t0 0 9090 service.mesh."))
+			// and
+			Expect(response.Extra).To(HaveLen(1))
+			Expect(response.Extra[0].String()).To(Equal(fmt.Sprintf("service.mesh.\t60\tIN\tA\t%s", ip)))
+		})
+
+		It("should resolve SRV of a service with unknown ports to the VIP listen port", func() {
+			// when
+			response := exchange("_http._tcp.service-v6.mesh.", dns.TypeSRV)
+
+			// then
+			Expect(response.Answer).To(HaveLen(1))
+			Expect(response.Answer[0].String()).To(Equal("_http._tcp.service-v6.mesh.\t60\tIN\tSRV\t0 0 80 service-v6.mesh."))
+		})
+
+		It("should resolve names with a search domain appended", func() {
+			// when
+			response := exchange("service.mesh.default.svc.cluster.local.", dns.TypeA)
+
+			// then
+			Expect(response.Answer).To(HaveLen(1))
+			Expect(response.Answer[0].String()).To(Equal(fmt.Sprintf("service.mesh.default.svc.cluster.local.\t60\tIN\tA\t%s", ip)))
+
+			// when
+			response = exchange("_http._tcp.service.mesh.svc.cluster.local.", dns.TypeSRV)
+
+			// then
+			Expect(response.Answer).To(HaveLen(2))
+			Expect(response.Answer[0].String()).To(Equal("_http._tcp.service.mesh.svc.cluster.local.\t60\tIN\tSRV\t0 0 8080 service.mesh.svc.cluster.local."))
+		})
+
+		It("should forward other names of a search domain to upstreams", func() {
+			// when
+			response := exchange("web.upstream.cluster.local.", dns.TypeA)
+
+			// then
+			Expect(response.Answer).To(BeEmpty())
+			// and
+			Expect(test_metrics.FindMetric(metrics, "dns_server_resolution", "result", "forwarded").Counter.GetValue()).To(Equal(1.0))
+		})
+
+		It("should resolve over TCP", func() {
+			// when
+			client := &dns.Client{Net: "tcp"}
+			message := new(dns.Msg)
+			_ = message.SetQuestion("service.mesh.", dns.TypeA)
+			var response *dns.Msg
+			Eventually(func() error {
+				var err error
+				response, _, err = client.Exchange(message, fmt.Sprintf("127.0.0.1:%d", port))
+				return err
+			}).ShouldNot(HaveOccurred())
+
+			// then
+			Expect(response.Answer).To(HaveLen(1))
+			Expect(response.Answer[0].String()).To(Equal(fmt.Sprintf("service.mesh.\t60\tIN\tA\t%s", ip)))
+		})
+
+		It("should forward queries outside of the domain to upstreams", func() {
+			// when
+			response := exchange("web.upstream.", dns.TypeA)
+
+			// then
+			Expect(response.Answer).To(HaveLen(1))
+			Expect(response.Answer[0].String()).To(Equal("web.upstream.\t60\tIN\tA\t240.0.0.2"))
+
+			// and metrics are published
+			Expect(test_metrics.FindMetric(metrics, "dns_server_resolution", "result", "forwarded").Counter.GetValue()).To(Equal(1.0))
+		})
+	})
+
+	Describe("Forwarding of truncated responses", func() {
+		var port uint32
+		var stop chan struct{}
+		var upstreams []*dns.Server
+
+		BeforeEach(func() {
+			// setup an upstream which truncates responses over UDP and answers with many records over TCP
+			p, err := test.GetFreePort()
+			Expect(err).ToNot(HaveOccurred())
+			upstreamAddress := fmt.Sprintf("127.0.0.1:%d", p)
+			handler := dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+				m := new(dns.Msg)
+				m.SetReply(r)
+				if _, ok := w.RemoteAddr().(*net.UDPAddr); ok {
+					m.Truncated = true
+				} else {
+					for i := 1; i <= 50; i++ {
+						rr, err := dns.NewRR(fmt.Sprintf("%s 60 IN A 240.0.0.%d", r.Question[0].Name, i))
+						Expect(err).ToNot(HaveOccurred())
+						m.Answer = append(m.Answer, rr)
+					}
+				}
+				_ = w.WriteMsg(m)
+			})
+			upstreams = nil
+			for _, network := range []string{"udp", "tcp"} {
+				started := make(chan struct{})
+				upstream := &dns.Server{Addr: upstreamAddress, Net: network, Handler: handler, NotifyStartedFunc: func() {
+					close(started)
+				}}
+				go func() {
+					_ = upstream.ListenAndServe()
+				}()
+				<-started
+				upstreams = append(upstreams, upstream)
+			}
+
+			// and
+			p, err = test.GetFreePort()
+			Expect(err).ToNot(HaveOccurred())
+			port = uint32(p)
+			metrics, err := core_metrics.NewMetrics("Standalone")
+			Expect(err).ToNot(HaveOccurred())
+			server, err := NewDNSServer(port, NewDNSResolver("mesh"), []string{upstreamAddress}, nil, metrics)
+			Expect(err).ToNot(HaveOccurred())
+			stop = make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				Expect(server.Start(stop)).To(Succeed())
+			}()
+		})
+
+		AfterEach(func() {
+			close(stop)
+			for _, upstream := range upstreams {
+				Expect(upstream.Shutdown()).To(Succeed())
+			}
+		})
+
+		exchange := func(network string) *dns.Msg {
+			client := &dns.Client{Net: network}
+			message := new(dns.Msg)
+			_ = message.SetQuestion("web.upstream.", dns.TypeA)
+			var response *dns.Msg
+			Eventually(func() error {
+				var err error
+				response, _, err = client.Exchange(message, fmt.Sprintf("127.0.0.1:%d", port))
+				return err
+			}).ShouldNot(HaveOccurred())
+			return response
+		}
+
+		It("should retry over TCP and truncate the response to a UDP client", func() {
+			// when
+			response := exchange("udp")
+
+			// then the response of the upstream over TCP does not fit into a UDP message
+			Expect(response.Truncated).To(BeTrue())
+			Expect(len(response.Answer)).To(BeNumerically("<", 50))
+
+			// when
+			response = exchange("tcp")
+
+			// then
+			Expect(response.Truncated).To(BeFalse())
+			Expect(response.Answer).To(HaveLen(50))
+		})
 	})
 })
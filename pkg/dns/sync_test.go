@@ -96,6 +96,13 @@ var _ = Describe("DNS sync", func() {
 			Expect(ip).To(Equal(ip2))
 		})
 
+		It("should sync ports of services to DNS resolver", func() {
+			// then ports of "web" are synchronized to DNS Resolver
+			Eventually(func() []uint32 {
+				return dnsResolverFollower.LookupPorts("web")
+			}, "5s").Should(Equal([]uint32{1234}))
+		})
+
 		It("should sync another service", func() {
 			// when "backend" service is up
 			backendDp := core_mesh.DataplaneResource{
@@ -1,9 +1,11 @@
 package dns
 
 import (
+	"context"
 	"time"
 
 	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
 )
@@ -14,6 +16,7 @@ var (
 
 type (
 	// VIPsSynchronizer takes allocated VIPs by VIPsAllocator and updates DNSResolver.
+	// It also updates ports of services in DNSResolver on every instance of the Control Plane.
 	VIPsSynchronizer interface {
 		Start(<-chan struct{}) error
 		NeedLeaderElection() bool
@@ -67,6 +70,12 @@ func (d *vipsSynchronizer) Start(stop <-chan struct{}) error {
 }
 
 func (d *vipsSynchronizer) synchronize() error {
+	dataplanes := core_mesh.DataplaneResourceList{}
+	if err := d.rm.List(context.Background(), &dataplanes); err != nil {
+		return err
+	}
+	d.resolver.SetServicePorts(servicePortsOf(dataplanes.Items))
+
 	if d.leadInfo.IsLeader() {
 		return nil // when CP is leader we skip this because VIP allocator updates DNSResolver
 	}
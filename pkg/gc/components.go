@@ -13,10 +13,15 @@ var (
 )
 
 func Setup(rt runtime.Runtime) error {
-	// Dataplane GC is run only on Universal because on Kubernetes Dataplanes are bounded by ownership to Pods.
-	// Therefore on K8S offline dataplanes are cleaned up quickly enough to not run this.
-	if rt.Config().Environment != config_core.UniversalEnvironment {
+	switch rt.Config().Environment {
+	case config_core.UniversalEnvironment:
+		// Dataplane GC is run only on Universal because on Kubernetes Dataplanes are bounded by ownership to Pods.
+		// Therefore on K8S offline dataplanes are cleaned up quickly enough to not run this.
+		return rt.Add(NewCollector(rt.ResourceManager(), 1*time.Minute, rt.Config().Runtime.Universal.DataplaneCleanupAge))
+	case config_core.KubernetesEnvironment:
+		// Learned destinations are turned into outbounds of Pods only on Kubernetes.
+		return rt.Add(NewLearnedDestinationsPruner(rt.ResourceManager(), 1*time.Minute, rt.Config().Runtime.Kubernetes.LearnedDestinations.TTL))
+	default:
 		return nil
 	}
-	return rt.Add(NewCollector(rt.ResourceManager(), 1*time.Minute, rt.Config().Runtime.Universal.DataplaneCleanupAge))
 }
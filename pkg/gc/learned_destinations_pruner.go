@@ -0,0 +1,68 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+// learnedDestinationsPruner forgets destinations in DependenciesInsights that were not reported within TTL,
+// so Dataplanes stop getting outbounds for destinations they no longer use.
+type learnedDestinationsPruner struct {
+	rm      manager.ResourceManager
+	ttl     time.Duration
+	polling time.Duration
+}
+
+func NewLearnedDestinationsPruner(rm manager.ResourceManager, polling, ttl time.Duration) component.Component {
+	return &learnedDestinationsPruner{
+		rm:      rm,
+		ttl:     ttl,
+		polling: polling,
+	}
+}
+
+func (p *learnedDestinationsPruner) Start(stop <-chan struct{}) error {
+	ticker := time.NewTicker(p.polling)
+	defer ticker.Stop()
+	gcLog.Info("started learned destinations pruner")
+	for {
+		select {
+		case <-ticker.C:
+			if err := p.prune(); err != nil {
+				gcLog.Error(err, "unable to prune learned destinations")
+				continue
+			}
+		case <-stop:
+			gcLog.Info("stopped learned destinations pruner")
+			return nil
+		}
+	}
+}
+
+func (p *learnedDestinationsPruner) prune() error {
+	ctx := context.Background()
+	insights := &core_mesh.DependenciesInsightResourceList{}
+	if err := p.rm.List(ctx, insights); err != nil {
+		return err
+	}
+	for _, insight := range insights.Items {
+		if !insight.Prune(core.Now(), p.ttl) {
+			continue
+		}
+		gcLog.Info("pruning learned destinations", "name", insight.Meta.GetName(), "mesh", insight.Meta.GetMesh())
+		if err := p.rm.Update(ctx, insight); err != nil {
+			gcLog.Error(err, "unable to prune learned destinations", "name", insight.Meta.GetName(), "mesh", insight.Meta.GetMesh())
+			continue
+		}
+	}
+	return nil
+}
+
+func (p *learnedDestinationsPruner) NeedLeaderElection() bool {
+	return true
+}
@@ -0,0 +1,72 @@
+package gc_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/gc"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Learned Destinations Pruner", func() {
+	var rm manager.ResourceManager
+
+	BeforeEach(func() {
+		rm = manager.NewResourceManager(memory.NewStore())
+		err := rm.Create(context.Background(), &core_mesh.MeshResource{}, store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	It("should forget destinations that were not reported within TTL", func() {
+		// given destinations reported 2 hours and 30 minutes ago
+		now := time.Now()
+		insight := &core_mesh.DependenciesInsightResource{
+			Spec: mesh_proto.DependenciesInsight{
+				LearnedDestinations: []*mesh_proto.DependenciesInsight_LearnedDestination{
+					{Address: "10.0.0.1:80", LastSeen: proto.MustTimestampProto(now.Add(-2 * time.Hour))},
+					{Address: "10.0.0.2:80", LastSeen: proto.MustTimestampProto(now.Add(-30 * time.Minute))},
+				},
+			},
+		}
+		err := rm.Create(context.Background(), insight, store.CreateByKey("learning", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		core.Now = func() time.Time {
+			return now
+		}
+
+		// when pruner is run with TTL of 1 hour
+		pruner := gc.NewLearnedDestinationsPruner(rm, 100*time.Millisecond, 1*time.Hour)
+
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			_ = pruner.Start(stop)
+		}()
+
+		// then only the destination reported within TTL is kept
+		Eventually(func() ([]string, error) {
+			actual := &core_mesh.DependenciesInsightResource{}
+			if err := rm.Get(context.Background(), actual, store.GetByKey("learning", "default")); err != nil {
+				return nil, err
+			}
+			var addresses []string
+			for _, learned := range actual.Spec.LearnedDestinations {
+				addresses = append(addresses, learned.Address)
+			}
+			return addresses, nil
+		}).Should(Equal([]string{"10.0.0.2:80"}))
+	})
+})
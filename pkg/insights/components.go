@@ -0,0 +1,24 @@
+package insights
+
+import (
+	"time"
+
+	config_core "github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/runtime"
+)
+
+var (
+	log = core.Log.WithName("insights")
+)
+
+const resyncInterval = 10 * time.Second
+
+func Setup(rt runtime.Runtime) error {
+	// Global Control Plane does not compute ServiceInsights from Dataplanes but aggregates ServiceInsights synced from zones
+	aggregate := rt.Config().Mode == config_core.Global
+	return rt.Add(
+		NewServiceInsightResyncer(rt.ResourceManager(), resyncInterval, aggregate),
+		NewMeshInsightResyncer(rt.ResourceManager(), resyncInterval),
+	)
+}
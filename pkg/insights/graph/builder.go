@@ -0,0 +1,191 @@
+package graph
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+// Provider gives access to the ServiceGraph of a Mesh.
+type Provider interface {
+	ServiceGraph(ctx context.Context, mesh string) (*types.ServiceGraph, error)
+}
+
+// Builder builds the service graph of a Mesh out of the traffic to upstream clusters that Kuma DP reports
+// to the Control Plane. The traffic is stored in DataplaneInsights, therefore every instance of the Control Plane
+// builds the same graph.
+//
+// Traffic of a Dataplane that was not reported within the expiration time is not included in the graph.
+type Builder struct {
+	rm             manager.ReadOnlyResourceManager
+	expirationTime time.Duration
+}
+
+var _ Provider = &Builder{}
+
+func NewBuilder(rm manager.ReadOnlyResourceManager, expirationTime time.Duration) *Builder {
+	return &Builder{
+		rm:             rm,
+		expirationTime: expirationTime,
+	}
+}
+
+func (b *Builder) ServiceGraph(ctx context.Context, mesh string) (*types.ServiceGraph, error) {
+	dataplanes := &core_mesh.DataplaneResourceList{}
+	if err := b.rm.List(ctx, dataplanes, store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	insights := &core_mesh.DataplaneInsightResourceList{}
+	if err := b.rm.List(ctx, insights, store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	insightsByName := map[string]*mesh_proto.DataplaneInsight{}
+	for _, insight := range insights.Items {
+		insightsByName[insight.GetMeta().GetName()] = &insight.Spec
+	}
+
+	now := core.Now()
+	instances := map[string]int{}
+	var sources []source
+	for _, dataplane := range dataplanes.Items {
+		if dataplane.Spec.IsIngress() {
+			continue
+		}
+		insight := insightsByName[dataplane.GetMeta().GetName()]
+		isOnline := insight.IsOnline()
+		services := map[string]bool{}
+		for _, inbound := range dataplane.Spec.GetNetworking().GetInbound() {
+			services[inbound.GetService()] = true
+		}
+		for service := range services {
+			// a service is part of the graph even if none of its instances is online
+			count := instances[service]
+			if isOnline {
+				count++
+			}
+			instances[service] = count
+		}
+		if !isOnline {
+			continue
+		}
+		if traffic, period := b.recentTraffic(insight, now); traffic != nil {
+			sources = append(sources, source{
+				service: dataplane.Spec.GetIdentifyingService(),
+				traffic: traffic,
+				period:  period,
+			})
+		}
+	}
+
+	edges := map[edgeKey]*edgeAccumulator{}
+	for _, s := range sources {
+		seconds := s.period.Seconds()
+		for cluster, traffic := range s.traffic.GetClusters() {
+			if _, known := instances[cluster]; !known {
+				continue // inbound, admin and other internal clusters
+			}
+			key := edgeKey{source: s.service, destination: cluster}
+			acc, ok := edges[key]
+			if !ok {
+				acc = &edgeAccumulator{}
+				edges[key] = acc
+			}
+			acc.add(traffic, seconds)
+		}
+	}
+	return buildGraph(mesh, now, instances, edges), nil
+}
+
+// recentTraffic returns the traffic of a Dataplane and the period it was observed over
+// if it was reported within the expiration time.
+func (b *Builder) recentTraffic(insight *mesh_proto.DataplaneInsight, now time.Time) (*mesh_proto.DataplaneInsight_Traffic, time.Duration) {
+	traffic := insight.GetTraffic()
+	if traffic == nil {
+		return nil, 0
+	}
+	reportTime, err := ptypes.Timestamp(traffic.GetReportTime())
+	if err != nil || now.Sub(reportTime) > b.expirationTime {
+		return nil, 0
+	}
+	period, err := ptypes.Duration(traffic.GetPeriod())
+	if err != nil || period <= 0 {
+		return nil, 0
+	}
+	return traffic, period
+}
+
+type source struct {
+	service string
+	traffic *mesh_proto.DataplaneInsight_Traffic
+	period  time.Duration
+}
+
+type edgeKey struct {
+	source      string
+	destination string
+}
+
+type edgeAccumulator struct {
+	requestRate    float64
+	errorRate      float64
+	connectionRate float64
+	latencySum     float64
+	latencyCount   float64
+}
+
+func (a *edgeAccumulator) add(traffic *mesh_proto.DataplaneInsight_ClusterTraffic, seconds float64) {
+	a.requestRate += float64(traffic.GetRequests()) / seconds
+	a.errorRate += float64(traffic.GetErrors()) / seconds
+	a.connectionRate += float64(traffic.GetConnections()) / seconds
+	a.latencySum += traffic.GetLatencySum()
+	a.latencyCount += float64(traffic.GetLatencyCount())
+}
+
+func buildGraph(mesh string, generatedAt time.Time, instances map[string]int, edges map[edgeKey]*edgeAccumulator) *types.ServiceGraph {
+	graph := &types.ServiceGraph{
+		Mesh:        mesh,
+		GeneratedAt: generatedAt,
+		Nodes:       []types.ServiceGraphNode{},
+		Edges:       []types.ServiceGraphEdge{},
+	}
+	for service, count := range instances {
+		graph.Nodes = append(graph.Nodes, types.ServiceGraphNode{
+			Service:   service,
+			Instances: count,
+		})
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].Service < graph.Nodes[j].Service
+	})
+	for key, acc := range edges {
+		edge := types.ServiceGraphEdge{
+			Source:         key.source,
+			Destination:    key.destination,
+			RequestRate:    acc.requestRate,
+			ConnectionRate: acc.connectionRate,
+		}
+		if acc.requestRate > 0 {
+			edge.ErrorRate = acc.errorRate / acc.requestRate
+		}
+		if acc.latencyCount > 0 {
+			edge.LatencyMs = acc.latencySum / acc.latencyCount
+		}
+		graph.Edges = append(graph.Edges, edge)
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].Source == graph.Edges[j].Source {
+			return graph.Edges[i].Destination < graph.Edges[j].Destination
+		}
+		return graph.Edges[i].Source < graph.Edges[j].Source
+	})
+	return graph
+}
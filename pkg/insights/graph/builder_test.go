@@ -0,0 +1,168 @@
+package graph_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/insights/graph"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Service Graph Builder", func() {
+
+	var rm manager.ResourceManager
+	var builder *graph.Builder
+	var now time.Time
+
+	createDataplane := func(name, service string, online bool, reportTime time.Time, clusters map[string]*mesh_proto.DataplaneInsight_ClusterTraffic) {
+		dp := &core_mesh.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "127.0.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+						Port: 8080,
+						Tags: map[string]string{
+							"kuma.io/service": service,
+						},
+					}},
+				},
+			},
+		}
+		err := rm.Create(context.Background(), dp, store.CreateByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		subscription := &mesh_proto.DiscoverySubscription{
+			ConnectTime: proto.MustTimestampProto(now.Add(-time.Hour)),
+		}
+		if !online {
+			subscription.DisconnectTime = proto.MustTimestampProto(now.Add(-time.Minute))
+		}
+		insight := &core_mesh.DataplaneInsightResource{
+			Spec: mesh_proto.DataplaneInsight{
+				Subscriptions: []*mesh_proto.DiscoverySubscription{subscription},
+			},
+		}
+		if clusters != nil {
+			Expect(insight.Spec.ReportTraffic(reportTime, 30*time.Second, clusters)).To(Succeed())
+		}
+		err = rm.Create(context.Background(), insight, store.CreateByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+		core.Now = func() time.Time {
+			return now
+		}
+		rm = manager.NewResourceManager(memory.NewStore())
+		err := rm.Create(context.Background(), &core_mesh.MeshResource{}, store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		builder = graph.NewBuilder(rm, 90*time.Second)
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	It("should build a graph out of the reported traffic", func() {
+		// given
+		createDataplane("web-01", "web", true, now.Add(-10*time.Second), map[string]*mesh_proto.DataplaneInsight_ClusterTraffic{
+			"backend": {
+				Requests:     300,
+				Errors:       75,
+				Connections:  3,
+				LatencySum:   3000,
+				LatencyCount: 300,
+			},
+			"localhost_8080": {
+				Requests: 100,
+			},
+		})
+		createDataplane("backend-01", "backend", true, time.Time{}, nil)
+		createDataplane("backend-02", "backend", false, now.Add(-10*time.Second), map[string]*mesh_proto.DataplaneInsight_ClusterTraffic{
+			"web": {
+				Requests: 300,
+			},
+		})
+
+		// when
+		serviceGraph, err := builder.ServiceGraph(context.Background(), "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(serviceGraph).To(Equal(&types.ServiceGraph{
+			Mesh:        "default",
+			GeneratedAt: now,
+			Nodes: []types.ServiceGraphNode{
+				{Service: "backend", Instances: 1},
+				{Service: "web", Instances: 1},
+			},
+			Edges: []types.ServiceGraphEdge{{
+				Source:         "web",
+				Destination:    "backend",
+				RequestRate:    10,
+				ErrorRate:      0.25,
+				LatencyMs:      10,
+				ConnectionRate: 0.1,
+			}},
+		}))
+	})
+
+	It("should sum the traffic of all instances of a service", func() {
+		// given
+		for _, name := range []string{"web-01", "web-02"} {
+			createDataplane(name, "web", true, now.Add(-10*time.Second), map[string]*mesh_proto.DataplaneInsight_ClusterTraffic{
+				"backend": {
+					Requests: 150,
+				},
+			})
+		}
+		createDataplane("backend-01", "backend", true, time.Time{}, nil)
+
+		// when
+		serviceGraph, err := builder.ServiceGraph(context.Background(), "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(serviceGraph.Edges).To(HaveLen(1))
+		Expect(serviceGraph.Edges[0].RequestRate).To(Equal(10.0))
+	})
+
+	It("should not include traffic that was not reported within the expiration time", func() {
+		// given
+		createDataplane("web-01", "web", true, now.Add(-2*time.Minute), map[string]*mesh_proto.DataplaneInsight_ClusterTraffic{
+			"backend": {
+				Requests: 300,
+			},
+		})
+		createDataplane("backend-01", "backend", true, time.Time{}, nil)
+
+		// when
+		serviceGraph, err := builder.ServiceGraph(context.Background(), "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(serviceGraph.Nodes).To(HaveLen(2))
+		Expect(serviceGraph.Edges).To(BeEmpty())
+	})
+
+	It("should return an empty graph of a mesh without dataplanes", func() {
+		// when
+		serviceGraph, err := builder.ServiceGraph(context.Background(), "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(serviceGraph.Nodes).To(BeEmpty())
+		Expect(serviceGraph.Edges).To(BeEmpty())
+	})
+})
@@ -0,0 +1,221 @@
+package insights
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// policyTypes are types of policies counted in a MeshInsight.
+var policyTypes = []model.ResourceType{
+	core_mesh.CircuitBreakerType,
+	core_mesh.FaultInjectionType,
+	core_mesh.GatewayListenerType,
+	core_mesh.GatewayRouteType,
+	core_mesh.HealthCheckType,
+	core_mesh.ProxyTemplateType,
+	core_mesh.TrafficLogType,
+	core_mesh.TrafficPermissionType,
+	core_mesh.TrafficRouteType,
+	core_mesh.TrafficTraceType,
+}
+
+// certificateExpirations are upper bounds of time left until a certificate expires.
+var certificateExpirations = []struct {
+	name       string
+	upperBound time.Duration
+}{
+	{name: "1h", upperBound: time.Hour},
+	{name: "24h", upperBound: 24 * time.Hour},
+	{name: "168h", upperBound: 7 * 24 * time.Hour},
+	{name: "720h", upperBound: 30 * 24 * time.Hour},
+}
+
+const certificateExpirationInf = "+Inf"
+
+type meshInsightResyncer struct {
+	rm       manager.ResourceManager
+	interval time.Duration
+}
+
+// NewMeshInsightResyncer returns a component that periodically computes a MeshInsight of every Mesh.
+func NewMeshInsightResyncer(rm manager.ResourceManager, interval time.Duration) component.Component {
+	return &meshInsightResyncer{
+		rm:       rm,
+		interval: interval,
+	}
+}
+
+func (r *meshInsightResyncer) Start(stop <-chan struct{}) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	log.Info("started")
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.resync(); err != nil {
+				log.Error(err, "unable to resync mesh insights")
+				continue
+			}
+		case <-stop:
+			log.Info("stopped")
+			return nil
+		}
+	}
+}
+
+func (r *meshInsightResyncer) NeedLeaderElection() bool {
+	return true
+}
+
+func (r *meshInsightResyncer) resync() error {
+	ctx := context.Background()
+	meshes := &core_mesh.MeshResourceList{}
+	if err := r.rm.List(ctx, meshes); err != nil {
+		return err
+	}
+	existing := map[string]bool{}
+	for _, mesh := range meshes.Items {
+		meshName := mesh.GetMeta().GetName()
+		existing[meshName] = true
+		insight, err := r.computeMeshInsight(ctx, meshName)
+		if err != nil {
+			log.Error(err, "unable to compute mesh insight", "mesh", meshName)
+			continue
+		}
+		if err := r.upsert(ctx, meshName, insight); err != nil {
+			log.Error(err, "unable to save mesh insight", "mesh", meshName)
+			continue
+		}
+	}
+	return r.cleanup(ctx, existing)
+}
+
+func (r *meshInsightResyncer) computeMeshInsight(ctx context.Context, mesh string) (*mesh_proto.MeshInsight, error) {
+	dataplanes := &core_mesh.DataplaneResourceList{}
+	if err := r.rm.List(ctx, dataplanes, store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	dataplaneInsights := &core_mesh.DataplaneInsightResourceList{}
+	if err := r.rm.List(ctx, dataplaneInsights, store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	insightsByName := map[string]*mesh_proto.DataplaneInsight{}
+	for _, dataplaneInsight := range dataplaneInsights.Items {
+		insightsByName[dataplaneInsight.GetMeta().GetName()] = &dataplaneInsight.Spec
+	}
+
+	insight := &mesh_proto.MeshInsight{
+		Dataplanes: &mesh_proto.MeshInsight_DataplaneStat{},
+		Policies:   map[string]*mesh_proto.MeshInsight_PolicyStat{},
+		MTLS:       &mesh_proto.MeshInsight_MTLS{},
+		DpVersions: &mesh_proto.MeshInsight_DpVersions{},
+	}
+	now := core.Now()
+	for _, dataplane := range dataplanes.Items {
+		dataplaneInsight := insightsByName[dataplane.GetMeta().GetName()]
+		insight.Dataplanes.Total++
+		if dataplaneInsight.IsOnline() {
+			insight.Dataplanes.Online++
+		} else {
+			insight.Dataplanes.Offline++
+		}
+		addCertificate(insight.MTLS, dataplaneInsight.GetMTLS(), now)
+		addVersion(insight.DpVersions, dataplaneInsight)
+	}
+
+	for _, policyType := range policyTypes {
+		policies, err := registry.Global().NewList(policyType)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.rm.List(ctx, policies, store.ListByMesh(mesh)); err != nil {
+			return nil, err
+		}
+		insight.Policies[string(policyType)] = &mesh_proto.MeshInsight_PolicyStat{
+			Total: uint32(len(policies.GetItems())),
+		}
+	}
+	return insight, nil
+}
+
+func (r *meshInsightResyncer) upsert(ctx context.Context, mesh string, insight *mesh_proto.MeshInsight) error {
+	key := store.GetByKey(mesh, mesh)
+	meshInsight := &core_mesh.MeshInsightResource{}
+	if err := r.rm.Get(ctx, meshInsight, key); err != nil {
+		if !store.IsResourceNotFound(err) {
+			return err
+		}
+		meshInsight.Spec = *insight
+		meshInsight.Spec.LastSync = util_proto.MustTimestampProto(core.Now())
+		return r.rm.Create(ctx, meshInsight, store.CreateByKey(mesh, mesh))
+	}
+	current := proto.Clone(&meshInsight.Spec).(*mesh_proto.MeshInsight)
+	current.LastSync = nil
+	if proto.Equal(current, insight) {
+		return nil
+	}
+	meshInsight.Spec = *insight
+	meshInsight.Spec.LastSync = util_proto.MustTimestampProto(core.Now())
+	return r.rm.Update(ctx, meshInsight)
+}
+
+// cleanup removes MeshInsights of Meshes that no longer exist.
+func (r *meshInsightResyncer) cleanup(ctx context.Context, meshes map[string]bool) error {
+	meshInsights := &core_mesh.MeshInsightResourceList{}
+	if err := r.rm.List(ctx, meshInsights); err != nil {
+		return err
+	}
+	for _, meshInsight := range meshInsights.Items {
+		if meshes[meshInsight.GetMeta().GetName()] {
+			continue
+		}
+		if err := r.rm.Delete(ctx, meshInsight, store.DeleteBy(model.MetaToResourceKey(meshInsight.GetMeta()))); err != nil && !store.IsResourceNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func addCertificate(mtls *mesh_proto.MeshInsight_MTLS, certificate *mesh_proto.DataplaneInsight_MTLS, now time.Time) {
+	expiration := util_proto.MustTimestampFromProto(certificate.GetCertificateExpirationTime())
+	if expiration == nil {
+		mtls.NoCertificates++
+		return
+	}
+	left := expiration.Sub(now)
+	if left <= 0 {
+		mtls.ExpiredCertificates++
+		return
+	}
+	mtls.ValidCertificates++
+	bucket := certificateExpirationInf
+	for _, candidate := range certificateExpirations {
+		if left <= candidate.upperBound {
+			bucket = candidate.name
+			break
+		}
+	}
+	mtls.CertificateExpirations = increment(mtls.CertificateExpirations, bucket, 1)
+}
+
+func addVersion(versions *mesh_proto.MeshInsight_DpVersions, dataplaneInsight *mesh_proto.DataplaneInsight) {
+	subscription, _ := dataplaneInsight.GetLatestSubscription()
+	if kumaDp := subscription.GetVersion().GetKumaDp().GetVersion(); kumaDp != "" {
+		versions.KumaDp = increment(versions.KumaDp, kumaDp, 1)
+	}
+	if envoy := subscription.GetVersion().GetEnvoy().GetVersion(); envoy != "" {
+		versions.Envoy = increment(versions.Envoy, envoy, 1)
+	}
+}
@@ -0,0 +1,183 @@
+package insights_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/insights"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("MeshInsight Resyncer", func() {
+
+	var rm manager.ResourceManager
+	var stop chan struct{}
+
+	createDataplane := func(name string, online bool, certificateExpiration *time.Time, kumaDpVersion, envoyVersion string) {
+		dp := &core_mesh.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port: 8080,
+							Tags: map[string]string{
+								mesh_proto.ServiceTag: "backend",
+							},
+						},
+					},
+				},
+			},
+		}
+		err := rm.Create(context.Background(), dp, store.CreateByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		subscription := &mesh_proto.DiscoverySubscription{
+			ConnectTime: proto.MustTimestampProto(core.Now()),
+			Version: &mesh_proto.Version{
+				KumaDp: &mesh_proto.KumaDpVersion{
+					Version: kumaDpVersion,
+				},
+				Envoy: &mesh_proto.EnvoyVersion{
+					Version: envoyVersion,
+				},
+			},
+		}
+		if !online {
+			subscription.DisconnectTime = proto.MustTimestampProto(core.Now())
+		}
+		insight := &core_mesh.DataplaneInsightResource{
+			Spec: mesh_proto.DataplaneInsight{
+				Subscriptions: []*mesh_proto.DiscoverySubscription{subscription},
+			},
+		}
+		if certificateExpiration != nil {
+			insight.Spec.MTLS = &mesh_proto.DataplaneInsight_MTLS{
+				CertificateExpirationTime: proto.MustTimestampProto(*certificateExpiration),
+			}
+		}
+		err = rm.Create(context.Background(), insight, store.CreateByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	meshInsight := func(mesh string) func() *mesh_proto.MeshInsight {
+		return func() *mesh_proto.MeshInsight {
+			insight := &core_mesh.MeshInsightResource{}
+			err := rm.Get(context.Background(), insight, store.GetByKey(mesh, mesh))
+			if err != nil {
+				return nil
+			}
+			// LastSync depends on the time of the resync
+			insight.Spec.LastSync = nil
+			return &insight.Spec
+		}
+	}
+
+	after := func(d time.Duration) *time.Time {
+		t := core.Now().Add(d)
+		return &t
+	}
+
+	BeforeEach(func() {
+		rm = manager.NewResourceManager(memory.NewStore())
+		err := rm.Create(context.Background(), &core_mesh.MeshResource{}, store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		stop = make(chan struct{})
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	It("should compute insights about a Mesh", func() {
+		// given
+		createDataplane("backend-01", true, after(30*time.Minute), "0.8.0", "1.15.0")
+		createDataplane("backend-02", true, after(48*time.Hour), "0.8.0", "1.15.0")
+		createDataplane("backend-03", false, after(-time.Hour), "0.7.1", "1.14.1")
+		createDataplane("backend-04", false, nil, "", "")
+		// and
+		err := rm.Create(context.Background(), &core_mesh.TrafficPermissionResource{
+			Spec: mesh_proto.TrafficPermission{
+				Sources: []*mesh_proto.Selector{{
+					Match: map[string]string{mesh_proto.ServiceTag: "*"},
+				}},
+				Destinations: []*mesh_proto.Selector{{
+					Match: map[string]string{mesh_proto.ServiceTag: "*"},
+				}},
+			},
+		}, store.CreateByKey("allow-all", "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resyncer := insights.NewMeshInsightResyncer(rm, 50*time.Millisecond)
+		go func() {
+			defer GinkgoRecover()
+			Expect(resyncer.Start(stop)).To(Succeed())
+		}()
+
+		// then
+		Eventually(meshInsight("default"), "5s", "50ms").Should(Equal(&mesh_proto.MeshInsight{
+			Dataplanes: &mesh_proto.MeshInsight_DataplaneStat{
+				Total:   4,
+				Online:  2,
+				Offline: 2,
+			},
+			Policies: map[string]*mesh_proto.MeshInsight_PolicyStat{
+				"CircuitBreaker":    {},
+				"FaultInjection":    {},
+				"GatewayListener":   {},
+				"GatewayRoute":      {},
+				"HealthCheck":       {},
+				"ProxyTemplate":     {},
+				"TrafficLog":        {},
+				"TrafficPermission": {Total: 1},
+				"TrafficRoute":      {},
+				"TrafficTrace":      {},
+			},
+			MTLS: &mesh_proto.MeshInsight_MTLS{
+				ValidCertificates:   2,
+				ExpiredCertificates: 1,
+				NoCertificates:      1,
+				CertificateExpirations: map[string]uint32{
+					"1h":   1,
+					"168h": 1,
+				},
+			},
+			DpVersions: &mesh_proto.MeshInsight_DpVersions{
+				KumaDp: map[string]uint32{"0.8.0": 2, "0.7.1": 1},
+				Envoy:  map[string]uint32{"1.15.0": 2, "1.14.1": 1},
+			},
+		}))
+	})
+
+	It("should remove insights about deleted Meshes", func() {
+		// given
+		err := rm.Create(context.Background(), &core_mesh.MeshResource{}, store.CreateByKey("deleted", "deleted"))
+		Expect(err).ToNot(HaveOccurred())
+		err = rm.Create(context.Background(), &core_mesh.MeshInsightResource{}, store.CreateByKey("deleted", "deleted"))
+		Expect(err).ToNot(HaveOccurred())
+		err = rm.Delete(context.Background(), &core_mesh.MeshResource{}, store.DeleteByKey("deleted", "deleted"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resyncer := insights.NewMeshInsightResyncer(rm, 50*time.Millisecond)
+		go func() {
+			defer GinkgoRecover()
+			Expect(resyncer.Start(stop)).To(Succeed())
+		}()
+
+		// then
+		Eventually(meshInsight("deleted"), "5s", "50ms").Should(BeNil())
+		// and
+		Eventually(meshInsight("default"), "5s", "50ms").ShouldNot(BeNil())
+	})
+})
@@ -0,0 +1,220 @@
+package insights
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const versionTag = "version"
+
+type serviceInsightResyncer struct {
+	rm       manager.ResourceManager
+	interval time.Duration
+	// aggregate defines whether ServiceInsights are aggregated from ServiceInsights synced from zones
+	// instead of being computed from Dataplanes.
+	aggregate bool
+}
+
+// NewServiceInsightResyncer returns a component that periodically computes a ServiceInsight of every Mesh.
+// On Global Control Plane ServiceInsights synced from zones are aggregated into a single ServiceInsight of a Mesh.
+func NewServiceInsightResyncer(rm manager.ResourceManager, interval time.Duration, aggregate bool) component.Component {
+	return &serviceInsightResyncer{
+		rm:        rm,
+		interval:  interval,
+		aggregate: aggregate,
+	}
+}
+
+func (r *serviceInsightResyncer) Start(stop <-chan struct{}) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	log.Info("started")
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.resync(); err != nil {
+				log.Error(err, "unable to resync service insights")
+				continue
+			}
+		case <-stop:
+			log.Info("stopped")
+			return nil
+		}
+	}
+}
+
+func (r *serviceInsightResyncer) NeedLeaderElection() bool {
+	return true
+}
+
+func (r *serviceInsightResyncer) resync() error {
+	ctx := context.Background()
+	meshes := &core_mesh.MeshResourceList{}
+	if err := r.rm.List(ctx, meshes); err != nil {
+		return err
+	}
+	for _, mesh := range meshes.Items {
+		meshName := mesh.GetMeta().GetName()
+		var insight *mesh_proto.ServiceInsight
+		var err error
+		if r.aggregate {
+			insight, err = r.aggregateServiceInsight(ctx, meshName)
+		} else {
+			insight, err = r.computeServiceInsight(ctx, meshName)
+		}
+		if err != nil {
+			log.Error(err, "unable to compute service insight", "mesh", meshName)
+			continue
+		}
+		if err := r.upsert(ctx, meshName, insight); err != nil {
+			log.Error(err, "unable to save service insight", "mesh", meshName)
+			continue
+		}
+	}
+	return nil
+}
+
+func (r *serviceInsightResyncer) computeServiceInsight(ctx context.Context, mesh string) (*mesh_proto.ServiceInsight, error) {
+	dataplanes := &core_mesh.DataplaneResourceList{}
+	if err := r.rm.List(ctx, dataplanes, store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	dataplaneInsights := &core_mesh.DataplaneInsightResourceList{}
+	if err := r.rm.List(ctx, dataplaneInsights, store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	online := map[string]bool{}
+	for _, dataplaneInsight := range dataplaneInsights.Items {
+		online[dataplaneInsight.GetMeta().GetName()] = dataplaneInsight.Spec.IsOnline()
+	}
+
+	insight := &mesh_proto.ServiceInsight{
+		Services: map[string]*mesh_proto.ServiceInsight_Service{},
+	}
+	for _, dataplane := range dataplanes.Items {
+		if dataplane.Spec.IsIngress() {
+			continue
+		}
+		for service, tags := range serviceTags(&dataplane.Spec) {
+			addDataplane(serviceOf(insight, service), tags, online[dataplane.GetMeta().GetName()])
+		}
+	}
+	return insight, nil
+}
+
+func (r *serviceInsightResyncer) aggregateServiceInsight(ctx context.Context, mesh string) (*mesh_proto.ServiceInsight, error) {
+	serviceInsights := &core_mesh.ServiceInsightResourceList{}
+	if err := r.rm.List(ctx, serviceInsights, store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	insight := &mesh_proto.ServiceInsight{
+		Services: map[string]*mesh_proto.ServiceInsight_Service{},
+	}
+	for _, zoneInsight := range serviceInsights.Items {
+		// every other ServiceInsight of a Mesh is synced from a zone
+		if zoneInsight.GetMeta().GetName() == core_mesh.ServiceInsightName(mesh) {
+			continue
+		}
+		for service, zoneService := range zoneInsight.Spec.GetServices() {
+			mergeService(serviceOf(insight, service), zoneService)
+		}
+	}
+	return insight, nil
+}
+
+func (r *serviceInsightResyncer) upsert(ctx context.Context, mesh string, insight *mesh_proto.ServiceInsight) error {
+	key := store.GetByKey(core_mesh.ServiceInsightName(mesh), mesh)
+	serviceInsight := &core_mesh.ServiceInsightResource{}
+	if err := r.rm.Get(ctx, serviceInsight, key); err != nil {
+		if !store.IsResourceNotFound(err) {
+			return err
+		}
+		serviceInsight.Spec = *insight
+		serviceInsight.Spec.LastSync = util_proto.MustTimestampProto(core.Now())
+		return r.rm.Create(ctx, serviceInsight, store.CreateByKey(core_mesh.ServiceInsightName(mesh), mesh))
+	}
+	if proto.Equal(&mesh_proto.ServiceInsight{Services: serviceInsight.Spec.Services}, insight) {
+		return nil
+	}
+	serviceInsight.Spec = *insight
+	serviceInsight.Spec.LastSync = util_proto.MustTimestampProto(core.Now())
+	return r.rm.Update(ctx, serviceInsight)
+}
+
+// serviceTags returns tags of the first inbound of every service of a given Dataplane.
+func serviceTags(dataplane *mesh_proto.Dataplane) map[string]map[string]string {
+	services := map[string]map[string]string{}
+	if gateway := dataplane.GetNetworking().GetGateway(); gateway != nil {
+		services[gateway.GetTags()[mesh_proto.ServiceTag]] = gateway.GetTags()
+	}
+	for _, inbound := range dataplane.GetNetworking().GetInbound() {
+		service := inbound.GetService()
+		if _, ok := services[service]; !ok {
+			services[service] = inbound.GetTags()
+		}
+	}
+	return services
+}
+
+func serviceOf(insight *mesh_proto.ServiceInsight, service string) *mesh_proto.ServiceInsight_Service {
+	if _, ok := insight.Services[service]; !ok {
+		insight.Services[service] = &mesh_proto.ServiceInsight_Service{
+			Dataplanes: &mesh_proto.ServiceInsight_DataplaneStat{},
+		}
+	}
+	return insight.Services[service]
+}
+
+func addDataplane(service *mesh_proto.ServiceInsight_Service, tags map[string]string, online bool) {
+	service.Dataplanes.Total++
+	if online {
+		service.Dataplanes.Online++
+	} else {
+		service.Dataplanes.Offline++
+	}
+	if zone := tags[mesh_proto.ZoneTag]; zone != "" {
+		service.Zones = increment(service.Zones, zone, 1)
+	}
+	protocol := core_mesh.ParseProtocol(tags[mesh_proto.ProtocolTag])
+	if protocol == core_mesh.ProtocolUnknown {
+		// Dataplanes without a valid protocol are proxied as TCP
+		protocol = core_mesh.ProtocolTCP
+	}
+	service.Protocols = increment(service.Protocols, string(protocol), 1)
+	if version := tags[versionTag]; version != "" {
+		service.Versions = increment(service.Versions, version, 1)
+	}
+}
+
+func mergeService(service *mesh_proto.ServiceInsight_Service, other *mesh_proto.ServiceInsight_Service) {
+	service.Dataplanes.Total += other.GetDataplanes().GetTotal()
+	service.Dataplanes.Online += other.GetDataplanes().GetOnline()
+	service.Dataplanes.Offline += other.GetDataplanes().GetOffline()
+	for zone, count := range other.GetZones() {
+		service.Zones = increment(service.Zones, zone, count)
+	}
+	for protocol, count := range other.GetProtocols() {
+		service.Protocols = increment(service.Protocols, protocol, count)
+	}
+	for version, count := range other.GetVersions() {
+		service.Versions = increment(service.Versions, version, count)
+	}
+}
+
+func increment(counts map[string]uint32, key string, value uint32) map[string]uint32 {
+	if counts == nil {
+		counts = map[string]uint32{}
+	}
+	counts[key] += value
+	return counts
+}
@@ -0,0 +1,179 @@
+package insights_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/insights"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("ServiceInsight Resyncer", func() {
+
+	var rm manager.ResourceManager
+	var stop chan struct{}
+
+	createDataplane := func(name string, online bool, tags ...map[string]string) {
+		dp := &core_mesh.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+				},
+			},
+		}
+		for i, t := range tags {
+			dp.Spec.Networking.Inbound = append(dp.Spec.Networking.Inbound, &mesh_proto.Dataplane_Networking_Inbound{
+				Port: uint32(8080 + i),
+				Tags: t,
+			})
+		}
+		err := rm.Create(context.Background(), dp, store.CreateByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		subscription := &mesh_proto.DiscoverySubscription{
+			ConnectTime: proto.MustTimestampProto(core.Now()),
+		}
+		if !online {
+			subscription.DisconnectTime = proto.MustTimestampProto(core.Now())
+		}
+		insight := &core_mesh.DataplaneInsightResource{
+			Spec: mesh_proto.DataplaneInsight{
+				Subscriptions: []*mesh_proto.DiscoverySubscription{subscription},
+			},
+		}
+		err = rm.Create(context.Background(), insight, store.CreateByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	serviceInsight := func() func() *mesh_proto.ServiceInsight {
+		return func() *mesh_proto.ServiceInsight {
+			insight := &core_mesh.ServiceInsightResource{}
+			err := rm.Get(context.Background(), insight, store.GetByKey(core_mesh.ServiceInsightName("default"), "default"))
+			if err != nil {
+				return nil
+			}
+			// LastSync depends on the time of the resync
+			insight.Spec.LastSync = nil
+			return &insight.Spec
+		}
+	}
+
+	BeforeEach(func() {
+		rm = manager.NewResourceManager(memory.NewStore())
+		err := rm.Create(context.Background(), &core_mesh.MeshResource{}, store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		stop = make(chan struct{})
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	It("should compute insights about services from Dataplanes", func() {
+		// given
+		createDataplane("backend-01", true, map[string]string{
+			mesh_proto.ServiceTag:  "backend",
+			mesh_proto.ProtocolTag: "http",
+			mesh_proto.ZoneTag:     "zone-1",
+			"version":              "v1",
+		})
+		createDataplane("backend-02", false, map[string]string{
+			mesh_proto.ServiceTag:  "backend",
+			mesh_proto.ProtocolTag: "http",
+			mesh_proto.ZoneTag:     "zone-1",
+			"version":              "v2",
+		}, map[string]string{
+			// second inbound of the same service is not counted twice
+			mesh_proto.ServiceTag:  "backend",
+			mesh_proto.ProtocolTag: "http",
+			mesh_proto.ZoneTag:     "zone-1",
+			"version":              "v2",
+		})
+		createDataplane("redis-01", true, map[string]string{
+			mesh_proto.ServiceTag: "redis",
+		})
+
+		// when
+		resyncer := insights.NewServiceInsightResyncer(rm, 50*time.Millisecond, false)
+		go func() {
+			defer GinkgoRecover()
+			Expect(resyncer.Start(stop)).To(Succeed())
+		}()
+
+		// then
+		Eventually(serviceInsight(), "5s", "50ms").Should(Equal(&mesh_proto.ServiceInsight{
+			Services: map[string]*mesh_proto.ServiceInsight_Service{
+				"backend": {
+					Dataplanes: &mesh_proto.ServiceInsight_DataplaneStat{
+						Total:   2,
+						Online:  1,
+						Offline: 1,
+					},
+					Zones:     map[string]uint32{"zone-1": 2},
+					Protocols: map[string]uint32{"http": 2},
+					Versions:  map[string]uint32{"v1": 1, "v2": 1},
+				},
+				"redis": {
+					Dataplanes: &mesh_proto.ServiceInsight_DataplaneStat{
+						Total:  1,
+						Online: 1,
+					},
+					Protocols: map[string]uint32{"tcp": 1},
+				},
+			},
+		}))
+	})
+
+	It("should aggregate insights synced from zones", func() {
+		// given
+		for _, zone := range []string{"zone-1", "zone-2"} {
+			zoneInsight := &core_mesh.ServiceInsightResource{
+				Spec: mesh_proto.ServiceInsight{
+					Services: map[string]*mesh_proto.ServiceInsight_Service{
+						"backend": {
+							Dataplanes: &mesh_proto.ServiceInsight_DataplaneStat{
+								Total:  1,
+								Online: 1,
+							},
+							Zones:     map[string]uint32{zone: 1},
+							Protocols: map[string]uint32{"http": 1},
+						},
+					},
+				},
+			}
+			name := zone + "." + core_mesh.ServiceInsightName("default")
+			err := rm.Create(context.Background(), zoneInsight, store.CreateByKey(name, "default"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// when
+		resyncer := insights.NewServiceInsightResyncer(rm, 50*time.Millisecond, true)
+		go func() {
+			defer GinkgoRecover()
+			Expect(resyncer.Start(stop)).To(Succeed())
+		}()
+
+		// then
+		Eventually(serviceInsight(), "5s", "50ms").Should(Equal(&mesh_proto.ServiceInsight{
+			Services: map[string]*mesh_proto.ServiceInsight_Service{
+				"backend": {
+					Dataplanes: &mesh_proto.ServiceInsight_DataplaneStat{
+						Total:  2,
+						Online: 2,
+					},
+					Zones:     map[string]uint32{"zone-1": 1, "zone-2": 1},
+					Protocols: map[string]uint32{"http": 2},
+				},
+			},
+		}))
+	})
+})
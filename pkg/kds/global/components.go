@@ -17,6 +17,7 @@ import (
 	kds_server "github.com/kumahq/kuma/pkg/kds/server"
 
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
@@ -40,12 +41,15 @@ var (
 		mesh.TrafficRouteType,
 		mesh.TrafficTraceType,
 		mesh.ProxyTemplateType,
+		mesh.GatewayListenerType,
+		mesh.GatewayRouteType,
 		system.SecretType,
 		system.ConfigType,
 	}
 	consumedTypes = []model.ResourceType{
 		mesh.DataplaneType,
 		mesh.DataplaneInsightType,
+		mesh.ServiceInsightType,
 	}
 )
 
@@ -56,7 +60,11 @@ func Setup(rt runtime.Runtime) (err error) {
 	if err != nil {
 		return err
 	}
-	resourceSyncer := sync_store.NewResourceSyncer(kdsGlobalLog, rt.ResourceStore())
+	resourceStore := rt.ResourceStore()
+	if recorder, ok := audit.FromRecorderContext(rt.Extensions()); ok {
+		resourceStore = audit.NewAuditingStore(resourceStore, recorder, audit.OriginKDS)
+	}
+	resourceSyncer := sync_store.NewResourceSyncer(kdsGlobalLog, resourceStore)
 	onSessionStarted := mux.OnSessionStartedFunc(func(session mux.Session) error {
 		log := kdsGlobalLog.WithValues("peer-id", session.PeerID())
 		log.Info("new session created")
@@ -10,6 +10,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
@@ -24,6 +25,7 @@ var (
 	providedTypes = []model.ResourceType{
 		mesh.DataplaneType,
 		mesh.DataplaneInsightType,
+		mesh.ServiceInsightType,
 	}
 	consumedTypes = []model.ResourceType{
 		mesh.MeshType,
@@ -36,6 +38,8 @@ var (
 		mesh.TrafficRouteType,
 		mesh.TrafficTraceType,
 		mesh.ProxyTemplateType,
+		mesh.GatewayListenerType,
+		mesh.GatewayRouteType,
 		system.SecretType,
 		system.ConfigType,
 	}
@@ -49,7 +53,11 @@ func Setup(rt core_runtime.Runtime) error {
 	if err != nil {
 		return err
 	}
-	resourceSyncer := sync_store.NewResourceSyncer(kdsRemoteLog, rt.ResourceStore())
+	resourceStore := rt.ResourceStore()
+	if recorder, ok := audit.FromRecorderContext(rt.Extensions()); ok {
+		resourceStore = audit.NewAuditingStore(resourceStore, recorder, audit.OriginKDS)
+	}
+	resourceSyncer := sync_store.NewResourceSyncer(kdsRemoteLog, resourceStore)
 	onSessionStarted := mux.OnSessionStartedFunc(func(session mux.Session) error {
 		log := kdsRemoteLog.WithValues("peer-id", session.PeerID())
 		log.Info("new session created")
@@ -78,7 +86,7 @@ func providedFilter(clusterName string) reconcile.ResourceFilter {
 		if r.GetType() == mesh.DataplaneType {
 			return clusterName == util.ZoneTag(r)
 		}
-		return r.GetType() == mesh.DataplaneInsightType
+		return r.GetType() == mesh.DataplaneInsightType || r.GetType() == mesh.ServiceInsightType
 	}
 }
 
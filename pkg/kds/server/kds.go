@@ -60,6 +60,7 @@ type watches struct {
 	meshes             chan envoy_cache.Response
 	ingresses          chan envoy_cache.Response
 	dataplaneInsights  chan envoy_cache.Response
+	serviceInsights    chan envoy_cache.Response
 	circuitBreakers    chan envoy_cache.Response
 	faultInjections    chan envoy_cache.Response
 	healthChecks       chan envoy_cache.Response
@@ -68,12 +69,15 @@ type watches struct {
 	trafficRoutes      chan envoy_cache.Response
 	trafficTraces      chan envoy_cache.Response
 	proxyTemplates     chan envoy_cache.Response
+	gatewayListeners   chan envoy_cache.Response
+	gatewayRoutes      chan envoy_cache.Response
 	secrets            chan envoy_cache.Response
 	config             chan envoy_cache.Response
 
 	meshesCancel             func()
 	ingressesCancel          func()
 	dataplaneInsightsCancel  func()
+	serviceInsightsCancel    func()
 	circuitBreakersCancel    func()
 	faultInjectionsCancel    func()
 	healthChecksCancel       func()
@@ -82,12 +86,15 @@ type watches struct {
 	trafficRoutesCancel      func()
 	trafficTracesCancel      func()
 	proxyTemplatesCancel     func()
+	gatewayListenersCancel   func()
+	gatewayRoutesCancel      func()
 	secretsCancel            func()
 	configCancel             func()
 
 	meshesNonce             string
 	ingressesNonce          string
 	dataplaneInsightsNonce  string
+	serviceInsightsNonce    string
 	circuitBreakersNonce    string
 	faultInjectionsNonce    string
 	healthChecksNonce       string
@@ -96,6 +103,8 @@ type watches struct {
 	trafficRoutesNonce      string
 	trafficTracesNonce      string
 	proxyTemplatesNonce     string
+	gatewayListenersNonce   string
+	gatewayRoutesNonce      string
 	secretsNonce            string
 	configNonce             string
 }
@@ -111,6 +120,9 @@ func (values watches) Cancel() {
 	if values.dataplaneInsightsCancel != nil {
 		values.dataplaneInsightsCancel()
 	}
+	if values.serviceInsightsCancel != nil {
+		values.serviceInsightsCancel()
+	}
 	if values.circuitBreakersCancel != nil {
 		values.circuitBreakersCancel()
 	}
@@ -135,6 +147,12 @@ func (values watches) Cancel() {
 	if values.proxyTemplatesCancel != nil {
 		values.proxyTemplatesCancel()
 	}
+	if values.gatewayListenersCancel != nil {
+		values.gatewayListenersCancel()
+	}
+	if values.gatewayRoutesCancel != nil {
+		values.gatewayRoutesCancel()
+	}
 	if values.secretsCancel != nil {
 		values.secretsCancel()
 	}
@@ -256,6 +274,16 @@ func (s *server) process(stream stream, reqCh <-chan *envoy.DiscoveryRequest) (e
 			}
 			values.dataplaneInsightsNonce = nonce
 
+		case resp, more := <-values.serviceInsights:
+			if !more {
+				return status.Errorf(codes.Unavailable, "service insights watch failed")
+			}
+			nonce, err := send(resp, mesh_core.ServiceInsightType)
+			if err != nil {
+				return err
+			}
+			values.serviceInsightsNonce = nonce
+
 		case resp, more := <-values.circuitBreakers:
 			if !more {
 				return status.Errorf(codes.Unavailable, "circuitBreakers watch failed")
@@ -336,6 +364,26 @@ func (s *server) process(stream stream, reqCh <-chan *envoy.DiscoveryRequest) (e
 			}
 			values.proxyTemplatesNonce = nonce
 
+		case resp, more := <-values.gatewayListeners:
+			if !more {
+				return status.Errorf(codes.Unavailable, "gatewayListeners watch failed")
+			}
+			nonce, err := send(resp, mesh_core.GatewayListenerType)
+			if err != nil {
+				return err
+			}
+			values.gatewayListenersNonce = nonce
+
+		case resp, more := <-values.gatewayRoutes:
+			if !more {
+				return status.Errorf(codes.Unavailable, "gatewayRoutes watch failed")
+			}
+			nonce, err := send(resp, mesh_core.GatewayRouteType)
+			if err != nil {
+				return err
+			}
+			values.gatewayRoutesNonce = nonce
+
 		case resp, more := <-values.secrets:
 			if !more {
 				return status.Errorf(codes.Unavailable, "secrets watch failed")
@@ -404,6 +452,11 @@ func (s *server) process(stream stream, reqCh <-chan *envoy.DiscoveryRequest) (e
 					values.dataplaneInsightsCancel()
 				}
 				values.dataplaneInsights, values.dataplaneInsightsCancel = s.cache.CreateWatch(*req)
+			case requestResourceType == mesh_core.ServiceInsightType && (values.serviceInsightsNonce == "" || values.serviceInsightsNonce == nonce):
+				if values.serviceInsightsCancel != nil {
+					values.serviceInsightsCancel()
+				}
+				values.serviceInsights, values.serviceInsightsCancel = s.cache.CreateWatch(*req)
 			case requestResourceType == mesh_core.CircuitBreakerType && (values.circuitBreakersNonce == "" || values.circuitBreakersNonce == nonce):
 				if values.circuitBreakersCancel != nil {
 					values.circuitBreakersCancel()
@@ -444,6 +497,16 @@ func (s *server) process(stream stream, reqCh <-chan *envoy.DiscoveryRequest) (e
 					values.proxyTemplatesCancel()
 				}
 				values.proxyTemplates, values.proxyTemplatesCancel = s.cache.CreateWatch(*req)
+			case requestResourceType == mesh_core.GatewayListenerType && (values.gatewayListenersNonce == "" || values.gatewayListenersNonce == nonce):
+				if values.gatewayListenersCancel != nil {
+					values.gatewayListenersCancel()
+				}
+				values.gatewayListeners, values.gatewayListenersCancel = s.cache.CreateWatch(*req)
+			case requestResourceType == mesh_core.GatewayRouteType && (values.gatewayRoutesNonce == "" || values.gatewayRoutesNonce == nonce):
+				if values.gatewayRoutesCancel != nil {
+					values.gatewayRoutesCancel()
+				}
+				values.gatewayRoutes, values.gatewayRoutesCancel = s.cache.CreateWatch(*req)
 			case requestResourceType == system.SecretType && (values.secretsNonce == "" || values.secretsNonce == nonce):
 				if values.secretsCancel != nil {
 					values.secretsCancel()
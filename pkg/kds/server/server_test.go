@@ -61,12 +61,13 @@ var _ = Describe("KDS Server", func() {
 		ctx := context.Background()
 
 		// Just to don't forget to update this test after updating 'kds.SupportedTypes
-		Expect([]proto.Message{&kds_samples.Mesh1, &kds_samples.Ingress, &kds_samples.DataplaneInsight, &kds_samples.CircuitBreaker, &kds_samples.FaultInjection, &kds_samples.HealthCheck, &kds_samples.TrafficLog, &kds_samples.TrafficPermission, &kds_samples.TrafficRoute, &kds_samples.TrafficTrace, &kds_samples.ProxyTemplate, &kds_samples.Secret, &kds_samples.Config}).To(HaveLen(len(kds.SupportedTypes)))
+		Expect([]proto.Message{&kds_samples.Mesh1, &kds_samples.Ingress, &kds_samples.DataplaneInsight, &kds_samples.ServiceInsight, &kds_samples.CircuitBreaker, &kds_samples.FaultInjection, &kds_samples.HealthCheck, &kds_samples.TrafficLog, &kds_samples.TrafficPermission, &kds_samples.TrafficRoute, &kds_samples.TrafficTrace, &kds_samples.ProxyTemplate, &kds_samples.GatewayListener, &kds_samples.GatewayRoute, &kds_samples.Secret, &kds_samples.Config}).To(HaveLen(len(kds.SupportedTypes)))
 
 		vrf := kds_verifier.New().
 			Exec(kds_verifier.Create(ctx, &mesh.MeshResource{Spec: kds_samples.Mesh1}, store.CreateByKey("mesh-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.DataplaneResource{Spec: kds_samples.Ingress}, store.CreateByKey("Ingress-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.DataplaneInsightResource{Spec: kds_samples.DataplaneInsight}, store.CreateByKey("insight-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.ServiceInsightResource{Spec: kds_samples.ServiceInsight}, store.CreateByKey("all-services-mesh-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.CircuitBreakerResource{Spec: kds_samples.CircuitBreaker}, store.CreateByKey("cb-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.FaultInjectionResource{Spec: kds_samples.FaultInjection}, store.CreateByKey("fi-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.HealthCheckResource{Spec: kds_samples.HealthCheck}, store.CreateByKey("hc-1", "mesh-1"))).
@@ -75,6 +76,8 @@ var _ = Describe("KDS Server", func() {
 			Exec(kds_verifier.Create(ctx, &mesh.TrafficRouteResource{Spec: kds_samples.TrafficRoute}, store.CreateByKey("tr-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.TrafficTraceResource{Spec: kds_samples.TrafficTrace}, store.CreateByKey("tt-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.ProxyTemplateResource{Spec: kds_samples.ProxyTemplate}, store.CreateByKey("pt-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.GatewayListenerResource{Spec: kds_samples.GatewayListener}, store.CreateByKey("gl-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.GatewayRouteResource{Spec: kds_samples.GatewayRoute}, store.CreateByKey("gr-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &system.SecretResource{Spec: kds_samples.Secret}, store.CreateByKey("s-1", "mesh-1"))).
 			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshType)).
 			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
@@ -91,6 +94,11 @@ var _ = Describe("KDS Server", func() {
 				Expect(rs).To(HaveLen(1))
 				Expect(rs[0].GetSpec()).To(Equal(&kds_samples.DataplaneInsight))
 			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.ServiceInsightType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(Equal(&kds_samples.ServiceInsight))
+			})).
 			Exec(kds_verifier.DiscoveryRequest(node, mesh.CircuitBreakerType)).
 			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
 				Expect(rs).To(HaveLen(1))
@@ -131,6 +139,16 @@ var _ = Describe("KDS Server", func() {
 				Expect(rs).To(HaveLen(1))
 				Expect(rs[0].GetSpec()).To(Equal(&kds_samples.ProxyTemplate))
 			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.GatewayListenerType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(Equal(&kds_samples.GatewayListener))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.GatewayRouteType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(Equal(&kds_samples.GatewayRoute))
+			})).
 			Exec(kds_verifier.DiscoveryRequest(node, system.SecretType)).
 			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
 				Expect(rs).To(HaveLen(1))
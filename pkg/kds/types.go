@@ -17,6 +17,7 @@ var (
 		mesh.MeshType,
 		mesh.DataplaneType,
 		mesh.DataplaneInsightType,
+		mesh.ServiceInsightType,
 		mesh.CircuitBreakerType,
 		mesh.FaultInjectionType,
 		mesh.HealthCheckType,
@@ -25,6 +26,8 @@ var (
 		mesh.TrafficRouteType,
 		mesh.TrafficTraceType,
 		mesh.ProxyTemplateType,
+		mesh.GatewayListenerType,
+		mesh.GatewayRouteType,
 		system.SecretType,
 		system.ConfigType,
 	}
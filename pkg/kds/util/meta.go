@@ -59,3 +59,7 @@ func (r *resourceMeta) GetCreationTime() time.Time {
 func (r *resourceMeta) GetModificationTime() time.Time {
 	return *r.modificationTime
 }
+
+func (r *resourceMeta) GetLabels() map[string]string {
+	return nil
+}
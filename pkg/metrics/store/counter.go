@@ -7,6 +7,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
@@ -16,8 +17,11 @@ import (
 var log = core.Log.WithName("metrics").WithName("store-counter")
 
 type storeCounter struct {
-	resManager manager.ReadOnlyResourceManager
-	counts     *prometheus.GaugeVec
+	resManager   manager.ReadOnlyResourceManager
+	counts       *prometheus.GaugeVec
+	dataplanes   *prometheus.GaugeVec
+	policies     *prometheus.GaugeVec
+	certificates *prometheus.GaugeVec
 }
 
 var _ component.Component = &storeCounter{}
@@ -27,13 +31,31 @@ func NewStoreCounter(resManager manager.ReadOnlyResourceManager, metrics metrics
 		Name: "resources_count",
 	}, []string{"resource_type"})
 
-	if err := metrics.Register(counts); err != nil {
-		return nil, err
+	dataplanes := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mesh_dataplanes",
+		Help: "Number of Dataplanes of a Mesh by their status",
+	}, []string{"mesh", "status"})
+	policies := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mesh_policies",
+		Help: "Number of policies of a Mesh by their type",
+	}, []string{"mesh", "policy_type"})
+	certificates := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mesh_dataplane_certificates",
+		Help: "Number of Dataplanes of a Mesh by the status of their certificate",
+	}, []string{"mesh", "status"})
+
+	for _, collector := range []prometheus.Collector{counts, dataplanes, policies, certificates} {
+		if err := metrics.Register(collector); err != nil {
+			return nil, err
+		}
 	}
 
 	return &storeCounter{
-		resManager: resManager,
-		counts:     counts,
+		resManager:   resManager,
+		counts:       counts,
+		dataplanes:   dataplanes,
+		policies:     policies,
+		certificates: certificates,
 	}, nil
 }
 
@@ -73,5 +95,28 @@ func (s *storeCounter) count() error {
 		}
 		s.counts.WithLabelValues(string(resType)).Set(float64(len(list.GetItems())))
 	}
+	return s.countMeshInsights()
+}
+
+func (s *storeCounter) countMeshInsights() error {
+	insights := &core_mesh.MeshInsightResourceList{}
+	if err := s.resManager.List(context.Background(), insights); err != nil {
+		return err
+	}
+	// reset gauges so that metrics of deleted Meshes are not reported anymore
+	s.dataplanes.Reset()
+	s.policies.Reset()
+	s.certificates.Reset()
+	for _, insight := range insights.Items {
+		mesh := insight.GetMeta().GetName()
+		s.dataplanes.WithLabelValues(mesh, "online").Set(float64(insight.Spec.GetDataplanes().GetOnline()))
+		s.dataplanes.WithLabelValues(mesh, "offline").Set(float64(insight.Spec.GetDataplanes().GetOffline()))
+		for policyType, stat := range insight.Spec.GetPolicies() {
+			s.policies.WithLabelValues(mesh, policyType).Set(float64(stat.GetTotal()))
+		}
+		s.certificates.WithLabelValues(mesh, "valid").Set(float64(insight.Spec.GetMTLS().GetValidCertificates()))
+		s.certificates.WithLabelValues(mesh, "expired").Set(float64(insight.Spec.GetMTLS().GetExpiredCertificates()))
+		s.certificates.WithLabelValues(mesh, "none").Set(float64(insight.Spec.GetMTLS().GetNoCertificates()))
+	}
 	return nil
 }
@@ -0,0 +1,80 @@
+package metrics_test
+
+import (
+	"context"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	metrics_store "github.com/kumahq/kuma/pkg/metrics/store"
+	store_memory "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_metrics "github.com/kumahq/kuma/pkg/test/metrics"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Store Counter", func() {
+
+	var metrics core_metrics.Metrics
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		m, err := core_metrics.NewMetrics("Standalone")
+		metrics = m
+		Expect(err).ToNot(HaveOccurred())
+		store := store_memory.NewStore()
+
+		// setup test data
+		err = store.Create(context.Background(), &core_mesh.MeshResource{}, core_store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		err = store.Create(context.Background(), &core_mesh.MeshInsightResource{
+			Spec: mesh_proto.MeshInsight{
+				Dataplanes: &mesh_proto.MeshInsight_DataplaneStat{
+					Total:   3,
+					Online:  2,
+					Offline: 1,
+				},
+				Policies: map[string]*mesh_proto.MeshInsight_PolicyStat{
+					"TrafficPermission": {Total: 4},
+				},
+				MTLS: &mesh_proto.MeshInsight_MTLS{
+					ValidCertificates: 2,
+					NoCertificates:    1,
+				},
+			},
+		}, core_store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		counter, err := metrics_store.NewStoreCounter(core_manager.NewResourceManager(store), metrics)
+		Expect(err).ToNot(HaveOccurred())
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			Expect(counter.Start(stop)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	It("should publish metrics of resources", func() {
+		Eventually(func() float64 {
+			return test_metrics.FindMetric(metrics, "resources_count", "resource_type", "Mesh").GetGauge().GetValue()
+		}, "5s").Should(Equal(1.0))
+	})
+
+	It("should publish metrics of Meshes", func() {
+		Eventually(func() float64 {
+			return test_metrics.FindMetric(metrics, "mesh_dataplanes", "mesh", "default", "status", "online").GetGauge().GetValue()
+		}, "5s").Should(Equal(2.0))
+		Expect(test_metrics.FindMetric(metrics, "mesh_dataplanes", "mesh", "default", "status", "offline").GetGauge().GetValue()).To(Equal(1.0))
+		Expect(test_metrics.FindMetric(metrics, "mesh_policies", "mesh", "default", "policy_type", "TrafficPermission").GetGauge().GetValue()).To(Equal(4.0))
+		Expect(test_metrics.FindMetric(metrics, "mesh_dataplane_certificates", "mesh", "default", "status", "valid").GetGauge().GetValue()).To(Equal(2.0))
+		Expect(test_metrics.FindMetric(metrics, "mesh_dataplane_certificates", "mesh", "default", "status", "expired").GetGauge().GetValue()).To(Equal(0.0))
+		Expect(test_metrics.FindMetric(metrics, "mesh_dataplane_certificates", "mesh", "default", "status", "none").GetGauge().GetValue()).To(Equal(1.0))
+	})
+})
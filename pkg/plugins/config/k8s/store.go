@@ -108,7 +108,17 @@ func (s *KubernetesStore) Delete(ctx context.Context, r core_model.Resource, fs
 			configMapKey: configRes.Spec.Config,
 		},
 	}
-	return s.client.Delete(context.Background(), cm)
+	var deleteOpts []kube_client.DeleteOption
+	if opts.Version != "" {
+		deleteOpts = append(deleteOpts, kube_client.Preconditions{ResourceVersion: &opts.Version})
+	}
+	if err := s.client.Delete(context.Background(), cm, deleteOpts...); err != nil {
+		if kube_apierrs.IsConflict(err) {
+			return core_store.ErrorResourcePreconditionFailed(r.GetType(), opts.Name, opts.Mesh)
+		}
+		return err
+	}
+	return nil
 }
 func (s *KubernetesStore) Get(ctx context.Context, r core_model.Resource, fs ...core_store.GetOptionsFunc) error {
 	configRes, ok := r.(*config_model.ConfigResource)
@@ -32,7 +32,8 @@ func NewLeaderElector(b *core_runtime.Builder) (component.LeaderElector, error)
 		}
 		elector := leader_postgres.NewPostgresLeaderElector(client)
 		return elector, nil
-	case store.MemoryStore:
+	case store.MemoryStore, store.EmbeddedStore:
+		// the file of Embedded store can be opened only by one instance of the Control Plane
 		return leader_memory.NewAlwaysLeaderElector(), nil
 	// In case of Kubernetes, Leader Elector is embedded in a Kubernetes ComponentManager
 	default:
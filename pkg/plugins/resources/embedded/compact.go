@@ -0,0 +1,78 @@
+package embedded
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+
+	config "github.com/kumahq/kuma/pkg/config/plugins/resources/embedded"
+)
+
+// Compact rewrites the file of the store, so the space left by deleted resources is given back to the file system.
+// The file cannot be used by the Control Plane during the compaction.
+// It returns the size of the file before and after the compaction.
+func Compact(cfg config.EmbeddedStoreConfig) (int64, int64, error) {
+	src, err := open(cfg.Path, cfg.LockTimeout)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer src.Close()
+
+	tmpPath := cfg.Path + ".compact"
+	if err := os.RemoveAll(tmpPath); err != nil {
+		return 0, 0, errors.Wrapf(err, "could not remove %s", tmpPath)
+	}
+	dst, err := open(tmpPath, cfg.LockTimeout)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := copyBuckets(src, dst); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(tmpPath)
+		return 0, 0, err
+	}
+	if err := dst.Close(); err != nil {
+		return 0, 0, errors.Wrapf(err, "could not close %s", tmpPath)
+	}
+
+	before, err := fileSize(cfg.Path)
+	if err != nil {
+		return 0, 0, err
+	}
+	after, err := fileSize(tmpPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	// the lock of the source file is held until the compacted file replaces it
+	if err := os.Rename(tmpPath, cfg.Path); err != nil {
+		return 0, 0, errors.Wrapf(err, "could not replace %s", cfg.Path)
+	}
+	return before, after, nil
+}
+
+func copyBuckets(src, dst *bolt.DB) error {
+	return src.View(func(srcTx *bolt.Tx) error {
+		return dst.Update(func(dstTx *bolt.Tx) error {
+			return srcTx.ForEach(func(name []byte, srcBucket *bolt.Bucket) error {
+				dstBucket, err := dstTx.CreateBucket(name)
+				if err != nil {
+					return errors.Wrapf(err, "failed to create a bucket for %s", name)
+				}
+				// keys are copied in order, so the pages can be filled completely
+				dstBucket.FillPercent = 1.0
+				return srcBucket.ForEach(func(key, value []byte) error {
+					return dstBucket.Put(key, value)
+				})
+			})
+		})
+	})
+}
+
+func fileSize(path string) (int64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not read size of %s", path)
+	}
+	return info.Size(), nil
+}
@@ -0,0 +1,13 @@
+package embedded_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestEmbeddedStore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Embedded ResourceStore Suite")
+}
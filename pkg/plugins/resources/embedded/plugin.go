@@ -0,0 +1,29 @@
+package embedded
+
+import (
+	"github.com/pkg/errors"
+
+	config "github.com/kumahq/kuma/pkg/config/plugins/resources/embedded"
+	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+var _ core_plugins.ResourceStorePlugin = &plugin{}
+
+type plugin struct{}
+
+func init() {
+	core_plugins.Register(core_plugins.Embedded, &plugin{})
+}
+
+func (p *plugin) NewResourceStore(pc core_plugins.PluginContext, cfg core_plugins.PluginConfig) (core_store.ResourceStore, error) {
+	embeddedCfg, ok := cfg.(*config.EmbeddedStoreConfig)
+	if !ok {
+		return nil, errors.New("invalid type of the config. Passed config should be a EmbeddedStoreConfig")
+	}
+	return NewStore(*embeddedCfg)
+}
+
+func (p *plugin) Migrate(pc core_plugins.PluginContext, cfg core_plugins.PluginConfig) (core_plugins.DbVersion, error) {
+	return 0, errors.New("migrations are not supported for Embedded resource store")
+}
@@ -0,0 +1,419 @@
+package embedded
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+
+	config "github.com/kumahq/kuma/pkg/config/plugins/resources/embedded"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// keySeparator separates name and mesh in the key of a resource.
+// It is lower than any character of a name, therefore resources are sorted by name and then by mesh.
+const keySeparator = "\x00"
+
+const initialVersion uint64 = 1
+
+// EmbeddedStore keeps resources in a local file. Every resource type has its own bucket.
+// Resources are stored as JSON records under the key consisting of name and mesh.
+type EmbeddedStore struct {
+	db *bolt.DB
+}
+
+var _ store.ResourceStore = &EmbeddedStore{}
+var _ store.Backuper = &EmbeddedStore{}
+
+type resourceKey struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	Mesh string `json:"mesh"`
+}
+
+type record struct {
+	Name             string            `json:"name"`
+	Mesh             string            `json:"mesh"`
+	Version          uint64            `json:"version"`
+	Spec             string            `json:"spec"`
+	CreationTime     time.Time         `json:"creationTime"`
+	ModificationTime time.Time         `json:"modificationTime"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	Owner            *resourceKey      `json:"owner,omitempty"`
+	Children         []resourceKey     `json:"children,omitempty"`
+}
+
+func NewStore(cfg config.EmbeddedStoreConfig) (*EmbeddedStore, error) {
+	db, err := open(cfg.Path, cfg.LockTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &EmbeddedStore{
+		db: db,
+	}, nil
+}
+
+func open(path string, lockTimeout time.Duration) (*bolt.DB, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, errors.Wrapf(err, "could not create a directory for %s", path)
+	}
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: lockTimeout})
+	if err == bolt.ErrTimeout {
+		return nil, errors.Errorf("could not open %s, the file is used by another process", path)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open %s", path)
+	}
+	return db, nil
+}
+
+func (s *EmbeddedStore) Create(_ context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
+	opts := store.NewCreateOptions(fs...)
+
+	spec, err := proto.ToJSON(resource.GetSpec())
+	if err != nil {
+		return errors.Wrap(err, "failed to convert spec to json")
+	}
+	rec := &record{
+		Name:             opts.Name,
+		Mesh:             opts.Mesh,
+		Version:          initialVersion,
+		Spec:             string(spec),
+		CreationTime:     opts.CreationTime,
+		ModificationTime: opts.CreationTime,
+		Labels:           opts.Labels,
+	}
+	key := resourceKey{Type: string(resource.GetType()), Name: opts.Name, Mesh: opts.Mesh}
+
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		existing, err := getRecord(tx, key)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return store.ErrorResourceAlreadyExists(resource.GetType(), opts.Name, opts.Mesh)
+		}
+		if opts.Owner != nil {
+			ownerKey := resourceKey{
+				Type: string(opts.Owner.GetType()),
+				Name: opts.Owner.GetMeta().GetName(),
+				Mesh: opts.Owner.GetMeta().GetMesh(),
+			}
+			owner, err := getRecord(tx, ownerKey)
+			if err != nil {
+				return err
+			}
+			if owner == nil {
+				return store.ErrorResourceNotFound(opts.Owner.GetType(), ownerKey.Name, ownerKey.Mesh)
+			}
+			owner.Children = append(owner.Children, key)
+			if err := putRecord(tx, ownerKey, owner); err != nil {
+				return err
+			}
+			rec.Owner = &ownerKey
+		}
+		return putRecord(tx, key, rec)
+	})
+	if err != nil {
+		return err
+	}
+
+	resource.SetMeta(rec.meta())
+	return nil
+}
+
+func (s *EmbeddedStore) Update(_ context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
+	opts := store.NewUpdateOptions(fs...)
+
+	spec, err := proto.ToJSON(resource.GetSpec())
+	if err != nil {
+		return errors.Wrap(err, "failed to convert spec to json")
+	}
+	version, err := strconv.ParseUint(resource.GetMeta().GetVersion(), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert meta version to int")
+	}
+	key := resourceKey{
+		Type: string(resource.GetType()),
+		Name: resource.GetMeta().GetName(),
+		Mesh: resource.GetMeta().GetMesh(),
+	}
+
+	var rec *record
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		rec, err = getRecord(tx, key)
+		if err != nil {
+			return err
+		}
+		if rec == nil || rec.Version != version {
+			return store.ErrorResourceConflict(resource.GetType(), key.Name, key.Mesh)
+		}
+		rec.Version++
+		rec.Spec = string(spec)
+		rec.ModificationTime = opts.ModificationTime
+		if opts.Labels != nil { // labels are preserved when not changed explicitly
+			rec.Labels = opts.Labels
+		}
+		return putRecord(tx, key, rec)
+	})
+	if err != nil {
+		return err
+	}
+
+	resource.SetMeta(rec.meta())
+	return nil
+}
+
+func (s *EmbeddedStore) Delete(_ context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
+	opts := store.NewDeleteOptions(fs...)
+
+	key := resourceKey{Type: string(resource.GetType()), Name: opts.Name, Mesh: opts.Mesh}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		rec, err := getRecord(tx, key)
+		if err != nil {
+			return err
+		}
+		if rec == nil {
+			return store.ErrorResourceNotFound(resource.GetType(), opts.Name, opts.Mesh)
+		}
+		if opts.Version != "" && strconv.FormatUint(rec.Version, 10) != opts.Version {
+			return store.ErrorResourcePreconditionFailed(resource.GetType(), opts.Name, opts.Mesh)
+		}
+		return deleteRecord(tx, key, rec)
+	})
+}
+
+// deleteRecord deletes the record together with all resources that it owns.
+func deleteRecord(tx *bolt.Tx, key resourceKey, rec *record) error {
+	if err := tx.Bucket([]byte(key.Type)).Delete(recordKey(key.Name, key.Mesh)); err != nil {
+		return errors.Wrap(err, "failed to delete the record")
+	}
+	if rec.Owner != nil {
+		owner, err := getRecord(tx, *rec.Owner)
+		if err != nil {
+			return err
+		}
+		// the owner is already deleted when the deletion is cascaded from it
+		if owner != nil {
+			var children []resourceKey
+			for _, child := range owner.Children {
+				if child != key {
+					children = append(children, child)
+				}
+			}
+			owner.Children = children
+			if err := putRecord(tx, *rec.Owner, owner); err != nil {
+				return err
+			}
+		}
+	}
+	for _, childKey := range rec.Children {
+		child, err := getRecord(tx, childKey)
+		if err != nil {
+			return err
+		}
+		if child == nil {
+			continue
+		}
+		if err := deleteRecord(tx, childKey, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *EmbeddedStore) Get(_ context.Context, resource model.Resource, fs ...store.GetOptionsFunc) error {
+	opts := store.NewGetOptions(fs...)
+
+	key := resourceKey{Type: string(resource.GetType()), Name: opts.Name, Mesh: opts.Mesh}
+	var rec *record
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		rec, err = getRecord(tx, key)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if rec == nil {
+		return store.ErrorResourceNotFound(resource.GetType(), opts.Name, opts.Mesh)
+	}
+	if err := rec.unmarshal(resource); err != nil {
+		return err
+	}
+	if opts.Version != "" && resource.GetMeta().GetVersion() != opts.Version {
+		return store.ErrorResourcePreconditionFailed(resource.GetType(), opts.Name, opts.Mesh)
+	}
+	return nil
+}
+
+func (s *EmbeddedStore) List(_ context.Context, resources model.ResourceList, fs ...store.ListOptionsFunc) error {
+	opts := store.NewListOptions(fs...)
+
+	offset := 0
+	if opts.PageOffset != "" {
+		o, err := strconv.Atoi(opts.PageOffset)
+		if err != nil {
+			return store.ErrorInvalidOffset
+		}
+		offset = o
+	}
+
+	var items []model.Resource
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(resources.GetItemType()))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(_, value []byte) error {
+			rec := &record{}
+			if err := json.Unmarshal(value, rec); err != nil {
+				return errors.Wrap(err, "failed to unmarshal the record")
+			}
+			if opts.Mesh != "" && rec.Mesh != opts.Mesh {
+				return nil
+			}
+			item := resources.NewItem()
+			if err := rec.unmarshal(item); err != nil {
+				return err
+			}
+			if opts.Filter(item) {
+				items = append(items, item)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	paginateResults := opts.PageSize != 0
+	pageSize := len(items)
+	if paginateResults {
+		pageSize = opts.PageSize
+	}
+	for i := offset; i < offset+pageSize && i < len(items); i++ {
+		if err := resources.AddItem(items[i]); err != nil {
+			return err
+		}
+	}
+	if paginateResults {
+		nextOffset := ""
+		if offset+pageSize < len(items) { // set new offset only if there is next page
+			nextOffset = strconv.Itoa(offset + pageSize)
+		}
+		resources.GetPagination().SetNextOffset(nextOffset)
+	}
+	resources.GetPagination().SetTotal(uint32(len(items)))
+	return nil
+}
+
+// Backup writes a consistent copy of the file to w. Writes to the store are not blocked during the backup.
+func (s *EmbeddedStore) Backup(w io.Writer) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(w)
+		return err
+	})
+}
+
+func (s *EmbeddedStore) Close() error {
+	return s.db.Close()
+}
+
+func recordKey(name, mesh string) []byte {
+	return []byte(name + keySeparator + mesh)
+}
+
+func getRecord(tx *bolt.Tx, key resourceKey) (*record, error) {
+	bucket := tx.Bucket([]byte(key.Type))
+	if bucket == nil {
+		return nil, nil
+	}
+	value := bucket.Get(recordKey(key.Name, key.Mesh))
+	if value == nil {
+		return nil, nil
+	}
+	rec := &record{}
+	if err := json.Unmarshal(value, rec); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal the record")
+	}
+	return rec, nil
+}
+
+func putRecord(tx *bolt.Tx, key resourceKey, rec *record) error {
+	bucket, err := tx.CreateBucketIfNotExists([]byte(key.Type))
+	if err != nil {
+		return errors.Wrapf(err, "failed to create a bucket for %s", key.Type)
+	}
+	value, err := json.Marshal(rec)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the record")
+	}
+	return bucket.Put(recordKey(key.Name, key.Mesh), value)
+}
+
+func (r *record) unmarshal(resource model.Resource) error {
+	if err := proto.FromJSON([]byte(r.Spec), resource.GetSpec()); err != nil {
+		return errors.Wrap(err, "failed to convert json to spec")
+	}
+	resource.SetMeta(r.meta())
+	return nil
+}
+
+func (r *record) meta() *resourceMetaObject {
+	return &resourceMetaObject{
+		Name:             r.Name,
+		Mesh:             r.Mesh,
+		Version:          strconv.FormatUint(r.Version, 10),
+		CreationTime:     r.CreationTime,
+		ModificationTime: r.ModificationTime,
+		Labels:           r.Labels,
+	}
+}
+
+type resourceMetaObject struct {
+	Name             string
+	Version          string
+	Mesh             string
+	CreationTime     time.Time
+	ModificationTime time.Time
+	Labels           map[string]string
+}
+
+var _ model.ResourceMeta = &resourceMetaObject{}
+
+func (r *resourceMetaObject) GetName() string {
+	return r.Name
+}
+
+func (r *resourceMetaObject) GetNameExtensions() model.ResourceNameExtensions {
+	return model.ResourceNameExtensionsUnsupported
+}
+
+func (r *resourceMetaObject) GetVersion() string {
+	return r.Version
+}
+
+func (r *resourceMetaObject) GetMesh() string {
+	return r.Mesh
+}
+
+func (r *resourceMetaObject) GetCreationTime() time.Time {
+	return r.CreationTime
+}
+
+func (r *resourceMetaObject) GetModificationTime() time.Time {
+	return r.ModificationTime
+}
+
+func (r *resourceMetaObject) GetLabels() map[string]string {
+	return r.Labels
+}
@@ -0,0 +1,48 @@
+package embedded_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	config "github.com/kumahq/kuma/pkg/config/plugins/resources/embedded"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/embedded"
+	test_store "github.com/kumahq/kuma/pkg/test/store"
+)
+
+var _ = Describe("EmbeddedStore template", func() {
+	var dir string
+	var stores []*embedded.EmbeddedStore
+
+	createStore := func() store.ResourceStore {
+		if dir == "" {
+			d, err := ioutil.TempDir("", "")
+			Expect(err).ToNot(HaveOccurred())
+			dir = d
+		}
+		cfg := config.DefaultEmbeddedStoreConfig()
+		// every store has its own file, because the file can be opened only once
+		cfg.Path = filepath.Join(dir, fmt.Sprintf("kuma-%d.db", len(stores)))
+		s, err := embedded.NewStore(*cfg)
+		Expect(err).ToNot(HaveOccurred())
+		stores = append(stores, s)
+		return s
+	}
+
+	AfterEach(func() {
+		for _, s := range stores {
+			Expect(s.Close()).To(Succeed())
+		}
+		stores = nil
+		Expect(os.RemoveAll(dir)).To(Succeed())
+		dir = ""
+	})
+
+	test_store.ExecuteStoreTests(createStore)
+	test_store.ExecuteOwnerTests(createStore)
+})
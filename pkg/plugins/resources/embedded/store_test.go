@@ -0,0 +1,140 @@
+package embedded_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	config "github.com/kumahq/kuma/pkg/config/plugins/resources/embedded"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/embedded"
+	sample_proto "github.com/kumahq/kuma/pkg/test/apis/sample/v1alpha1"
+	sample_model "github.com/kumahq/kuma/pkg/test/resources/apis/sample"
+)
+
+var _ = Describe("EmbeddedStore", func() {
+
+	var dir string
+	var cfg *config.EmbeddedStoreConfig
+	var s *embedded.EmbeddedStore
+
+	BeforeEach(func() {
+		d, err := ioutil.TempDir("", "")
+		Expect(err).ToNot(HaveOccurred())
+		dir = d
+		cfg = config.DefaultEmbeddedStoreConfig()
+		cfg.Path = filepath.Join(dir, "kuma.db")
+		cfg.LockTimeout = 100 * time.Millisecond
+		s, err = embedded.NewStore(*cfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(s.Close()).To(Succeed())
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	createResource := func(name string) {
+		res := sample_model.TrafficRouteResource{
+			Spec: sample_proto.TrafficRoute{
+				Path: name,
+			},
+		}
+		err := s.Create(context.Background(), &res, store.CreateByKey(name, "default"), store.CreatedAt(time.Now()))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	expectResource := func(s store.ResourceStore, name string) {
+		res := sample_model.TrafficRouteResource{}
+		err := s.Get(context.Background(), &res, store.GetByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Spec.Path).To(Equal(name))
+	}
+
+	It("should keep resources after the store is reopened", func() {
+		// given
+		createResource("route-1")
+		Expect(s.Close()).To(Succeed())
+
+		// when
+		var err error
+		s, err = embedded.NewStore(*cfg)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		expectResource(s, "route-1")
+	})
+
+	It("should not open the file that is used by another store", func() {
+		// when
+		_, err := embedded.NewStore(*cfg)
+
+		// then
+		Expect(err).To(MatchError(fmt.Sprintf("could not open %s, the file is used by another process", cfg.Path)))
+	})
+
+	It("should take a backup that can be opened as a store", func() {
+		// given
+		createResource("route-1")
+
+		// when
+		buf := &bytes.Buffer{}
+		err := s.Backup(buf)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when the backup is opened
+		backupCfg := *cfg
+		backupCfg.Path = filepath.Join(dir, "backup.db")
+		Expect(ioutil.WriteFile(backupCfg.Path, buf.Bytes(), 0600)).To(Succeed())
+		backup, err := embedded.NewStore(backupCfg)
+		Expect(err).ToNot(HaveOccurred())
+		defer backup.Close()
+
+		// then
+		expectResource(backup, "route-1")
+	})
+
+	It("should compact the file", func() {
+		// given a file with space left by deleted resources
+		for i := 0; i < 100; i++ {
+			createResource(fmt.Sprintf("route-%d", i))
+		}
+		for i := 1; i < 100; i++ {
+			err := s.Delete(context.Background(), &sample_model.TrafficRouteResource{}, store.DeleteByKey(fmt.Sprintf("route-%d", i), "default"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(s.Close()).To(Succeed())
+
+		// when
+		before, after, err := embedded.Compact(*cfg)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(after).To(BeNumerically("<", before))
+
+		// and resources are preserved
+		s, err = embedded.NewStore(*cfg)
+		Expect(err).ToNot(HaveOccurred())
+		expectResource(s, "route-0")
+		list := sample_model.TrafficRouteResourceList{}
+		Expect(s.List(context.Background(), &list)).To(Succeed())
+		Expect(list.Items).To(HaveLen(1))
+	})
+
+	It("should not compact the file that is used by the store", func() {
+		// when
+		_, _, err := embedded.Compact(*cfg)
+
+		// then
+		Expect(err).To(MatchError(fmt.Sprintf("could not open %s, the file is used by another process", cfg.Path)))
+	})
+})
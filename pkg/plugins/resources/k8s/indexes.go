@@ -0,0 +1,121 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	kube_fields "k8s.io/apimachinery/pkg/fields"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_registry "github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	k8s_model "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+)
+
+// Kubernetes supports field selectors of custom resources only on metadata.name and metadata.namespace,
+// therefore filters of the store are served by indexes of the cache of the manager.
+const (
+	meshIndex       = "kuma.io/mesh"
+	namePrefixIndex = "kuma.io/name-prefix"
+	tagIndex        = "kuma.io/tag"
+)
+
+// AddIndexes registers indexes used by the store to filter resources of every type with a Kubernetes counterpart.
+func AddIndexes(indexer kube_client.FieldIndexer, converter Converter) error {
+	for _, typ := range core_registry.Global().ObjectTypes() {
+		res, err := core_registry.Global().NewObject(typ)
+		if err != nil {
+			return err
+		}
+		obj, err := converter.ToKubernetesObject(res)
+		if err != nil {
+			continue // type is not stored in Kubernetes
+		}
+		indexes := map[string]func(core_model.Resource) []string{
+			meshIndex:       meshIndexValues,
+			namePrefixIndex: namePrefixIndexValues,
+		}
+		if _, ok := res.(store.TaggedResource); ok {
+			indexes[tagIndex] = tagIndexValues
+		}
+		for field, values := range indexes {
+			if err := indexer.IndexField(context.Background(), obj, field, coreIndexFunc(converter, typ, values)); err != nil {
+				return errors.Wrapf(err, "could not add index %s of %s", field, typ)
+			}
+		}
+	}
+	return nil
+}
+
+func coreIndexFunc(converter Converter, typ core_model.ResourceType, values func(core_model.Resource) []string) kube_client.IndexerFunc {
+	return func(obj kube_runtime.Object) []string {
+		kubeObj, ok := obj.(k8s_model.KubernetesObject)
+		if !ok {
+			return nil
+		}
+		res, err := core_registry.Global().NewObject(typ)
+		if err != nil {
+			return nil
+		}
+		if err := converter.ToCoreResource(kubeObj, res); err != nil {
+			return nil
+		}
+		return values(res)
+	}
+}
+
+func meshIndexValues(res core_model.Resource) []string {
+	return []string{res.GetMeta().GetMesh()}
+}
+
+func namePrefixIndexValues(res core_model.Resource) []string {
+	name := res.GetMeta().GetName()
+	prefixes := make([]string, 0, len(name))
+	for i := 1; i <= len(name); i++ {
+		prefixes = append(prefixes, name[:i])
+	}
+	return prefixes
+}
+
+// tagIndexValues returns every tag key, matching a filter on presence of the tag, and every tag as key=value.
+func tagIndexValues(res core_model.Resource) []string {
+	var values []string
+	seen := map[string]bool{}
+	for _, tags := range res.(store.TaggedResource).TagSets() {
+		for key, value := range tags {
+			for _, v := range []string{key, key + "=" + value} {
+				if !seen[v] {
+					seen[v] = true
+					values = append(values, v)
+				}
+			}
+		}
+	}
+	return values
+}
+
+// fieldSelector picks the most selective filter that an index can serve. The cache matches a single field exactly,
+// so the rest of the filters are applied after the resources are fetched.
+func fieldSelector(opts *store.ListOptions, item core_model.Resource) kube_fields.Selector {
+	_, tagged := item.(store.TaggedResource)
+	for _, tag := range opts.Tags {
+		if !tagged || tag.Negate || len(tag.Values) > 1 || strings.Contains(tag.Key, "=") {
+			continue
+		}
+		value := tag.Key
+		if len(tag.Values) == 1 {
+			value = tag.Key + "=" + tag.Values[0]
+		}
+		return kube_fields.OneTermEqualSelector(tagIndex, value)
+	}
+	if opts.NamePrefix != "" {
+		return kube_fields.OneTermEqualSelector(namePrefixIndex, opts.NamePrefix)
+	}
+	if opts.Mesh != "" {
+		return kube_fields.OneTermEqualSelector(meshIndex, opts.Mesh)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package k8s
+
+import (
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_policy "github.com/kumahq/kuma/pkg/core/policy"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	common_k8s "github.com/kumahq/kuma/pkg/plugins/common/k8s"
+	k8s_model "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+)
+
+// inboundPolicies are connection policies that are applied on the destination side of a connection.
+var inboundPolicies = map[core_model.ResourceType]bool{
+	core_mesh.TrafficPermissionType: true,
+	core_mesh.FaultInjectionType:    true,
+}
+
+// NamespaceScopedSelectors returns the name of the field and selectors of the policy that select Dataplanes the policy is applied on.
+// Those are destinations of inbound connection policies, sources of the other connection policies and selectors of dataplane policies.
+// Resources that are not policies have no such selectors.
+func NamespaceScopedSelectors(res core_model.Resource) (string, []*mesh_proto.Selector) {
+	switch policy := res.(type) {
+	case core_policy.ConnectionPolicy:
+		if inboundPolicies[res.GetType()] {
+			return "destinations", policy.Destinations()
+		}
+		return "sources", policy.Sources()
+	case core_policy.DataplanePolicy:
+		return "selectors", policy.Selectors()
+	default:
+		return "", nil
+	}
+}
+
+// ScopeToNamespace restricts the policy to Dataplanes of the given namespace.
+// Selectors of the policy get one more tag, therefore a namespaced policy is more specific than a mesh-wide policy
+// with the same selectors and it takes precedence over it.
+func ScopeToNamespace(res core_model.Resource, namespace string) {
+	_, selectors := NamespaceScopedSelectors(res)
+	for _, selector := range selectors {
+		if selector.Match == nil {
+			selector.Match = map[string]string{}
+		}
+		selector.Match[mesh_proto.KubeNamespaceTag] = namespace
+	}
+}
+
+// unscopeSpec removes the tag added by ScopeToNamespace from selectors of the policy in the form of a Kubernetes spec.
+func unscopeSpec(spec map[string]interface{}, field string, namespace string) {
+	selectors, _ := spec[field].([]interface{})
+	for _, selector := range selectors {
+		selector, _ := selector.(map[string]interface{})
+		match, _ := selector["match"].(map[string]interface{})
+		if match[mesh_proto.KubeNamespaceTag] == namespace {
+			delete(match, mesh_proto.KubeNamespaceTag)
+		}
+	}
+}
+
+var _ Converter = &NamespaceScopedConverter{}
+
+// NamespaceScopedConverter restricts policies applied in a namespace other than the system namespace
+// to Dataplanes of the same namespace. Policies synced from the other Control Plane are mesh-wide and so are
+// policies generated by controllers, e.g. gateway configuration of an Ingress served by Dataplanes of another namespace.
+// The restriction is applied only to the core model, it is never persisted in Kubernetes objects.
+type NamespaceScopedConverter struct {
+	Converter
+	SystemNamespace string
+}
+
+func (c *NamespaceScopedConverter) ToKubernetesObject(res core_model.Resource) (k8s_model.KubernetesObject, error) {
+	obj, err := c.Converter.ToKubernetesObject(res)
+	if err != nil {
+		return nil, err
+	}
+	if c.isNamespaceScoped(obj) {
+		if field, _ := NamespaceScopedSelectors(res); field != "" {
+			unscopeSpec(obj.GetSpec(), field, obj.GetNamespace())
+		}
+	}
+	return obj, nil
+}
+
+func (c *NamespaceScopedConverter) ToCoreResource(obj k8s_model.KubernetesObject, out core_model.Resource) error {
+	if err := c.Converter.ToCoreResource(obj, out); err != nil {
+		return err
+	}
+	if c.isNamespaceScoped(obj) {
+		ScopeToNamespace(out, obj.GetNamespace())
+	}
+	return nil
+}
+
+func (c *NamespaceScopedConverter) ToCoreList(in k8s_model.KubernetesList, out core_model.ResourceList, predicate ConverterPredicate) error {
+	for _, o := range in.GetItems() {
+		r := out.NewItem()
+		if err := c.ToCoreResource(o, r); err != nil {
+			return err
+		}
+		if predicate(r) {
+			_ = out.AddItem(r)
+		}
+	}
+	out.GetPagination().SetNextOffset(in.GetContinue())
+	return nil
+}
+
+func (c *NamespaceScopedConverter) isNamespaceScoped(obj k8s_model.KubernetesObject) bool {
+	return obj.Scope() == k8s_model.ScopeNamespace &&
+		obj.GetNamespace() != "" &&
+		obj.GetNamespace() != c.SystemNamespace &&
+		obj.GetAnnotations()[common_k8s.K8sSynced] != "true" &&
+		kube_meta.GetControllerOf(obj.GetObjectMeta()) == nil
+}
@@ -0,0 +1,186 @@
+package k8s_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	kube_core "k8s.io/api/core/v1"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s"
+	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("NamespaceScopedConverter", func() {
+
+	converter := &k8s.NamespaceScopedConverter{
+		Converter:       k8s.DefaultConverter(),
+		SystemNamespace: "kuma-system",
+	}
+
+	permission := func(namespace string, annotations map[string]string) *mesh_k8s.TrafficPermission {
+		spec, err := util_proto.ToMap(&mesh_proto.TrafficPermission{
+			Sources:      []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "web"}}},
+			Destinations: []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "backend"}}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return &mesh_k8s.TrafficPermission{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name:        "web-to-backend",
+				Namespace:   namespace,
+				Annotations: annotations,
+			},
+			Mesh: "default",
+			Spec: spec,
+		}
+	}
+
+	It("should restrict destinations of a policy to its namespace", func() {
+		// given
+		res := &core_mesh.TrafficPermissionResource{}
+
+		// when
+		Expect(converter.ToCoreResource(permission("demo", nil), res)).To(Succeed())
+
+		// then
+		Expect(res.Spec.Sources[0].Match).To(Equal(map[string]string{"kuma.io/service": "web"}))
+		Expect(res.Spec.Destinations[0].Match).To(Equal(map[string]string{
+			"kuma.io/service":       "backend",
+			"k8s.kuma.io/namespace": "demo",
+		}))
+	})
+
+	It("should not restrict a policy of the system namespace", func() {
+		// given
+		res := &core_mesh.TrafficPermissionResource{}
+
+		// when
+		Expect(converter.ToCoreResource(permission("kuma-system", nil), res)).To(Succeed())
+
+		// then
+		Expect(res.Spec.Destinations[0].Match).To(Equal(map[string]string{"kuma.io/service": "backend"}))
+	})
+
+	It("should not restrict a synced policy", func() {
+		// given
+		res := &core_mesh.TrafficPermissionResource{}
+
+		// when
+		Expect(converter.ToCoreResource(permission("demo", map[string]string{"k8s.kuma.io/synced": "true"}), res)).To(Succeed())
+
+		// then
+		Expect(res.Spec.Destinations[0].Match).To(Equal(map[string]string{"kuma.io/service": "backend"}))
+	})
+
+	It("should not restrict a policy generated by a controller", func() {
+		// given
+		obj := permission("demo", nil)
+		isController := true
+		obj.OwnerReferences = []kube_meta.OwnerReference{{
+			APIVersion: "networking.k8s.io/v1beta1",
+			Kind:       "Ingress",
+			Name:       "web",
+			UID:        "f2b6ee9d-2a53-4ae4-8f0a-5d1c0b3c0f6e",
+			Controller: &isController,
+		}}
+		res := &core_mesh.TrafficPermissionResource{}
+
+		// when
+		Expect(converter.ToCoreResource(obj, res)).To(Succeed())
+
+		// then
+		Expect(res.Spec.Destinations[0].Match).To(Equal(map[string]string{"kuma.io/service": "backend"}))
+	})
+
+	It("should not persist the restriction of a policy", func() {
+		// given
+		res := &core_mesh.TrafficPermissionResource{}
+		Expect(converter.ToCoreResource(permission("demo", nil), res)).To(Succeed())
+
+		// when
+		obj, err := converter.ToKubernetesObject(res)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(obj.GetSpec()).To(Equal(permission("demo", nil).Spec))
+		// and the core resource is still restricted
+		Expect(res.Spec.Destinations[0].Match).To(HaveKeyWithValue("k8s.kuma.io/namespace", "demo"))
+	})
+
+	It("should restrict sources of an outbound policy", func() {
+		// given
+		route := &core_mesh.TrafficRouteResource{
+			Spec: mesh_proto.TrafficRoute{
+				Sources:      []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "*"}}},
+				Destinations: []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "backend"}}},
+			},
+		}
+
+		// when
+		k8s.ScopeToNamespace(route, "demo")
+
+		// then
+		Expect(route.Spec.Sources[0].Match).To(HaveKeyWithValue("k8s.kuma.io/namespace", "demo"))
+		Expect(route.Spec.Destinations[0].Match).ToNot(HaveKey("k8s.kuma.io/namespace"))
+	})
+
+	Describe("with KubernetesStore", func() {
+
+		var s store.ResourceStore
+		var ns string
+
+		BeforeEach(func() {
+			ns = string(uuid.NewUUID())
+			Expect(k8sClient.Create(context.Background(), &kube_core.Namespace{
+				ObjectMeta: kube_meta.ObjectMeta{Name: ns},
+			})).To(Succeed())
+			s = store.NewStrictResourceStore(&k8s.KubernetesStore{
+				Client:    k8sClient,
+				Converter: converter,
+				Scheme:    k8sClientScheme,
+			})
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.DeleteAllOf(context.Background(), &mesh_k8s.TrafficPermission{}, kube_client.InNamespace(ns))).To(Succeed())
+		})
+
+		It("should not write the restriction back on update", func() {
+			// setup
+			Expect(k8sClient.Create(context.Background(), permission(ns, nil))).To(Succeed())
+
+			// given
+			res := &core_mesh.TrafficPermissionResource{}
+			Expect(s.Get(context.Background(), res, store.GetByKey("web-to-backend."+ns, "default"))).To(Succeed())
+			Expect(res.Spec.Destinations[0].Match).To(HaveKeyWithValue("k8s.kuma.io/namespace", ns))
+
+			// when
+			res.Spec.Sources[0].Match["kuma.io/service"] = "frontend"
+			err := s.Update(context.Background(), res)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Spec.Destinations[0].Match).To(HaveKeyWithValue("k8s.kuma.io/namespace", ns))
+
+			// when
+			actual := &mesh_k8s.TrafficPermission{}
+			Expect(k8sClient.Get(context.Background(), kube_client.ObjectKey{Namespace: ns, Name: "web-to-backend"}, actual)).To(Succeed())
+
+			// then
+			Expect(actual.Spec["destinations"]).To(Equal([]interface{}{
+				map[string]interface{}{"match": map[string]interface{}{"kuma.io/service": "backend"}},
+			}))
+			Expect(actual.Spec["sources"]).To(Equal([]interface{}{
+				map[string]interface{}{"match": map[string]interface{}{"kuma.io/service": "frontend"}},
+			}))
+		})
+	})
+})
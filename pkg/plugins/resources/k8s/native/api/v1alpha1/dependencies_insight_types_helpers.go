@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+func (pt *DependenciesInsight) GetObjectMeta() *metav1.ObjectMeta {
+	return &pt.ObjectMeta
+}
+
+func (pt *DependenciesInsight) SetObjectMeta(m *metav1.ObjectMeta) {
+	pt.ObjectMeta = *m
+}
+
+func (pt *DependenciesInsight) GetMesh() string {
+	return pt.Mesh
+}
+
+func (pt *DependenciesInsight) SetMesh(mesh string) {
+	pt.Mesh = mesh
+}
+
+func (pt *DependenciesInsight) GetSpec() map[string]interface{} {
+	return pt.Status
+}
+
+func (pt *DependenciesInsight) SetSpec(spec map[string]interface{}) {
+	pt.Status = spec
+}
+
+func (pt *DependenciesInsight) Scope() model.Scope {
+	return model.ScopeNamespace
+}
+
+func (l *DependenciesInsightList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&proto.DependenciesInsight{}, &DependenciesInsight{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "DependenciesInsight",
+		},
+	})
+	registry.RegisterListType(&proto.DependenciesInsight{}, &DependenciesInsightList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "DependenciesInsightList",
+		},
+	})
+}
@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+func (pt *Dependencies) GetObjectMeta() *metav1.ObjectMeta {
+	return &pt.ObjectMeta
+}
+
+func (pt *Dependencies) SetObjectMeta(m *metav1.ObjectMeta) {
+	pt.ObjectMeta = *m
+}
+
+func (pt *Dependencies) GetMesh() string {
+	return pt.Mesh
+}
+
+func (pt *Dependencies) SetMesh(mesh string) {
+	pt.Mesh = mesh
+}
+
+func (pt *Dependencies) GetSpec() map[string]interface{} {
+	return pt.Spec
+}
+
+func (pt *Dependencies) SetSpec(spec map[string]interface{}) {
+	pt.Spec = spec
+}
+
+func (l *Dependencies) Scope() model.Scope {
+	return model.ScopeNamespace
+}
+
+func (l *DependenciesList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&proto.Dependencies{}, &Dependencies{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "Dependencies",
+		},
+	})
+	registry.RegisterListType(&proto.Dependencies{}, &DependenciesList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "DependenciesList",
+		},
+	})
+}
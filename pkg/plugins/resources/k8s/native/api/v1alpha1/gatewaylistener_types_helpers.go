@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+func (pt *GatewayListener) GetObjectMeta() *metav1.ObjectMeta {
+	return &pt.ObjectMeta
+}
+
+func (pt *GatewayListener) SetObjectMeta(m *metav1.ObjectMeta) {
+	pt.ObjectMeta = *m
+}
+
+func (pt *GatewayListener) GetMesh() string {
+	return pt.Mesh
+}
+
+func (pt *GatewayListener) SetMesh(mesh string) {
+	pt.Mesh = mesh
+}
+
+func (pt *GatewayListener) GetSpec() map[string]interface{} {
+	return pt.Spec
+}
+
+func (pt *GatewayListener) SetSpec(spec map[string]interface{}) {
+	pt.Spec = spec
+}
+
+func (l *GatewayListener) Scope() model.Scope {
+	return model.ScopeNamespace
+}
+
+func (l *GatewayListenerList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&proto.GatewayListener{}, &GatewayListener{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "GatewayListener",
+		},
+	})
+	registry.RegisterListType(&proto.GatewayListener{}, &GatewayListenerList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "GatewayListenerList",
+		},
+	})
+}
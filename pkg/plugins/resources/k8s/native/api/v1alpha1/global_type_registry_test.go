@@ -39,6 +39,11 @@ var _ = Describe("global TypeRegistry", func() {
 				expectedType: &Mesh{},
 				expectedKind: "Mesh",
 			}),
+			Entry("MeshInsight", testCase{
+				inputType:    &mesh_proto.MeshInsight{},
+				expectedType: &MeshInsight{},
+				expectedKind: "MeshInsight",
+			}),
 			Entry("Dataplane", testCase{
 				inputType:    &mesh_proto.Dataplane{},
 				expectedType: &Dataplane{},
@@ -49,6 +54,11 @@ var _ = Describe("global TypeRegistry", func() {
 				expectedType: &DataplaneInsight{},
 				expectedKind: "DataplaneInsight",
 			}),
+			Entry("ServiceInsight", testCase{
+				inputType:    &mesh_proto.ServiceInsight{},
+				expectedType: &ServiceInsight{},
+				expectedKind: "ServiceInsight",
+			}),
 			Entry("HealthCheck", testCase{
 				inputType:    &mesh_proto.HealthCheck{},
 				expectedType: &HealthCheck{},
@@ -111,6 +121,11 @@ var _ = Describe("global TypeRegistry", func() {
 				expectedType: &MeshList{},
 				expectedKind: "MeshList",
 			}),
+			Entry("MeshInsightList", testCase{
+				inputType:    &mesh_proto.MeshInsight{},
+				expectedType: &MeshInsightList{},
+				expectedKind: "MeshInsightList",
+			}),
 			Entry("DataplaneList", testCase{
 				inputType:    &mesh_proto.Dataplane{},
 				expectedType: &DataplaneList{},
@@ -121,6 +136,11 @@ var _ = Describe("global TypeRegistry", func() {
 				expectedType: &DataplaneInsightList{},
 				expectedKind: "DataplaneInsightList",
 			}),
+			Entry("ServiceInsightList", testCase{
+				inputType:    &mesh_proto.ServiceInsight{},
+				expectedType: &ServiceInsightList{},
+				expectedKind: "ServiceInsightList",
+			}),
 			Entry("HealthCheckList", testCase{
 				inputType:    &mesh_proto.HealthCheck{},
 				expectedType: &HealthCheckList{},
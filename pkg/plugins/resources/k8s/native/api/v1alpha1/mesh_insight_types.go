@@ -0,0 +1,44 @@
+/*
+Copyright 2019 Kuma authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MeshInsightStatus defines the observed state of a Mesh
+type MeshInsightStatus = map[string]interface{}
+
+// MeshInsight is the Schema for the Mesh Insights API
+type MeshInsight struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Mesh   string            `json:"mesh,omitempty"`
+	Status MeshInsightStatus `json:"status,omitempty"`
+}
+
+// MeshInsightList contains a list of MeshInsight
+type MeshInsightList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeshInsight `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeshInsight{}, &MeshInsightList{})
+}
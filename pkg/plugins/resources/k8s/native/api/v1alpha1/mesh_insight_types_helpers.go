@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+func (pt *MeshInsight) GetObjectMeta() *metav1.ObjectMeta {
+	return &pt.ObjectMeta
+}
+
+func (pt *MeshInsight) SetObjectMeta(m *metav1.ObjectMeta) {
+	pt.ObjectMeta = *m
+}
+
+func (pt *MeshInsight) GetMesh() string {
+	return pt.Mesh
+}
+
+func (pt *MeshInsight) SetMesh(mesh string) {
+	pt.Mesh = mesh
+}
+
+func (pt *MeshInsight) GetSpec() map[string]interface{} {
+	return pt.Status
+}
+
+func (pt *MeshInsight) SetSpec(spec map[string]interface{}) {
+	pt.Status = spec
+}
+
+func (pt *MeshInsight) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *MeshInsightList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&proto.MeshInsight{}, &MeshInsight{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshInsight",
+		},
+	})
+	registry.RegisterListType(&proto.MeshInsight{}, &MeshInsightList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshInsightList",
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (cb *Role) DeepCopyInto(out *Role) {
+	*out = *cb
+	out.TypeMeta = cb.TypeMeta
+	cb.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = runtime.DeepCopyJSON(cb.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficPermission.
+func (cb *Role) DeepCopy() *Role {
+	if cb == nil {
+		return nil
+	}
+	out := new(Role)
+	cb.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (cb *Role) DeepCopyObject() runtime.Object {
+	if c := cb.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (l *RoleList) DeepCopyInto(out *RoleList) {
+	*out = *l
+	out.TypeMeta = l.TypeMeta
+	out.ListMeta = l.ListMeta
+	if l.Items != nil {
+		in, out := &l.Items, &out.Items
+		*out = make([]Role, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficPermissionList.
+func (l *RoleList) DeepCopy() *RoleList {
+	if l == nil {
+		return nil
+	}
+	out := new(RoleList)
+	l.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (l *RoleList) DeepCopyObject() runtime.Object {
+	if c := l.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
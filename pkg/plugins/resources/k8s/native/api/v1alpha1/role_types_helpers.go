@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+func (cb *Role) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *Role) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *Role) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *Role) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *Role) GetSpec() map[string]interface{} {
+	return cb.Spec
+}
+
+func (cb *Role) SetSpec(spec map[string]interface{}) {
+	cb.Spec = spec
+}
+
+func (cb *Role) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *RoleList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&proto.Role{}, &Role{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "Role",
+		},
+	})
+	registry.RegisterListType(&proto.Role{}, &RoleList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "RoleList",
+		},
+	})
+}
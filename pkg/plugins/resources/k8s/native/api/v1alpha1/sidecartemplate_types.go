@@ -0,0 +1,51 @@
+/*
+Copyright 2019 Kuma authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SidecarTemplateSpec defines the desired state of SidecarTemplate
+type SidecarTemplateSpec struct {
+	// Container is a strategic merge patch applied to the sidecar container
+	// injected into Pods of the Mesh.
+	Container map[string]interface{} `json:"container,omitempty"`
+}
+
+// SidecarTemplate is the Schema for the sidecartemplates API.
+//
+// SidecarTemplate defined in the system namespace applies to all Pods of the Mesh,
+// SidecarTemplate defined in any other namespace applies to Pods of the Mesh in that namespace.
+type SidecarTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Mesh              string `json:"mesh,omitempty"`
+
+	Spec SidecarTemplateSpec `json:"spec,omitempty"`
+}
+
+// SidecarTemplateList contains a list of SidecarTemplate
+type SidecarTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SidecarTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SidecarTemplate{}, &SidecarTemplateList{})
+}
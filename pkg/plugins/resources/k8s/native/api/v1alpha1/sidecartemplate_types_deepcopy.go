@@ -0,0 +1,65 @@
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (t *SidecarTemplate) DeepCopyInto(out *SidecarTemplate) {
+	*out = *t
+	out.TypeMeta = t.TypeMeta
+	t.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if t.Spec.Container != nil {
+		out.Spec.Container = runtime.DeepCopyJSON(t.Spec.Container)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarTemplate.
+func (t *SidecarTemplate) DeepCopy() *SidecarTemplate {
+	if t == nil {
+		return nil
+	}
+	out := new(SidecarTemplate)
+	t.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (t *SidecarTemplate) DeepCopyObject() runtime.Object {
+	if c := t.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (l *SidecarTemplateList) DeepCopyInto(out *SidecarTemplateList) {
+	*out = *l
+	out.TypeMeta = l.TypeMeta
+	out.ListMeta = l.ListMeta
+	if l.Items != nil {
+		in, out := &l.Items, &out.Items
+		*out = make([]SidecarTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarTemplateList.
+func (l *SidecarTemplateList) DeepCopy() *SidecarTemplateList {
+	if l == nil {
+		return nil
+	}
+	out := new(SidecarTemplateList)
+	l.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (l *SidecarTemplateList) DeepCopyObject() runtime.Object {
+	if c := l.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -3,12 +3,13 @@ package k8s
 import (
 	"context"
 	"fmt"
-	"strings"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
 	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_labels "k8s.io/apimachinery/pkg/labels"
 	kube_runtime "k8s.io/apimachinery/pkg/runtime"
 	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -28,16 +29,31 @@ type KubernetesStore struct {
 	Client    kube_client.Client
 	Converter Converter
 	Scheme    *kube_runtime.Scheme
+	// indexed is set when the Client reads from a cache with indexes registered by AddIndexes
+	indexed bool
 }
 
-func NewStore(client kube_client.Client, scheme *kube_runtime.Scheme) (store.ResourceStore, error) {
+func NewStore(client kube_client.Client, scheme *kube_runtime.Scheme, converter Converter) (store.ResourceStore, error) {
 	return &KubernetesStore{
 		Client:    client,
-		Converter: DefaultConverter(),
+		Converter: converter,
 		Scheme:    scheme,
 	}, nil
 }
 
+// NewIndexedStore returns a store that filters resources with indexes of the cache that the client reads from.
+func NewIndexedStore(client kube_client.Client, indexer kube_client.FieldIndexer, scheme *kube_runtime.Scheme, converter Converter) (store.ResourceStore, error) {
+	if err := AddIndexes(indexer, converter); err != nil {
+		return nil, err
+	}
+	return &KubernetesStore{
+		Client:    client,
+		Converter: converter,
+		Scheme:    scheme,
+		indexed:   true,
+	}, nil
+}
+
 func (s *KubernetesStore) Create(ctx context.Context, r core_model.Resource, fs ...store.CreateOptionsFunc) error {
 	opts := store.NewCreateOptions(fs...)
 	obj, err := s.Converter.ToKubernetesObject(r)
@@ -51,6 +67,7 @@ func (s *KubernetesStore) Create(ctx context.Context, r core_model.Resource, fs
 	obj.SetMesh(opts.Mesh)
 	obj.GetObjectMeta().SetName(name)
 	obj.GetObjectMeta().SetNamespace(namespace)
+	obj.GetObjectMeta().SetLabels(opts.Labels)
 
 	if opts.Owner != nil {
 		k8sOwner, err := s.Converter.ToKubernetesObject(opts.Owner)
@@ -99,6 +116,9 @@ func (s *KubernetesStore) Update(ctx context.Context, r core_model.Resource, fs
 	if opts.Synced {
 		markAsSynced(obj)
 	}
+	if opts.Labels != nil {
+		obj.GetObjectMeta().SetLabels(opts.Labels)
+	}
 
 	if err := s.Client.Update(ctx, obj); err != nil {
 		if kube_apierrs.IsConflict(err) {
@@ -116,7 +136,7 @@ func (s *KubernetesStore) Delete(ctx context.Context, r core_model.Resource, fs
 	opts := store.NewDeleteOptions(fs...)
 
 	// get object and validate mesh
-	if err := s.Get(ctx, r, store.GetByKey(opts.Name, opts.Mesh)); err != nil {
+	if err := s.Get(ctx, r, store.GetByKey(opts.Name, opts.Mesh), store.GetByVersion(opts.Version)); err != nil {
 		return err
 	}
 
@@ -130,10 +150,17 @@ func (s *KubernetesStore) Delete(ctx context.Context, r core_model.Resource, fs
 	}
 	obj.GetObjectMeta().SetName(name)
 	obj.GetObjectMeta().SetNamespace(namespace)
-	if err := s.Client.Delete(ctx, obj); err != nil {
+	var deleteOpts []kube_client.DeleteOption
+	if opts.Version != "" {
+		deleteOpts = append(deleteOpts, kube_client.Preconditions{ResourceVersion: &opts.Version})
+	}
+	if err := s.Client.Delete(ctx, obj, deleteOpts...); err != nil {
 		if kube_apierrs.IsNotFound(err) {
 			return nil
 		}
+		if kube_apierrs.IsConflict(err) {
+			return store.ErrorResourcePreconditionFailed(r.GetType(), opts.Name, opts.Mesh)
+		}
 		return errors.Wrap(err, "failed to delete k8s resource")
 	}
 	return nil
@@ -172,61 +199,55 @@ func (s *KubernetesStore) List(ctx context.Context, rs core_model.ResourceList,
 		return errors.Wrapf(err, "failed to convert core list model of type %s into k8s counterpart", rs.GetItemType())
 	}
 
-	var kubeOpts kube_client.ListOptions
-	if opts.PageSize > 0 {
-		kubeOpts = kube_client.ListOptions{
-			Limit:    int64(opts.PageSize),
-			Continue: opts.PageOffset,
-		}
-	}
-
-	if err := s.Client.List(ctx, obj, &kubeOpts); err != nil {
-		if strings.Contains(err.Error(), "invalid continue token") {
+	offset := 0
+	if opts.PageSize > 0 && opts.PageOffset != "" {
+		o, err := strconv.Atoi(opts.PageOffset)
+		if err != nil || o < 0 {
 			return store.ErrorInvalidOffset
 		}
-		return errors.Wrap(err, "failed to list k8s resources")
-	}
-	predicate := func(r core_model.Resource) bool {
-		if opts.Mesh != "" {
-			return r.GetMeta().GetMesh() == opts.Mesh
-		}
-		return true
-	}
-	if err := s.Converter.ToCoreList(obj, rs, predicate); err != nil {
-		return errors.Wrap(err, "failed to convert k8s model into core counterpart")
+		offset = o
 	}
 
-	total, err := s.countK8sResources(ctx, rs, opts.Mesh)
-	if err != nil {
-		return err
+	kubeOpts := kube_client.ListOptions{}
+	if len(opts.Labels) > 0 {
+		kubeOpts.LabelSelector = kube_labels.SelectorFromSet(opts.Labels)
 	}
-	rs.GetPagination().SetTotal(uint32(total))
-
-	return nil
-}
-
-func (s *KubernetesStore) countK8sResources(ctx context.Context, rs core_model.ResourceList, mesh string) (int, error) {
-	obj, err := s.Converter.ToKubernetesList(rs)
-	if err != nil {
-		return 0, errors.Wrapf(err, "failed to convert core list model of type %s into k8s counterpart", rs.GetItemType())
-	}
-
-	if err := s.Client.List(ctx, obj, &kube_client.ListOptions{}); err != nil {
-		return 0, errors.Wrap(err, "failed to list k8s resources")
+	if s.indexed {
+		kubeOpts.FieldSelector = fieldSelector(opts, rs.NewItem())
 	}
-
-	if mesh == "" {
-		return len(obj.GetItems()), nil
+	if err := s.Client.List(ctx, obj, &kubeOpts); err != nil {
+		return errors.Wrap(err, "failed to list k8s resources")
 	}
 
-	total := 0
+	// filters that selectors could not serve are applied before pagination, so pages are full and the total is exact
+	var items []core_model.Resource
 	for _, item := range obj.GetItems() {
-		if item.GetMesh() == mesh {
-			total++
+		r := rs.NewItem()
+		if err := s.Converter.ToCoreResource(item, r); err != nil {
+			return errors.Wrap(err, "failed to convert k8s model into core counterpart")
+		}
+		if opts.Mesh != "" && r.GetMeta().GetMesh() != opts.Mesh {
+			continue
+		}
+		if opts.Filter(r) {
+			items = append(items, r)
 		}
 	}
 
-	return total, nil
+	pageSize := len(items)
+	if opts.PageSize > 0 {
+		pageSize = opts.PageSize
+	}
+	for i := offset; i < offset+pageSize && i < len(items); i++ {
+		_ = rs.AddItem(items[i])
+	}
+	nextOffset := ""
+	if opts.PageSize > 0 && offset+pageSize < len(items) {
+		nextOffset = strconv.Itoa(offset + pageSize)
+	}
+	rs.GetPagination().SetNextOffset(nextOffset)
+	rs.GetPagination().SetTotal(uint32(len(items)))
+	return nil
 }
 
 func k8sNameNamespace(coreName string, scope k8s_model.Scope) (string, string, error) {
@@ -703,6 +703,50 @@ var _ = Describe("KubernetesStore", func() {
 
 		})
 
+		It("should paginate resources after filtering them", func() {
+			// setup
+			for _, route := range []struct{ name, mesh string }{
+				{"route-1", "default"},
+				{"route-2", "demo"},
+				{"other-3", "demo"},
+				{"route-4", "demo"},
+				{"route-5", "default"},
+			} {
+				backend.Create(backend.ParseYAML(fmt.Sprintf(`
+            apiVersion: sample.test.kuma.io/v1alpha1
+            kind: SampleTrafficRoute
+            mesh: %s
+            metadata:
+              namespace: %s
+              name: %s
+            spec:
+              path: /example
+`, route.mesh, ns, route.name)))
+			}
+
+			// when
+			trl := &sample_core.TrafficRouteResourceList{}
+			err := s.List(context.Background(), trl, store.ListByMesh("demo"), store.ListByNamePrefix("route-"), store.ListByPage(1, ""))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(trl.Pagination.Total).To(Equal(uint32(2)))
+			Expect(trl.Items).To(HaveLen(1))
+			Expect(trl.Items[0].Meta.GetName()).To(Equal(fmt.Sprintf("route-2.%s", ns)))
+			Expect(trl.Pagination.NextOffset).ToNot(BeEmpty())
+
+			// when
+			next := &sample_core.TrafficRouteResourceList{}
+			err = s.List(context.Background(), next, store.ListByMesh("demo"), store.ListByNamePrefix("route-"), store.ListByPage(1, trl.Pagination.NextOffset))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next.Pagination.Total).To(Equal(uint32(2)))
+			Expect(next.Items).To(HaveLen(1))
+			Expect(next.Items[0].Meta.GetName()).To(Equal(fmt.Sprintf("route-4.%s", ns)))
+			Expect(next.Pagination.NextOffset).To(BeEmpty())
+		})
+
 		It("should return a list of matching Meshes", func() {
 			// setup
 			one := backend.ParseYAML(`
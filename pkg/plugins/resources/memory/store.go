@@ -7,6 +7,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
@@ -30,6 +32,7 @@ type memoryStoreRecord struct {
 	Spec             string
 	CreationTime     time.Time
 	ModificationTime time.Time
+	Labels           map[string]string
 	Children         []*resourceKey
 }
 type memoryStoreRecords = []*memoryStoreRecord
@@ -42,6 +45,7 @@ type memoryMeta struct {
 	Version          memoryVersion
 	CreationTime     time.Time
 	ModificationTime time.Time
+	Labels           map[string]string
 }
 
 func (m memoryMeta) GetName() string {
@@ -62,6 +66,9 @@ func (m memoryMeta) GetCreationTime() time.Time {
 func (m memoryMeta) GetModificationTime() time.Time {
 	return m.ModificationTime
 }
+func (m memoryMeta) GetLabels() map[string]string {
+	return m.Labels
+}
 
 type memoryVersion uint64
 
@@ -96,7 +103,7 @@ func (c *memoryStore) Create(_ context.Context, r model.Resource, fs ...store.Cr
 	switch r.GetType() {
 	case mesh.MeshType:
 		opts.Mesh = opts.Name
-	case system.ZoneType:
+	case system.ZoneType, system.RoleType:
 		opts.Mesh = "default"
 	}
 	// Name must be provided via CreateOptions
@@ -110,6 +117,7 @@ func (c *memoryStore) Create(_ context.Context, r model.Resource, fs ...store.Cr
 		Version:          initialVersion(),
 		CreationTime:     opts.CreationTime,
 		ModificationTime: opts.CreationTime,
+		Labels:           opts.Labels,
 	}
 
 	// fill the meta
@@ -142,30 +150,41 @@ func (c *memoryStore) Update(_ context.Context, r model.Resource, fs ...store.Up
 
 	opts := store.NewUpdateOptions(fs...)
 
-	meta, ok := (r.GetMeta()).(memoryMeta)
-	if !ok {
-		return fmt.Errorf("MemoryStore.Update() requires r.GetMeta() to be of type memoryMeta")
+	// version is taken from any kind of meta, the same way as in other stores
+	version, err := strconv.ParseUint(r.GetMeta().GetVersion(), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert meta version to int")
 	}
 
 	// Name must be provided via r.GetMeta()
 	mesh := r.GetMeta().GetMesh()
 	idx, record := c.findRecord(string(r.GetType()), r.GetMeta().GetName(), mesh)
-	if record == nil || meta.Version != record.Version {
+	if record == nil || memoryVersion(version) != record.Version {
 		return store.ErrorResourceConflict(r.GetType(), r.GetMeta().GetName(), r.GetMeta().GetMesh())
 	}
-	meta.Version = meta.Version.Next()
-	meta.ModificationTime = opts.ModificationTime
+	meta := memoryMeta{
+		Name:             record.Name,
+		Mesh:             record.Mesh,
+		Version:          record.Version.Next(),
+		CreationTime:     record.CreationTime,
+		ModificationTime: opts.ModificationTime,
+		Labels:           record.Labels,
+	}
+	if opts.Labels != nil {
+		meta.Labels = opts.Labels
+	}
 
-	record, err := c.marshalRecord(
+	newRecord, err := c.marshalRecord(
 		string(r.GetType()),
 		meta,
 		r.GetSpec())
 	if err != nil {
 		return err
 	}
+	newRecord.Children = record.Children
 
 	// persist
-	c.records[idx] = record
+	c.records[idx] = newRecord
 
 	r.SetMeta(meta)
 	return nil
@@ -189,6 +208,9 @@ func (c *memoryStore) delete(ctx context.Context, r model.Resource, fs ...store.
 	if record == nil {
 		return store.ErrorResourceNotFound(r.GetType(), opts.Name, opts.Mesh)
 	}
+	if opts.Version != "" && opts.Version != record.Version.String() {
+		return store.ErrorResourcePreconditionFailed(r.GetType(), opts.Name, opts.Mesh)
+	}
 	for _, child := range record.Children {
 		_, childRecord := c.findRecord(child.ResourceType, child.Name, child.Mesh)
 		if childRecord == nil {
@@ -217,7 +239,7 @@ func (c *memoryStore) Get(_ context.Context, r model.Resource, fs ...store.GetOp
 	switch r.GetType() {
 	case mesh.MeshType:
 		opts.Mesh = opts.Name
-	case system.ZoneType:
+	case system.ZoneType, system.RoleType:
 		opts.Mesh = "default"
 	}
 	// Name must be provided via GetOptions
@@ -236,7 +258,16 @@ func (c *memoryStore) List(_ context.Context, rs model.ResourceList, fs ...store
 
 	opts := store.NewListOptions(fs...)
 
-	records := c.findRecords(string(rs.GetItemType()), opts.Mesh)
+	var records []model.Resource
+	for _, record := range c.findRecords(string(rs.GetItemType()), opts.Mesh) {
+		r := rs.NewItem()
+		if err := c.unmarshalRecord(record, r); err != nil {
+			return err
+		}
+		if opts.Filter(r) {
+			records = append(records, r)
+		}
+	}
 
 	offset := 0
 	pageSize := len(records)
@@ -253,11 +284,7 @@ func (c *memoryStore) List(_ context.Context, rs model.ResourceList, fs ...store
 	}
 
 	for i := offset; i < offset+pageSize && i < len(records); i++ {
-		r := rs.NewItem()
-		if err := c.unmarshalRecord(records[i], r); err != nil {
-			return err
-		}
-		_ = rs.AddItem(r)
+		_ = rs.AddItem(records[i])
 	}
 
 	if paginateResults {
@@ -314,6 +341,7 @@ func (c *memoryStore) marshalRecord(resourceType string, meta memoryMeta, spec m
 		Spec:             string(content),
 		CreationTime:     meta.CreationTime,
 		ModificationTime: meta.ModificationTime,
+		Labels:           meta.Labels,
 	}, nil
 }
 
@@ -324,6 +352,7 @@ func (c *memoryStore) unmarshalRecord(s *memoryStoreRecord, r model.Resource) er
 		Version:          s.Version,
 		CreationTime:     s.CreationTime,
 		ModificationTime: s.ModificationTime,
+		Labels:           s.Labels,
 	})
 	return util_proto.FromJSON([]byte(s.Spec), r.GetSpec())
 }
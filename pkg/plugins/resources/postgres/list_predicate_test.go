@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+var _ = Describe("listPredicate", func() {
+	It("should filter only by type and mesh without filters", func() {
+		// when
+		predicate, args := listPredicate("Dataplane", store.NewListOptions(store.ListByMesh("default")))
+
+		// then
+		Expect(predicate).To(Equal("type=$1 AND mesh=$2"))
+		Expect(args).To(Equal([]interface{}{"Dataplane", "default"}))
+	})
+
+	It("should build predicate with all filters", func() {
+		// given
+		since := time.Date(2020, 1, 2, 15, 4, 5, 0, time.UTC)
+		opts := store.NewListOptions(
+			store.ListByMesh("default"),
+			store.ListByNamePrefix("web_%"),
+			store.ListModifiedSince(since),
+			store.ListByTags(
+				store.TagFilter{Key: "kuma.io/service", Values: []string{"web"}},
+				store.TagFilter{Key: "version", Values: []string{"v1", "v2"}, Negate: true},
+				store.TagFilter{Key: "region"},
+			),
+		)
+
+		// when
+		predicate, args := listPredicate("Dataplane", opts)
+
+		// then
+		Expect(predicate).To(Equal(`type=$1 AND mesh=$2 AND name LIKE $3 ESCAPE '\' AND modification_time > $4` +
+			` AND EXISTS (SELECT 1 FROM jsonb_array_elements(` + dataplaneTagSets + `) AS tag_set WHERE` +
+			` (tag_set->'tags'->>$5) IN ($6)` +
+			` AND ((tag_set->'tags'->>$7) IS NULL OR NOT (tag_set->'tags'->>$7) IN ($8, $9))` +
+			` AND (tag_set->'tags'->>$10) IS NOT NULL)`))
+		Expect(args).To(Equal([]interface{}{
+			"Dataplane", "default", `web\_\%%`, since, "kuma.io/service", "web", "version", "v1", "v2", "region",
+		}))
+	})
+
+	It("should filter by labels", func() {
+		// when
+		predicate, args := listPredicate("TrafficRoute", store.NewListOptions(store.ListByLabels(map[string]string{"team": "web"})))
+
+		// then
+		Expect(predicate).To(Equal("type=$1 AND labels @> $2::jsonb"))
+		Expect(args).To(Equal([]interface{}{"TrafficRoute", `{"team":"web"}`}))
+	})
+})
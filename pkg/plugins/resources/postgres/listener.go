@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	common_postgres "github.com/kumahq/kuma/pkg/plugins/common/postgres"
+)
+
+var listenerLog = core.Log.WithName("plugin").WithName("resources").WithName("postgres").WithName("listener")
+
+// resourceChangesChannel is a channel to which the trigger on resources table publishes changes, see migrations.
+const resourceChangesChannel = "resource_changes"
+
+// pingInterval is an interval of checking whether the connection of the listener is still alive.
+const pingInterval = 30 * time.Second
+
+type resourceChangeNotification struct {
+	Operation string `json:"operation"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Mesh      string `json:"mesh"`
+}
+
+var operations = map[string]store.ChangeOperation{
+	"INSERT": store.CreateOperation,
+	"UPDATE": store.UpdateOperation,
+	"DELETE": store.DeleteOperation,
+}
+
+func (r *postgresResourceStore) Listen(stop <-chan struct{}, handler store.ChangeHandler) error {
+	connStr, err := common_postgres.ConnectionString(r.cfg)
+	if err != nil {
+		return err
+	}
+	listener := pq.NewListener(connStr, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			listenerLog.Error(err, "connection to the database failed")
+		}
+	})
+	defer listener.Close()
+	if err := listener.Listen(resourceChangesChannel); err != nil {
+		return errors.Wrapf(err, "could not listen on %s channel", resourceChangesChannel)
+	}
+	listenerLog.Info("listening for changes of resources")
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			listenerLog.Info("stopping")
+			return nil
+		case <-ticker.C:
+			// the connection is reestablished by the listener, ping only detects that it was lost
+			if err := listener.Ping(); err != nil {
+				listenerLog.Error(err, "connection to the database is lost")
+			}
+		case notification := <-listener.Notify:
+			// nil notification means that the connection was reestablished and some notifications could have been missed
+			if notification == nil {
+				handler.OnResync()
+				continue
+			}
+			event, err := toResourceChangedEvent(notification.Extra)
+			if err != nil {
+				listenerLog.Error(err, "could not parse the notification", "payload", notification.Extra)
+				handler.OnResync()
+				continue
+			}
+			handler.OnChange(event)
+		}
+	}
+}
+
+func toResourceChangedEvent(payload string) (store.ResourceChangedEvent, error) {
+	notification := resourceChangeNotification{}
+	if err := json.Unmarshal([]byte(payload), &notification); err != nil {
+		return store.ResourceChangedEvent{}, err
+	}
+	operation, ok := operations[notification.Operation]
+	if !ok {
+		return store.ResourceChangedEvent{}, errors.Errorf("unknown operation %q", notification.Operation)
+	}
+	return store.ResourceChangedEvent{
+		Operation: operation,
+		Type:      model.ResourceType(notification.Type),
+		Name:      notification.Name,
+		Mesh:      notification.Mesh,
+	}, nil
+}
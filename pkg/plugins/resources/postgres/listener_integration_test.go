@@ -0,0 +1,77 @@
+// +build integration
+
+package postgres
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/config"
+	"github.com/kumahq/kuma/pkg/config/plugins/resources/postgres"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	common_postgres "github.com/kumahq/kuma/pkg/plugins/common/postgres"
+	sample_proto "github.com/kumahq/kuma/pkg/test/apis/sample/v1alpha1"
+	sample_model "github.com/kumahq/kuma/pkg/test/resources/apis/sample"
+)
+
+type recordingHandler struct {
+	sync.Mutex
+	events []store.ResourceChangedEvent
+}
+
+func (r *recordingHandler) OnChange(event store.ResourceChangedEvent) {
+	r.Lock()
+	defer r.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *recordingHandler) OnResync() {
+}
+
+func (r *recordingHandler) Events() []store.ResourceChangedEvent {
+	r.Lock()
+	defer r.Unlock()
+	return append([]store.ResourceChangedEvent{}, r.events...)
+}
+
+var _ = Describe("Listen", func() {
+	It("should notify about changes of resources", func() {
+		// given
+		cfg := postgres.PostgresStoreConfig{}
+		Expect(config.Load("", &cfg)).To(Succeed())
+		dbName, err := common_postgres.CreateRandomDb(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		cfg.DbName = dbName
+		_, err = migrateDb(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		s, err := NewStore(cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		handler := &recordingHandler{}
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			defer GinkgoRecover()
+			Expect(s.(store.ChangeNotifier).Listen(stop, handler)).To(Succeed())
+		}()
+		// wait until the listener is connected
+		time.Sleep(time.Second)
+
+		// when
+		res := &sample_model.TrafficRouteResource{Spec: sample_proto.TrafficRoute{Path: "demo"}}
+		Expect(s.Create(context.Background(), res, store.CreateByKey("route-1", "default"))).To(Succeed())
+		Expect(s.Update(context.Background(), res)).To(Succeed())
+		Expect(s.Delete(context.Background(), res, store.DeleteByKey("route-1", "default"))).To(Succeed())
+
+		// then
+		Eventually(handler.Events, "5s").Should(Equal([]store.ResourceChangedEvent{
+			{Operation: store.CreateOperation, Type: sample_model.TrafficRouteType, Name: "route-1", Mesh: "default"},
+			{Operation: store.UpdateOperation, Type: sample_model.TrafficRouteType, Name: "route-1", Mesh: "default"},
+			{Operation: store.DeleteOperation, Type: sample_model.TrafficRouteType, Name: "route-1", Mesh: "default"},
+		}))
+	})
+})
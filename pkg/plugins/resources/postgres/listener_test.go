@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+var _ = Describe("toResourceChangedEvent", func() {
+	It("should convert the notification of the trigger", func() {
+		// when
+		event, err := toResourceChangedEvent(`{"operation" : "UPDATE", "type" : "Dataplane", "name" : "dp-1", "mesh" : "default"}`)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event).To(Equal(store.ResourceChangedEvent{
+			Operation: store.UpdateOperation,
+			Type:      "Dataplane",
+			Name:      "dp-1",
+			Mesh:      "default",
+		}))
+	})
+
+	It("should return an error on unknown operation", func() {
+		// when
+		_, err := toResourceChangedEvent(`{"operation" : "TRUNCATE", "type" : "Dataplane", "name" : "dp-1", "mesh" : "default"}`)
+
+		// then
+		Expect(err).To(MatchError(`unknown operation "TRUNCATE"`))
+	})
+})
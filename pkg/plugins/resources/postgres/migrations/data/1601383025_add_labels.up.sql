@@ -0,0 +1,2 @@
+ALTER TABLE resources
+    ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,22 @@
+CREATE OR REPLACE FUNCTION notify_resource_change() RETURNS TRIGGER AS $$
+DECLARE
+    changed RECORD;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := OLD;
+    ELSE
+        changed := NEW;
+    END IF;
+    PERFORM pg_notify('resource_changes', json_build_object(
+        'operation', TG_OP,
+        'type', changed.type,
+        'name', changed.name,
+        'mesh', changed.mesh
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER resource_changes
+    AFTER INSERT OR UPDATE OR DELETE ON resources
+    FOR EACH ROW EXECUTE PROCEDURE notify_resource_change();
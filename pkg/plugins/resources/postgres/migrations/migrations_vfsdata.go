@@ -21,35 +21,47 @@ var Migrations = func() http.FileSystem {
 	fs := vfsgen۰FS{
 		"/": &vfsgen۰DirInfo{
 			name:    "/",
-			modTime: time.Date(2020, 6, 15, 14, 49, 38, 390867092, time.UTC),
+			modTime: time.Date(2026, 10, 19, 8, 31, 32, 35556100, time.UTC),
 		},
 		"/1579518998_create_resources.up.sql": &vfsgen۰CompressedFileInfo{
 			name:             "1579518998_create_resources.up.sql",
 			modTime:          time.Date(2020, 4, 2, 12, 24, 0, 540178165, time.UTC),
 			uncompressedSize: 299,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8e\x4f\x0b\x82\x40\x10\x47\xef\x7e\x8a\xdf\x51\x61\x0f\x76\xee\x64\xb1\x81\x64\x16\xba\x41\x1e\x97\x65\x48\x0f\xea\xb2\xb3\x49\x7d\xfb\x68\xfb\x07\x1d\xc2\x39\x0e\x6f\xde\xbc\x75\x25\x33\x25\xa1\xb2\x55\x21\x91\x6f\x50\xee\x15\xe4\x29\xaf\x55\x0d\x47\x3c\x5e\x9c\x21\x46\x1c\x01\xc0\xa0\x7b\xc2\x6b\x26\xed\x4c\xab\x5d\xbc\x48\xd3\x24\xdc\x94\xc7\xa2\x10\x1f\x8c\xad\x36\xf4\x1f\xeb\x89\xdb\x19\x36\x7f\xb3\x73\x9e\x4e\xe4\xb8\x1b\x87\x80\x75\x83\xa7\x33\xb9\x1f\x82\x2d\x99\xb7\xc8\xd3\xd5\x3f\xb7\x87\x2a\xdf\x65\x55\x83\xad\x6c\x10\x3f\xca\xc5\xb7\x5f\x84\x46\x11\x12\x92\x28\x59\xde\x03\x00\x00\xff\xff\x88\x1c\x8d\x52\x2b\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x8e\x3d\xcb\x83\x40\x10\x84\x7b\x7f\xc5\x94\x0a\x57\xf8\xd6\x6f\x65\xc2\x05\x24\xc6\x04\xbd\x40\x2c\x8f\x63\x89\x16\x7e\xb0\x7b\x91\xe4\xdf\x07\xcd\x17\xa4\x08\x4e\xb9\x3c\x3b\xf3\xac\x0b\x9d\x18\x0d\x93\xac\x32\x8d\x74\x83\x7c\x6f\xa0\x4f\x69\x69\x4a\x30\x49\x7f\x61\x47\x82\x30\x00\x80\xce\xb6\x84\x67\x46\xcb\xae\xb6\x1c\xfe\xc5\x71\x34\xff\xe4\xc7\x2c\x53\x6f\x4c\x06\xeb\xe8\x37\xd6\x92\xd4\x0b\xda\xfc\x6d\x58\x32\x3a\x12\x4b\xd3\x77\x98\xd2\x74\x9e\xce\xc4\x5f\x84\x0c\xe4\x5e\x45\x9e\xae\xfe\x71\x3d\x14\xe9\x2e\x29\x2a\x6c\x75\x85\x70\x32\x57\x1f\x7f\x35\x3b\xaa\x59\x21\x0a\xa2\xff\xfb\x00\x88\x1c\x8d\x52\x2b\x01\x00\x00"),
 		},
 		"/1580128050_add_creation_modification_time.up.sql": &vfsgen۰CompressedFileInfo{
 			name:             "1580128050_add_creation_modification_time.up.sql",
 			modTime:          time.Date(2020, 4, 2, 12, 24, 0, 540282811, time.UTC),
 			uncompressedSize: 165,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4a\x2d\xce\x2f\x2d\x4a\x4e\x2d\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\x2e\x4a\x4d\x2c\xc9\xcc\xcf\x8b\x2f\xc9\xcc\x4d\x55\x08\xf1\xf4\x75\x0d\x0e\x71\xf4\x0d\x50\xf0\xf3\x0f\x51\xf0\x0b\xf5\xf1\x51\x70\x71\x75\x73\x0c\xf5\x09\x51\xc8\xcb\x2f\xd7\xd0\xb4\xe6\x22\x68\x60\x6e\x7e\x4a\x66\x5a\x66\x32\x29\x86\x02\x02\x00\x00\xff\xff\x56\x69\x01\xb8\xa5\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4a\x2d\xce\x2f\x2d\x4a\x4e\x2d\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\x2e\x4a\x4d\x2c\xc9\xcc\xcf\x8b\x2f\xc9\xcc\x4d\x55\x08\xf1\xf4\x75\x0d\x0e\x71\xf4\x0d\x50\xf0\xf3\x0f\x51\xf0\x0b\xf5\xf1\x51\x70\x71\x75\x73\x0c\xf5\x09\x51\xc8\xcb\x2f\xd7\xd0\xb4\xe6\x22\x68\x60\x6e\x7e\x4a\x66\x5a\x66\x32\x29\x86\x02\x06\x00\x56\x69\x01\xb8\xa5\x00\x00\x00"),
 		},
 		"/1589041445_add_unique_id_and_owner.up.sql": &vfsgen۰CompressedFileInfo{
 			name:             "1589041445_add_unique_id_and_owner.up.sql",
 			modTime:          time.Date(2020, 5, 12, 16, 39, 56, 292245031, time.UTC),
 			uncompressedSize: 973,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x91\xc1\x6e\xdb\x30\x10\x44\xef\xfc\x8a\xe9\xc9\x31\x20\x17\x71\xae\x46\x0e\xac\x49\xb7\x42\x25\xca\xa0\x64\x14\x39\x05\x84\xb2\xb1\x8c\x28\x92\x40\x2a\x4d\xf3\xf7\x85\x28\xa5\x66\x52\xd7\x45\x7b\xdd\xe5\xec\xbc\x19\xf2\xa4\x90\x1a\x05\xff\x94\x48\x58\x72\xed\x93\x2d\xc9\x31\x00\x10\x3a\xdb\x62\x9d\xa9\xbc\xd0\x3c\x56\xc5\x71\x7b\xdb\x3d\xd0\x4b\xe4\xdf\x70\x21\xfe\xfc\x04\x5b\x1d\xa7\x5c\xdf\xe0\xab\xbc\xc1\x45\x63\x1e\x29\xc2\x23\xb9\x2a\x42\xff\xd2\xd1\x7c\xc5\xfe\xea\x9d\xec\x52\x85\x41\xe8\x3a\x53\xd2\x39\xc1\x08\xe2\xdf\xb7\xcf\x0d\xd9\xdb\x41\x85\xef\xc6\x96\x95\xb1\x17\xcb\xcb\xcb\xb3\x76\xbf\xa9\x07\xcc\xff\x57\x0f\xf1\xfe\x51\xfd\xab\xc2\xf1\xc2\xfd\x03\x36\x99\x96\xf1\x67\x35\x96\x77\xcc\x14\x05\x84\x51\xe0\x37\x87\x96\x1b\xa9\xa5\x5a\xcb\xfc\xe8\x70\xa2\x76\x64\x0a\x42\x26\xb2\x90\x58\xf3\x7c\xcd\x85\x5c\x31\x36\x0e\xd8\x46\x67\x69\x80\xf7\xed\x8b\xd4\xd2\xab\x70\x8d\x99\x30\xbd\xe9\x6a\xd3\x50\xdc\xb8\xc3\xbe\xea\x67\x2b\xc6\x16\x0b\x38\xea\x47\x0c\xdc\xb7\x16\xa6\xae\x03\x77\xfa\x51\x52\xd7\x23\x25\x57\xb1\xdd\x56\xf0\x22\x08\x0f\xbb\x64\xb9\x2c\xc2\xef\xba\x86\xbd\xfa\xe8\x81\x7d\x33\xc1\x57\xf8\x8d\x0f\x11\x6c\x5e\xc1\x86\xf3\xb3\x77\xec\xb0\x57\x13\xfe\x74\x72\x38\xb1\xf4\x27\x18\xc0\x95\x18\xe6\x6f\x0f\x4c\xe3\xe5\x38\xfe\xf0\x3a\xf7\x29\x17\xb8\xa3\x9a\x7a\xc2\x9d\x69\xf6\xf5\xa1\xd9\x87\x15\xb7\x0d\x39\x3c\x1f\xfa\xaa\x7d\x9a\xaa\x98\x9f\x2d\x34\x48\x1c\xe7\x50\xbb\x24\x99\xbc\x83\xc0\xa7\x16\x9e\xeb\xed\xe2\x1d\xea\xcf\x00\x00\x00\xff\xff\x17\x5c\x38\xd4\xcd\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x91\x51\x4f\xdb\x30\x14\x85\xdf\xfd\x2b\xce\x9e\x4a\xa5\x74\xa2\xbc\x56\x3c\x78\xb1\xbb\x45\x4b\x9c\xca\x49\x35\xf1\x84\xac\x70\x69\x2a\x42\x12\xd9\x61\x8c\x7f\x3f\xc5\x09\xab\x41\x8c\x89\xbd\xde\xeb\x73\xee\x77\x8e\x79\x5a\x4a\x8d\x92\x7f\x49\x25\x2c\xb9\xee\xc1\x56\xe4\x18\x00\x08\x9d\xef\x10\xe7\xaa\x28\x35\x4f\x54\x79\xda\x5e\xf7\x77\xf4\x14\xf9\x37\x5c\x88\xbf\x3f\xc1\x4e\x27\x19\xd7\x57\xf8\x2e\xaf\x70\xd6\x9a\x7b\x8a\x70\x4f\xae\x8e\x30\x3c\xf5\xb4\xdc\xb0\x7f\xde\x4e\xf7\x99\xc2\x28\x74\xbd\xa9\xe8\x3d\xc1\x04\xe2\xdf\x77\x8f\x2d\xd9\xeb\x51\x85\x9f\xc6\x56\xb5\xb1\x67\xeb\xf3\xf3\xe5\x87\xd4\x23\xe6\xff\xab\xc7\x78\x1f\x54\xff\xa9\x70\x72\xb8\xbd\xc3\x36\xd7\x32\xf9\xaa\xa6\xf2\x4e\x99\xa2\x80\x30\x0a\xee\x2d\xa1\xe5\x56\x6a\xa9\x62\x59\x9c\x2e\xbc\x51\x3b\x72\x05\x21\x53\x59\x4a\xc4\xbc\x88\xb9\x90\x1b\xc6\xa6\x01\xdb\xea\x3c\x0b\xf0\x7e\x7c\x93\x5a\x7a\x15\x2e\xb1\x10\x66\x30\x7d\x63\x5a\x4a\x5a\x77\x3c\xd4\xc3\x62\xc3\xd8\x6a\x05\x47\xc3\x84\x81\xdb\xce\xc2\x34\x4d\x70\x9d\x7e\x55\xd4\x0f\xc8\xc8\xd5\x6c\xbf\x13\xbc\x0c\xc2\xc3\xae\x59\x21\xcb\xf0\xbb\x2e\x61\x2f\x3e\x7b\x60\xdf\x4c\xf0\x15\x7e\xe3\x43\x04\x9b\x67\xb0\xd1\x7e\xf1\x8a\x1d\xf6\x62\xc6\x9f\x2d\x47\x8b\xb5\xb7\x60\x00\x57\x62\x9c\xbf\x34\x98\xc7\xeb\x69\xfc\xe9\x79\xee\x53\xae\x70\x43\x0d\x0d\x84\x1b\xd3\x1e\x9a\x63\x7b\x08\x2b\xee\x5a\x72\x78\x3c\x0e\x75\xf7\x30\x57\xb1\x7c\xb7\xd0\x20\x71\x52\x40\xed\xd3\x74\xbe\x1d\x04\x7e\x6b\xe1\xb9\x5e\x2e\x5e\xa1\xfe\x1e\x00\x17\x5c\x38\xd4\xcd\x03\x00\x00"),
 		},
 		"/1592232449_add_leader_table.up.sql": &vfsgen۰CompressedFileInfo{
 			name:             "1592232449_add_leader_table.up.sql",
 			modTime:          time.Date(2020, 6, 15, 14, 49, 38, 390783584, time.UTC),
 			uncompressedSize: 217,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\x31\xab\xc2\x30\x1c\xc4\xf7\x7c\x8a\x1b\x5b\x78\xbc\xe1\x41\xa7\x4e\x49\xde\x9f\x1a\xd4\xa8\x31\x2a\x99\x4a\x6d\x33\x88\x36\x81\x54\xeb\xd7\x17\x6c\x47\x1d\xef\xf8\x71\xf7\x93\x86\xb8\x25\x58\x2e\x56\x84\x5b\x6c\xaf\x03\x32\x06\x00\xa1\xe9\x3d\xe4\x82\x1b\x2e\x2d\x19\x1c\xb9\x71\x4a\x57\xd9\x5f\x51\xe4\xd8\x1a\xb5\xe6\xc6\x61\x49\xee\xe7\x0d\x27\xdf\xc6\xd4\xd5\xa3\x4f\xc3\x25\x86\x3a\x3c\xfa\xb3\x4f\x10\xaa\x52\xda\x4e\x44\xd7\xdc\x1b\x08\x67\x89\x4f\x39\x3e\x83\x4f\x5f\xf6\x59\x5e\x32\x36\x8b\xed\x69\x77\x20\x2d\x67\xb7\x3a\x8d\x01\x9b\x93\xa6\x7f\x08\x37\x55\xbf\x1f\xbf\x4b\xf6\x0a\x00\x00\xff\xff\x9b\x4e\x1b\xbc\xd9\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x8e\x31\xab\xc2\x30\x1c\xc4\xf7\x7c\x8a\x1b\x5b\x78\xbc\xe1\x41\xa7\x4e\x49\xde\x9f\x1a\xd4\xa8\x31\x2a\x99\x4a\x6d\x33\x88\x36\x81\x54\xeb\xd7\x17\x6c\x47\x1d\xef\xf8\x71\xf7\x93\x86\xb8\x25\x58\x2e\x56\x84\x5b\x6c\xaf\x03\x32\x06\x00\xa1\xe9\x3d\xe4\x82\x1b\x2e\x2d\x19\x1c\xb9\x71\x4a\x57\xd9\x5f\x51\xe4\xd8\x1a\xb5\xe6\xc6\x61\x49\xee\xe7\x0d\x27\xdf\xc6\xd4\xd5\xa3\x4f\xc3\x25\x86\x3a\x3c\xfa\xb3\x4f\x10\xaa\x52\xda\x4e\x44\xd7\xdc\x1b\x08\x67\x89\x4f\x39\x3e\x83\x4f\x5f\xf6\x59\x5e\x32\x36\x8b\xed\x69\x77\x20\x2d\x67\xb7\x3a\x8d\x01\x9b\x93\xa6\x7f\x08\x37\x55\xbf\x1f\xbf\x4b\xf6\x1a\x00\x9b\x4e\x1b\xbc\xd9\x00\x00\x00"),
+		},
+		"/1601383025_add_labels.up.sql": &vfsgen۰FileInfo{
+			name:    "1601383025_add_labels.up.sql",
+			modTime: time.Date(2026, 10, 19, 7, 15, 17, 617805811, time.UTC),
+			content: []byte("\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x73\x0a\x20\x20\x20\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x6c\x61\x62\x65\x6c\x73\x20\x4a\x53\x4f\x4e\x42\x20\x4e\x4f\x54\x20\x4e\x55\x4c\x4c\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x27\x7b\x7d\x27\x3b\x0a"),
+		},
+		"/1603180800_notify_resource_changes.up.sql": &vfsgen۰CompressedFileInfo{
+			name:             "1603180800_notify_resource_changes.up.sql",
+			modTime:          time.Date(2026, 10, 19, 8, 31, 32, 39556100, time.UTC),
+			uncompressedSize: 585,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x92\xcb\xce\x9b\x30\x10\x85\xf7\x7e\x8a\x59\x20\x91\x48\xa8\x0f\x10\x94\x85\x6b\x0f\x04\xc9\xb5\xd1\x60\x94\xee\x50\x2e\x6e\x2e\x4a\x80\x06\x22\x35\x6f\x5f\x81\x93\x14\x45\xfd\x77\x0c\xdf\x8c\x7d\xce\x1c\x0b\x42\x6e\x11\x0c\x01\x61\xae\xb8\x40\x48\x4a\x2d\x6c\x66\x34\xd4\x4d\x7f\xfa\xf5\xa8\x6e\xae\x6b\xee\xb7\x9d\xab\x76\xc7\x4d\x7d\x70\xb3\x39\x10\xda\x92\x74\x01\x96\xb2\x34\x45\x02\x5e\x40\x10\x30\x89\x42\x71\x42\x06\x00\xe0\x5b\xf7\x40\x28\x0c\xc9\x98\x7d\xc7\x34\xd3\x23\xc9\x12\xb0\x69\x65\x72\x58\x42\x28\x51\xa1\xc5\x10\xec\x0a\x3d\x9c\x8e\x2e\x96\x60\x94\x8c\xc7\xff\xa8\x0a\xfc\x5f\x83\xc6\xf5\xb3\x41\x4b\xc8\x12\xff\x9d\x23\x25\x86\x7e\x40\x7b\xa8\xbc\x83\x59\xf8\x61\xa1\x0b\x23\x38\x77\x4d\x5d\x6d\xef\xa7\xcb\xbe\x6a\xb6\x67\xb7\xeb\x67\xef\x0b\xc2\xa6\x75\xb7\x4d\x7f\x6a\xea\x30\xf2\x62\xa3\x7f\xac\x7f\xb4\x2e\x8c\x5e\x22\xbe\x0d\xe5\x84\xd6\x9b\xeb\x94\x0e\xe5\x84\x5e\x5d\x77\x9c\xd0\xa1\x1c\xe1\x7c\xb1\xe8\xdd\x9f\x7e\xee\xf5\xfb\xed\x82\x2e\x95\x8a\x19\x6a\x19\xb3\x20\x00\xc5\x75\x5a\xf2\x14\xa1\xbd\xb4\x87\xee\xf7\x25\x66\xec\x99\xdc\x2b\x84\x4f\x8f\xe3\x59\x3c\xb1\x48\x90\xe9\x02\xc9\x0e\x19\x97\xb9\x7c\xa6\xed\x77\x0f\x46\xbf\x07\xfd\x44\x62\x08\x90\x8b\x15\x90\x59\x03\xfe\x44\x51\x5a\x84\x9c\x8c\x40\x59\x12\x7e\xf9\x26\x62\xf6\x77\x00\x69\x95\x1c\x67\x49\x02\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -57,6 +69,8 @@ var Migrations = func() http.FileSystem {
 		fs["/1580128050_add_creation_modification_time.up.sql"].(os.FileInfo),
 		fs["/1589041445_add_unique_id_and_owner.up.sql"].(os.FileInfo),
 		fs["/1592232449_add_leader_table.up.sql"].(os.FileInfo),
+		fs["/1601383025_add_labels.up.sql"].(os.FileInfo),
+		fs["/1603180800_notify_resource_changes.up.sql"].(os.FileInfo),
 	}
 
 	return fs
@@ -82,6 +96,11 @@ func (fs vfsgen۰FS) Open(path string) (http.File, error) {
 			vfsgen۰CompressedFileInfo: f,
 			gr:                        gr,
 		}, nil
+	case *vfsgen۰FileInfo:
+		return &vfsgen۰File{
+			vfsgen۰FileInfo: f,
+			Reader:          bytes.NewReader(f.content),
+		}, nil
 	case *vfsgen۰DirInfo:
 		return &vfsgen۰Dir{
 			vfsgen۰DirInfo: f,
@@ -163,6 +182,37 @@ func (f *vfsgen۰CompressedFile) Close() error {
 	return f.gr.Close()
 }
 
+// vfsgen۰FileInfo is a static definition of an uncompressed file (because it's not worth gzip compressing).
+type vfsgen۰FileInfo struct {
+	name    string
+	modTime time.Time
+	content []byte
+}
+
+func (f *vfsgen۰FileInfo) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
+}
+func (f *vfsgen۰FileInfo) Stat() (os.FileInfo, error) { return f, nil }
+
+func (f *vfsgen۰FileInfo) NotWorthGzipCompressing() {}
+
+func (f *vfsgen۰FileInfo) Name() string       { return f.name }
+func (f *vfsgen۰FileInfo) Size() int64        { return int64(len(f.content)) }
+func (f *vfsgen۰FileInfo) Mode() os.FileMode  { return 0444 }
+func (f *vfsgen۰FileInfo) ModTime() time.Time { return f.modTime }
+func (f *vfsgen۰FileInfo) IsDir() bool        { return false }
+func (f *vfsgen۰FileInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰File is an opened file instance.
+type vfsgen۰File struct {
+	*vfsgen۰FileInfo
+	*bytes.Reader
+}
+
+func (f *vfsgen۰File) Close() error {
+	return nil
+}
+
 // vfsgen۰DirInfo is a static definition of a directory.
 type vfsgen۰DirInfo struct {
 	name    string
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -22,10 +23,12 @@ import (
 const duplicateKeyErrorMsg = "duplicate key value violates unique constraint"
 
 type postgresResourceStore struct {
-	db *sql.DB
+	db  *sql.DB
+	cfg config.PostgresStoreConfig
 }
 
 var _ store.ResourceStore = &postgresResourceStore{}
+var _ store.ChangeNotifier = &postgresResourceStore{}
 
 func NewStore(config config.PostgresStoreConfig) (store.ResourceStore, error) {
 	db, err := common_postgres.ConnectToDb(config)
@@ -34,7 +37,8 @@ func NewStore(config config.PostgresStoreConfig) (store.ResourceStore, error) {
 	}
 
 	return &postgresResourceStore{
-		db: db,
+		db:  db,
+		cfg: config,
 	}, nil
 }
 
@@ -57,10 +61,15 @@ func (r *postgresResourceStore) Create(_ context.Context, resource model.Resourc
 		ownerType = ptr(string(opts.Owner.GetType()))
 	}
 
+	labels, err := labelsToJSON(opts.Labels)
+	if err != nil {
+		return err
+	}
+
 	version := 0
-	statement := `INSERT INTO resources VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);`
+	statement := `INSERT INTO resources VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);`
 	_, err = r.db.Exec(statement, opts.Name, opts.Mesh, resource.GetType(), version, string(bytes),
-		opts.CreationTime, opts.CreationTime, ownerName, ownerMesh, ownerType)
+		opts.CreationTime, opts.CreationTime, ownerName, ownerMesh, ownerType, labels)
 	if err != nil {
 		if strings.Contains(err.Error(), duplicateKeyErrorMsg) {
 			return store.ErrorResourceAlreadyExists(resource.GetType(), opts.Name, opts.Mesh)
@@ -74,6 +83,7 @@ func (r *postgresResourceStore) Create(_ context.Context, resource model.Resourc
 		Version:          strconv.Itoa(version),
 		CreationTime:     opts.CreationTime,
 		ModificationTime: opts.CreationTime,
+		Labels:           opts.Labels,
 	})
 	return nil
 }
@@ -91,7 +101,17 @@ func (r *postgresResourceStore) Update(_ context.Context, resource model.Resourc
 	if err != nil {
 		return errors.Wrap(err, "failed to convert meta version to int")
 	}
-	statement := `UPDATE resources SET spec=$1, version=$2, modification_time=$3 WHERE name=$4 AND mesh=$5 AND type=$6 AND version=$7;`
+	labels := resource.GetMeta().GetLabels()
+	var newLabels *string // labels are preserved when not changed explicitly
+	if opts.Labels != nil {
+		labels = opts.Labels
+		value, err := labelsToJSON(opts.Labels)
+		if err != nil {
+			return err
+		}
+		newLabels = &value
+	}
+	statement := `UPDATE resources SET spec=$1, version=$2, modification_time=$3, labels=COALESCE($8::jsonb, labels) WHERE name=$4 AND mesh=$5 AND type=$6 AND version=$7;`
 	result, err := r.db.Exec(
 		statement,
 		string(bytes),
@@ -101,6 +121,7 @@ func (r *postgresResourceStore) Update(_ context.Context, resource model.Resourc
 		resource.GetMeta().GetMesh(),
 		resource.GetType(),
 		version,
+		newLabels,
 	)
 	if err != nil {
 		return errors.Wrapf(err, "failed to execute query %s", statement)
@@ -115,6 +136,7 @@ func (r *postgresResourceStore) Update(_ context.Context, resource model.Resourc
 		Mesh:             resource.GetMeta().GetMesh(),
 		Version:          strconv.Itoa(newVersion),
 		ModificationTime: opts.ModificationTime,
+		Labels:           labels,
 	})
 
 	return nil
@@ -124,27 +146,52 @@ func (r *postgresResourceStore) Delete(_ context.Context, resource model.Resourc
 	opts := store.NewDeleteOptions(fs...)
 
 	statement := `DELETE FROM resources WHERE name=$1 AND type=$2 AND mesh=$3`
-	result, err := r.db.Exec(statement, opts.Name, resource.GetType(), opts.Mesh)
+	args := []interface{}{opts.Name, resource.GetType(), opts.Mesh}
+	if opts.Version != "" {
+		version, err := strconv.Atoi(opts.Version)
+		if err != nil {
+			return store.ErrorResourcePreconditionFailed(resource.GetType(), opts.Name, opts.Mesh)
+		}
+		statement += ` AND version=$4`
+		args = append(args, version)
+	}
+	result, err := r.db.Exec(statement, args...)
 	if err != nil {
 		return errors.Wrapf(err, "failed to execute query: %s", statement)
 	}
 	if rows, _ := result.RowsAffected(); rows == 0 { // error ignored, postgres supports RowsAffected()
+		if opts.Version != "" {
+			return r.notDeletedError(resource.GetType(), opts.Name, opts.Mesh)
+		}
 		return store.ErrorResourceNotFound(resource.GetType(), opts.Name, opts.Mesh)
 	}
 
 	return nil
 }
 
+// notDeletedError tells whether a resource was not deleted because it does not exist or because it was modified.
+func (r *postgresResourceStore) notDeletedError(typ model.ResourceType, name, mesh string) error {
+	statement := `SELECT count(*) FROM resources WHERE name=$1 AND type=$2 AND mesh=$3`
+	var count int
+	if err := r.db.QueryRow(statement, name, typ, mesh).Scan(&count); err != nil {
+		return errors.Wrapf(err, "failed to execute query: %s", statement)
+	}
+	if count == 0 {
+		return store.ErrorResourceNotFound(typ, name, mesh)
+	}
+	return store.ErrorResourcePreconditionFailed(typ, name, mesh)
+}
+
 func (r *postgresResourceStore) Get(_ context.Context, resource model.Resource, fs ...store.GetOptionsFunc) error {
 	opts := store.NewGetOptions(fs...)
 
-	statement := `SELECT spec, version, creation_time, modification_time FROM resources WHERE name=$1 AND mesh=$2 AND type=$3;`
+	statement := `SELECT spec, version, creation_time, modification_time, labels FROM resources WHERE name=$1 AND mesh=$2 AND type=$3;`
 	row := r.db.QueryRow(statement, opts.Name, opts.Mesh, resource.GetType())
 
-	var spec string
+	var spec, labels string
 	var version int
 	var creationTime, modificationTime time.Time
-	err := row.Scan(&spec, &version, &creationTime, &modificationTime)
+	err := row.Scan(&spec, &version, &creationTime, &modificationTime, &labels)
 	if err == sql.ErrNoRows {
 		return store.ErrorResourceNotFound(resource.GetType(), opts.Name, opts.Mesh)
 	}
@@ -163,6 +210,9 @@ func (r *postgresResourceStore) Get(_ context.Context, resource model.Resource,
 		CreationTime:     creationTime,
 		ModificationTime: modificationTime,
 	}
+	if meta.Labels, err = labelsFromJSON(labels); err != nil {
+		return err
+	}
 	resource.SetMeta(meta)
 
 	if opts.Version != "" && resource.GetMeta().GetVersion() != opts.Version {
@@ -174,15 +224,8 @@ func (r *postgresResourceStore) Get(_ context.Context, resource model.Resource,
 func (r *postgresResourceStore) List(_ context.Context, resources model.ResourceList, args ...store.ListOptionsFunc) error {
 	opts := store.NewListOptions(args...)
 
-	statement := `SELECT name, mesh, spec, version, creation_time, modification_time FROM resources WHERE type=$1`
-	var statementArgs []interface{}
-	statementArgs = append(statementArgs, resources.GetItemType())
-	argsIndex := 1
-	if opts.Mesh != "" {
-		argsIndex++
-		statement += fmt.Sprintf(" AND mesh=$%d", argsIndex)
-		statementArgs = append(statementArgs, opts.Mesh)
-	}
+	predicate, statementArgs := listPredicate(string(resources.GetItemType()), opts)
+	statement := `SELECT name, mesh, spec, version, creation_time, modification_time, labels FROM resources WHERE ` + predicate
 	statement += " ORDER BY name, mesh"
 
 	paginateResults := opts.PageSize != 0
@@ -225,7 +268,7 @@ func (r *postgresResourceStore) List(_ context.Context, resources model.Resource
 		resources.GetPagination().SetNextOffset(nextOffset)
 	}
 
-	total, err := r.countRows(string(resources.GetItemType()), opts.Mesh)
+	total, err := r.countRows(predicate, statementArgs)
 	if err != nil {
 		return err
 	}
@@ -235,10 +278,10 @@ func (r *postgresResourceStore) List(_ context.Context, resources model.Resource
 }
 
 func rowToItem(resources model.ResourceList, rows *sql.Rows) (model.Resource, error) {
-	var name, mesh, spec string
+	var name, mesh, spec, labels string
 	var version int
 	var creationTime, modificationTime time.Time
-	if err := rows.Scan(&name, &mesh, &spec, &version, &creationTime, &modificationTime); err != nil {
+	if err := rows.Scan(&name, &mesh, &spec, &version, &creationTime, &modificationTime, &labels); err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve elements from query")
 	}
 
@@ -252,22 +295,39 @@ func rowToItem(resources model.ResourceList, rows *sql.Rows) (model.Resource, er
 		Mesh:    mesh,
 		Version: strconv.Itoa(version),
 	}
+	var err error
+	if meta.Labels, err = labelsFromJSON(labels); err != nil {
+		return nil, err
+	}
 	item.SetMeta(meta)
 
 	return item, nil
 }
 
-func (r *postgresResourceStore) countRows(resource, mesh string) (int, error) {
-	statement := `SELECT COUNT(*) as count FROM resources WHERE type=$1`
-	var statementArgs []interface{}
-	statementArgs = append(statementArgs, resource)
-	argsIndex := 1
-	if mesh != "" {
-		argsIndex++
-		statement += fmt.Sprintf(" AND mesh=$%d", argsIndex)
-		statementArgs = append(statementArgs, mesh)
+func labelsToJSON(labels map[string]string) (string, error) {
+	if labels == nil {
+		return "{}", nil
 	}
+	bytes, err := json.Marshal(labels)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert labels to json")
+	}
+	return string(bytes), nil
+}
+
+func labelsFromJSON(value string) (map[string]string, error) {
+	var labels map[string]string
+	if err := json.Unmarshal([]byte(value), &labels); err != nil {
+		return nil, errors.Wrap(err, "failed to convert json to labels")
+	}
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	return labels, nil
+}
 
+func (r *postgresResourceStore) countRows(predicate string, statementArgs []interface{}) (int, error) {
+	statement := `SELECT COUNT(*) as count FROM resources WHERE ` + predicate
 	var count int
 	err := r.db.QueryRow(statement, statementArgs...).Scan(&count)
 	if err != nil {
@@ -279,6 +339,60 @@ func (r *postgresResourceStore) countRows(resource, mesh string) (int, error) {
 	return count, nil
 }
 
+// dataplaneTagSets is a JSON array of tag sets of a Dataplane, which are tags of every inbound and of the gateway.
+// Spec of other resources has no networking, therefore the array is empty.
+const dataplaneTagSets = `COALESCE(spec::jsonb->'networking'->'inbound', '[]'::jsonb) || ` +
+	`CASE WHEN spec::jsonb->'networking'->'gateway' IS NULL THEN '[]'::jsonb ELSE jsonb_build_array(spec::jsonb->'networking'->'gateway') END`
+
+// listPredicate builds WHERE clause of list and count queries with its arguments
+func listPredicate(resourceType string, opts *store.ListOptions) (string, []interface{}) {
+	statementArgs := []interface{}{resourceType}
+	arg := func(value interface{}) string {
+		statementArgs = append(statementArgs, value)
+		return fmt.Sprintf("$%d", len(statementArgs))
+	}
+	predicate := "type=$1"
+	if opts.Mesh != "" {
+		predicate += " AND mesh=" + arg(opts.Mesh)
+	}
+	if opts.NamePrefix != "" {
+		predicate += fmt.Sprintf(` AND name LIKE %s ESCAPE '\'`, arg(escapeLike(opts.NamePrefix)+"%"))
+	}
+	if !opts.ModifiedSince.IsZero() {
+		predicate += " AND modification_time > " + arg(opts.ModifiedSince)
+	}
+	if len(opts.Labels) > 0 {
+		labels, _ := json.Marshal(opts.Labels) // error ignored, map of strings is always valid JSON
+		predicate += fmt.Sprintf(" AND labels @> %s::jsonb", arg(string(labels)))
+	}
+	if len(opts.Tags) > 0 {
+		var conditions []string
+		for _, tag := range opts.Tags {
+			value := fmt.Sprintf("(tag_set->'tags'->>%s)", arg(tag.Key))
+			if len(tag.Values) == 0 {
+				conditions = append(conditions, value+" IS NOT NULL")
+				continue
+			}
+			var values []string
+			for _, v := range tag.Values {
+				values = append(values, arg(v))
+			}
+			in := fmt.Sprintf("%s IN (%s)", value, strings.Join(values, ", "))
+			if tag.Negate {
+				conditions = append(conditions, fmt.Sprintf("(%s IS NULL OR NOT %s)", value, in))
+			} else {
+				conditions = append(conditions, in)
+			}
+		}
+		predicate += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM jsonb_array_elements(%s) AS tag_set WHERE %s)", dataplaneTagSets, strings.Join(conditions, " AND "))
+	}
+	return predicate, statementArgs
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
 func (r *postgresResourceStore) Close() error {
 	return r.db.Close()
 }
@@ -289,6 +403,7 @@ type resourceMetaObject struct {
 	Mesh             string
 	CreationTime     time.Time
 	ModificationTime time.Time
+	Labels           map[string]string
 }
 
 var _ model.ResourceMeta = &resourceMetaObject{}
@@ -316,3 +431,7 @@ func (r *resourceMetaObject) GetCreationTime() time.Time {
 func (r *resourceMetaObject) GetModificationTime() time.Time {
 	return r.ModificationTime
 }
+
+func (r *resourceMetaObject) GetLabels() map[string]string {
+	return r.Labels
+}
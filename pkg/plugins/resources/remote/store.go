@@ -7,6 +7,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -34,28 +36,41 @@ type remoteStore struct {
 func (s *remoteStore) Create(ctx context.Context, res model.Resource, fs ...store.CreateOptionsFunc) error {
 	opts := store.NewCreateOptions(fs...)
 	meta := rest.ResourceMeta{
-		Type: string(res.GetType()),
-		Name: opts.Name,
-		Mesh: opts.Mesh,
+		Type:   string(res.GetType()),
+		Name:   opts.Name,
+		Mesh:   opts.Mesh,
+		Labels: opts.Labels,
 	}
-	if err := s.upsert(ctx, res, meta); err != nil {
+	if err := s.upsert(ctx, res, meta, ""); err != nil {
+		if isConflict(err) {
+			return store.ErrorResourceAlreadyExists(res.GetType(), opts.Name, opts.Mesh)
+		}
 		return err
 	}
 	return nil
 }
 func (s *remoteStore) Update(ctx context.Context, res model.Resource, fs ...store.UpdateOptionsFunc) error {
+	opts := store.NewUpdateOptions(fs...)
 	meta := rest.ResourceMeta{
-		Type: string(res.GetType()),
-		Name: res.GetMeta().GetName(),
-		Mesh: res.GetMeta().GetMesh(),
+		Type:   string(res.GetType()),
+		Name:   res.GetMeta().GetName(),
+		Mesh:   res.GetMeta().GetMesh(),
+		Labels: res.GetMeta().GetLabels(),
+	}
+	if opts.Labels != nil {
+		meta.Labels = opts.Labels
 	}
-	if err := s.upsert(ctx, res, meta); err != nil {
+	// the version of the resource is sent as a precondition, so the changes made by others in the meantime are not overwritten
+	if err := s.upsert(ctx, res, meta, res.GetMeta().GetVersion()); err != nil {
+		if isConflict(err) {
+			return store.ErrorResourceConflict(res.GetType(), meta.Name, meta.Mesh)
+		}
 		return err
 	}
 	return nil
 }
 
-func (s *remoteStore) upsert(ctx context.Context, res model.Resource, meta rest.ResourceMeta) error {
+func (s *remoteStore) upsert(ctx context.Context, res model.Resource, meta rest.ResourceMeta, version string) error {
 	resourceApi, err := s.api.GetResourceApi(res.GetType())
 	if err != nil {
 		return errors.Wrapf(err, "failed to construct URI to update a %q", res.GetType())
@@ -73,8 +88,14 @@ func (s *remoteStore) upsert(ctx context.Context, res model.Resource, meta rest.
 		return err
 	}
 	req.Header.Set("content-type", "application/json")
-	statusCode, b, err := s.doRequest(ctx, req)
+	if version != "" {
+		req.Header.Set("If-Match", `"`+version+`"`)
+	}
+	statusCode, header, b, err := s.doRequest(ctx, req)
 	if err != nil {
+		if statusCode == http.StatusConflict || statusCode == http.StatusPreconditionFailed {
+			return &conflictError{err: err}
+		}
 		return err
 	}
 	if statusCode != http.StatusOK && statusCode != http.StatusCreated {
@@ -87,7 +108,8 @@ func (s *remoteStore) upsert(ctx context.Context, res model.Resource, meta rest.
 	res.SetMeta(remoteMeta{
 		Name:    meta.Name,
 		Mesh:    meta.Mesh,
-		Version: "",
+		Version: versionFromETag(header),
+		Labels:  meta.Labels,
 	})
 	return nil
 }
@@ -101,11 +123,17 @@ func (s *remoteStore) Delete(ctx context.Context, res model.Resource, fs ...stor
 	if err != nil {
 		return err
 	}
-	statusCode, b, err := s.doRequest(ctx, req)
+	if opts.Version != "" {
+		req.Header.Set("If-Match", `"`+opts.Version+`"`)
+	}
+	statusCode, _, b, err := s.doRequest(ctx, req)
 	if err != nil {
 		if statusCode == 404 {
 			return store.ErrorResourceNotFound(res.GetType(), opts.Name, opts.Mesh)
 		}
+		if statusCode == http.StatusConflict || statusCode == http.StatusPreconditionFailed {
+			return store.ErrorResourcePreconditionFailed(res.GetType(), opts.Name, opts.Mesh)
+		}
 		return err
 	}
 	if statusCode != http.StatusOK {
@@ -127,7 +155,7 @@ func (s *remoteStore) Get(ctx context.Context, res model.Resource, fs ...store.G
 	if err != nil {
 		return err
 	}
-	statusCode, b, err := s.doRequest(ctx, req)
+	statusCode, header, b, err := s.doRequest(ctx, req)
 	if err != nil {
 		if statusCode == 404 {
 			return store.ErrorResourceNotFound(res.GetType(), opts.Name, opts.Mesh)
@@ -137,7 +165,13 @@ func (s *remoteStore) Get(ctx context.Context, res model.Resource, fs ...store.G
 	if statusCode != 200 {
 		return errors.Errorf("(%d): %s", statusCode, string(b))
 	}
-	return Unmarshal(b, res)
+	if err := Unmarshal(b, res); err != nil {
+		return err
+	}
+	meta := res.GetMeta().(remoteMeta)
+	meta.Version = versionFromETag(header)
+	res.SetMeta(meta)
+	return nil
 }
 
 func (s *remoteStore) List(ctx context.Context, rs model.ResourceList, fs ...store.ListOptionsFunc) error {
@@ -157,9 +191,21 @@ func (s *remoteStore) List(ctx context.Context, rs model.ResourceList, fs ...sto
 	if opts.PageSize != 0 {
 		query.Add("size", strconv.Itoa(opts.PageSize))
 	}
+	if opts.NamePrefix != "" {
+		query.Add("namePrefix", opts.NamePrefix)
+	}
+	for _, tag := range opts.Tags {
+		query.Add("tag", tag.String())
+	}
+	if !opts.ModifiedSince.IsZero() {
+		query.Add("modifiedSince", opts.ModifiedSince.Format(time.RFC3339))
+	}
+	for key, value := range opts.Labels {
+		query.Add("label", key+":"+value)
+	}
 	req.URL.RawQuery = query.Encode()
 
-	statusCode, b, err := s.doRequest(ctx, req)
+	statusCode, _, b, err := s.doRequest(ctx, req)
 	if err != nil {
 		return err
 	}
@@ -169,25 +215,47 @@ func (s *remoteStore) List(ctx context.Context, rs model.ResourceList, fs ...sto
 	return UnmarshalList(b, rs)
 }
 
-// execute a request. Returns status code, body, error
-func (s *remoteStore) doRequest(ctx context.Context, req *http.Request) (int, []byte, error) {
+// execute a request. Returns status code, headers, body, error
+func (s *remoteStore) doRequest(ctx context.Context, req *http.Request) (int, http.Header, []byte, error) {
 	req.Header.Set("Accept", "application/json")
 	resp, err := s.client.Do(req.WithContext(ctx))
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, nil, err
 	}
 	defer resp.Body.Close()
 	b, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return resp.StatusCode, nil, err
+		return resp.StatusCode, resp.Header, nil, err
 	}
 	if resp.StatusCode/100 >= 4 {
 		kumaErr := types.Error{}
 		if err := json.Unmarshal(b, &kumaErr); err == nil {
 			if kumaErr.Title != "" && kumaErr.Details != "" {
-				return resp.StatusCode, b, &kumaErr
+				return resp.StatusCode, resp.Header, b, &kumaErr
 			}
 		}
+		if resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusPreconditionFailed {
+			return resp.StatusCode, resp.Header, b, errors.Errorf("(%d): %s", resp.StatusCode, string(b))
+		}
 	}
-	return resp.StatusCode, b, nil
+	return resp.StatusCode, resp.Header, b, nil
+}
+
+// versionFromETag returns the version of a resource from the ETag header set by the API Server.
+func versionFromETag(header http.Header) string {
+	return strings.Trim(header.Get("ETag"), `"`)
+}
+
+// conflictError means that the resource was modified or created by someone else in the meantime.
+type conflictError struct {
+	err error
+}
+
+func (e *conflictError) Error() string {
+	return e.err.Error()
+}
+
+func isConflict(err error) bool {
+	_, ok := err.(*conflictError)
+	return ok
 }
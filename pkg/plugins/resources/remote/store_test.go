@@ -96,6 +96,36 @@ var _ = Describe("RemoteStore", func() {
 			Expect(resource.GetMeta().GetModificationTime()).Should(Equal(modificationTime))
 		})
 
+		It("should take the version of the resource from ETag", func() {
+			// setup
+			client := &http.Client{
+				Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					file, err := os.Open(filepath.Join("testdata", "get.json"))
+					if err != nil {
+						return nil, err
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     http.Header{"Etag": []string{`"7"`}},
+						Body:       ioutil.NopCloser(bufio.NewReader(file)),
+					}, nil
+				}),
+			}
+			store := remote.NewStore(client, &core_rest.ApiDescriptor{
+				Resources: map[core_model.ResourceType]core_rest.ResourceApi{
+					sample_core.TrafficRouteType: core_rest.NewResourceApi(sample_core.TrafficRouteType, "traffic-routes"),
+				},
+			})
+
+			// when
+			resource := sample_core.TrafficRouteResource{}
+			err := store.Get(context.Background(), &resource, core_store.GetByKey("res-1", "default"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resource.GetMeta().GetVersion()).To(Equal("7"))
+		})
+
 		It("should get mesh resource", func() {
 			meshName := "someMesh"
 			store := setupStore("get-mesh.json", func(req *http.Request) {
@@ -258,6 +288,53 @@ var _ = Describe("RemoteStore", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should send the version of the resource as If-Match", func() {
+			// setup
+			store := setupStore("create_update.json", func(req *http.Request) {
+				Expect(req.Header.Get("If-Match")).To(Equal(`"3"`))
+			})
+
+			// when
+			resource := sample_core.TrafficRouteResource{
+				Spec: sample_api.TrafficRoute{
+					Path: "/some-path",
+				},
+				Meta: &model.ResourceMeta{
+					Mesh:    "default",
+					Name:    "res-1",
+					Version: "3",
+				},
+			}
+			err := store.Update(context.Background(), &resource)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should map 412 error to ResourceConflict", func() {
+			// given
+			json := `
+			{
+				"title": "Could not update a resource",
+				"details": "Precondition Failed"
+			}
+`
+			store := setupErrorStore(412, json)
+
+			// when
+			resource := sample_core.TrafficRouteResource{
+				Meta: &model.ResourceMeta{
+					Mesh:    "default",
+					Name:    "res-1",
+					Version: "3",
+				},
+			}
+			err := store.Update(context.Background(), &resource)
+
+			// then
+			Expect(core_store.IsResourceConflict(err)).To(BeTrue())
+		})
+
 		It("should send proper mesh json", func() {
 			// setup
 			meshName := "someMesh"
@@ -412,6 +489,33 @@ var _ = Describe("RemoteStore", func() {
 			Expect(rs.Items[0].Meta.GetModificationTime()).Should(Equal(modificationTime))
 		})
 
+		It("should list known resources using filters", func() {
+			// given
+			store := setupStore("list-pagination.json", func(req *http.Request) {
+				Expect(req.URL.Path).To(Equal("/meshes/demo/traffic-routes"))
+				Expect(req.URL.Query().Get("namePrefix")).To(Equal("on"))
+				Expect(req.URL.Query()["tag"]).To(Equal([]string{"kuma.io/service:web", "version:!v1,v2"}))
+				Expect(req.URL.Query().Get("modifiedSince")).To(Equal("2018-07-17T16:05:36Z"))
+			})
+			tags := []core_store.TagFilter{
+				{Key: "kuma.io/service", Values: []string{"web"}},
+				{Key: "version", Values: []string{"v1", "v2"}, Negate: true},
+			}
+
+			// when
+			rs := sample_core.TrafficRouteResourceList{}
+			err := store.List(context.Background(), &rs,
+				core_store.ListByMesh("demo"),
+				core_store.ListByNamePrefix("on"),
+				core_store.ListByTags(tags...),
+				core_store.ListModifiedSince(time.Date(2018, 7, 17, 16, 5, 36, 0, time.UTC)),
+			)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs.Items).To(HaveLen(1))
+		})
+
 		It("should list meshes", func() {
 			// given
 			store := setupStore("list-meshes.json", func(req *http.Request) {
@@ -504,6 +608,37 @@ var _ = Describe("RemoteStore", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should send the expected version as If-Match", func() {
+			// given
+			store := setupStore("delete.json", func(req *http.Request) {
+				Expect(req.Header.Get("If-Match")).To(Equal(`"3"`))
+			})
+
+			// when
+			resource := sample_core.TrafficRouteResource{}
+			err := store.Delete(context.Background(), &resource, core_store.DeleteByKey("tr-1", "mesh-1"), core_store.DeleteByVersion("3"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should map 412 error to ResourcePreconditionFailed", func() {
+			// given
+			json := `
+			{
+				"title": "Could not delete a resource",
+				"details": "Precondition Failed"
+			}`
+			store := setupErrorStore(412, json)
+
+			// when
+			resource := sample_core.TrafficRouteResource{}
+			err := store.Delete(context.Background(), &resource, core_store.DeleteByKey("tr-1", "mesh-1"), core_store.DeleteByVersion("3"))
+
+			// then
+			Expect(core_store.IsResourcePreconditionFailed(err)).To(BeTrue())
+		})
+
 		It("should return error from the api server", func() {
 			// given
 			store := setupErrorStore(400, "some error from the server")
@@ -17,6 +17,7 @@ type remoteMeta struct {
 	Version          string
 	CreationTime     time.Time
 	ModificationTime time.Time
+	Labels           map[string]string
 }
 
 func (m remoteMeta) GetName() string {
@@ -37,6 +38,9 @@ func (m remoteMeta) GetCreationTime() time.Time {
 func (m remoteMeta) GetModificationTime() time.Time {
 	return m.ModificationTime
 }
+func (m remoteMeta) GetLabels() map[string]string {
+	return m.Labels
+}
 
 func Unmarshal(b []byte, res model.Resource) error {
 	restResource := rest.Resource{
@@ -51,6 +55,7 @@ func Unmarshal(b []byte, res model.Resource) error {
 		Version:          "",
 		CreationTime:     restResource.Meta.CreationTime,
 		ModificationTime: restResource.Meta.ModificationTime,
+		Labels:           restResource.Meta.Labels,
 	})
 	return nil
 }
@@ -73,6 +78,7 @@ func UnmarshalList(b []byte, rs model.ResourceList) error {
 			Version:          "",
 			CreationTime:     ri.Meta.CreationTime,
 			ModificationTime: ri.Meta.ModificationTime,
+			Labels:           ri.Meta.Labels,
 		})
 		_ = rs.AddItem(r)
 	}
@@ -0,0 +1,64 @@
+package controllers
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	kube_core "k8s.io/api/core/v1"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/metadata"
+)
+
+// Dependencies are Dependencies in the Mesh of a Pod together with destinations they learned.
+type Dependencies struct {
+	Resources []*mesh_core.DependenciesResource
+	// LearnedDestinations are destinations learned from the observed traffic of Dataplanes by the name of Dependencies
+	LearnedDestinations map[string][]string
+}
+
+// OutboundDependenciesFor limits outbounds of a Dataplane to its dependencies.
+// Dependencies are services listed in the kuma.io/dependencies annotation of the Pod, services of Dependencies
+// that select the Dataplane and destinations those Dependencies learned from the observed traffic of Dataplanes.
+// Outbounds are not limited if the Dataplane has no dependencies declared.
+func OutboundDependenciesFor(pod *kube_core.Pod, dataplane *mesh_proto.Dataplane, outbounds []*mesh_proto.Dataplane_Networking_Outbound, dependencies Dependencies) []*mesh_proto.Dataplane_Networking_Outbound {
+	declared := false
+	services := map[string]bool{}
+	destinations := map[string]bool{}
+
+	if value, exist := metadata.Annotations(pod.Annotations).GetString(metadata.KumaDependenciesAnnotation); exist {
+		declared = true
+		for _, service := range strings.Split(value, ",") {
+			services[strings.TrimSpace(service)] = true
+		}
+	}
+	for _, dependency := range dependencies.Resources {
+		if !dependency.Selects(dataplane) {
+			continue
+		}
+		declared = true
+		for _, service := range dependency.Spec.Services {
+			services[service] = true
+		}
+		if !dependency.Spec.Learn {
+			continue
+		}
+		for _, destination := range dependencies.LearnedDestinations[dependency.Meta.GetName()] {
+			destinations[destination] = true
+		}
+	}
+	if !declared {
+		return outbounds
+	}
+
+	var result []*mesh_proto.Dataplane_Networking_Outbound
+	for _, outbound := range outbounds {
+		destination := net.JoinHostPort(outbound.Address, strconv.Itoa(int(outbound.Port)))
+		if services[outbound.GetTagsIncludingLegacy()[mesh_proto.ServiceTag]] || destinations[destination] {
+			result = append(result, outbound)
+		}
+	}
+	return result
+}
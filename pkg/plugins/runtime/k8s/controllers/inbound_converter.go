@@ -58,6 +58,7 @@ func InboundTagsFor(zone string, pod *kube_core.Pod, svc *kube_core.Service, svc
 		tags = make(map[string]string)
 	}
 	tags[mesh_proto.ServiceTag] = ServiceTagFor(svc, svcPort)
+	tags[mesh_proto.KubeNamespaceTag] = pod.Namespace
 	if zone != "" {
 		tags[mesh_proto.ZoneTag] = zone
 	}
@@ -0,0 +1,345 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	kube_core "k8s.io/api/core/v1"
+	kube_networking "k8s.io/api/networking/v1beta1"
+	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_record "k8s.io/client-go/tools/record"
+	kube_ctrl "sigs.k8s.io/controller-runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_controllerutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	kube_handler "sigs.k8s.io/controller-runtime/pkg/handler"
+	kube_reconile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+	kube_source "sigs.k8s.io/controller-runtime/pkg/source"
+
+	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const (
+	// FailedToGenerateKumaGatewayReason is added to an event when
+	// an Ingress cannot be turned into a Kuma gateway configuration.
+	FailedToGenerateKumaGatewayReason = "FailedToGenerateKumaGateway"
+
+	// ingressNamespaceLabel and ingressNameLabel mark objects generated out of an Ingress.
+	ingressNamespaceLabel = "k8s.kuma.io/ingress-namespace"
+	ingressNameLabel      = "k8s.kuma.io/ingress-name"
+
+	// kumaSecretType and kumaSecretMeshLabel mirror the layout of Kuma Secrets on Kubernetes.
+	kumaSecretType      = "system.kuma.io/secret"
+	kumaSecretMeshLabel = "kuma.io/mesh"
+)
+
+// KubernetesIngressReconciler turns Kubernetes Ingresses of a given class
+// into GatewayListeners and GatewayRoutes served by gateway Dataplanes.
+type KubernetesIngressReconciler struct {
+	kube_client.Client
+	kube_record.EventRecorder
+	Scheme *kube_runtime.Scheme
+	Log    logr.Logger
+
+	SystemNamespace string
+	IngressClass    string
+}
+
+func (r *KubernetesIngressReconciler) Reconcile(req kube_ctrl.Request) (kube_ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("ingress", req.NamespacedName)
+
+	// Fetch the Ingress instance
+	ingress := &kube_networking.Ingress{}
+	if err := r.Get(ctx, req.NamespacedName, ingress); err != nil {
+		if kube_apierrs.IsNotFound(err) {
+			// GatewayListener and GatewayRoutes are garbage collected by Kubernetes, copied Secrets are not
+			return kube_ctrl.Result{}, r.cleanup(ctx, req.NamespacedName, nil, nil)
+		}
+		log.Error(err, "unable to fetch Ingress")
+		return kube_ctrl.Result{}, err
+	}
+
+	if IngressClassFor(ingress) != r.IngressClass {
+		return kube_ctrl.Result{}, r.cleanup(ctx, req.NamespacedName, nil, nil)
+	}
+
+	if err := r.createOrUpdateGateway(ctx, ingress); err != nil {
+		log.Error(err, "unable to generate Kuma gateway configuration")
+		r.EventRecorder.Eventf(ingress, kube_core.EventTypeWarning, FailedToGenerateKumaGatewayReason, "Failed to generate Kuma gateway configuration: %s", err.Error())
+		return kube_ctrl.Result{}, err
+	}
+	return kube_ctrl.Result{}, nil
+}
+
+func (r *KubernetesIngressReconciler) createOrUpdateGateway(ctx context.Context, ingress *kube_networking.Ingress) error {
+	mesh := IngressMeshFor(ingress)
+
+	secrets, err := r.copyTLSSecrets(ctx, ingress, mesh)
+	if err != nil {
+		return err
+	}
+
+	services := &kube_core.ServiceList{}
+	if err := r.List(ctx, services, kube_client.InNamespace(ingress.Namespace)); err != nil {
+		return errors.Wrap(err, "unable to list Services")
+	}
+	var servicePtrs []*kube_core.Service
+	for i := range services.Items {
+		servicePtrs = append(servicePtrs, &services.Items[i])
+	}
+	routes, err := GatewayRoutesFor(ingress, servicePtrs)
+	if err != nil {
+		return err
+	}
+
+	listener := &mesh_k8s.GatewayListener{
+		ObjectMeta: kube_meta.ObjectMeta{
+			Namespace: ingress.Namespace,
+			Name:      ingress.Name,
+		},
+	}
+	if _, err := kube_controllerutil.CreateOrUpdate(ctx, r.Client, listener, func() error {
+		spec, err := util_proto.ToMap(GatewayListenerFor(ingress, secrets))
+		if err != nil {
+			return err
+		}
+		listener.Mesh = mesh
+		listener.Spec = spec
+		listener.Labels = ingressLabels(ingress.Namespace, ingress.Name)
+		return kube_controllerutil.SetControllerReference(ingress, listener, r.Scheme)
+	}); err != nil {
+		return errors.Wrap(err, "unable to create/update GatewayListener")
+	}
+
+	for name, routeProto := range routes {
+		route := &mesh_k8s.GatewayRoute{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Namespace: ingress.Namespace,
+				Name:      name,
+			},
+		}
+		routeProto := routeProto
+		if _, err := kube_controllerutil.CreateOrUpdate(ctx, r.Client, route, func() error {
+			spec, err := util_proto.ToMap(routeProto)
+			if err != nil {
+				return err
+			}
+			route.Mesh = mesh
+			route.Spec = spec
+			route.Labels = ingressLabels(ingress.Namespace, ingress.Name)
+			return kube_controllerutil.SetControllerReference(ingress, route, r.Scheme)
+		}); err != nil {
+			return errors.Wrapf(err, "unable to create/update GatewayRoute %q", name)
+		}
+	}
+
+	secretNames := map[string]bool{}
+	for _, secret := range secrets {
+		secretNames[secret.Certificate] = true
+		secretNames[secret.Key] = true
+	}
+	routeNames := map[string]bool{}
+	for name := range routes {
+		routeNames[name] = true
+	}
+	return r.cleanup(ctx, kube_types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}, routeNames, secretNames)
+}
+
+// copyTLSSecrets copies TLS Secrets referenced by an Ingress into Kuma Secrets,
+// so that gateway Dataplanes could fetch them through the regular Secret machinery.
+// TLS Secrets that are missing or have an unexpected layout are skipped.
+func (r *KubernetesIngressReconciler) copyTLSSecrets(ctx context.Context, ingress *kube_networking.Ingress, mesh string) (map[string]IngressTLSSecret, error) {
+	secrets := map[string]IngressTLSSecret{}
+	for _, tls := range ingress.Spec.TLS {
+		if tls.SecretName == "" {
+			continue
+		}
+		if _, ok := secrets[tls.SecretName]; ok {
+			continue
+		}
+		tlsSecret := &kube_core.Secret{}
+		if err := r.Get(ctx, kube_types.NamespacedName{Namespace: ingress.Namespace, Name: tls.SecretName}, tlsSecret); err != nil {
+			if kube_apierrs.IsNotFound(err) {
+				r.EventRecorder.Eventf(ingress, kube_core.EventTypeWarning, FailedToGenerateKumaGatewayReason, "TLS Secret %q not found", tls.SecretName)
+				continue
+			}
+			return nil, errors.Wrapf(err, "unable to fetch TLS Secret %q", tls.SecretName)
+		}
+		cert, key := tlsSecret.Data[kube_core.TLSCertKey], tlsSecret.Data[kube_core.TLSPrivateKeyKey]
+		if len(cert) == 0 || len(key) == 0 {
+			r.EventRecorder.Eventf(ingress, kube_core.EventTypeWarning, FailedToGenerateKumaGatewayReason, "TLS Secret %q has to define %q and %q", tls.SecretName, kube_core.TLSCertKey, kube_core.TLSPrivateKeyKey)
+			continue
+		}
+		secret := IngressTLSSecret{
+			Certificate: ingressSecretName(ingress, tls.SecretName, "cert"),
+			Key:         ingressSecretName(ingress, tls.SecretName, "key"),
+		}
+		for name, value := range map[string][]byte{secret.Certificate: cert, secret.Key: key} {
+			if err := r.createOrUpdateKumaSecret(ctx, ingress, mesh, name, value); err != nil {
+				return nil, err
+			}
+		}
+		secrets[tls.SecretName] = secret
+	}
+	return secrets, nil
+}
+
+func (r *KubernetesIngressReconciler) createOrUpdateKumaSecret(ctx context.Context, ingress *kube_networking.Ingress, mesh string, name string, value []byte) error {
+	secret := &kube_core.Secret{
+		ObjectMeta: kube_meta.ObjectMeta{
+			Namespace: r.SystemNamespace,
+			Name:      name,
+		},
+	}
+	_, err := kube_controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.ResourceVersion != "" && !isGeneratedOutOf(secret.Labels, ingress) {
+			return errors.Errorf("Secret %q already exists and is not generated out of this Ingress", name)
+		}
+		secret.Type = kumaSecretType
+		secret.Labels = ingressLabels(ingress.Namespace, ingress.Name)
+		secret.Labels[kumaSecretMeshLabel] = mesh
+		secret.Data = map[string][]byte{
+			"value": value,
+		}
+		return nil
+	})
+	return errors.Wrapf(err, "unable to create/update Secret %q", name)
+}
+
+// cleanup deletes objects generated out of an Ingress that are no longer needed.
+func (r *KubernetesIngressReconciler) cleanup(ctx context.Context, ingress kube_types.NamespacedName, routeNames, secretNames map[string]bool) error {
+	labels := kube_client.MatchingLabels(ingressLabels(ingress.Namespace, ingress.Name))
+
+	if routeNames == nil {
+		listener := &mesh_k8s.GatewayListener{}
+		listener.Namespace, listener.Name = ingress.Namespace, ingress.Name
+		if err := r.Delete(ctx, listener); err != nil && !kube_apierrs.IsNotFound(err) {
+			return errors.Wrap(err, "unable to delete GatewayListener")
+		}
+	}
+
+	routes := &mesh_k8s.GatewayRouteList{}
+	if err := r.List(ctx, routes, kube_client.InNamespace(ingress.Namespace), labels); err != nil {
+		return errors.Wrap(err, "unable to list GatewayRoutes")
+	}
+	for i := range routes.Items {
+		route := &routes.Items[i]
+		if routeNames[route.Name] {
+			continue
+		}
+		if err := r.Delete(ctx, route); err != nil && !kube_apierrs.IsNotFound(err) {
+			return errors.Wrapf(err, "unable to delete GatewayRoute %q", route.Name)
+		}
+	}
+
+	secrets := &kube_core.SecretList{}
+	if err := r.List(ctx, secrets, kube_client.InNamespace(r.SystemNamespace), labels); err != nil {
+		return errors.Wrap(err, "unable to list Secrets")
+	}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if secretNames[secret.Name] {
+			continue
+		}
+		if err := r.Delete(ctx, secret); err != nil && !kube_apierrs.IsNotFound(err) {
+			return errors.Wrapf(err, "unable to delete Secret %q", secret.Name)
+		}
+	}
+	return nil
+}
+
+func ingressLabels(namespace, name string) map[string]string {
+	return map[string]string{
+		ingressNamespaceLabel: namespace,
+		ingressNameLabel:      name,
+	}
+}
+
+func isGeneratedOutOf(labels map[string]string, ingress *kube_networking.Ingress) bool {
+	return labels[ingressNamespaceLabel] == ingress.Namespace && labels[ingressNameLabel] == ingress.Name
+}
+
+// ingressSecretName returns a name of a Kuma Secret copied from a TLS Secret of an Ingress.
+// Joining the names with dashes would be ambiguous, e.g. for Ingresses "a-b/c" and "a/b-c", therefore the name is a hash.
+func ingressSecretName(ingress *kube_networking.Ingress, secretName string, suffix string) string {
+	// names of Kubernetes objects cannot contain "/"
+	return fmt.Sprintf("ingress-%x-%s", sha256.Sum256([]byte(ingress.Namespace+"/"+ingress.Name+"/"+secretName)), suffix)
+}
+
+func (r *KubernetesIngressReconciler) SetupWithManager(mgr kube_ctrl.Manager) error {
+	for _, addToScheme := range []func(*kube_runtime.Scheme) error{kube_core.AddToScheme, kube_networking.AddToScheme, mesh_k8s.AddToScheme} {
+		if err := addToScheme(mgr.GetScheme()); err != nil {
+			return err
+		}
+	}
+	return kube_ctrl.NewControllerManagedBy(mgr).
+		For(&kube_networking.Ingress{}).
+		Owns(&mesh_k8s.GatewayListener{}).
+		Owns(&mesh_k8s.GatewayRoute{}).
+		// on TLS Secret update reconcile Ingresses that refer to it
+		Watches(&kube_source.Kind{Type: &kube_core.Secret{}}, &kube_handler.EnqueueRequestsFromMapFunc{
+			ToRequests: &SecretToIngressesMapper{Client: mgr.GetClient(), Log: r.Log.WithName("secret-to-ingresses-mapper")},
+		}).
+		// on Service update reconcile Ingresses in the same namespace to resolve named ports
+		Watches(&kube_source.Kind{Type: &kube_core.Service{}}, &kube_handler.EnqueueRequestsFromMapFunc{
+			ToRequests: &ServiceToIngressesMapper{Client: mgr.GetClient(), Log: r.Log.WithName("service-to-ingresses-mapper")},
+		}).
+		Complete(r)
+}
+
+type SecretToIngressesMapper struct {
+	kube_client.Client
+	Log logr.Logger
+}
+
+func (m *SecretToIngressesMapper) Map(obj kube_handler.MapObject) []kube_reconile.Request {
+	// List Ingresses in the same namespace as a Secret
+	ingresses := &kube_networking.IngressList{}
+	if err := m.Client.List(context.Background(), ingresses, kube_client.InNamespace(obj.Meta.GetNamespace())); err != nil {
+		m.Log.WithValues("secret", obj.Meta.GetName()).Error(err, "failed to fetch Ingresses")
+		return nil
+	}
+
+	var req []kube_reconile.Request
+	for _, ingress := range ingresses.Items {
+		for _, tls := range ingress.Spec.TLS {
+			if tls.SecretName == obj.Meta.GetName() {
+				req = append(req, kube_reconile.Request{
+					NamespacedName: kube_types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name},
+				})
+				break
+			}
+		}
+	}
+	return req
+}
+
+type ServiceToIngressesMapper struct {
+	kube_client.Client
+	Log logr.Logger
+}
+
+func (m *ServiceToIngressesMapper) Map(obj kube_handler.MapObject) []kube_reconile.Request {
+	// List Ingresses in the same namespace as a Service
+	ingresses := &kube_networking.IngressList{}
+	if err := m.Client.List(context.Background(), ingresses, kube_client.InNamespace(obj.Meta.GetNamespace())); err != nil {
+		m.Log.WithValues("service", obj.Meta.GetName()).Error(err, "failed to fetch Ingresses")
+		return nil
+	}
+
+	var req []kube_reconile.Request
+	for _, ingress := range ingresses.Items {
+		req = append(req, kube_reconile.Request{
+			NamespacedName: kube_types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name},
+		})
+	}
+	return req
+}
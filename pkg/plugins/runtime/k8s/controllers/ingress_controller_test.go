@@ -0,0 +1,181 @@
+package controllers_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	kube_core "k8s.io/api/core/v1"
+	kube_networking "k8s.io/api/networking/v1beta1"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_intstr "k8s.io/apimachinery/pkg/util/intstr"
+	kube_record "k8s.io/client-go/tools/record"
+	kube_ctrl "sigs.k8s.io/controller-runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_client_fake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	kube_reconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kumahq/kuma/pkg/core"
+	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	. "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers"
+)
+
+var _ = Describe("KubernetesIngressReconciler", func() {
+
+	var kubeClient kube_client.Client
+	var reconciler kube_reconcile.Reconciler
+
+	req := kube_ctrl.Request{
+		NamespacedName: kube_types.NamespacedName{Namespace: "demo", Name: "web"},
+	}
+
+	BeforeEach(func() {
+		kubeClient = kube_client_fake.NewFakeClientWithScheme(
+			k8sClientScheme,
+			&kube_networking.Ingress{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Namespace: "demo",
+					Name:      "web",
+					Annotations: map[string]string{
+						"kubernetes.io/ingress.class": "kuma",
+					},
+				},
+				Spec: kube_networking.IngressSpec{
+					TLS: []kube_networking.IngressTLS{{
+						Hosts:      []string{"example.com"},
+						SecretName: "example-tls",
+					}},
+					Rules: []kube_networking.IngressRule{{
+						Host: "example.com",
+						IngressRuleValue: kube_networking.IngressRuleValue{
+							HTTP: &kube_networking.HTTPIngressRuleValue{
+								Paths: []kube_networking.HTTPIngressPath{{
+									Path: "/",
+									Backend: kube_networking.IngressBackend{
+										ServiceName: "web",
+										ServicePort: kube_intstr.FromInt(8080),
+									},
+								}},
+							},
+						},
+					}},
+				},
+			},
+			&kube_core.Secret{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Namespace: "demo",
+					Name:      "example-tls",
+				},
+				Type: kube_core.SecretTypeTLS,
+				Data: map[string][]byte{
+					"tls.crt": []byte("CERT"),
+					"tls.key": []byte("KEY"),
+				},
+			},
+		)
+
+		reconciler = &KubernetesIngressReconciler{
+			Client:          kubeClient,
+			EventRecorder:   kube_record.NewFakeRecorder(10),
+			Scheme:          k8sClientScheme,
+			Log:             core.Log.WithName("test"),
+			SystemNamespace: "kuma-system",
+			IngressClass:    "kuma",
+		}
+	})
+
+	It("should generate GatewayListener, GatewayRoutes and Secrets", func() {
+		// when
+		result, err := reconciler.Reconcile(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeZero())
+
+		// when
+		listener := &mesh_k8s.GatewayListener{}
+		err = kubeClient.Get(context.Background(), req.NamespacedName, listener)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listener.Mesh).To(Equal("default"))
+		Expect(listener.Spec["listeners"]).To(HaveLen(2))
+
+		// when
+		route := &mesh_k8s.GatewayRoute{}
+		err = kubeClient.Get(context.Background(), kube_types.NamespacedName{Namespace: "demo", Name: "web-0"}, route)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(route.Spec["hostnames"]).To(ConsistOf("example.com"))
+
+		// when
+		secret := &kube_core.Secret{}
+		err = kubeClient.Get(context.Background(), kube_types.NamespacedName{Namespace: "kuma-system", Name: "ingress-4a2a0a9115037b7af6edb0f61bbc1073dd1ff9087119ea55d44076fc8254443d-cert"}, secret)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(secret.Type)).To(Equal("system.kuma.io/secret"))
+		Expect(secret.Labels).To(HaveKeyWithValue("kuma.io/mesh", "default"))
+		Expect(secret.Data["value"]).To(Equal([]byte("CERT")))
+	})
+
+	It("should not overwrite a Secret that is not generated out of the Ingress", func() {
+		// given
+		Expect(kubeClient.Create(context.Background(), &kube_core.Secret{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Namespace: "kuma-system",
+				Name:      "ingress-4a2a0a9115037b7af6edb0f61bbc1073dd1ff9087119ea55d44076fc8254443d-cert",
+				Labels: map[string]string{
+					"k8s.kuma.io/ingress-namespace": "demo-web",
+					"k8s.kuma.io/ingress-name":      "example-tls",
+				},
+			},
+			Data: map[string][]byte{
+				"value": []byte("OTHER"),
+			},
+		})).To(Succeed())
+
+		// when
+		_, err := reconciler.Reconcile(req)
+
+		// then
+		Expect(err).To(MatchError(`unable to create/update Secret "ingress-4a2a0a9115037b7af6edb0f61bbc1073dd1ff9087119ea55d44076fc8254443d-cert": Secret "ingress-4a2a0a9115037b7af6edb0f61bbc1073dd1ff9087119ea55d44076fc8254443d-cert" already exists and is not generated out of this Ingress`))
+
+		// when
+		secret := &kube_core.Secret{}
+		err = kubeClient.Get(context.Background(), kube_types.NamespacedName{Namespace: "kuma-system", Name: "ingress-4a2a0a9115037b7af6edb0f61bbc1073dd1ff9087119ea55d44076fc8254443d-cert"}, secret)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.Data["value"]).To(Equal([]byte("OTHER")))
+	})
+
+	It("should clean up when Ingress no longer belongs to the ingress class", func() {
+		// given
+		_, err := reconciler.Reconcile(req)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		ingress := &kube_networking.Ingress{}
+		Expect(kubeClient.Get(context.Background(), req.NamespacedName, ingress)).To(Succeed())
+		ingress.Annotations["kubernetes.io/ingress.class"] = "nginx"
+		Expect(kubeClient.Update(context.Background(), ingress)).To(Succeed())
+
+		// when
+		_, err = reconciler.Reconcile(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		routes := &mesh_k8s.GatewayRouteList{}
+		Expect(kubeClient.List(context.Background(), routes)).To(Succeed())
+		secrets := &kube_core.SecretList{}
+		Expect(kubeClient.List(context.Background(), secrets, kube_client.InNamespace("kuma-system"))).To(Succeed())
+		err = kubeClient.Get(context.Background(), req.NamespacedName, &mesh_k8s.GatewayListener{})
+
+		// then
+		Expect(routes.Items).To(BeEmpty())
+		Expect(secrets.Items).To(BeEmpty())
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,228 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	kube_core "k8s.io/api/core/v1"
+	kube_networking "k8s.io/api/networking/v1beta1"
+	kube_intstr "k8s.io/apimachinery/pkg/util/intstr"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/metadata"
+)
+
+const (
+	// IngressClassAnnotation is a well-known annotation that assigns an Ingress to an Ingress controller.
+	IngressClassAnnotation = "kubernetes.io/ingress.class"
+
+	ingressHTTPPort  = 80
+	ingressHTTPSPort = 443
+
+	// ingressBackendWeight is a weight of every Ingress backend.
+	// Ingress has exactly one backend per path, so the value is arbitrary.
+	ingressBackendWeight = 100
+)
+
+// IngressTLSSecret is a pair of Kuma Secrets holding a certificate and a key
+// copied from a TLS Secret referenced by an Ingress.
+type IngressTLSSecret struct {
+	Certificate string
+	Key         string
+}
+
+// IngressClassFor returns an ingress class of a given Ingress.
+func IngressClassFor(ingress *kube_networking.Ingress) string {
+	if class, ok := ingress.Annotations[IngressClassAnnotation]; ok {
+		return class
+	}
+	if ingress.Spec.IngressClassName != nil {
+		return *ingress.Spec.IngressClassName
+	}
+	return ""
+}
+
+// IngressMeshFor returns a Mesh of gateway Dataplanes that should serve a given Ingress.
+func IngressMeshFor(ingress *kube_networking.Ingress) string {
+	mesh, exist := metadata.Annotations(ingress.Annotations).GetString(metadata.KumaMeshAnnotation)
+	if !exist || mesh == "" {
+		return model.DefaultMesh
+	}
+	return mesh
+}
+
+// GatewaySelectorsFor returns selectors of gateway Dataplanes that should serve a given Ingress.
+func GatewaySelectorsFor(ingress *kube_networking.Ingress) []*mesh_proto.Selector {
+	service, exist := metadata.Annotations(ingress.Annotations).GetString(metadata.KumaGatewayServiceAnnotation)
+	if !exist || service == "" {
+		service = mesh_proto.MatchAllTag
+	}
+	return []*mesh_proto.Selector{{
+		Match: map[string]string{
+			mesh_proto.ServiceTag: service,
+		},
+	}}
+}
+
+// GatewayListenerFor turns a given Ingress into a GatewayListener.
+//
+// Ingress is always served over plain HTTP on port 80.
+// TLS hosts are additionally served over HTTPS on port 443, given that
+// the TLS Secret of a host has already been copied into Kuma Secrets.
+func GatewayListenerFor(ingress *kube_networking.Ingress, secrets map[string]IngressTLSSecret) *mesh_proto.GatewayListener {
+	listener := &mesh_proto.GatewayListener{
+		Selectors: GatewaySelectorsFor(ingress),
+		Listeners: []*mesh_proto.GatewayListener_Listener{{
+			Port:     ingressHTTPPort,
+			Protocol: mesh_proto.GatewayListener_Listener_HTTP,
+			Hostname: mesh_proto.MatchAllTag,
+		}},
+	}
+	for _, tls := range ingress.Spec.TLS {
+		secret, ok := secrets[tls.SecretName]
+		if !ok {
+			continue
+		}
+		hosts := tls.Hosts
+		if len(hosts) == 0 {
+			hosts = []string{mesh_proto.MatchAllTag}
+		}
+		for _, host := range hosts {
+			listener.Listeners = append(listener.Listeners, &mesh_proto.GatewayListener_Listener{
+				Port:     ingressHTTPSPort,
+				Protocol: mesh_proto.GatewayListener_Listener_HTTPS,
+				Hostname: host,
+				Tls: &mesh_proto.GatewayListener_Listener_Tls{
+					Certificate: &system_proto.DataSource{
+						Type: &system_proto.DataSource_Secret{Secret: secret.Certificate},
+					},
+					Key: &system_proto.DataSource{
+						Type: &system_proto.DataSource_Secret{Secret: secret.Key},
+					},
+				},
+			})
+		}
+	}
+	return listener
+}
+
+// GatewayRoutesFor turns a given Ingress into GatewayRoutes, one per host.
+// Returned GatewayRoutes are keyed by name.
+//
+// Default backend of the Ingress is appended to every GatewayRoute
+// and additionally served on any other host.
+func GatewayRoutesFor(ingress *kube_networking.Ingress, services []*kube_core.Service) (map[string]*mesh_proto.GatewayRoute, error) {
+	var hosts []string
+	rulesByHost := map[string][]*mesh_proto.GatewayRoute_Rule{}
+	for _, ingressRule := range ingress.Spec.Rules {
+		if _, ok := rulesByHost[ingressRule.Host]; !ok {
+			hosts = append(hosts, ingressRule.Host)
+			rulesByHost[ingressRule.Host] = nil
+		}
+		if ingressRule.HTTP == nil {
+			continue
+		}
+		for _, path := range ingressRule.HTTP.Paths {
+			backend, err := gatewayBackendFor(ingress.Namespace, path.Backend, services)
+			if err != nil {
+				return nil, err
+			}
+			rulesByHost[ingressRule.Host] = append(rulesByHost[ingressRule.Host], &mesh_proto.GatewayRoute_Rule{
+				Matches:  []*mesh_proto.GatewayRoute_Rule_Match{{Path: gatewayPathFor(path)}},
+				Backends: []*mesh_proto.GatewayRoute_Rule_Backend{backend},
+			})
+		}
+	}
+
+	if ingress.Spec.Backend != nil {
+		backend, err := gatewayBackendFor(ingress.Namespace, *ingress.Spec.Backend, services)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := rulesByHost[""]; !ok {
+			hosts = append(hosts, "")
+		}
+		for _, host := range hosts {
+			rulesByHost[host] = append(rulesByHost[host], &mesh_proto.GatewayRoute_Rule{
+				Matches: []*mesh_proto.GatewayRoute_Rule_Match{{Path: &mesh_proto.GatewayRoute_Rule_Match_Path{
+					Type:  mesh_proto.GatewayRoute_Rule_Match_Path_PREFIX,
+					Value: "/",
+				}}},
+				Backends: []*mesh_proto.GatewayRoute_Rule_Backend{backend},
+			})
+		}
+	}
+
+	routes := map[string]*mesh_proto.GatewayRoute{}
+	for i, host := range hosts {
+		if len(rulesByHost[host]) == 0 {
+			continue
+		}
+		route := &mesh_proto.GatewayRoute{
+			Selectors: GatewaySelectorsFor(ingress),
+			Rules:     rulesByHost[host],
+		}
+		if host != "" {
+			route.Hostnames = []string{host}
+		}
+		routes[fmt.Sprintf("%s-%d", ingress.Name, i)] = route
+	}
+	return routes, nil
+}
+
+func gatewayPathFor(path kube_networking.HTTPIngressPath) *mesh_proto.GatewayRoute_Rule_Match_Path {
+	value := path.Path
+	if value == "" {
+		value = "/"
+	}
+	pathType := mesh_proto.GatewayRoute_Rule_Match_Path_PREFIX
+	if path.PathType != nil && *path.PathType == kube_networking.PathTypeExact {
+		pathType = mesh_proto.GatewayRoute_Rule_Match_Path_EXACT
+	}
+	return &mesh_proto.GatewayRoute_Rule_Match_Path{
+		Type:  pathType,
+		Value: value,
+	}
+}
+
+func gatewayBackendFor(namespace string, backend kube_networking.IngressBackend, services []*kube_core.Service) (*mesh_proto.GatewayRoute_Rule_Backend, error) {
+	if backend.ServiceName == "" {
+		return nil, errors.New("only Service backends are supported")
+	}
+	service, err := serviceTagForBackend(namespace, backend, services)
+	if err != nil {
+		return nil, err
+	}
+	return &mesh_proto.GatewayRoute_Rule_Backend{
+		Destination: map[string]string{
+			mesh_proto.ServiceTag: service,
+		},
+		Weight: ingressBackendWeight,
+	}, nil
+}
+
+func serviceTagForBackend(namespace string, backend kube_networking.IngressBackend, services []*kube_core.Service) (string, error) {
+	for _, svc := range services {
+		if svc.Namespace != namespace || svc.Name != backend.ServiceName {
+			continue
+		}
+		for i := range svc.Spec.Ports {
+			svcPort := &svc.Spec.Ports[i]
+			if (backend.ServicePort.Type == kube_intstr.Int && svcPort.Port == backend.ServicePort.IntVal) ||
+				(backend.ServicePort.Type == kube_intstr.String && svcPort.Name == backend.ServicePort.StrVal) {
+				return ServiceTagFor(svc, svcPort), nil
+			}
+		}
+		return "", errors.Errorf("Service %q has no port %q", backend.ServiceName, backend.ServicePort.String())
+	}
+	// numeric port of a missing Service can still be resolved into a `kuma.io/service` tag
+	if backend.ServicePort.Type == kube_intstr.Int {
+		svc := &kube_core.Service{}
+		svc.Name, svc.Namespace = backend.ServiceName, namespace
+		return ServiceTagFor(svc, &kube_core.ServicePort{Port: backend.ServicePort.IntVal}), nil
+	}
+	return "", errors.Errorf("Service %q not found", backend.ServiceName)
+}
@@ -0,0 +1,229 @@
+package controllers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/ghodss/yaml"
+
+	kube_core "k8s.io/api/core/v1"
+	kube_networking "k8s.io/api/networking/v1beta1"
+
+	. "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("IngressConverter", func() {
+
+	parseIngress := func(input string) *kube_networking.Ingress {
+		ingress := &kube_networking.Ingress{}
+		Expect(yaml.Unmarshal([]byte(input), ingress)).To(Succeed())
+		return ingress
+	}
+
+	var services []*kube_core.Service
+	BeforeEach(func() {
+		services = []*kube_core.Service{{}}
+		Expect(yaml.Unmarshal([]byte(`
+metadata:
+  namespace: demo
+  name: web
+spec:
+  ports:
+  - name: http
+    port: 8080
+`), services[0])).To(Succeed())
+	})
+
+	Describe("IngressClassFor()", func() {
+		type testCase struct {
+			input    string
+			expected string
+		}
+
+		DescribeTable("should read ingress class",
+			func(given testCase) {
+				Expect(IngressClassFor(parseIngress(given.input))).To(Equal(given.expected))
+			},
+			Entry("from annotation", testCase{
+				input: `
+metadata:
+  annotations:
+    kubernetes.io/ingress.class: kuma
+spec:
+  ingressClassName: other
+`,
+				expected: "kuma",
+			}),
+			Entry("from spec", testCase{
+				input: `
+spec:
+  ingressClassName: kuma
+`,
+				expected: "kuma",
+			}),
+			Entry("when not set", testCase{
+				input:    `{}`,
+				expected: "",
+			}),
+		)
+	})
+
+	Describe("GatewayListenerFor()", func() {
+		It("should serve HTTP and HTTPS for TLS hosts", func() {
+			// given
+			ingress := parseIngress(`
+metadata:
+  namespace: demo
+  name: web
+  annotations:
+    kuma.io/gateway-service: edge
+spec:
+  tls:
+  - hosts:
+    - example.com
+    secretName: example-tls
+  - hosts:
+    - other.com
+    secretName: missing-tls
+`)
+			secrets := map[string]IngressTLSSecret{
+				"example-tls": {Certificate: "demo-web-example-tls-cert", Key: "demo-web-example-tls-key"},
+			}
+
+			// when
+			listener := GatewayListenerFor(ingress, secrets)
+
+			// then
+			actual, err := util_proto.ToYAML(listener)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(`
+selectors:
+- match:
+    kuma.io/service: edge
+listeners:
+- port: 80
+  hostname: '*'
+- port: 443
+  protocol: HTTPS
+  hostname: example.com
+  tls:
+    certificate:
+      secret: demo-web-example-tls-cert
+    key:
+      secret: demo-web-example-tls-key
+`))
+		})
+	})
+
+	Describe("GatewayRoutesFor()", func() {
+		It("should generate a route per host", func() {
+			// given
+			ingress := parseIngress(`
+metadata:
+  namespace: demo
+  name: web
+spec:
+  backend:
+    serviceName: fallback
+    servicePort: 80
+  rules:
+  - host: example.com
+    http:
+      paths:
+      - path: /api
+        pathType: Exact
+        backend:
+          serviceName: web
+          servicePort: http
+      - backend:
+          serviceName: web
+          servicePort: 8080
+`)
+
+			// when
+			routes, err := GatewayRoutesFor(ingress, services)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(routes).To(HaveLen(2))
+
+			// when
+			actual, err := util_proto.ToYAML(routes["web-0"])
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(`
+selectors:
+- match:
+    kuma.io/service: '*'
+hostnames:
+- example.com
+rules:
+- matches:
+  - path:
+      type: EXACT
+      value: /api
+  backends:
+  - destination:
+      kuma.io/service: web_demo_svc_8080
+    weight: 100
+- matches:
+  - path:
+      value: /
+  backends:
+  - destination:
+      kuma.io/service: web_demo_svc_8080
+    weight: 100
+- matches:
+  - path:
+      value: /
+  backends:
+  - destination:
+      kuma.io/service: fallback_demo_svc_80
+    weight: 100
+`))
+
+			// when
+			actual, err = util_proto.ToYAML(routes["web-1"])
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(`
+selectors:
+- match:
+    kuma.io/service: '*'
+rules:
+- matches:
+  - path:
+      value: /
+  backends:
+  - destination:
+      kuma.io/service: fallback_demo_svc_80
+    weight: 100
+`))
+		})
+
+		It("should fail on a named port of a missing Service", func() {
+			// given
+			ingress := parseIngress(`
+metadata:
+  namespace: demo
+  name: web
+spec:
+  rules:
+  - http:
+      paths:
+      - backend:
+          serviceName: missing
+          servicePort: http
+`)
+
+			// when
+			_, err := GatewayRoutesFor(ingress, services)
+
+			// then
+			Expect(err).To(MatchError(`Service "missing" not found`))
+		})
+	})
+})
@@ -2,8 +2,13 @@ package controllers
 
 import (
 	"context"
+	"time"
 
+	"github.com/kumahq/kuma/pkg/core"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
@@ -23,6 +28,7 @@ import (
 	kube_record "k8s.io/client-go/tools/record"
 
 	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	k8s_model "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
 	metadata "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/metadata"
 
 	util_k8s "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/util"
@@ -44,9 +50,12 @@ const (
 type PodReconciler struct {
 	kube_client.Client
 	kube_record.EventRecorder
-	Scheme       *kube_runtime.Scheme
-	Log          logr.Logger
-	PodConverter PodConverter
+	Scheme          *kube_runtime.Scheme
+	Log             logr.Logger
+	PodConverter    PodConverter
+	ResourceManager manager.ReadOnlyResourceManager
+	// LearnedDestinationsTTL is how long a destination learned by Dependencies is kept after it was last reported
+	LearnedDestinationsTTL time.Duration
 }
 
 func (r *PodReconciler) Reconcile(req kube_ctrl.Request) (kube_ctrl.Result, error) {
@@ -102,7 +111,12 @@ func (r *PodReconciler) Reconcile(req kube_ctrl.Request) (kube_ctrl.Result, erro
 
 	r.Log.WithValues("req", req).V(1).Info("other dataplanes", "others", others)
 
-	if err := r.createOrUpdateDataplane(pod, services, others); err != nil {
+	dependencies, err := r.findDependencies(pod)
+	if err != nil {
+		return kube_ctrl.Result{}, err
+	}
+
+	if err := r.createOrUpdateDataplane(pod, services, others, dependencies); err != nil {
 		return kube_ctrl.Result{}, err
 	}
 
@@ -150,7 +164,33 @@ func (r *PodReconciler) findOtherDataplanes(pod *kube_core.Pod) ([]*mesh_k8s.Dat
 	return otherDataplanes, nil
 }
 
-func (r *PodReconciler) createOrUpdateDataplane(pod *kube_core.Pod, services []*kube_core.Service, others []*mesh_k8s.Dataplane) error {
+// findDependencies lists Dependencies in the Mesh of the Pod together with destinations they learned.
+// Those are read through the ResourceManager, so Dependencies applied in a namespace are scoped the same way as other policies.
+func (r *PodReconciler) findDependencies(pod *kube_core.Pod) (Dependencies, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("pod", kube_types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name})
+
+	dependencies := &mesh_core.DependenciesResourceList{}
+	if err := r.ResourceManager.List(ctx, dependencies, store.ListByMesh(MeshFor(pod))); err != nil {
+		log.Error(err, "unable to list Dependencies")
+		return Dependencies{}, err
+	}
+	insights := &mesh_core.DependenciesInsightResourceList{}
+	if err := r.ResourceManager.List(ctx, insights, store.ListByMesh(MeshFor(pod))); err != nil {
+		log.Error(err, "unable to list Dependencies Insights")
+		return Dependencies{}, err
+	}
+	learned := map[string][]string{}
+	for _, insight := range insights.Items {
+		learned[insight.Meta.GetName()] = insight.Destinations(core.Now(), r.LearnedDestinationsTTL)
+	}
+	return Dependencies{
+		Resources:           dependencies.Items,
+		LearnedDestinations: learned,
+	}, nil
+}
+
+func (r *PodReconciler) createOrUpdateDataplane(pod *kube_core.Pod, services []*kube_core.Service, others []*mesh_k8s.Dataplane, dependencies Dependencies) error {
 	ctx := context.Background()
 
 	dataplane := &mesh_k8s.Dataplane{
@@ -160,7 +200,7 @@ func (r *PodReconciler) createOrUpdateDataplane(pod *kube_core.Pod, services []*
 		},
 	}
 	operationResult, err := kube_controllerutil.CreateOrUpdate(ctx, r.Client, dataplane, func() error {
-		if err := r.PodConverter.PodToDataplane(dataplane, pod, services, others); err != nil {
+		if err := r.PodConverter.PodToDataplane(dataplane, pod, services, others, dependencies); err != nil {
 			return errors.Wrap(err, "unable to translate a Pod into a Dataplane")
 		}
 		if err := kube_controllerutil.SetControllerReference(pod, dataplane, r.Scheme); err != nil {
@@ -233,6 +273,14 @@ func (r *PodReconciler) SetupWithManager(mgr kube_ctrl.Manager) error {
 		Watches(&kube_source.Kind{Type: &mesh_k8s.Dataplane{}}, &kube_handler.EnqueueRequestsFromMapFunc{
 			ToRequests: &DataplaneToSameMeshDataplanesMapper{Client: mgr.GetClient(), Log: r.Log.WithName("dataplane-to-dataplanes-mapper")},
 		}).
+		// on Dependencies update reconcile Dataplanes in the same Mesh, outbounds of the selected ones have to be limited
+		Watches(&kube_source.Kind{Type: &mesh_k8s.Dependencies{}}, &kube_handler.EnqueueRequestsFromMapFunc{
+			ToRequests: &DependenciesToDataplanesMapper{Client: mgr.GetClient(), Log: r.Log.WithName("dependencies-to-dataplanes-mapper")},
+		}).
+		// on DependenciesInsight update reconcile Dataplanes in the same Mesh, learned destinations have changed
+		Watches(&kube_source.Kind{Type: &mesh_k8s.DependenciesInsight{}}, &kube_handler.EnqueueRequestsFromMapFunc{
+			ToRequests: &DependenciesToDataplanesMapper{Client: mgr.GetClient(), Log: r.Log.WithName("dependencies-insight-to-dataplanes-mapper")},
+		}).
 		Complete(r)
 }
 
@@ -316,3 +364,47 @@ func (m *DataplaneToSameMeshDataplanesMapper) Map(obj kube_handler.MapObject) []
 	}
 	return req
 }
+
+type DependenciesToDataplanesMapper struct {
+	kube_client.Client
+	Log logr.Logger
+}
+
+func (m *DependenciesToDataplanesMapper) Map(obj kube_handler.MapObject) []kube_reconile.Request {
+	var cause k8s_model.KubernetesObject
+	switch object := obj.Object.(type) {
+	case *mesh_k8s.Dependencies:
+		cause = object
+	case *mesh_k8s.DependenciesInsight:
+		cause = object
+	default:
+		m.Log.WithValues("dependencies", obj.Meta).Error(errors.Errorf("wrong argument type: expected %T or %T, got %T", &mesh_k8s.Dependencies{}, &mesh_k8s.DependenciesInsight{}, obj.Object), "wrong argument type")
+		return nil
+	}
+
+	// List Dataplanes in the same Mesh as Dependencies
+	dataplanes := &mesh_k8s.DataplaneList{}
+	if err := m.Client.List(context.Background(), dataplanes); err != nil {
+		m.Log.WithValues("dependencies", obj.Meta).Error(err, "failed to fetch Dataplanes")
+		return nil
+	}
+
+	mesh := cause.GetMesh()
+	if mesh == "" {
+		mesh = model.DefaultMesh
+	}
+	var req []kube_reconile.Request
+	for _, dataplane := range dataplanes.Items {
+		if dataplane.Mesh != mesh {
+			continue
+		}
+		ownerRef := kube_meta.GetControllerOf(&dataplane)
+		if ownerRef == nil || ownerRef.Kind != "Pod" {
+			continue
+		}
+		req = append(req, kube_reconile.Request{
+			NamespacedName: kube_types.NamespacedName{Namespace: dataplane.Namespace, Name: ownerRef.Name},
+		})
+	}
+	return req
+}
@@ -11,6 +11,8 @@ import (
 	. "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers"
 
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
 
 	kube_types "k8s.io/apimachinery/pkg/types"
 	kube_ctrl "sigs.k8s.io/controller-runtime"
@@ -118,10 +120,11 @@ var _ = Describe("PodReconciler", func() {
 			})
 
 		reconciler = &PodReconciler{
-			Client:        kubeClient,
-			EventRecorder: kube_record.NewFakeRecorder(10),
-			Scheme:        k8sClientScheme,
-			Log:           core.Log.WithName("test"),
+			Client:          kubeClient,
+			EventRecorder:   kube_record.NewFakeRecorder(10),
+			Scheme:          k8sClientScheme,
+			Log:             core.Log.WithName("test"),
+			ResourceManager: manager.NewResourceManager(memory.NewStore()),
 		}
 	})
 
@@ -266,10 +269,12 @@ var _ = Describe("PodReconciler", func() {
                 app: sample
                 kuma.io/protocol: http
                 kuma.io/service: example_demo_svc_80
+                k8s.kuma.io/namespace: demo
             - port: 6060
               tags:
                 app: sample
                 kuma.io/service: example_demo_svc_6061
+                k8s.kuma.io/namespace: demo
                 kuma.io/protocol: tcp
 `))
 	})
@@ -338,10 +343,12 @@ var _ = Describe("PodReconciler", func() {
                 app: sample
                 kuma.io/protocol: http
                 kuma.io/service: example_demo_svc_80
+                k8s.kuma.io/namespace: demo
             - port: 6060
               tags:
                 app: sample
                 kuma.io/service: example_demo_svc_6061
+                k8s.kuma.io/namespace: demo
                 kuma.io/protocol: tcp
 `))
 	})
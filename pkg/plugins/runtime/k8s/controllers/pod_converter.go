@@ -26,9 +26,9 @@ type PodConverter struct {
 	Zone          string
 }
 
-func (p *PodConverter) PodToDataplane(dataplane *mesh_k8s.Dataplane, pod *kube_core.Pod, services []*kube_core.Service, others []*mesh_k8s.Dataplane) error {
+func (p *PodConverter) PodToDataplane(dataplane *mesh_k8s.Dataplane, pod *kube_core.Pod, services []*kube_core.Service, others []*mesh_k8s.Dataplane, dependencies Dependencies) error {
 	dataplane.Mesh = MeshFor(pod)
-	dataplaneProto, err := p.DataplaneFor(pod, services, others)
+	dataplaneProto, err := p.DataplaneFor(pod, services, others, dependencies)
 	if err != nil {
 		return err
 	}
@@ -62,7 +62,7 @@ func MeshFor(pod *kube_core.Pod) string {
 	return mesh
 }
 
-func (p *PodConverter) DataplaneFor(pod *kube_core.Pod, services []*kube_core.Service, others []*mesh_k8s.Dataplane) (*mesh_proto.Dataplane, error) {
+func (p *PodConverter) DataplaneFor(pod *kube_core.Pod, services []*kube_core.Service, others []*mesh_k8s.Dataplane, dependencies Dependencies) (*mesh_proto.Dataplane, error) {
 	dataplane := &mesh_proto.Dataplane{
 		Networking: &mesh_proto.Dataplane_Networking{},
 	}
@@ -120,7 +120,7 @@ func (p *PodConverter) DataplaneFor(pod *kube_core.Pod, services []*kube_core.Se
 	if err != nil {
 		return nil, err
 	}
-	dataplane.Networking.Outbound = ofaces
+	dataplane.Networking.Outbound = OutboundDependenciesFor(pod, dataplane, ofaces, dependencies)
 
 	metrics, err := MetricsFor(pod)
 	if err != nil {
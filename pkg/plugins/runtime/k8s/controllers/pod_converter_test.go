@@ -18,7 +18,10 @@ import (
 
 	. "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/controllers"
 
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	util_yaml "github.com/kumahq/kuma/pkg/util/yaml"
 
 	kube_core "k8s.io/api/core/v1"
@@ -96,12 +99,29 @@ var _ = Describe("PodToDataplane(..)", func() {
 		return dataplanes, nil
 	}
 
+	ParseDependencies := func(values []string) ([]*mesh_core.DependenciesResource, error) {
+		dependencies := make([]*mesh_core.DependenciesResource, len(values))
+		for i, value := range values {
+			dependency := &mesh_core.DependenciesResource{
+				Meta: &test_model.ResourceMeta{Name: fmt.Sprintf("dependencies-%d", i)},
+			}
+			if err := util_proto.FromYAML([]byte(value), &dependency.Spec); err != nil {
+				return nil, err
+			}
+			dependencies[i] = dependency
+		}
+		return dependencies, nil
+	}
+
 	type testCase struct {
 		pod             string
 		servicesForPod  string
 		otherDataplanes string
 		otherServices   string
-		dataplane       string
+		dependencies    string
+		// learned destinations by the name of Dependencies
+		learnedDestinations map[string][]string
+		dataplane           string
 	}
 	DescribeTable("should convert Pod into a Dataplane YAML version",
 		func(given testCase) {
@@ -143,6 +163,18 @@ var _ = Describe("PodToDataplane(..)", func() {
 				Expect(err).ToNot(HaveOccurred())
 			}
 
+			// dependencies
+			dependencies := Dependencies{
+				LearnedDestinations: given.learnedDestinations,
+			}
+			if given.dependencies != "" {
+				bytes, err = ioutil.ReadFile(filepath.Join("testdata", given.dependencies))
+				Expect(err).ToNot(HaveOccurred())
+				YAMLs := util_yaml.SplitYAML(string(bytes))
+				dependencies.Resources, err = ParseDependencies(YAMLs)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
 			converter := PodConverter{
 				ServiceGetter: serviceGetter,
 				Zone:          "zone-1",
@@ -150,7 +182,7 @@ var _ = Describe("PodToDataplane(..)", func() {
 
 			// when
 			dataplane := &mesh_k8s.Dataplane{}
-			err = converter.PodToDataplane(dataplane, pod, services, otherDataplanes)
+			err = converter.PodToDataplane(dataplane, pod, services, otherDataplanes, dependencies)
 
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -219,6 +251,24 @@ var _ = Describe("PodToDataplane(..)", func() {
 			servicesForPod: "10.services-for-pod.yaml",
 			dataplane:      "10.dataplane.yaml",
 		}),
+		Entry("11. Pod with dependencies annotation", testCase{
+			pod:             "11.pod.yaml",
+			servicesForPod:  "11.services-for-pod.yaml",
+			otherDataplanes: "11.other-dataplanes.yaml",
+			otherServices:   "11.other-services.yaml",
+			dataplane:       "11.dataplane.yaml",
+		}),
+		Entry("12. Pod selected by Dependencies with learned destinations", testCase{
+			pod:             "12.pod.yaml",
+			servicesForPod:  "12.services-for-pod.yaml",
+			otherDataplanes: "12.other-dataplanes.yaml",
+			otherServices:   "12.other-services.yaml",
+			dependencies:    "12.dependencies.yaml",
+			learnedDestinations: map[string][]string{
+				"dependencies-0": {"10.108.144.24:443"},
+			},
+			dataplane: "12.dataplane.yaml",
+		}),
 	)
 
 	Context("when Dataplane cannot be generated", func() {
@@ -243,7 +293,7 @@ var _ = Describe("PodToDataplane(..)", func() {
 				dataplane := &mesh_k8s.Dataplane{}
 
 				// when
-				err = converter.PodToDataplane(dataplane, pod, services, nil)
+				err = converter.PodToDataplane(dataplane, pod, services, nil, Dependencies{})
 
 				// then
 				Expect(err).To(HaveOccurred())
@@ -337,7 +387,8 @@ var _ = Describe("InboundTagsFor(..)", func() {
 			// given
 			pod := &kube_core.Pod{
 				ObjectMeta: kube_meta.ObjectMeta{
-					Labels: given.podLabels,
+					Namespace: "demo",
+					Labels:    given.podLabels,
 				},
 			}
 			// and
@@ -371,8 +422,9 @@ var _ = Describe("InboundTagsFor(..)", func() {
 			isGateway: false,
 			podLabels: nil,
 			expected: map[string]string{
-				"kuma.io/service":  "example_demo_svc_80",
-				"kuma.io/protocol": "tcp", // we want Kuma's default behaviour to be explicit to a user
+				"kuma.io/service":       "example_demo_svc_80",
+				"k8s.kuma.io/namespace": "demo",
+				"kuma.io/protocol":      "tcp", // we want Kuma's default behaviour to be explicit to a user
 			},
 		}),
 		Entry("Pod with labels", testCase{
@@ -382,10 +434,11 @@ var _ = Describe("InboundTagsFor(..)", func() {
 				"version": "0.1",
 			},
 			expected: map[string]string{
-				"app":              "example",
-				"version":          "0.1",
-				"kuma.io/service":  "example_demo_svc_80",
-				"kuma.io/protocol": "tcp", // we want Kuma's default behaviour to be explicit to a user
+				"app":                   "example",
+				"version":               "0.1",
+				"kuma.io/service":       "example_demo_svc_80",
+				"k8s.kuma.io/namespace": "demo",
+				"kuma.io/protocol":      "tcp", // we want Kuma's default behaviour to be explicit to a user
 			},
 		}),
 		Entry("Pod with `service` label", testCase{
@@ -396,10 +449,11 @@ var _ = Describe("InboundTagsFor(..)", func() {
 				"version":         "0.1",
 			},
 			expected: map[string]string{
-				"app":              "example",
-				"version":          "0.1",
-				"kuma.io/service":  "example_demo_svc_80",
-				"kuma.io/protocol": "tcp", // we want Kuma's default behaviour to be explicit to a user
+				"app":                   "example",
+				"version":               "0.1",
+				"kuma.io/service":       "example_demo_svc_80",
+				"k8s.kuma.io/namespace": "demo",
+				"kuma.io/protocol":      "tcp", // we want Kuma's default behaviour to be explicit to a user
 			},
 		}),
 		Entry("Service with a `<port>.service.kuma.io/protocol` annotation and an unknown value", testCase{
@@ -412,10 +466,11 @@ var _ = Describe("InboundTagsFor(..)", func() {
 				"80.service.kuma.io/protocol": "not-yet-supported-protocol",
 			},
 			expected: map[string]string{
-				"app":              "example",
-				"version":          "0.1",
-				"kuma.io/service":  "example_demo_svc_80",
-				"kuma.io/protocol": "not-yet-supported-protocol", // we want Kuma's behaviour to be straightforward to a user (just copy annotation value "as is")
+				"app":                   "example",
+				"version":               "0.1",
+				"kuma.io/service":       "example_demo_svc_80",
+				"k8s.kuma.io/namespace": "demo",
+				"kuma.io/protocol":      "not-yet-supported-protocol", // we want Kuma's behaviour to be straightforward to a user (just copy annotation value "as is")
 			},
 		}),
 		Entry("Service with a `<port>.service.kuma.io/protocol` annotation and a known value", testCase{
@@ -428,10 +483,11 @@ var _ = Describe("InboundTagsFor(..)", func() {
 				"80.service.kuma.io/protocol": "http",
 			},
 			expected: map[string]string{
-				"app":              "example",
-				"version":          "0.1",
-				"kuma.io/service":  "example_demo_svc_80",
-				"kuma.io/protocol": "http",
+				"app":                   "example",
+				"version":               "0.1",
+				"kuma.io/service":       "example_demo_svc_80",
+				"k8s.kuma.io/namespace": "demo",
+				"kuma.io/protocol":      "http",
 			},
 		}),
 		Entry("Inject a zone tag if Zone is set", testCase{
@@ -442,11 +498,12 @@ var _ = Describe("InboundTagsFor(..)", func() {
 				"version": "0.1",
 			},
 			expected: map[string]string{
-				"app":                  "example",
-				"version":              "0.1",
-				mesh_proto.ServiceTag:  "example_demo_svc_80",
-				mesh_proto.ZoneTag:     "zone-1",
-				mesh_proto.ProtocolTag: "tcp",
+				"app":                       "example",
+				"version":                   "0.1",
+				mesh_proto.ServiceTag:       "example_demo_svc_80",
+				mesh_proto.KubeNamespaceTag: "demo",
+				mesh_proto.ZoneTag:          "zone-1",
+				mesh_proto.ProtocolTag:      "tcp",
 			},
 		}),
 		Entry("Pod with empty labels", testCase{
@@ -456,9 +513,10 @@ var _ = Describe("InboundTagsFor(..)", func() {
 				"version": "",
 			},
 			expected: map[string]string{
-				"app":              "example",
-				"kuma.io/service":  "example_demo_svc_80",
-				"kuma.io/protocol": "tcp",
+				"app":                   "example",
+				"kuma.io/service":       "example_demo_svc_80",
+				"k8s.kuma.io/namespace": "demo",
+				"kuma.io/protocol":      "tcp",
 			},
 		}),
 	)
@@ -2,6 +2,7 @@ package metadata
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -21,6 +22,11 @@ const (
 	// inbound listeners won't be generated in that case
 	KumaGatewayAnnotation = "kuma.io/gateway"
 
+	// KumaGatewayServiceAnnotation defines an Ingress annotation that
+	// limits gateway Dataplanes serving the Ingress to those of a given `kuma.io/service`.
+	// By default, the Ingress is served by all gateway Dataplanes in the Mesh.
+	KumaGatewayServiceAnnotation = "kuma.io/gateway-service"
+
 	// KumaIngressAnnotation allows to mark pod with Kuma Ingress
 	// which is crucial for Multicluster communication
 	KumaIngressAnnotation = "kuma.io/ingress"
@@ -28,6 +34,10 @@ const (
 	// KumaDirectAccess defines a comma-separated list of Services that will be accessed directly
 	KumaDirectAccess = "kuma.io/direct-access-services"
 
+	// KumaDependenciesAnnotation defines a comma-separated list of services the Pod depends on.
+	// When set, the Dataplane of the Pod gets outbounds only for those services and for dependencies defined by Dependencies resources.
+	KumaDependenciesAnnotation = "kuma.io/dependencies"
+
 	// KumaVirtualProbesAnnotation enables automatic converting HttpGet probes to virtual. Virtual probe
 	// serves on sub-path of insecure port defined in 'KumaVirtualProbesPortAnnotation',
 	// i.e :8080/health/readiness -> :9000/8080/health/readiness where 9000 is a value of'KumaVirtualProbesPortAnnotation'
@@ -41,6 +51,28 @@ const (
 
 	// KumaMetricsPrometheusPath to override `Mesh`-wide default path
 	KumaMetricsPrometheusPath = "prometheus.metrics.kuma.io/path"
+
+	// KumaSidecarCPURequestAnnotation overrides CPU request of the sidecar container
+	KumaSidecarCPURequestAnnotation = "kuma.io/sidecar-cpu-request"
+
+	// KumaSidecarCPULimitAnnotation overrides CPU limit of the sidecar container
+	KumaSidecarCPULimitAnnotation = "kuma.io/sidecar-cpu-limit"
+
+	// KumaSidecarMemoryRequestAnnotation overrides memory request of the sidecar container
+	KumaSidecarMemoryRequestAnnotation = "kuma.io/sidecar-memory-request"
+
+	// KumaSidecarMemoryLimitAnnotation overrides memory limit of the sidecar container
+	KumaSidecarMemoryLimitAnnotation = "kuma.io/sidecar-memory-limit"
+
+	// KumaSidecarLogLevelAnnotation overrides log level of the sidecar, available values are: "off", "info", "debug"
+	KumaSidecarLogLevelAnnotation = "kuma.io/sidecar-log-level"
+
+	// KumaSidecarConcurrencyAnnotation defines a number of worker threads of Envoy
+	KumaSidecarConcurrencyAnnotation = "kuma.io/sidecar-concurrency"
+
+	// KumaSidecarDrainTimeAnnotation overrides drain time of the sidecar, i.e. "30s".
+	// Termination grace period of the Pod is extended accordingly.
+	KumaSidecarDrainTimeAnnotation = "kuma.io/sidecar-drain-time"
 )
 
 // Annotations that are being automatically set by the Kuma Sidecar Injector.
@@ -95,6 +127,18 @@ func (a Annotations) GetString(key string) (string, bool) {
 	return value, true
 }
 
+func (a Annotations) GetDuration(key string) (time.Duration, bool, error) {
+	value, ok := a[key]
+	if !ok {
+		return 0, false, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, true, err
+	}
+	return d, true, nil
+}
+
 func (a Annotations) GetBool(key string) (bool, bool, error) {
 	value, ok := a[key]
 	if !ok {
@@ -18,6 +18,7 @@ import (
 	kube_webhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	managers_mesh "github.com/kumahq/kuma/pkg/core/managers/apis/mesh"
 	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
 	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
@@ -74,6 +75,9 @@ func addControllers(mgr kube_ctrl.Manager, rt core_runtime.Runtime) error {
 	if err := addPodReconciler(mgr, rt); err != nil {
 		return err
 	}
+	if err := addIngressReconciler(mgr, rt); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -117,6 +121,24 @@ func addPodReconciler(mgr kube_ctrl.Manager, rt core_runtime.Runtime) error {
 			ServiceGetter: mgr.GetClient(),
 			Zone:          rt.Config().Multicluster.Remote.Zone,
 		},
+		ResourceManager:        rt.ReadOnlyResourceManager(),
+		LearnedDestinationsTTL: rt.Config().Runtime.Kubernetes.LearnedDestinations.TTL,
+	}
+	return reconciler.SetupWithManager(mgr)
+}
+
+func addIngressReconciler(mgr kube_ctrl.Manager, rt core_runtime.Runtime) error {
+	cfg := rt.Config().Runtime.Kubernetes.IngressController
+	if !cfg.Enabled {
+		return nil
+	}
+	reconciler := &controllers.KubernetesIngressReconciler{
+		Client:          mgr.GetClient(),
+		EventRecorder:   mgr.GetEventRecorderFor("k8s.kuma.io/gateway-generator"),
+		Scheme:          mgr.GetScheme(),
+		Log:             core.Log.WithName("controllers").WithName("Ingress"),
+		SystemNamespace: rt.Config().Store.Kubernetes.SystemNamespace,
+		IngressClass:    cfg.IngressClass,
 	}
 	return reconciler.SetupWithManager(mgr)
 }
@@ -148,7 +170,21 @@ func generateDefaulterPath(gvk kube_schema.GroupVersionKind) string {
 func addValidators(mgr kube_ctrl.Manager, rt core_runtime.Runtime) error {
 	composite := k8s_webhooks.CompositeValidator{}
 
-	handler := k8s_webhooks.NewValidatingWebhook(k8s_resources.DefaultConverter(), core_registry.Global(), k8s_registry.Global(), rt.Config().Mode)
+	var auditor *k8s_webhooks.Auditor
+	if recorder, ok := audit.FromRecorderContext(rt.Extensions()); ok {
+		auditor = k8s_webhooks.NewAuditor(recorder, k8s_resources.DefaultConverter(), rt.Config().Store.Kubernetes.SystemNamespace)
+		if err := auditor.Watch(mgr.GetCache()); err != nil {
+			return err
+		}
+	}
+
+	if rt.Config().Store.Kubernetes.NamespaceScopedPolicies {
+		// registered before the validating webhook, so rejected policies are not noted by the auditor
+		namespacedPolicyValidator := k8s_webhooks.NewNamespacedPolicyValidator(k8s_resources.DefaultConverter(), core_registry.Global(), k8s_registry.Global(), rt.Config().Store.Kubernetes.SystemNamespace)
+		composite.AddValidator(namespacedPolicyValidator)
+	}
+
+	handler := k8s_webhooks.NewValidatingWebhook(k8s_resources.DefaultConverter(), core_registry.Global(), k8s_registry.Global(), rt.Config().Mode, auditor)
 	composite.AddValidator(handler)
 
 	coreMeshValidator := managers_mesh.MeshValidator{CaManagers: rt.CaManagers()}
@@ -162,9 +198,13 @@ func addValidators(mgr kube_ctrl.Manager, rt core_runtime.Runtime) error {
 	mgr.GetWebhookServer().Register("/validate-v1-service", &kube_webhook.Admission{Handler: &k8s_webhooks.ServiceValidator{}})
 	log.Info("Registering a validation webhook for v1/Service", "path", "/validate-v1-service")
 
+	mgr.GetWebhookServer().Register("/validate-kuma-io-v1alpha1-sidecartemplate", &kube_webhook.Admission{Handler: &k8s_webhooks.SidecarTemplateValidator{}})
+	log.Info("Registering a validation webhook for kuma.io/v1alpha1/SidecarTemplate", "path", "/validate-kuma-io-v1alpha1-sidecartemplate")
+
 	secretValidator := &k8s_webhooks.SecretValidator{
 		Client:    mgr.GetClient(),
 		Validator: manager.NewSecretValidator(rt.CaManagers(), rt.ResourceStore()),
+		Auditor:   auditor,
 	}
 	mgr.GetWebhookServer().Register("/validate-v1-secret", &kube_webhook.Admission{Handler: secretValidator})
 	log.Info("Registering a validation webhook for v1/Secret", "path", "/validate-v1-secret")
@@ -178,6 +218,7 @@ func addMutators(mgr kube_ctrl.Manager, rt core_runtime.Runtime) {
 			rt.Config().Runtime.Kubernetes.Injector,
 			rt.Config().ApiServer.Catalog.ApiServer.Url,
 			mgr.GetClient(),
+			rt.Config().Store.Kubernetes.SystemNamespace,
 		)
 		mgr.GetWebhookServer().Register("/inject-sidecar", k8s_webhooks.PodMutatingWebhook(kumaInjector.InjectKuma))
 	}
@@ -0,0 +1,278 @@
+package webhooks
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"k8s.io/api/admission/v1beta1"
+	kube_core "k8s.io/api/core/v1"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
+	kube_toolscache "k8s.io/client-go/tools/cache"
+	kube_cache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kuma_core "github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_registry "github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	k8s_resources "github.com/kumahq/kuma/pkg/plugins/resources/k8s"
+	k8s_model "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+)
+
+var auditLog = kuma_core.Log.WithName("webhooks").WithName("audit")
+
+// admittedChangeTTL is how long an admitted change waits to be observed. Changes that are not observed in time
+// were rejected by other admission webhooks or by the Kubernetes API Server.
+const admittedChangeTTL = time.Minute
+
+// InformerSource provides informers of Kubernetes objects. It is implemented by the cache of the controller manager.
+type InformerSource interface {
+	GetInformer(ctx context.Context, obj kube_runtime.Object) (kube_cache.Informer, error)
+}
+
+// Auditor records changes of Kuma resources made through the Kubernetes API Server.
+// Only the admission webhook knows who made a change, but an admitted change can still be rejected later on.
+// Therefore the webhook only notes the admitted change and the change is recorded once it is observed in the watch
+// of the resource. Every instance of the Control Plane records only the changes that it admitted itself,
+// so a change is recorded once even though all instances watch all resources.
+// Changes made by service accounts of the system namespace are skipped, because they are made by the Control Plane
+// and they are already recorded by its Resource Manager.
+type Auditor struct {
+	recorder        *audit.Recorder
+	converter       k8s_resources.Converter
+	systemNamespace string
+
+	sync.Mutex
+	admitted map[admittedKey][]admittedChange
+}
+
+type admittedKey struct {
+	resType core_model.ResourceType
+	key     core_model.ResourceKey
+}
+
+type admittedChange struct {
+	operation store.ChangeOperation
+	user      user.User
+	after     core_model.Resource
+	time      time.Time
+}
+
+func NewAuditor(recorder *audit.Recorder, converter k8s_resources.Converter, systemNamespace string) *Auditor {
+	return &Auditor{
+		recorder:        recorder,
+		converter:       converter,
+		systemNamespace: systemNamespace,
+		admitted:        map[admittedKey][]admittedChange{},
+	}
+}
+
+// Admit notes the change of the resource admitted by the webhook. after is nil for deleted resources.
+// It is a noop on a nil Auditor.
+func (a *Auditor) Admit(req admission.Request, resType core_model.ResourceType, key core_model.ResourceKey, after core_model.Resource) {
+	if a == nil || !a.recorder.Audits(resType) {
+		return
+	}
+	if req.DryRun != nil && *req.DryRun {
+		return
+	}
+	if strings.HasPrefix(req.UserInfo.Username, "system:serviceaccount:"+a.systemNamespace+":") {
+		return
+	}
+	var operation store.ChangeOperation
+	switch req.Operation {
+	case v1beta1.Create:
+		operation = store.CreateOperation
+	case v1beta1.Update:
+		operation = store.UpdateOperation
+	case v1beta1.Delete:
+		operation = store.DeleteOperation
+	default:
+		return
+	}
+	a.Lock()
+	defer a.Unlock()
+	aKey := admittedKey{resType: resType, key: key}
+	a.admitted[aKey] = append(a.admitted[aKey], admittedChange{
+		operation: operation,
+		user: user.User{
+			Name:   req.UserInfo.Username,
+			Groups: req.UserInfo.Groups,
+		},
+		after: after,
+		time:  kuma_core.Now(),
+	})
+}
+
+// Watch observes changes of resources of the audited types and records the ones admitted by the Auditor.
+func (a *Auditor) Watch(informers InformerSource) error {
+	for _, resType := range core_registry.Global().ObjectTypes() {
+		if !a.recorder.Audits(resType) {
+			continue
+		}
+		obj, err := a.kubernetesObject(resType)
+		if err != nil {
+			return err
+		}
+		if obj == nil {
+			continue // type is not stored in Kubernetes
+		}
+		informer, err := informers.GetInformer(context.Background(), obj)
+		if err != nil {
+			return errors.Wrapf(err, "could not get an informer of %s", resType)
+		}
+		informer.AddEventHandler(a.eventHandler(resType))
+	}
+	return nil
+}
+
+func (a *Auditor) kubernetesObject(resType core_model.ResourceType) (kube_runtime.Object, error) {
+	if resType == system.SecretType {
+		return &kube_core.Secret{}, nil
+	}
+	res, err := core_registry.Global().NewObject(resType)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := a.converter.ToKubernetesObject(res)
+	if err != nil {
+		return nil, nil
+	}
+	return obj, nil
+}
+
+func (a *Auditor) eventHandler(resType core_model.ResourceType) kube_toolscache.ResourceEventHandler {
+	return kube_toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			a.observe(store.CreateOperation, resType, nil, obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldKubeObj, oldOk := oldObj.(kube_meta.Object)
+			newKubeObj, newOk := newObj.(kube_meta.Object)
+			if oldOk && newOk && oldKubeObj.GetResourceVersion() == newKubeObj.GetResourceVersion() {
+				return // periodic resync
+			}
+			a.observe(store.UpdateOperation, resType, oldObj, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(kube_toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			a.observe(store.DeleteOperation, resType, obj, nil)
+		},
+	}
+}
+
+func (a *Auditor) observe(operation store.ChangeOperation, resType core_model.ResourceType, oldObj, newObj interface{}) {
+	var key core_model.ResourceKey
+	var before, after core_model.Resource
+	if oldObj != nil {
+		k, res, ok := a.convert(resType, oldObj)
+		if !ok {
+			return
+		}
+		key, before = k, res
+	}
+	if newObj != nil {
+		k, res, ok := a.convert(resType, newObj)
+		if !ok {
+			return
+		}
+		key, after = k, res
+	}
+	change, ok := a.claim(operation, resType, key, after)
+	if !ok {
+		return
+	}
+	ctx := user.NewContext(context.Background(), change.user)
+	ctx = audit.NewSourceContext(ctx, audit.Source{Origin: audit.OriginKubernetes})
+	a.recorder.Record(ctx, audit.OriginKubernetes, operation, resType, key, before, after)
+}
+
+// convert returns the key of the observed object together with its core counterpart. Secrets are not converted,
+// because their values must not be recorded. It returns false for objects that are not Kuma resources.
+func (a *Auditor) convert(resType core_model.ResourceType, obj interface{}) (core_model.ResourceKey, core_model.Resource, bool) {
+	if secret, ok := obj.(*kube_core.Secret); ok {
+		if !isKumaSecret(secret) {
+			return core_model.ResourceKey{}, nil, false
+		}
+		return core_model.ResourceKey{Name: secret.GetName(), Mesh: meshOfSecret(secret)}, nil, true
+	}
+	kubeObj, ok := obj.(k8s_model.KubernetesObject)
+	if !ok {
+		auditLog.Error(errors.Errorf("unexpected object %T", obj), "could not convert the object", "type", resType)
+		return core_model.ResourceKey{}, nil, false
+	}
+	res, err := core_registry.Global().NewObject(resType)
+	if err == nil {
+		err = a.converter.ToCoreResource(kubeObj, res)
+	}
+	if err != nil {
+		auditLog.Error(err, "could not convert the object", "type", resType, "name", kubeObj.GetName())
+		return core_model.ResourceKey{}, nil, false
+	}
+	return core_model.MetaToResourceKey(res.GetMeta()), res, true
+}
+
+// claim removes and returns the admitted change that matches the observed one.
+func (a *Auditor) claim(operation store.ChangeOperation, resType core_model.ResourceType, key core_model.ResourceKey, after core_model.Resource) (admittedChange, bool) {
+	a.Lock()
+	defer a.Unlock()
+	a.expire()
+	aKey := admittedKey{resType: resType, key: key}
+	changes := a.admitted[aKey]
+	for i, change := range changes {
+		if change.operation != operation || !sameContent(change.after, after) {
+			continue
+		}
+		changes = append(changes[:i:i], changes[i+1:]...)
+		if len(changes) == 0 {
+			delete(a.admitted, aKey)
+		} else {
+			a.admitted[aKey] = changes
+		}
+		return change, true
+	}
+	return admittedChange{}, false
+}
+
+func (a *Auditor) expire() {
+	for aKey, changes := range a.admitted {
+		var valid []admittedChange
+		for _, change := range changes {
+			if kuma_core.Now().Sub(change.time) < admittedChangeTTL {
+				valid = append(valid, change)
+			}
+		}
+		if len(valid) == 0 {
+			delete(a.admitted, aKey)
+		} else {
+			a.admitted[aKey] = valid
+		}
+	}
+}
+
+// sameContent checks that the admitted resource was persisted as it is. Deleted resources and secrets have no content.
+func sameContent(admitted, observed core_model.Resource) bool {
+	if admitted == nil || observed == nil {
+		return admitted == nil && observed == nil
+	}
+	if !proto.Equal(admitted.GetSpec(), observed.GetSpec()) {
+		return false
+	}
+	admittedLabels := admitted.GetMeta().GetLabels()
+	observedLabels := observed.GetMeta().GetLabels()
+	if len(admittedLabels) == 0 && len(observedLabels) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(admittedLabels, observedLabels)
+}
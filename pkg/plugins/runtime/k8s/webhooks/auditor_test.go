@@ -0,0 +1,182 @@
+package webhooks_test
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
+	kube_toolscache "k8s.io/client-go/tools/cache"
+	kube_cache "sigs.k8s.io/controller-runtime/pkg/cache"
+	kube_admission "sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kumahq/kuma/pkg/core/audit"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	k8s_resources "github.com/kumahq/kuma/pkg/plugins/resources/k8s"
+	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/webhooks"
+)
+
+type recordingSink struct {
+	sync.Mutex
+	records []audit.Record
+}
+
+func (r *recordingSink) Write(record audit.Record) error {
+	r.Lock()
+	defer r.Unlock()
+	r.records = append(r.records, record)
+	return nil
+}
+
+type fakeInformer struct {
+	handlers []kube_toolscache.ResourceEventHandler
+}
+
+func (f *fakeInformer) AddEventHandler(handler kube_toolscache.ResourceEventHandler) {
+	f.handlers = append(f.handlers, handler)
+}
+
+func (f *fakeInformer) AddEventHandlerWithResyncPeriod(handler kube_toolscache.ResourceEventHandler, _ time.Duration) {
+	f.AddEventHandler(handler)
+}
+
+func (f *fakeInformer) AddIndexers(kube_toolscache.Indexers) error {
+	return nil
+}
+
+func (f *fakeInformer) HasSynced() bool {
+	return true
+}
+
+type fakeInformers map[reflect.Type]*fakeInformer
+
+func (f fakeInformers) GetInformer(_ context.Context, obj kube_runtime.Object) (kube_cache.Informer, error) {
+	informer, ok := f[reflect.TypeOf(obj)]
+	if !ok {
+		informer = &fakeInformer{}
+		f[reflect.TypeOf(obj)] = informer
+	}
+	return informer, nil
+}
+
+var _ = Describe("Auditor", func() {
+
+	var sink *recordingSink
+	var auditor *webhooks.Auditor
+	var informer *fakeInformer
+
+	BeforeEach(func() {
+		sink = &recordingSink{}
+		recorder := audit.NewRecorder(sink, []core_model.ResourceType{core_mesh.TrafficRouteType})
+		auditor = webhooks.NewAuditor(recorder, k8s_resources.DefaultConverter(), "kuma-system")
+		informers := fakeInformers{}
+		Expect(auditor.Watch(informers)).To(Succeed())
+		informer = informers[reflect.TypeOf(&mesh_k8s.TrafficRoute{})]
+		Expect(informer).ToNot(BeNil())
+	})
+
+	route := func(version string, service string) *mesh_k8s.TrafficRoute {
+		obj := &mesh_k8s.TrafficRoute{
+			ObjectMeta: kube_meta.ObjectMeta{
+				Name:            "route-1",
+				ResourceVersion: version,
+			},
+		}
+		obj.SetMesh("default")
+		obj.SetSpec(map[string]interface{}{
+			"sources":      []interface{}{map[string]interface{}{"match": map[string]interface{}{"kuma.io/service": "*"}}},
+			"destinations": []interface{}{map[string]interface{}{"match": map[string]interface{}{"kuma.io/service": "backend"}}},
+			"conf": []interface{}{map[string]interface{}{
+				"weight":      100,
+				"destination": map[string]interface{}{"kuma.io/service": service},
+			}},
+		})
+		return obj
+	}
+
+	admit := func(operation admissionv1beta1.Operation, username string, obj *mesh_k8s.TrafficRoute) {
+		req := kube_admission.Request{
+			AdmissionRequest: admissionv1beta1.AdmissionRequest{
+				Operation: operation,
+				UserInfo:  authenticationv1.UserInfo{Username: username},
+			},
+		}
+		key := core_model.ResourceKey{Name: "route-1", Mesh: "default"}
+		var after core_model.Resource
+		if obj != nil {
+			res := &core_mesh.TrafficRouteResource{}
+			Expect(k8s_resources.DefaultConverter().ToCoreResource(obj, res)).To(Succeed())
+			after = res
+		}
+		auditor.Admit(req, core_mesh.TrafficRouteType, key, after)
+	}
+
+	It("should record admitted changes once they are observed", func() {
+		// given
+		admit(admissionv1beta1.Create, "john", route("", "backend-v1"))
+		admit(admissionv1beta1.Update, "jane", route("1", "backend-v2"))
+		admit(admissionv1beta1.Delete, "mark", nil)
+
+		// when
+		informer.handlers[0].OnAdd(route("1", "backend-v1"))
+		informer.handlers[0].OnUpdate(route("1", "backend-v1"), route("2", "backend-v2"))
+		informer.handlers[0].OnDelete(route("2", "backend-v2"))
+
+		// then
+		Expect(sink.records).To(HaveLen(3))
+		Expect(sink.records[0].Operation).To(Equal(store.CreateOperation))
+		Expect(sink.records[0].Principal).To(Equal("john"))
+		Expect(sink.records[0].Origin).To(Equal(audit.OriginKubernetes))
+		Expect(sink.records[0].Name).To(Equal("route-1"))
+		Expect(sink.records[0].Mesh).To(Equal("default"))
+		Expect(sink.records[1].Operation).To(Equal(store.UpdateOperation))
+		Expect(sink.records[1].Principal).To(Equal("jane"))
+		Expect(sink.records[1].Diff).To(ContainSubstring("-      kuma.io/service: backend-v1"))
+		Expect(sink.records[1].Diff).To(ContainSubstring("+      kuma.io/service: backend-v2"))
+		Expect(sink.records[2].Operation).To(Equal(store.DeleteOperation))
+		Expect(sink.records[2].Principal).To(Equal("mark"))
+	})
+
+	It("should not record admitted changes that were not persisted", func() {
+		// given the change is rejected after admission
+		admit(admissionv1beta1.Update, "jane", route("1", "backend-v2"))
+
+		// when another change is observed
+		informer.handlers[0].OnUpdate(route("1", "backend-v1"), route("2", "backend-v3"))
+
+		// then
+		Expect(sink.records).To(BeEmpty())
+	})
+
+	It("should not record changes made by the Control Plane", func() {
+		// given
+		admit(admissionv1beta1.Create, "system:serviceaccount:kuma-system:kuma-control-plane", route("", "backend-v1"))
+
+		// when
+		informer.handlers[0].OnAdd(route("1", "backend-v1"))
+
+		// then
+		Expect(sink.records).To(BeEmpty())
+	})
+
+	It("should not record resyncs of the informer", func() {
+		// given
+		admit(admissionv1beta1.Update, "jane", route("1", "backend-v1"))
+
+		// when
+		informer.handlers[0].OnUpdate(route("1", "backend-v1"), route("1", "backend-v1"))
+
+		// then
+		Expect(sink.records).To(BeEmpty())
+	})
+})
@@ -3,6 +3,8 @@ package injector
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -10,6 +12,7 @@ import (
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 
 	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	kuma_log "github.com/kumahq/kuma/pkg/log"
 	k8s_resources "github.com/kumahq/kuma/pkg/plugins/resources/k8s"
 	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
 	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/metadata"
@@ -25,17 +28,24 @@ const (
 	KumaInitContainerName    = "kuma-init"
 )
 
+const (
+	// terminationGracePeriodMargin is added to the drain time of the sidecar to give it time
+	// to notify the Control Plane before draining and to stop after draining.
+	terminationGracePeriodMargin = 10 * time.Second
+)
+
 const (
 	// serviceAccountTokenMountPath is a well-known location where Kubernetes mounts a ServiceAccount token.
 	serviceAccountTokenMountPath = "/var/run/secrets/kubernetes.io/serviceaccount"
 )
 
-func New(cfg runtime_k8s.Injector, controlPlaneUrl string, client kube_client.Client) *KumaInjector {
+func New(cfg runtime_k8s.Injector, controlPlaneUrl string, client kube_client.Client, systemNamespace string) *KumaInjector {
 	return &KumaInjector{
 		cfg:             cfg,
 		controlPlaneUrl: controlPlaneUrl,
 		client:          client,
 		converter:       k8s_resources.DefaultConverter(),
+		systemNamespace: systemNamespace,
 	}
 }
 
@@ -44,6 +54,7 @@ type KumaInjector struct {
 	controlPlaneUrl string
 	client          kube_client.Client
 	converter       k8s_resources.Converter
+	systemNamespace string
 }
 
 func (i *KumaInjector) InjectKuma(pod *kube_core.Pod) error {
@@ -60,7 +71,18 @@ func (i *KumaInjector) InjectKuma(pod *kube_core.Pod) error {
 	if pod.Spec.Containers == nil {
 		pod.Spec.Containers = []kube_core.Container{}
 	}
-	pod.Spec.Containers = append(pod.Spec.Containers, i.NewSidecarContainer(pod, ns))
+	sidecar, err := i.NewSidecarContainer(pod, ns)
+	if err != nil {
+		return errors.Wrap(err, "could not build sidecar container")
+	}
+	pod.Spec.Containers = append(pod.Spec.Containers, sidecar)
+	if i.cfg.SidecarContainer.LifecyclePort != 0 {
+		drainTime, err := i.sidecarDrainTime(sidecar)
+		if err != nil {
+			return errors.Wrap(err, "could not determine drain time of the sidecar")
+		}
+		ensureTerminationGracePeriod(pod, drainTime)
+	}
 
 	mesh, err := i.meshFor(pod, ns)
 	if err != nil {
@@ -147,9 +169,106 @@ func (i *KumaInjector) namespaceFor(pod *kube_core.Pod) (*kube_core.Namespace, e
 	return ns, nil
 }
 
-func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod, ns *kube_core.Namespace) kube_core.Container {
+// NewSidecarContainer builds the sidecar container from the Injector config, then applies SidecarTemplates
+// of the Mesh and the namespace and finally overrides defined by the Pod annotations.
+func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod, ns *kube_core.Namespace) (kube_core.Container, error) {
 	mesh := meshName(pod, ns)
-	return kube_core.Container{
+	container := i.newSidecarContainer(pod, mesh)
+
+	templates, err := i.sidecarTemplatesFor(ns, mesh)
+	if err != nil {
+		return kube_core.Container{}, err
+	}
+	for _, template := range templates {
+		if container, err = ApplySidecarTemplate(container, &template); err != nil {
+			return kube_core.Container{}, errors.Wrapf(err, "could not apply SidecarTemplate %q from namespace %q", template.Name, template.Namespace)
+		}
+	}
+
+	if err := overrideSidecarWithAnnotations(&container, metadata.Annotations(pod.Annotations)); err != nil {
+		return kube_core.Container{}, err
+	}
+	return container, nil
+}
+
+// overrideSidecarWithAnnotations applies per-Pod overrides of the sidecar container defined by the Pod annotations.
+func overrideSidecarWithAnnotations(container *kube_core.Container, annotations metadata.Annotations) error {
+	if container.Resources.Requests == nil {
+		container.Resources.Requests = kube_core.ResourceList{}
+	}
+	if container.Resources.Limits == nil {
+		container.Resources.Limits = kube_core.ResourceList{}
+	}
+	resources := []struct {
+		annotation string
+		list       kube_core.ResourceList
+		name       kube_core.ResourceName
+	}{
+		{metadata.KumaSidecarCPURequestAnnotation, container.Resources.Requests, kube_core.ResourceCPU},
+		{metadata.KumaSidecarCPULimitAnnotation, container.Resources.Limits, kube_core.ResourceCPU},
+		{metadata.KumaSidecarMemoryRequestAnnotation, container.Resources.Requests, kube_core.ResourceMemory},
+		{metadata.KumaSidecarMemoryLimitAnnotation, container.Resources.Limits, kube_core.ResourceMemory},
+	}
+	for _, resource := range resources {
+		value, exist := annotations.GetString(resource.annotation)
+		if !exist {
+			continue
+		}
+		quantity, err := kube_api.ParseQuantity(value)
+		if err != nil {
+			return errors.Wrapf(err, "annotation \"%s\" has wrong value \"%s\"", resource.annotation, value)
+		}
+		resource.list[resource.name] = quantity
+	}
+
+	if value, exist := annotations.GetString(metadata.KumaSidecarLogLevelAnnotation); exist {
+		if _, err := kuma_log.ParseLogLevel(value); err != nil {
+			return errors.Wrapf(err, "annotation \"%s\" has wrong value \"%s\"", metadata.KumaSidecarLogLevelAnnotation, value)
+		}
+		setArg(container, "--log-level", value)
+	}
+
+	concurrency, exist, err := annotations.GetUint32(metadata.KumaSidecarConcurrencyAnnotation)
+	if err != nil {
+		return errors.Wrapf(err, "annotation \"%s\" has wrong value", metadata.KumaSidecarConcurrencyAnnotation)
+	}
+	if exist {
+		setEnv(container, "KUMA_DATAPLANE_CONCURRENCY", fmt.Sprintf("%d", concurrency))
+	}
+
+	drainTime, exist, err := annotations.GetDuration(metadata.KumaSidecarDrainTimeAnnotation)
+	if err != nil {
+		return errors.Wrapf(err, "annotation \"%s\" has wrong value", metadata.KumaSidecarDrainTimeAnnotation)
+	}
+	if exist {
+		setEnv(container, "KUMA_DATAPLANE_DRAIN_TIME", drainTime.String())
+	}
+	return nil
+}
+
+func setArg(container *kube_core.Container, name string, value string) {
+	arg := fmt.Sprintf("%s=%s", name, value)
+	for idx := range container.Args {
+		if strings.HasPrefix(container.Args[idx], name+"=") {
+			container.Args[idx] = arg
+			return
+		}
+	}
+	container.Args = append(container.Args, arg)
+}
+
+func setEnv(container *kube_core.Container, name string, value string) {
+	for idx := range container.Env {
+		if container.Env[idx].Name == name {
+			container.Env[idx] = kube_core.EnvVar{Name: name, Value: value}
+			return
+		}
+	}
+	container.Env = append(container.Env, kube_core.EnvVar{Name: name, Value: value})
+}
+
+func (i *KumaInjector) newSidecarContainer(pod *kube_core.Pod, mesh string) kube_core.Container {
+	container := kube_core.Container{
 		Name:            KumaSidecarContainerName,
 		Image:           i.cfg.SidecarContainer.Image,
 		ImagePullPolicy: kube_core.PullIfNotPresent,
@@ -207,11 +326,16 @@ func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod, ns *kube_core.Nam
 				Name:  "KUMA_DATAPLANE_DRAIN_TIME",
 				Value: i.cfg.SidecarContainer.DrainTime.String(),
 			},
+			{
+				Name:  "KUMA_DATAPLANE_LIFECYCLE_PORT",
+				Value: fmt.Sprintf("%d", i.cfg.SidecarContainer.LifecyclePort),
+			},
 			{
 				Name:  "KUMA_DATAPLANE_RUNTIME_TOKEN_PATH",
 				Value: "/var/run/secrets/kubernetes.io/serviceaccount/token",
 			},
 		},
+		Lifecycle: i.NewSidecarLifecycle(),
 		SecurityContext: &kube_core.SecurityContext{
 			RunAsUser:  &i.cfg.SidecarContainer.UID,
 			RunAsGroup: &i.cfg.SidecarContainer.GID,
@@ -219,11 +343,7 @@ func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod, ns *kube_core.Nam
 		LivenessProbe: &kube_core.Probe{
 			Handler: kube_core.Handler{
 				Exec: &kube_core.ExecAction{
-					Command: []string{
-						"wget",
-						"-qO-",
-						fmt.Sprintf("http://127.0.0.1:%d", i.cfg.SidecarContainer.AdminPort),
-					},
+					Command: i.sidecarProbeCommand("/live"),
 				},
 			},
 			InitialDelaySeconds: i.cfg.SidecarContainer.LivenessProbe.InitialDelaySeconds,
@@ -235,11 +355,7 @@ func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod, ns *kube_core.Nam
 		ReadinessProbe: &kube_core.Probe{
 			Handler: kube_core.Handler{
 				Exec: &kube_core.ExecAction{
-					Command: []string{
-						"wget",
-						"-qO-",
-						fmt.Sprintf("http://127.0.0.1:%d", i.cfg.SidecarContainer.AdminPort),
-					},
+					Command: i.sidecarProbeCommand("/ready"),
 				},
 			},
 			InitialDelaySeconds: i.cfg.SidecarContainer.ReadinessProbe.InitialDelaySeconds,
@@ -264,6 +380,61 @@ func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod, ns *kube_core.Nam
 		// ServiceAccount volume mount into containers it creates.
 		VolumeMounts: i.NewVolumeMounts(pod),
 	}
+	if interval := i.cfg.SidecarContainer.DependenciesReportInterval; interval > 0 {
+		setEnv(&container, "KUMA_DATAPLANE_DEPENDENCIES_REPORT_INTERVAL", interval.String())
+	}
+	return container
+}
+
+// sidecarProbeCommand returns a command that checks a given endpoint of the lifecycle server of Kuma DP.
+// When the lifecycle server is disabled, the command only checks that Envoy Admin API responds.
+func (i *KumaInjector) sidecarProbeCommand(path string) []string {
+	url := fmt.Sprintf("http://127.0.0.1:%d", i.cfg.SidecarContainer.AdminPort)
+	if i.cfg.SidecarContainer.LifecyclePort != 0 {
+		url = fmt.Sprintf("http://127.0.0.1:%d%s", i.cfg.SidecarContainer.LifecyclePort, path)
+	}
+	return []string{
+		"wget",
+		"-qO-",
+		url,
+	}
+}
+
+// NewSidecarLifecycle returns a pre-stop hook that drains the sidecar before Kubernetes sends SIGTERM to it.
+// The hook returns once the Dataplane is excluded from endpoints of other Dataplanes and there are no active connections.
+func (i *KumaInjector) NewSidecarLifecycle() *kube_core.Lifecycle {
+	if i.cfg.SidecarContainer.LifecyclePort == 0 {
+		return nil
+	}
+	return &kube_core.Lifecycle{
+		PreStop: &kube_core.Handler{
+			Exec: &kube_core.ExecAction{
+				Command: []string{
+					"wget",
+					"-qO-",
+					fmt.Sprintf("http://127.0.0.1:%d/drain", i.cfg.SidecarContainer.LifecyclePort),
+				},
+			},
+		},
+	}
+}
+
+// sidecarDrainTime returns drain time of the sidecar, which might be overridden by a SidecarTemplate or the Pod annotation.
+func (i *KumaInjector) sidecarDrainTime(sidecar kube_core.Container) (time.Duration, error) {
+	for _, env := range sidecar.Env {
+		if env.Name == "KUMA_DATAPLANE_DRAIN_TIME" {
+			return time.ParseDuration(env.Value)
+		}
+	}
+	return i.cfg.SidecarContainer.DrainTime, nil
+}
+
+// ensureTerminationGracePeriod makes sure that Kubernetes does not kill the sidecar while it is draining.
+func ensureTerminationGracePeriod(pod *kube_core.Pod, drainTime time.Duration) {
+	gracePeriod := int64((drainTime + terminationGracePeriodMargin) / time.Second)
+	if pod.Spec.TerminationGracePeriodSeconds == nil || *pod.Spec.TerminationGracePeriodSeconds < gracePeriod {
+		pod.Spec.TerminationGracePeriodSeconds = &gracePeriod
+	}
 }
 
 func (i *KumaInjector) NewVolumeMounts(pod *kube_core.Pod) []kube_core.VolumeMount {
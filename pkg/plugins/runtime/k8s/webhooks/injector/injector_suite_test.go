@@ -17,12 +17,14 @@ limitations under the License.
 package injector_test
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	kube_core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
@@ -72,6 +74,10 @@ var _ = BeforeSuite(func(done Done) {
 	Expect(err).ToNot(HaveOccurred())
 	Expect(k8sClient).ToNot(BeNil())
 
+	// SidecarTemplates of the whole Mesh are defined in the system namespace
+	err = k8sClient.Create(context.Background(), &kube_core.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kuma-system"}})
+	Expect(err).ToNot(HaveOccurred())
+
 	close(done)
 }, 60)
 
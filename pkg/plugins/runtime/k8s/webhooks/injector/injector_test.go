@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -16,7 +17,10 @@ import (
 	inject "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/webhooks/injector"
 
 	kube_core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/ghodss/yaml"
 )
@@ -28,18 +32,23 @@ var _ = Describe("Injector", func() {
 	BeforeEach(func() {
 		var cfg conf.Injector
 		Expect(config.Load(filepath.Join("testdata", "inject.config.yaml"), &cfg)).To(Succeed())
-		injector = inject.New(cfg, "http://kuma-control-plane.kuma-system:5681", k8sClient)
+		injector = inject.New(cfg, "http://kuma-control-plane.kuma-system:5681", k8sClient, "kuma-system")
 	})
 
 	type testCase struct {
-		num       string
-		mesh      string
-		namespace string
+		num              string
+		mesh             string
+		namespace        string
+		sidecarTemplates []string
 	}
 
 	BeforeEach(func() {
 		err := k8sClient.DeleteAllOf(context.Background(), &v1alpha1.Mesh{})
 		Expect(err).ToNot(HaveOccurred())
+		for _, ns := range []string{"default", "kuma-system"} {
+			err := k8sClient.DeleteAllOf(context.Background(), &v1alpha1.SidecarTemplate{}, kube_client.InNamespace(ns))
+			Expect(err).ToNot(HaveOccurred())
+		}
 	})
 
 	DescribeTable("should inject Kuma into a Pod",
@@ -58,6 +67,12 @@ var _ = Describe("Injector", func() {
 			Expect(errNs).ToNot(HaveOccurred())
 			errUpd := k8sClient.Update(context.Background(), ns)
 			Expect(errUpd).ToNot(HaveOccurred())
+			// and create sidecar templates
+			for _, template := range given.sidecarTemplates {
+				obj, _, err := decoder.Decode([]byte(template), nil, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(k8sClient.Create(context.Background(), obj)).To(Succeed())
+			}
 
 			// given
 			pod := &kube_core.Pod{}
@@ -355,5 +370,139 @@ var _ = Describe("Injector", func() {
                 annotations:
                   kuma.io/sidecar-injection: enabled`,
 		}),
+		Entry("17. Override sidecar with annotations", testCase{
+			num: "17",
+			mesh: `
+              apiVersion: kuma.io/v1alpha1
+              kind: Mesh
+              metadata:
+                name: default
+              spec: {}`,
+			namespace: `
+              apiVersion: v1
+              kind: Namespace
+              metadata:
+                name: default
+                annotations:
+                  kuma.io/sidecar-injection: enabled`,
+		}),
+		Entry("18. Apply sidecar templates of Mesh and Namespace", testCase{
+			num: "18",
+			mesh: `
+              apiVersion: kuma.io/v1alpha1
+              kind: Mesh
+              metadata:
+                name: default
+              spec: {}`,
+			namespace: `
+              apiVersion: v1
+              kind: Namespace
+              metadata:
+                name: default
+                annotations:
+                  kuma.io/sidecar-injection: enabled`,
+			sidecarTemplates: []string{`
+              apiVersion: kuma.io/v1alpha1
+              kind: SidecarTemplate
+              metadata:
+                name: mesh-wide
+                namespace: kuma-system
+              mesh: default
+              spec:
+                container:
+                  env:
+                  - name: KUMA_DATAPLANE_DRAIN_TIME
+                    value: 50s
+                  - name: TEAM
+                    value: platform
+                  resources:
+                    limits:
+                      cpu: "2"
+                      memory: 2Gi`, `
+              apiVersion: kuma.io/v1alpha1
+              kind: SidecarTemplate
+              metadata:
+                name: other-mesh
+                namespace: kuma-system
+              mesh: other
+              spec:
+                container:
+                  env:
+                  - name: OTHER
+                    value: other`, `
+              apiVersion: kuma.io/v1alpha1
+              kind: SidecarTemplate
+              metadata:
+                name: namespace-wide
+                namespace: default
+              spec:
+                container:
+                  env:
+                  - name: TEAM
+                    value: latency-critical
+                  volumeMounts:
+                  - name: default-token-w7dxf
+                    mountPath: /var/run/secrets/kubernetes.io/serviceaccount
+                    readOnly: true
+                  - name: cache
+                    mountPath: /var/cache/kuma`,
+			},
+		}),
 	)
+
+	It("should not inject Kuma when a sidecar annotation has a wrong value", func() {
+		// setup
+		err := k8sClient.Create(context.Background(), &v1alpha1.Mesh{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+		Expect(err).ToNot(HaveOccurred())
+		ns := &kube_core.Namespace{}
+		err = k8sClient.Get(context.Background(), kube_types.NamespacedName{Name: "default"}, ns)
+		Expect(err).ToNot(HaveOccurred())
+		ns.Annotations = map[string]string{"kuma.io/sidecar-injection": "enabled"}
+		err = k8sClient.Update(context.Background(), ns)
+		Expect(err).ToNot(HaveOccurred())
+
+		// given
+		pod := &kube_core.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "busybox",
+				Namespace: "default",
+				Annotations: map[string]string{
+					"kuma.io/sidecar-memory-limit": "a lot",
+				},
+			},
+		}
+
+		// when
+		err = injector.InjectKuma(pod)
+
+		// then
+		Expect(err).To(MatchError(`could not build sidecar container: annotation "kuma.io/sidecar-memory-limit" has wrong value "a lot": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`))
+	})
+
+	It("should configure the sidecar to report dependencies when the report interval is set", func() {
+		// given
+		var cfg conf.Injector
+		Expect(config.Load(filepath.Join("testdata", "inject.config.yaml"), &cfg)).To(Succeed())
+		cfg.SidecarContainer.DependenciesReportInterval = 30 * time.Second
+		injector := inject.New(cfg, "http://kuma-control-plane.kuma-system:5681", k8sClient, "kuma-system")
+
+		pod := &kube_core.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "busybox",
+				Namespace: "default",
+			},
+		}
+		ns := &kube_core.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "default",
+			},
+		}
+
+		// when
+		container, err := injector.NewSidecarContainer(pod, ns)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(container.Env).To(ContainElement(kube_core.EnvVar{Name: "KUMA_DATAPLANE_DEPENDENCIES_REPORT_INTERVAL", Value: "30s"}))
+	})
 })
@@ -0,0 +1,73 @@
+package injector
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+	kube_core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+)
+
+// ApplySidecarTemplate applies the container patch of a given SidecarTemplate to the sidecar container
+// using strategic merge patch semantics, i.e. env variables and volume mounts are merged by name.
+func ApplySidecarTemplate(container kube_core.Container, template *mesh_k8s.SidecarTemplate) (kube_core.Container, error) {
+	if len(template.Spec.Container) == 0 {
+		return container, nil
+	}
+	original, err := json.Marshal(container)
+	if err != nil {
+		return kube_core.Container{}, err
+	}
+	patch, err := json.Marshal(template.Spec.Container)
+	if err != nil {
+		return kube_core.Container{}, err
+	}
+	patched, err := strategicpatch.StrategicMergePatch(original, patch, kube_core.Container{})
+	if err != nil {
+		return kube_core.Container{}, err
+	}
+	result := kube_core.Container{}
+	if err := json.Unmarshal(patched, &result); err != nil {
+		return kube_core.Container{}, err
+	}
+	return result, nil
+}
+
+// SidecarTemplateMesh returns a name of the Mesh a given SidecarTemplate applies to.
+func SidecarTemplateMesh(template *mesh_k8s.SidecarTemplate) string {
+	if template.Mesh == "" {
+		return core_model.DefaultMesh
+	}
+	return template.Mesh
+}
+
+// sidecarTemplatesFor returns SidecarTemplates that apply to a Pod in a given order:
+// templates of the whole Mesh go first, then templates of the Pod's namespace.
+func (i *KumaInjector) sidecarTemplatesFor(ns *kube_core.Namespace, mesh string) ([]mesh_k8s.SidecarTemplate, error) {
+	namespaces := []string{i.systemNamespace}
+	if ns.GetName() != i.systemNamespace {
+		namespaces = append(namespaces, ns.GetName())
+	}
+	var templates []mesh_k8s.SidecarTemplate
+	for _, namespace := range namespaces {
+		list := &mesh_k8s.SidecarTemplateList{}
+		if err := i.client.List(context.Background(), list, kube_client.InNamespace(namespace)); err != nil {
+			return nil, errors.Wrapf(err, "could not list SidecarTemplates in namespace %q", namespace)
+		}
+		sort.Slice(list.Items, func(a, b int) bool {
+			return list.Items[a].Name < list.Items[b].Name
+		})
+		for _, template := range list.Items {
+			if SidecarTemplateMesh(&template) == mesh {
+				templates = append(templates, template)
+			}
+		}
+	}
+	return templates, nil
+}
@@ -0,0 +1,84 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"k8s.io/api/admission/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_registry "github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	common_k8s "github.com/kumahq/kuma/pkg/plugins/common/k8s"
+	k8s_resources "github.com/kumahq/kuma/pkg/plugins/resources/k8s"
+	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	k8s_model "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	k8s_registry "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+// NewNamespacedPolicyValidator returns a validator that rejects policies applied in a namespace other than the system namespace
+// that select Dataplanes of another namespace. Such policies are restricted to Dataplanes of their own namespace.
+func NewNamespacedPolicyValidator(converter k8s_resources.Converter, coreRegistry core_registry.TypeRegistry, k8sRegistry k8s_registry.TypeRegistry, systemNamespace string) AdmissionValidator {
+	return &namespacedPolicyValidator{
+		converter:       converter,
+		coreRegistry:    coreRegistry,
+		k8sRegistry:     k8sRegistry,
+		systemNamespace: systemNamespace,
+	}
+}
+
+type namespacedPolicyValidator struct {
+	converter       k8s_resources.Converter
+	coreRegistry    core_registry.TypeRegistry
+	k8sRegistry     k8s_registry.TypeRegistry
+	decoder         *admission.Decoder
+	systemNamespace string
+}
+
+func (h *namespacedPolicyValidator) InjectDecoder(d *admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
+
+func (h *namespacedPolicyValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != v1beta1.Create && req.Operation != v1beta1.Update {
+		return admission.Allowed("")
+	}
+	coreRes, err := h.coreRegistry.NewObject(core_model.ResourceType(req.Kind.Kind))
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	obj, err := h.k8sRegistry.NewObject(coreRes.GetSpec())
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if err := h.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if obj.GetAnnotations()[common_k8s.K8sSynced] == "true" {
+		return admission.Allowed("")
+	}
+	if err := h.converter.ToCoreResource(obj, coreRes); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	verr := validators.ValidationError{}
+	field, selectors := k8s_resources.NamespaceScopedSelectors(coreRes)
+	for i, selector := range selectors {
+		if namespace, ok := selector.Match[mesh_proto.KubeNamespaceTag]; ok && namespace != req.Namespace {
+			verr.AddViolationAt(validators.RootedAt(field).Index(i).Field("match").Key(mesh_proto.KubeNamespaceTag),
+				fmt.Sprintf("policy applied in the %q namespace can select only Dataplanes of this namespace", req.Namespace))
+		}
+	}
+	if !verr.HasViolations() {
+		return admission.Allowed("")
+	}
+	return convertSpecValidationError(&verr, obj.(k8s_model.KubernetesObject))
+}
+
+func (h *namespacedPolicyValidator) Supports(req admission.Request) bool {
+	return req.Kind.Group == mesh_k8s.GroupVersion.Group && req.Namespace != "" && req.Namespace != h.systemNamespace
+}
@@ -0,0 +1,151 @@
+package webhooks_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_admission "sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	core_registry "github.com/kumahq/kuma/pkg/core/resources/registry"
+	k8s_resources "github.com/kumahq/kuma/pkg/plugins/resources/k8s"
+	k8s_registry "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/webhooks"
+)
+
+var _ = Describe("Namespaced Policy Validator", func() {
+
+	type testCase struct {
+		kind      string
+		namespace string
+		obj       string
+		resp      kube_admission.Response
+	}
+	DescribeTable("should validate namespaced policies",
+		func(given testCase) {
+			// given
+			validator := webhooks.NewNamespacedPolicyValidator(k8s_resources.DefaultConverter(), core_registry.Global(), k8s_registry.Global(), "kuma-system")
+			webhook := &kube_admission.Webhook{
+				Handler: validator,
+			}
+			Expect(webhook.InjectScheme(scheme)).To(Succeed())
+			req := kube_admission.Request{
+				AdmissionRequest: admissionv1beta1.AdmissionRequest{
+					UID:       kube_types.UID("12345"),
+					Operation: admissionv1beta1.Create,
+					Namespace: given.namespace,
+					Object: kube_runtime.RawExtension{
+						Raw: []byte(given.obj),
+					},
+					Kind: kube_meta.GroupVersionKind{
+						Group:   "kuma.io",
+						Version: "v1alpha1",
+						Kind:    given.kind,
+					},
+				},
+			}
+
+			// when
+			Expect(validator.Supports(req)).To(BeTrue())
+			resp := webhook.Handle(context.Background(), req)
+
+			// then
+			Expect(resp).To(Equal(given.resp))
+		},
+		Entry("should allow a policy that selects Dataplanes of its namespace", testCase{
+			kind:      "TrafficPermission",
+			namespace: "demo",
+			obj: `
+            {
+              "apiVersion":"kuma.io/v1alpha1",
+              "kind":"TrafficPermission",
+              "mesh":"default",
+              "metadata":{
+                "namespace":"demo",
+                "name":"web-to-backend",
+                "creationTimestamp":null
+              },
+              "spec":{
+                "sources":[{"match":{"kuma.io/service":"web"}}],
+                "destinations":[{"match":{"kuma.io/service":"backend","k8s.kuma.io/namespace":"demo"}}]
+              }
+            }`,
+			resp: kube_admission.Response{
+				AdmissionResponse: admissionv1beta1.AdmissionResponse{
+					UID:     "12345",
+					Allowed: true,
+					Result: &kube_meta.Status{
+						Code: 200,
+					},
+				},
+			},
+		}),
+		Entry("should reject a policy that selects Dataplanes of another namespace", testCase{
+			kind:      "TrafficRoute",
+			namespace: "demo",
+			obj: `
+            {
+              "apiVersion":"kuma.io/v1alpha1",
+              "kind":"TrafficRoute",
+              "mesh":"default",
+              "metadata":{
+                "namespace":"demo",
+                "name":"web-to-backend",
+                "creationTimestamp":null
+              },
+              "spec":{
+                "sources":[{"match":{"kuma.io/service":"web","k8s.kuma.io/namespace":"other"}}],
+                "destinations":[{"match":{"kuma.io/service":"backend","k8s.kuma.io/namespace":"other"}}],
+                "conf":[{"weight":100,"destination":{"kuma.io/service":"backend"}}]
+              }
+            }`,
+			resp: kube_admission.Response{
+				AdmissionResponse: admissionv1beta1.AdmissionResponse{
+					UID:     "12345",
+					Allowed: false,
+					Result: &kube_meta.Status{
+						Status:  "Failure",
+						Message: `spec.sources[0].match["k8s.kuma.io/namespace"]: policy applied in the "demo" namespace can select only Dataplanes of this namespace`,
+						Reason:  "Invalid",
+						Details: &kube_meta.StatusDetails{
+							Name: "web-to-backend",
+							Kind: "TrafficRoute",
+							Causes: []kube_meta.StatusCause{
+								{
+									Type:    "FieldValueInvalid",
+									Message: `policy applied in the "demo" namespace can select only Dataplanes of this namespace`,
+									Field:   `spec.sources[0].match["k8s.kuma.io/namespace"]`,
+								},
+							},
+						},
+						Code: 422,
+					},
+				},
+			},
+		}),
+	)
+
+	It("should not support policies of the system namespace", func() {
+		// given
+		validator := webhooks.NewNamespacedPolicyValidator(k8s_resources.DefaultConverter(), core_registry.Global(), k8s_registry.Global(), "kuma-system")
+		req := kube_admission.Request{
+			AdmissionRequest: admissionv1beta1.AdmissionRequest{
+				Namespace: "kuma-system",
+				Kind: kube_meta.GroupVersionKind{
+					Group:   "kuma.io",
+					Version: "v1alpha1",
+					Kind:    "TrafficRoute",
+				},
+			},
+		}
+
+		// expect
+		Expect(validator.Supports(req)).To(BeFalse())
+	})
+})
@@ -6,6 +6,8 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
 	"github.com/kumahq/kuma/pkg/core/validators"
 	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
@@ -26,6 +28,7 @@ type SecretValidator struct {
 	Decoder   *admission.Decoder
 	Client    kube_client.Client
 	Validator secret_manager.SecretValidator
+	Auditor   *Auditor
 }
 
 func (v *SecretValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
@@ -63,6 +66,7 @@ func (v *SecretValidator) handleUpdate(ctx context.Context, req admission.Reques
 		}
 		return admission.Denied(err.Error())
 	}
+	v.audit(req, secret)
 	return admission.Allowed("")
 }
 
@@ -81,10 +85,20 @@ func (v *SecretValidator) handleDelete(ctx context.Context, req admission.Reques
 			}
 			return admission.Denied(err.Error())
 		}
+		v.audit(req, secret)
 	}
 	return admission.Allowed("")
 }
 
+// audit notes the admitted change of a Kuma secret. Values of secrets are never recorded.
+func (v *SecretValidator) audit(req admission.Request, secret *kube_core.Secret) {
+	if !isKumaSecret(secret) {
+		return
+	}
+	key := core_model.ResourceKey{Name: secret.GetName(), Mesh: meshOfSecret(secret)}
+	v.Auditor.Admit(req, system.SecretType, key, nil)
+}
+
 func (v *SecretValidator) validate(ctx context.Context, secret *kube_core.Secret, oldSecret *kube_core.Secret) error {
 	verr := &validators.ValidationError{}
 	if !isKumaSecret(secret) {
@@ -0,0 +1,68 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	kube_core "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kumahq/kuma/pkg/core/validators"
+	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/webhooks/injector"
+)
+
+// SidecarTemplateValidator validates that a SidecarTemplate can be applied to the sidecar container.
+type SidecarTemplateValidator struct {
+	decoder *admission.Decoder
+}
+
+// Handle admits a SidecarTemplate only if its container patch can be applied to the sidecar container.
+func (v *SidecarTemplateValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	template := &mesh_k8s.SidecarTemplate{}
+
+	err := v.decoder.Decode(req, template)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if err := v.validate(template); err != nil {
+		if verr, ok := err.(*validators.ValidationError); ok {
+			return convertValidationErrorOf(*verr, template, template)
+		}
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("")
+}
+
+func (v *SidecarTemplateValidator) validate(template *mesh_k8s.SidecarTemplate) error {
+	verr := &validators.ValidationError{}
+	path := validators.RootedAt("spec").Field("container")
+	sidecar := kube_core.Container{
+		Name: injector.KumaSidecarContainerName,
+	}
+	patched, err := injector.ApplySidecarTemplate(sidecar, template)
+	if err != nil {
+		verr.AddViolationAt(path, fmt.Sprintf("cannot be applied to the sidecar container: %s", err))
+		return verr.OrNil()
+	}
+	if patched.Name != sidecar.Name {
+		verr.AddViolationAt(path.Field("name"), "cannot be changed")
+	}
+	for _, env := range patched.Env {
+		if env.Name == "KUMA_DATAPLANE_DRAIN_TIME" && env.ValueFrom == nil {
+			if _, err := time.ParseDuration(env.Value); err != nil {
+				verr.AddViolationAt(path.Field("env"), fmt.Sprintf("value of %s must be a valid duration", env.Name))
+			}
+		}
+	}
+	return verr.OrNil()
+}
+
+func (v *SidecarTemplateValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
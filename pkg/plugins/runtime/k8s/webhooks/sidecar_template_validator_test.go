@@ -0,0 +1,159 @@
+package webhooks_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	. "github.com/kumahq/kuma/pkg/plugins/runtime/k8s/webhooks"
+
+	"github.com/ghodss/yaml"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
+	kube_admission "sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("SidecarTemplateValidator", func() {
+
+	var decoder *kube_admission.Decoder
+
+	BeforeEach(func() {
+		scheme := kube_runtime.NewScheme()
+		// expect
+		Expect(mesh_k8s.AddToScheme(scheme)).To(Succeed())
+
+		var err error
+		// when
+		decoder, err = kube_admission.NewDecoder(scheme)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	type testCase struct {
+		request  string
+		expected string
+	}
+
+	DescribeTable("should make a proper admission verdict",
+		func(given testCase) {
+			// setup
+			validator := &SidecarTemplateValidator{}
+			// when
+			err := validator.InjectDecoder(decoder)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// setup
+			admissionReview := admissionv1beta1.AdmissionReview{}
+			// when
+			err = yaml.Unmarshal([]byte(given.request), &admissionReview)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// do
+			resp := validator.Handle(context.Background(), kube_admission.Request{
+				AdmissionRequest: *admissionReview.Request,
+			})
+
+			// when
+			actual, err := yaml.Marshal(resp.AdmissionResponse)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("SidecarTemplate with env, resources and volume mounts", testCase{
+			request: `
+            apiVersion: admission.k8s.io/v1
+            kind: AdmissionReview
+            request:
+              uid: 12345
+              kind:
+                group: kuma.io
+                kind: SidecarTemplate
+                version: v1alpha1
+              name: latency-critical
+              namespace: kuma-example
+              object:
+                apiVersion: kuma.io/v1alpha1
+                kind: SidecarTemplate
+                mesh: default
+                metadata:
+                  name: latency-critical
+                  namespace: kuma-example
+                spec:
+                  container:
+                    env:
+                    - name: KUMA_DATAPLANE_DRAIN_TIME
+                      value: 60s
+                    resources:
+                      limits:
+                        cpu: "2"
+                    volumeMounts:
+                    - name: cache
+                      mountPath: /var/cache/kuma
+              operation: CREATE
+`,
+			expected: `
+            allowed: true
+            status:
+              code: 200
+              metadata: {}
+            uid: ""
+`,
+		}),
+		Entry("SidecarTemplate that renames the sidecar and sets invalid drain time", testCase{
+			request: `
+            apiVersion: admission.k8s.io/v1
+            kind: AdmissionReview
+            request:
+              uid: 12345
+              kind:
+                group: kuma.io
+                kind: SidecarTemplate
+                version: v1alpha1
+              name: broken
+              namespace: kuma-example
+              object:
+                apiVersion: kuma.io/v1alpha1
+                kind: SidecarTemplate
+                mesh: default
+                metadata:
+                  name: broken
+                  namespace: kuma-example
+                spec:
+                  container:
+                    name: envoy
+                    env:
+                    - name: KUMA_DATAPLANE_DRAIN_TIME
+                      value: forever
+              operation: UPDATE
+`,
+			expected: `
+            allowed: false
+            status:
+              code: 422
+              details:
+                causes:
+                - field: spec.container.name
+                  message: cannot be changed
+                  reason: FieldValueInvalid
+                - field: spec.container.env
+                  message: value of KUMA_DATAPLANE_DRAIN_TIME must be a valid duration
+                  reason: FieldValueInvalid
+                kind: SidecarTemplate
+                name: broken
+              message: 'spec.container.name: cannot be changed; spec.container.env: value
+                of KUMA_DATAPLANE_DRAIN_TIME must be a valid duration'
+              metadata: {}
+              reason: Invalid
+              status: Failure
+            uid: ""
+`,
+		}),
+	)
+})
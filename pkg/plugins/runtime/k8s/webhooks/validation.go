@@ -24,12 +24,13 @@ import (
 	k8s_registry "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
 )
 
-func NewValidatingWebhook(converter k8s_resources.Converter, coreRegistry core_registry.TypeRegistry, k8sRegistry k8s_registry.TypeRegistry, mode core.CpMode) AdmissionValidator {
+func NewValidatingWebhook(converter k8s_resources.Converter, coreRegistry core_registry.TypeRegistry, k8sRegistry k8s_registry.TypeRegistry, mode core.CpMode, auditor *Auditor) AdmissionValidator {
 	return &validatingHandler{
 		coreRegistry: coreRegistry,
 		k8sRegistry:  k8sRegistry,
 		converter:    converter,
 		mode:         mode,
+		auditor:      auditor,
 	}
 }
 
@@ -39,6 +40,7 @@ type validatingHandler struct {
 	converter    k8s_resources.Converter
 	decoder      *admission.Decoder
 	mode         core.CpMode
+	auditor      *Auditor
 }
 
 func (h *validatingHandler) InjectDecoder(d *admission.Decoder) error {
@@ -48,6 +50,7 @@ func (h *validatingHandler) InjectDecoder(d *admission.Decoder) error {
 
 func (h *validatingHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
 	if req.Operation == v1beta1.Delete {
+		h.audit(req, nil)
 		return admission.Allowed("")
 	}
 
@@ -84,9 +87,52 @@ func (h *validatingHandler) Handle(ctx context.Context, req admission.Request) a
 		return admission.Denied(err.Error())
 	}
 
+	h.audit(req, coreRes)
 	return admission.Allowed("")
 }
 
+// audit notes the admitted change, which is recorded once it is observed by the Auditor. The key of a deleted
+// resource is taken from the old object, a deletion of an object that cannot be decoded is not noted.
+func (h *validatingHandler) audit(req admission.Request, after core_model.Resource) {
+	if h.auditor == nil {
+		return
+	}
+	resType := core_model.ResourceType(req.Kind.Kind)
+	var key core_model.ResourceKey
+	switch {
+	case after != nil:
+		key = core_model.MetaToResourceKey(after.GetMeta())
+	case len(req.OldObject.Raw) != 0:
+		before, err := h.decodeOld(req, resType)
+		if err != nil {
+			auditLog.Error(err, "could not decode the old object", "kind", req.Kind.Kind, "name", req.Name)
+			return
+		}
+		key = core_model.MetaToResourceKey(before.GetMeta())
+	default:
+		return
+	}
+	h.auditor.Admit(req, resType, key, after)
+}
+
+func (h *validatingHandler) decodeOld(req admission.Request, resType core_model.ResourceType) (core_model.Resource, error) {
+	coreRes, err := h.coreRegistry.NewObject(resType)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := h.k8sRegistry.NewObject(coreRes.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+	if err := h.decoder.DecodeRaw(req.OldObject, obj); err != nil {
+		return nil, err
+	}
+	if err := h.converter.ToCoreResource(obj.(k8s_model.KubernetesObject), coreRes); err != nil {
+		return nil, err
+	}
+	return coreRes, nil
+}
+
 // Note that this func does not validate ConfigMap and Secret since this webhook does not support those
 func (h *validatingHandler) validateSync(resType core_model.ResourceType, obj k8s_model.KubernetesObject) admission.Response {
 	if isDefaultMesh(resType, obj) { // skip validation for the default mesh
@@ -111,10 +111,17 @@ func (s *KubernetesStore) Delete(ctx context.Context, r core_model.Resource, fs
 	secret.Namespace = s.namespace
 	secret.Name = opts.Name
 
-	if err := s.writer.Delete(ctx, secret); err != nil {
+	var deleteOpts []kube_client.DeleteOption
+	if opts.Version != "" {
+		deleteOpts = append(deleteOpts, kube_client.Preconditions{ResourceVersion: &opts.Version})
+	}
+	if err := s.writer.Delete(ctx, secret, deleteOpts...); err != nil {
 		if kube_apierrs.IsNotFound(err) {
 			return nil
 		}
+		if kube_apierrs.IsConflict(err) {
+			return core_store.ErrorResourcePreconditionFailed(r.GetType(), opts.Name, opts.Mesh)
+		}
 		return errors.Wrap(err, "failed to delete k8s Secret")
 	}
 	return nil
@@ -84,6 +84,19 @@ var (
 			CertificateRegenerations: 3,
 		},
 	}
+	ServiceInsight = mesh_proto.ServiceInsight{
+		Services: map[string]*mesh_proto.ServiceInsight_Service{
+			"backend": {
+				Dataplanes: &mesh_proto.ServiceInsight_DataplaneStat{
+					Total:  2,
+					Online: 1,
+				},
+				Zones: map[string]uint32{
+					"kuma-1": 2,
+				},
+			},
+		},
+	}
 	Ingress = mesh_proto.Dataplane{
 		Networking: &mesh_proto.Dataplane_Networking{
 			Ingress: &mesh_proto.Dataplane_Networking_Ingress{
@@ -188,6 +201,26 @@ var (
 			Imports: []string{"default-kuma-profile"},
 		},
 	}
+	GatewayListener = mesh_proto.GatewayListener{
+		Selectors: []*mesh_proto.Selector{{
+			Match: map[string]string{"kuma.io/service": "edge"},
+		}},
+		Listeners: []*mesh_proto.GatewayListener_Listener{{
+			Port:     80,
+			Hostname: "*",
+		}},
+	}
+	GatewayRoute = mesh_proto.GatewayRoute{
+		Selectors: []*mesh_proto.Selector{{
+			Match: map[string]string{"kuma.io/service": "edge"},
+		}},
+		Rules: []*mesh_proto.GatewayRoute_Rule{{
+			Backends: []*mesh_proto.GatewayRoute_Rule_Backend{{
+				Destination: map[string]string{"kuma.io/service": "web"},
+				Weight:      100,
+			}},
+		}},
+	}
 	Secret = system_proto.Secret{
 		Data: &wrappers.BytesValue{Value: []byte("secret key")},
 	}
@@ -155,6 +155,35 @@ func ExecuteStoreTests(
 		//todo(jakubdyszkiewicz) write tests for optimistic locking
 	})
 
+	Describe("Labels", func() {
+		It("should preserve labels on update unless they are changed", func() {
+			// given
+			res := sample_model.TrafficRouteResource{}
+			err := s.Create(context.Background(), &res, store.CreateByKey("labels.demo", mesh), store.CreatedAt(time.Now()),
+				store.CreateWithLabels(map[string]string{"team": "web"}))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when updated without labels
+			fetched := sample_model.TrafficRouteResource{}
+			Expect(s.Get(context.Background(), &fetched, store.GetByKey("labels.demo", mesh))).To(Succeed())
+			fetched.Spec.Path = "new-path"
+			Expect(s.Update(context.Background(), &fetched)).To(Succeed())
+
+			// then labels are preserved
+			updated := sample_model.TrafficRouteResource{}
+			Expect(s.Get(context.Background(), &updated, store.GetByKey("labels.demo", mesh))).To(Succeed())
+			Expect(updated.Meta.GetLabels()).To(Equal(map[string]string{"team": "web"}))
+
+			// when updated with new labels
+			Expect(s.Update(context.Background(), &updated, store.UpdateWithLabels(map[string]string{"team": "backend"}))).To(Succeed())
+
+			// then labels are replaced
+			relabeled := sample_model.TrafficRouteResource{}
+			Expect(s.Get(context.Background(), &relabeled, store.GetByKey("labels.demo", mesh))).To(Succeed())
+			Expect(relabeled.Meta.GetLabels()).To(Equal(map[string]string{"team": "backend"}))
+		})
+	})
+
 	Describe("Delete()", func() {
 		It("should throw an error if resource is not found", func() {
 			// given
@@ -209,6 +238,49 @@ func ExecuteStoreTests(
 			// then resource cannot be found
 			Expect(err).To(Equal(store.ErrorResourceNotFound(resource.GetType(), name, mesh)))
 		})
+
+		It("should delete a resource in the given version", func() {
+			// given a resources in storage
+			name := "to-be-deleted.demo"
+			created := createResource(name)
+
+			// when
+			resource := sample_model.TrafficRouteResource{}
+			err := s.Delete(context.TODO(), &resource, store.DeleteByKey(name, mesh), store.DeleteByVersion(created.GetMeta().GetVersion()))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when query for deleted resource
+			resource = sample_model.TrafficRouteResource{}
+			err = s.Get(context.Background(), &resource, store.GetByKey(name, mesh))
+
+			// then resource cannot be found
+			Expect(err).To(Equal(store.ErrorResourceNotFound(resource.GetType(), name, mesh)))
+		})
+
+		It("should not delete a resource modified since the given version", func() {
+			// given a resources in storage
+			name := "to-be-modified.demo"
+			created := createResource(name)
+			version := created.GetMeta().GetVersion()
+
+			// and the resource is modified
+			created.Spec.Path = "new-path"
+			Expect(s.Update(context.Background(), created)).To(Succeed())
+
+			// when
+			resource := sample_model.TrafficRouteResource{}
+			err := s.Delete(context.TODO(), &resource, store.DeleteByKey(name, mesh), store.DeleteByVersion(version))
+
+			// then
+			Expect(err).To(Equal(store.ErrorResourcePreconditionFailed(resource.GetType(), name, mesh)))
+
+			// and resource still exists
+			resource = sample_model.TrafficRouteResource{}
+			Expect(s.Get(context.Background(), &resource, store.GetByKey(name, mesh))).To(Succeed())
+			Expect(resource.Spec.Path).To(Equal("new-path"))
+		})
 	})
 
 	Describe("Get()", func() {
@@ -334,6 +406,64 @@ func ExecuteStoreTests(
 			Expect(list.Items).To(HaveLen(0))
 		})
 
+		It("should return a list of resources with a name prefix", func() {
+			// given
+			createResource("prefix-1.demo")
+			createResource("prefix-2.demo")
+			createResource("other-1.demo")
+
+			list := sample_model.TrafficRouteResourceList{}
+
+			// when
+			err := s.List(context.Background(), &list, store.ListByMesh(mesh), store.ListByNamePrefix("prefix-"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Pagination.Total).To(Equal(uint32(2)))
+			names := []string{list.Items[0].Meta.GetName(), list.Items[1].Meta.GetName()}
+			Expect(names).To(ConsistOf("prefix-1.demo", "prefix-2.demo"))
+		})
+
+		It("should not return resources without tags when filtering by tags", func() {
+			// given
+			createResource("list-res-1.demo")
+
+			list := sample_model.TrafficRouteResourceList{}
+
+			// when
+			err := s.List(context.Background(), &list, store.ListByTags(store.TagFilter{Key: "version", Values: []string{"v1"}, Negate: true}))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Pagination.Total).To(Equal(uint32(0)))
+			Expect(list.Items).To(HaveLen(0))
+		})
+
+		It("should return a list of resources with labels", func() {
+			// given
+			web := sample_model.TrafficRouteResource{}
+			err := s.Create(context.Background(), &web, store.CreateByKey("labeled-1.demo", mesh), store.CreatedAt(time.Now()),
+				store.CreateWithLabels(map[string]string{"team": "web", "env": "prod"}))
+			Expect(err).ToNot(HaveOccurred())
+			backend := sample_model.TrafficRouteResource{}
+			err = s.Create(context.Background(), &backend, store.CreateByKey("labeled-2.demo", mesh), store.CreatedAt(time.Now()),
+				store.CreateWithLabels(map[string]string{"team": "backend", "env": "prod"}))
+			Expect(err).ToNot(HaveOccurred())
+			createResource("unlabeled.demo")
+
+			list := sample_model.TrafficRouteResourceList{}
+
+			// when
+			err = s.List(context.Background(), &list, store.ListByMesh(mesh), store.ListByLabels(map[string]string{"team": "web"}))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Pagination.Total).To(Equal(uint32(1)))
+			Expect(list.Items).To(HaveLen(1))
+			Expect(list.Items[0].Meta.GetName()).To(Equal("labeled-1.demo"))
+			Expect(list.Items[0].Meta.GetLabels()).To(Equal(map[string]string{"team": "web", "env": "prod"}))
+		})
+
 		Describe("Pagination", func() {
 			It("should list all resources using pagination", func() {
 				// given
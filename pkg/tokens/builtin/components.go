@@ -10,3 +10,9 @@ func NewDataplaneTokenIssuer(rt runtime.Runtime) (issuer.DataplaneTokenIssuer, e
 		return issuer.GetSigningKey(rt.ReadOnlyResourceManager())
 	}), nil
 }
+
+func NewUserTokenIssuer(rt runtime.Runtime) issuer.UserTokenIssuer {
+	return issuer.NewUserTokenIssuer(func() ([]byte, error) {
+		return issuer.GetUserTokenSigningKey(rt.ReadOnlyResourceManager())
+	})
+}
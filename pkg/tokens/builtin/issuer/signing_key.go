@@ -29,6 +29,19 @@ var SigningKeyResourceKey = model.ResourceKey{
 	Name: "dataplane-token-signing-key",
 }
 
+// UserTokenSigningKeyResourceKey is a key of the Signing Key for User Tokens.
+// It is separate from the Dataplane Token Signing Key so a Dataplane Token cannot be used as a User Token.
+var UserTokenSigningKeyResourceKey = model.ResourceKey{
+	Mesh: "default",
+	Name: "user-token-signing-key",
+}
+
+// SigningKeyResourceKeys are keys of all Signing Keys. Whoever can read them can issue tokens of any Dataplane and any user.
+var SigningKeyResourceKeys = []model.ResourceKey{
+	SigningKeyResourceKey,
+	UserTokenSigningKeyResourceKey,
+}
+
 func CreateSigningKey() (system.SecretResource, error) {
 	res := system.SecretResource{}
 	key, err := rsa.GenerateKey(rand.Reader, defaultRsaBits)
@@ -42,9 +55,18 @@ func CreateSigningKey() (system.SecretResource, error) {
 	}
 	return res, nil
 }
+
 func GetSigningKey(manager manager.ReadOnlyResourceManager) ([]byte, error) {
+	return getSigningKey(manager, SigningKeyResourceKey)
+}
+
+func GetUserTokenSigningKey(manager manager.ReadOnlyResourceManager) ([]byte, error) {
+	return getSigningKey(manager, UserTokenSigningKeyResourceKey)
+}
+
+func getSigningKey(manager manager.ReadOnlyResourceManager, key model.ResourceKey) ([]byte, error) {
 	resource := system.SecretResource{}
-	if err := manager.Get(context.Background(), &resource, store.GetBy(SigningKeyResourceKey)); err != nil {
+	if err := manager.Get(context.Background(), &resource, store.GetBy(key)); err != nil {
 		if store.IsResourceNotFound(err) {
 			return nil, SigningKeyNotFound
 		}
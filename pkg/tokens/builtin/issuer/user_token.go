@@ -0,0 +1,91 @@
+package issuer
+
+import (
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+// UserTokenIssuer issues User Tokens used then for authenticating users of the API Server.
+type UserTokenIssuer interface {
+	// Generate issues a token of the user that expires after validFor.
+	Generate(user user.User, validFor time.Duration) (string, error)
+	Validate(token string) (user.User, error)
+}
+
+type userClaims struct {
+	Name   string
+	Groups []string
+	jwt.StandardClaims
+}
+
+func NewUserTokenIssuer(signingKeyAccessor SigningKeyAccessor) UserTokenIssuer {
+	return &jwtUserTokenIssuer{
+		jwtTokenIssuer: jwtTokenIssuer{signingKeyAccessor},
+	}
+}
+
+var _ UserTokenIssuer = &jwtUserTokenIssuer{}
+
+type jwtUserTokenIssuer struct {
+	jwtTokenIssuer
+}
+
+func (i *jwtUserTokenIssuer) Generate(u user.User, validFor time.Duration) (string, error) {
+	if validFor <= 0 {
+		return "", errors.New("validity of the token has to be positive")
+	}
+	signingKey, err := i.signingKey()
+	if err != nil {
+		return "", err
+	}
+
+	now := core.Now()
+	c := userClaims{
+		Name:   u.Name,
+		Groups: u.Groups,
+		StandardClaims: jwt.StandardClaims{
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(validFor).Unix(),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
+	tokenString, err := token.SignedString(signingKey)
+	if err != nil {
+		return "", errors.Wrap(err, "could not sign a token")
+	}
+	return tokenString, nil
+}
+
+func (i *jwtUserTokenIssuer) Validate(token string) (user.User, error) {
+	signingKey, err := i.signingKey()
+	if err != nil {
+		return user.User{}, err
+	}
+
+	c := &userClaims{}
+	parsed, err := jwt.ParseWithClaims(token, c, func(*jwt.Token) (interface{}, error) {
+		return signingKey, nil
+	})
+	if err != nil {
+		return user.User{}, errors.Wrap(err, "could not parse token")
+	}
+	if !parsed.Valid {
+		return user.User{}, errors.New("token is not valid")
+	}
+	if c.ExpiresAt == 0 {
+		return user.User{}, errors.New("token does not have an expiration time")
+	}
+	if c.Name == "" {
+		return user.User{}, errors.New("token does not contain a name of the user")
+	}
+	return user.User{
+		Name:   c.Name,
+		Groups: c.Groups,
+	}, nil
+}
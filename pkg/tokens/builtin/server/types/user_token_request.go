@@ -0,0 +1,8 @@
+package types
+
+type UserTokenRequest struct {
+	Name   string   `json:"name"`
+	Groups []string `json:"groups"`
+	// ValidFor is a duration (e.g. "24h") after which the token expires. The Control Plane picks a default when it is empty.
+	ValidFor string `json:"validFor"`
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core/rbac"
+	"github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+)
+
+// DefaultUserTokenValidity is used when a request does not specify validity of the token.
+const DefaultUserTokenValidity = 24 * time.Hour
+
+type userTokenWebService struct {
+	issuer issuer.UserTokenIssuer
+}
+
+// NewUserTokenWebservice returns a webservice that issues User Tokens. Only admins can issue tokens.
+// The webservice expects the user to be put into the context of the request.
+func NewUserTokenWebservice(issuer issuer.UserTokenIssuer) *restful.WebService {
+	ws := userTokenWebService{
+		issuer: issuer,
+	}
+	return ws.createWs()
+}
+
+func (u *userTokenWebService) createWs() *restful.WebService {
+	ws := new(restful.WebService).
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Path("/user-tokens").
+		Route(ws.POST("").To(u.handleUserTokenRequest))
+	return ws
+}
+
+func (u *userTokenWebService) handleUserTokenRequest(request *restful.Request, response *restful.Response) {
+	caller, _ := user.FromContext(request.Request.Context())
+	if !caller.IsAdmin() {
+		errors.HandleError(response, &rbac.AccessDeniedError{User: caller.Name, Verb: "create", ResourceType: "UserToken"}, "Could not issue a token")
+		return
+	}
+
+	userReq := types.UserTokenRequest{}
+	if err := request.ReadEntity(&userReq); err != nil {
+		log.Error(err, "Could not read a request")
+		response.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	validFor := DefaultUserTokenValidity
+	if userReq.ValidFor != "" {
+		d, err := time.ParseDuration(userReq.ValidFor)
+		if err != nil || d <= 0 {
+			verr := validators.ValidationError{}
+			verr.AddViolation("validFor", "has to be a positive duration, e.g. 24h")
+			errors.HandleError(response, verr.OrNil(), "Could not issue a token")
+			return
+		}
+		validFor = d
+	}
+
+	token, err := u.issuer.Generate(user.User{
+		Name:   userReq.Name,
+		Groups: userReq.Groups,
+	}, validFor)
+	if err != nil {
+		errors.HandleError(response, err, "Could not issue a token")
+		return
+	}
+
+	response.Header().Set("content-type", "text/plain")
+	if _, err := response.Write([]byte(token)); err != nil {
+		log.Error(err, "Could write a response")
+	}
+}
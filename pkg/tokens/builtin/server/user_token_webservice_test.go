@@ -0,0 +1,113 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/emicklei/go-restful"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+)
+
+var _ = Describe("User Token Webservice", func() {
+
+	var url string
+	var tokenIssuer issuer.UserTokenIssuer
+	var caller user.User
+
+	BeforeEach(func() {
+		tokenIssuer = issuer.NewUserTokenIssuer(func() ([]byte, error) {
+			return []byte("signing-key"), nil
+		})
+		ws := server.NewUserTokenWebservice(tokenIssuer)
+
+		container := restful.NewContainer()
+		container.Filter(func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+			request.Request = request.Request.WithContext(user.NewContext(request.Request.Context(), caller))
+			chain.ProcessFilter(request, response)
+		})
+		container.Add(ws)
+		srv := httptest.NewServer(container)
+		url = srv.URL
+	})
+
+	requestToken := func(validFor string) *http.Response {
+		reqBytes, err := json.Marshal(types.UserTokenRequest{
+			Name:     "john",
+			Groups:   []string{"operators"},
+			ValidFor: validFor,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		req, err := http.NewRequest("POST", fmt.Sprintf("%s/user-tokens", url), bytes.NewReader(reqBytes))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Add("content-type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		return resp
+	}
+
+	It("should respond with generated token to the admin", func() {
+		// given
+		caller = user.Admin
+
+		// when
+		resp := requestToken("1h")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(200))
+		respBody, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		u, err := tokenIssuer.Validate(string(respBody))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u).To(Equal(user.User{Name: "john", Groups: []string{"operators"}}))
+	})
+
+	It("should issue a token with default validity", func() {
+		// given
+		caller = user.Admin
+
+		// when
+		resp := requestToken("")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(200))
+		respBody, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		claims := jwt.StandardClaims{}
+		_, _, err = new(jwt.Parser).ParseUnverified(string(respBody), &claims)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(claims.ExpiresAt - claims.IssuedAt).To(Equal(int64(server.DefaultUserTokenValidity.Seconds())))
+	})
+
+	It("should not issue a token with invalid validity", func() {
+		// given
+		caller = user.Admin
+
+		// when
+		resp := requestToken("-1h")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(400))
+	})
+
+	It("should not issue a token for non admin user", func() {
+		// given
+		caller = user.User{Name: "jane"}
+
+		// when
+		resp := requestToken("1h")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(403))
+	})
+})
@@ -120,6 +120,14 @@ var _ = Describe("bootstrapGenerator", func() {
 				Name:               "name.namespace",
 				AdminPort:          1234,
 				DataplaneTokenPath: "/tmp/token",
+				Version: types.Version{
+					KumaDp: types.KumaDpVersion{
+						Version:   "0.8.0",
+						GitTag:    "v0.8.0",
+						GitCommit: "91ce236824a9d875601679aa80c63783fb0e8725",
+						BuildDate: "2020-08-25T13:55:21Z",
+					},
+				},
 			},
 			expectedConfigFile: "generator.default-config.golden.yaml",
 		}),
@@ -5,18 +5,28 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	http_prometheus "github.com/slok/go-http-metrics/metrics/prometheus"
 	"github.com/slok/go-http-metrics/middleware"
 	"github.com/slok/go-http-metrics/middleware/std"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/config/xds/bootstrap"
 	"github.com/kumahq/kuma/pkg/core"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
 	"github.com/kumahq/kuma/pkg/core/validators"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	sds_auth "github.com/kumahq/kuma/pkg/sds/auth"
 	"github.com/kumahq/kuma/pkg/util/proto"
 	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
 )
@@ -27,6 +37,15 @@ type BootstrapServer struct {
 	Config    *bootstrap.BootstrapServerConfig
 	Generator BootstrapGenerator
 	Metrics   prometheus.Registerer
+	// ResManager and Authenticator are used to mark Dataplanes as draining before shutdown
+	// and to learn dependencies of Dataplanes from their observed traffic
+	ResManager    manager.ResourceManager
+	Authenticator sds_auth.Authenticator
+	// LearnedDestinationsTTL and LearnedDestinationsLimit bound destinations kept in DependenciesInsight
+	LearnedDestinationsTTL   time.Duration
+	LearnedDestinationsLimit uint32
+	// StatsReportInterval is how often Dataplanes are expected to report their traffic
+	StatsReportInterval time.Duration
 }
 
 func (b *BootstrapServer) NeedLeaderElection() bool {
@@ -45,6 +64,9 @@ func (b *BootstrapServer) Start(stop <-chan struct{}) error {
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/bootstrap", b.handleBootstrapRequest)
+	mux.HandleFunc("/drain", b.handleDrainRequest)
+	mux.HandleFunc("/dependencies", b.handleDependenciesRequest)
+	mux.HandleFunc("/stats", b.handleStatsRequest)
 
 	bootstrapServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", b.Config.Port),
@@ -133,3 +155,292 @@ func (b *BootstrapServer) handleBootstrapRequest(resp http.ResponseWriter, req *
 		return
 	}
 }
+
+// handleDrainRequest marks a Dataplane as draining, so it is excluded from endpoints of other Dataplanes.
+// Requests are authenticated with the same credential that Envoy uses for SDS.
+func (b *BootstrapServer) handleDrainRequest(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		resp.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	bytes, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		log.Error(err, "Could not read a request")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reqParams := types.DrainRequest{}
+	if err := json.Unmarshal(bytes, &reqParams); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	proxyId := core_xds.ProxyId{Mesh: reqParams.Mesh, Name: reqParams.Name}
+	if !b.authenticate(resp, req, proxyId) {
+		return
+	}
+
+	insight := &mesh_core.DataplaneInsightResource{}
+	err = manager.Upsert(b.ResManager, proxyId.ToResourceKey(), insight, func(model.Resource) {
+		_ = insight.Spec.Drain(core.Now()) // ignore error because the current time is always a valid timestamp
+	})
+	if err != nil {
+		log.WithValues("params", reqParams).Error(err, "Could not mark the Dataplane as draining")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	log.Info("Dataplane is draining", "dataplane", proxyId)
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+// handleDependenciesRequest records destinations that a Dataplane reached through the passthrough cluster
+// in Dependencies that select the Dataplane and have learning enabled.
+// Requests are authenticated with the same credential that Envoy uses for SDS.
+func (b *BootstrapServer) handleDependenciesRequest(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		resp.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	bytes, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		log.Error(err, "Could not read a request")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reqParams := types.DependenciesRequest{}
+	if err := json.Unmarshal(bytes, &reqParams); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	for _, destination := range reqParams.Destinations {
+		if _, _, err := net.SplitHostPort(destination); err != nil {
+			log.WithValues("params", reqParams).Info("Invalid destination", "destination", destination, "err", err.Error())
+			resp.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	proxyId := core_xds.ProxyId{Mesh: reqParams.Mesh, Name: reqParams.Name}
+	if !b.authenticate(resp, req, proxyId) {
+		return
+	}
+
+	dataplane := &mesh_core.DataplaneResource{}
+	if err := b.ResManager.Get(req.Context(), dataplane, store.GetBy(proxyId.ToResourceKey())); err != nil {
+		if store.IsResourceNotFound(err) {
+			resp.WriteHeader(http.StatusNotFound)
+			return
+		}
+		log.WithValues("params", reqParams).Error(err, "Could not get the Dataplane")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if err := b.learnDestinations(req.Context(), dataplane, reqParams.Destinations); err != nil {
+		log.WithValues("params", reqParams).Error(err, "Could not learn destinations of the Dataplane")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	bytes, err = json.Marshal(types.DependenciesResponse{RefreshInterval: b.learnedDestinationsRefreshInterval()})
+	if err != nil {
+		log.WithValues("params", reqParams).Error(err, "Could not marshal the response")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("content-type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err := resp.Write(bytes); err != nil {
+		log.WithValues("params", reqParams).Error(err, "Error while writing the response")
+	}
+}
+
+// learnedDestinationsRefreshInterval is how often a learned destination is reported again while it is in use.
+// It is half of the TTL, so a destination in use never expires.
+func (b *BootstrapServer) learnedDestinationsRefreshInterval() time.Duration {
+	return b.LearnedDestinationsTTL / 2
+}
+
+func (b *BootstrapServer) learnDestinations(ctx context.Context, dataplane *mesh_core.DataplaneResource, destinations []string) error {
+	dependencies := &mesh_core.DependenciesResourceList{}
+	if err := b.ResManager.List(ctx, dependencies, store.ListByMesh(dataplane.Meta.GetMesh())); err != nil {
+		return errors.Wrap(err, "could not list Dependencies")
+	}
+	for _, dependency := range dependencies.Items {
+		if !dependency.Spec.Learn || !dependency.Selects(&dataplane.Spec) {
+			continue
+		}
+		if err := b.learnDestinationsOf(ctx, dependency, destinations); err != nil {
+			return err
+		}
+		log.Info("learned destinations", "dependencies", dependency.Meta.GetName(), "mesh", dependency.Meta.GetMesh(), "dataplane", dataplane.Meta.GetName())
+	}
+	return nil
+}
+
+// refreshLearnedDestinations keeps learned destinations that a Dataplane still has traffic to from expiring.
+// A learned destination becomes an outbound of the Dataplane, so its traffic no longer goes through the passthrough
+// cluster and the Dataplane does not report it as a dependency. Instead, new connections to the cluster
+// of the outbound are taken as a sign that the destination is still in use.
+func (b *BootstrapServer) refreshLearnedDestinations(ctx context.Context, proxyId core_xds.ProxyId, clusters map[string]types.ClusterStats) error {
+	connected := map[string]bool{}
+	for name, stats := range clusters {
+		if stats.Connections > 0 {
+			connected[name] = true
+		}
+	}
+	if len(connected) == 0 {
+		return nil
+	}
+	dataplane := &mesh_core.DataplaneResource{}
+	if err := b.ResManager.Get(ctx, dataplane, store.GetBy(proxyId.ToResourceKey())); err != nil {
+		return errors.Wrap(err, "could not get the Dataplane")
+	}
+	var destinations []string
+	for _, outbound := range dataplane.Spec.GetNetworking().GetOutbound() {
+		// clusters of outbounds are named after the service, see generator.OutboundProxyGenerator
+		if connected[outbound.GetTagsIncludingLegacy()[mesh_proto.ServiceTag]] {
+			destinations = append(destinations, net.JoinHostPort(outbound.Address, strconv.Itoa(int(outbound.Port))))
+		}
+	}
+	if len(destinations) == 0 {
+		return nil
+	}
+
+	dependencies := &mesh_core.DependenciesResourceList{}
+	if err := b.ResManager.List(ctx, dependencies, store.ListByMesh(dataplane.Meta.GetMesh())); err != nil {
+		return errors.Wrap(err, "could not list Dependencies")
+	}
+	for _, dependency := range dependencies.Items {
+		if !dependency.Spec.Learn || !dependency.Selects(&dataplane.Spec) {
+			continue
+		}
+		key := model.MetaToResourceKey(dependency.Meta)
+		insight := &mesh_core.DependenciesInsightResource{}
+		if err := b.ResManager.Get(ctx, insight, store.GetBy(key)); err != nil {
+			if store.IsResourceNotFound(err) {
+				continue
+			}
+			return errors.Wrapf(err, "could not get DependenciesInsight %q", key.Name)
+		}
+		if !insight.RefreshDestinations(destinations, core.Now(), b.learnedDestinationsRefreshInterval()) {
+			continue
+		}
+		if err := b.ResManager.Update(ctx, insight); err != nil {
+			return errors.Wrapf(err, "could not update DependenciesInsight %q", key.Name)
+		}
+	}
+	return nil
+}
+
+// learnDestinationsOf records destinations in the DependenciesInsight of the same name as the Dependencies.
+// The insight is owned by the Dependencies so it is deleted together with them.
+func (b *BootstrapServer) learnDestinationsOf(ctx context.Context, dependency *mesh_core.DependenciesResource, destinations []string) error {
+	key := model.MetaToResourceKey(dependency.Meta)
+	insight := &mesh_core.DependenciesInsightResource{}
+	create := false
+	if err := b.ResManager.Get(ctx, insight, store.GetBy(key)); err != nil {
+		if !store.IsResourceNotFound(err) {
+			return errors.Wrapf(err, "could not get DependenciesInsight %q", key.Name)
+		}
+		create = true
+	}
+	if !insight.LearnDestinations(destinations, core.Now(), b.LearnedDestinationsTTL, int(b.LearnedDestinationsLimit)) {
+		return nil
+	}
+	if create {
+		if err := b.ResManager.Create(ctx, insight, store.CreateBy(key), store.CreateWithOwner(dependency)); err != nil {
+			return errors.Wrapf(err, "could not create DependenciesInsight %q", key.Name)
+		}
+		return nil
+	}
+	if err := b.ResManager.Update(ctx, insight); err != nil {
+		return errors.Wrapf(err, "could not update DependenciesInsight %q", key.Name)
+	}
+	return nil
+}
+
+// handleStatsRequest records the traffic that a Dataplane reported to its upstream clusters in its DataplaneInsight,
+// so every instance of the Control Plane can build the service graph out of it.
+// Requests are authenticated with the same credential that Envoy uses for SDS.
+func (b *BootstrapServer) handleStatsRequest(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		resp.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	bytes, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		log.Error(err, "Could not read a request")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reqParams := types.StatsRequest{}
+	if err := json.Unmarshal(bytes, &reqParams); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if reqParams.Period <= 0 {
+		log.WithValues("params", reqParams).Info("Invalid period of stats")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	proxyId := core_xds.ProxyId{Mesh: reqParams.Mesh, Name: reqParams.Name}
+	if !b.authenticate(resp, req, proxyId) {
+		return
+	}
+
+	clusters := map[string]*mesh_proto.DataplaneInsight_ClusterTraffic{}
+	for name, stats := range reqParams.Clusters {
+		clusters[name] = &mesh_proto.DataplaneInsight_ClusterTraffic{
+			Requests:     stats.Requests,
+			Errors:       stats.Errors,
+			Connections:  stats.Connections,
+			LatencySum:   stats.LatencySum,
+			LatencyCount: stats.LatencyCount,
+		}
+	}
+	insight := &mesh_core.DataplaneInsightResource{}
+	err = manager.Upsert(b.ResManager, proxyId.ToResourceKey(), insight, func(model.Resource) {
+		_ = insight.Spec.ReportTraffic(core.Now(), reqParams.Period, clusters) // ignore error because the current time is always a valid timestamp
+	})
+	if err != nil {
+		log.WithValues("params", reqParams).Error(err, "Could not record traffic of the Dataplane")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if err := b.refreshLearnedDestinations(req.Context(), proxyId, reqParams.Clusters); err != nil {
+		// the traffic is recorded and destinations are refreshed with one of the next reports
+		log.WithValues("params", reqParams).Error(err, "Could not refresh learned destinations of the Dataplane")
+	}
+
+	bytes, err = json.Marshal(types.StatsResponse{ReportInterval: b.StatsReportInterval})
+	if err != nil {
+		log.WithValues("params", reqParams).Error(err, "Could not marshal the response")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("content-type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err := resp.Write(bytes); err != nil {
+		log.WithValues("params", reqParams).Error(err, "Error while writing the response")
+	}
+}
+
+// authenticate verifies that the request is sent by the given Dataplane.
+// If it is not, the status of the response is written and false is returned.
+func (b *BootstrapServer) authenticate(resp http.ResponseWriter, req *http.Request, proxyId core_xds.ProxyId) bool {
+	credential := sds_auth.Credential(req.Header.Get("Authorization"))
+	if _, err := b.Authenticator.Authenticate(req.Context(), proxyId, credential); err != nil {
+		if store.IsResourceNotFound(errors.Cause(err)) {
+			resp.WriteHeader(http.StatusNotFound)
+			return false
+		}
+		log.WithValues("dataplane", proxyId, "path", req.URL.Path).Info("Could not authenticate a request", "err", err.Error())
+		resp.WriteHeader(http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
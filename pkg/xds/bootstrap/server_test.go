@@ -10,6 +10,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
 	"github.com/kumahq/kuma/pkg/core"
 
 	. "github.com/onsi/ginkgo"
@@ -21,12 +24,29 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	core_metrics "github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	sds_auth "github.com/kumahq/kuma/pkg/sds/auth"
 	"github.com/kumahq/kuma/pkg/test"
 	test_metrics "github.com/kumahq/kuma/pkg/test/metrics"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
+type testAuthenticator struct {
+	resManager manager.ResourceManager
+}
+
+func (t *testAuthenticator) Authenticate(ctx context.Context, proxyId core_xds.ProxyId, credential sds_auth.Credential) (sds_auth.Identity, error) {
+	if err := t.resManager.Get(ctx, &mesh.DataplaneResource{}, store.GetBy(proxyId.ToResourceKey())); err != nil {
+		return sds_auth.Identity{}, errors.Wrap(err, "unable to find Dataplane")
+	}
+	if credential != "valid-token" {
+		return sds_auth.Identity{}, errors.New("invalid credential")
+	}
+	return sds_auth.Identity{Mesh: proxyId.Mesh}, nil
+}
+
 var _ = Describe("Bootstrap Server", func() {
 
 	var stop chan struct{}
@@ -60,6 +80,12 @@ var _ = Describe("Bootstrap Server", func() {
 			},
 			Generator: NewDefaultBootstrapGenerator(resManager, config, ""),
 			Metrics:   metrics,
+
+			ResManager:               resManager,
+			Authenticator:            &testAuthenticator{resManager: resManager},
+			LearnedDestinationsTTL:   time.Hour,
+			LearnedDestinationsLimit: 3,
+			StatsReportInterval:      10 * time.Second,
 		}
 		stop = make(chan struct{})
 		go func() {
@@ -196,4 +222,335 @@ var _ = Describe("Bootstrap Server", func() {
 		Expect(test_metrics.FindMetric(metrics, "bootstrap_server_http_requests_inflight", "handler", "/bootstrap")).ToNot(BeNil())
 		Expect(test_metrics.FindMetric(metrics, "bootstrap_server_http_response_size_bytes", "handler", "/bootstrap")).ToNot(BeNil())
 	})
+
+	Describe("drain", func() {
+
+		createDataplane := func() {
+			res := mesh.DataplaneResource{
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "8.8.8.8",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Port:        443,
+								ServicePort: 8443,
+								Tags: map[string]string{
+									"kuma.io/service": "backend",
+								},
+							},
+						},
+					},
+				},
+			}
+			err := resManager.Create(context.Background(), &res, store.CreateByKey("dp-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		drain := func(credential string) *http.Response {
+			req, err := http.NewRequest("POST", baseUrl+"/drain", strings.NewReader(`{ "mesh": "default", "name": "dp-1" }`))
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("Authorization", credential)
+			resp, err := httpClient.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			return resp
+		}
+
+		It("should mark the dataplane as draining", func() {
+			// given
+			createDataplane()
+
+			// when
+			resp := drain("valid-token")
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+			insight := &mesh.DataplaneInsightResource{}
+			Expect(resManager.Get(context.Background(), insight, store.GetByKey("dp-1", "default"))).To(Succeed())
+			Expect(insight.Spec.IsDraining()).To(BeTrue())
+			Expect(ptypes.TimestampString(insight.Spec.DrainTime)).To(Equal("2018-07-17T16:05:36.995Z"))
+		})
+
+		It("should reject a request with an invalid credential", func() {
+			// given
+			createDataplane()
+
+			// when
+			resp := drain("invalid-token")
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+			err := resManager.Get(context.Background(), &mesh.DataplaneInsightResource{}, store.GetByKey("dp-1", "default"))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should return 404 for unknown dataplane", func() {
+			// when
+			resp := drain("valid-token")
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("dependencies", func() {
+
+		BeforeEach(func() {
+			dataplane := mesh.DataplaneResource{
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "8.8.8.8",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Port: 443,
+								Tags: map[string]string{
+									"kuma.io/service": "backend",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(resManager.Create(context.Background(), &dataplane, store.CreateByKey("dp-1", "default"))).To(Succeed())
+
+			for name, spec := range map[string]mesh_proto.Dependencies{
+				"learning": {
+					Selectors: []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "backend"}}},
+					Learn:     true,
+				},
+				"not-learning": {
+					Selectors: []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "backend"}}},
+					Services:  []string{"redis"},
+				},
+				"other-service": {
+					Selectors: []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "web"}}},
+					Learn:     true,
+				},
+			} {
+				dependencies := mesh.DependenciesResource{Spec: spec}
+				Expect(resManager.Create(context.Background(), &dependencies, store.CreateByKey(name, "default"))).To(Succeed())
+			}
+
+			lastSeen, err := ptypes.TimestampProto(core.Now())
+			Expect(err).ToNot(HaveOccurred())
+			insight := mesh.DependenciesInsightResource{
+				Spec: mesh_proto.DependenciesInsight{
+					LearnedDestinations: []*mesh_proto.DependenciesInsight_LearnedDestination{
+						{Address: "10.0.0.2:80", LastSeen: lastSeen},
+					},
+				},
+			}
+			Expect(resManager.Create(context.Background(), &insight, store.CreateByKey("learning", "default"))).To(Succeed())
+		})
+
+		report := func(credential string, body string) (*http.Response, string) {
+			req, err := http.NewRequest("POST", baseUrl+"/dependencies", strings.NewReader(body))
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("Authorization", credential)
+			resp, err := httpClient.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+			respBody, err := ioutil.ReadAll(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			return resp, string(respBody)
+		}
+
+		learnedDestinations := func(name string) []string {
+			insight := &mesh.DependenciesInsightResource{}
+			err := resManager.Get(context.Background(), insight, store.GetByKey(name, "default"))
+			if store.IsResourceNotFound(err) {
+				return nil
+			}
+			Expect(err).ToNot(HaveOccurred())
+			return insight.Destinations(core.Now(), time.Hour)
+		}
+
+		It("should learn destinations in Dependencies that select the dataplane", func() {
+			// when
+			resp, body := report("valid-token", `{ "mesh": "default", "name": "dp-1", "destinations": ["10.0.0.1:443", "10.0.0.2:80"] }`)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(body).To(MatchJSON(`{ "refreshInterval": 1800000000000 }`))
+			Expect(learnedDestinations("learning")).To(Equal([]string{"10.0.0.1:443", "10.0.0.2:80"}))
+			Expect(learnedDestinations("not-learning")).To(BeEmpty())
+			Expect(learnedDestinations("other-service")).To(BeEmpty())
+		})
+
+		It("should keep at most the limit of destinations", func() {
+			// when
+			resp, _ := report("valid-token", `{ "mesh": "default", "name": "dp-1", "destinations": ["10.0.0.1:443", "10.0.0.3:80", "10.0.0.4:80"] }`)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(learnedDestinations("learning")).To(HaveLen(3))
+		})
+
+		It("should reject a request with an invalid credential", func() {
+			// when
+			resp, _ := report("invalid-token", `{ "mesh": "default", "name": "dp-1", "destinations": ["10.0.0.1:443"] }`)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+			Expect(learnedDestinations("learning")).To(Equal([]string{"10.0.0.2:80"}))
+		})
+
+		It("should reject a request with an invalid destination", func() {
+			// when
+			resp, _ := report("valid-token", `{ "mesh": "default", "name": "dp-1", "destinations": ["10.0.0.1"] }`)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+			Expect(learnedDestinations("learning")).To(Equal([]string{"10.0.0.2:80"}))
+		})
+
+		It("should return 404 for unknown dataplane", func() {
+			// when
+			resp, _ := report("valid-token", `{ "mesh": "default", "name": "dp-2", "destinations": ["10.0.0.1:443"] }`)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("stats", func() {
+
+		BeforeEach(func() {
+			dataplane := mesh.DataplaneResource{
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "8.8.8.8",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Port:        443,
+								ServicePort: 8443,
+								Tags: map[string]string{
+									"kuma.io/service": "web",
+								},
+							},
+						},
+						Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+							{
+								Address: "10.0.0.2",
+								Port:    80,
+								Tags: map[string]string{
+									"kuma.io/service": "redis",
+								},
+							},
+						},
+					},
+				},
+			}
+			err := resManager.Create(context.Background(), &dataplane, store.CreateByKey("dp-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		report := func(credential string, body string) (*http.Response, string) {
+			req, err := http.NewRequest("POST", baseUrl+"/stats", strings.NewReader(body))
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("Authorization", credential)
+			resp, err := httpClient.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+			respBody, err := ioutil.ReadAll(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			return resp, string(respBody)
+		}
+
+		It("should record the traffic in the DataplaneInsight", func() {
+			// when
+			resp, body := report("valid-token", `{ "mesh": "default", "name": "dp-1", "period": 30000000000, "clusters": { "backend": { "requests": 300, "errors": 3, "connections": 2, "latencySum": 1500.5, "latencyCount": 300 } } }`)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(body).To(MatchJSON(`{ "reportInterval": 10000000000 }`))
+
+			// and
+			insight := &mesh.DataplaneInsightResource{}
+			Expect(resManager.Get(context.Background(), insight, store.GetByKey("dp-1", "default"))).To(Succeed())
+			Expect(util_proto.ToYAML(insight.Spec.Traffic)).To(MatchYAML(`
+            reportTime: "2018-07-17T16:05:36.995Z"
+            period: 30s
+            clusters:
+              backend:
+                requests: "300"
+                errors: "3"
+                connections: "2"
+                latencySum: 1500.5
+                latencyCount: "300"
+`))
+		})
+
+		It("should refresh learned destinations that the dataplane has new connections to", func() {
+			// given
+			dependencies := mesh.DependenciesResource{
+				Spec: mesh_proto.Dependencies{
+					Selectors: []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "web"}}},
+					Learn:     true,
+				},
+			}
+			Expect(resManager.Create(context.Background(), &dependencies, store.CreateByKey("learning", "default"))).To(Succeed())
+			lastSeen, err := ptypes.TimestampProto(core.Now().Add(-45 * time.Minute))
+			Expect(err).ToNot(HaveOccurred())
+			insight := mesh.DependenciesInsightResource{
+				Spec: mesh_proto.DependenciesInsight{
+					LearnedDestinations: []*mesh_proto.DependenciesInsight_LearnedDestination{
+						{Address: "10.0.0.2:80", LastSeen: lastSeen},
+						{Address: "10.0.0.3:80", LastSeen: lastSeen},
+					},
+				},
+			}
+			Expect(resManager.Create(context.Background(), &insight, store.CreateByKey("learning", "default"))).To(Succeed())
+
+			// when
+			resp, _ := report("valid-token", `{ "mesh": "default", "name": "dp-1", "period": 30000000000, "clusters": { "redis": { "connections": 1 } } }`)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resManager.Get(context.Background(), &insight, store.GetByKey("learning", "default"))).To(Succeed())
+			Expect(insight.Destinations(core.Now(), 30*time.Minute)).To(Equal([]string{"10.0.0.2:80"}))
+		})
+
+		It("should keep the rest of the DataplaneInsight", func() {
+			// given
+			insight := &mesh.DataplaneInsightResource{}
+			Expect(insight.Spec.Drain(core.Now())).To(Succeed())
+			Expect(resManager.Create(context.Background(), insight, store.CreateByKey("dp-1", "default"))).To(Succeed())
+
+			// when
+			resp, _ := report("valid-token", `{ "mesh": "default", "name": "dp-1", "period": 30000000000 }`)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resManager.Get(context.Background(), insight, store.GetByKey("dp-1", "default"))).To(Succeed())
+			Expect(insight.Spec.IsDraining()).To(BeTrue())
+			Expect(insight.Spec.Traffic).ToNot(BeNil())
+		})
+
+		It("should reject a request with an invalid credential", func() {
+			// when
+			resp, _ := report("invalid-token", `{ "mesh": "default", "name": "dp-1", "period": 30000000000 }`)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+			err := resManager.Get(context.Background(), &mesh.DataplaneInsightResource{}, store.GetByKey("dp-1", "default"))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should reject a request without a period", func() {
+			// when
+			resp, _ := report("valid-token", `{ "mesh": "default", "name": "dp-1" }`)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return 404 for unknown dataplane", func() {
+			// when
+			resp, _ := report("valid-token", `{ "mesh": "default", "name": "dp-2", "period": 30000000000 }`)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		})
+	})
 })
@@ -15,6 +15,10 @@ type configParameters struct {
 	DataplaneTokenPath string
 	DataplaneResource  string
 	CertBytes          string
+	KumaDpVersion      string
+	KumaDpGitTag       string
+	KumaDpGitCommit    string
+	KumaDpBuildDate    string
 }
 
 const configTemplate string = `
@@ -31,6 +35,14 @@ node:
 {{if .AdminPort }}
     dataplane.admin.port: "{{ .AdminPort }}"
 {{ end }}
+{{if .KumaDpVersion }}
+    version:
+      kumaDp:
+        version: "{{ .KumaDpVersion }}"
+        gitTag: "{{ .KumaDpGitTag }}"
+        gitCommit: "{{ .KumaDpGitCommit }}"
+        buildDate: "{{ .KumaDpBuildDate }}"
+{{ end }}
 
 {{if .AdminPort }}
 admin:
@@ -1,9 +1,21 @@
 package types
 
 type BootstrapRequest struct {
-	Mesh               string `json:"mesh"`
-	Name               string `json:"name"`
-	AdminPort          uint32 `json:"adminPort,omitempty"`
-	DataplaneTokenPath string `json:"dataplaneTokenPath,omitempty"`
-	DataplaneResource  string `json:"dataplaneResource,omitempty"`
+	Mesh               string  `json:"mesh"`
+	Name               string  `json:"name"`
+	AdminPort          uint32  `json:"adminPort,omitempty"`
+	DataplaneTokenPath string  `json:"dataplaneTokenPath,omitempty"`
+	DataplaneResource  string  `json:"dataplaneResource,omitempty"`
+	Version            Version `json:"version"`
+}
+
+type Version struct {
+	KumaDp KumaDpVersion `json:"kumaDp"`
+}
+
+type KumaDpVersion struct {
+	Version   string `json:"version"`
+	GitTag    string `json:"gitTag"`
+	GitCommit string `json:"gitCommit"`
+	BuildDate string `json:"buildDate"`
 }
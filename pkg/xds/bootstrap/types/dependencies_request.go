@@ -0,0 +1,18 @@
+package types
+
+import (
+	"time"
+)
+
+type DependenciesRequest struct {
+	Mesh string `json:"mesh"`
+	Name string `json:"name"`
+	// Destinations in the "address:port" format reached by the Dataplane through the passthrough cluster
+	Destinations []string `json:"destinations"`
+}
+
+type DependenciesResponse struct {
+	// RefreshInterval is how long after a destination was accepted the Dataplane should report it again
+	// if it still reaches it through the passthrough cluster
+	RefreshInterval time.Duration `json:"refreshInterval"`
+}
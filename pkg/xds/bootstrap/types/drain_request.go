@@ -0,0 +1,6 @@
+package types
+
+type DrainRequest struct {
+	Mesh string `json:"mesh"`
+	Name string `json:"name"`
+}
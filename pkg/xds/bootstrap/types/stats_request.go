@@ -0,0 +1,27 @@
+package types
+
+import (
+	"time"
+)
+
+type StatsRequest struct {
+	Mesh string `json:"mesh"`
+	Name string `json:"name"`
+	// Period over which the stats were observed
+	Period time.Duration `json:"period"`
+	// Stats of upstream clusters that had traffic during the period indexed by the name of a cluster
+	Clusters map[string]ClusterStats `json:"clusters"`
+}
+
+type ClusterStats struct {
+	Requests     uint64  `json:"requests"`
+	Errors       uint64  `json:"errors"`
+	Connections  uint64  `json:"connections"`
+	LatencySum   float64 `json:"latencySum"`
+	LatencyCount uint64  `json:"latencyCount"`
+}
+
+type StatsResponse struct {
+	// ReportInterval is how often the Control Plane expects the Dataplane to report stats
+	ReportInterval time.Duration `json:"reportInterval"`
+}
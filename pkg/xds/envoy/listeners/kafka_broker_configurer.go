@@ -0,0 +1,44 @@
+package listeners
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_kafka "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/kafka_broker/v2alpha1"
+
+	"github.com/kumahq/kuma/pkg/util/proto"
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+)
+
+const kafkaBrokerFilterName = "envoy.filters.network.kafka_broker"
+
+// KafkaBroker inserts the Kafka broker filter that emits per-request stats.
+// It has to be configured before the terminal filter (e.g. TcpProxy) of the chain.
+func KafkaBroker(statsName string) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.Add(&KafkaBrokerConfigurer{
+			statsName: statsName,
+		})
+	})
+}
+
+type KafkaBrokerConfigurer struct {
+	statsName string
+}
+
+func (c *KafkaBrokerConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	kafkaBroker := &envoy_kafka.KafkaBroker{
+		StatPrefix: util_xds.SanitizeMetric(c.statsName),
+	}
+
+	pbst, err := proto.MarshalAnyDeterministic(kafkaBroker)
+	if err != nil {
+		return err
+	}
+
+	filterChain.Filters = append(filterChain.Filters, &envoy_listener.Filter{
+		Name: kafkaBrokerFilterName,
+		ConfigType: &envoy_listener.Filter_TypedConfig{
+			TypedConfig: pbst,
+		},
+	})
+	return nil
+}
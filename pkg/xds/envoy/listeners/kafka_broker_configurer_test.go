@@ -0,0 +1,50 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("KafkaBrokerConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		listener, err := NewListenerBuilder().
+			Configure(InboundListener("inbound:192.168.0.1:9092", "192.168.0.1", 9092)).
+			Configure(FilterChain(NewFilterChainBuilder().
+				Configure(KafkaBroker("localhost:19092")).
+				Configure(TcpProxy("localhost:19092", envoy_common.ClusterSubset{ClusterName: "localhost:19092"})))).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+        name: inbound:192.168.0.1:9092
+        trafficDirection: INBOUND
+        address:
+          socketAddress:
+            address: 192.168.0.1
+            portValue: 9092
+        filterChains:
+        - filters:
+          - name: envoy.filters.network.kafka_broker
+            typedConfig:
+              '@type': type.googleapis.com/envoy.config.filter.network.kafka_broker.v2alpha1.KafkaBroker
+              statPrefix: localhost_19092
+          - name: envoy.tcp_proxy
+            typedConfig:
+              '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+              cluster: localhost:19092
+              statPrefix: localhost_19092
+`))
+	})
+})
@@ -0,0 +1,58 @@
+package listeners
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_mongo "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/mongo_proxy/v2"
+	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/util/proto"
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+)
+
+// MongoProxy inserts the MongoDB proxy filter that emits per-command stats.
+// It has to be configured before the terminal filter (e.g. TcpProxy) of the chain.
+//
+// Envoy can only write MongoDB operation logs to a file, so the access log is configured
+// only when a given backend is of the file type.
+func MongoProxy(statsName string, backend *mesh_proto.LoggingBackend) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.Add(&MongoProxyConfigurer{
+			statsName: statsName,
+			backend:   backend,
+		})
+	})
+}
+
+type MongoProxyConfigurer struct {
+	statsName string
+	backend   *mesh_proto.LoggingBackend
+}
+
+func (c *MongoProxyConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	mongoProxy := &envoy_mongo.MongoProxy{
+		StatPrefix:          util_xds.SanitizeMetric(c.statsName),
+		EmitDynamicMetadata: true,
+	}
+	if c.backend.GetType() == mesh_proto.LoggingFileType {
+		cfg := mesh_proto.FileLoggingBackendConfig{}
+		if err := proto.ToTyped(c.backend.GetConf(), &cfg); err != nil {
+			return errors.Wrap(err, "could not parse backend config")
+		}
+		mongoProxy.AccessLog = cfg.Path
+	}
+
+	pbst, err := proto.MarshalAnyDeterministic(mongoProxy)
+	if err != nil {
+		return err
+	}
+
+	filterChain.Filters = append(filterChain.Filters, &envoy_listener.Filter{
+		Name: envoy_wellknown.MongoProxy,
+		ConfigType: &envoy_listener.Filter_TypedConfig{
+			TypedConfig: pbst,
+		},
+	})
+	return nil
+}
@@ -0,0 +1,123 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("MongoProxyConfigurer", func() {
+
+	type testCase struct {
+		backend  *mesh_proto.LoggingBackend
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			listener, err := NewListenerBuilder().
+				Configure(OutboundListener("outbound:127.0.0.1:27017", "127.0.0.1", 27017)).
+				Configure(FilterChain(NewFilterChainBuilder().
+					Configure(MongoProxy("mongo", given.backend)).
+					Configure(TcpProxy("mongo", envoy_common.ClusterSubset{ClusterName: "mongo"})))).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(listener)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("without access log", testCase{
+			backend: nil,
+			expected: `
+            name: outbound:127.0.0.1:27017
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27017
+            filterChains:
+            - filters:
+              - name: envoy.mongo_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.mongo_proxy.v2.MongoProxy
+                  emitDynamicMetadata: true
+                  statPrefix: mongo
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  cluster: mongo
+                  statPrefix: mongo
+`,
+		}),
+		Entry("with file access log", testCase{
+			backend: &mesh_proto.LoggingBackend{
+				Name: "file",
+				Type: mesh_proto.LoggingFileType,
+				Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+					Path: "/tmp/log",
+				}),
+			},
+			expected: `
+            name: outbound:127.0.0.1:27017
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27017
+            filterChains:
+            - filters:
+              - name: envoy.mongo_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.mongo_proxy.v2.MongoProxy
+                  accessLog: /tmp/log
+                  emitDynamicMetadata: true
+                  statPrefix: mongo
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  cluster: mongo
+                  statPrefix: mongo
+`,
+		}),
+		Entry("with tcp access log", testCase{
+			backend: &mesh_proto.LoggingBackend{
+				Name: "tcp",
+				Type: mesh_proto.LoggingTcpType,
+				Conf: util_proto.MustToStruct(&mesh_proto.TcpLoggingBackendConfig{
+					Address: "127.0.0.1:1234",
+				}),
+			},
+			expected: `
+            name: outbound:127.0.0.1:27017
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27017
+            filterChains:
+            - filters:
+              - name: envoy.mongo_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.mongo_proxy.v2.MongoProxy
+                  emitDynamicMetadata: true
+                  statPrefix: mongo
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  cluster: mongo
+                  statPrefix: mongo
+`,
+		}),
+	)
+})
@@ -0,0 +1,43 @@
+package listeners
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_mysql "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/mysql_proxy/v1alpha1"
+	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+
+	"github.com/kumahq/kuma/pkg/util/proto"
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+)
+
+// MySQLProxy inserts the MySQL proxy filter that emits per-command stats.
+// It has to be configured before the terminal filter (e.g. TcpProxy) of the chain.
+func MySQLProxy(statsName string) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.Add(&MySQLProxyConfigurer{
+			statsName: statsName,
+		})
+	})
+}
+
+type MySQLProxyConfigurer struct {
+	statsName string
+}
+
+func (c *MySQLProxyConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	mysqlProxy := &envoy_mysql.MySQLProxy{
+		StatPrefix: util_xds.SanitizeMetric(c.statsName),
+	}
+
+	pbst, err := proto.MarshalAnyDeterministic(mysqlProxy)
+	if err != nil {
+		return err
+	}
+
+	filterChain.Filters = append(filterChain.Filters, &envoy_listener.Filter{
+		Name: envoy_wellknown.MySQLProxy,
+		ConfigType: &envoy_listener.Filter_TypedConfig{
+			TypedConfig: pbst,
+		},
+	})
+	return nil
+}
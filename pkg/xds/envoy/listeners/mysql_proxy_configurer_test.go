@@ -0,0 +1,50 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("MySQLProxyConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		listener, err := NewListenerBuilder().
+			Configure(OutboundListener("outbound:127.0.0.1:3306", "127.0.0.1", 3306)).
+			Configure(FilterChain(NewFilterChainBuilder().
+				Configure(MySQLProxy("db")).
+				Configure(TcpProxy("db", envoy_common.ClusterSubset{ClusterName: "db"})))).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+        name: outbound:127.0.0.1:3306
+        trafficDirection: OUTBOUND
+        address:
+          socketAddress:
+            address: 127.0.0.1
+            portValue: 3306
+        filterChains:
+        - filters:
+          - name: envoy.filters.network.mysql_proxy
+            typedConfig:
+              '@type': type.googleapis.com/envoy.config.filter.network.mysql_proxy.v1alpha1.MySQLProxy
+              statPrefix: db
+          - name: envoy.tcp_proxy
+            typedConfig:
+              '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+              cluster: db
+              statPrefix: db
+`))
+	})
+})
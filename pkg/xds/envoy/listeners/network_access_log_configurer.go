@@ -1,6 +1,7 @@
 package listeners
 
 import (
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	envoy_tcp "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/tcp_proxy/v2"
 
@@ -43,3 +44,35 @@ func (c *NetworkAccessLogConfigurer) Configure(filterChain *envoy_listener.Filte
 		return nil
 	})
 }
+
+// ListenerAccessLog configures an access log of the whole listener. Envoy emits it when a connection is closed.
+// It is used for terminal filters other than TcpProxy (e.g. Redis proxy) that cannot log connections on their own.
+func ListenerAccessLog(mesh string, trafficDirection TrafficDirection, sourceService string, destinationService string, backend *v1alpha1.LoggingBackend, proxy *core_xds.Proxy) ListenerBuilderOpt {
+	return ListenerBuilderOptFunc(func(config *ListenerBuilderConfig) {
+		if backend != nil {
+			config.Add(&ListenerAccessLogConfigurer{
+				AccessLogConfigurer: AccessLogConfigurer{
+					mesh:               mesh,
+					trafficDirection:   trafficDirection,
+					sourceService:      sourceService,
+					destinationService: destinationService,
+					backend:            backend,
+					proxy:              proxy,
+				},
+			})
+		}
+	})
+}
+
+type ListenerAccessLogConfigurer struct {
+	AccessLogConfigurer
+}
+
+func (c *ListenerAccessLogConfigurer) Configure(l *v2.Listener) error {
+	accessLog, err := convertLoggingBackend(c.AccessLogConfigurer.mesh, c.AccessLogConfigurer.trafficDirection, c.AccessLogConfigurer.sourceService, c.AccessLogConfigurer.destinationService, c.AccessLogConfigurer.backend, c.AccessLogConfigurer.proxy, defaultNetworkAccessLogFormat)
+	if err != nil {
+		return err
+	}
+	l.AccessLog = append(l.AccessLog, accessLog)
+	return nil
+}
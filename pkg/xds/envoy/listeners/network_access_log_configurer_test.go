@@ -188,4 +188,71 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
 `,
 		}),
 	)
+
+	It("should configure access log of the whole listener", func() {
+		// given
+		proxy := &core_xds.Proxy{
+			Id: xds.ProxyId{
+				Name: "backend",
+				Mesh: "example",
+			},
+			Dataplane: &mesh_core.DataplaneResource{
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+					},
+				},
+			},
+		}
+		backend := &mesh_proto.LoggingBackend{
+			Name: "file",
+			Type: mesh_proto.LoggingFileType,
+			Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+				Path: "/tmp/log",
+			}),
+		}
+
+		// when
+		listener, err := NewListenerBuilder().
+			Configure(OutboundListener("outbound:127.0.0.1:6379", "127.0.0.1", 6379)).
+			Configure(FilterChain(NewFilterChainBuilder().
+				Configure(RedisProxy("redis", "redis")))).
+			Configure(ListenerAccessLog("demo", TrafficDirectionOutbound, "backend", "redis", backend, proxy)).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+        name: outbound:127.0.0.1:6379
+        trafficDirection: OUTBOUND
+        address:
+          socketAddress:
+            address: 127.0.0.1
+            portValue: 6379
+        accessLog:
+        - name: envoy.file_access_log
+          typedConfig:
+            '@type': type.googleapis.com/envoy.config.accesslog.v2.FileAccessLog
+            format: |+
+              [%START_TIME%] %RESPONSE_FLAGS% demo 192.168.0.1(backend)->%UPSTREAM_HOST%(redis) took %DURATION%ms, sent %BYTES_SENT% bytes, received: %BYTES_RECEIVED% bytes
+
+            path: /tmp/log
+        filterChains:
+        - filters:
+          - name: envoy.redis_proxy
+            typedConfig:
+              '@type': type.googleapis.com/envoy.config.filter.network.redis_proxy.v2.RedisProxy
+              prefixRoutes:
+                catchAllRoute:
+                  cluster: redis
+              settings:
+                enableCommandStats: true
+                opTimeout: 10s
+              statPrefix: redis
+`))
+	})
 })
@@ -0,0 +1,62 @@
+package listeners
+
+import (
+	"time"
+
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_redis "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/redis_proxy/v2"
+	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/kumahq/kuma/pkg/util/proto"
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+)
+
+const defaultRedisOpTimeout = 10 * time.Second
+
+// RedisProxy configures the Redis proxy filter that emits per-command stats.
+//
+// Unlike other protocol filters, Redis proxy is a terminal filter that routes commands on its own,
+// therefore it has to be used instead of TcpProxy rather than in front of it.
+func RedisProxy(statsName string, cluster string) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.Add(&RedisProxyConfigurer{
+			statsName: statsName,
+			cluster:   cluster,
+		})
+	})
+}
+
+type RedisProxyConfigurer struct {
+	statsName string
+	// Cluster to forward all commands to.
+	cluster string
+}
+
+func (c *RedisProxyConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	redisProxy := &envoy_redis.RedisProxy{
+		StatPrefix: util_xds.SanitizeMetric(c.statsName),
+		Settings: &envoy_redis.RedisProxy_ConnPoolSettings{
+			OpTimeout:          ptypes.DurationProto(defaultRedisOpTimeout),
+			EnableCommandStats: true,
+		},
+		PrefixRoutes: &envoy_redis.RedisProxy_PrefixRoutes{
+			CatchAllRoute: &envoy_redis.RedisProxy_PrefixRoutes_Route{
+				Cluster: c.cluster,
+			},
+		},
+	}
+
+	pbst, err := proto.MarshalAnyDeterministic(redisProxy)
+	if err != nil {
+		return err
+	}
+
+	filterChain.Filters = append(filterChain.Filters, &envoy_listener.Filter{
+		Name: envoy_wellknown.RedisProxy,
+		ConfigType: &envoy_listener.Filter_TypedConfig{
+			TypedConfig: pbst,
+		},
+	})
+	return nil
+}
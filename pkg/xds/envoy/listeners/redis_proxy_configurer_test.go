@@ -0,0 +1,49 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("RedisProxyConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		listener, err := NewListenerBuilder().
+			Configure(OutboundListener("outbound:127.0.0.1:6379", "127.0.0.1", 6379)).
+			Configure(FilterChain(NewFilterChainBuilder().
+				Configure(RedisProxy("redis", "redis")))).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+        name: outbound:127.0.0.1:6379
+        trafficDirection: OUTBOUND
+        address:
+          socketAddress:
+            address: 127.0.0.1
+            portValue: 6379
+        filterChains:
+        - filters:
+          - name: envoy.redis_proxy
+            typedConfig:
+              '@type': type.googleapis.com/envoy.config.filter.network.redis_proxy.v2.RedisProxy
+              prefixRoutes:
+                catchAllRoute:
+                  cluster: redis
+              settings:
+                enableCommandStats: true
+                opTimeout: 10s
+              statPrefix: redis
+`))
+	})
+})
@@ -0,0 +1,38 @@
+package listeners
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+
+	"github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+)
+
+func ServerSideTLS(cert []byte, key []byte) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.Add(&ServerSideTLSConfigurer{
+			cert: cert,
+			key:  key,
+		})
+	})
+}
+
+// ServerSideTLSConfigurer terminates TLS of clients outside of the mesh with a given certificate.
+type ServerSideTLSConfigurer struct {
+	cert []byte
+	key  []byte
+}
+
+func (c *ServerSideTLSConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	pbst, err := proto.MarshalAnyDeterministic(envoy.CreateStaticDownstreamTlsContext(c.cert, c.key))
+	if err != nil {
+		return err
+	}
+	filterChain.TransportSocket = &envoy_core.TransportSocket{
+		Name: "envoy.transport_sockets.tls",
+		ConfigType: &envoy_core.TransportSocket_TypedConfig{
+			TypedConfig: pbst,
+		},
+	}
+	return nil
+}
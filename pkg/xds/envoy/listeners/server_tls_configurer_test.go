@@ -0,0 +1,58 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("ServerSideTLSConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		listener, err := NewListenerBuilder().
+			Configure(InboundListener("gateway:192.168.0.1:443", "192.168.0.1", 443)).
+			Configure(TLSInspector()).
+			Configure(FilterChain(NewFilterChainBuilder().
+				Configure(FilterChainMatch("example.com")).
+				Configure(ServerSideTLS([]byte("CERT"), []byte("KEY"))))).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            name: gateway:192.168.0.1:443
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 443
+            listenerFilters:
+            - name: envoy.filters.listener.tls_inspector
+              typedConfig:
+                '@type': type.googleapis.com/google.protobuf.Empty
+                value: {}
+            filterChains:
+            - filterChainMatch:
+                serverNames:
+                - example.com
+              transportSocket:
+                name: envoy.transport_sockets.tls
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.api.v2.auth.DownstreamTlsContext
+                  commonTlsContext:
+                    tlsCertificates:
+                    - certificateChain:
+                        inlineBytes: Q0VSVA==
+                      privateKey:
+                        inlineBytes: S0VZ
+`))
+	})
+})
@@ -35,3 +35,11 @@ func GetPrometheusListenerName() string {
 func GetTracingClusterName(backendName string) string {
 	return fmt.Sprintf("tracing:%s", backendName)
 }
+
+func GetGatewayListenerName(address string, port uint32) string {
+	return fmt.Sprintf("gateway:%s:%d", address, port)
+}
+
+func GetGatewayRouteName(port uint32) string {
+	return fmt.Sprintf("gateway:%d", port)
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+)
+
+func Domains(domains ...string) VirtualHostBuilderOpt {
+	return VirtualHostBuilderOptFunc(func(config *VirtualHostBuilderConfig) {
+		config.Add(&DomainsConfigurer{
+			domains: domains,
+		})
+	})
+}
+
+// DomainsConfigurer restricts a VirtualHost to given domains.
+type DomainsConfigurer struct {
+	domains []string
+}
+
+func (c DomainsConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
+	virtualHost.Domains = c.domains
+	return nil
+}
@@ -0,0 +1,85 @@
+package routes
+
+import (
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+)
+
+// PathPrefixRoute forwards requests with a path of a given prefix and given headers to given subsets.
+func PathPrefixRoute(prefix string, headers []*envoy_route.HeaderMatcher, subsets ...envoy_common.ClusterSubset) VirtualHostBuilderOpt {
+	return VirtualHostBuilderOptFunc(func(config *VirtualHostBuilderConfig) {
+		config.Add(&PathRouteConfigurer{
+			match: &envoy_route.RouteMatch{
+				PathSpecifier: &envoy_route.RouteMatch_Prefix{
+					Prefix: prefix,
+				},
+				Headers: headers,
+			},
+			RouteConfigurer: RouteConfigurer{
+				subsets: subsets,
+			},
+		})
+	})
+}
+
+// PathExactRoute forwards requests with a given path and given headers to given subsets.
+func PathExactRoute(path string, headers []*envoy_route.HeaderMatcher, subsets ...envoy_common.ClusterSubset) VirtualHostBuilderOpt {
+	return VirtualHostBuilderOptFunc(func(config *VirtualHostBuilderConfig) {
+		config.Add(&PathRouteConfigurer{
+			match: &envoy_route.RouteMatch{
+				PathSpecifier: &envoy_route.RouteMatch_Path{
+					Path: path,
+				},
+				Headers: headers,
+			},
+			RouteConfigurer: RouteConfigurer{
+				subsets: subsets,
+			},
+		})
+	})
+}
+
+type PathRouteConfigurer struct {
+	match *envoy_route.RouteMatch
+	RouteConfigurer
+}
+
+func (c PathRouteConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
+	virtualHost.Routes = append(virtualHost.Routes, &envoy_route.Route{
+		Match: c.match,
+		Action: &envoy_route.Route_Route{
+			Route: c.routeAction(),
+		},
+	})
+	return nil
+}
+
+// ExactHeaderMatcher matches a header of a given value.
+func ExactHeaderMatcher(name, value string) *envoy_route.HeaderMatcher {
+	return &envoy_route.HeaderMatcher{
+		Name: name,
+		HeaderMatchSpecifier: &envoy_route.HeaderMatcher_ExactMatch{
+			ExactMatch: value,
+		},
+	}
+}
+
+// RegexHeaderMatcher matches a header of a value that matches a given regex.
+func RegexHeaderMatcher(name, regex string) *envoy_route.HeaderMatcher {
+	return &envoy_route.HeaderMatcher{
+		Name: name,
+		HeaderMatchSpecifier: &envoy_route.HeaderMatcher_SafeRegexMatch{
+			SafeRegexMatch: &envoy_type_matcher.RegexMatcher{
+				EngineType: &envoy_type_matcher.RegexMatcher_GoogleRe2{
+					GoogleRe2: &envoy_type_matcher.RegexMatcher_GoogleRE2{
+						MaxProgramSize: &wrappers.UInt32Value{Value: 500},
+					},
+				},
+				Regex: regex,
+			},
+		},
+	}
+}
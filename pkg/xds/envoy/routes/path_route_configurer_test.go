@@ -0,0 +1,77 @@
+package routes_test
+
+import (
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/xds/envoy/routes"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("PathRouteConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		virtualHost, err := NewVirtualHostBuilder().
+			Configure(CommonVirtualHost("example.com")).
+			Configure(Domains("example.com", "example.com:80")).
+			Configure(PathExactRoute("/api", nil, envoy_common.ClusterSubset{ClusterName: "api", Weight: 100})).
+			Configure(PathPrefixRoute("/",
+				[]*envoy_route.HeaderMatcher{
+					ExactHeaderMatcher("x-env", "staging"),
+					RegexHeaderMatcher("x-version", "v[12]"),
+				},
+				envoy_common.ClusterSubset{ClusterName: "web", Weight: 30, Tags: map[string]string{"version": "v1"}},
+				envoy_common.ClusterSubset{ClusterName: "web", Weight: 70, Tags: map[string]string{"version": "v2"}},
+			)).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(virtualHost)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            name: example.com
+            domains:
+            - example.com
+            - example.com:80
+            routes:
+            - match:
+                path: /api
+              route:
+                cluster: api
+            - match:
+                prefix: /
+                headers:
+                - name: x-env
+                  exactMatch: staging
+                - name: x-version
+                  safeRegexMatch:
+                    googleRe2:
+                      maxProgramSize: 500
+                    regex: v[12]
+              route:
+                weightedClusters:
+                  clusters:
+                  - metadataMatch:
+                      filterMetadata:
+                        envoy.lb:
+                          version: v1
+                    name: web
+                    weight: 30
+                  - metadataMatch:
+                      filterMetadata:
+                        envoy.lb:
+                          version: v2
+                    name: web
+                    weight: 70
+                  totalWeight: 100
+`))
+	})
+})
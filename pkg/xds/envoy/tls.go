@@ -35,6 +35,23 @@ func CreateDownstreamTlsContext(ctx xds_context.Context, metadata *core_xds.Data
 	}, nil
 }
 
+// CreateStaticDownstreamTlsContext creates DownstreamTlsContext for incoming connections of clients outside of the mesh.
+// It secures a listener with a given certificate and key, client certificates are not requested.
+func CreateStaticDownstreamTlsContext(cert []byte, key []byte) *envoy_auth.DownstreamTlsContext {
+	return &envoy_auth.DownstreamTlsContext{
+		CommonTlsContext: &envoy_auth.CommonTlsContext{
+			TlsCertificates: []*envoy_auth.TlsCertificate{{
+				CertificateChain: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{InlineBytes: cert},
+				},
+				PrivateKey: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{InlineBytes: key},
+				},
+			}},
+		},
+	}
+}
+
 // CreateUpstreamTlsContext creates UpstreamTlsContext for outgoing connections
 // It verifies that the upstream server has TLS certificate signed by Mesh CA with URI SAN of spiffe://{mesh_name}/{upstream_service}
 // The downstream client exposes for the upstream server cert with multiple URI SANs, which means that if DP has inbound with services "web" and "web-api" and communicates with "backend"
@@ -0,0 +1,311 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+
+	envoy_api_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	model "github.com/kumahq/kuma/pkg/core/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
+	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes"
+)
+
+// OriginGateway is a marker to indicate by which ProxyGenerator resources were generated.
+const OriginGateway = "gateway"
+
+// GatewayGenerator generates listeners and routes of a gateway Dataplane
+// out of GatewayListeners and GatewayRoutes that select it.
+//
+// Routes forward traffic to clusters generated for outbound interfaces of the gateway,
+// therefore a backend service has to be among outbounds of the gateway to be reachable.
+// A backend that consists of a service only is split by the TrafficRoute of the outbound,
+// a backend with extra tags is routed to a subset of the service as is.
+type GatewayGenerator struct {
+}
+
+func (g GatewayGenerator) Generate(_ xds_context.Context, proxy *model.Proxy) (*model.ResourceSet, error) {
+	resources := model.NewResourceSet()
+	if proxy.Gateway == nil {
+		return resources, nil
+	}
+	for _, port := range g.ports(proxy.Gateway.Listeners) {
+		listeners := g.listenersOnPort(proxy.Gateway.Listeners, port)
+		routeName := envoy_names.GetGatewayRouteName(port)
+
+		listener, err := g.generateLDS(proxy, port, routeName, listeners)
+		if err != nil {
+			return nil, err
+		}
+		resources.Add(&model.Resource{
+			Name:     listener.Name,
+			Origin:   OriginGateway,
+			Resource: listener,
+		})
+
+		route, err := g.generateRDS(proxy, port, routeName, listeners)
+		if err != nil {
+			return nil, err
+		}
+		resources.Add(&model.Resource{
+			Name:     route.Name,
+			Origin:   OriginGateway,
+			Resource: route,
+		})
+	}
+	return resources, nil
+}
+
+func (_ GatewayGenerator) ports(listeners []model.GatewayListener) []uint32 {
+	seen := map[uint32]bool{}
+	var ports []uint32
+	for _, listener := range listeners {
+		if !seen[listener.Port] {
+			seen[listener.Port] = true
+			ports = append(ports, listener.Port)
+		}
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i] < ports[j]
+	})
+	return ports
+}
+
+// listenersOnPort returns listeners of a given port.
+// A port serves a single protocol, listeners of the other protocol are ignored.
+func (_ GatewayGenerator) listenersOnPort(listeners []model.GatewayListener, port uint32) []model.GatewayListener {
+	var result []model.GatewayListener
+	for _, listener := range listeners {
+		if listener.Port != port {
+			continue
+		}
+		if len(result) > 0 && result[0].Protocol != listener.Protocol {
+			continue
+		}
+		result = append(result, listener)
+	}
+	return result
+}
+
+func (_ GatewayGenerator) generateLDS(proxy *model.Proxy, port uint32, routeName string, listeners []model.GatewayListener) (*envoy_api_v2.Listener, error) {
+	address := proxy.Dataplane.Spec.GetNetworking().GetAddress()
+	listenerName := envoy_names.GetGatewayListenerName(address, port)
+	httpFilterChainBuilder := func() *envoy_listeners.FilterChainBuilder {
+		return envoy_listeners.NewFilterChainBuilder().
+			Configure(envoy_listeners.HttpConnectionManager(routeName)).
+			Configure(envoy_listeners.Tracing(proxy.TracingBackend)).
+			Configure(envoy_listeners.HttpOutboundRoute(routeName))
+	}
+
+	listenerBuilder := envoy_listeners.NewListenerBuilder().
+		Configure(envoy_listeners.InboundListener(listenerName, address, port))
+	switch listeners[0].Protocol {
+	case mesh_proto.GatewayListener_Listener_HTTPS:
+		listenerBuilder.Configure(envoy_listeners.TLSInspector())
+		for _, listener := range listeners {
+			filterChainBuilder := httpFilterChainBuilder()
+			if !isWildcardHostname(listener.Hostname) {
+				filterChainBuilder.Configure(envoy_listeners.FilterChainMatch(listener.Hostname))
+			}
+			filterChainBuilder.Configure(envoy_listeners.ServerSideTLS(listener.Certificate, listener.Key))
+			listenerBuilder.Configure(envoy_listeners.FilterChain(filterChainBuilder))
+		}
+	default:
+		listenerBuilder.Configure(envoy_listeners.FilterChain(httpFilterChainBuilder()))
+	}
+	listener, err := listenerBuilder.
+		Configure(envoy_listeners.TransparentProxying(proxy.Dataplane.Spec.Networking.GetTransparentProxying())).
+		Build()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not generate listener %s", listenerName)
+	}
+	return listener, nil
+}
+
+// gatewayRouteEntry is a single match of a GatewayRoute together with resolved subsets.
+type gatewayRouteEntry struct {
+	path    *mesh_proto.GatewayRoute_Rule_Match_Path
+	headers []*mesh_proto.GatewayRoute_Rule_Match_Header
+	subsets []envoy_common.ClusterSubset
+}
+
+// key identifies requests matched by the entry.
+func (e gatewayRouteEntry) key() string {
+	key := e.path.GetType().String() + ":" + e.path.GetValue()
+	for _, header := range e.headers {
+		key += fmt.Sprintf(";%s:%s=%s", header.GetType(), header.GetName(), header.GetValue())
+	}
+	return key
+}
+
+func (e gatewayRouteEntry) headerMatchers() []*envoy_route.HeaderMatcher {
+	var matchers []*envoy_route.HeaderMatcher
+	for _, header := range e.headers {
+		switch header.GetType() {
+		case mesh_proto.GatewayRoute_Rule_Match_Header_REGEX:
+			matchers = append(matchers, envoy_routes.RegexHeaderMatcher(header.GetName(), header.GetValue()))
+		default:
+			matchers = append(matchers, envoy_routes.ExactHeaderMatcher(header.GetName(), header.GetValue()))
+		}
+	}
+	return matchers
+}
+
+func (g GatewayGenerator) generateRDS(proxy *model.Proxy, port uint32, routeName string, listeners []model.GatewayListener) (*envoy_api_v2.RouteConfiguration, error) {
+	wildcard := false
+	listenerHostnames := map[string]bool{}
+	for _, listener := range listeners {
+		if isWildcardHostname(listener.Hostname) {
+			wildcard = true
+			listenerHostnames[mesh_proto.MatchAllTag] = true
+		} else {
+			listenerHostnames[listener.Hostname] = true
+		}
+	}
+
+	entriesByHostname := map[string][]gatewayRouteEntry{}
+	for _, route := range proxy.Gateway.Routes {
+		var hostnames []string
+		if len(route.Spec.GetHostnames()) == 0 {
+			for hostname := range listenerHostnames {
+				hostnames = append(hostnames, hostname)
+			}
+		}
+		for _, hostname := range route.Spec.GetHostnames() {
+			// a wildcard listener accepts any hostname
+			if listenerHostnames[hostname] || wildcard {
+				hostnames = append(hostnames, hostname)
+			}
+		}
+		entries := g.routeEntries(proxy, route)
+		for _, hostname := range hostnames {
+			entriesByHostname[hostname] = append(entriesByHostname[hostname], entries...)
+		}
+	}
+
+	var hostnames []string
+	for hostname := range entriesByHostname {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+
+	routeConfigurationBuilder := envoy_routes.NewRouteConfigurationBuilder().
+		Configure(envoy_routes.CommonRouteConfiguration(routeName))
+	for _, hostname := range hostnames {
+		entries := entriesByHostname[hostname]
+		// the most specific match has to come first since Envoy picks the first matching route
+		sort.SliceStable(entries, func(i, j int) bool {
+			if entries[i].path.GetType() != entries[j].path.GetType() {
+				return entries[i].path.GetType() == mesh_proto.GatewayRoute_Rule_Match_Path_EXACT
+			}
+			if len(entries[i].path.GetValue()) != len(entries[j].path.GetValue()) {
+				return len(entries[i].path.GetValue()) > len(entries[j].path.GetValue())
+			}
+			return len(entries[i].headers) > len(entries[j].headers)
+		})
+
+		virtualHostBuilder := envoy_routes.NewVirtualHostBuilder().
+			Configure(envoy_routes.CommonVirtualHost(hostname))
+		if hostname != mesh_proto.MatchAllTag {
+			virtualHostBuilder.Configure(envoy_routes.Domains(hostname, fmt.Sprintf("%s:%d", hostname, port)))
+		}
+		seen := map[string]bool{}
+		for _, entry := range entries {
+			// when several routes have the same match, the one that comes first wins
+			if seen[entry.key()] {
+				continue
+			}
+			seen[entry.key()] = true
+			switch entry.path.GetType() {
+			case mesh_proto.GatewayRoute_Rule_Match_Path_EXACT:
+				virtualHostBuilder.Configure(envoy_routes.PathExactRoute(entry.path.GetValue(), entry.headerMatchers(), entry.subsets...))
+			default:
+				virtualHostBuilder.Configure(envoy_routes.PathPrefixRoute(entry.path.GetValue(), entry.headerMatchers(), entry.subsets...))
+			}
+		}
+		routeConfigurationBuilder.Configure(envoy_routes.VirtualHost(virtualHostBuilder))
+	}
+	return routeConfigurationBuilder.Build()
+}
+
+func (g GatewayGenerator) routeEntries(proxy *model.Proxy, route *mesh_core.GatewayRouteResource) []gatewayRouteEntry {
+	var entries []gatewayRouteEntry
+	for _, rule := range route.Spec.GetRules() {
+		subsets := g.determineSubsets(proxy, rule.GetBackends())
+		if len(subsets) == 0 {
+			// there is no backend to forward traffic to
+			continue
+		}
+		matches := rule.GetMatches()
+		if len(matches) == 0 {
+			matches = []*mesh_proto.GatewayRoute_Rule_Match{{}}
+		}
+		for _, match := range matches {
+			path := match.GetPath()
+			if path.GetValue() == "" {
+				path = &mesh_proto.GatewayRoute_Rule_Match_Path{
+					Type:  mesh_proto.GatewayRoute_Rule_Match_Path_PREFIX,
+					Value: "/",
+				}
+			}
+			entries = append(entries, gatewayRouteEntry{
+				path:    path,
+				headers: match.GetHeaders(),
+				subsets: subsets,
+			})
+		}
+	}
+	return entries
+}
+
+func (g GatewayGenerator) determineSubsets(proxy *model.Proxy, backends []*mesh_proto.GatewayRoute_Rule_Backend) []envoy_common.ClusterSubset {
+	var subsets []envoy_common.ClusterSubset
+	for _, backend := range backends {
+		if backend.GetWeight() == 0 {
+			// 0 assumes no traffic is passed there. Envoy doesn't support 0 weight, so instead of passing it to Envoy we just skip such cluster.
+			continue
+		}
+		service := backend.GetDestination()[mesh_proto.ServiceTag]
+		route := g.trafficRoute(proxy, service)
+		if route == nil || len(backend.GetDestination()) > 1 {
+			subsets = append(subsets, envoy_common.ClusterSubset{
+				ClusterName: service,
+				Weight:      backend.GetWeight(),
+				Tags:        backend.GetDestination(),
+			})
+			continue
+		}
+		for _, destination := range route.Spec.GetConf() {
+			if destination.GetWeight() == 0 {
+				continue
+			}
+			subsets = append(subsets, envoy_common.ClusterSubset{
+				ClusterName: destination.Destination[mesh_proto.ServiceTag],
+				Weight:      backend.GetWeight() * destination.GetWeight(),
+				Tags:        destination.Destination,
+			})
+		}
+	}
+	return subsets
+}
+
+// trafficRoute returns the TrafficRoute of the outbound interface of a given service.
+func (_ GatewayGenerator) trafficRoute(proxy *model.Proxy, service string) *mesh_core.TrafficRouteResource {
+	for _, outbound := range proxy.Dataplane.Spec.GetNetworking().GetOutbound() {
+		if outbound.GetTagsIncludingLegacy()[mesh_proto.ServiceTag] == service {
+			return proxy.TrafficRoutes[proxy.Dataplane.Spec.Networking.ToOutboundInterface(outbound)]
+		}
+	}
+	return nil
+}
+
+func isWildcardHostname(hostname string) bool {
+	return hostname == "" || hostname == mesh_proto.MatchAllTag
+}
@@ -0,0 +1,235 @@
+package generator_test
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+
+	envoy_api_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	resources_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	plugins_memory "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/generator"
+	"github.com/kumahq/kuma/pkg/xds/topology"
+)
+
+var _ = Describe("GatewayGenerator", func() {
+
+	It("should not generate Envoy xDS resources for a Dataplane without gateway configuration", func() {
+		// given
+		gen := generator.GatewayGenerator{}
+		proxy := &core_xds.Proxy{
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{Name: "backend-1", Mesh: "default"},
+			},
+		}
+
+		// when
+		rs, err := gen.Generate(xds_context.Context{}, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rs.List()).To(BeEmpty())
+	})
+
+	It("should generate Envoy xDS resources", func() {
+		// given
+		gen := generator.GatewayGenerator{}
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(`
+            networking:
+              address: 192.168.0.1
+              gateway:
+                tags:
+                  kuma.io/service: edge
+              outbound:
+              - port: 18080
+                tags:
+                  kuma.io/service: web
+              - port: 18081
+                tags:
+                  kuma.io/service: api
+`), &dataplane)).To(Succeed())
+
+		route := mesh_proto.GatewayRoute{}
+		Expect(util_proto.FromYAML([]byte(`
+            selectors:
+            - match:
+                kuma.io/service: edge
+            hostnames:
+            - example.com
+            rules:
+            - matches:
+              - path:
+                  value: /
+              backends:
+              - destination:
+                  kuma.io/service: web
+                weight: 100
+            - matches:
+              - path:
+                  type: EXACT
+                  value: /api/v1
+              - path:
+                  value: /api
+              backends:
+              - destination:
+                  kuma.io/service: api
+                  version: v2
+                weight: 100
+            - matches:
+              - path:
+                  value: /api
+                headers:
+                - name: x-version
+                  value: v1
+              backends:
+              - destination:
+                  kuma.io/service: api
+                  version: v1
+                weight: 100
+`), &route)).To(Succeed())
+
+		fallback := mesh_proto.GatewayRoute{}
+		Expect(util_proto.FromYAML([]byte(`
+            selectors:
+            - match:
+                kuma.io/service: edge
+            rules:
+            - backends:
+              - destination:
+                  kuma.io/service: web
+                weight: 100
+`), &fallback)).To(Succeed())
+
+		outbound := func(port uint32) mesh_proto.OutboundInterface {
+			return mesh_proto.OutboundInterface{DataplaneIP: "127.0.0.1", DataplanePort: port}
+		}
+		trafficRoute := func(conf ...*mesh_proto.TrafficRoute_WeightedDestination) *mesh_core.TrafficRouteResource {
+			return &mesh_core.TrafficRouteResource{
+				Spec: mesh_proto.TrafficRoute{Conf: conf},
+			}
+		}
+
+		proxy := &core_xds.Proxy{
+			Id: core_xds.ProxyId{Name: "edge-1", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{Name: "edge-1", Mesh: "default", Version: "1"},
+				Spec: dataplane,
+			},
+			TrafficRoutes: core_xds.RouteMap{
+				outbound(18080): trafficRoute(
+					&mesh_proto.TrafficRoute_WeightedDestination{Weight: 90, Destination: map[string]string{"kuma.io/service": "web", "version": "v1"}},
+					&mesh_proto.TrafficRoute_WeightedDestination{Weight: 10, Destination: map[string]string{"kuma.io/service": "web", "version": "v2"}},
+				),
+				outbound(18081): trafficRoute(
+					&mesh_proto.TrafficRoute_WeightedDestination{Weight: 100, Destination: map[string]string{"kuma.io/service": "api"}},
+				),
+			},
+			Gateway: &core_xds.Gateway{
+				Listeners: []core_xds.GatewayListener{
+					{Port: 80, Hostname: "*"},
+					{Port: 443, Protocol: mesh_proto.GatewayListener_Listener_HTTPS, Hostname: "example.com", Certificate: []byte("CERT"), Key: []byte("KEY")},
+				},
+				Routes: []*mesh_core.GatewayRouteResource{
+					{Meta: &test_model.ResourceMeta{Name: "example", Mesh: "default"}, Spec: route},
+					{Meta: &test_model.ResourceMeta{Name: "fallback", Mesh: "default"}, Spec: fallback},
+				},
+			},
+		}
+
+		// when
+		rs, err := gen.Generate(xds_context.Context{}, proxy)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := rs.List().ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "gateway", "01.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should generate a single filter chain for HTTPS listeners with an empty and a wildcard hostname on the same port", func() {
+		// given
+		store := plugins_memory.NewStore()
+		manager := resources_manager.NewResourceManager(store)
+		tls := func(cert string) *mesh_proto.GatewayListener_Listener_Tls {
+			return &mesh_proto.GatewayListener_Listener_Tls{
+				Certificate: &system_proto.DataSource{Type: &system_proto.DataSource_Inline{Inline: &wrappers.BytesValue{Value: []byte(cert)}}},
+				Key:         &system_proto.DataSource{Type: &system_proto.DataSource_Inline{Inline: &wrappers.BytesValue{Value: []byte("KEY")}}},
+			}
+		}
+		for name, hostname := range map[string]string{"web-1": "", "web-2": "*"} {
+			listener := &mesh_core.GatewayListenerResource{
+				Spec: mesh_proto.GatewayListener{
+					Selectors: []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": "edge"}}},
+					Listeners: []*mesh_proto.GatewayListener_Listener{
+						{Port: 443, Protocol: mesh_proto.GatewayListener_Listener_HTTPS, Hostname: hostname, Tls: tls(name)},
+					},
+				},
+			}
+			Expect(store.Create(context.Background(), listener, core_store.CreateByKey(name, "default"))).To(Succeed())
+		}
+
+		dataplane := &mesh_core.DataplaneResource{
+			Meta: &test_model.ResourceMeta{Name: "edge-1", Mesh: "default", Version: "1"},
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Gateway: &mesh_proto.Dataplane_Networking_Gateway{
+						Tags: map[string]string{"kuma.io/service": "edge"},
+					},
+				},
+			},
+		}
+		gateway, err := topology.GetGateway(context.Background(), dataplane, manager, datasource.NewDataSourceLoader(manager))
+		Expect(err).ToNot(HaveOccurred())
+
+		proxy := &core_xds.Proxy{
+			Id:        core_xds.ProxyId{Name: "edge-1", Mesh: "default"},
+			Dataplane: dataplane,
+			Gateway:   gateway,
+		}
+
+		// when
+		rs, err := generator.GatewayGenerator{}.Generate(xds_context.Context{}, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		var listeners []*envoy_api_v2.Listener
+		for _, res := range rs.List() {
+			if listener, ok := res.Resource.(*envoy_api_v2.Listener); ok {
+				listeners = append(listeners, listener)
+			}
+		}
+		Expect(listeners).To(HaveLen(1))
+		Expect(listeners[0].FilterChains).To(HaveLen(1))
+		Expect(listeners[0].FilterChains[0].FilterChainMatch).To(BeNil())
+		// and the certificate of the GatewayListener that comes first by name is used
+		Expect(gateway.Listeners).To(Equal([]core_xds.GatewayListener{
+			{Port: 443, Protocol: mesh_proto.GatewayListener_Listener_HTTPS, Hostname: "", Certificate: []byte("web-1"), Key: []byte("KEY")},
+		}))
+	})
+})
@@ -68,6 +68,20 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Pr
 					Configure(envoy_listeners.FaultInjection(proxy.FaultInjections[endpoint])).
 					Configure(envoy_listeners.Tracing(proxy.TracingBackend)).
 					Configure(envoy_listeners.HttpInboundRoute(service, envoy_common.ClusterSubset{ClusterName: localClusterName}))
+			case mesh_core.ProtocolKafka:
+				filterChainBuilder.
+					Configure(envoy_listeners.KafkaBroker(localClusterName)).
+					Configure(envoy_listeners.TcpProxy(localClusterName, envoy_common.ClusterSubset{ClusterName: localClusterName}))
+			case mesh_core.ProtocolMongo:
+				filterChainBuilder.
+					Configure(envoy_listeners.MongoProxy(localClusterName, nil)).
+					Configure(envoy_listeners.TcpProxy(localClusterName, envoy_common.ClusterSubset{ClusterName: localClusterName}))
+			case mesh_core.ProtocolMySQL:
+				filterChainBuilder.
+					Configure(envoy_listeners.MySQLProxy(localClusterName)).
+					Configure(envoy_listeners.TcpProxy(localClusterName, envoy_common.ClusterSubset{ClusterName: localClusterName}))
+			case mesh_core.ProtocolRedis:
+				filterChainBuilder.Configure(envoy_listeners.RedisProxy(localClusterName, localClusterName))
 			case mesh_core.ProtocolTCP:
 				fallthrough
 			default:
@@ -168,5 +168,9 @@ var _ = Describe("InboundProxyGenerator", func() {
 			dataplaneFile:   "4-dataplane.input.yaml",
 			envoyConfigFile: "4-envoy-config.golden.yaml",
 		}),
+		Entry("05. protocols=kafka,mongo,mysql,redis", testCase{
+			dataplaneFile:   "5-dataplane.input.yaml",
+			envoyConfigFile: "5-envoy-config.golden.yaml",
+		}),
 	)
 })
@@ -94,6 +94,10 @@ func (_ OutboundProxyGenerator) generateLDS(proxy *model.Proxy, subsets []envoy_
 	sourceService := proxy.Dataplane.Spec.GetIdentifyingService()
 	serviceName := outbound.GetTagsIncludingLegacy()[kuma_mesh.ServiceTag]
 	outboundListenerName := envoy_names.GetOutboundListenerName(oface.DataplaneIP, oface.DataplanePort)
+	// Redis proxy cannot split traffic nor match subsets, in such cases we fall back to plain TCP
+	if protocol == mesh_core.ProtocolRedis && (len(subsets) != 1 || envoy_common.LbMetadata(subsets[0].Tags) != nil) {
+		protocol = mesh_core.ProtocolTCP
+	}
 	filterChainBuilder := func() *envoy_listeners.FilterChainBuilder {
 		filterChainBuilder := envoy_listeners.NewFilterChainBuilder()
 		switch protocol {
@@ -110,6 +114,23 @@ func (_ OutboundProxyGenerator) generateLDS(proxy *model.Proxy, subsets []envoy_
 				Configure(envoy_listeners.Tracing(proxy.TracingBackend)).
 				Configure(envoy_listeners.HttpAccessLog(meshName, envoy_listeners.TrafficDirectionOutbound, sourceService, serviceName, proxy.Logs[serviceName], proxy)).
 				Configure(envoy_listeners.HttpOutboundRoute(envoy_names.GetOutboundRouteName(serviceName)))
+		case mesh_core.ProtocolKafka:
+			filterChainBuilder.
+				Configure(envoy_listeners.KafkaBroker(serviceName)).
+				Configure(envoy_listeners.TcpProxy(serviceName, subsets...)).
+				Configure(envoy_listeners.NetworkAccessLog(meshName, envoy_listeners.TrafficDirectionOutbound, sourceService, serviceName, proxy.Logs[serviceName], proxy))
+		case mesh_core.ProtocolMongo:
+			filterChainBuilder.
+				Configure(envoy_listeners.MongoProxy(serviceName, proxy.Logs[serviceName])).
+				Configure(envoy_listeners.TcpProxy(serviceName, subsets...)).
+				Configure(envoy_listeners.NetworkAccessLog(meshName, envoy_listeners.TrafficDirectionOutbound, sourceService, serviceName, proxy.Logs[serviceName], proxy))
+		case mesh_core.ProtocolMySQL:
+			filterChainBuilder.
+				Configure(envoy_listeners.MySQLProxy(serviceName)).
+				Configure(envoy_listeners.TcpProxy(serviceName, subsets...)).
+				Configure(envoy_listeners.NetworkAccessLog(meshName, envoy_listeners.TrafficDirectionOutbound, sourceService, serviceName, proxy.Logs[serviceName], proxy))
+		case mesh_core.ProtocolRedis:
+			filterChainBuilder.Configure(envoy_listeners.RedisProxy(serviceName, subsets[0].ClusterName))
 		case mesh_core.ProtocolTCP:
 			fallthrough
 		default:
@@ -120,11 +141,15 @@ func (_ OutboundProxyGenerator) generateLDS(proxy *model.Proxy, subsets []envoy_
 		}
 		return filterChainBuilder
 	}()
-	listener, err := envoy_listeners.NewListenerBuilder().
+	listenerBuilder := envoy_listeners.NewListenerBuilder().
 		Configure(envoy_listeners.OutboundListener(outboundListenerName, oface.DataplaneIP, oface.DataplanePort)).
 		Configure(envoy_listeners.FilterChain(filterChainBuilder)).
-		Configure(envoy_listeners.TransparentProxying(proxy.Dataplane.Spec.Networking.GetTransparentProxying())).
-		Build()
+		Configure(envoy_listeners.TransparentProxying(proxy.Dataplane.Spec.Networking.GetTransparentProxying()))
+	if protocol == mesh_core.ProtocolRedis {
+		// Redis proxy does not log connections, so they are logged by the listener
+		listenerBuilder.Configure(envoy_listeners.ListenerAccessLog(meshName, envoy_listeners.TrafficDirectionOutbound, sourceService, serviceName, proxy.Logs[serviceName], proxy))
+	}
+	listener, err := listenerBuilder.Build()
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not generate listener %s for service %s", outboundListenerName, serviceName)
 	}
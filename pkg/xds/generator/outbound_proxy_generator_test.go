@@ -153,6 +153,75 @@ var _ = Describe("OutboundProxyGenerator", func() {
 							}},
 						},
 					},
+					mesh_proto.OutboundInterface{
+						DataplaneIP:   "127.0.0.1",
+						DataplanePort: 40005,
+					}: &mesh_core.TrafficRouteResource{
+						Spec: mesh_proto.TrafficRoute{
+							Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+								Weight:      100,
+								Destination: mesh_proto.MatchService("kafka"),
+							}},
+						},
+					},
+					mesh_proto.OutboundInterface{
+						DataplaneIP:   "127.0.0.1",
+						DataplanePort: 40006,
+					}: &mesh_core.TrafficRouteResource{
+						Spec: mesh_proto.TrafficRoute{
+							Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+								Weight:      100,
+								Destination: mesh_proto.MatchService("mongo"),
+							}},
+						},
+					},
+					mesh_proto.OutboundInterface{
+						DataplaneIP:   "127.0.0.1",
+						DataplanePort: 40007,
+					}: &mesh_core.TrafficRouteResource{
+						Spec: mesh_proto.TrafficRoute{
+							Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+								Weight:      100,
+								Destination: mesh_proto.MatchService("mysql"),
+							}},
+						},
+					},
+					mesh_proto.OutboundInterface{
+						DataplaneIP:   "127.0.0.1",
+						DataplanePort: 40008,
+					}: &mesh_core.TrafficRouteResource{
+						Spec: mesh_proto.TrafficRoute{
+							Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+								Weight:      100,
+								Destination: mesh_proto.MatchService("redis"),
+							}},
+						},
+					},
+					mesh_proto.OutboundInterface{
+						DataplaneIP:   "127.0.0.1",
+						DataplanePort: 40009,
+					}: &mesh_core.TrafficRouteResource{
+						Spec: mesh_proto.TrafficRoute{
+							Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+								Weight:      50,
+								Destination: mesh_proto.TagSelector{"kuma.io/service": "redis-cache", "version": "v1"},
+							}, {
+								Weight:      50,
+								Destination: mesh_proto.TagSelector{"kuma.io/service": "redis-cache", "version": "v2"},
+							}},
+						},
+					},
+					mesh_proto.OutboundInterface{
+						DataplaneIP:   "127.0.0.1",
+						DataplanePort: 40010,
+					}: &mesh_core.TrafficRouteResource{
+						Spec: mesh_proto.TrafficRoute{
+							Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+								Weight:      100,
+								Destination: mesh_proto.TagSelector{"kuma.io/service": "redis-session", "role": "master"},
+							}},
+						},
+					},
 				},
 				OutboundSelectors: model.DestinationMap{
 					"api-http": model.TagSelectorSet{
@@ -175,6 +244,25 @@ var _ = Describe("OutboundProxyGenerator", func() {
 						{"kuma.io/service": "db", "role": "replica"},
 						{"kuma.io/service": "db", "role": "canary"},
 					},
+					"kafka": model.TagSelectorSet{
+						{"kuma.io/service": "kafka"},
+					},
+					"mongo": model.TagSelectorSet{
+						{"kuma.io/service": "mongo"},
+					},
+					"mysql": model.TagSelectorSet{
+						{"kuma.io/service": "mysql"},
+					},
+					"redis": model.TagSelectorSet{
+						{"kuma.io/service": "redis"},
+					},
+					"redis-cache": model.TagSelectorSet{
+						{"kuma.io/service": "redis-cache", "version": "v1"},
+						{"kuma.io/service": "redis-cache", "version": "v2"},
+					},
+					"redis-session": model.TagSelectorSet{
+						{"kuma.io/service": "redis-session", "role": "master"},
+					},
 				},
 				OutboundTargets: model.EndpointMap{
 					"api-http": []model.Endpoint{ // notice that all endpoints have tag `kuma.io/protocol: http`
@@ -242,6 +330,60 @@ var _ = Describe("OutboundProxyGenerator", func() {
 							Weight: 1,
 						},
 					},
+					"kafka": []model.Endpoint{
+						{
+							Target: "192.168.0.8",
+							Port:   9092,
+							Tags:   map[string]string{"kuma.io/service": "kafka", "kuma.io/protocol": "kafka"},
+							Weight: 1,
+						},
+					},
+					"mongo": []model.Endpoint{
+						{
+							Target: "192.168.0.9",
+							Port:   27017,
+							Tags:   map[string]string{"kuma.io/service": "mongo", "kuma.io/protocol": "mongo"},
+							Weight: 1,
+						},
+					},
+					"mysql": []model.Endpoint{
+						{
+							Target: "192.168.0.10",
+							Port:   3306,
+							Tags:   map[string]string{"kuma.io/service": "mysql", "kuma.io/protocol": "mysql"},
+							Weight: 1,
+						},
+					},
+					"redis": []model.Endpoint{
+						{
+							Target: "192.168.0.11",
+							Port:   6379,
+							Tags:   map[string]string{"kuma.io/service": "redis", "kuma.io/protocol": "redis"},
+							Weight: 1,
+						},
+					},
+					"redis-cache": []model.Endpoint{
+						{
+							Target: "192.168.0.12",
+							Port:   6379,
+							Tags:   map[string]string{"kuma.io/service": "redis-cache", "kuma.io/protocol": "redis", "version": "v1"},
+							Weight: 1,
+						},
+						{
+							Target: "192.168.0.13",
+							Port:   6379,
+							Tags:   map[string]string{"kuma.io/service": "redis-cache", "kuma.io/protocol": "redis", "version": "v2"},
+							Weight: 1,
+						},
+					},
+					"redis-session": []model.Endpoint{
+						{
+							Target: "192.168.0.14",
+							Port:   6379,
+							Tags:   map[string]string{"kuma.io/service": "redis-session", "kuma.io/protocol": "redis", "role": "master"},
+							Weight: 1,
+						},
+					},
 				},
 				Logs: model.LogMap{
 					"api-http": &mesh_proto.LoggingBackend{
@@ -258,6 +400,27 @@ var _ = Describe("OutboundProxyGenerator", func() {
 							Address: "logstash:1234",
 						}),
 					},
+					"kafka": &mesh_proto.LoggingBackend{
+						Name: "file",
+						Type: mesh_proto.LoggingFileType,
+						Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+							Path: "/var/log",
+						}),
+					},
+					"redis": &mesh_proto.LoggingBackend{
+						Name: "file",
+						Type: mesh_proto.LoggingFileType,
+						Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+							Path: "/var/log",
+						}),
+					},
+					"redis-cache": &mesh_proto.LoggingBackend{
+						Name: "file",
+						Type: mesh_proto.LoggingFileType,
+						Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+							Path: "/var/log",
+						}),
+					},
 				},
 				Metadata: &model.DataplaneMetadata{},
 				CircuitBreakers: model.CircuitBreakerMap{
@@ -354,6 +517,31 @@ var _ = Describe("OutboundProxyGenerator", func() {
 `,
 			expected: "04.envoy.golden.yaml",
 		}),
+		Entry("05. kafka, mongo, mysql and redis outbounds, redis with split or subset traffic falls back to TCP", testCase{
+			ctx: plainCtx,
+			dataplane: `
+            networking:
+              address: 10.0.0.1
+              inbound:
+              - port: 8080
+                tags:
+                  kuma.io/service: web
+              outbound:
+              - port: 40005
+                service: kafka
+              - port: 40006
+                service: mongo
+              - port: 40007
+                service: mysql
+              - port: 40008
+                service: redis
+              - port: 40009
+                service: redis-cache
+              - port: 40010
+                service: redis-session
+`,
+			expected: "05.envoy.golden.yaml",
+		}),
 	)
 
 	It("Add sanitized alternative cluster name for stats", func() {
@@ -10,11 +10,16 @@ var (
 	// protocolStack is a mapping between a protocol and its full protocol stack, e.g.
 	// HTTP has a protocol stack [HTTP, TCP],
 	// GRPC has a protocol stack [GRPC, HTTP2, TCP],
+	// KAFKA has a protocol stack [KAFKA, TCP],
 	// TCP  has a protocol stack [TCP].
 	protocolStacks = map[mesh_core.Protocol]mesh_core.ProtocolList{
 		mesh_core.ProtocolGRPC:  {mesh_core.ProtocolGRPC, mesh_core.ProtocolHTTP2, mesh_core.ProtocolTCP},
 		mesh_core.ProtocolHTTP2: {mesh_core.ProtocolHTTP2, mesh_core.ProtocolTCP},
 		mesh_core.ProtocolHTTP:  {mesh_core.ProtocolHTTP, mesh_core.ProtocolTCP},
+		mesh_core.ProtocolKafka: {mesh_core.ProtocolKafka, mesh_core.ProtocolTCP},
+		mesh_core.ProtocolMongo: {mesh_core.ProtocolMongo, mesh_core.ProtocolTCP},
+		mesh_core.ProtocolMySQL: {mesh_core.ProtocolMySQL, mesh_core.ProtocolTCP},
+		mesh_core.ProtocolRedis: {mesh_core.ProtocolRedis, mesh_core.ProtocolTCP},
 		mesh_core.ProtocolTCP:   {mesh_core.ProtocolTCP},
 	}
 )
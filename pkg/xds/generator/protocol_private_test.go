@@ -102,5 +102,25 @@ var _ = Describe("getCommonProtocol()", func() {
 			another:  mesh_core.ProtocolTCP,
 			expected: mesh_core.ProtocolTCP,
 		}),
+		Entry("`kafka` and `kafka`", testCase{
+			one:      mesh_core.ProtocolKafka,
+			another:  mesh_core.ProtocolKafka,
+			expected: mesh_core.ProtocolKafka,
+		}),
+		Entry("`kafka` and `tcp`", testCase{
+			one:      mesh_core.ProtocolKafka,
+			another:  mesh_core.ProtocolTCP,
+			expected: mesh_core.ProtocolTCP,
+		}),
+		Entry("`mongo` and `redis`", testCase{
+			one:      mesh_core.ProtocolMongo,
+			another:  mesh_core.ProtocolRedis,
+			expected: mesh_core.ProtocolTCP,
+		}),
+		Entry("`mysql` and `http`", testCase{
+			one:      mesh_core.ProtocolMySQL,
+			another:  mesh_core.ProtocolHTTP,
+			expected: mesh_core.ProtocolTCP,
+		}),
 	)
 })
@@ -75,6 +75,7 @@ func NewDefaultProxyProfile() ResourceGenerator {
 		DirectAccessProxyGenerator{},
 		TracingProxyGenerator{},
 		ProbeProxyGenerator{},
+		GatewayGenerator{},
 	}
 }
 
@@ -7,17 +7,14 @@ import (
 	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server/v2"
 	"github.com/prometheus/client_golang/prometheus"
 
-	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
-	"github.com/kumahq/kuma/pkg/core/faultinjections"
-	"github.com/kumahq/kuma/pkg/core/logs"
-	"github.com/kumahq/kuma/pkg/core/permissions"
 	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/core/xds"
 	"github.com/kumahq/kuma/pkg/metrics"
+	sds_server "github.com/kumahq/kuma/pkg/sds/server"
 	util_watchdog "github.com/kumahq/kuma/pkg/util/watchdog"
 	util_xds "github.com/kumahq/kuma/pkg/util/xds"
 	xds_bootstrap "github.com/kumahq/kuma/pkg/xds/bootstrap"
@@ -59,6 +56,12 @@ func SetupServer(rt core_runtime.Runtime) error {
 		metadataTracker,
 		lifecycle,
 		statusTracker,
+		rt.XDS().ConfigTracker(),
+	}
+
+	authenticator, err := sds_server.DefaultAuthenticator(rt)
+	if err != nil {
+		return err
 	}
 
 	srv := NewServer(rt.XDS().Cache(), callbacks)
@@ -76,6 +79,12 @@ func SetupServer(rt core_runtime.Runtime) error {
 			Config:    rt.Config().BootstrapServer,
 			Generator: xds_bootstrap.NewDefaultBootstrapGenerator(rt.ResourceManager(), rt.Config().BootstrapServer.Params, rt.Config().XdsServer.TlsCertFile),
 			Metrics:   rt.Metrics(),
+
+			ResManager:               rt.ResourceManager(),
+			Authenticator:            authenticator,
+			LearnedDestinationsTTL:   rt.Config().Runtime.Kubernetes.LearnedDestinations.TTL,
+			LearnedDestinationsLimit: rt.Config().Runtime.Kubernetes.LearnedDestinations.Limit,
+			StatsReportInterval:      rt.Config().Metrics.ServiceGraph.ReportInterval,
 		},
 	)
 }
@@ -104,9 +113,6 @@ func DefaultIngressReconciler(rt core_runtime.Runtime) SnapshotReconciler {
 }
 
 func DefaultDataplaneSyncTracker(rt core_runtime.Runtime, reconciler, ingressReconciler SnapshotReconciler, metadataTracker *DataplaneMetadataTracker) (envoy_xds.Callbacks, error) {
-	permissionsMatcher := permissions.TrafficPermissionsMatcher{ResourceManager: rt.ReadOnlyResourceManager()}
-	logsMatcher := logs.TrafficLogsMatcher{ResourceManager: rt.ReadOnlyResourceManager()}
-	faultInjectionMatcher := faultinjections.FaultInjectionMatcher{ResourceManager: rt.ReadOnlyResourceManager()}
 	envoyCpCtx, err := xds_context.BuildControlPlaneContext(rt.Config())
 	if err != nil {
 		return nil, err
@@ -163,8 +169,12 @@ func DefaultDataplaneSyncTracker(rt core_runtime.Runtime, reconciler, ingressRec
 					if err := ingress.UpdateAvailableServices(ctx, rt.ResourceManager(), dataplane, allMeshDataplanes.Items); err != nil {
 						return err
 					}
+					endpointDataplanes, err := xds_topology.FilterDraining(ctx, rt.ReadOnlyResourceManager(), allMeshDataplanes)
+					if err != nil {
+						return err
+					}
 					destinations := ingress.BuildDestinationMap(dataplane)
-					endpoints := ingress.BuildEndpointMap(destinations, allMeshDataplanes.Items)
+					endpoints := ingress.BuildEndpointMap(destinations, endpointDataplanes.Items)
 					proxy := xds.Proxy{
 						Id:              proxyID,
 						Dataplane:       dataplane,
@@ -177,95 +187,11 @@ func DefaultDataplaneSyncTracker(rt core_runtime.Runtime, reconciler, ingressRec
 					return ingressReconciler.Reconcile(envoyCtx, &proxy)
 				}
 
-				mesh := &mesh_core.MeshResource{}
-				if err := rt.ReadOnlyResourceManager().Get(ctx, mesh, core_store.GetByKey(proxyID.Mesh, proxyID.Mesh)); err != nil {
-					return err
-				}
-				dataplanes, err := xds_topology.GetDataplanes(log, ctx, rt.ReadOnlyResourceManager(), rt.LookupIP(), dataplane.Meta.GetMesh())
-				if err != nil {
-					return err
-				}
-				envoyCtx := xds_context.Context{
-					ControlPlane: envoyCpCtx,
-					Mesh: xds_context.MeshContext{
-						Resource:   mesh,
-						Dataplanes: dataplanes,
-					},
-				}
-
-				// Generate VIP outbounds only when not Ingress and Transparent Proxying is enabled
-				if !dataplane.Spec.IsIngress() && dataplane.Spec.Networking.GetTransparentProxying() != nil {
-					err = xds_topology.PatchDataplaneWithVIPOutbounds(dataplane, dataplanes, rt.DNSResolver())
-					if err != nil {
-						return err
-					}
-				}
-
-				// pick a single the most specific route for each outbound interface
-				routes, err := xds_topology.GetRoutes(ctx, dataplane, rt.ReadOnlyResourceManager())
-				if err != nil {
-					return err
-				}
-
-				// create creates a map of selectors to match other dataplanes reachable via given routes
-				destinations := xds_topology.BuildDestinationMap(dataplane, routes)
-
-				// resolve all endpoints that match given selectors
-				outbound, err := xds_topology.GetOutboundTargets(destinations, dataplanes, rt.Config().Multicluster.Remote.Zone, mesh)
-				if err != nil {
-					return err
-				}
-
-				healthChecks, err := xds_topology.GetHealthChecks(ctx, dataplane, destinations, rt.ReadOnlyResourceManager())
-				if err != nil {
-					return err
-				}
-
-				circuitBreakers, err := xds_topology.GetCircuitBreakers(ctx, dataplane, destinations, rt.ReadOnlyResourceManager())
-				if err != nil {
-					return err
-				}
-
-				trafficTrace, err := xds_topology.GetTrafficTrace(ctx, dataplane, rt.ReadOnlyResourceManager())
-				if err != nil {
-					return err
-				}
-				var tracingBackend *mesh_proto.TracingBackend
-				if trafficTrace != nil {
-					tracingBackend = mesh.GetTracingBackend(trafficTrace.Spec.GetConf().GetBackend())
-				}
-
-				matchedPermissions, err := permissionsMatcher.Match(ctx, dataplane, mesh)
-				if err != nil {
-					return err
-				}
-
-				matchedLogs, err := logsMatcher.Match(ctx, dataplane)
+				proxy, envoyCtx, err := BuildDataplaneProxy(ctx, rt, rt.ReadOnlyResourceManager(), envoyCpCtx, dataplane, metadataTracker.Metadata(streamId))
 				if err != nil {
 					return err
 				}
-
-				faultInjection, err := faultInjectionMatcher.Match(ctx, dataplane, mesh)
-				if err != nil {
-					return err
-				}
-
-				proxy := xds.Proxy{
-					Id:                 proxyID,
-					Dataplane:          dataplane,
-					TrafficPermissions: matchedPermissions,
-					TrafficRoutes:      routes,
-					OutboundSelectors:  destinations,
-					OutboundTargets:    outbound,
-					HealthChecks:       healthChecks,
-					CircuitBreakers:    circuitBreakers,
-					Logs:               matchedLogs,
-					TrafficTrace:       trafficTrace,
-					TracingBackend:     tracingBackend,
-					Metadata:           metadataTracker.Metadata(streamId),
-					FaultInjections:    faultInjection,
-				}
-				return reconciler.Reconcile(envoyCtx, &proxy)
+				return reconciler.Reconcile(envoyCtx, proxy)
 			},
 			OnError: func(err error) {
 				xdsGenerationsErrors.Inc()
@@ -2,10 +2,12 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server/v2"
 	"github.com/golang/protobuf/proto"
 
@@ -120,6 +122,7 @@ func (c *dataplaneStatusTracker) OnStreamRequest(streamID int64, req *envoy.Disc
 	if state.dataplaneId == (core_model.ResourceKey{}) {
 		if id, err := core_xds.ParseProxyId(req.Node); err == nil {
 			state.dataplaneId = core_model.ResourceKey{Mesh: id.Mesh, Name: id.Name}
+			state.subscription.Version = readVersion(req.Node)
 			// kick off async Dataplane status flusher
 			go c.createStatusSink(state).Start(state.stop)
 		} else {
@@ -172,6 +175,22 @@ func (c *dataplaneStatusTracker) OnFetchRequest(context.Context, *envoy.Discover
 // OnFetchResponse is called immediately prior to sending a response.
 func (c *dataplaneStatusTracker) OnFetchResponse(*envoy.DiscoveryRequest, *envoy.DiscoveryResponse) {}
 
+// readVersion returns version of Kuma DP and Envoy that sent a given Node.
+func readVersion(node *envoy_core.Node) *mesh_proto.Version {
+	version := core_xds.DataplaneMetadataFromNode(node).GetVersion()
+	if buildVersion := node.GetUserAgentBuildVersion(); buildVersion != nil {
+		if version == nil {
+			version = &mesh_proto.Version{}
+		}
+		semver := buildVersion.GetVersion()
+		version.Envoy = &mesh_proto.EnvoyVersion{
+			Version: fmt.Sprintf("%d.%d.%d", semver.GetMajorNumber(), semver.GetMinorNumber(), semver.GetPatch()),
+			Build:   buildVersion.GetMetadata().GetFields()["revision.sha"].GetStringValue(),
+		}
+	}
+	return version
+}
+
 func (c *dataplaneStatusTracker) GetStatusAccessor(streamID int64) (SubscriptionStatusAccessor, bool) {
 	state, ok := c.streams[streamID]
 	return state, ok
@@ -15,6 +15,8 @@ import (
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type"
+	pstruct "github.com/golang/protobuf/ptypes/struct"
 
 	test_runtime "github.com/kumahq/kuma/pkg/test/runtime"
 )
@@ -156,6 +158,78 @@ var _ = Describe("DataplaneStatusTracker", func() {
 `))
 	})
 
+	It("should record version of Kuma DP and Envoy", func() {
+		// given
+		streamID := int64(1)
+
+		By("simulating start of ADS subscription")
+		// when
+		err := tracker.OnStreamOpen(ctx, streamID, "")
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		accessor, _ := tracker.GetStatusAccessor(streamID)
+		// then
+		Expect(accessor).ToNot(BeNil())
+
+		By("simulating initial LDS request")
+		// when
+		metadata := &pstruct.Struct{}
+		err = util_proto.FromYAML([]byte(`
+        version:
+          kumaDp:
+            version: 0.8.0
+            gitTag: v0.8.0
+            gitCommit: 91ce236824a9d875601679aa80c63783fb0e8725
+            buildDate: "2020-08-25T13:55:21Z"
+`), metadata)
+		Expect(err).ToNot(HaveOccurred())
+		discoveryRequest := &envoy.DiscoveryRequest{
+			Node: &envoy_core.Node{
+				Id:       "default.example-001",
+				Metadata: metadata,
+				UserAgentVersionType: &envoy_core.Node_UserAgentBuildVersion{
+					UserAgentBuildVersion: &envoy_core.BuildVersion{
+						Version: &envoy_type.SemanticVersion{
+							MajorNumber: 1,
+							MinorNumber: 15,
+							Patch:       0,
+						},
+						Metadata: &pstruct.Struct{
+							Fields: map[string]*pstruct.Value{
+								"revision.sha": {
+									Kind: &pstruct.Value_StringValue{
+										StringValue: "5d8fe6d0b1e7bd3cbd1ad1a8b5b2c8e2d4fb9d14",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			TypeUrl: "type.googleapis.com/envoy.api.v2.Listener",
+		}
+		err = tracker.OnStreamRequest(streamID, discoveryRequest)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		By("ensuring that version is recorded")
+		// when
+		_, subscription := accessor.GetStatus()
+		// then
+		Expect(util_proto.ToYAML(subscription.Version)).To(MatchYAML(`
+        envoy:
+          build: 5d8fe6d0b1e7bd3cbd1ad1a8b5b2c8e2d4fb9d14
+          version: 1.15.0
+        kumaDp:
+          buildDate: "2020-08-25T13:55:21Z"
+          gitCommit: 91ce236824a9d875601679aa80c63783fb0e8725
+          gitTag: v0.8.0
+          version: 0.8.0
+`))
+	})
+
 	type testCase struct {
 		TypeUrl                    string
 		ExpectedStatsAfterResponse string
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/faultinjections"
+	"github.com/kumahq/kuma/pkg/core/logs"
+	"github.com/kumahq/kuma/pkg/core/permissions"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/core/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	xds_topology "github.com/kumahq/kuma/pkg/xds/topology"
+)
+
+// BuildDataplaneProxy builds a Proxy of a Dataplane (other than Ingress) from resources of its mesh.
+// Address of the Dataplane has to be resolved beforehand.
+func BuildDataplaneProxy(
+	ctx context.Context,
+	rt core_runtime.Runtime,
+	resManager core_manager.ReadOnlyResourceManager,
+	envoyCpCtx *xds_context.ControlPlaneContext,
+	dataplane *mesh_core.DataplaneResource,
+	metadata *xds.DataplaneMetadata,
+) (*xds.Proxy, xds_context.Context, error) {
+	log := xdsServerLog.WithName("proxy-builder").WithValues("dataplane", dataplane.GetMeta().GetName())
+	proxyID := xds.FromResourceKey(core_model.MetaToResourceKey(dataplane.GetMeta()))
+	mesh := &mesh_core.MeshResource{}
+	if err := resManager.Get(ctx, mesh, core_store.GetByKey(proxyID.Mesh, proxyID.Mesh)); err != nil {
+		return nil, xds_context.Context{}, err
+	}
+	dataplanes, err := xds_topology.GetDataplanes(log, ctx, resManager, rt.LookupIP(), dataplane.Meta.GetMesh())
+	if err != nil {
+		return nil, xds_context.Context{}, err
+	}
+	envoyCtx := xds_context.Context{
+		ControlPlane: envoyCpCtx,
+		Mesh: xds_context.MeshContext{
+			Resource:   mesh,
+			Dataplanes: dataplanes,
+		},
+	}
+
+	// Generate VIP outbounds only when not Ingress and Transparent Proxying is enabled
+	if !dataplane.Spec.IsIngress() && dataplane.Spec.Networking.GetTransparentProxying() != nil {
+		err = xds_topology.PatchDataplaneWithVIPOutbounds(dataplane, dataplanes, rt.DNSResolver())
+		if err != nil {
+			return nil, xds_context.Context{}, err
+		}
+	}
+
+	// pick a single the most specific route for each outbound interface
+	routes, err := xds_topology.GetRoutes(ctx, dataplane, resManager)
+	if err != nil {
+		return nil, xds_context.Context{}, err
+	}
+
+	// create creates a map of selectors to match other dataplanes reachable via given routes
+	destinations := xds_topology.BuildDestinationMap(dataplane, routes)
+
+	// draining dataplanes are excluded, so they stop receiving new requests before shutdown
+	endpointDataplanes, err := xds_topology.FilterDraining(ctx, resManager, dataplanes)
+	if err != nil {
+		return nil, xds_context.Context{}, err
+	}
+
+	// resolve all endpoints that match given selectors
+	outbound, err := xds_topology.GetOutboundTargets(destinations, endpointDataplanes, rt.Config().Multicluster.Remote.Zone, mesh)
+	if err != nil {
+		return nil, xds_context.Context{}, err
+	}
+
+	healthChecks, err := xds_topology.GetHealthChecks(ctx, dataplane, destinations, resManager)
+	if err != nil {
+		return nil, xds_context.Context{}, err
+	}
+
+	circuitBreakers, err := xds_topology.GetCircuitBreakers(ctx, dataplane, destinations, resManager)
+	if err != nil {
+		return nil, xds_context.Context{}, err
+	}
+
+	trafficTrace, err := xds_topology.GetTrafficTrace(ctx, dataplane, resManager)
+	if err != nil {
+		return nil, xds_context.Context{}, err
+	}
+	var tracingBackend *mesh_proto.TracingBackend
+	if trafficTrace != nil {
+		tracingBackend = mesh.GetTracingBackend(trafficTrace.Spec.GetConf().GetBackend())
+	}
+
+	permissionsMatcher := permissions.TrafficPermissionsMatcher{ResourceManager: resManager}
+	matchedPermissions, err := permissionsMatcher.Match(ctx, dataplane, mesh)
+	if err != nil {
+		return nil, xds_context.Context{}, err
+	}
+
+	logsMatcher := logs.TrafficLogsMatcher{ResourceManager: resManager}
+	matchedLogs, err := logsMatcher.Match(ctx, dataplane)
+	if err != nil {
+		return nil, xds_context.Context{}, err
+	}
+
+	faultInjectionMatcher := faultinjections.FaultInjectionMatcher{ResourceManager: resManager}
+	faultInjection, err := faultInjectionMatcher.Match(ctx, dataplane, mesh)
+	if err != nil {
+		return nil, xds_context.Context{}, err
+	}
+
+	gateway, err := xds_topology.GetGateway(ctx, dataplane, resManager, rt.DataSourceLoader())
+	if err != nil {
+		return nil, xds_context.Context{}, err
+	}
+
+	proxy := &xds.Proxy{
+		Id:                 proxyID,
+		Dataplane:          dataplane,
+		TrafficPermissions: matchedPermissions,
+		TrafficRoutes:      routes,
+		OutboundSelectors:  destinations,
+		OutboundTargets:    outbound,
+		HealthChecks:       healthChecks,
+		CircuitBreakers:    circuitBreakers,
+		Logs:               matchedLogs,
+		TrafficTrace:       trafficTrace,
+		TracingBackend:     tracingBackend,
+		Metadata:           metadata,
+		FaultInjections:    faultInjection,
+		Gateway:            gateway,
+	}
+	return proxy, envoyCtx, nil
+}
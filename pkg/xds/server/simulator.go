@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/core/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	xds_template "github.com/kumahq/kuma/pkg/xds/template"
+	xds_topology "github.com/kumahq/kuma/pkg/xds/topology"
+)
+
+// Simulator generates Envoy configuration of a Dataplane as if given resources were applied, without persisting them.
+type Simulator interface {
+	Simulate(ctx context.Context, dataplane *mesh_core.DataplaneResource, resources []core_model.Resource) (envoy_cache.Snapshot, error)
+}
+
+func NewSimulator(rt core_runtime.Runtime) (Simulator, error) {
+	envoyCpCtx, err := xds_context.BuildControlPlaneContext(rt.Config())
+	if err != nil {
+		return nil, err
+	}
+	return &simulator{
+		rt:         rt,
+		envoyCpCtx: envoyCpCtx,
+	}, nil
+}
+
+type simulator struct {
+	rt         core_runtime.Runtime
+	envoyCpCtx *xds_context.ControlPlaneContext
+}
+
+var _ Simulator = &simulator{}
+
+func (s *simulator) Simulate(ctx context.Context, dataplane *mesh_core.DataplaneResource, resources []core_model.Resource) (envoy_cache.Snapshot, error) {
+	if dataplane.Spec.IsIngress() {
+		return envoy_cache.Snapshot{}, errors.New("simulation of an Ingress is not supported")
+	}
+	// the Dataplane is modified when the Proxy is built, so the overlay gets a copy
+	overlay := &mesh_core.DataplaneResource{
+		Meta: dataplane.GetMeta(),
+		Spec: *proto.Clone(&dataplane.Spec).(*mesh_proto.Dataplane),
+	}
+	resManager := core_manager.NewOverlayManager(s.rt.ReadOnlyResourceManager(), append(resources, overlay))
+
+	if err := xds_topology.ResolveAddress(s.rt.LookupIP(), dataplane); err != nil {
+		return envoy_cache.Snapshot{}, err
+	}
+	proxyId := xds.FromResourceKey(core_model.MetaToResourceKey(dataplane.GetMeta()))
+	// a hypothetical Dataplane has no metadata, e.g. the admin port, so the Prometheus endpoint is not generated
+	metadata := s.rt.XDS().ConfigTracker().Metadata(proxyId)
+	proxy, envoyCtx, err := BuildDataplaneProxy(ctx, s.rt, resManager, s.envoyCpCtx, dataplane, metadata)
+	if err != nil {
+		return envoy_cache.Snapshot{}, err
+	}
+	generator := &templateSnapshotGenerator{
+		ProxyTemplateResolver: &simpleProxyTemplateResolver{
+			ReadOnlyResourceManager: resManager,
+			DefaultProxyTemplate:    xds_template.DefaultProxyTemplate,
+		},
+	}
+	return generator.GenerateSnapshot(envoyCtx, proxy)
+}
@@ -0,0 +1,135 @@
+package server_test
+
+import (
+	"context"
+
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	test_runtime "github.com/kumahq/kuma/pkg/test/runtime"
+	. "github.com/kumahq/kuma/pkg/xds/server"
+)
+
+var _ = Describe("Simulator", func() {
+
+	var runtime core_runtime.Runtime
+	var simulator Simulator
+
+	dataplane := func(address string, inbound string, outbound string) *mesh_core.DataplaneResource {
+		dp := &mesh_core.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: address,
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{Port: 8080, ServicePort: 18080, Tags: map[string]string{mesh_proto.ServiceTag: inbound}},
+					},
+				},
+			},
+		}
+		if outbound != "" {
+			dp.Spec.Networking.Outbound = []*mesh_proto.Dataplane_Networking_Outbound{
+				{Port: 10001, Service: outbound},
+			}
+		}
+		return dp
+	}
+
+	BeforeEach(func() {
+		rt, err := test_runtime.BuilderFor(kuma_cp.DefaultConfig()).Build()
+		Expect(err).ToNot(HaveOccurred())
+		runtime = rt
+		simulator, err = NewSimulator(runtime)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx := context.Background()
+		err = runtime.ResourceManager().Create(ctx, &mesh_core.MeshResource{}, core_store.CreateByKey("demo", "demo"))
+		Expect(err).ToNot(HaveOccurred())
+		dataplanes := map[string]*mesh_core.DataplaneResource{
+			"web-01":        dataplane("192.168.0.1", "web", "backend"),
+			"backend-01":    dataplane("192.168.0.2", "backend", ""),
+			"backend-v2-01": dataplane("192.168.0.3", "backend-v2", ""),
+		}
+		for name, dp := range dataplanes {
+			err := runtime.ResourceManager().Create(ctx, dp, core_store.CreateByKey(name, "demo"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	It("should generate configuration with candidate policies without persisting them", func() {
+		// given
+		ctx := context.Background()
+		dp := &mesh_core.DataplaneResource{}
+		Expect(runtime.ReadOnlyResourceManager().Get(ctx, dp, core_store.GetByKey("web-01", "demo"))).To(Succeed())
+		route := &mesh_core.TrafficRouteResource{
+			Meta: &rest.ResourceMeta{Type: string(mesh_core.TrafficRouteType), Mesh: "demo", Name: "web-to-backend-v2"},
+			Spec: mesh_proto.TrafficRoute{
+				Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchService("web")}},
+				Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("backend")}},
+				Conf: []*mesh_proto.TrafficRoute_WeightedDestination{
+					{Weight: 100, Destination: mesh_proto.MatchService("backend-v2")},
+				},
+			},
+		}
+
+		// when
+		before, err := simulator.Simulate(ctx, dp, nil)
+		Expect(err).ToNot(HaveOccurred())
+		after, err := simulator.Simulate(ctx, dp, []core_model.Resource{route})
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(before.Resources[envoy_types.Cluster].Items).To(HaveKey("backend"))
+		Expect(before.Resources[envoy_types.Cluster].Items).ToNot(HaveKey("backend-v2"))
+		Expect(after.Resources[envoy_types.Cluster].Items).To(HaveKey("backend-v2"))
+		Expect(after.Resources[envoy_types.Cluster].Items).ToNot(HaveKey("backend"))
+
+		// and
+		routes := &mesh_core.TrafficRouteResourceList{}
+		Expect(runtime.ReadOnlyResourceManager().List(ctx, routes, core_store.ListByMesh("demo"))).To(Succeed())
+		for _, r := range routes.Items {
+			Expect(r.Meta.GetName()).ToNot(Equal("web-to-backend-v2"))
+		}
+	})
+
+	It("should generate configuration of a hypothetical dataplane", func() {
+		// given
+		dp := dataplane("192.168.0.4", "web", "backend")
+		dp.Meta = &rest.ResourceMeta{Type: string(mesh_core.DataplaneType), Mesh: "demo", Name: "web-02"}
+
+		// when
+		snapshot, err := simulator.Simulate(context.Background(), dp, nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(snapshot.Resources[envoy_types.Listener].Items).To(HaveKey("inbound:192.168.0.4:8080"))
+		Expect(snapshot.Resources[envoy_types.Cluster].Items).To(HaveKey("backend"))
+	})
+
+	It("should not simulate an Ingress", func() {
+		// given
+		dp := &mesh_core.DataplaneResource{
+			Meta: &rest.ResourceMeta{Type: string(mesh_core.DataplaneType), Mesh: "demo", Name: "ingress"},
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Ingress: &mesh_proto.Dataplane_Networking_Ingress{},
+					Address: "192.168.0.5",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{Port: 10001}},
+				},
+			},
+		}
+
+		// when
+		_, err := simulator.Simulate(context.Background(), dp, nil)
+
+		// then
+		Expect(err).To(MatchError("simulation of an Ingress is not supported"))
+	})
+})
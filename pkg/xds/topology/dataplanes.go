@@ -7,6 +7,8 @@ import (
 
 	"github.com/kumahq/kuma/pkg/core/dns/lookup"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
 
 	"github.com/pkg/errors"
 
@@ -56,3 +58,34 @@ func ResolveAddresses(log logr.Logger, lookupIPFunc lookup.LookupIPFunc, datapla
 	}
 	return rv
 }
+
+// FilterDraining removes Dataplanes that are draining before shutdown, so other Dataplanes stop sending requests to them.
+// Insights are fetched only from meshes of the given Dataplanes.
+func FilterDraining(ctx context.Context, rm manager.ReadOnlyResourceManager, dataplanes *core_mesh.DataplaneResourceList) (*core_mesh.DataplaneResourceList, error) {
+	meshes := map[string]bool{}
+	for _, d := range dataplanes.Items {
+		meshes[d.GetMeta().GetMesh()] = true
+	}
+	draining := map[model.ResourceKey]bool{}
+	for mesh := range meshes {
+		insights := &core_mesh.DataplaneInsightResourceList{}
+		if err := rm.List(ctx, insights, store.ListByMesh(mesh)); err != nil {
+			return nil, err
+		}
+		for _, insight := range insights.Items {
+			if insight.Spec.IsDraining() {
+				draining[model.MetaToResourceKey(insight.GetMeta())] = true
+			}
+		}
+	}
+	if len(draining) == 0 {
+		return dataplanes, nil
+	}
+	rv := &core_mesh.DataplaneResourceList{}
+	for _, d := range dataplanes.Items {
+		if !draining[model.MetaToResourceKey(d.GetMeta())] {
+			_ = rv.AddItem(d)
+		}
+	}
+	return rv, nil
+}
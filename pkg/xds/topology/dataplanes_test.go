@@ -1,7 +1,9 @@
 package topology
 
 import (
+	"context"
 	"net"
+	"time"
 
 	"github.com/kumahq/kuma/pkg/core"
 
@@ -11,6 +13,11 @@ import (
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
 )
 
 var _ = Describe("Resolve Dataplane address", func() {
@@ -71,3 +78,50 @@ var _ = Describe("Resolve Dataplane address", func() {
 		})
 	})
 })
+
+type meshRecordingManager struct {
+	manager.ReadOnlyResourceManager
+	meshes []string
+}
+
+func (m *meshRecordingManager) List(ctx context.Context, list model.ResourceList, fs ...store.ListOptionsFunc) error {
+	m.meshes = append(m.meshes, store.NewListOptions(fs...).Mesh)
+	return m.ReadOnlyResourceManager.List(ctx, list, fs...)
+}
+
+var _ = Describe("FilterDraining", func() {
+
+	It("should exclude draining dataplanes", func() {
+		// setup
+		resManager := manager.NewResourceManager(memory.NewStore())
+		err := resManager.Create(context.Background(), &mesh.MeshResource{}, store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		dataplanes := &mesh.DataplaneResourceList{}
+		for _, name := range []string{"web-01", "web-02"} {
+			dataplane := &mesh.DataplaneResource{
+				Meta: &test_model.ResourceMeta{Mesh: "default", Name: name},
+				Spec: mesh_proto.Dataplane{Networking: &mesh_proto.Dataplane_Networking{Address: "192.168.0.1"}},
+			}
+			Expect(dataplanes.AddItem(dataplane)).To(Succeed())
+		}
+
+		// given web-01 is draining
+		insight := &mesh.DataplaneInsightResource{}
+		Expect(insight.Spec.Drain(time.Now())).To(Succeed())
+		err = resManager.Create(context.Background(), insight, store.CreateByKey("web-01", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		// and web-02 is connected
+		err = resManager.Create(context.Background(), &mesh.DataplaneInsightResource{}, store.CreateByKey("web-02", "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		lister := &meshRecordingManager{ReadOnlyResourceManager: resManager}
+		actual, err := FilterDraining(context.Background(), lister, dataplanes)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lister.meshes).To(Equal([]string{"default"}))
+		Expect(actual.Items).To(HaveLen(1))
+		Expect(actual.Items[0].GetMeta().GetName()).To(Equal("web-02"))
+	})
+})
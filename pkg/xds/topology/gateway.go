@@ -0,0 +1,101 @@
+package topology
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+)
+
+// GetGateway resolves listeners and routes of a gateway Dataplane.
+// Unlike other policies, all GatewayListeners and GatewayRoutes that select the Dataplane apply to it.
+// When several GatewayListeners define the same port and hostname, the one that comes first by name wins.
+// It returns nil for a Dataplane that is not a gateway or has no listeners.
+func GetGateway(ctx context.Context, dataplane *mesh_core.DataplaneResource, manager core_manager.ReadOnlyResourceManager, loader datasource.Loader) (*core_xds.Gateway, error) {
+	if dataplane.Spec.GetNetworking().GetGateway() == nil {
+		return nil, nil
+	}
+	mesh := dataplane.GetMeta().GetMesh()
+
+	listeners := mesh_core.GatewayListenerResourceList{}
+	if err := manager.List(ctx, &listeners, store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	sort.Slice(listeners.Items, func(i, j int) bool {
+		return listeners.Items[i].GetMeta().GetName() < listeners.Items[j].GetMeta().GetName()
+	})
+
+	gateway := &core_xds.Gateway{}
+	type listenerKey struct {
+		port     uint32
+		hostname string
+	}
+	seen := map[listenerKey]bool{}
+	for _, resource := range listeners.Items {
+		if !selectsDataplane(dataplane, resource.Selectors()) {
+			continue
+		}
+		for _, listener := range resource.Spec.GetListeners() {
+			key := listenerKey{port: listener.GetPort(), hostname: listener.GetHostname()}
+			// an empty hostname matches any hostname, just like "*", see GatewayListenerResource.Validate()
+			if key.hostname == "" {
+				key.hostname = mesh_proto.MatchAllTag
+			}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			gatewayListener := core_xds.GatewayListener{
+				Port:     listener.GetPort(),
+				Protocol: listener.GetProtocol(),
+				Hostname: listener.GetHostname(),
+			}
+			if listener.GetProtocol() == mesh_proto.GatewayListener_Listener_HTTPS {
+				cert, err := loader.Load(ctx, mesh, listener.GetTls().GetCertificate())
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not load TLS certificate of GatewayListener %q", resource.GetMeta().GetName())
+				}
+				key, err := loader.Load(ctx, mesh, listener.GetTls().GetKey())
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not load TLS key of GatewayListener %q", resource.GetMeta().GetName())
+				}
+				gatewayListener.Certificate = cert
+				gatewayListener.Key = key
+			}
+			gateway.Listeners = append(gateway.Listeners, gatewayListener)
+		}
+	}
+	if len(gateway.Listeners) == 0 {
+		return nil, nil
+	}
+
+	routes := mesh_core.GatewayRouteResourceList{}
+	if err := manager.List(ctx, &routes, store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	sort.Slice(routes.Items, func(i, j int) bool {
+		return routes.Items[i].GetMeta().GetName() < routes.Items[j].GetMeta().GetName()
+	})
+	for _, route := range routes.Items {
+		if selectsDataplane(dataplane, route.Selectors()) {
+			gateway.Routes = append(gateway.Routes, route)
+		}
+	}
+	return gateway, nil
+}
+
+func selectsDataplane(dataplane *mesh_core.DataplaneResource, selectors []*mesh_proto.Selector) bool {
+	for _, selector := range selectors {
+		if dataplane.Spec.Matches(mesh_proto.TagSelector(selector.Match)) {
+			return true
+		}
+	}
+	return false
+}
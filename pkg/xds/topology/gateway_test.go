@@ -0,0 +1,150 @@
+package topology_test
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	resources_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	plugins_memory "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	"github.com/kumahq/kuma/pkg/xds/topology"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetGateway", func() {
+
+	gateway := core_mesh.DataplaneResource{
+		Meta: &test_model.ResourceMeta{
+			Name: "edge-1",
+			Mesh: "default",
+		},
+		Spec: mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "192.168.0.1",
+				Gateway: &mesh_proto.Dataplane_Networking_Gateway{
+					Tags: map[string]string{
+						"kuma.io/service": "edge",
+					},
+				},
+			},
+		},
+	}
+
+	var store core_store.ResourceStore
+	var manager resources_manager.ResourceManager
+	var loader datasource.Loader
+
+	BeforeEach(func() {
+		store = plugins_memory.NewStore()
+		manager = resources_manager.NewResourceManager(store)
+		loader = datasource.NewDataSourceLoader(manager)
+	})
+
+	create := func(resource core_model.Resource) {
+		err := store.Create(context.Background(), resource, core_store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	selectors := func(service string) []*mesh_proto.Selector {
+		return []*mesh_proto.Selector{{Match: map[string]string{"kuma.io/service": service}}}
+	}
+
+	It("should return listeners and routes that select the gateway", func() {
+		// given
+		create(&core_mesh.GatewayListenerResource{
+			Meta: &test_model.ResourceMeta{Name: "web", Mesh: "default"},
+			Spec: mesh_proto.GatewayListener{
+				Selectors: selectors("*"),
+				Listeners: []*mesh_proto.GatewayListener_Listener{
+					{Port: 80, Hostname: "*"},
+					{
+						Port:     443,
+						Protocol: mesh_proto.GatewayListener_Listener_HTTPS,
+						Hostname: "example.com",
+						Tls: &mesh_proto.GatewayListener_Listener_Tls{
+							Certificate: &system_proto.DataSource{Type: &system_proto.DataSource_Inline{Inline: &wrappers.BytesValue{Value: []byte("CERT")}}},
+							Key:         &system_proto.DataSource{Type: &system_proto.DataSource_Inline{Inline: &wrappers.BytesValue{Value: []byte("KEY")}}},
+						},
+					},
+				},
+			},
+		})
+		create(&core_mesh.GatewayListenerResource{
+			Meta: &test_model.ResourceMeta{Name: "xyz", Mesh: "default"},
+			Spec: mesh_proto.GatewayListener{
+				Selectors: selectors("edge"),
+				Listeners: []*mesh_proto.GatewayListener_Listener{
+					{Port: 80, Hostname: "*"},
+					{Port: 8080, Hostname: "*"},
+				},
+			},
+		})
+		create(&core_mesh.GatewayListenerResource{
+			Meta: &test_model.ResourceMeta{Name: "other", Mesh: "default"},
+			Spec: mesh_proto.GatewayListener{
+				Selectors: selectors("other"),
+				Listeners: []*mesh_proto.GatewayListener_Listener{
+					{Port: 9090, Hostname: "*"},
+				},
+			},
+		})
+		create(&core_mesh.GatewayRouteResource{
+			Meta: &test_model.ResourceMeta{Name: "web", Mesh: "default"},
+			Spec: mesh_proto.GatewayRoute{
+				Selectors: selectors("edge"),
+			},
+		})
+		create(&core_mesh.GatewayRouteResource{
+			Meta: &test_model.ResourceMeta{Name: "other", Mesh: "default"},
+			Spec: mesh_proto.GatewayRoute{
+				Selectors: selectors("other"),
+			},
+		})
+
+		// when
+		actual, err := topology.GetGateway(context.Background(), &gateway, manager, loader)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual.Listeners).To(Equal([]core_xds.GatewayListener{
+			{Port: 80, Hostname: "*"},
+			{Port: 443, Protocol: mesh_proto.GatewayListener_Listener_HTTPS, Hostname: "example.com", Certificate: []byte("CERT"), Key: []byte("KEY")},
+			{Port: 8080, Hostname: "*"},
+		}))
+		Expect(actual.Routes).To(HaveLen(1))
+		Expect(actual.Routes[0].GetMeta().GetName()).To(Equal("web"))
+	})
+
+	It("should return nil for a Dataplane that is not a gateway", func() {
+		// given
+		dataplane := core_mesh.DataplaneResource{
+			Meta: &test_model.ResourceMeta{Name: "dp-1", Mesh: "default"},
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.2",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+						Port: 8080,
+						Tags: map[string]string{"kuma.io/service": "edge"},
+					}},
+				},
+			},
+		}
+
+		// when
+		actual, err := topology.GetGateway(context.Background(), &dataplane, manager, loader)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(BeNil())
+	})
+})
@@ -0,0 +1,207 @@
+package topology
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	manager_dataplane "github.com/kumahq/kuma/pkg/core/managers/apis/dataplane"
+	"github.com/kumahq/kuma/pkg/core/policy"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+// AttachmentType is a part of a Dataplane that a policy is applied to.
+type AttachmentType string
+
+const (
+	InboundAttachment   AttachmentType = "inbound"
+	OutboundAttachment  AttachmentType = "outbound"
+	ServiceAttachment   AttachmentType = "service"
+	DataplaneAttachment AttachmentType = "dataplane"
+)
+
+// Attachment identifies a part of a Dataplane, e.g. an inbound interface or a destination service.
+type Attachment struct {
+	Type AttachmentType
+	// Name is an inbound or an outbound interface, a name of a service or a name of a Dataplane.
+	Name string
+	// Service is a service of an inbound or an outbound interface.
+	Service string
+}
+
+// MatchedPolicy is a policy applied to a given part of a Dataplane.
+type MatchedPolicy struct {
+	Policy     core_model.Resource
+	Attachment Attachment
+}
+
+// MeshPolicies holds all policies of a mesh that are matched with Dataplanes.
+type MeshPolicies struct {
+	Mesh               *mesh_core.MeshResource
+	TrafficPermissions []*mesh_core.TrafficPermissionResource
+	FaultInjections    []*mesh_core.FaultInjectionResource
+	TrafficRoutes      []*mesh_core.TrafficRouteResource
+	TrafficLogs        []*mesh_core.TrafficLogResource
+	HealthChecks       []*mesh_core.HealthCheckResource
+	CircuitBreakers    []*mesh_core.CircuitBreakerResource
+	TrafficTraces      []*mesh_core.TrafficTraceResource
+	ProxyTemplates     []*mesh_core.ProxyTemplateResource
+}
+
+// GetMeshPolicies fetches all policies of a given mesh.
+func GetMeshPolicies(ctx context.Context, mesh *mesh_core.MeshResource, manager core_manager.ReadOnlyResourceManager) (*MeshPolicies, error) {
+	meshName := mesh.GetMeta().GetName()
+	permissions := &mesh_core.TrafficPermissionResourceList{}
+	faultInjections := &mesh_core.FaultInjectionResourceList{}
+	routes := &mesh_core.TrafficRouteResourceList{}
+	logs := &mesh_core.TrafficLogResourceList{}
+	healthChecks := &mesh_core.HealthCheckResourceList{}
+	circuitBreakers := &mesh_core.CircuitBreakerResourceList{}
+	traces := &mesh_core.TrafficTraceResourceList{}
+	proxyTemplates := &mesh_core.ProxyTemplateResourceList{}
+	for _, list := range []core_model.ResourceList{permissions, faultInjections, routes, logs, healthChecks, circuitBreakers, traces, proxyTemplates} {
+		if err := manager.List(ctx, list, core_store.ListByMesh(meshName)); err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve %s", list.GetItemType())
+		}
+	}
+	return &MeshPolicies{
+		Mesh:               mesh,
+		TrafficPermissions: permissions.Items,
+		FaultInjections:    faultInjections.Items,
+		TrafficRoutes:      routes.Items,
+		TrafficLogs:        logs.Items,
+		HealthChecks:       healthChecks.Items,
+		CircuitBreakers:    circuitBreakers.Items,
+		TrafficTraces:      traces.Items,
+		ProxyTemplates:     proxyTemplates.Items,
+	}, nil
+}
+
+// MatchPolicies returns policies applied to a given Dataplane.
+// Policies are selected the same way as when Envoy configuration of the Dataplane is generated.
+func MatchPolicies(dataplane *mesh_core.DataplaneResource, policies *MeshPolicies) ([]MatchedPolicy, error) {
+	if dataplane.Spec.IsIngress() {
+		return nil, nil
+	}
+	var matched []MatchedPolicy
+
+	// inbound policies
+	additionalInbounds, err := manager_dataplane.AdditionalInbounds(dataplane, policies.Mesh)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch additional inbounds")
+	}
+	inbounds := append(dataplane.Spec.GetNetworking().GetInbound(), additionalInbounds...)
+	permissions := make([]policy.ConnectionPolicy, len(policies.TrafficPermissions))
+	for i, permission := range policies.TrafficPermissions {
+		permissions[i] = permission
+	}
+	faultInjections := make([]policy.ConnectionPolicy, len(policies.FaultInjections))
+	for i, faultInjection := range policies.FaultInjections {
+		faultInjections[i] = faultInjection
+	}
+	permissionMap := policy.SelectInboundConnectionPolicies(dataplane, inbounds, permissions)
+	faultInjectionMap := policy.SelectInboundConnectionPolicies(dataplane, inbounds, faultInjections)
+	for _, inbound := range inbounds {
+		iface := dataplane.Spec.GetNetworking().ToInboundInterface(inbound)
+		attachment := Attachment{
+			Type:    InboundAttachment,
+			Name:    iface.String(),
+			Service: inbound.GetService(),
+		}
+		if permission, ok := permissionMap[iface]; ok {
+			matched = append(matched, MatchedPolicy{Policy: permission, Attachment: attachment})
+		}
+		if faultInjection, ok := faultInjectionMap[iface]; ok {
+			matched = append(matched, MatchedPolicy{Policy: faultInjection, Attachment: attachment})
+		}
+	}
+
+	// outbound policies
+	routeMap := BuildRouteMap(dataplane, policies.TrafficRoutes)
+	logs := make([]policy.ConnectionPolicy, len(policies.TrafficLogs))
+	for i, log := range policies.TrafficLogs {
+		logs[i] = log
+	}
+	logMap := policy.SelectOutboundConnectionPolicies(dataplane, logs)
+	backends := loggingBackends(policies.Mesh)
+	for _, oface := range dataplane.Spec.GetNetworking().GetOutbound() {
+		service := oface.GetTagsIncludingLegacy()[mesh_proto.ServiceTag]
+		outbound := dataplane.Spec.GetNetworking().ToOutboundInterface(oface)
+		attachment := Attachment{
+			Type:    OutboundAttachment,
+			Name:    outbound.String(),
+			Service: service,
+		}
+		if route, ok := routeMap[outbound]; ok {
+			if _, implicit := route.GetMeta().(*pseudoMeta); !implicit {
+				matched = append(matched, MatchedPolicy{Policy: route, Attachment: attachment})
+			}
+		}
+		if log, ok := logMap[service]; ok {
+			// traffic logs without a backend are ignored when Envoy configuration is generated
+			if _, found := backends[log.(*mesh_core.TrafficLogResource).Spec.GetConf().GetBackend()]; found {
+				matched = append(matched, MatchedPolicy{Policy: log, Attachment: attachment})
+			}
+		}
+	}
+
+	// policies of destination services
+	destinations := BuildDestinationMap(dataplane, routeMap)
+	healthCheckMap := BuildHealthCheckMap(dataplane, destinations, policies.HealthChecks)
+	circuitBreakerMap := BuildCircuitBreakerMap(dataplane, destinations, policies.CircuitBreakers)
+	services := make([]string, 0, len(destinations))
+	for service := range destinations {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		attachment := Attachment{
+			Type:    ServiceAttachment,
+			Name:    service,
+			Service: service,
+		}
+		if healthCheck, ok := healthCheckMap[service]; ok {
+			matched = append(matched, MatchedPolicy{Policy: healthCheck, Attachment: attachment})
+		}
+		if circuitBreaker, ok := circuitBreakerMap[service]; ok {
+			matched = append(matched, MatchedPolicy{Policy: circuitBreaker, Attachment: attachment})
+		}
+	}
+
+	// policies of the whole dataplane
+	attachment := Attachment{
+		Type: DataplaneAttachment,
+		Name: dataplane.GetMeta().GetName(),
+	}
+	traces := make([]policy.DataplanePolicy, len(policies.TrafficTraces))
+	for i, trace := range policies.TrafficTraces {
+		traces[i] = trace
+	}
+	if trace := policy.SelectDataplanePolicy(dataplane, traces); trace != nil {
+		matched = append(matched, MatchedPolicy{Policy: trace, Attachment: attachment})
+	}
+	proxyTemplates := make([]policy.DataplanePolicy, len(policies.ProxyTemplates))
+	for i, proxyTemplate := range policies.ProxyTemplates {
+		proxyTemplates[i] = proxyTemplate
+	}
+	if proxyTemplate := policy.SelectDataplanePolicy(dataplane, proxyTemplates); proxyTemplate != nil {
+		matched = append(matched, MatchedPolicy{Policy: proxyTemplate, Attachment: attachment})
+	}
+	return matched, nil
+}
+
+func loggingBackends(mesh *mesh_core.MeshResource) map[string]bool {
+	backends := map[string]bool{}
+	for _, backend := range mesh.Spec.GetLogging().GetBackends() {
+		backends[backend.Name] = true
+	}
+	if defaultBackend := mesh.Spec.GetLogging().GetDefaultBackend(); defaultBackend != "" {
+		backends[""] = backends[defaultBackend]
+	}
+	return backends
+}
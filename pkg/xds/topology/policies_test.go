@@ -0,0 +1,204 @@
+package topology_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/xds/topology"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("MatchPolicies()", func() {
+
+	var ctx context.Context
+	var rm core_manager.ResourceManager
+	var store core_store.ResourceStore
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = memory_resources.NewStore()
+		rm = core_manager.NewResourceManager(store)
+	})
+
+	type matched struct {
+		policy     string
+		attachment Attachment
+	}
+
+	It("should list policies applied to every part of a dataplane", func() {
+		// given
+		mesh := &mesh_core.MeshResource{
+			Spec: mesh_proto.Mesh{
+				Logging: &mesh_proto.Logging{
+					Backends: []*mesh_proto.LoggingBackend{
+						{Name: "file"},
+					},
+				},
+			},
+		}
+		// validation of logging backends is not relevant to this test case
+		Expect(store.Create(ctx, mesh, core_store.CreateByKey("demo", "demo"))).To(Succeed())
+
+		dataplane := &mesh_core.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Tags:        map[string]string{"kuma.io/service": "web"},
+							Port:        8080,
+							ServicePort: 18080,
+						},
+					},
+					Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+						{Service: "backend", Port: 10001},
+						{Service: "redis", Port: 10002},
+					},
+				},
+			},
+		}
+		Expect(rm.Create(ctx, dataplane, core_store.CreateByKey("web-01", "demo"))).To(Succeed())
+
+		policies := []struct {
+			name string
+			res  core_model.Resource
+		}{
+			{
+				name: "allow-all",
+				res: &mesh_core.TrafficPermissionResource{
+					Spec: mesh_proto.TrafficPermission{
+						Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+						Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+					},
+				},
+			},
+			{
+				name: "backend-v2",
+				res: &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+						Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("backend")}},
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{
+							{Weight: 100, Destination: mesh_proto.MatchTags(map[string]string{"kuma.io/service": "backend-v2"})},
+						},
+					},
+				},
+			},
+			{
+				name: "logs",
+				res: &mesh_core.TrafficLogResource{
+					Spec: mesh_proto.TrafficLog{
+						Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+						Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("redis")}},
+						Conf:         &mesh_proto.TrafficLog_Conf{Backend: "file"},
+					},
+				},
+			},
+			{
+				name: "logs-without-backend",
+				res: &mesh_core.TrafficLogResource{
+					Spec: mesh_proto.TrafficLog{
+						Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+						Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("backend")}},
+						Conf:         &mesh_proto.TrafficLog_Conf{Backend: "unknown"},
+					},
+				},
+			},
+			{
+				name: "hc-backend-v2",
+				res: &mesh_core.HealthCheckResource{
+					Spec: mesh_proto.HealthCheck{
+						Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+						Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("backend-v2")}},
+						Conf: &mesh_proto.HealthCheck_Conf{
+							Interval:           ptypes.DurationProto(5 * time.Second),
+							Timeout:            ptypes.DurationProto(time.Second),
+							UnhealthyThreshold: 3,
+							HealthyThreshold:   1,
+						},
+					},
+				},
+			},
+			{
+				name: "traces",
+				res: &mesh_core.TrafficTraceResource{
+					Spec: mesh_proto.TrafficTrace{
+						Selectors: []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+					},
+				},
+			},
+		}
+		for _, p := range policies {
+			Expect(rm.Create(ctx, p.res, core_store.CreateByKey(p.name, "demo"))).To(Succeed())
+		}
+
+		// when
+		meshPolicies, err := GetMeshPolicies(ctx, mesh, rm)
+		Expect(err).ToNot(HaveOccurred())
+		result, err := MatchPolicies(dataplane, meshPolicies)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		var actual []matched
+		for _, m := range result {
+			actual = append(actual, matched{
+				policy:     string(m.Policy.GetType()) + "/" + m.Policy.GetMeta().GetName(),
+				attachment: m.Attachment,
+			})
+		}
+		Expect(actual).To(Equal([]matched{
+			{
+				policy:     "TrafficPermission/allow-all",
+				attachment: Attachment{Type: InboundAttachment, Name: "192.168.0.1:8080:18080", Service: "web"},
+			},
+			{
+				policy:     "TrafficRoute/backend-v2",
+				attachment: Attachment{Type: OutboundAttachment, Name: "127.0.0.1:10001", Service: "backend"},
+			},
+			{
+				policy:     "TrafficLog/logs",
+				attachment: Attachment{Type: OutboundAttachment, Name: "127.0.0.1:10002", Service: "redis"},
+			},
+			{
+				policy:     "HealthCheck/hc-backend-v2",
+				attachment: Attachment{Type: ServiceAttachment, Name: "backend-v2", Service: "backend-v2"},
+			},
+			{
+				policy:     "TrafficTrace/traces",
+				attachment: Attachment{Type: DataplaneAttachment, Name: "web-01"},
+			},
+		}))
+	})
+
+	It("should not match policies with an Ingress", func() {
+		// given
+		ingress := &mesh_core.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Ingress: &mesh_proto.Dataplane_Networking_Ingress{},
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{Port: 10001},
+					},
+				},
+			},
+		}
+
+		// when
+		result, err := MatchPolicies(ingress, &MeshPolicies{Mesh: &mesh_core.MeshResource{}})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeEmpty())
+	})
+})
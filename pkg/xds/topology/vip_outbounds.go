@@ -8,7 +8,7 @@ import (
 	"github.com/kumahq/kuma/pkg/dns"
 )
 
-const VIPListenPort = uint32(80)
+const VIPListenPort = dns.VIPListenPort
 
 func PatchDataplaneWithVIPOutbounds(dataplane *mesh_core.DataplaneResource,
 	dataplanes *mesh_core.DataplaneResourceList, resolver dns.DNSResolver) (errs error) {
@@ -47,15 +47,24 @@ func PatchDataplaneWithVIPOutbounds(dataplane *mesh_core.DataplaneResource,
 	sort.Strings(services)
 
 	for _, service := range services {
-		dataplane.Spec.Networking.Outbound = append(dataplane.Spec.Networking.Outbound,
-			&mesh_proto.Dataplane_Networking_Outbound{
-				Address: serviceVIPMap[service],
-				Port:    VIPListenPort,
-				Service: service,
-				Tags: map[string]string{
-					mesh_proto.ServiceTag: service,
-				},
-			})
+		// a service is available on VIPListenPort and on ports of its instances, which the DNS Server returns in SRV records
+		ports := []uint32{VIPListenPort}
+		for _, port := range resolver.LookupPorts(service) {
+			if port != VIPListenPort {
+				ports = append(ports, port)
+			}
+		}
+		for _, port := range ports {
+			dataplane.Spec.Networking.Outbound = append(dataplane.Spec.Networking.Outbound,
+				&mesh_proto.Dataplane_Networking_Outbound{
+					Address: serviceVIPMap[service],
+					Port:    port,
+					Service: service,
+					Tags: map[string]string{
+						mesh_proto.ServiceTag: service,
+					},
+				})
+		}
 	}
 
 	return
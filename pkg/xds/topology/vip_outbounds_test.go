@@ -85,4 +85,61 @@ var _ = Describe("PatchDataplaneWithVIPOutbounds", func() {
 		Expect(dataplane.Spec.Networking.Outbound[3].Port).To(Equal(topology.VIPListenPort))
 	})
 
+	It("should add outbounds on ports of services", func() {
+		dataplane := &core_mesh.DataplaneResource{
+			Meta: &test_model.ResourceMeta{
+				Name: "dp1",
+				Mesh: "default",
+			},
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+				},
+			},
+		}
+
+		// setup
+		resolver := dns.NewDNSResolver("mesh")
+		resolver.SetVIPs(dns.VIPList{
+			"backend": "240.0.0.1",
+		})
+		resolver.SetServicePorts(dns.ServicePorts{
+			"backend": {80, 8080},
+		})
+
+		// given
+		dataplanes := core_mesh.DataplaneResourceList{
+			Items: []*core_mesh.DataplaneResource{{
+				Meta: &test_model.ResourceMeta{
+					Name: "dp2",
+					Mesh: "default",
+				},
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.2",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+							Port: 8080,
+							Tags: map[string]string{
+								"kuma.io/service": "backend",
+							},
+						}},
+					},
+				},
+			}},
+		}
+
+		// when
+		err := topology.PatchDataplaneWithVIPOutbounds(dataplane, &dataplanes, resolver)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(dataplane.Spec.Networking.Outbound).To(HaveLen(2))
+		Expect(dataplane.Spec.Networking.Outbound[0].Address).To(Equal("240.0.0.1"))
+		Expect(dataplane.Spec.Networking.Outbound[0].Port).To(Equal(topology.VIPListenPort))
+		Expect(dataplane.Spec.Networking.Outbound[1].Address).To(Equal("240.0.0.1"))
+		Expect(dataplane.Spec.Networking.Outbound[1].Port).To(Equal(uint32(8080)))
+		Expect(dataplane.Spec.Networking.Outbound[1].GetService()).To(Equal("backend"))
+	})
+
 })
@@ -55,5 +55,6 @@ gen_help kumactl get zones
 gen_help kumactl delete
 gen_help kumactl inspect
 gen_help kumactl inspect dataplanes
+gen_help kumactl inspect services
 gen_help kumactl inspect zones
 gen_help kumactl version